@@ -29,6 +29,8 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_AccountsByType_FullMethodName       = "/cosmos.auth.v1beta1.Query/AccountsByType"
+	Query_AccountsByAddresses_FullMethodName  = "/cosmos.auth.v1beta1.Query/AccountsByAddresses"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +74,14 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountsByType returns all the existing accounts of a given type.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AccountsByType(ctx context.Context, in *QueryAccountsByTypeRequest, opts ...grpc.CallOption) (*QueryAccountsByTypeResponse, error)
+	// AccountsByAddresses returns the existing accounts among the given addresses.
+	// The number of addresses is capped by the max_account_query_batch parameter.
+	AccountsByAddresses(ctx context.Context, in *QueryAccountsByAddressesRequest, opts ...grpc.CallOption) (*QueryAccountsByAddressesResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +182,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountsByType(ctx context.Context, in *QueryAccountsByTypeRequest, opts ...grpc.CallOption) (*QueryAccountsByTypeResponse, error) {
+	out := new(QueryAccountsByTypeResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByAddresses(ctx context.Context, in *QueryAccountsByAddressesRequest, opts ...grpc.CallOption) (*QueryAccountsByAddressesResponse, error) {
+	out := new(QueryAccountsByAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +241,14 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountsByType returns all the existing accounts of a given type.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AccountsByType(context.Context, *QueryAccountsByTypeRequest) (*QueryAccountsByTypeResponse, error)
+	// AccountsByAddresses returns the existing accounts among the given addresses.
+	// The number of addresses is capped by the max_account_query_batch parameter.
+	AccountsByAddresses(context.Context, *QueryAccountsByAddressesRequest) (*QueryAccountsByAddressesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +286,12 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) AccountsByType(context.Context, *QueryAccountsByTypeRequest) (*QueryAccountsByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByType not implemented")
+}
+func (UnimplementedQueryServer) AccountsByAddresses(context.Context, *QueryAccountsByAddressesRequest) (*QueryAccountsByAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByAddresses not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +485,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByType(ctx, req.(*QueryAccountsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByAddresses(ctx, req.(*QueryAccountsByAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +568,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountsByType",
+			Handler:    _Query_AccountsByType_Handler,
+		},
+		{
+			MethodName: "AccountsByAddresses",
+			Handler:    _Query_AccountsByAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
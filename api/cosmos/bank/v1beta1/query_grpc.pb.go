@@ -30,6 +30,12 @@ const (
 	Query_DenomsMetadata_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
 	Query_DenomOwners_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_SendEnabled_FullMethodName             = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_DenomSupplyCap_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomSupplyCap"
+	Query_FrozenAccounts_FullMethodName          = "/cosmos.bank.v1beta1.Query/FrozenAccounts"
+	Query_Escrow_FullMethodName                  = "/cosmos.bank.v1beta1.Query/Escrow"
+	Query_TopDenomsBySupply_FullMethodName       = "/cosmos.bank.v1beta1.Query/TopDenomsBySupply"
+	Query_DenomHolders_FullMethodName            = "/cosmos.bank.v1beta1.Query/DenomHolders"
+	Query_TransferMemo_FullMethodName            = "/cosmos.bank.v1beta1.Query/TransferMemo"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +98,23 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// DenomSupplyCap queries the supply cap of a single coin.
+	DenomSupplyCap(ctx context.Context, in *QueryDenomSupplyCapRequest, opts ...grpc.CallOption) (*QueryDenomSupplyCapResponse, error)
+	// FrozenAccounts queries the frozen denoms of all the frozen accounts.
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	// Escrow queries an escrow by its identifier.
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// TopDenomsBySupply queries the denoms with the largest total supply, in
+	// descending order of supply.
+	TopDenomsBySupply(ctx context.Context, in *QueryTopDenomsBySupplyRequest, opts ...grpc.CallOption) (*QueryTopDenomsBySupplyResponse, error)
+	// DenomHolders queries the accounts holding a denom, with their balance.
+	//
+	// Unlike DenomOwners, the balances are read from the denom holders index, so
+	// that the cost of the query only depends on the page size.
+	DenomHolders(ctx context.Context, in *QueryDenomHoldersRequest, opts ...grpc.CallOption) (*QueryDenomHoldersResponse, error)
+	// TransferMemo queries the memo of a transfer sent with MsgSendWithMemo by
+	// its sender and sequence.
+	TransferMemo(ctx context.Context, in *QueryTransferMemoRequest, opts ...grpc.CallOption) (*QueryTransferMemoResponse, error)
 }
 
 type queryClient struct {
@@ -201,6 +224,60 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) DenomSupplyCap(ctx context.Context, in *QueryDenomSupplyCapRequest, opts ...grpc.CallOption) (*QueryDenomSupplyCapResponse, error) {
+	out := new(QueryDenomSupplyCapResponse)
+	err := c.cc.Invoke(ctx, Query_DenomSupplyCap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_Escrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopDenomsBySupply(ctx context.Context, in *QueryTopDenomsBySupplyRequest, opts ...grpc.CallOption) (*QueryTopDenomsBySupplyResponse, error) {
+	out := new(QueryTopDenomsBySupplyResponse)
+	err := c.cc.Invoke(ctx, Query_TopDenomsBySupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomHolders(ctx context.Context, in *QueryDenomHoldersRequest, opts ...grpc.CallOption) (*QueryDenomHoldersResponse, error) {
+	out := new(QueryDenomHoldersResponse)
+	err := c.cc.Invoke(ctx, Query_DenomHolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferMemo(ctx context.Context, in *QueryTransferMemoRequest, opts ...grpc.CallOption) (*QueryTransferMemoResponse, error) {
+	out := new(QueryTransferMemoResponse)
+	err := c.cc.Invoke(ctx, Query_TransferMemo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -261,6 +338,23 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// DenomSupplyCap queries the supply cap of a single coin.
+	DenomSupplyCap(context.Context, *QueryDenomSupplyCapRequest) (*QueryDenomSupplyCapResponse, error)
+	// FrozenAccounts queries the frozen denoms of all the frozen accounts.
+	FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error)
+	// Escrow queries an escrow by its identifier.
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// TopDenomsBySupply queries the denoms with the largest total supply, in
+	// descending order of supply.
+	TopDenomsBySupply(context.Context, *QueryTopDenomsBySupplyRequest) (*QueryTopDenomsBySupplyResponse, error)
+	// DenomHolders queries the accounts holding a denom, with their balance.
+	//
+	// Unlike DenomOwners, the balances are read from the denom holders index, so
+	// that the cost of the query only depends on the page size.
+	DenomHolders(context.Context, *QueryDenomHoldersRequest) (*QueryDenomHoldersResponse, error)
+	// TransferMemo queries the memo of a transfer sent with MsgSendWithMemo by
+	// its sender and sequence.
+	TransferMemo(context.Context, *QueryTransferMemoRequest) (*QueryTransferMemoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -301,6 +395,24 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) DenomSupplyCap(context.Context, *QueryDenomSupplyCapRequest) (*QueryDenomSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSupplyCap not implemented")
+}
+func (UnimplementedQueryServer) FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (UnimplementedQueryServer) Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (UnimplementedQueryServer) TopDenomsBySupply(context.Context, *QueryTopDenomsBySupplyRequest) (*QueryTopDenomsBySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopDenomsBySupply not implemented")
+}
+func (UnimplementedQueryServer) DenomHolders(context.Context, *QueryDenomHoldersRequest) (*QueryDenomHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomHolders not implemented")
+}
+func (UnimplementedQueryServer) TransferMemo(context.Context, *QueryTransferMemoRequest) (*QueryTransferMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferMemo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -512,6 +624,114 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSupplyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomSupplyCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSupplyCap(ctx, req.(*QueryDenomSupplyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopDenomsBySupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopDenomsBySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopDenomsBySupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TopDenomsBySupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopDenomsBySupply(ctx, req.(*QueryTopDenomsBySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomHolders(ctx, req.(*QueryDenomHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferMemo(ctx, req.(*QueryTransferMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +783,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "DenomSupplyCap",
+			Handler:    _Query_DenomSupplyCap_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "TopDenomsBySupply",
+			Handler:    _Query_TopDenomsBySupply_Handler,
+		},
+		{
+			MethodName: "DenomHolders",
+			Handler:    _Query_DenomHolders_Handler,
+		},
+		{
+			MethodName: "TransferMemo",
+			Handler:    _Query_TransferMemo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                   = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName              = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName         = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_FreezeAccount_FullMethodName          = "/cosmos.bank.v1beta1.Msg/FreezeAccount"
+	Msg_UnfreezeAccount_FullMethodName        = "/cosmos.bank.v1beta1.Msg/UnfreezeAccount"
+	Msg_CreateEscrow_FullMethodName           = "/cosmos.bank.v1beta1.Msg/CreateEscrow"
+	Msg_FulfillEscrow_FullMethodName          = "/cosmos.bank.v1beta1.Msg/FulfillEscrow"
+	Msg_GrantBurnAuthorization_FullMethodName = "/cosmos.bank.v1beta1.Msg/GrantBurnAuthorization"
+	Msg_BurnCoins_FullMethodName              = "/cosmos.bank.v1beta1.Msg/BurnCoins"
+	Msg_SendWithMemo_FullMethodName           = "/cosmos.bank.v1beta1.Msg/SendWithMemo"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +52,27 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// FreezeAccount is an operation of the authority or of a module account
+	// freezing the transfers of some denoms from an account.
+	FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount is an operation of the authority or of a module account
+	// unfreezing the transfers of some denoms from an account.
+	UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error)
+	// CreateEscrow defines a method for holding coins of the sender in escrow
+	// until the recipient sends the expected coins in exchange.
+	CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error)
+	// FulfillEscrow defines a method for the recipient of an escrow to exchange
+	// the expected coins with the coins held in escrow.
+	FulfillEscrow(ctx context.Context, in *MsgFulfillEscrow, opts ...grpc.CallOption) (*MsgFulfillEscrowResponse, error)
+	// GrantBurnAuthorization defines a governance operation authorizing an
+	// address to burn up to a given amount of a denom from its own account.
+	GrantBurnAuthorization(ctx context.Context, in *MsgGrantBurnAuthorization, opts ...grpc.CallOption) (*MsgGrantBurnAuthorizationResponse, error)
+	// BurnCoins defines a method for burning coins from the signer's account
+	// under a burn authorization.
+	BurnCoins(ctx context.Context, in *MsgBurnCoins, opts ...grpc.CallOption) (*MsgBurnCoinsResponse, error)
+	// SendWithMemo defines a method for sending coins from one account to
+	// another account, storing a memo describing the purpose of the transfer.
+	SendWithMemo(ctx context.Context, in *MsgSendWithMemo, opts ...grpc.CallOption) (*MsgSendWithMemoResponse, error)
 }
 
 type msgClient struct {
@@ -91,6 +119,69 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error) {
+	out := new(MsgFreezeAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error) {
+	out := new(MsgUnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error) {
+	out := new(MsgCreateEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillEscrow(ctx context.Context, in *MsgFulfillEscrow, opts ...grpc.CallOption) (*MsgFulfillEscrowResponse, error) {
+	out := new(MsgFulfillEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_FulfillEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantBurnAuthorization(ctx context.Context, in *MsgGrantBurnAuthorization, opts ...grpc.CallOption) (*MsgGrantBurnAuthorizationResponse, error) {
+	out := new(MsgGrantBurnAuthorizationResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantBurnAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnCoins(ctx context.Context, in *MsgBurnCoins, opts ...grpc.CallOption) (*MsgBurnCoinsResponse, error) {
+	out := new(MsgBurnCoinsResponse)
+	err := c.cc.Invoke(ctx, Msg_BurnCoins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendWithMemo(ctx context.Context, in *MsgSendWithMemo, opts ...grpc.CallOption) (*MsgSendWithMemoResponse, error) {
+	out := new(MsgSendWithMemoResponse)
+	err := c.cc.Invoke(ctx, Msg_SendWithMemo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +202,27 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// FreezeAccount is an operation of the authority or of a module account
+	// freezing the transfers of some denoms from an account.
+	FreezeAccount(context.Context, *MsgFreezeAccount) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount is an operation of the authority or of a module account
+	// unfreezing the transfers of some denoms from an account.
+	UnfreezeAccount(context.Context, *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error)
+	// CreateEscrow defines a method for holding coins of the sender in escrow
+	// until the recipient sends the expected coins in exchange.
+	CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error)
+	// FulfillEscrow defines a method for the recipient of an escrow to exchange
+	// the expected coins with the coins held in escrow.
+	FulfillEscrow(context.Context, *MsgFulfillEscrow) (*MsgFulfillEscrowResponse, error)
+	// GrantBurnAuthorization defines a governance operation authorizing an
+	// address to burn up to a given amount of a denom from its own account.
+	GrantBurnAuthorization(context.Context, *MsgGrantBurnAuthorization) (*MsgGrantBurnAuthorizationResponse, error)
+	// BurnCoins defines a method for burning coins from the signer's account
+	// under a burn authorization.
+	BurnCoins(context.Context, *MsgBurnCoins) (*MsgBurnCoinsResponse, error)
+	// SendWithMemo defines a method for sending coins from one account to
+	// another account, storing a memo describing the purpose of the transfer.
+	SendWithMemo(context.Context, *MsgSendWithMemo) (*MsgSendWithMemoResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -130,6 +242,27 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) FreezeAccount(context.Context, *MsgFreezeAccount) (*MsgFreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeAccount(context.Context, *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedMsgServer) CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrow not implemented")
+}
+func (UnimplementedMsgServer) FulfillEscrow(context.Context, *MsgFulfillEscrow) (*MsgFulfillEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillEscrow not implemented")
+}
+func (UnimplementedMsgServer) GrantBurnAuthorization(context.Context, *MsgGrantBurnAuthorization) (*MsgGrantBurnAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBurnAuthorization not implemented")
+}
+func (UnimplementedMsgServer) BurnCoins(context.Context, *MsgBurnCoins) (*MsgBurnCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnCoins not implemented")
+}
+func (UnimplementedMsgServer) SendWithMemo(context.Context, *MsgSendWithMemo) (*MsgSendWithMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWithMemo not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -215,6 +348,132 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAccount(ctx, req.(*MsgFreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAccount(ctx, req.(*MsgUnfreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEscrow(ctx, req.(*MsgCreateEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FulfillEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillEscrow(ctx, req.(*MsgFulfillEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantBurnAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantBurnAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantBurnAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantBurnAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantBurnAuthorization(ctx, req.(*MsgGrantBurnAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnCoins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BurnCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnCoins(ctx, req.(*MsgBurnCoins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendWithMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendWithMemo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendWithMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendWithMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendWithMemo(ctx, req.(*MsgSendWithMemo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -238,6 +497,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _Msg_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _Msg_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CreateEscrow",
+			Handler:    _Msg_CreateEscrow_Handler,
+		},
+		{
+			MethodName: "FulfillEscrow",
+			Handler:    _Msg_FulfillEscrow_Handler,
+		},
+		{
+			MethodName: "GrantBurnAuthorization",
+			Handler:    _Msg_GrantBurnAuthorization_Handler,
+		},
+		{
+			MethodName: "BurnCoins",
+			Handler:    _Msg_BurnCoins_Handler,
+		},
+		{
+			MethodName: "SendWithMemo",
+			Handler:    _Msg_SendWithMemo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
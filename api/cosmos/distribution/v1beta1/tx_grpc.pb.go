@@ -21,10 +21,13 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_SetWithdrawAddress_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
 	Msg_WithdrawDelegatorReward_FullMethodName     = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
+	Msg_WithdrawAllDelegatorRewards_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards"
 	Msg_WithdrawValidatorCommission_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
 	Msg_FundCommunityPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
 	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
 	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
+	Msg_SetValidatorFeeShare_FullMethodName        = "/cosmos.distribution.v1beta1.Msg/SetValidatorFeeShare"
+	Msg_RefundSlash_FullMethodName                 = "/cosmos.distribution.v1beta1.Msg/RefundSlash"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +40,9 @@ type MsgClient interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of
+	// delegator from all the validators it delegates to.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
@@ -55,6 +61,14 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error)
+	// SetValidatorFeeShare defines a method for a validator to set the fraction
+	// of the commission earned on the blocks it proposed which is shared with
+	// its delegators.
+	SetValidatorFeeShare(ctx context.Context, in *MsgSetValidatorFeeShare, opts ...grpc.CallOption) (*MsgSetValidatorFeeShareResponse, error)
+	// RefundSlash defines a governance operation for refunding, from the
+	// community pool, the delegators of a validator wrongly slashed at a given
+	// height. The authority is defined in the keeper.
+	RefundSlash(ctx context.Context, in *MsgRefundSlash, opts ...grpc.CallOption) (*MsgRefundSlashResponse, error)
 }
 
 type msgClient struct {
@@ -83,6 +97,15 @@ func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdraw
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAllDelegatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error) {
 	out := new(MsgWithdrawValidatorCommissionResponse)
 	err := c.cc.Invoke(ctx, Msg_WithdrawValidatorCommission_FullMethodName, in, out, opts...)
@@ -119,6 +142,24 @@ func (c *msgClient) CommunityPoolSpend(ctx context.Context, in *MsgCommunityPool
 	return out, nil
 }
 
+func (c *msgClient) SetValidatorFeeShare(ctx context.Context, in *MsgSetValidatorFeeShare, opts ...grpc.CallOption) (*MsgSetValidatorFeeShareResponse, error) {
+	out := new(MsgSetValidatorFeeShareResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorFeeShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundSlash(ctx context.Context, in *MsgRefundSlash, opts ...grpc.CallOption) (*MsgRefundSlashResponse, error) {
+	out := new(MsgRefundSlashResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundSlash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -129,6 +170,9 @@ type MsgServer interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of
+	// delegator from all the validators it delegates to.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
@@ -147,6 +191,14 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error)
+	// SetValidatorFeeShare defines a method for a validator to set the fraction
+	// of the commission earned on the blocks it proposed which is shared with
+	// its delegators.
+	SetValidatorFeeShare(context.Context, *MsgSetValidatorFeeShare) (*MsgSetValidatorFeeShareResponse, error)
+	// RefundSlash defines a governance operation for refunding, from the
+	// community pool, the delegators of a validator wrongly slashed at a given
+	// height. The authority is defined in the keeper.
+	RefundSlash(context.Context, *MsgRefundSlash) (*MsgRefundSlashResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -160,6 +212,9 @@ func (UnimplementedMsgServer) SetWithdrawAddress(context.Context, *MsgSetWithdra
 func (UnimplementedMsgServer) WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
 }
+func (UnimplementedMsgServer) WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
 func (UnimplementedMsgServer) WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
 }
@@ -172,6 +227,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpend not implemented")
 }
+func (UnimplementedMsgServer) SetValidatorFeeShare(context.Context, *MsgSetValidatorFeeShare) (*MsgSetValidatorFeeShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorFeeShare not implemented")
+}
+func (UnimplementedMsgServer) RefundSlash(context.Context, *MsgRefundSlash) (*MsgRefundSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundSlash not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -221,6 +282,24 @@ func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAllDelegatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawValidatorCommission)
 	if err := dec(in); err != nil {
@@ -293,6 +372,42 @@ func _Msg_CommunityPoolSpend_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetValidatorFeeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorFeeShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorFeeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorFeeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorFeeShare(ctx, req.(*MsgSetValidatorFeeShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundSlash(ctx, req.(*MsgRefundSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -308,6 +423,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawDelegatorReward",
 			Handler:    _Msg_WithdrawDelegatorReward_Handler,
 		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
 		{
 			MethodName: "WithdrawValidatorCommission",
 			Handler:    _Msg_WithdrawValidatorCommission_Handler,
@@ -324,6 +443,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPoolSpend",
 			Handler:    _Msg_CommunityPoolSpend_Handler,
 		},
+		{
+			MethodName: "SetValidatorFeeShare",
+			Handler:    _Msg_SetValidatorFeeShare_Handler,
+		},
+		{
+			MethodName: "RefundSlash",
+			Handler:    _Msg_RefundSlash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -21,14 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Proposal_FullMethodName    = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName   = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName        = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName       = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName      = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName     = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName    = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Proposal_FullMethodName               = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName              = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                   = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                  = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                 = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName                = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName               = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName            = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalsByKeyword_FullMethodName     = "/cosmos.gov.v1.Query/ProposalsByKeyword"
+	Query_ProposalMessageResults_FullMethodName = "/cosmos.gov.v1.Query/ProposalMessageResults"
 )
 
 // QueryClient is the client API for Query service.
@@ -51,6 +53,12 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalsByKeyword queries the proposals whose title or summary contains a
+	// given word.
+	ProposalsByKeyword(ctx context.Context, in *QueryProposalsByKeywordRequest, opts ...grpc.CallOption) (*QueryProposalsByKeywordResponse, error)
+	// ProposalMessageResults queries the execution results of the messages of
+	// a proposal.
+	ProposalMessageResults(ctx context.Context, in *QueryProposalMessageResultsRequest, opts ...grpc.CallOption) (*QueryProposalMessageResultsResponse, error)
 }
 
 type queryClient struct {
@@ -133,6 +141,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByKeyword(ctx context.Context, in *QueryProposalsByKeywordRequest, opts ...grpc.CallOption) (*QueryProposalsByKeywordResponse, error) {
+	out := new(QueryProposalsByKeywordResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByKeyword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalMessageResults(ctx context.Context, in *QueryProposalMessageResultsRequest, opts ...grpc.CallOption) (*QueryProposalMessageResultsResponse, error) {
+	out := new(QueryProposalMessageResultsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalMessageResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -153,6 +179,12 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalsByKeyword queries the proposals whose title or summary contains a
+	// given word.
+	ProposalsByKeyword(context.Context, *QueryProposalsByKeywordRequest) (*QueryProposalsByKeywordResponse, error)
+	// ProposalMessageResults queries the execution results of the messages of
+	// a proposal.
+	ProposalMessageResults(context.Context, *QueryProposalMessageResultsRequest) (*QueryProposalMessageResultsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -184,6 +216,12 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ProposalsByKeyword(context.Context, *QueryProposalsByKeywordRequest) (*QueryProposalsByKeywordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByKeyword not implemented")
+}
+func (UnimplementedQueryServer) ProposalMessageResults(context.Context, *QueryProposalMessageResultsRequest) (*QueryProposalMessageResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalMessageResults not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -341,6 +379,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByKeyword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByKeywordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByKeyword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByKeyword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByKeyword(ctx, req.(*QueryProposalsByKeywordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalMessageResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalMessageResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalMessageResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalMessageResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalMessageResults(ctx, req.(*QueryProposalMessageResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +454,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalsByKeyword",
+			Handler:    _Query_ProposalsByKeyword_Handler,
+		},
+		{
+			MethodName: "ProposalMessageResults",
+			Handler:    _Query_ProposalMessageResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -25,8 +25,12 @@ const (
 	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
 	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
 	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_VoteOnBehalfOf_FullMethodName    = "/cosmos.gov.v1.Msg/VoteOnBehalfOf"
 	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_AmendProposal_FullMethodName     = "/cosmos.gov.v1.Msg/AmendProposal"
+	Msg_WithdrawDeposit_FullMethodName   = "/cosmos.gov.v1.Msg/WithdrawDeposit"
 	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_PruneOldProposals_FullMethodName = "/cosmos.gov.v1.Msg/PruneOldProposals"
 )
 
 // MsgClient is the client API for Msg service.
@@ -42,13 +46,28 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
+	// VoteOnBehalfOf defines a method for a delegator to override the vote of a
+	// single validator it delegates to.
+	VoteOnBehalfOf(ctx context.Context, in *MsgVoteOnBehalfOf, opts ...grpc.CallOption) (*MsgVoteOnBehalfOfResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// AmendProposal defines a method for the proposer to replace the content of
+	// a proposal during its deposit period.
+	AmendProposal(ctx context.Context, in *MsgAmendProposal, opts ...grpc.CallOption) (*MsgAmendProposalResponse, error)
+	// WithdrawDeposit defines a method for a depositor to withdraw, during the
+	// reconsideration window following an amendment, the deposit made on a
+	// proposal before it was amended.
+	WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
 	// parameters. The authority is defined in the keeper.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PruneOldProposals defines a governance operation for deleting the
+	// finished proposals submitted before a given height, along with their votes
+	// and deposits, up to a maximum number of proposals set in the keeper config.
+	// The authority is defined in the keeper.
+	PruneOldProposals(ctx context.Context, in *MsgPruneOldProposals, opts ...grpc.CallOption) (*MsgPruneOldProposalsResponse, error)
 }
 
 type msgClient struct {
@@ -95,6 +114,15 @@ func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts
 	return out, nil
 }
 
+func (c *msgClient) VoteOnBehalfOf(ctx context.Context, in *MsgVoteOnBehalfOf, opts ...grpc.CallOption) (*MsgVoteOnBehalfOfResponse, error) {
+	out := new(MsgVoteOnBehalfOfResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteOnBehalfOf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, Msg_Deposit_FullMethodName, in, out, opts...)
@@ -104,6 +132,24 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) AmendProposal(ctx context.Context, in *MsgAmendProposal, opts ...grpc.CallOption) (*MsgAmendProposalResponse, error) {
+	out := new(MsgAmendProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error) {
+	out := new(MsgWithdrawDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -113,6 +159,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PruneOldProposals(ctx context.Context, in *MsgPruneOldProposals, opts ...grpc.CallOption) (*MsgPruneOldProposalsResponse, error) {
+	out := new(MsgPruneOldProposalsResponse)
+	err := c.cc.Invoke(ctx, Msg_PruneOldProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -126,13 +181,28 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
+	// VoteOnBehalfOf defines a method for a delegator to override the vote of a
+	// single validator it delegates to.
+	VoteOnBehalfOf(context.Context, *MsgVoteOnBehalfOf) (*MsgVoteOnBehalfOfResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// AmendProposal defines a method for the proposer to replace the content of
+	// a proposal during its deposit period.
+	AmendProposal(context.Context, *MsgAmendProposal) (*MsgAmendProposalResponse, error)
+	// WithdrawDeposit defines a method for a depositor to withdraw, during the
+	// reconsideration window following an amendment, the deposit made on a
+	// proposal before it was amended.
+	WithdrawDeposit(context.Context, *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
 	// parameters. The authority is defined in the keeper.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PruneOldProposals defines a governance operation for deleting the
+	// finished proposals submitted before a given height, along with their votes
+	// and deposits, up to a maximum number of proposals set in the keeper config.
+	// The authority is defined in the keeper.
+	PruneOldProposals(context.Context, *MsgPruneOldProposals) (*MsgPruneOldProposalsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -152,12 +222,24 @@ func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse,
 func (UnimplementedMsgServer) VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
 }
+func (UnimplementedMsgServer) VoteOnBehalfOf(context.Context, *MsgVoteOnBehalfOf) (*MsgVoteOnBehalfOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteOnBehalfOf not implemented")
+}
 func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (UnimplementedMsgServer) AmendProposal(context.Context, *MsgAmendProposal) (*MsgAmendProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendProposal not implemented")
+}
+func (UnimplementedMsgServer) WithdrawDeposit(context.Context, *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDeposit not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) PruneOldProposals(context.Context, *MsgPruneOldProposals) (*MsgPruneOldProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneOldProposals not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -243,6 +325,24 @@ func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteOnBehalfOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteOnBehalfOf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteOnBehalfOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteOnBehalfOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteOnBehalfOf(ctx, req.(*MsgVoteOnBehalfOf))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -261,6 +361,42 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AmendProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendProposal(ctx, req.(*MsgAmendProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDeposit(ctx, req.(*MsgWithdrawDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -279,6 +415,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneOldProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneOldProposals)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneOldProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PruneOldProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneOldProposals(ctx, req.(*MsgPruneOldProposals))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,14 +456,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VoteWeighted",
 			Handler:    _Msg_VoteWeighted_Handler,
 		},
+		{
+			MethodName: "VoteOnBehalfOf",
+			Handler:    _Msg_VoteOnBehalfOf_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "AmendProposal",
+			Handler:    _Msg_AmendProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawDeposit",
+			Handler:    _Msg_WithdrawDeposit_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PruneOldProposals",
+			Handler:    _Msg_PruneOldProposals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -2754,7 +2754,8 @@ type QuerySigningInfoRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cons_address is the address to query signing info of
+	// cons_address is the address to query signing info of, either bech32 or
+	// 0x-prefixed hex encoded.
 	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
 }
 
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName           = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_SetSigningWindow_FullMethodName = "/cosmos.slashing.v1beta1.Msg/SetSigningWindow"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +37,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetSigningWindow defines a method for a validator to set its own signing
+	// window, shorter than the `SignedBlocksWindow` param.
+	SetSigningWindow(ctx context.Context, in *MsgSetSigningWindow, opts ...grpc.CallOption) (*MsgSetSigningWindowResponse, error)
 }
 
 type msgClient struct {
@@ -64,6 +68,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetSigningWindow(ctx context.Context, in *MsgSetSigningWindow, opts ...grpc.CallOption) (*MsgSetSigningWindowResponse, error) {
+	out := new(MsgSetSigningWindowResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSigningWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -77,6 +90,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetSigningWindow defines a method for a validator to set its own signing
+	// window, shorter than the `SignedBlocksWindow` param.
+	SetSigningWindow(context.Context, *MsgSetSigningWindow) (*MsgSetSigningWindowResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -90,6 +106,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetSigningWindow(context.Context, *MsgSetSigningWindow) (*MsgSetSigningWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSigningWindow not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -139,6 +158,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSigningWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSigningWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSigningWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSigningWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSigningWindow(ctx, req.(*MsgSetSigningWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +191,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetSigningWindow",
+			Handler:    _Msg_SetSigningWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -23,9 +23,11 @@ const (
 	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
 	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
 	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+	Msg_PriorityRedelegate_FullMethodName        = "/cosmos.staking.v1beta1.Msg/PriorityRedelegate"
 	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
 	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_AttestValidatorIdentity_FullMethodName   = "/cosmos.staking.v1beta1.Msg/AttestValidatorIdentity"
 )
 
 // MsgClient is the client API for Msg service.
@@ -42,6 +44,10 @@ type MsgClient interface {
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error)
+	// PriorityRedelegate defines a method for performing a redelegation away
+	// from a jailed or tombstoned validator, executed by the authority, which is
+	// not subject to the MaxEntries limit.
+	PriorityRedelegate(ctx context.Context, in *MsgPriorityRedelegate, opts ...grpc.CallOption) (*MsgPriorityRedelegateResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
@@ -54,6 +60,9 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AttestValidatorIdentity defines a method for a validator operator to
+	// attest the verifiable credential of its identity.
+	AttestValidatorIdentity(ctx context.Context, in *MsgAttestValidatorIdentity, opts ...grpc.CallOption) (*MsgAttestValidatorIdentityResponse, error)
 }
 
 type msgClient struct {
@@ -100,6 +109,15 @@ func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate,
 	return out, nil
 }
 
+func (c *msgClient) PriorityRedelegate(ctx context.Context, in *MsgPriorityRedelegate, opts ...grpc.CallOption) (*MsgPriorityRedelegateResponse, error) {
+	out := new(MsgPriorityRedelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_PriorityRedelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
 	out := new(MsgUndelegateResponse)
 	err := c.cc.Invoke(ctx, Msg_Undelegate_FullMethodName, in, out, opts...)
@@ -127,6 +145,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AttestValidatorIdentity(ctx context.Context, in *MsgAttestValidatorIdentity, opts ...grpc.CallOption) (*MsgAttestValidatorIdentityResponse, error) {
+	out := new(MsgAttestValidatorIdentityResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestValidatorIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -141,6 +168,10 @@ type MsgServer interface {
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error)
+	// PriorityRedelegate defines a method for performing a redelegation away
+	// from a jailed or tombstoned validator, executed by the authority, which is
+	// not subject to the MaxEntries limit.
+	PriorityRedelegate(context.Context, *MsgPriorityRedelegate) (*MsgPriorityRedelegateResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
@@ -153,6 +184,9 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AttestValidatorIdentity defines a method for a validator operator to
+	// attest the verifiable credential of its identity.
+	AttestValidatorIdentity(context.Context, *MsgAttestValidatorIdentity) (*MsgAttestValidatorIdentityResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -172,6 +206,9 @@ func (UnimplementedMsgServer) Delegate(context.Context, *MsgDelegate) (*MsgDeleg
 func (UnimplementedMsgServer) BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
 }
+func (UnimplementedMsgServer) PriorityRedelegate(context.Context, *MsgPriorityRedelegate) (*MsgPriorityRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriorityRedelegate not implemented")
+}
 func (UnimplementedMsgServer) Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
@@ -181,6 +218,9 @@ func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCan
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) AttestValidatorIdentity(context.Context, *MsgAttestValidatorIdentity) (*MsgAttestValidatorIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestValidatorIdentity not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -266,6 +306,24 @@ func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PriorityRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPriorityRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PriorityRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PriorityRedelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PriorityRedelegate(ctx, req.(*MsgPriorityRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUndelegate)
 	if err := dec(in); err != nil {
@@ -320,6 +378,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AttestValidatorIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestValidatorIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestValidatorIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestValidatorIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestValidatorIdentity(ctx, req.(*MsgAttestValidatorIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +419,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BeginRedelegate",
 			Handler:    _Msg_BeginRedelegate_Handler,
 		},
+		{
+			MethodName: "PriorityRedelegate",
+			Handler:    _Msg_PriorityRedelegate_Handler,
+		},
 		{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
@@ -355,6 +435,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "AttestValidatorIdentity",
+			Handler:    _Msg_AttestValidatorIdentity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1,7 +1,9 @@
 package baseapp
 
 import (
+	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -445,8 +448,21 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
 	app.deliverState.ms.Write()
+
+	if app.commitWAL != nil {
+		if err := app.commitWAL.BeginCommit(header.Height); err != nil {
+			panic(fmt.Errorf("failed to write commit WAL, height: %d, err: %w", header.Height, err))
+		}
+	}
+
 	commitID := app.cms.Commit()
 
+	if app.commitWAL != nil {
+		if err := app.commitWAL.EndCommit(commitID); err != nil {
+			panic(fmt.Errorf("failed to write commit WAL, height: %d, err: %w", header.Height, err))
+		}
+	}
+
 	res := abci.ResponseCommit{
 		Data:         commitID.Hash,
 		RetainHeight: retainHeight,
@@ -695,7 +711,12 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	res, err := handler(ctx, req)
+	res, err := app.runQueryWithTimeout(ctx, handler, req)
+	if errors.Is(err, sdkerrors.ErrQueryTimeout) {
+		res = sdkerrors.QueryResult(err, app.trace)
+		res.Height = req.Height
+		return res
+	}
 	if err != nil {
 		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
 		res.Height = req.Height
@@ -705,6 +726,54 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 	return res
 }
 
+// runQueryWithTimeout runs the gRPC query handler, abandoning it once the ABCI
+// query timeout is exceeded so that it does not block block processing. The
+// handler context is cancelled on timeout but, as the handler cannot be
+// interrupted, it keeps running in the background on its own branch of the
+// state until it returns.
+func (app *BaseApp) runQueryWithTimeout(ctx sdk.Context, handler GRPCQueryHandler, req abci.RequestQuery) (abci.ResponseQuery, error) {
+	if app.abciQueryTimeout <= 0 {
+		return handler(ctx, req)
+	}
+
+	start := time.Now()
+	goCtx, cancel := context.WithTimeout(ctx.Context(), app.abciQueryTimeout)
+	defer cancel()
+
+	type result struct {
+		res       abci.ResponseQuery
+		err       error
+		recovered interface{}
+	}
+	done := make(chan result, 1)
+
+	go func() {
+		// a panic is handed over to be recovered by Query, as it would crash
+		// the node if raised from this goroutine
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{recovered: r}
+			}
+		}()
+
+		res, err := handler(ctx.WithContext(goCtx), req)
+		done <- result{res: res, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.recovered != nil {
+			panic(r.recovered)
+		}
+		return r.res, r.err
+
+	case <-goCtx.Done():
+		elapsed := time.Since(start)
+		app.logger.Error("ABCI query timed out", "path", req.Path, "height", req.Height, "elapsed", elapsed)
+		return abci.ResponseQuery{}, sdkerrors.Wrapf(sdkerrors.ErrQueryTimeout, "query %s exceeded the timeout of %s", req.Path, app.abciQueryTimeout)
+	}
+}
+
 func gRPCErrorToSDKError(err error) error {
 	status, ok := grpcstatus.FromError(err)
 	if !ok {
@@ -909,6 +978,23 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.version),
 			}
 
+		case "iavl-stats":
+			reporter, ok := app.cms.(storetypes.IAVLCacheStatsReporter)
+			if !ok {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't report IAVL cache statistics"), app.trace)
+			}
+
+			bz, err := json.Marshal(reporter.IAVLCacheStats())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode IAVL cache statistics"), app.trace)
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
@@ -917,7 +1003,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be one of 'simulate', 'version' or 'iavl-stats', none was present",
 		), app.trace)
 }
 
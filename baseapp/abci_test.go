@@ -2,11 +2,13 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -1212,6 +1214,48 @@ func TestABCI_Query(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// blockingQueryImpl is a query server whose Echo blocks until its context is
+// cancelled.
+type blockingQueryImpl struct {
+	testdata.QueryImpl
+}
+
+func (blockingQueryImpl) Echo(ctx context.Context, _ *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestABCI_Query_Timeout(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetABCIQueryTimeout(50*time.Millisecond))
+	testdata.RegisterQueryServer(suite.baseApp.GRPCQueryRouter(), blockingQueryImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	suite.baseApp.Commit()
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+
+	// queries completing within the timeout are answered
+	res := suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/SayHello", Data: reqBz})
+	require.True(t, res.IsOK(), res.Log)
+
+	var hello testdata.SayHelloResponse
+	require.NoError(t, hello.Unmarshal(res.Value))
+	require.Equal(t, "Hello foo!", hello.Greeting)
+
+	// queries exceeding the timeout are abandoned
+	reqBz, err = (&testdata.EchoRequest{Message: "foo"}).Marshal()
+	require.NoError(t, err)
+
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/Echo", Data: reqBz})
+	require.Equal(t, sdkerrors.ErrQueryTimeout.ABCICode(), res.Code)
+	require.Equal(t, sdkerrors.ErrQueryTimeout.Codespace(), res.Codespace)
+}
+
 func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	logger := defaultLogger()
 	db := dbm.NewMemDB()
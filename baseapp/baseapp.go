@@ -6,6 +6,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	"golang.org/x/exp/maps"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -33,6 +35,20 @@ type (
 	// an older version of the software. In particular, if a module changed the substore key name
 	// (or removed a substore) between two versions of the software.
 	StoreLoader func(ms storetypes.CommitMultiStore) error
+
+	// CommitWAL defines a write-ahead log of the commits of the application,
+	// allowing a commit interrupted by a crash to be recovered on restart.
+	CommitWAL interface {
+		// BeginCommit records that the state of the given height is about to be
+		// committed.
+		BeginCommit(height int64) error
+
+		// EndCommit records that the state of a height has been committed.
+		EndCommit(commitID storetypes.CommitID) error
+
+		// Recover reconciles the loaded multistore with the log.
+		Recover(ms storetypes.CommitMultiStore) error
+	}
 )
 
 const (
@@ -73,6 +89,10 @@ type BaseApp struct { //nolint: maligned
 	idPeerFilter    sdk.PeerFilter             // filter peers by node ID
 	fauxMerkleMode  bool                       // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// migrationChainValidator verifies that the module migrations form complete
+	// chains, it is run on Init so that missing migrations are caught at startup
+	migrationChainValidator func() error
+
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
@@ -136,6 +156,10 @@ type BaseApp struct { //nolint: maligned
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
+	// abciQueryTimeout is the duration after which a gRPC query received through
+	// ABCI is abandoned and ErrQueryTimeout returned. A value of 0 disables it.
+	abciQueryTimeout time.Duration
+
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
@@ -144,6 +168,12 @@ type BaseApp struct { //nolint: maligned
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
 
+	// commitWAL records the commits of the application, if set
+	commitWAL CommitWAL
+
+	// msgGasRecorder records the gas consumed by each delivered message, if set
+	msgGasRecorder MsgGasRecorder
+
 	chainID string
 
 	f *os.File
@@ -313,6 +343,12 @@ func (app *BaseApp) LoadLatestVersion() error {
 		return fmt.Errorf("failed to load latest version: %w", err)
 	}
 
+	if app.commitWAL != nil {
+		if err := app.commitWAL.Recover(app.cms); err != nil {
+			return fmt.Errorf("failed to recover from commit WAL: %w", err)
+		}
+	}
+
 	return app.Init()
 }
 
@@ -356,6 +392,11 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// ChainID returns the chain ID of the app.
+func (app *BaseApp) ChainID() string {
+	return app.chainID
+}
+
 // Mempool returns the Mempool of the app.
 func (app *BaseApp) Mempool() mempool.Mempool {
 	return app.mempool
@@ -370,6 +411,18 @@ func (app *BaseApp) Init() error {
 		panic("cannot call initFromMainStore: baseapp already sealed")
 	}
 
+	if app.migrationChainValidator != nil {
+		if err := app.migrationChainValidator(); err != nil {
+			return err
+		}
+	}
+
+	if app.interfaceRegistry != nil {
+		if err := codec.ValidateCodecRegistrations(codec.NewProtoCodec(app.interfaceRegistry)); err != nil {
+			return err
+		}
+	}
+
 	emptyHeader := tmproto.Header{ChainID: app.chainID}
 
 	// needed for the export command which inits from store but never calls initchain
@@ -403,18 +456,40 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setCommitWAL(wal CommitWAL) {
+	app.commitWAL = wal
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setABCIQueryTimeout(timeout time.Duration) {
+	app.abciQueryTimeout = timeout
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
-	app.indexEvents = make(map[string]struct{})
+	if app.indexEvents == nil {
+		app.indexEvents = make(map[string]struct{})
+	}
 
 	for _, e := range ie {
 		app.indexEvents[e] = struct{}{}
 	}
 }
 
+func (app *BaseApp) setIndexedAttributes(indexedAttributes map[string][]string) {
+	if app.indexEvents == nil {
+		app.indexEvents = make(map[string]struct{})
+	}
+
+	for eventType, keys := range indexedAttributes {
+		for _, key := range keys {
+			app.indexEvents[fmt.Sprintf("%s.%s", eventType, key)] = struct{}{}
+		}
+	}
+}
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -810,6 +885,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
 
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		// ADR 031 request type routing
 		msgResult, err := handler(ctx, msg)
 		app.f.WriteString("res: " + msgResult.String() + "\n")
@@ -817,6 +894,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
+		if app.msgGasRecorder != nil && mode == runTxModeDeliver {
+			app.msgGasRecorder.RecordGas(sdk.MsgTypeURL(msg), ctx.GasMeter().GasConsumed()-gasBefore)
+		}
+
 		// create message events
 		msgEvents := createEvents(msgResult.GetEvents(), msg)
 
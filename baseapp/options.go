@@ -3,6 +3,7 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -11,6 +12,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
@@ -60,11 +62,38 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetIndexedAttributes provides a BaseApp option function that adds the given
+// attribute keys of each event type to the events to index.
+func SetIndexedAttributes(indexedAttributes map[string][]string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setIndexedAttributes(indexedAttributes) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
 }
 
+// SetIAVLStoreCacheSizes provides a BaseApp option function that sets the size
+// of the IAVL cache of individual stores, keyed by store name. It panics if
+// sizes are given and the multistore does not implement
+// storetypes.IAVLStoreCacheSizeSetter.
+func SetIAVLStoreCacheSizes(sizes map[string]int) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if len(sizes) == 0 {
+			return
+		}
+
+		setter, ok := bapp.cms.(storetypes.IAVLStoreCacheSizeSetter)
+		if !ok {
+			panic("multistore doesn't support setting the IAVL cache size of individual stores")
+		}
+
+		for storeName, size := range sizes {
+			setter.SetIAVLStoreCacheSize(storeName, size)
+		}
+	}
+}
+
 // SetIAVLDisableFastNode enables(false)/disables(true) fast node usage from the IAVL store.
 func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
@@ -75,12 +104,31 @@ func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
 }
 
+// SetABCIQueryTimeout provides a BaseApp option function that sets the duration
+// after which a gRPC query received through ABCI is abandoned. A value of 0
+// disables the timeout.
+func SetABCIQueryTimeout(timeout time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) { app.setABCIQueryTimeout(timeout) }
+}
+
+// SetCommitWAL provides a BaseApp option function that sets the write-ahead log
+// recording the commits of the application.
+func SetCommitWAL(wal CommitWAL) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCommitWAL(wal) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetABCIListener provides a BaseApp option function that registers a listener
+// of the ABCI messages, in addition to those of the streaming services.
+func SetABCIListener(listener ABCIListener) func(*BaseApp) {
+	return func(app *BaseApp) { app.abciListeners = append(app.abciListeners, listener) }
+}
+
 // SetSnapshot sets the snapshot store.
 func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
@@ -166,6 +214,16 @@ func (app *BaseApp) SetEndBlocker(endBlocker sdk.EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+// SetMigrationChainValidator sets a function verifying, on Init, that the
+// module migrations form complete chains up to the modules' consensus versions.
+func (app *BaseApp) SetMigrationChainValidator(validator func() error) {
+	if app.sealed {
+		panic("SetMigrationChainValidator() on sealed BaseApp")
+	}
+
+	app.migrationChainValidator = validator
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -277,6 +335,18 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	app.mempool = mempool
 }
 
+// MsgGasRecorder records the gas consumed by the execution of delivered
+// messages, e.g. to calibrate the gas constants of the modules in simulations.
+type MsgGasRecorder interface {
+	RecordGas(msgTypeURL string, gasUsed uint64)
+}
+
+// SetMsgGasRecorder sets the recorder of the gas consumed by each delivered
+// message. It can be set on a sealed BaseApp.
+func (app *BaseApp) SetMsgGasRecorder(recorder MsgGasRecorder) {
+	app.msgGasRecorder = recorder
+}
+
 // SetProcessProposal sets the process proposal function for the BaseApp.
 func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	if app.sealed {
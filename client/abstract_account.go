@@ -0,0 +1,85 @@
+package client
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// CustomAccountHandler defines a read-only version of the auth module's
+// CustomAccountHandler, implemented by the accounts with custom authentication
+// logic, a.k.a. abstract accounts.
+type CustomAccountHandler interface {
+	Account
+
+	Authenticate(ctx sdk.Context, tx sdk.Tx, witnessData []byte) error
+	ExecuteTx(ctx sdk.Context, tx sdk.Tx) error
+}
+
+// AbstractAccountBroadcaster broadcasts the transactions of accounts which may
+// be abstract accounts. The transactions of abstract accounts are broadcast by
+// BroadcastAbstractAccountTx, as the ante handlers reject them unless they
+// carry an AbstractAccountWitness, while the transactions of the other accounts
+// are broadcast by BroadcastTx.
+type AbstractAccountBroadcaster struct {
+	clientCtx Context
+}
+
+// NewAbstractAccountBroadcaster returns an AbstractAccountBroadcaster
+// retrieving the accounts and broadcasting the transactions with the given
+// client context.
+func NewAbstractAccountBroadcaster(clientCtx Context) AbstractAccountBroadcaster {
+	return AbstractAccountBroadcaster{clientCtx: clientCtx}
+}
+
+// BroadcastTx signs with sign, encodes and broadcasts the transaction built by
+// txBuilder, sent by the account at addr. If the account implements
+// CustomAccountHandler, the transaction carries an AbstractAccountWitness with
+// the given authentication data, which is ignored otherwise.
+func (b AbstractAccountBroadcaster) BroadcastTx(addr sdk.AccAddress, txBuilder TxBuilder, witnessData []byte, sign func(TxBuilder) error) (*sdk.TxResponse, error) {
+	acc, err := b.clientCtx.AccountRetriever.GetAccount(b.clientCtx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := acc.(CustomAccountHandler); !ok {
+		if err := sign(txBuilder); err != nil {
+			return nil, err
+		}
+
+		txBytes, err := b.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+
+		return b.clientCtx.BroadcastTx(txBytes)
+	}
+
+	witness := &tx.AbstractAccountWitness{Address: addr.String(), Data: witnessData}
+	return b.clientCtx.BroadcastAbstractAccountTx(txBuilder, witness, sign)
+}
+
+// BroadcastAbstractAccountTx attaches the given AbstractAccountWitness to the
+// transaction built by txBuilder, then signs it with sign, encodes and
+// broadcasts it. The witness being part of the AuthInfo, it is attached before
+// the transaction is signed so that it is covered by the signatures of the
+// other signers.
+func (ctx Context) BroadcastAbstractAccountTx(txBuilder TxBuilder, witness *tx.AbstractAccountWitness, sign func(TxBuilder) error) (*sdk.TxResponse, error) {
+	witnessBuilder, ok := txBuilder.(AbstractAccountTxBuilder)
+	if !ok {
+		return nil, fmt.Errorf("expected AbstractAccountTxBuilder, got %T", txBuilder)
+	}
+
+	witnessBuilder.SetAbstractAccountWitness(witness)
+	if err := sign(txBuilder); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := ctx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return ctx.BroadcastTx(txBytes)
+}
@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// recordingMockClient records the transactions it broadcasts.
+type recordingMockClient struct {
+	mock.Client
+	txs *[]tmtypes.Tx
+}
+
+func (c recordingMockClient) BroadcastTxSync(_ context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	*c.txs = append(*c.txs, tx)
+	return &coretypes.ResultBroadcastTx{}, nil
+}
+
+type mockAccount struct {
+	addr sdk.AccAddress
+}
+
+func (acc mockAccount) GetAddress() sdk.AccAddress    { return acc.addr }
+func (acc mockAccount) GetPubKey() cryptotypes.PubKey { return nil }
+func (acc mockAccount) GetAccountNumber() uint64      { return 1 }
+func (acc mockAccount) GetSequence() uint64           { return 1 }
+
+type mockAbstractAccount struct {
+	mockAccount
+}
+
+func (mockAbstractAccount) Authenticate(sdk.Context, sdk.Tx, []byte) error { return nil }
+func (mockAbstractAccount) ExecuteTx(sdk.Context, sdk.Tx) error            { return nil }
+
+// mockAccountsRetriever returns the accounts it holds by address.
+type mockAccountsRetriever struct {
+	client.MockAccountRetriever
+	accounts map[string]client.Account
+}
+
+func (r mockAccountsRetriever) GetAccount(_ client.Context, addr sdk.AccAddress) (client.Account, error) {
+	return r.accounts[addr.String()], nil
+}
+
+func TestAbstractAccountBroadcaster(t *testing.T) {
+	regularAddr := sdk.AccAddress("regular")
+	abstractAddr := sdk.AccAddress("abstract")
+
+	var txs []tmtypes.Tx
+	txConfig := testutil.MakeTestEncodingConfig().TxConfig
+	clientCtx := client.Context{
+		Client:        recordingMockClient{txs: &txs},
+		BroadcastMode: flags.BroadcastSync,
+		TxConfig:      txConfig,
+		AccountRetriever: mockAccountsRetriever{accounts: map[string]client.Account{
+			regularAddr.String():  mockAccount{addr: regularAddr},
+			abstractAddr.String(): mockAbstractAccount{mockAccount{addr: abstractAddr}},
+		}},
+	}
+
+	// sign records the witness the transaction carries when it is signed
+	var signedWitness *tx.AbstractAccountWitness
+	sign := func(txBuilder client.TxBuilder) error {
+		signedWitness = txBuilder.GetTx().(tx.AbstractAccountWitnessTx).GetAbstractAccountWitness()
+		return nil
+	}
+
+	broadcaster := client.NewAbstractAccountBroadcaster(clientCtx)
+
+	// the transactions of regular accounts carry no witness
+	txBuilder := txConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(100)
+	_, err := broadcaster.BroadcastTx(regularAddr, txBuilder, []byte("witness"), sign)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Nil(t, signedWitness)
+
+	decoded, err := txConfig.TxDecoder()(txs[0])
+	require.NoError(t, err)
+	require.Nil(t, decoded.(tx.AbstractAccountWitnessTx).GetAbstractAccountWitness())
+
+	// the transactions of abstract accounts carry a witness, attached before
+	// they are signed
+	txBuilder = txConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(100)
+	_, err = broadcaster.BroadcastTx(abstractAddr, txBuilder, []byte("witness"), sign)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+
+	expected := &tx.AbstractAccountWitness{Address: abstractAddr.String(), Data: []byte("witness")}
+	require.Equal(t, expected, signedWitness)
+
+	decoded, err = txConfig.TxDecoder()(txs[1])
+	require.NoError(t, err)
+	require.Equal(t, expected, decoded.(tx.AbstractAccountWitnessTx).GetAbstractAccountWitness())
+	require.Equal(t, uint64(100), decoded.(sdk.FeeTx).GetGas())
+}
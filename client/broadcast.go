@@ -2,8 +2,12 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/mempool"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -35,6 +39,67 @@ func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error)
 	return res, err
 }
 
+// maxBroadcastBackoff is the maximum delay between two broadcast attempts of
+// BroadcastTxWithRetry.
+const maxBroadcastBackoff = time.Minute
+
+// ErrNodeUnavailable is returned by the clients created by NewClientFromNode
+// when the node responds with HTTP 503 Service Unavailable.
+var ErrNodeUnavailable = errors.New("node unavailable")
+
+// BroadcastTxWithRetry broadcasts a transaction like BroadcastTx, retrying up
+// to maxRetries times if it fails with a transient network error: io.EOF,
+// context.DeadlineExceeded or ErrNodeUnavailable. The delay between attempts
+// starts at backoff and doubles after each of them, up to one minute, with a
+// random jitter of up to half of it.
+//
+// A response with a non-zero ABCI code, such as ErrWrongSequence, is returned
+// as is, without any retry, as broadcasting the same transaction again would
+// fail the same way.
+func BroadcastTxWithRetry(ctx Context, txBytes []byte, maxRetries int, backoff time.Duration) (*sdk.TxResponse, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := ctx.BroadcastTx(txBytes)
+		if err == nil || attempt >= maxRetries || !isTransientBroadcastError(err) {
+			return res, err
+		}
+
+		// wait for a random delay in [delay/2, delay)
+		delay := broadcastBackoff(backoff, attempt)
+		if half := int64(delay / 2); half > 0 {
+			delay = time.Duration(half + rand.Int63n(half)) //nolint:gosec // jitter does not need a secure source
+		}
+
+		time.Sleep(delay)
+	}
+}
+
+// broadcastBackoff returns the delay after the given attempt, doubling the
+// initial backoff after each attempt up to maxBroadcastBackoff.
+func broadcastBackoff(backoff time.Duration, attempt int) time.Duration {
+	delay := backoff
+	for i := 0; i < attempt && delay < maxBroadcastBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > maxBroadcastBackoff {
+		return maxBroadcastBackoff
+	}
+
+	return delay
+}
+
+// isTransientBroadcastError returns true if the broadcast error may be caused
+// by a transient network error and is worth retrying.
+func isTransientBroadcastError(err error) bool {
+	if errors.Is(err, sdkerrors.ErrWrongSequence) {
+		return false
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrNodeUnavailable)
+}
+
 // CheckTendermintError checks if the error returned from BroadcastTx is a
 // Tendermint error that is returned before the tx is submitted due to
 // precondition checks that failed. If an Tendermint error is detected, this
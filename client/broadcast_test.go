@@ -3,7 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/mempool"
@@ -63,3 +67,81 @@ func TestBroadcastError(t *testing.T) {
 		}
 	}
 }
+
+type RetryMockClient struct {
+	mock.Client
+	errs  []error
+	res   *coretypes.ResultBroadcastTx
+	calls *int
+}
+
+func (c RetryMockClient) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	*c.calls++
+	if *c.calls <= len(c.errs) {
+		return nil, c.errs[*c.calls-1]
+	}
+	return c.res, nil
+}
+
+func TestBroadcastTxWithRetry(t *testing.T) {
+	unavailableErr := fmt.Errorf("post failed: %w", ErrNodeUnavailable)
+	wrongSequence := &coretypes.ResultBroadcastTx{Code: sdkerrors.ErrWrongSequence.ABCICode(), Codespace: sdkerrors.ErrWrongSequence.Codespace()}
+
+	testCases := []struct {
+		name       string
+		errs       []error
+		res        *coretypes.ResultBroadcastTx
+		maxRetries int
+		expCalls   int
+		expCode    uint32
+		expErr     error
+	}{
+		{"success", nil, &coretypes.ResultBroadcastTx{}, 3, 1, 0, nil},
+		{"transient errors", []error{io.EOF, context.DeadlineExceeded, unavailableErr}, &coretypes.ResultBroadcastTx{}, 3, 4, 0, nil},
+		{"max retries exceeded", []error{io.EOF, io.EOF, io.EOF}, &coretypes.ResultBroadcastTx{}, 2, 3, 0, io.EOF},
+		{"non transient error", []error{io.ErrClosedPipe}, &coretypes.ResultBroadcastTx{}, 3, 1, 0, io.ErrClosedPipe},
+		{"wrong sequence", nil, wrongSequence, 3, 1, sdkerrors.ErrWrongSequence.ABCICode(), nil},
+		{"application error after transient error", []error{io.EOF}, wrongSequence, 3, 2, sdkerrors.ErrWrongSequence.ABCICode(), nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			ctx := Context{
+				Client:        RetryMockClient{errs: tc.errs, res: tc.res, calls: &calls},
+				BroadcastMode: flags.BroadcastSync,
+			}
+
+			res, err := BroadcastTxWithRetry(ctx, []byte{0xA, 0xB}, tc.maxRetries, time.Millisecond)
+			require.Equal(t, tc.expCalls, calls)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expCode, res.Code)
+		})
+	}
+}
+
+func TestBroadcastBackoff(t *testing.T) {
+	require.Equal(t, time.Second, broadcastBackoff(time.Second, 0))
+	require.Equal(t, 8*time.Second, broadcastBackoff(time.Second, 3))
+	require.Equal(t, maxBroadcastBackoff, broadcastBackoff(time.Second, 100))
+	require.Equal(t, maxBroadcastBackoff, broadcastBackoff(2*maxBroadcastBackoff, 0))
+}
+
+func TestNewClientFromNodeUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	node, err := NewClientFromNode(server.URL)
+	require.NoError(t, err)
+
+	_, err = node.BroadcastTxSync(context.Background(), []byte{0xA, 0xB})
+	require.ErrorIs(t, err, ErrNodeUnavailable)
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ AccountRetriever = (*CachingAccountRetriever)(nil)
+
+// CachingAccountRetriever is an AccountRetriever caching the accounts retrieved
+// by another AccountRetriever for up to a given number of blocks, so that the
+// account number and sequence of an account submitting several transactions
+// are not queried for each of them.
+//
+// The node is not queried for its latest block: the age of a cached account is
+// measured against the latest height observed by the retriever, i.e. the
+// heights the accounts were retrieved at and the heights of the handled
+// transaction responses.
+//
+// The cached sequence of an account is incremented when one of its
+// transactions is accepted by a node, and the account is evicted from the cache
+// when a transaction fails with ErrWrongSequence, see HandleTxResponse.
+type CachingAccountRetriever struct {
+	retriever AccountRetriever
+	ttl       int64
+
+	mtx          sync.Mutex
+	accounts     map[string]cachedAccount
+	latestHeight int64
+}
+
+// cachedAccount is an account cached at the height it was retrieved at.
+type cachedAccount struct {
+	account Account
+	height  int64
+}
+
+// sequencedAccount is a cached account whose sequence was incremented.
+type sequencedAccount struct {
+	Account
+	sequence uint64
+}
+
+func (acc sequencedAccount) GetSequence() uint64 { return acc.sequence }
+
+// NewCachingAccountRetriever returns a CachingAccountRetriever caching the
+// accounts retrieved by the given AccountRetriever for ttl blocks.
+func NewCachingAccountRetriever(retriever AccountRetriever, ttl int64) *CachingAccountRetriever {
+	return &CachingAccountRetriever{
+		retriever: retriever,
+		ttl:       ttl,
+		accounts:  make(map[string]cachedAccount),
+	}
+}
+
+// GetAccount implements AccountRetriever.
+func (r *CachingAccountRetriever) GetAccount(clientCtx Context, addr sdk.AccAddress) (Account, error) {
+	acc, _, err := r.GetAccountWithHeight(clientCtx, addr)
+	return acc, err
+}
+
+// GetAccountWithHeight implements AccountRetriever. The account is retrieved
+// from the cache if it was retrieved less than ttl blocks before the latest
+// height observed by the retriever.
+func (r *CachingAccountRetriever) GetAccountWithHeight(clientCtx Context, addr sdk.AccAddress) (Account, int64, error) {
+	r.mtx.Lock()
+	cached, ok := r.accounts[addr.String()]
+	latestHeight := r.latestHeight
+	r.mtx.Unlock()
+
+	if ok && latestHeight-cached.height < r.ttl {
+		return cached.account, cached.height, nil
+	}
+
+	acc, height, err := r.retriever.GetAccountWithHeight(clientCtx, addr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r.mtx.Lock()
+	r.accounts[addr.String()] = cachedAccount{account: acc, height: height}
+	r.observeHeight(height)
+	r.mtx.Unlock()
+
+	return acc, height, nil
+}
+
+// EnsureExists implements AccountRetriever.
+func (r *CachingAccountRetriever) EnsureExists(clientCtx Context, addr sdk.AccAddress) error {
+	_, err := r.GetAccount(clientCtx, addr)
+	return err
+}
+
+// GetAccountNumberSequence implements AccountRetriever.
+func (r *CachingAccountRetriever) GetAccountNumberSequence(clientCtx Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	acc, err := r.GetAccount(clientCtx, addr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return acc.GetAccountNumber(), acc.GetSequence(), nil
+}
+
+// Invalidate evicts the account of the given address from the cache.
+func (r *CachingAccountRetriever) Invalidate(addr sdk.AccAddress) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.accounts, addr.String())
+}
+
+// HandleTxResponse updates the cached account of the signer of a broadcast
+// transaction: its sequence is incremented if the transaction was accepted, and
+// it is evicted from the cache if the transaction failed with
+// ErrWrongSequence. The height of the response, if any, advances the latest
+// height observed by the retriever.
+func (r *CachingAccountRetriever) HandleTxResponse(addr sdk.AccAddress, res *sdk.TxResponse) {
+	if res == nil {
+		return
+	}
+
+	r.mtx.Lock()
+	r.observeHeight(res.Height)
+	r.mtx.Unlock()
+
+	if res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+		r.Invalidate(addr)
+		return
+	}
+
+	if res.Code != 0 {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	cached, ok := r.accounts[addr.String()]
+	if !ok {
+		return
+	}
+
+	sequence := cached.account.GetSequence() + 1
+	if acc, ok := cached.account.(sequencedAccount); ok {
+		cached.account = acc.Account
+	}
+	cached.account = sequencedAccount{Account: cached.account, sequence: sequence}
+	r.accounts[addr.String()] = cached
+}
+
+// observeHeight advances the latest height observed by the retriever. It must
+// be called with the mutex held.
+func (r *CachingAccountRetriever) observeHeight(height int64) {
+	if height > r.latestHeight {
+		r.latestHeight = height
+	}
+}
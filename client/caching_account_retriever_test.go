@@ -0,0 +1,83 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type mockAccount struct {
+	addr     sdk.AccAddress
+	sequence uint64
+}
+
+func (acc mockAccount) GetAddress() sdk.AccAddress    { return acc.addr }
+func (acc mockAccount) GetPubKey() cryptotypes.PubKey { return nil }
+func (acc mockAccount) GetAccountNumber() uint64      { return 1 }
+func (acc mockAccount) GetSequence() uint64           { return acc.sequence }
+
+// countingAccountRetriever returns accounts with the given sequence, counting
+// the queries.
+type countingAccountRetriever struct {
+	MockAccountRetriever
+	height   *int64
+	sequence uint64
+	queries  int
+}
+
+func (r *countingAccountRetriever) GetAccountWithHeight(_ Context, addr sdk.AccAddress) (Account, int64, error) {
+	r.queries++
+	return mockAccount{addr: addr, sequence: r.sequence}, *r.height, nil
+}
+
+func TestCachingAccountRetriever(t *testing.T) {
+	height := int64(10)
+	retriever := &countingAccountRetriever{height: &height, sequence: 5}
+	cachingRetriever := NewCachingAccountRetriever(retriever, 2)
+	clientCtx := Context{}
+	addr := sdk.AccAddress("addr")
+	otherAddr := sdk.AccAddress("other")
+
+	requireNumSeq := func(expSeq uint64, expQueries int) {
+		t.Helper()
+		num, seq, err := cachingRetriever.GetAccountNumberSequence(clientCtx, addr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), num)
+		require.Equal(t, expSeq, seq)
+		require.Equal(t, expQueries, retriever.queries)
+	}
+
+	requireNumSeq(5, 1)
+
+	// cached until the ttl expires, the height being advanced by the retrieval
+	// of other accounts
+	height++
+	_, _, err := cachingRetriever.GetAccountWithHeight(clientCtx, otherAddr)
+	require.NoError(t, err)
+	requireNumSeq(5, 2)
+
+	// accepted txs increment the sequence
+	cachingRetriever.HandleTxResponse(addr, &sdk.TxResponse{})
+	cachingRetriever.HandleTxResponse(addr, &sdk.TxResponse{})
+	requireNumSeq(7, 2)
+
+	// failed txs do not
+	cachingRetriever.HandleTxResponse(addr, &sdk.TxResponse{Codespace: sdkerrors.ErrInsufficientFunds.Codespace(), Code: sdkerrors.ErrInsufficientFunds.ABCICode()})
+	requireNumSeq(7, 2)
+
+	// the account is queried again once the ttl expired, the height being
+	// advanced by the tx responses
+	height++
+	cachingRetriever.HandleTxResponse(otherAddr, &sdk.TxResponse{Height: height})
+	retriever.sequence = 7
+	requireNumSeq(7, 3)
+
+	// a wrong sequence invalidates the account
+	retriever.sequence = 9
+	cachingRetriever.HandleTxResponse(addr, &sdk.TxResponse{Codespace: sdkerrors.ErrWrongSequence.Codespace(), Code: sdkerrors.ErrWrongSequence.ABCICode()})
+	requireNumSeq(9, 4)
+}
@@ -4,11 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sort"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 // Cmd returns a CLI command to interactively create an application CLI
@@ -20,9 +23,63 @@ func Cmd() *cobra.Command {
 		RunE:  runConfigCmd,
 		Args:  cobra.RangeArgs(0, 2),
 	}
+	cmd.AddCommand(IAVLStatsCmd())
 	return cmd
 }
 
+// IAVLStatsCmd returns a CLI command reporting the IAVL cache hit and miss
+// rates of every store of a running node.
+func IAVLStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iavl-stats",
+		Short: "Query the IAVL cache hit and miss rates of every store of a node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, _, err := clientCtx.QueryWithData("/app/iavl-stats", nil)
+			if err != nil {
+				return err
+			}
+
+			var stats map[string]storetypes.IAVLCacheStats
+			if err := json.Unmarshal(bz, &stats); err != nil {
+				return fmt.Errorf("failed to decode IAVL cache statistics: %w", err)
+			}
+
+			storeNames := maps.Keys(stats)
+			sort.Strings(storeNames)
+
+			for _, storeName := range storeNames {
+				s := stats[storeName]
+				cmd.Printf(
+					"%s: cache_size=%d hits=%d misses=%d hit_rate=%.2f%% fast_hits=%d fast_misses=%d fast_hit_rate=%.2f%%\n",
+					storeName, s.CacheSize,
+					s.CacheHits, s.CacheMisses, hitRate(s.CacheHits, s.CacheMisses),
+					s.FastCacheHits, s.FastCacheMisses, hitRate(s.FastCacheHits, s.FastCacheMisses),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// hitRate returns the percentage of cache lookups that were hits.
+func hitRate(hits, misses uint64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+
+	return float64(hits) * 100 / float64(hits+misses)
+}
+
 func runConfigCmd(cmd *cobra.Command, args []string) error {
 	clientCtx := client.GetClientContextFromCmd(cmd)
 	configPath := filepath.Join(clientCtx.HomeDir, "config")
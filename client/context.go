@@ -21,6 +21,13 @@ import (
 // PreprocessTxFn defines a hook by which chains can preprocess transactions before broadcasting
 type PreprocessTxFn func(chainID string, key keyring.KeyType, tx TxBuilder) error
 
+// MsgExecBuilder defines the interface by which chains build the message
+// executing, on behalf of a granter, messages built for the grantee signing the
+// transaction.
+type MsgExecBuilder interface {
+	BuildMsgExec(granter, grantee sdk.AccAddress, msgs []sdk.Msg) (sdk.Msg, error)
+}
+
 // Context implements a typical context created in SDK modules for transaction
 // handling and queries.
 type Context struct {
@@ -52,9 +59,11 @@ type Context struct {
 	NodeURI           string
 	FeePayer          sdk.AccAddress
 	FeeGranter        sdk.AccAddress
+	ExecAsGranter     sdk.AccAddress
 	Viper             *viper.Viper
 	LedgerHasProtobuf bool
 	PreprocessTxHook  PreprocessTxFn
+	MsgExecBuilder    MsgExecBuilder
 
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
@@ -205,6 +214,13 @@ func (ctx Context) WithFeePayerAddress(addr sdk.AccAddress) Context {
 	return ctx
 }
 
+// WithExecAsGranter returns a copy of the context with an updated granter on
+// behalf of which the messages are executed.
+func (ctx Context) WithExecAsGranter(granter sdk.AccAddress) Context {
+	ctx.ExecAsGranter = granter
+	return ctx
+}
+
 // WithFeeGranterAddress returns a copy of the context with an updated fee granter account
 // address.
 func (ctx Context) WithFeeGranterAddress(addr sdk.AccAddress) Context {
@@ -281,6 +297,13 @@ func (ctx Context) WithPreprocessTxHook(preprocessFn PreprocessTxFn) Context {
 	return ctx
 }
 
+// WithMsgExecBuilder returns the context with the provided builder of the
+// messages executed on behalf of a granter.
+func (ctx Context) WithMsgExecBuilder(builder MsgExecBuilder) Context {
+	ctx.MsgExecBuilder = builder
+	return ctx
+}
+
 // PrintString prints the raw string to ctx.Output if it's defined, otherwise to os.Stdout
 func (ctx Context) PrintString(str string) error {
 	return ctx.PrintBytes([]byte(str))
@@ -0,0 +1,134 @@
+package debug
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	encodingProtobuf = "protobuf"
+	encodingAmino    = "amino"
+)
+
+// DecodeTxCmd returns a command decoding a hex or base64 encoded transaction
+// and printing its fields in a table.
+func DecodeTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-tx [hex-or-base64]",
+		Short: "Decode a raw transaction and print its fields",
+		Long: fmt.Sprintf(`Decode a hex or base64 encoded transaction, either protobuf or amino encoded,
+and print its memo, fee, messages and signatures.
+
+The chain ID and account numbers are only signed over and not encoded in the
+transaction, so they are not displayed.
+
+Example:
+$ %s debug decode-tx CpIBCo8BChwvY29zbW9zLmJhbmsudjFiZXRhMS5Nc2dTZW5k...
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			txBytes, err := hex.DecodeString(args[0])
+			if err != nil {
+				txBytes, err = base64.StdEncoding.DecodeString(args[0])
+				if err != nil {
+					return fmt.Errorf("expected hex or base64 encoded bytes: %w", err)
+				}
+			}
+
+			tx, encoding, err := decodeTx(clientCtx, txBytes)
+			if err != nil {
+				return err
+			}
+
+			return printTx(cmd.OutOrStdout(), clientCtx, tx, encoding)
+		},
+	}
+}
+
+// decodeTx decodes the transaction with the protobuf decoder of the client
+// context, falling back to the amino decoder, and returns the encoding used.
+func decodeTx(clientCtx client.Context, txBytes []byte) (authsigning.Tx, string, error) {
+	decoded, protoErr := clientCtx.TxConfig.TxDecoder()(txBytes)
+	encoding := encodingProtobuf
+	if protoErr != nil {
+		var aminoErr error
+		decoded, aminoErr = legacytx.StdTxConfig{Cdc: clientCtx.LegacyAmino}.TxDecoder()(txBytes)
+		if aminoErr != nil {
+			return nil, "", fmt.Errorf("failed to decode tx as protobuf: %v, nor as amino: %w", protoErr, aminoErr)
+		}
+		encoding = encodingAmino
+	}
+
+	tx, ok := decoded.(authsigning.Tx)
+	if !ok {
+		return nil, "", fmt.Errorf("expected %T, got %T", (authsigning.Tx)(nil), decoded)
+	}
+
+	return tx, encoding, nil
+}
+
+func printTx(w io.Writer, clientCtx client.Context, tx authsigning.Tx, encoding string) error {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Encoding:\t%s\n", encoding)
+	fmt.Fprintf(tw, "Memo:\t%s\n", tx.GetMemo())
+	fmt.Fprintf(tw, "Timeout height:\t%d\n", tx.GetTimeoutHeight())
+	fmt.Fprintf(tw, "Fee:\t%s\n", tx.GetFee())
+	fmt.Fprintf(tw, "Gas limit:\t%d\n", tx.GetGas())
+	fmt.Fprintf(tw, "Fee payer:\t%s\n", tx.FeePayer())
+	if granter := tx.FeeGranter(); granter != nil {
+		fmt.Fprintf(tw, "Fee granter:\t%s\n", granter)
+	}
+
+	fmt.Fprintln(tw, "\nMessages:")
+	for i, msg := range tx.GetMsgs() {
+		summary, err := clientCtx.Codec.MarshalJSON(msg)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(tw, "  %d\t%s\t%s\n", i, sdk.MsgTypeURL(msg), summary)
+	}
+
+	fmt.Fprintln(tw, "\nSignatures:")
+	for i, sig := range sigs {
+		// amino transactions do not encode the signer sequences
+		sequence := "-"
+		if encoding == encodingProtobuf {
+			sequence = fmt.Sprintf("%d", sig.Sequence)
+		}
+		fmt.Fprintf(tw, "  %d\t%s\t%s\tsequence %s\n", i, sig.PubKey, signModeString(sig.Data), sequence)
+	}
+
+	return tw.Flush()
+}
+
+// signModeString returns the sign mode of single signatures, or multisig.
+func signModeString(data signing.SignatureData) string {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return data.SignMode.String()
+	case *signing.MultiSignatureData:
+		return "multisig"
+	default:
+		return "unknown"
+	}
+}
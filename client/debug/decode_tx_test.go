@@ -0,0 +1,108 @@
+package debug_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDecodeTxCmd(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 150))
+
+	builder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(fee)
+	builder.SetMemo("foomemo")
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("sig")},
+		Sequence: 7,
+	}))
+	protoBz, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	stdTx := legacytx.NewStdTx([]sdk.Msg{msg}, legacytx.NewStdFee(50000, fee), []legacytx.StdSignature{
+		legacytx.NewStdSignature(pubKey, []byte("sig")),
+	}, "foomemo")
+	aminoBz, err := legacytx.StdTxConfig{Cdc: encCfg.Amino}.TxEncoder()(stdTx)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		arg      string
+		expected []string
+		expErr   bool
+	}{
+		{
+			name:     "protobuf hex",
+			arg:      hex.EncodeToString(protoBz),
+			expected: []string{"protobuf", "SIGN_MODE_DIRECT", "sequence 7"},
+		},
+		{
+			name:     "protobuf base64",
+			arg:      base64.StdEncoding.EncodeToString(protoBz),
+			expected: []string{"protobuf", "SIGN_MODE_DIRECT", "sequence 7"},
+		},
+		{
+			name:     "amino",
+			arg:      base64.StdEncoding.EncodeToString(aminoBz),
+			expected: []string{"amino", "SIGN_MODE_LEGACY_AMINO_JSON", "sequence -"},
+		},
+		{
+			name:   "invalid encoding",
+			arg:    "not a tx",
+			expErr: true,
+		},
+		{
+			name:   "invalid tx",
+			arg:    "0102",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := debug.DecodeTxCmd()
+			_, out := testutil.ApplyMockIO(cmd)
+
+			clientCtx := client.Context{}.
+				WithTxConfig(encCfg.TxConfig).
+				WithCodec(encCfg.Codec).
+				WithLegacyAmino(encCfg.Amino)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd.SetArgs([]string{tc.arg})
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, s := range append(tc.expected, "foomemo", "150stake", "/cosmos.bank.v1beta1.MsgSend", addr.String()) {
+				require.Contains(t, out.String(), s)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package debug
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/iavl"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHeight    = "height"
+	flagStore     = "store"
+	flagKeyPrefix = "key-prefix"
+	flagDBBackend = "db-backend"
+)
+
+// StoreKVPair is a key-value pair of a store dumped by the inspect-store
+// command.
+type StoreKVPair struct {
+	// Key is the hex encoded key.
+	Key string `json:"key"`
+	// Value is the base64 encoded value.
+	Value []byte `json:"value"`
+}
+
+// InspectStoreOutput is the output of the inspect-store command.
+type InspectStoreOutput struct {
+	Height int64         `json:"height"`
+	Store  string        `json:"store"`
+	Pairs  []StoreKVPair `json:"pairs"`
+}
+
+// InspectStoreCmd returns a command dumping the key-value pairs of a committed
+// store of the application database at a given height, without running the
+// node.
+func InspectStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect-store",
+		Short: "Dump the key-value pairs of a committed store at a given height",
+		Long: fmt.Sprintf(`Open the application database in read-only mode and dump the key-value pairs
+of the given store at the given height, or at the latest height if omitted. Keys
+are hex encoded and values base64 encoded. The node must not be running.
+
+Example:
+$ %s debug inspect-store --store bank --key-prefix 02 --height 100
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home := client.GetClientContextFromCmd(cmd).HomeDir
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			storeName, _ := cmd.Flags().GetString(flagStore)
+			backend, _ := cmd.Flags().GetString(flagDBBackend)
+
+			prefixStr, _ := cmd.Flags().GetString(flagKeyPrefix)
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return fmt.Errorf("invalid key prefix: %w", err)
+			}
+
+			db, err := openReadOnlyDB(filepath.Join(home, "data"), dbm.BackendType(backend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			out, err := inspectStore(db, storeName, height, prefix)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the store to inspect, the latest height if 0")
+	cmd.Flags().String(flagStore, "", "Name of the store to inspect")
+	cmd.Flags().String(flagKeyPrefix, "", "Hex encoded prefix of the keys to dump, all keys if empty")
+	cmd.Flags().String(flagDBBackend, string(dbm.GoLevelDBBackend), "Database backend of the application")
+	_ = cmd.MarkFlagRequired(flagStore)
+
+	return cmd
+}
+
+// openReadOnlyDB opens the application database. Only goleveldb databases can
+// be opened in read-only mode, the others being opened as is but never written
+// to.
+func openReadOnlyDB(dir string, backend dbm.BackendType) (dbm.DB, error) {
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dir, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
+	}
+
+	return dbm.NewDB("application", backend, dir)
+}
+
+// inspectStore returns the key-value pairs with the given prefix of the IAVL
+// store of the given name at the given height, or at the latest height if 0.
+func inspectStore(db dbm.DB, storeName string, height int64, prefix []byte) (*InspectStoreOutput, error) {
+	if height == 0 {
+		height = rootmulti.GetLatestVersion(db)
+	}
+
+	storeDB := dbm.NewPrefixDB(db, []byte("s/k:"+storeName+"/"))
+	tree, err := iavl.NewMutableTree(storeDB, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	itree, err := tree.GetImmutable(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store %s at height %d: %w", storeName, height, err)
+	}
+
+	var end []byte
+	if len(prefix) > 0 {
+		end = storetypes.PrefixEndBytes(prefix)
+	}
+
+	iter, err := itree.Iterator(prefix, end, true)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	out := &InspectStoreOutput{Height: height, Store: storeName, Pairs: []StoreKVPair{}}
+	for ; iter.Valid(); iter.Next() {
+		out.Pairs = append(out.Pairs, StoreKVPair{Key: hex.EncodeToString(iter.Key()), Value: iter.Value()})
+	}
+
+	return out, iter.Error()
+}
@@ -0,0 +1,99 @@
+package debug_test
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestInspectStoreCmd(t *testing.T) {
+	home := t.TempDir()
+
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+
+	key := storetypes.NewKVStoreKey("bank")
+	ms := rootmulti.NewStore(db, log.NewNopLogger())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(key).Set([]byte{0x01, 0x01}, []byte("a"))
+	ms.GetKVStore(key).Set([]byte{0x02, 0x01}, []byte("b"))
+	ms.Commit()
+	ms.GetKVStore(key).Set([]byte{0x02, 0x02}, []byte("c"))
+	ms.Commit()
+	require.NoError(t, db.Close())
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expHeight int64
+		expPairs  []debug.StoreKVPair
+		expErr    bool
+	}{
+		{
+			name:      "latest height",
+			args:      []string{"--store=bank"},
+			expHeight: 2,
+			expPairs:  []debug.StoreKVPair{{"0101", []byte("a")}, {"0201", []byte("b")}, {"0202", []byte("c")}},
+		},
+		{
+			name:      "historical height with prefix",
+			args:      []string{"--store=bank", "--height=1", "--key-prefix=02"},
+			expHeight: 1,
+			expPairs:  []debug.StoreKVPair{{"0201", []byte("b")}},
+		},
+		{
+			name:   "unknown height",
+			args:   []string{"--store=bank", "--height=3"},
+			expErr: true,
+		},
+		{
+			name:   "unknown store",
+			args:   []string{"--store=staking"},
+			expErr: true,
+		},
+		{
+			name:   "invalid prefix",
+			args:   []string{"--store=bank", "--key-prefix=xyz"},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := debug.InspectStoreCmd()
+			_, out := testutil.ApplyMockIO(cmd)
+
+			clientCtx := client.Context{}.WithHomeDir(home)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd.SetArgs(tc.args)
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			var res debug.InspectStoreOutput
+			require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+			require.Equal(t, tc.expHeight, res.Height)
+			require.Equal(t, "bank", res.Store)
+			require.Equal(t, tc.expPairs, res.Pairs)
+		})
+	}
+}
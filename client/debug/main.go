@@ -38,6 +38,8 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(DecodeTxCmd())
+	cmd.AddCommand(InspectStoreCmd())
 
 	return cmd
 }
@@ -76,6 +76,7 @@ const (
 	FlagKeyType          = "key-type"
 	FlagFeePayer         = "fee-payer"
 	FlagFeeGranter       = "fee-granter"
+	FlagExecAs           = "exec-as"
 	FlagReverse          = "reverse"
 	FlagTip              = "tip"
 	FlagAux              = "aux"
@@ -129,6 +130,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
+	f.String(FlagExecAs, "", "Execute the messages on behalf of the given authz granter, wrapping them in a MsgExec signed by the from address")
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
 	f.Bool(FlagAux, false, "Generate aux signer data instead of sending a tx")
 	f.String(FlagChainID, "", "The network chain ID")
@@ -142,7 +144,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|os-native|file|kwallet|pass|test|memory)")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// subscriberSeq makes the subscriber names of SubscribeToModuleEvents unique,
+// as a subscriber can only subscribe once to a given query.
+var subscriberSeq atomic.Uint64
+
+// SubscribeToModuleEvents subscribes to the transaction events of a CometBFT
+// WebSocket client and returns a channel of the typed events of the given type
+// emitted by the modules, decoded into T. If eventType is empty, the proto
+// message name of T is used.
+//
+// The subscription is cancelled and the channel is closed when ctx is done, or
+// when the subscription of the client is terminated. Events which cannot be
+// decoded into T are skipped.
+func SubscribeToModuleEvents[T proto.Message](ctx context.Context, c rpcclient.EventsClient, eventType string) (<-chan T, error) {
+	msgType := reflect.TypeOf((*T)(nil)).Elem()
+	if msgType.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("%s is not a pointer to a proto message", msgType)
+	}
+
+	if eventType == "" {
+		eventType = proto.MessageName(reflect.New(msgType.Elem()).Interface().(T))
+	}
+
+	subscriber := fmt.Sprintf("module-events-%s-%d", eventType, subscriberSeq.Add(1))
+	query := fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx)
+
+	eventCh, err := c.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to %s events: %w", eventType, err)
+	}
+
+	out := make(chan T)
+	go func() {
+		// out is only closed here, once nothing can be sent anymore
+		defer close(out)
+		defer c.Unsubscribe(context.Background(), subscriber, query) //nolint:errcheck // ignore unsubscribe error
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case res, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				txe, ok := res.Data.(tmtypes.EventDataTx)
+				if !ok {
+					continue
+				}
+
+				for _, event := range txe.Result.Events {
+					if event.Type != eventType {
+						continue
+					}
+
+					msg := reflect.New(msgType.Elem()).Interface().(T)
+					if err := unmarshalEventAttributes(event, msg); err != nil {
+						continue
+					}
+
+					select {
+					case out <- msg:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// unmarshalEventAttributes decodes the JSON encoded attributes of a typed event
+// into msg.
+func unmarshalEventAttributes(event abci.Event, msg proto.Message) error {
+	attrMap := make(map[string]json.RawMessage)
+	for _, attr := range event.Attributes {
+		attrMap[attr.Key] = json.RawMessage(attr.Value)
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return err
+	}
+
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return unmarshaler.Unmarshal(bytes.NewReader(attrBytes), msg)
+}
@@ -0,0 +1,89 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockEventsClient struct {
+	events       chan coretypes.ResultEvent
+	unsubscribed chan string
+}
+
+func (c mockEventsClient) Subscribe(_ context.Context, _, _ string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	return c.events, nil
+}
+
+func (c mockEventsClient) Unsubscribe(_ context.Context, subscriber, _ string) error {
+	c.unsubscribed <- subscriber
+	return nil
+}
+
+func (c mockEventsClient) UnsubscribeAll(_ context.Context, subscriber string) error {
+	c.unsubscribed <- subscriber
+	return nil
+}
+
+func txEvent(t *testing.T, msgs ...proto.Message) coretypes.ResultEvent {
+	t.Helper()
+
+	var events []abci.Event
+	for _, msg := range msgs {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		events = append(events, abci.Event(event))
+	}
+
+	return coretypes.ResultEvent{
+		Data: tmtypes.EventDataTx{TxResult: abci.TxResult{Result: abci.ResponseDeliverTx{Events: events}}},
+	}
+}
+
+func TestSubscribeToModuleEvents(t *testing.T) {
+	client := mockEventsClient{
+		events:       make(chan coretypes.ResultEvent, 3),
+		unsubscribed: make(chan string, 1),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	coinCh, err := rpc.SubscribeToModuleEvents[*sdk.Coin](ctx, client, "")
+	require.NoError(t, err)
+
+	coin1, coin2 := sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("atom", 2)
+	client.events <- txEvent(t, &coin1, &testdata.Dog{Name: "Spot"})
+	client.events <- coretypes.ResultEvent{Data: tmtypes.EventDataNewBlock{}}
+	client.events <- txEvent(t, &coin2)
+
+	for _, expected := range []sdk.Coin{coin1, coin2} {
+		select {
+		case coin := <-coinCh:
+			require.Equal(t, expected, *coin)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+
+	cancel()
+
+	select {
+	case <-client.unsubscribed:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for unsubscription")
+	}
+
+	_, ok := <-coinCh
+	require.False(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,8 +15,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func newTxResponseCheckTx(res *coretypes.ResultBroadcastTxCommit) *sdk.TxResponse {
@@ -138,3 +141,122 @@ func QueryEventForTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// txSearchPerPage is the maximum number of results per page of the CometBFT
+// tx_search endpoint.
+const txSearchPerPage = 100
+
+// FetchTxsByAddress returns the transactions with an event attribute equal to
+// the given address, sorted by descending height. The event attributes are
+// given as "{eventType}.{attributeKey}" keys, e.g. "message.sender" and
+// "transfer.recipient" for the transactions sent or received by an account, a
+// transaction matching several of them being returned once. The offset and
+// limit of the pagination apply to the merged results, all of them being
+// returned if pagination is nil or has no limit. Key based pagination is not
+// supported.
+func FetchTxsByAddress(clientCtx client.Context, addr sdk.AccAddress, eventKeys []string, pagination *query.PageRequest) ([]sdk.TxResponse, error) {
+	var offset, limit int
+	if pagination != nil {
+		if len(pagination.Key) > 0 {
+			return nil, errors.ErrInvalidRequest.Wrap("key based pagination is not supported")
+		}
+		offset, limit = int(pagination.Offset), int(pagination.Limit)
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	// the first offset+limit merged results are among the first offset+limit
+	// results of each query, as they are all sorted by descending height
+	maxResults := 0
+	if limit > 0 {
+		maxResults = offset + limit
+	}
+
+	seen := make(map[string]bool)
+	var resTxs []*coretypes.ResultTx
+	for _, key := range eventKeys {
+		txs, err := searchTxs(node, fmt.Sprintf("%s='%s'", key, addr), maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range txs {
+			if hash := tx.Hash.String(); !seen[hash] {
+				seen[hash] = true
+				resTxs = append(resTxs, tx)
+			}
+		}
+	}
+
+	sort.Slice(resTxs, func(i, j int) bool {
+		if resTxs[i].Height != resTxs[j].Height {
+			return resTxs[i].Height > resTxs[j].Height
+		}
+		return resTxs[i].Index > resTxs[j].Index
+	})
+
+	if offset >= len(resTxs) {
+		return []sdk.TxResponse{}, nil
+	}
+	resTxs = resTxs[offset:]
+	if limit > 0 && limit < len(resTxs) {
+		resTxs = resTxs[:limit]
+	}
+
+	return formatTxResponses(clientCtx, node, resTxs)
+}
+
+// searchTxs returns the transactions matching the query, sorted by descending
+// height, going through all the result pages until maxResults are found. All
+// of them are returned if maxResults is 0.
+func searchTxs(node client.TendermintRPC, query string, maxResults int) ([]*coretypes.ResultTx, error) {
+	var txs []*coretypes.ResultTx
+	perPage := txSearchPerPage
+	for page := 1; ; page++ {
+		res, err := node.TxSearch(context.Background(), query, false, &page, &perPage, "desc")
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, res.Txs...)
+		if len(res.Txs) == 0 || len(txs) >= res.TotalCount || (maxResults > 0 && len(txs) >= maxResults) {
+			return txs, nil
+		}
+	}
+}
+
+// formatTxResponses decodes the indexed transactions into TxResponses,
+// timestamped with the time of their block.
+func formatTxResponses(clientCtx client.Context, node client.TendermintRPC, resTxs []*coretypes.ResultTx) ([]sdk.TxResponse, error) {
+	blockTimes := make(map[int64]string)
+	out := make([]sdk.TxResponse, len(resTxs))
+	for i, resTx := range resTxs {
+		timestamp, ok := blockTimes[resTx.Height]
+		if !ok {
+			resBlock, err := node.Block(context.Background(), &resTx.Height)
+			if err != nil {
+				return nil, err
+			}
+
+			timestamp = resBlock.Block.Time.Format(time.RFC3339)
+			blockTimes[resTx.Height] = timestamp
+		}
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(resTx.Tx)
+		if err != nil {
+			return nil, err
+		}
+
+		p, ok := tx.(interface{ AsAny() *codectypes.Any })
+		if !ok {
+			return nil, fmt.Errorf("expecting a type implementing AsAny, got: %T", tx)
+		}
+
+		out[i] = *sdk.NewResponseResultTx(resTx, p.AsAny(), timestamp)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,113 @@
+package rpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TxsByAddressTestSuite runs on its own network, as the transactions it sends
+// would advance the chain of the other suites.
+type TxsByAddressTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+}
+
+func (s *TxsByAddressTestSuite) SetupSuite() {
+	s.T().Log("setting up txs by address test suite")
+
+	cfg, err := network.DefaultConfigWithAppConfig(network.MinimumAppConfig())
+	s.Require().NoError(err)
+	cfg.NumValidators = 2
+
+	s.network, err = network.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+}
+
+func (s *TxsByAddressTestSuite) TearDownSuite() {
+	s.T().Log("tearing down txs by address test suite")
+	s.network.Cleanup()
+}
+
+func (s *TxsByAddressTestSuite) TestFetchTxsByAddress() {
+	val0, val1 := s.network.Validators[0], s.network.Validators[1]
+	recipient := sdk.AccAddress("recipient")
+
+	send := func(val *network.Validator, to sdk.AccAddress) string {
+		// only the first validator exposes its RPC
+		clientCtx := val.ClientCtx.WithClient(val0.RPCClient).WithNodeURI(val0.RPCAddress)
+		out, err := clitestutil.MsgSendExec(clientCtx, val.Address, to,
+			sdk.NewCoins(sdk.NewInt64Coin(s.network.Config.BondDenom, 10)),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(s.network.Config.BondDenom, 10)),
+		)
+		s.Require().NoError(err)
+
+		var res sdk.TxResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Equal(uint32(0), res.Code, res.RawLog)
+		s.Require().NoError(s.network.WaitForNextBlock())
+
+		return res.TxHash
+	}
+
+	hash1 := send(val0, recipient)
+	hash2 := send(val1, recipient)
+	hash3 := send(val0, val0.Address)
+
+	eventKeys := []string{
+		fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeySender),
+		fmt.Sprintf("%s.%s", banktypes.EventTypeTransfer, banktypes.AttributeKeyRecipient),
+	}
+
+	testCases := []struct {
+		name       string
+		addr       sdk.AccAddress
+		pagination *query.PageRequest
+		expHashes  []string
+		expErr     bool
+	}{
+		{"recipient", recipient, nil, []string{hash2, hash1}, false},
+		{"sender and recipient", val0.Address, nil, []string{hash3, hash1}, false},
+		{"limit", recipient, &query.PageRequest{Limit: 1}, []string{hash2}, false},
+		{"offset", recipient, &query.PageRequest{Offset: 1, Limit: 1}, []string{hash1}, false},
+		{"offset out of range", recipient, &query.PageRequest{Offset: 2}, []string{}, false},
+		{"key", recipient, &query.PageRequest{Key: []byte("key")}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			txs, err := rpc.FetchTxsByAddress(val0.ClientCtx, tc.addr, eventKeys, tc.pagination)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			hashes := make([]string, len(txs))
+			for i, tx := range txs {
+				hashes[i] = tx.TxHash
+				s.Require().NotEmpty(tx.Timestamp)
+			}
+			s.Require().Equal(tc.expHashes, hashes)
+		})
+	}
+}
+
+func TestTxsByAddressTestSuite(t *testing.T) {
+	suite.Run(t, new(TxsByAddressTestSuite))
+}
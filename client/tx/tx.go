@@ -29,9 +29,30 @@ func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet,
 		return err
 	}
 
+	// If the --exec-as flag is set, the messages are executed on behalf of the
+	// granter through a message signed by the from address.
+	if clientCtx.ExecAsGranter != nil {
+		msg, err := buildMsgExec(clientCtx, msgs)
+		if err != nil {
+			return err
+		}
+
+		msgs = []sdk.Msg{msg}
+	}
+
 	return GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...)
 }
 
+// buildMsgExec builds the message executing msgs, built for the from address,
+// on behalf of the context's granter.
+func buildMsgExec(clientCtx client.Context, msgs []sdk.Msg) (sdk.Msg, error) {
+	if clientCtx.MsgExecBuilder == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "executing messages on behalf of a granter is not supported by this client")
+	}
+
+	return clientCtx.MsgExecBuilder.BuildMsgExec(clientCtx.ExecAsGranter, clientCtx.GetFromAddress(), msgs)
+}
+
 // GenerateOrBroadcastTxWithFactory will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
@@ -129,6 +150,10 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	if r, ok := txf.accountRetriever.(*client.CachingAccountRetriever); ok {
+		r.HandleTxResponse(clientCtx.GetFromAddress(), res)
+	}
+
 	return clientCtx.PrintProto(res)
 }
 
@@ -20,6 +20,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -171,6 +172,28 @@ func TestBuildUnsignedTxWithWithExtensionOptions(t *testing.T) {
 	require.Equal(t, extOpts, txb.ExtOptions)
 }
 
+// recordingMsgExecBuilder wraps the messages it is given in a MsgSend
+// recording the granter and the grantee.
+type recordingMsgExecBuilder struct{}
+
+func (recordingMsgExecBuilder) BuildMsgExec(granter, grantee sdk.AccAddress, msgs []sdk.Msg) (sdk.Msg, error) {
+	return banktypes.NewMsgSend(grantee, granter, sdk.NewCoins(sdk.NewInt64Coin("stake", int64(len(msgs))))), nil
+}
+
+func TestBuildMsgExec(t *testing.T) {
+	granter, grantee := sdk.AccAddress("granter"), sdk.AccAddress("grantee")
+	msg := banktypes.NewMsgSend(grantee, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	clientCtx := client.Context{}.WithFromAddress(grantee).WithExecAsGranter(granter)
+
+	// the client must support executing messages on behalf of a granter
+	_, err := buildMsgExec(clientCtx, []sdk.Msg{msg})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	wrapped, err := buildMsgExec(clientCtx.WithMsgExecBuilder(recordingMsgExecBuilder{}), []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, banktypes.NewMsgSend(grantee, granter, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), wrapped)
+}
+
 func TestMnemonicInMemo(t *testing.T) {
 	txConfig, cdc := newTestTxConfig(t)
 	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
@@ -50,6 +50,13 @@ type (
 		AddAuxSignerData(tx.AuxSignerData) error
 	}
 
+	// AbstractAccountTxBuilder extends the TxBuilder interface,
+	// which is used to attach the authentication data of an abstract account to
+	// a transaction before it is signed.
+	AbstractAccountTxBuilder interface {
+		SetAbstractAccountWitness(witness *tx.AbstractAccountWitness)
+	}
+
 	// ExtendedTxBuilder extends the TxBuilder interface,
 	// which is used to set extension options to be included in a transaction.
 	ExtendedTxBuilder interface {
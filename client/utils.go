@@ -2,8 +2,10 @@ package client
 
 import (
 	"encoding/base64"
+	"net/http"
 
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -74,9 +76,37 @@ func ReadPageRequest(flagSet *pflag.FlagSet) (*query.PageRequest, error) {
 }
 
 // NewClientFromNode sets up Client implementation that communicates with a Tendermint node over
-// JSON RPC and WebSockets
+// JSON RPC and WebSockets. Requests the node responds to with HTTP 503 fail
+// with ErrNodeUnavailable.
 func NewClientFromNode(nodeURI string) (*rpchttp.HTTP, error) {
-	return rpchttp.New(nodeURI, "/websocket")
+	httpClient, err := jsonrpcclient.DefaultHTTPClient(nodeURI)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient.Transport = unavailableNodeTransport{httpClient.Transport}
+	return rpchttp.NewWithClient(nodeURI, "/websocket", httpClient)
+}
+
+// unavailableNodeTransport is an http.RoundTripper returning
+// ErrNodeUnavailable on HTTP 503 responses, which the CometBFT RPC client only
+// reports in the message of a decoding error.
+type unavailableNodeTransport struct {
+	http.RoundTripper
+}
+
+func (t unavailableNodeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusServiceUnavailable {
+		res.Body.Close()
+		return nil, ErrNodeUnavailable
+	}
+
+	return res, nil
 }
 
 // FlagSetWithPageKeyDecoded returns the provided flagSet with the page-key value base64 decoded (if it exists).
@@ -0,0 +1,93 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// DefaultMaxDelimitedSize is the maximum size of a message read by
+// ReadDelimited, which bounds the memory allocated for an untrusted size prefix.
+const DefaultMaxDelimitedSize = 4 << 20
+
+// WriteDelimited writes msg to w prefixed with its size encoded as a uvarint.
+// This is the framing used by gRPC streaming and by
+// ProtoCodec.MarshalLengthPrefixed, which makes it suitable for streaming
+// sequences of messages to files.
+func WriteDelimited(w io.Writer, msg proto.Message) error {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	var sizeBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(sizeBuf[:], uint64(len(bz)))
+	if _, err := w.Write(sizeBuf[:n]); err != nil {
+		return err
+	}
+
+	_, err = w.Write(bz)
+	return err
+}
+
+// ReadDelimited reads the next message written by WriteDelimited from r into a
+// new message created by factory. It returns io.EOF if r has no more messages
+// and io.ErrUnexpectedEOF if r ends in the middle of a message.
+//
+// If r is not an io.ByteReader, the size prefix is read one byte at a time so
+// that no bytes past the message are consumed; wrap r in a bufio.Reader to read
+// efficiently.
+//
+// Messages larger than DefaultMaxDelimitedSize bytes are rejected, use
+// ReadDelimitedWithMaxSize to read larger messages.
+func ReadDelimited(r io.Reader, factory func() proto.Message) (proto.Message, error) {
+	return ReadDelimitedWithMaxSize(r, factory, DefaultMaxDelimitedSize)
+}
+
+// ReadDelimitedWithMaxSize is like ReadDelimited but rejects the messages larger
+// than maxSize bytes instead of DefaultMaxDelimitedSize. The size prefix is
+// checked before allocating the message buffer.
+func ReadDelimitedWithMaxSize(r io.Reader, factory func() proto.Message, maxSize uint64) (proto.Message, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = byteReader{r}
+	}
+
+	size, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > maxSize {
+		return nil, fmt.Errorf("delimited message size %d exceeds maximum %d", size, maxSize)
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	msg := factory()
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// byteReader implements io.ByteReader on top of an io.Reader without buffering.
+type byteReader struct {
+	io.Reader
+}
+
+func (r byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r.Reader, b[:])
+	return b[0], err
+}
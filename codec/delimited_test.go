@@ -0,0 +1,65 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestWriteReadDelimited(t *testing.T) {
+	cdc := codec.NewProtoCodec(createTestInterfaceRegistry())
+	newCat := func() proto.Message { return &testdata.Cat{} }
+
+	cats := []*testdata.Cat{{Moniker: "Garfield", Lives: 6}, {}, {Moniker: "Tom"}}
+
+	var buf bytes.Buffer
+	for _, cat := range cats {
+		require.NoError(t, codec.WriteDelimited(&buf, cat))
+	}
+
+	// the framing is compatible with MarshalLengthPrefixed
+	bz, err := cdc.MarshalLengthPrefixed(cats[0])
+	require.NoError(t, err)
+	require.Equal(t, bz, buf.Bytes()[:len(bz)])
+
+	// io.Reader without io.ByteReader
+	r := io.LimitReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	for _, cat := range cats {
+		msg, err := codec.ReadDelimited(r, newCat)
+		require.NoError(t, err)
+		require.Equal(t, cat, msg)
+	}
+	_, err = codec.ReadDelimited(r, newCat)
+	require.ErrorIs(t, err, io.EOF)
+
+	// truncated message
+	_, err = codec.ReadDelimited(bytes.NewReader(buf.Bytes()[:len(bz)-1]), newCat)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestReadDelimitedMaxSize(t *testing.T) {
+	newCat := func() proto.Message { return &testdata.Cat{} }
+
+	var buf bytes.Buffer
+	require.NoError(t, codec.WriteDelimited(&buf, &testdata.Cat{Moniker: "Garfield"}))
+
+	_, err := codec.ReadDelimitedWithMaxSize(bytes.NewReader(buf.Bytes()), newCat, uint64(buf.Len()-2))
+	require.ErrorContains(t, err, "exceeds maximum")
+
+	msg, err := codec.ReadDelimitedWithMaxSize(bytes.NewReader(buf.Bytes()), newCat, uint64(buf.Len()-1))
+	require.NoError(t, err)
+	require.Equal(t, &testdata.Cat{Moniker: "Garfield"}, msg)
+
+	// a huge size prefix is rejected without allocating the message
+	var sizeBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(sizeBuf[:], codec.DefaultMaxDelimitedSize+1)
+	_, err = codec.ReadDelimited(bytes.NewReader(sizeBuf[:n]), newCat)
+	require.ErrorContains(t, err, "exceeds maximum")
+}
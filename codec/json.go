@@ -2,6 +2,9 @@ package codec
 
 import (
 	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
 
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
@@ -20,6 +23,25 @@ func ProtoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, e
 	if resolver != nil {
 		jm = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true, AnyResolver: resolver}
 	}
+
+	return protoMarshalJSON(msg, jm)
+}
+
+// ProtoMarshalJSONCompact provides an auxiliary function to return compact
+// Proto3 JSON encoded bytes of a message. Unlike ProtoMarshalJSON, field names
+// follow the json_name field option and zero-value scalars and empty repeated
+// fields are omitted, as in the protojson default behaviour. Zero-value
+// custom scalars, such as sdk.Dec and sdk.Int, are omitted as well.
+func ProtoMarshalJSONCompact(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, error) {
+	bz, err := protoMarshalJSON(msg, &jsonpb.Marshaler{AnyResolver: resolver})
+	if err != nil {
+		return nil, err
+	}
+
+	return omitZeroCustomScalars(bz, reflect.ValueOf(msg))
+}
+
+func protoMarshalJSON(msg proto.Message, jm *jsonpb.Marshaler) ([]byte, error) {
 	err := types.UnpackInterfaces(msg, types.ProtoJSONPacker{JSONPBMarshaler: jm})
 	if err != nil {
 		return nil, err
@@ -32,3 +54,150 @@ func ProtoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, e
 
 	return buf.Bytes(), nil
 }
+
+// omitZeroCustomScalars removes from the JSON encoding bz of v the zero-value
+// custom scalar fields, which jsonpb always emits as it only checks the zero
+// value of builtin kinds.
+func omitZeroCustomScalars(bz []byte, v reflect.Value) ([]byte, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return bz, nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(bz, &elems); err != nil || len(elems) != v.Len() {
+			return bz, nil
+		}
+		for i := range elems {
+			elem, err := omitZeroCustomScalars(elems[i], v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = elem
+		}
+		return json.Marshal(elems)
+
+	case reflect.Struct:
+		fields, ok := decodeJSONObject(bz)
+		if !ok {
+			return bz, nil
+		}
+
+		buf := new(bytes.Buffer)
+		buf.WriteByte('{')
+		for _, f := range fields {
+			fv, found := jsonField(v, f.key)
+			if found && isZeroCustomScalar(fv) {
+				continue
+			}
+			if found {
+				value, err := omitZeroCustomScalars(f.value, fv)
+				if err != nil {
+					return nil, err
+				}
+				f.value = value
+			}
+
+			if buf.Len() > 1 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(f.key)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(f.value)
+		}
+		buf.WriteByte('}')
+
+		return buf.Bytes(), nil
+
+	default:
+		return bz, nil
+	}
+}
+
+// jsonField returns the field of the proto message struct v named key in its
+// JSON encoding.
+func jsonField(v reflect.Value, key string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+
+		name := ""
+		for _, opt := range strings.Split(tag, ",") {
+			switch {
+			case strings.HasPrefix(opt, "json="):
+				name = strings.TrimPrefix(opt, "json=")
+			case strings.HasPrefix(opt, "name=") && name == "":
+				name = strings.TrimPrefix(opt, "name=")
+			}
+		}
+		if name == key {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// isZeroCustomScalar reports whether v is a custom scalar, i.e. a struct which
+// is not a proto message, holding a nil or zero value.
+func isZeroCustomScalar(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct || !v.CanInterface() {
+		return false
+	}
+	if _, ok := reflect.New(v.Type()).Interface().(proto.Message); ok {
+		return false
+	}
+
+	if n, ok := v.Interface().(interface{ IsNil() bool }); ok && n.IsNil() {
+		return true
+	}
+	if z, ok := v.Interface().(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+
+	return false
+}
+
+type jsonObjectField struct {
+	key   string
+	value json.RawMessage
+}
+
+// decodeJSONObject decodes the fields of the JSON object bz, in order.
+func decodeJSONObject(bz []byte) ([]jsonObjectField, bool) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false
+	}
+
+	var fields []jsonObjectField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, false
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, false
+		}
+		fields = append(fields, jsonObjectField{key: key, value: value})
+	}
+
+	return fields, true
+}
@@ -146,6 +146,19 @@ func (pc *ProtoCodec) MarshalJSON(o gogoproto.Message) ([]byte, error) {
 	return ProtoMarshalJSON(m, pc.interfaceRegistry)
 }
 
+// MarshalJSONCompact marshals to JSON using proto codec, omitting zero-value
+// fields and naming fields after their json_name option.
+// NOTE: this function must be used with a concrete type which
+// implements proto.Message. For interface please use the codec.MarshalInterfaceJSON
+func (pc *ProtoCodec) MarshalJSONCompact(o gogoproto.Message) ([]byte, error) {
+	m, ok := o.(ProtoMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("cannot protobuf JSON encode unsupported type: %T", o)
+	}
+
+	return ProtoMarshalJSONCompact(m, pc.interfaceRegistry)
+}
+
 // MustMarshalJSON implements JSONCodec.MustMarshalJSON method,
 // it executes MarshalJSON except it panics upon failure.
 // NOTE: this function must be used with a concrete type which
@@ -267,6 +280,14 @@ func (pc *ProtoCodec) InterfaceRegistry() types.InterfaceRegistry {
 	return pc.interfaceRegistry
 }
 
+// ValidateCodecRegistrations checks the interface registrations of cdc for type
+// URLs registered for different concrete types, which corrupts the resolution
+// of Any's, and for type URLs differing only by case, which are confusing in
+// JSON. It is called by BaseApp on Init.
+func ValidateCodecRegistrations(cdc *ProtoCodec) error {
+	return types.ValidateTypeURLs(cdc.interfaceRegistry)
+}
+
 // GRPCCodec returns the gRPC Codec for this specific ProtoCodec
 func (pc *ProtoCodec) GRPCCodec() encoding.Codec {
 	return &grpcProtoCodec{cdc: pc}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -70,6 +71,49 @@ func TestEnsureRegistered(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(cat))
 }
 
+func TestValidateCodecRegistrations(t *testing.T) {
+	interfaceRegistry := createTestInterfaceRegistry()
+	require.NoError(t, codec.ValidateCodecRegistrations(codec.NewProtoCodec(interfaceRegistry)))
+
+	// testdata.Dog registered under a custom type URL differing only by case from testdata.Cat's
+	interfaceRegistry.(interface {
+		RegisterCustomTypeURL(iface interface{}, typeURL string, impl proto.Message)
+	}).RegisterCustomTypeURL((*testdata.Animal)(nil), "/testpb.cat", &testdata.Dog{})
+	require.ErrorContains(t, codec.ValidateCodecRegistrations(codec.NewProtoCodec(interfaceRegistry)), "differ only by case")
+}
+
+func TestProtoCodecMarshalJSONCompact(t *testing.T) {
+	cdc := codec.NewProtoCodec(createTestInterfaceRegistry())
+
+	params := banktypes.Params{DefaultSendEnabled: true}
+	bz, err := cdc.MarshalJSON(&params)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"send_enabled":[],"default_send_enabled":true,"burn_authorization_timeout":"0","memo_prune_after_blocks":"0","transfer_tax":"0","transfer_tax_destination":"TRANSFER_TAX_DESTINATION_BURN"}`, string(bz))
+
+	bz, err = cdc.MarshalJSONCompact(&params)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"defaultSendEnabled":true}`, string(bz))
+
+	bz, err = cdc.MarshalJSONCompact(&banktypes.Params{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(bz))
+
+	// zero-value custom scalars are omitted as well
+	bz, err = cdc.MarshalJSONCompact(&banktypes.Params{TransferTax: sdk.ZeroDec()})
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(bz))
+
+	bz, err = cdc.MarshalJSONCompact(&banktypes.Params{TransferTax: sdk.NewDecWithPrec(1, 2)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"transferTax":"0.010000000000000000"}`, string(bz))
+
+	any, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+	bz, err = cdc.MarshalJSONCompact(&testdata.HasAnimal{Animal: any})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"animal":{"@type":"/testpb.Dog","name":"Spot"}}`, string(bz))
+}
+
 func TestProtoCodecMarshal(t *testing.T) {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	interfaceRegistry.RegisterInterface("testdata.Animal",
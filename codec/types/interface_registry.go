@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
@@ -288,6 +290,44 @@ func (registry *interfaceRegistry) Resolve(typeURL string) (proto.Message, error
 	return msg, nil
 }
 
+// ValidateTypeURLs checks the type URLs registered in registry. It returns an
+// error if a type URL was registered for different concrete types under
+// different interfaces, in which case the last registration silently replaced
+// the previous ones when resolving Any's, or if type URLs differ only by case.
+func ValidateTypeURLs(registry InterfaceRegistry) error {
+	r, ok := registry.(*interfaceRegistry)
+	if !ok {
+		return nil
+	}
+
+	typeURLs := make([]string, 0, len(r.typeURLMap))
+	for typeURL := range r.typeURLMap {
+		typeURLs = append(typeURLs, typeURL)
+	}
+	sort.Strings(typeURLs)
+
+	for _, typeURL := range typeURLs {
+		implType := r.typeURLMap[typeURL]
+		for ityp, imap := range r.interfaceImpls {
+			if otherType, found := imap[typeURL]; found && otherType != implType {
+				return fmt.Errorf("duplicate type URL %s: registered for %s as an implementation of %s and for %s",
+					typeURL, otherType, ityp, implType)
+			}
+		}
+	}
+
+	lowerTypeURLs := make(map[string]string, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		lower := strings.ToLower(typeURL)
+		if other, found := lowerTypeURLs[lower]; found {
+			return fmt.Errorf("type URLs %s and %s differ only by case", other, typeURL)
+		}
+		lowerTypeURLs[lower] = typeURL
+	}
+
+	return nil
+}
+
 // UnpackInterfaces is a convenience function that calls UnpackInterfaces
 // on x if x implements UnpackInterfacesMessage
 func UnpackInterfaces(x interface{}, unpacker AnyUnpacker) error {
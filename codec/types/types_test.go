@@ -88,6 +88,29 @@ func TestRegister(t *testing.T) {
 	)
 }
 
+// A struct whose typeURL differs from testdata.Dog's only by case.
+type CaseDog struct {
+	FakeDog
+}
+
+func (dog CaseDog) XXX_MessageName() string { return strings.ToLower(proto.MessageName(&testdata.Dog{})) }
+
+func TestValidateTypeURLs(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	registry.RegisterInterface("Animal", (*testdata.Animal)(nil), &testdata.Dog{}, &testdata.Cat{})
+	registry.RegisterInterface("Cartoon", (*testdata.Cartoon)(nil), &testdata.Bird{})
+	require.NoError(t, types.ValidateTypeURLs(registry))
+
+	// same typeURL registered for another concrete type under another interface
+	registry.RegisterImplementations((*proto.Message)(nil), &FakeDog{})
+	require.EqualError(t, types.ValidateTypeURLs(registry),
+		"duplicate type URL /testpb.Dog: registered for *testdata.Dog as an implementation of testdata.Animal and for *types_test.FakeDog")
+
+	registry = types.NewInterfaceRegistry()
+	registry.RegisterInterface("Animal", (*testdata.Animal)(nil), &testdata.Dog{}, &CaseDog{})
+	require.EqualError(t, types.ValidateTypeURLs(registry), "type URLs /testpb.Dog and /testpb.dog differ only by case")
+}
+
 func TestUnpackInterfaces(t *testing.T) {
 	registry := testdata.NewTestInterfaceRegistry()
 
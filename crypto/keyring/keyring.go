@@ -28,12 +28,13 @@ import (
 
 // Backend options for Keyring
 const (
-	BackendFile    = "file"
-	BackendOS      = "os"
-	BackendKWallet = "kwallet"
-	BackendPass    = "pass"
-	BackendTest    = "test"
-	BackendMemory  = "memory"
+	BackendFile     = "file"
+	BackendOS       = "os"
+	BackendOSNative = "os-native"
+	BackendKWallet  = "kwallet"
+	BackendPass     = "pass"
+	BackendTest     = "test"
+	BackendMemory   = "memory"
 )
 
 const (
@@ -191,6 +192,8 @@ func New(
 		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendOS:
 		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendOSNative:
+		db, err = openOSNativeBackend(appName)
 	case BackendKWallet:
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
@@ -0,0 +1,15 @@
+//go:build darwin
+
+package keyring
+
+import "github.com/99designs/keyring"
+
+// openOSNativeBackend opens the macOS Keychain, without falling back to an
+// encrypted file if it is unavailable.
+func openOSNativeBackend(appName string) (keyring.Keyring, error) {
+	return keyring.Open(keyring.Config{
+		AllowedBackends:          []keyring.BackendType{keyring.KeychainBackend},
+		ServiceName:              appName,
+		KeychainTrustApplication: true,
+	})
+}
@@ -0,0 +1,16 @@
+//go:build linux
+
+package keyring
+
+import "github.com/99designs/keyring"
+
+// openOSNativeBackend opens the Secret Service of the desktop session, such as
+// GNOME Keyring, without falling back to an encrypted file if it is
+// unavailable.
+func openOSNativeBackend(appName string) (keyring.Keyring, error) {
+	return keyring.Open(keyring.Config{
+		AllowedBackends:         []keyring.BackendType{keyring.SecretServiceBackend},
+		ServiceName:             appName,
+		LibSecretCollectionName: appName,
+	})
+}
@@ -0,0 +1,16 @@
+//go:build !darwin && !linux && !windows
+
+package keyring
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/99designs/keyring"
+)
+
+// openOSNativeBackend returns an error as there is no native secret store
+// supported on this operating system.
+func openOSNativeBackend(_ string) (keyring.Keyring, error) {
+	return nil, fmt.Errorf("keyring backend %s is not supported on %s", BackendOSNative, runtime.GOOS)
+}
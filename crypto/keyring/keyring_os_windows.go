@@ -0,0 +1,15 @@
+//go:build windows
+
+package keyring
+
+import "github.com/99designs/keyring"
+
+// openOSNativeBackend opens the Windows Credential Manager, without falling back
+// to an encrypted file if it is unavailable.
+func openOSNativeBackend(appName string) (keyring.Keyring, error) {
+	return keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.WinCredBackend},
+		ServiceName:     appName,
+		WinCredPrefix:   appName,
+	})
+}
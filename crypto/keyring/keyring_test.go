@@ -61,6 +61,26 @@ func TestNewKeyring(t *testing.T) {
 	require.Equal(t, "foo", k.Name)
 }
 
+func TestNewOSNativeKeyring(t *testing.T) {
+	cdc := getCodec()
+
+	kr, err := New("cosmos-test", BackendOSNative, t.TempDir(), nil, cdc)
+	if err != nil {
+		t.Skipf("native OS secret store is unavailable: %v", err)
+	}
+
+	k, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		t.Skipf("native OS secret store is unavailable: %v", err)
+	}
+	t.Cleanup(func() { _ = kr.Delete("foo") })
+	require.Equal(t, "foo", k.Name)
+
+	k, err = kr.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, "foo", k.Name)
+}
+
 func TestKeyManagementKeyRing(t *testing.T) {
 	cdc := getCodec()
 	tempDir := t.TempDir()
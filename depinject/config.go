@@ -158,6 +158,47 @@ func Supply(values ...interface{}) Config {
 	})
 }
 
+// ProvideAll defines a container configuration which registers T as a
+// many-per-container type, as if it implemented ManyPerContainerType: T and []T
+// can be declared as output parameters of providers as many times within the
+// container as desired, and all of the provided values for T can be retrieved
+// by declaring an []T input parameter. The provided values are added to the
+// values collected for T. ProvideAll must be declared before any provider of T.
+//
+// This allows collecting implementations of an interface from many modules,
+// e.g. all the event handlers declared by the modules of an app:
+//
+//	ProvideAll[EventHandler]()
+func ProvideAll[T any](values ...T) Config {
+	loc := LocationFromCaller(1)
+	return containerConfig(func(ctr *container) error {
+		typ := reflect.TypeOf((*T)(nil)).Elem()
+		return ctr.provideAll(typ, reflect.ValueOf(values), loc)
+	})
+}
+
+// StrictWiring defines a container configuration which makes the container fail
+// with an UnusedBindingError if a provided type is never injected into any other
+// provider, invoker or output. It is meant to be enabled during development, e.g.
+// behind a --strict-wiring flag, to detect dead wiring.
+func StrictWiring() Config {
+	return containerConfig(func(ctr *container) error {
+		ctr.strictWiring = true
+		return nil
+	})
+}
+
+// SuppressUnused defines a container configuration which allows T to be provided
+// without being injected when StrictWiring is enabled. It is meant for
+// intentionally provided singletons like metrics registries. Suppressing a
+// many-per-container or one-per-module type also suppresses its slice or map.
+func SuppressUnused[T any]() Config {
+	return containerConfig(func(ctr *container) error {
+		ctr.suppressedUnused[reflect.TypeOf((*T)(nil)).Elem()] = true
+		return nil
+	})
+}
+
 // Error defines configuration which causes the dependency injection container to
 // fail immediately.
 func Error(err error) Config {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/pkg/errors"
 
@@ -17,6 +18,16 @@ type container struct {
 	interfaceBindings map[string]interfaceBinding
 	invokers          []invoker
 
+	// manyPerContainerTypes are the types registered with ProvideAll which are
+	// grouped together like ManyPerContainerType types.
+	manyPerContainerTypes map[reflect.Type]bool
+
+	// strictWiring enables the detection of provided types which are never
+	// injected, see StrictWiring.
+	strictWiring     bool
+	suppressedUnused map[reflect.Type]bool
+	usedTypes        map[reflect.Type]bool
+
 	moduleKeyContext *ModuleKeyContext
 
 	resolveStack []resolveFrame
@@ -46,12 +57,15 @@ type interfaceBinding struct {
 
 func newContainer(cfg *debugConfig) *container {
 	return &container{
-		debugConfig:       cfg,
-		resolvers:         map[string]resolver{},
-		moduleKeyContext:  &ModuleKeyContext{},
-		interfaceBindings: map[string]interfaceBinding{},
-		callerStack:       nil,
-		callerMap:         map[Location]bool{},
+		debugConfig:           cfg,
+		resolvers:             map[string]resolver{},
+		moduleKeyContext:      &ModuleKeyContext{},
+		interfaceBindings:     map[string]interfaceBinding{},
+		manyPerContainerTypes: map[reflect.Type]bool{},
+		suppressedUnused:      map[reflect.Type]bool{},
+		usedTypes:             map[reflect.Type]bool{},
+		callerStack:           nil,
+		callerMap:             map[Location]bool{},
 	}
 }
 
@@ -108,13 +122,13 @@ func (c *container) getResolver(typ reflect.Type, key *moduleKey) (resolver, err
 	}
 
 	elemType := typ
-	if isManyPerContainerSliceType(elemType) || isOnePerModuleMapType(elemType) {
+	if c.isManyPerContainerSliceType(elemType) || isOnePerModuleMapType(elemType) {
 		elemType = elemType.Elem()
 	}
 
 	var typeGraphNode *graphviz.Node
 
-	if isManyPerContainerType(elemType) {
+	if c.isManyPerContainerType(elemType) {
 		c.logf("Registering resolver for many-per-container type %v", elemType)
 		sliceType := reflect.SliceOf(elemType)
 
@@ -219,7 +233,7 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 			hasOwnModuleKeyParam = true
 		}
 
-		if isManyPerContainerType(typ) {
+		if c.isManyPerContainerType(typ) {
 			return nil, fmt.Errorf("many-per-container type %v can't be used as an input parameter", typ)
 		} else if isOnePerModuleType(typ) {
 			return nil, fmt.Errorf("one-per-module type %v can't be used as an input parameter", typ)
@@ -263,7 +277,7 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 			}
 
 			// many-per-container slices of many-per-container types
-			if isManyPerContainerSliceType(typ) {
+			if c.isManyPerContainerSliceType(typ) {
 				typ = typ.Elem()
 			}
 
@@ -419,6 +433,7 @@ func (c *container) resolve(in providerInput, moduleKey *moduleKey, caller Locat
 	}
 
 	markGraphNodeAsUsed(typeGraphNode)
+	c.usedTypes[vr.getType()] = true
 
 	c.resolveStack = c.resolveStack[:len(c.resolveStack)-1]
 
@@ -498,6 +513,69 @@ func (c *container) build(loc Location, outputs ...interface{}) error {
 	return nil
 }
 
+// checkUnusedBindings returns an UnusedBindingError for the first provided type,
+// in type name order, which was never injected into any provider, invoker or
+// output and was not suppressed with SuppressUnused.
+func (c *container) checkUnusedBindings() error {
+	var unused []resolver
+	seen := map[reflect.Type]bool{}
+	for _, r := range c.resolvers {
+		typ := r.getType()
+		if seen[typ] {
+			continue
+		}
+		seen[typ] = true
+
+		// suppressed types also cover the slices and maps collecting them
+		elemType := typ
+		switch r := r.(type) {
+		case *simpleResolver, *moduleDepResolver:
+		case *groupResolver:
+			if len(r.providers) == 0 {
+				continue
+			}
+			elemType = r.typ
+		case *sliceGroupResolver:
+			if len(r.providers) == 0 {
+				continue
+			}
+			elemType = r.typ
+		case *onePerModuleResolver:
+			if len(r.providers) == 0 {
+				continue
+			}
+			elemType = r.typ
+		case *mapOfOnePerModuleResolver:
+			if len(r.providers) == 0 {
+				continue
+			}
+			elemType = r.typ
+		default:
+			// supplied values are not provided by any provider
+			continue
+		}
+
+		if c.usedTypes[typ] || c.suppressedUnused[typ] || c.suppressedUnused[elemType] {
+			continue
+		}
+
+		unused = append(unused, r)
+	}
+
+	if len(unused) == 0 {
+		return nil
+	}
+
+	sort.Slice(unused, func(i, j int) bool {
+		return fullyQualifiedTypeName(unused[i].getType()) < fullyQualifiedTypeName(unused[j].getType())
+	})
+
+	return UnusedBindingError{
+		Type:     unused[0].getType(),
+		Location: unused[0].describeLocation(),
+	}
+}
+
 func (c container) formatResolveStack() string {
 	buf := &bytes.Buffer{}
 	_, _ = fmt.Fprintf(buf, "\twhile resolving:\n")
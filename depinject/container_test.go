@@ -3,6 +3,7 @@ package depinject_test
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -546,6 +547,87 @@ func TestManyPerContainer(t *testing.T) {
 	)
 }
 
+type EventHandler interface {
+	HandleEvent() string
+}
+
+type NamedEventHandler string
+
+func (h NamedEventHandler) HandleEvent() string { return string(h) }
+
+func ProvideBankEventHandler() EventHandler    { return NamedEventHandler("bank") }
+func ProvideStakingEventHandler() EventHandler { return NamedEventHandler("staking") }
+func ProvideGovEventHandlers() []EventHandler {
+	return []EventHandler{NamedEventHandler("gov"), NamedEventHandler("gov-v1")}
+}
+
+func CollectEventHandlers(handlers []EventHandler) int { return len(handlers) }
+
+func TestProvideAll(t *testing.T) {
+	var handlers []EventHandler
+	var count int
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.ProvideAll[EventHandler](NamedEventHandler("app")),
+				depinject.Provide(
+					ProvideBankEventHandler,
+					ProvideGovEventHandlers,
+					CollectEventHandlers,
+				),
+				depinject.ProvideInModule("staking", ProvideStakingEventHandler),
+			),
+			&handlers,
+			&count,
+		),
+	)
+	require.Len(t, handlers, 5)
+	require.Equal(t, 5, count)
+	for _, name := range []string{"app", "bank", "staking", "gov", "gov-v1"} {
+		require.Contains(t, handlers, NamedEventHandler(name))
+	}
+
+	require.NoError(t,
+		depinject.Inject(depinject.ProvideAll[EventHandler](), &handlers),
+		"no providers",
+	)
+	require.Empty(t, handlers)
+
+	var handler EventHandler
+	require.Error(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.ProvideAll[EventHandler](),
+				depinject.Provide(ProvideBankEventHandler),
+			),
+			&handler,
+		),
+		"bad input type",
+	)
+
+	require.ErrorContains(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.Provide(ProvideBankEventHandler),
+				depinject.ProvideAll[EventHandler](),
+			),
+			&handlers,
+		),
+		"already provided by",
+	)
+
+	require.ErrorContains(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.ProvideAll[EventHandler](),
+				depinject.ProvideAll[EventHandler](),
+			),
+			&handlers,
+		),
+		"already registered as a many-per-container type",
+	)
+}
+
 func TestSupply(t *testing.T) {
 	var x int
 	require.NoError(t,
@@ -587,6 +669,110 @@ func TestSupply(t *testing.T) {
 	)
 }
 
+type MetricsRegistry struct{}
+
+func ProvideString() string                   { return "provided" }
+func ProvideLenFromString(s string) int       { return len(s) }
+func ProvideMetricsRegistry() MetricsRegistry { return MetricsRegistry{} }
+func ProvideKeeperA() KeeperA                 { return KeeperA{} }
+func InvokeStringAndInt(string, int)          {}
+
+func TestStrictWiring(t *testing.T) {
+	var x int
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Provide(Provide1, ProvideString),
+			&x,
+		),
+		"unused bindings are allowed without strict wiring",
+	)
+
+	err := depinject.Inject(
+		depinject.Configs(
+			depinject.StrictWiring(),
+			depinject.Provide(Provide1, ProvideString),
+		),
+		&x,
+	)
+	var unusedErr depinject.UnusedBindingError
+	require.ErrorAs(t, err, &unusedErr)
+	require.Equal(t, reflect.TypeOf(""), unusedErr.Type)
+	require.ErrorContains(t, err, "string provided by")
+
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.StrictWiring(),
+				depinject.Provide(ProvideLenFromString, ProvideString),
+			),
+			&x,
+		),
+	)
+	require.Equal(t, len("provided"), x)
+
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.StrictWiring(),
+				depinject.SuppressUnused[MetricsRegistry](),
+				depinject.Provide(Provide1, ProvideMetricsRegistry),
+			),
+			&x,
+		),
+	)
+
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.StrictWiring(),
+				depinject.Provide(Provide1, ProvideString),
+				depinject.Invoke(InvokeStringAndInt),
+			),
+			&x,
+		),
+		"invoker inputs are injected",
+	)
+
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.StrictWiring(),
+				depinject.Provide(Provide1),
+				depinject.Supply("supplied"),
+			),
+			&x,
+		),
+		"supplied values are not reported",
+	)
+
+	require.ErrorAs(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.StrictWiring(),
+				depinject.ProvideAll[EventHandler](),
+				depinject.Provide(Provide1, ProvideBankEventHandler),
+			),
+			&x,
+		),
+		&unusedErr,
+	)
+	require.Equal(t, reflect.TypeOf([]EventHandler{}), unusedErr.Type)
+
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.StrictWiring(),
+				depinject.SuppressUnused[KeeperA](),
+				depinject.SuppressUnused[EventHandler](),
+				depinject.ProvideAll[EventHandler](),
+				depinject.Provide(Provide1, ProvideKeeperA, ProvideBankEventHandler),
+			),
+			&x,
+		),
+		"suppressed many-per-container types",
+	)
+}
+
 type TestInput struct {
 	depinject.In
 
@@ -68,3 +68,15 @@ func duplicateDefinitionError(typ reflect.Type, duplicateLoc Location, existingL
 	return errors.Errorf("duplicate provision of type %v by %s\n\talready provided by %s",
 		typ, duplicateLoc, existingLoc)
 }
+
+// UnusedBindingError defines an error condition where a type was provided to a
+// container built with StrictWiring but was never injected into any other
+// component.
+type UnusedBindingError struct {
+	Type     reflect.Type
+	Location string
+}
+
+func (err UnusedBindingError) Error() string {
+	return fmt.Sprintf("%v provided by %s is never injected, use SuppressUnused to allow it", err.Type, err.Location)
+}
@@ -28,6 +28,42 @@ func isManyPerContainerSliceType(typ reflect.Type) bool {
 	return typ.Kind() == reflect.Slice && isManyPerContainerType(typ.Elem())
 }
 
+// isManyPerContainerType returns true if t is a ManyPerContainerType or was
+// registered as a many-per-container type with ProvideAll.
+func (c *container) isManyPerContainerType(t reflect.Type) bool {
+	return isManyPerContainerType(t) || c.manyPerContainerTypes[t]
+}
+
+func (c *container) isManyPerContainerSliceType(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Slice && c.isManyPerContainerType(typ.Elem())
+}
+
+// provideAll registers typ as a many-per-container type and adds the provided
+// values to its group.
+func (c *container) provideAll(typ reflect.Type, values reflect.Value, loc Location) error {
+	if c.manyPerContainerTypes[typ] {
+		return errors.Errorf("%v is already registered as a many-per-container type by ProvideAll", typ)
+	}
+
+	if existing, ok := c.resolverByType(typ); ok {
+		if _, isGroup := existing.(*groupResolver); !isGroup {
+			return errors.Errorf("%v cannot be registered as a many-per-container type, it is already provided by %s",
+				typ, existing.describeLocation())
+		}
+	}
+
+	c.manyPerContainerTypes[typ] = true
+
+	_, err := c.addNode(&providerDescriptor{
+		Outputs: []providerOutput{{Type: values.Type()}},
+		Fn: func([]reflect.Value) ([]reflect.Value, error) {
+			return []reflect.Value{values}, nil
+		},
+		Location: loc,
+	}, nil)
+	return err
+}
+
 type groupResolver struct {
 	typ          reflect.Type
 	sliceType    reflect.Type
@@ -78,5 +78,13 @@ func doInject(cfg *debugConfig, loc Location, debugOpt DebugOption, config Confi
 	}
 	cfg.dedentLogger()
 
-	return ctr.build(loc, outputs...)
+	if err := ctr.build(loc, outputs...); err != nil {
+		return err
+	}
+
+	if ctr.strictWiring {
+		return ctr.checkUnusedBindings()
+	}
+
+	return nil
 }
@@ -125,6 +125,10 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	a.SetMigrationChainValidator(func() error {
+		return a.ModuleManager.ValidateMigrationChains(a.configurator)
+	})
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
@@ -195,6 +199,11 @@ func (a *App) Configurator() module.Configurator {
 	return a.configurator
 }
 
+// RegisterInvariants registers the invariants of all app modules with the given registry.
+func (a *App) RegisterInvariants(ir sdk.InvariantRegistry) {
+	a.ModuleManager.RegisterInvariants(ir)
+}
+
 // LoadHeight loads a particular height
 func (a *App) LoadHeight(height int64) error {
 	return a.LoadVersion(height)
@@ -6,6 +6,9 @@
 
 set -e
 
+echo "Installing the event builder plugin"
+go install ./types/events/cmd/protoc-gen-go-event-builder
+
 echo "Generating gogo proto code"
 cd proto
 proto_dirs=$(find ./cosmos ./amino -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -84,6 +85,11 @@ type BaseConfig struct {
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
 
+	// IndexedAttributes defines, per event type, the attribute keys which inform
+	// Tendermint what to index, in addition to IndexEvents. Each entry is an event
+	// type followed by its attribute keys.
+	IndexedAttributes [][]string `mapstructure:"indexed-attributes"`
+
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
@@ -93,6 +99,11 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// ABCIQueryTimeout defines the duration after which a gRPC query received
+	// through ABCI is abandoned so that it does not block block processing.
+	// A value of 0 disables the timeout.
+	ABCIQueryTimeout time.Duration `mapstructure:"abci-query-timeout"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -211,6 +222,17 @@ type MempoolConfig struct {
 	MaxTxs int `mapstructure:"max-txs"`
 }
 
+// IAVLConfig defines the IAVL cache configuration of the individual stores.
+type IAVLConfig struct {
+	// DefaultCacheSize defines the IAVL cache size (in number of nodes) of the
+	// stores without a dedicated entry. Zero falls back to iavl-cache-size.
+	DefaultCacheSize uint64 `mapstructure:"default_cache_size"`
+
+	// StoreCacheSizes defines the IAVL cache size of individual stores, set with
+	// "<store>_cache_size" entries.
+	StoreCacheSizes map[string]uint64 `mapstructure:",remain"`
+}
+
 type (
 	// StoreConfig defines application configuration for state streaming and other
 	// storage related operations.
@@ -256,6 +278,7 @@ type Config struct {
 	Store     StoreConfig      `mapstructure:"store"`
 	Streamers StreamersConfig  `mapstructure:"streamers"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	IAVL      IAVLConfig       `mapstructure:"iavl"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -288,6 +311,7 @@ func DefaultConfig() *Config {
 			PruningInterval:     "0",
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
+			IndexedAttributes:   make([][]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
@@ -347,6 +371,9 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
+		IAVL: IAVLConfig{
+			DefaultCacheSize: 0,
+		},
 	}
 }
 
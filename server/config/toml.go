@@ -70,6 +70,15 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 
+# IndexedAttributes defines, per event type, the attribute keys which inform
+# Tendermint what to index, in addition to index-events. Each entry is an event
+# type followed by its attribute keys. When it or index-events is not empty, the
+# other attributes are not indexed but remain part of the block results.
+#
+# Example:
+# [["transfer", "sender", "recipient"], ["cosmos.bank.v1beta1.EventSend", "from"]]
+indexed-attributes = [{{ range .BaseConfig.IndexedAttributes }}[{{ range . }}{{ printf "%q, " . }}{{end}}], {{end}}]
+
 # IavlCacheSize set the size of the iavl tree cache (in number of nodes).
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
@@ -81,6 +90,11 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# ABCIQueryTimeout defines the duration after which a gRPC query received through
+# ABCI is abandoned, returning a query timeout error, so that long-running queries
+# do not block block processing. A value of 0 disables the timeout.
+abci-query-timeout = "{{ .BaseConfig.ABCIQueryTimeout }}"
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in Tendermint's config.toml.
@@ -273,7 +287,20 @@ fsync = "{{ .Streamers.File.Fsync }}"
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
-`
+
+###############################################################################
+###                         IAVL Cache Configuration                        ###
+###############################################################################
+
+[iavl]
+# default_cache_size defines the IAVL cache size (in number of nodes) of the stores
+# without a dedicated entry. Setting it to 0 falls back to iavl-cache-size.
+default_cache_size = {{ .IAVL.DefaultCacheSize }}
+
+# The IAVL cache size of individual stores is set with "<store>_cache_size" entries,
+# e.g. bank_cache_size = 100000.
+{{ range $key, $size := .IAVL.StoreCacheSizes }}{{ $key }} = {{ $size }}
+{{ end }}`
 
 var configTemplate *template.Template
 
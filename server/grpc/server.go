@@ -12,7 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	statesync "github.com/cosmos/cosmos-sdk/server/grpc/statesync/v1beta1"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino" // Import amino.proto file for reflection
 )
@@ -56,6 +58,12 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, cfg config
 		return nil, err
 	}
 
+	// Serve the state sync snapshots of the node over the gRPC port, if the
+	// application takes snapshots.
+	if snapshotApp, ok := app.(interface{ SnapshotManager() *snapshots.Manager }); ok && snapshotApp.SnapshotManager() != nil {
+		statesync.Register(grpcSrv, snapshotApp.SnapshotManager())
+	}
+
 	// Reflection allows external clients to see what services and methods
 	// the gRPC server exposes.
 	gogoreflection.Register(grpcSrv)
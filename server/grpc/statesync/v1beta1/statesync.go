@@ -0,0 +1,85 @@
+package v1beta1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// Register registers the state sync service, serving the snapshots of the given
+// manager, to the provided *grpc.Server.
+func Register(srv *grpc.Server, manager *snapshots.Manager) {
+	RegisterStateSyncServiceServer(srv, stateSyncServiceServer{manager: manager})
+}
+
+type stateSyncServiceServer struct {
+	manager *snapshots.Manager
+}
+
+var _ StateSyncServiceServer = stateSyncServiceServer{}
+
+func (s stateSyncServiceServer) ListSnapshots(_ context.Context, _ *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	snapshots, err := s.manager.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	return &ListSnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+func (s stateSyncServiceServer) SnapshotChunks(req *SnapshotChunksRequest, stream StateSyncService_SnapshotChunksServer) error {
+	snapshot, err := s.findSnapshot(req.SnapshotHeight, req.Format)
+	if err != nil {
+		return err
+	}
+
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		data, err := s.manager.LoadChunk(snapshot.Height, snapshot.Format, index)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load chunk %d of snapshot at height %d: %v", index, snapshot.Height, err)
+		}
+
+		// the snapshot may have been pruned since it was listed
+		if data == nil {
+			return status.Errorf(codes.NotFound, "chunk %d of snapshot at height %d not found", index, snapshot.Height)
+		}
+
+		if err := stream.Send(&SnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Index:  index,
+			Chunks: snapshot.Chunks,
+			Data:   data,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findSnapshot returns the most recent snapshot matching the given height and
+// format, a zero value matching any height or format.
+func (s stateSyncServiceServer) findSnapshot(height uint64, format uint32) (*snapshottypes.Snapshot, error) {
+	snapshots, err := s.manager.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	for _, snapshot := range snapshots {
+		if (height == 0 || snapshot.Height == height) && (format == 0 || snapshot.Format == format) {
+			return snapshot, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "no snapshot found at height %d with format %d", height, format)
+}
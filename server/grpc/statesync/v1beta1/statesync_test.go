@@ -0,0 +1,147 @@
+package v1beta1_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	db "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	statesync "github.com/cosmos/cosmos-sdk/server/grpc/statesync/v1beta1"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func makeChunks(chunks [][]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func setupClient(t *testing.T) statesync.StateSyncServiceClient {
+	t.Helper()
+
+	store, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.Save(1, snapshottypes.CurrentFormat, makeChunks([][]byte{{1, 1}, {1, 2}}))
+	require.NoError(t, err)
+	_, err = store.Save(2, snapshottypes.CurrentFormat, makeChunks([][]byte{{2, 1}, {2, 2}, {2, 3}}))
+	require.NoError(t, err)
+
+	manager := snapshots.NewManager(store, snapshottypes.NewSnapshotOptions(1, 2), nil, nil, log.NewNopLogger())
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer()
+	statesync.Register(grpcSrv, manager)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return statesync.NewStateSyncServiceClient(conn)
+}
+
+func receiveChunks(t *testing.T, stream statesync.StateSyncService_SnapshotChunksClient) ([]*statesync.SnapshotChunk, error) {
+	t.Helper()
+
+	var chunks []*statesync.SnapshotChunk
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return chunks, nil
+		}
+		if err != nil {
+			return chunks, err
+		}
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	client := setupClient(t)
+
+	res, err := client.ListSnapshots(context.Background(), &statesync.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 2)
+	require.Equal(t, uint64(2), res.Snapshots[0].Height)
+	require.Equal(t, uint32(3), res.Snapshots[0].Chunks)
+	require.Equal(t, uint64(1), res.Snapshots[1].Height)
+}
+
+func TestSnapshotChunks(t *testing.T) {
+	client := setupClient(t)
+
+	testCases := []struct {
+		name       string
+		req        *statesync.SnapshotChunksRequest
+		expHeight  uint64
+		expChunks  [][]byte
+		expErrCode codes.Code
+	}{
+		{
+			name:      "latest snapshot",
+			req:       &statesync.SnapshotChunksRequest{},
+			expHeight: 2,
+			expChunks: [][]byte{{2, 1}, {2, 2}, {2, 3}},
+		},
+		{
+			name:      "snapshot at height",
+			req:       &statesync.SnapshotChunksRequest{SnapshotHeight: 1, Format: snapshottypes.CurrentFormat},
+			expHeight: 1,
+			expChunks: [][]byte{{1, 1}, {1, 2}},
+		},
+		{
+			name:       "unknown height",
+			req:        &statesync.SnapshotChunksRequest{SnapshotHeight: 3},
+			expErrCode: codes.NotFound,
+		},
+		{
+			name:       "unknown format",
+			req:        &statesync.SnapshotChunksRequest{SnapshotHeight: 1, Format: snapshottypes.CurrentFormat + 1},
+			expErrCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			stream, err := client.SnapshotChunks(context.Background(), tc.req)
+			require.NoError(t, err)
+
+			chunks, err := receiveChunks(t, stream)
+			if tc.expErrCode != codes.OK {
+				require.Equal(t, tc.expErrCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, chunks, len(tc.expChunks))
+			for i, chunk := range chunks {
+				require.Equal(t, tc.expHeight, chunk.Height)
+				require.Equal(t, snapshottypes.CurrentFormat, chunk.Format)
+				require.Equal(t, uint32(i), chunk.Index)
+				require.Equal(t, uint32(len(tc.expChunks)), chunk.Chunks)
+				require.Equal(t, tc.expChunks[i], chunk.Data)
+			}
+		})
+	}
+}
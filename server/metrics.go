@@ -0,0 +1,146 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// ABCI handler names used as the handler label of the ABCI latency histogram.
+const (
+	ABCIHandlerInitChain       = "InitChain"
+	ABCIHandlerQuery           = "Query"
+	ABCIHandlerCheckTx         = "CheckTx"
+	ABCIHandlerPrepareProposal = "PrepareProposal"
+	ABCIHandlerProcessProposal = "ProcessProposal"
+	ABCIHandlerBeginBlock      = "BeginBlock"
+	ABCIHandlerDeliverTx       = "DeliverTx"
+	ABCIHandlerEndBlock        = "EndBlock"
+	ABCIHandlerCommit          = "Commit"
+)
+
+// ABCIHandlerDurationBuckets defines the bucket boundaries, in seconds, of the
+// ABCI handler latency histogram.
+var ABCIHandlerDurationBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10}
+
+// ABCIMetrics defines the Prometheus metrics tracking the latency of the ABCI
+// handlers of an application.
+type ABCIMetrics struct {
+	HandlerDuration *prometheus.HistogramVec
+}
+
+// NewABCIMetrics returns the ABCI metrics, which must be registered before
+// being exposed.
+func NewABCIMetrics() *ABCIMetrics {
+	return &ABCIMetrics{
+		HandlerDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "abci_handler_duration_seconds",
+			Help:    "Duration of the ABCI handlers of the application in seconds.",
+			Buckets: ABCIHandlerDurationBuckets,
+		}, []string{"handler"}),
+	}
+}
+
+// Register registers the ABCI metrics with the telemetry Prometheus registry.
+// If they are already registered, the registered metrics are reused.
+func (m *ABCIMetrics) Register() error {
+	if err := telemetry.RegisterCollector(m.HandlerDuration); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			return err
+		}
+
+		existing, ok := are.ExistingCollector.(*prometheus.HistogramVec)
+		if !ok {
+			return err
+		}
+
+		m.HandlerDuration = existing
+	}
+
+	return nil
+}
+
+func (m *ABCIMetrics) observeSince(handler string, start time.Time) {
+	m.HandlerDuration.WithLabelValues(handler).Observe(time.Since(start).Seconds())
+}
+
+// metricsApplication wraps an ABCI application, observing the duration of its
+// ABCI handlers.
+type metricsApplication struct {
+	abci.Application
+	metrics *ABCIMetrics
+}
+
+var _ abci.Application = metricsApplication{}
+
+// NewABCIMetricsApplication returns an ABCI application recording the latency
+// of the ABCI handlers of the given application in the given metrics.
+func NewABCIMetricsApplication(app abci.Application, metrics *ABCIMetrics) abci.Application {
+	return metricsApplication{Application: app, metrics: metrics}
+}
+
+// abciApplication returns the application served to CometBFT, recording the
+// latency of its ABCI handlers when telemetry is enabled.
+func abciApplication(app abci.Application, metrics *telemetry.Metrics) (abci.Application, error) {
+	if metrics == nil {
+		return app, nil
+	}
+
+	abciMetrics := NewABCIMetrics()
+	if err := abciMetrics.Register(); err != nil {
+		return nil, fmt.Errorf("failed to register ABCI metrics: %w", err)
+	}
+
+	return NewABCIMetricsApplication(app, abciMetrics), nil
+}
+
+func (app metricsApplication) InitChain(req abci.RequestInitChain) abci.ResponseInitChain {
+	defer app.metrics.observeSince(ABCIHandlerInitChain, time.Now())
+	return app.Application.InitChain(req)
+}
+
+func (app metricsApplication) Query(req abci.RequestQuery) abci.ResponseQuery {
+	defer app.metrics.observeSince(ABCIHandlerQuery, time.Now())
+	return app.Application.Query(req)
+}
+
+func (app metricsApplication) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	defer app.metrics.observeSince(ABCIHandlerCheckTx, time.Now())
+	return app.Application.CheckTx(req)
+}
+
+func (app metricsApplication) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	defer app.metrics.observeSince(ABCIHandlerPrepareProposal, time.Now())
+	return app.Application.PrepareProposal(req)
+}
+
+func (app metricsApplication) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	defer app.metrics.observeSince(ABCIHandlerProcessProposal, time.Now())
+	return app.Application.ProcessProposal(req)
+}
+
+func (app metricsApplication) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	defer app.metrics.observeSince(ABCIHandlerBeginBlock, time.Now())
+	return app.Application.BeginBlock(req)
+}
+
+func (app metricsApplication) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	defer app.metrics.observeSince(ABCIHandlerDeliverTx, time.Now())
+	return app.Application.DeliverTx(req)
+}
+
+func (app metricsApplication) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	defer app.metrics.observeSince(ABCIHandlerEndBlock, time.Now())
+	return app.Application.EndBlock(req)
+}
+
+func (app metricsApplication) Commit() abci.ResponseCommit {
+	defer app.metrics.observeSince(ABCIHandlerCommit, time.Now())
+	return app.Application.Commit()
+}
@@ -0,0 +1,82 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestABCIMetricsApplication(t *testing.T) {
+	baseApp, closer, err := mock.SetupApp()
+	if closer != nil {
+		defer closer()
+	}
+	require.NoError(t, err)
+
+	metrics := server.NewABCIMetrics()
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(metrics.HandlerDuration))
+
+	app := server.NewABCIMetricsApplication(baseApp, metrics)
+
+	appState, err := mock.AppGenState(nil, types.GenesisDoc{}, nil)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+
+	const (
+		numBlocks      = 2
+		numTxsPerBlock = 3
+	)
+
+	for height := int64(1); height <= numBlocks; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+		for i := 0; i < numTxsPerBlock; i++ {
+			tx := mock.NewTx(fmt.Sprintf("key-%d-%d", height, i), "value", sdk.AccAddress("addr"))
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: tx.GetSignBytes()})
+			require.Equal(t, uint32(0), res.Code, res.Log)
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Equal(t, "abci_handler_duration_seconds", families[0].GetName())
+	require.Equal(t, dto.MetricType_HISTOGRAM, families[0].GetType())
+
+	counts := make(map[string]uint64)
+	for _, m := range families[0].GetMetric() {
+		require.Len(t, m.GetLabel(), 1)
+		require.Equal(t, "handler", m.GetLabel()[0].GetName())
+
+		histogram := m.GetHistogram()
+		buckets := histogram.GetBucket()
+		require.Len(t, buckets, len(server.ABCIHandlerDurationBuckets))
+		for i, bucket := range buckets {
+			require.Equal(t, server.ABCIHandlerDurationBuckets[i], bucket.GetUpperBound())
+		}
+
+		counts[m.GetLabel()[0].GetValue()] = histogram.GetSampleCount()
+	}
+
+	require.Equal(t, map[string]uint64{
+		server.ABCIHandlerInitChain:  1,
+		server.ABCIHandlerBeginBlock: numBlocks,
+		server.ABCIHandlerDeliverTx:  numBlocks * numTxsPerBlock,
+		server.ABCIHandlerEndBlock:   numBlocks,
+		server.ABCIHandlerCommit:     numBlocks,
+	}, counts)
+}
@@ -58,10 +58,14 @@ const (
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
 	FlagIndexEvents         = "index-events"
+	FlagIndexedAttributes   = "indexed-attributes"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagCommitWAL           = "commit-wal"
+	FlagABCIQueryTimeout    = "abci-query-timeout"
+	FlagStoreMetrics        = "store-metrics"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -200,6 +204,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagCommitWAL, false, "Record the application commits in a write-ahead log to recover interrupted commits on restart")
+	cmd.Flags().Bool(FlagStoreMetrics, false, "Expose the per block read and write operation counts of each store as Prometheus counters (telemetry must be enabled)")
+	cmd.Flags().Duration(FlagABCIQueryTimeout, 0, "Duration after which a gRPC query received through ABCI is abandoned (0 disables the timeout)")
 
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 
@@ -224,6 +231,12 @@ func startStandAlone(ctx *Context, clientCtx client.Context, appCreator types.Ap
 		return err
 	}
 
+	// check the IAVL cache configuration before creating the app, as
+	// DefaultBaseappOptions cannot return an error
+	if _, _, err := GetIAVLCacheSizes(ctx.Viper); err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	config, err := serverconfig.GetConfig(ctx.Viper)
@@ -289,7 +302,12 @@ func startStandAlone(ctx *Context, clientCtx client.Context, appCreator types.Ap
 		}
 	}
 
-	svr, err := server.NewServer(addr, transport, app)
+	abciApp, err := abciApplication(app, metrics)
+	if err != nil {
+		return err
+	}
+
+	svr, err := server.NewServer(addr, transport, abciApp)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
 	}
@@ -350,6 +368,12 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	// check the IAVL cache configuration before creating the app, as
+	// DefaultBaseappOptions cannot return an error
+	if _, _, err := GetIAVLCacheSizes(ctx.Viper); err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -358,6 +382,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
 
+	metrics, err := startTelemetry(config)
+	if err != nil {
+		return err
+	}
+
 	var (
 		tmNode   *node.Node
 		gRPCOnly = ctx.Viper.GetBool(flagGRPCOnly)
@@ -369,11 +398,16 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	} else {
 		ctx.Logger.Info("starting node with ABCI Tendermint in-process")
 
+		abciApp, err := abciApplication(app, metrics)
+		if err != nil {
+			return err
+		}
+
 		tmNode, err = node.NewNode(
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 			nodeKey,
-			proxy.NewLocalClientCreator(app),
+			proxy.NewLocalClientCreator(abciApp),
 			genDocProvider,
 			node.DefaultDBProvider,
 			node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -401,11 +435,6 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		app.RegisterNodeService(clientCtx)
 	}
 
-	metrics, err := startTelemetry(config)
-	if err != nil {
-		return err
-	}
-
 	apiSrv, err := startAPIserver(config, genDocProvider, clientCtx, home, ctx, app, metrics)
 	if err != nil {
 		return err
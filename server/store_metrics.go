@@ -0,0 +1,242 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var (
+	_ storetypes.MultiStorePersistentCache = (*StoreOperationMetrics)(nil)
+	_ baseapp.ABCIListener                 = (*StoreOperationMetrics)(nil)
+	_ storetypes.CommitKVStore             = (*countingStore)(nil)
+)
+
+// StoreOperationMetrics counts the read and write operations performed on each
+// committed store of an application during a block, and adds them to the
+// store_reads_total and store_writes_total Prometheus counters once the block
+// is committed.
+//
+// It wraps each CommitKVStore with a counting decorator as an inter-block
+// cache, itself wrapping the stores with the given inter-block cache if any,
+// and resets its counts at the start of each block as an ABCI listener. Reads
+// served by the transaction caches do not reach the committed stores and are
+// not counted, neither are queries which use immutable versions of the stores.
+type StoreOperationMetrics struct {
+	Reads  *prometheus.CounterVec
+	Writes *prometheus.CounterVec
+
+	cache  storetypes.MultiStorePersistentCache
+	mu     sync.Mutex
+	stores map[string]*countingStore
+}
+
+// NewStoreOperationMetrics returns the store operation metrics, wrapping the
+// stores with the given inter-block cache, which may be nil. The metrics must
+// be registered before being exposed.
+func NewStoreOperationMetrics(cache storetypes.MultiStorePersistentCache) *StoreOperationMetrics {
+	return &StoreOperationMetrics{
+		Reads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "store_reads_total",
+			Help: "Number of read operations performed on the committed store.",
+		}, []string{"store"}),
+		Writes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "store_writes_total",
+			Help: "Number of write operations performed on the committed store.",
+		}, []string{"store"}),
+		cache:  cache,
+		stores: make(map[string]*countingStore),
+	}
+}
+
+// Register registers the store operation metrics with the telemetry Prometheus
+// registry. If they are already registered, the registered metrics are reused.
+func (m *StoreOperationMetrics) Register() error {
+	reads, err := registerCounterVec(m.Reads)
+	if err != nil {
+		return err
+	}
+
+	writes, err := registerCounterVec(m.Writes)
+	if err != nil {
+		return err
+	}
+
+	m.Reads, m.Writes = reads, writes
+	return nil
+}
+
+func registerCounterVec(c *prometheus.CounterVec) (*prometheus.CounterVec, error) {
+	if err := telemetry.RegisterCollector(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			return nil, err
+		}
+
+		existing, ok := are.ExistingCollector.(*prometheus.CounterVec)
+		if !ok {
+			return nil, err
+		}
+
+		return existing, nil
+	}
+
+	return c, nil
+}
+
+// BlockOperations returns the number of read and write operations performed on
+// the named store during the current block.
+func (m *StoreOperationMetrics) BlockOperations(storeName string) (reads, writes uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store, ok := m.stores[storeName]
+	if !ok {
+		return 0, 0
+	}
+	return atomic.LoadUint64(&store.reads), atomic.LoadUint64(&store.writes)
+}
+
+// GetStoreCache implements MultiStorePersistentCache, wrapping the store with
+// the inter-block cache, if any, and the counting decorator.
+func (m *StoreOperationMetrics) GetStoreCache(key storetypes.StoreKey, store storetypes.CommitKVStore) storetypes.CommitKVStore {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	parent := store
+	if m.cache != nil {
+		store = m.cache.GetStoreCache(key, store)
+	}
+
+	counting := &countingStore{CommitKVStore: store, parent: parent}
+	m.stores[key.Name()] = counting
+
+	return counting
+}
+
+// Unwrap implements MultiStorePersistentCache, returning the store wrapped by
+// the counting decorator and the inter-block cache.
+func (m *StoreOperationMetrics) Unwrap(key storetypes.StoreKey) storetypes.CommitKVStore {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if store, ok := m.stores[key.Name()]; ok {
+		return store.parent
+	}
+
+	return nil
+}
+
+// Reset implements MultiStorePersistentCache, resetting the wrapped inter-block
+// cache, if any.
+func (m *StoreOperationMetrics) Reset() {
+	if m.cache != nil {
+		m.cache.Reset()
+	}
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener, resetting the counts of the
+// store operations.
+func (m *StoreOperationMetrics) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, store := range m.stores {
+		atomic.StoreUint64(&store.reads, 0)
+		atomic.StoreUint64(&store.writes, 0)
+	}
+
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (m *StoreOperationMetrics) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (m *StoreOperationMetrics) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener, adding the store operations of
+// the committed block, including the writes of the commit, to the counters.
+func (m *StoreOperationMetrics) ListenCommit(context.Context, abci.ResponseCommit) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, store := range m.stores {
+		m.Reads.WithLabelValues(name).Add(float64(atomic.LoadUint64(&store.reads)))
+		m.Writes.WithLabelValues(name).Add(float64(atomic.LoadUint64(&store.writes)))
+	}
+
+	return nil
+}
+
+// countingStore is a CommitKVStore decorator counting its read and write
+// operations.
+type countingStore struct {
+	storetypes.CommitKVStore
+	parent storetypes.CommitKVStore
+
+	reads  uint64
+	writes uint64
+}
+
+// Get implements KVStore.
+func (s *countingStore) Get(key []byte) []byte {
+	atomic.AddUint64(&s.reads, 1)
+	return s.CommitKVStore.Get(key)
+}
+
+// Has implements KVStore.
+func (s *countingStore) Has(key []byte) bool {
+	atomic.AddUint64(&s.reads, 1)
+	return s.CommitKVStore.Has(key)
+}
+
+// Iterator implements KVStore, counting the iterator as a single read.
+func (s *countingStore) Iterator(start, end []byte) storetypes.Iterator {
+	atomic.AddUint64(&s.reads, 1)
+	return s.CommitKVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore, counting the iterator as a single read.
+func (s *countingStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	atomic.AddUint64(&s.reads, 1)
+	return s.CommitKVStore.ReverseIterator(start, end)
+}
+
+// Set implements KVStore.
+func (s *countingStore) Set(key, value []byte) {
+	atomic.AddUint64(&s.writes, 1)
+	s.CommitKVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *countingStore) Delete(key []byte) {
+	atomic.AddUint64(&s.writes, 1)
+	s.CommitKVStore.Delete(key)
+}
+
+// CacheWrap implements the CacheWrapper interface, branching the counting
+// store rather than the wrapped one.
+func (s *countingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *countingStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
@@ -0,0 +1,133 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	metricsStoreKey1 = storetypes.NewKVStoreKey("store1")
+	metricsStoreKey2 = storetypes.NewKVStoreKey("store2")
+)
+
+func TestStoreOperationMetrics(t *testing.T) {
+	metrics := server.NewStoreOperationMetrics(cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize))
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(metrics.Reads))
+	require.NoError(t, registry.Register(metrics.Writes))
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	ms.SetInterBlockCache(metrics)
+	ms.MountStoreWithDB(metricsStoreKey1, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(metricsStoreKey2, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the underlying IAVL stores remain reachable
+	_, ok := ms.GetCommitKVStore(metricsStoreKey1).(*iavl.Store)
+	require.True(t, ok)
+
+	ctx := context.Background()
+	for height := 1; height <= 2; height++ {
+		require.NoError(t, metrics.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+
+		cacheMS := ms.CacheMultiStore()
+		store1 := cacheMS.GetKVStore(metricsStoreKey1)
+		store1.Set([]byte("key1"), []byte("value"))
+		store1.Set([]byte("key2"), []byte("value"))
+		store1.Get([]byte("key3"))
+		cacheMS.GetKVStore(metricsStoreKey2).Has([]byte("key1"))
+		cacheMS.Write()
+		ms.Commit()
+
+		reads, writes := metrics.BlockOperations(metricsStoreKey1.Name())
+		require.Equal(t, uint64(1), reads)
+		require.Equal(t, uint64(2), writes)
+		reads, writes = metrics.BlockOperations(metricsStoreKey2.Name())
+		require.Equal(t, uint64(1), reads)
+		require.Equal(t, uint64(0), writes)
+
+		require.NoError(t, metrics.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.Reads.WithLabelValues(metricsStoreKey1.Name())))
+	require.Equal(t, float64(4), testutil.ToFloat64(metrics.Writes.WithLabelValues(metricsStoreKey1.Name())))
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.Reads.WithLabelValues(metricsStoreKey2.Name())))
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.Writes.WithLabelValues(metricsStoreKey2.Name())))
+
+	// the counts are reset at the start of each block
+	require.NoError(t, metrics.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	reads, writes := metrics.BlockOperations(metricsStoreKey1.Name())
+	require.Zero(t, reads)
+	require.Zero(t, writes)
+
+	// queries on past versions are not counted
+	queryMS, err := ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), queryMS.GetKVStore(metricsStoreKey1).Get([]byte("key1")))
+	reads, _ = metrics.BlockOperations(metricsStoreKey1.Name())
+	require.Zero(t, reads)
+}
+
+func TestStoreOperationMetricsIAVLCacheStats(t *testing.T) {
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	ms.SetInterBlockCache(server.NewStoreOperationMetrics(cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)))
+	ms.SetIAVLCacheSize(1000)
+	ms.MountStoreWithDB(metricsStoreKey1, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(metricsStoreKey2, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the IAVL stores are reached through the counting decorator
+	stats := ms.IAVLCacheStats()
+	require.Len(t, stats, 2)
+	require.Equal(t, 1000, stats[metricsStoreKey1.Name()].CacheSize)
+	require.Equal(t, 1000, stats[metricsStoreKey2.Name()].CacheSize)
+}
+
+// BenchmarkStoreOperationMetrics measures the overhead of the counting store
+// decorator on the reads and writes of an IAVL store.
+func BenchmarkStoreOperationMetrics(b *testing.B) {
+	for _, withMetrics := range []bool{false, true} {
+		ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+		if withMetrics {
+			ms.SetInterBlockCache(server.NewStoreOperationMetrics(nil))
+		}
+		ms.MountStoreWithDB(metricsStoreKey1, storetypes.StoreTypeIAVL, nil)
+		require.NoError(b, ms.LoadLatestVersion())
+		store := ms.GetKVStore(metricsStoreKey1)
+
+		keys := make([][]byte, 1000)
+		for i := range keys {
+			keys[i] = []byte(fmt.Sprintf("key%04d", i))
+			store.Set(keys[i], []byte("value"))
+		}
+		ms.Commit()
+
+		b.Run(fmt.Sprintf("get/metrics=%t", withMetrics), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				store.Get(keys[i%len(keys)])
+			}
+		})
+
+		b.Run(fmt.Sprintf("set/metrics=%t", withMetrics), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				store.Set(keys[i%len(keys)], []byte("value"))
+			}
+		})
+	}
+}
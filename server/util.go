@@ -48,6 +48,13 @@ import (
 // a command's Context.
 const ServerContextKey = sdk.ContextKey("server.context")
 
+// app.toml keys of the per-store IAVL cache configuration.
+const (
+	iavlConfigSection        = "iavl"
+	iavlDefaultCacheSizeKey  = "default_cache_size"
+	iavlStoreCacheSizeSuffix = "_cache_size"
+)
+
 // server context
 type Context struct {
 	Viper  *viper.Viper
@@ -481,7 +488,17 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
 
-	return []func(*baseapp.BaseApp){
+	iavlCacheSize, iavlStoreCacheSizes, err := GetIAVLCacheSizes(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	indexedAttributes, err := GetIndexedAttributes(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -489,9 +506,12 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(FlagMinRetainBlocks))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
+		baseapp.SetABCIQueryTimeout(cast.ToDuration(appOpts.Get(FlagABCIQueryTimeout))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
+		baseapp.SetIndexedAttributes(indexedAttributes),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
-		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
+		baseapp.SetIAVLCacheSize(iavlCacheSize),
+		baseapp.SetIAVLStoreCacheSizes(iavlStoreCacheSizes),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		baseapp.SetMempool(
 			mempool.NewSenderNonceMempool(
@@ -501,6 +521,25 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
 		baseapp.SetChainID(chainID),
 	}
+
+	if cast.ToBool(appOpts.Get(FlagStoreMetrics)) {
+		storeMetrics := NewStoreOperationMetrics(cache)
+		if err := storeMetrics.Register(); err != nil {
+			panic(err)
+		}
+
+		baseappOptions = append(baseappOptions,
+			baseapp.SetInterBlockCache(storeMetrics),
+			baseapp.SetABCIListener(storeMetrics),
+		)
+	}
+
+	if cast.ToBool(appOpts.Get(FlagCommitWAL)) {
+		wal := NewCommitWAL(filepath.Join(homeDir, "data", "commit_wal.json"))
+		baseappOptions = append(baseappOptions, baseapp.SetCommitWAL(wal))
+	}
+
+	return baseappOptions
 }
 
 // readChainIdFromHome reads chain id from home directory.
@@ -536,6 +575,73 @@ func readChainIdFromHome(homeDir string, dbBackend string) (string, error) {
 	return appGenesis.ChainID, nil
 }
 
+// GetIAVLCacheSizes returns the default IAVL cache size and the cache sizes of
+// the individual stores, keyed by store name, set in the [iavl] section of
+// app.toml. The default cache size falls back to iavl-cache-size when unset.
+func GetIAVLCacheSizes(appOpts types.AppOptions) (int, map[string]int, error) {
+	defaultCacheSize := cast.ToInt(appOpts.Get(FlagIAVLCacheSize))
+	storeCacheSizes := make(map[string]int)
+
+	rawSection := appOpts.Get(iavlConfigSection)
+	if rawSection == nil {
+		return defaultCacheSize, storeCacheSizes, nil
+	}
+
+	section, err := cast.ToStringMapE(rawSection)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid [%s] app config section: %w", iavlConfigSection, err)
+	}
+
+	for key, value := range section {
+		size, err := cast.ToIntE(value)
+		if err != nil || size < 0 {
+			return 0, nil, fmt.Errorf("invalid IAVL cache size %s.%s: %v", iavlConfigSection, key, value)
+		}
+
+		switch {
+		case key == iavlDefaultCacheSizeKey:
+			if size > 0 {
+				defaultCacheSize = size
+			}
+
+		case strings.HasSuffix(key, iavlStoreCacheSizeSuffix):
+			storeCacheSizes[strings.TrimSuffix(key, iavlStoreCacheSizeSuffix)] = size
+
+		default:
+			return 0, nil, fmt.Errorf("unknown IAVL config entry %s.%s", iavlConfigSection, key)
+		}
+	}
+
+	return defaultCacheSize, storeCacheSizes, nil
+}
+
+// GetIndexedAttributes returns the attribute keys to index per event type set
+// with the indexed-attributes app.toml entry.
+func GetIndexedAttributes(appOpts types.AppOptions) (map[string][]string, error) {
+	indexedAttributes := make(map[string][]string)
+
+	raw := appOpts.Get(FlagIndexedAttributes)
+	if raw == nil {
+		return indexedAttributes, nil
+	}
+
+	entries, err := cast.ToSliceE(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s app config: %w", FlagIndexedAttributes, err)
+	}
+
+	for _, entry := range entries {
+		values, err := cast.ToStringSliceE(entry)
+		if err != nil || len(values) < 2 {
+			return nil, fmt.Errorf("invalid %s entry %v: expected an event type followed by attribute keys", FlagIndexedAttributes, entry)
+		}
+
+		indexedAttributes[values[0]] = append(indexedAttributes[values[0]], values[1:]...)
+	}
+
+	return indexedAttributes, nil
+}
+
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
@@ -459,3 +459,74 @@ func (m mapGetter) Get(key string) interface{} {
 }
 
 var _ servertypes.AppOptions = mapGetter{}
+
+func TestGetIAVLCacheSizes(t *testing.T) {
+	appCfgFilePath := filepath.Join(t.TempDir(), "app.toml")
+	appConf := config.DefaultConfig()
+	appConf.IAVL.DefaultCacheSize = 10000
+	appConf.IAVL.StoreCacheSizes = map[string]uint64{
+		"bank_cache_size":    100000,
+		"staking_cache_size": 50000,
+	}
+	config.WriteConfigFile(appCfgFilePath, appConf)
+
+	v := viper.New()
+	v.SetConfigFile(appCfgFilePath)
+	require.NoError(t, v.ReadInConfig())
+
+	defaultCacheSize, storeCacheSizes, err := server.GetIAVLCacheSizes(v)
+	require.NoError(t, err)
+	require.Equal(t, 10000, defaultCacheSize)
+	require.Equal(t, map[string]int{"bank": 100000, "staking": 50000}, storeCacheSizes)
+
+	parsedConf, err := config.GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, appConf.IAVL, parsedConf.IAVL)
+
+	// the default cache size falls back to iavl-cache-size
+	v.Set("iavl.default_cache_size", 0)
+	defaultCacheSize, _, err = server.GetIAVLCacheSizes(v)
+	require.NoError(t, err)
+	require.Equal(t, int(appConf.IAVLCacheSize), defaultCacheSize)
+
+	v.Set("iavl.bank_cache_size", "invalid")
+	_, _, err = server.GetIAVLCacheSizes(v)
+	require.Error(t, err)
+
+	v = viper.New()
+	v.Set("iavl.unknown", 1)
+	_, _, err = server.GetIAVLCacheSizes(v)
+	require.Error(t, err)
+}
+
+func TestGetIndexedAttributes(t *testing.T) {
+	appCfgFilePath := filepath.Join(t.TempDir(), "app.toml")
+	appConf := config.DefaultConfig()
+	appConf.IndexedAttributes = [][]string{
+		{"transfer", "sender", "recipient"},
+		{"cosmos.bank.v1beta1.EventSend", "from"},
+	}
+	config.WriteConfigFile(appCfgFilePath, appConf)
+
+	v := viper.New()
+	v.SetConfigFile(appCfgFilePath)
+	require.NoError(t, v.ReadInConfig())
+
+	indexedAttributes, err := server.GetIndexedAttributes(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"transfer":                      {"sender", "recipient"},
+		"cosmos.bank.v1beta1.EventSend": {"from"},
+	}, indexedAttributes)
+
+	parsedConf, err := config.GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, appConf.IndexedAttributes, parsedConf.IndexedAttributes)
+
+	indexedAttributes, err = server.GetIndexedAttributes(mapGetter{})
+	require.NoError(t, err)
+	require.Empty(t, indexedAttributes)
+
+	_, err = server.GetIndexedAttributes(mapGetter{server.FlagIndexedAttributes: []interface{}{[]interface{}{"transfer"}}})
+	require.ErrorContains(t, err, "expected an event type followed by attribute keys")
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// CommitWAL is a file based write-ahead log of the application commits. The
+// height being committed is recorded before the multistore persists its IAVL
+// versions, and the resulting commit ID once they are persisted, so that a
+// commit interrupted by a crash can be detected and recovered on restart.
+type CommitWAL struct {
+	path string
+}
+
+var _ baseapp.CommitWAL = (*CommitWAL)(nil)
+
+// commitWALEntry is the last record of the commit WAL.
+type commitWALEntry struct {
+	Height  int64  `json:"height"`
+	Hash    []byte `json:"hash,omitempty"`
+	Pending bool   `json:"pending"`
+}
+
+// NewCommitWAL returns a commit WAL persisted in the file at the given path.
+func NewCommitWAL(path string) *CommitWAL {
+	return &CommitWAL{path: path}
+}
+
+// BeginCommit implements baseapp.CommitWAL.
+func (w *CommitWAL) BeginCommit(height int64) error {
+	return w.write(commitWALEntry{Height: height, Pending: true})
+}
+
+// EndCommit implements baseapp.CommitWAL.
+func (w *CommitWAL) EndCommit(commitID storetypes.CommitID) error {
+	return w.write(commitWALEntry{Height: commitID.Version, Hash: commitID.Hash})
+}
+
+// Recover implements baseapp.CommitWAL. If a commit was interrupted before the
+// multistore persisted it, the IAVL versions it partially wrote are discarded
+// so that CometBFT replays the block. If it was persisted but not recorded, the
+// log is rolled forward to the persisted commit.
+func (w *CommitWAL) Recover(ms storetypes.CommitMultiStore) error {
+	entry, err := w.read()
+	if err != nil || entry == nil {
+		return err
+	}
+
+	latest := ms.LastCommitID()
+
+	switch {
+	case !entry.Pending:
+		if latest.Version == entry.Height && !bytes.Equal(latest.Hash, entry.Hash) {
+			return fmt.Errorf("app hash %X at height %d does not match commit WAL hash %X", latest.Hash, latest.Version, entry.Hash)
+		}
+
+		// the state may have been rolled back since the last commit
+		return nil
+
+	case latest.Version == entry.Height:
+		return w.EndCommit(latest)
+
+	case latest.Version == entry.Height-1:
+		// there are no versions to roll back to before the first commit
+		if latest.Version > 0 {
+			if err := ms.RollbackToVersion(latest.Version); err != nil {
+				return fmt.Errorf("failed to discard interrupted commit of height %d: %w", entry.Height, err)
+			}
+		}
+
+		return w.EndCommit(ms.LastCommitID())
+
+	default:
+		return fmt.Errorf("app height %d does not match commit WAL pending height %d", latest.Version, entry.Height)
+	}
+}
+
+// read returns the last entry of the log, or nil if nothing was recorded yet.
+func (w *CommitWAL) read() (*commitWALEntry, error) {
+	bz, err := os.ReadFile(w.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit WAL: %w", err)
+	}
+
+	var entry commitWALEntry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode commit WAL: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// write atomically replaces the last entry of the log, syncing it to disk.
+func (w *CommitWAL) write(entry commitWALEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(w.path), filepath.Base(w.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write commit WAL: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write commit WAL: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync commit WAL: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write commit WAL: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), w.path)
+}
@@ -0,0 +1,103 @@
+package server_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	walStoreKey1 = storetypes.NewKVStoreKey("store1")
+	walStoreKey2 = storetypes.NewKVStoreKey("store2")
+)
+
+func newWALMultiStore(t *testing.T, db dbm.DB) *rootmulti.Store {
+	t.Helper()
+
+	ms := rootmulti.NewStore(db, log.NewNopLogger())
+	ms.MountStoreWithDB(walStoreKey1, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(walStoreKey2, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	return ms
+}
+
+// commit commits the multistore, recording the commit in the WAL.
+func commit(t *testing.T, wal *server.CommitWAL, ms *rootmulti.Store) storetypes.CommitID {
+	t.Helper()
+
+	require.NoError(t, wal.BeginCommit(ms.LastCommitID().Version+1))
+	commitID := ms.Commit()
+	require.NoError(t, wal.EndCommit(commitID))
+
+	return commitID
+}
+
+func TestCommitWALRecover(t *testing.T) {
+	db := dbm.NewMemDB()
+	wal := server.NewCommitWAL(filepath.Join(t.TempDir(), "commit_wal.json"))
+
+	// nothing recorded yet
+	ms := newWALMultiStore(t, db)
+	require.NoError(t, wal.Recover(ms))
+
+	ms.GetKVStore(walStoreKey1).Set([]byte("key"), []byte("value1"))
+	ms.GetKVStore(walStoreKey2).Set([]byte("key"), []byte("value1"))
+	commit(t, wal, ms)
+	require.NoError(t, wal.Recover(ms))
+
+	// the commit of height 2 is interrupted after a single IAVL store was saved
+	require.NoError(t, wal.BeginCommit(2))
+	ms.GetKVStore(walStoreKey1).Set([]byte("key"), []byte("value2"))
+	ms.GetCommitKVStore(walStoreKey1).Commit()
+
+	ms = newWALMultiStore(t, db)
+	require.Equal(t, int64(1), ms.LastCommitID().Version)
+	require.NoError(t, wal.Recover(ms))
+	require.Equal(t, []byte("value1"), ms.GetKVStore(walStoreKey1).Get([]byte("key")))
+
+	// the block is replayed on top of the recovered state
+	ms.GetKVStore(walStoreKey1).Set([]byte("key"), []byte("value2"))
+	commitID := commit(t, wal, ms)
+	require.Equal(t, int64(2), commitID.Version)
+
+	// the commit of height 3 is persisted but not recorded
+	require.NoError(t, wal.BeginCommit(3))
+	commitID = ms.Commit()
+
+	ms = newWALMultiStore(t, db)
+	require.NoError(t, wal.Recover(ms))
+	require.NoError(t, wal.Recover(ms))
+	require.Equal(t, commitID, ms.LastCommitID())
+
+	// the recorded commit does not match the persisted state
+	require.NoError(t, wal.EndCommit(storetypes.CommitID{Version: 3, Hash: []byte("invalid")}))
+	require.Error(t, wal.Recover(ms))
+
+	// the pending height is not next to the persisted state
+	require.NoError(t, wal.BeginCommit(5))
+	require.Error(t, wal.Recover(ms))
+}
+
+func BenchmarkCommitWAL(b *testing.B) {
+	wal := server.NewCommitWAL(filepath.Join(b.TempDir(), "commit_wal.json"))
+	hash := make([]byte, 32)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		height := int64(i + 1)
+		if err := wal.BeginCommit(height); err != nil {
+			b.Fatal(err)
+		}
+		if err := wal.EndCommit(storetypes.CommitID{Version: height, Hash: hash}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -95,6 +95,9 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []
 	// clear validator historical rewards
 	app.DistrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
 
+	// clear reward rate snapshots, whose heights do not carry over
+	app.DistrKeeper.DeleteAllRewardRateSnapshots(ctx)
+
 	// set context height to zero
 	height := ctx.BlockHeight()
 	ctx = ctx.WithBlockHeight(0)
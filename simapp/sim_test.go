@@ -87,6 +87,9 @@ func TestFullAppSimulation(t *testing.T) {
 	app := NewSimApp(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, "SimApp", app.Name())
 
+	app.SimulationManager().RunInvariantsEveryBlock = simcli.FlagInvariantsEveryBlockValue
+	config.BlockInvariant = app.SimulationManager().BlockInvariant()
+
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t,
@@ -391,3 +394,52 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
+
+// TestAppGasCalibration runs several simulations recording the gas consumed by
+// each message type and exports the resulting gas table, including the
+// recommended gas constants, to the -ExportGasPath file (gas.json by default).
+func TestAppGasCalibration(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.GasRecorder = simtypes.NewGasRecorder()
+
+	exportGasPath := config.ExportGasPath
+	if exportGasPath == "" {
+		exportGasPath = "gas.json"
+	}
+	config.ExportGasPath = ""
+
+	numRuns := 5
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	seed := config.Seed
+	for i := 0; i < numRuns; i++ {
+		config.Seed = seed + int64(i)
+		fmt.Printf("running gas calibration simulation; seed %d: %d/%d\n", config.Seed, i+1, numRuns)
+
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+
+		_, _, err := simulation.SimulateFromSeed(
+			t,
+			os.Stdout,
+			app.BaseApp,
+			simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+			simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+			simtestutil.SimulationOperations(app, app.AppCodec(), config),
+			BlockedAddresses(),
+			config,
+			app.AppCodec(),
+		)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, config.GasRecorder.ExportJSON(exportGasPath))
+	fmt.Printf("gas table exported to %s\n", exportGasPath)
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -13,9 +14,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -52,3 +55,38 @@ func Test_TestnetCmd(t *testing.T) {
 	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Codec, appState)
 	require.NotEmpty(t, bankGenState.Supply.String())
 }
+
+func Test_ValidateInvariantsCmd(t *testing.T) {
+	home := t.TempDir()
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}, auth.AppModuleBasic{})
+	logger := log.NewNopLogger()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	err = genutiltest.ExecInitCmd(simapp.ModuleBasics, home, encodingConfig.Codec)
+	require.NoError(t, err)
+
+	serverCtx := server.NewContext(viper.New(), cfg, logger)
+	serverCtx.Viper.Set(flags.FlagHome, home)
+	serverCtx.Viper.Set(server.FlagPruning, pruningtypes.PruningOptionDefault)
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithHomeDir(home).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	cmd := testnetInitFilesCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{})
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=test", flags.FlagKeyringBackend), fmt.Sprintf("--output-dir=%s", home)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var out bytes.Buffer
+	cmd = genutilcli.ValidateInvariantsCmd(newApp)
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{cfg.GenesisFile()})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "PASS bank/total-supply")
+	require.Contains(t, out.String(), "PASS staking/module-accounts")
+	require.NotContains(t, out.String(), "FAIL")
+}
@@ -0,0 +1,113 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestAppBuilder builds a SimApp for integration tests with a fluent API. It
+// creates the validators and the funded genesis accounts, whose keys are kept
+// in an in-memory keyring, and initializes the chain up to the first block.
+type TestAppBuilder struct {
+	numValidators int
+	numAccounts   int
+	accountCoins  sdk.Coins
+	genesis       map[string]json.RawMessage
+
+	keyring  keyring.Keyring
+	accounts []testutil.TestAccount
+}
+
+// NewTestAppBuilder returns a TestAppBuilder building a SimApp with a single
+// validator and a single genesis account.
+func NewTestAppBuilder() *TestAppBuilder {
+	return &TestAppBuilder{
+		numValidators: 1,
+		numAccounts:   1,
+		accountCoins:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+		genesis:       make(map[string]json.RawMessage),
+	}
+}
+
+// WithNumValidators sets the number of validators of the chain.
+func (b *TestAppBuilder) WithNumValidators(n int) *TestAppBuilder {
+	b.numValidators = n
+	return b
+}
+
+// WithNumAccounts sets the number of genesis accounts created in the keyring.
+func (b *TestAppBuilder) WithNumAccounts(n int) *TestAppBuilder {
+	b.numAccounts = n
+	return b
+}
+
+// WithAccountCoins sets the genesis balance of each genesis account.
+func (b *TestAppBuilder) WithAccountCoins(coins sdk.Coins) *TestAppBuilder {
+	b.accountCoins = coins
+	return b
+}
+
+// WithGenesis sets the genesis state of the given module, replacing the one
+// generated by the builder.
+func (b *TestAppBuilder) WithGenesis(moduleName string, state json.RawMessage) *TestAppBuilder {
+	b.genesis[moduleName] = state
+	return b
+}
+
+// Build creates the SimApp, initializes its chain and begins the first block.
+func (b *TestAppBuilder) Build(t *testing.T) *SimApp {
+	t.Helper()
+
+	validators := make([]*tmtypes.Validator, b.numValidators)
+	for i := range validators {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+		validators[i] = tmtypes.NewValidator(pubKey, 1)
+	}
+	valSet := tmtypes.NewValidatorSet(validators)
+
+	app, genesisState := setup(true, 5)
+
+	b.keyring = keyring.NewInMemory(app.AppCodec())
+	b.accounts = testutil.CreateKeyringAccounts(t, b.keyring, b.numAccounts)
+
+	genAccs := make([]authtypes.GenesisAccount, len(b.accounts))
+	balances := make([]banktypes.Balance, len(b.accounts))
+	for i, acc := range b.accounts {
+		genAccs[i] = authtypes.NewBaseAccount(acc.Address, nil, uint64(i), 0)
+		balances[i] = banktypes.Balance{Address: acc.Address.String(), Coins: b.accountCoins}
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, genAccs, balances...)
+	require.NoError(t, err)
+
+	for moduleName, state := range b.genesis {
+		genesisState[moduleName] = state
+	}
+
+	initChain(t, app, genesisState, valSet)
+
+	return app
+}
+
+// Keyring returns the keyring holding the keys of the genesis accounts of the
+// last built SimApp.
+func (b *TestAppBuilder) Keyring() keyring.Keyring {
+	return b.keyring
+}
+
+// Accounts returns the genesis accounts of the last built SimApp.
+func (b *TestAppBuilder) Accounts() []testutil.TestAccount {
+	return b.accounts
+}
@@ -0,0 +1,40 @@
+package simapp
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestTestAppBuilder(t *testing.T) {
+	govGenesis := govv1.DefaultGenesisState()
+	govGenesis.Params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 42))
+
+	govGenesisBz, err := codec.ProtoMarshalJSON(govGenesis, nil)
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	builder := NewTestAppBuilder().
+		WithNumValidators(3).
+		WithNumAccounts(2).
+		WithAccountCoins(coins)
+	app := builder.WithGenesis(govtypes.ModuleName, govGenesisBz).Build(t)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	require.Len(t, app.StakingKeeper.GetAllValidators(ctx), 3)
+
+	accounts := builder.Accounts()
+	require.Len(t, accounts, 2)
+	for _, acc := range accounts {
+		_, err = builder.Keyring().Key(acc.Name)
+		require.NoError(t, err)
+	}
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, accounts[1].Address))
+	require.Equal(t, govGenesis.Params.MinDeposit, app.GovKeeper.GetParams(ctx).MinDeposit)
+}
@@ -131,6 +131,16 @@ func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs
 	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, genAccs, balances...)
 	require.NoError(t, err)
 
+	initChain(t, app, genesisState, valSet)
+
+	return app
+}
+
+// initChain initializes the chain of app with the given genesis state and
+// validator set, commits it and begins the first block.
+func initChain(t *testing.T, app *SimApp, genesisState GenesisState, valSet *tmtypes.ValidatorSet) {
+	t.Helper()
+
 	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 	require.NoError(t, err)
 
@@ -151,8 +161,6 @@ func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs
 		ValidatorsHash:     valSet.Hash(),
 		NextValidatorsHash: valSet.Hash(),
 	}})
-
-	return app
 }
 
 // GenesisStateWithSingleValidator initializes GenesisState with a single validator and genesis accounts
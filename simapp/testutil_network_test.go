@@ -1,13 +1,17 @@
 package simapp_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type IntegrationTestSuite struct {
@@ -40,3 +44,37 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func TestNetworkWithBondedStakeDistribution(t *testing.T) {
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = 2
+
+	powers := []int64{10, 30}
+	distribution := []math.Int{
+		sdk.TokensFromConsensusPower(powers[0], sdk.DefaultPowerReduction),
+		sdk.TokensFromConsensusPower(powers[1], sdk.DefaultPowerReduction),
+	}
+
+	// the distribution must have one amount per validator
+	_, err := network.New(t, t.TempDir(), cfg, network.WithBondedStakeDistribution(distribution[:1]))
+	require.Error(t, err)
+
+	net, err := network.New(t, t.TempDir(), cfg, network.WithBondedStakeDistribution(distribution))
+	require.NoError(t, err)
+	defer net.Cleanup()
+
+	_, err = net.WaitForHeight(1)
+	require.NoError(t, err)
+
+	res, err := net.Validators[0].RPCClient.Validators(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+
+	votingPowers := make(map[string]int64)
+	for _, val := range res.Validators {
+		votingPowers[val.Address.String()] = val.VotingPower
+	}
+	require.Len(t, votingPowers, len(powers))
+	for i, val := range net.Validators {
+		require.Equal(t, powers[i], votingPowers[val.PubKey.Address().String()], "validator %d", i)
+	}
+}
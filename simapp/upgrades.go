@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -27,6 +28,11 @@ import (
 // v0.46.x to v0.47.x.
 const UpgradeName = "v046-to-v047"
 
+// VestingStoreUpgradeName defines the on-chain upgrade name adding the vesting
+// module store, indexing the milestone vesting accounts by proposal, to a
+// chain already upgraded to v047.
+const VestingStoreUpgradeName = "v047-vesting-store"
+
 func (app SimApp) RegisterUpgradeHandlers() {
 	// Set param key table for params module migration
 	for _, subspace := range app.ParamsKeeper.GetSubspaces() {
@@ -68,7 +74,14 @@ func (app SimApp) RegisterUpgradeHandlers() {
 			// Note: this migration is optional,
 			// You can include x/gov proposal migration documented in [UPGRADING.md](https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md)
 
-			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM, module.NewLoggingMigrationReporter(ctx.Logger()))
+		},
+	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		VestingStoreUpgradeName,
+		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM, module.NewLoggingMigrationReporter(ctx.Logger()))
 		},
 	)
 
@@ -77,15 +90,27 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	var storeUpgrades *storetypes.StoreUpgrades
+	switch upgradeInfo.Name {
+	case UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
 			Added: []string{
 				consensustypes.ModuleName,
 				crisistypes.ModuleName,
 			},
 		}
-
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	case VestingStoreUpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{vestingtypes.StoreKey},
+		}
+	default:
+		return
 	}
+
+	// configure store loader that checks if version == upgradeHeight and applies store upgrades
+	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
 }
@@ -36,8 +36,10 @@ var (
 
 // Store Implements types.KVStore and CommitKVStore.
 type Store struct {
-	tree   Tree
-	logger log.Logger
+	tree      Tree
+	logger    log.Logger
+	cacheSize int
+	stats     *iavl.Statistics
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -52,7 +54,8 @@ func LoadStore(db dbm.DB, logger log.Logger, key types.StoreKey, id types.Commit
 // provided DB. An error is returned if the version fails to load, or if called with a positive
 // version on an empty tree.
 func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, lazyLoading bool, initialVersion uint64, cacheSize int, disableFastNode bool) (types.CommitKVStore, error) {
-	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion}, disableFastNode)
+	stats := &iavl.Statistics{}
+	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion, Stat: stats}, disableFastNode)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +90,10 @@ func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKe
 	}
 
 	return &Store{
-		tree:   tree,
-		logger: logger,
+		tree:      tree,
+		logger:    logger,
+		cacheSize: cacheSize,
+		stats:     stats,
 	}, nil
 }
 
@@ -124,6 +129,22 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}, nil
 }
 
+// CacheStats returns the node cache usage of the IAVL tree since the store was
+// loaded. Stores not created by LoadStore report no usage.
+func (st *Store) CacheStats() types.IAVLCacheStats {
+	if st.stats == nil {
+		return types.IAVLCacheStats{CacheSize: st.cacheSize}
+	}
+
+	return types.IAVLCacheStats{
+		CacheSize:       st.cacheSize,
+		CacheHits:       st.stats.GetCacheHitCnt(),
+		CacheMisses:     st.stats.GetCacheMissCnt(),
+		FastCacheHits:   st.stats.GetFastCacheHitCnt(),
+		FastCacheMisses: st.stats.GetFastCacheMissCnt(),
+	}
+}
+
 // Commit commits the current store state and returns a CommitID with the new
 // version and hash.
 func (st *Store) Commit() types.CommitID {
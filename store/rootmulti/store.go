@@ -60,6 +60,7 @@ type Store struct {
 	lastCommitInfo      *types.CommitInfo
 	pruningManager      *pruning.Manager
 	iavlCacheSize       int
+	iavlCacheSizes      map[string]int
 	iavlDisableFastNode bool
 	storesParams        map[types.StoreKey]storeParams
 	stores              map[types.StoreKey]types.CommitKVStore
@@ -76,8 +77,10 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore         = (*Store)(nil)
+	_ types.Queryable                = (*Store)(nil)
+	_ types.IAVLCacheStatsReporter   = (*Store)(nil)
+	_ types.IAVLStoreCacheSizeSetter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -89,6 +92,7 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		db:                  db,
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
+		iavlCacheSizes:      make(map[string]int),
 		iavlDisableFastNode: iavlDisablefastNodeDefault,
 		storesParams:        make(map[types.StoreKey]storeParams),
 		stores:              make(map[types.StoreKey]types.CommitKVStore),
@@ -121,6 +125,25 @@ func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
 
+// SetIAVLStoreCacheSize implements types.IAVLStoreCacheSizeSetter.
+func (rs *Store) SetIAVLStoreCacheSize(storeName string, cacheSize int) {
+	rs.iavlCacheSizes[storeName] = cacheSize
+}
+
+// IAVLCacheStats implements types.IAVLCacheStatsReporter.
+func (rs *Store) IAVLCacheStats() map[string]types.IAVLCacheStats {
+	stats := make(map[string]types.IAVLCacheStats)
+	for key := range rs.stores {
+		// unwrap the inter-block cache, and any store decorating it, to reach
+		// the underlying IAVL store
+		if iavlStore, ok := rs.GetCommitKVStore(key).(*iavl.Store); ok {
+			stats[key.Name()] = iavlStore.CacheStats()
+		}
+	}
+
+	return stats
+}
+
 func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
@@ -949,10 +972,15 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		var store types.CommitKVStore
 		var err error
 
+		cacheSize := rs.iavlCacheSize
+		if size, ok := rs.iavlCacheSizes[key.Name()]; ok {
+			cacheSize = size
+		}
+
 		if params.initialVersion == 0 {
-			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, rs.iavlCacheSize, rs.iavlDisableFastNode)
+			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, cacheSize, rs.iavlDisableFastNode)
 		} else {
-			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode)
+			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, cacheSize, rs.iavlDisableFastNode)
 		}
 
 		if err != nil {
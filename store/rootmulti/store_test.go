@@ -668,6 +668,31 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestIAVLStoreCacheSizes(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	multi.SetIAVLCacheSize(1000)
+	multi.SetIAVLStoreCacheSize("store2", 2000)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("value"))
+	multi.Commit()
+
+	// reload the stores so that reads go through the IAVL caches
+	multi = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	multi.SetIAVLCacheSize(1000)
+	multi.SetIAVLStoreCacheSize("store2", 2000)
+	require.NoError(t, multi.LoadLatestVersion())
+	require.Equal(t, []byte("value"), multi.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	stats := multi.IAVLCacheStats()
+	require.Len(t, stats, 3)
+	require.Equal(t, 1000, stats["store1"].CacheSize)
+	require.Equal(t, 2000, stats["store2"].CacheSize)
+	require.Equal(t, 1000, stats["store3"].CacheSize)
+	require.NotZero(t, stats["store1"].FastCacheHits+stats["store1"].FastCacheMisses)
+}
+
 func TestAddListenersAndListeningEnabled(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
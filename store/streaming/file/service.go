@@ -181,12 +181,7 @@ func (fss *StreamingService) writeBlockData(writer io.Writer) error {
 		cache := listener.PopStateCache()
 
 		for i := range cache {
-			bz, err := fss.codec.MarshalLengthPrefixed(&cache[i])
-			if err != nil {
-				return err
-			}
-
-			if _, err := writer.Write(bz); err != nil {
+			if err := codec.WriteDelimited(writer, &cache[i]); err != nil {
 				return err
 			}
 		}
@@ -40,6 +40,33 @@ type Queryable interface {
 	Query(abci.RequestQuery) abci.ResponseQuery
 }
 
+// IAVLCacheStats defines the node cache usage of an IAVL store since it was
+// loaded.
+type IAVLCacheStats struct {
+	CacheSize       int    `json:"cache_size"`
+	CacheHits       uint64 `json:"cache_hits"`
+	CacheMisses     uint64 `json:"cache_misses"`
+	FastCacheHits   uint64 `json:"fast_cache_hits"`
+	FastCacheMisses uint64 `json:"fast_cache_misses"`
+}
+
+// IAVLCacheStatsReporter reports the node cache usage of the IAVL stores of a
+// multistore, keyed by store name.
+//
+// This is an optional extension to any CommitMultiStore
+type IAVLCacheStatsReporter interface {
+	IAVLCacheStats() map[string]IAVLCacheStats
+}
+
+// IAVLStoreCacheSizeSetter sets the cache size of the IAVL tree of an
+// individual store of a multistore, overriding the size set by
+// SetIAVLCacheSize.
+//
+// This is an optional extension to any CommitMultiStore
+type IAVLStoreCacheSizeSetter interface {
+	SetIAVLStoreCacheSize(storeName string, size int)
+}
+
 //----------------------------------------
 // MultiStore
 
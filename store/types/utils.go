@@ -28,6 +28,12 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 	defer iterB.Close()
 
 	for {
+		// Skip the keys with an exclusion prefix that are only present in B.
+		for iterB.Valid() && hasAnyPrefix(iterB.Key(), prefixesToSkip) &&
+			(!iterA.Valid() || bytes.Compare(iterB.Key(), iterA.Key()) < 0) {
+			iterB.Next()
+		}
+
 		if !iterA.Valid() && !iterB.Valid() {
 			return kvAs, kvBs
 		}
@@ -43,17 +49,8 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 			kvB = kv.Pair{Key: iterB.Key(), Value: iterB.Value()}
 		}
 
-		compareValue := true
-
-		for _, prefix := range prefixesToSkip {
-			// Skip value comparison if we matched a prefix
-			if bytes.HasPrefix(kvA.Key, prefix) {
-				compareValue = false
-				break
-			}
-		}
-
-		if !compareValue {
+		// Skip value comparison if we matched a prefix
+		if hasAnyPrefix(kvA.Key, prefixesToSkip) {
 			// We're skipping this key due to an exclusion prefix.  If it's present in B, iterate past it.  If it's
 			// absent don't iterate.
 			if bytes.Equal(kvA.Key, kvB.Key) {
@@ -72,6 +69,16 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 	}
 }
 
+// hasAnyPrefix returns whether key starts with any of the given prefixes.
+func hasAnyPrefix(key []byte, prefixes [][]byte) bool {
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // PrefixEndBytes returns the []byte that would end a
 // range query for all []byte with a certain prefix
 // Deals with last byte of prefix being FF without overflowing
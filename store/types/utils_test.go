@@ -69,6 +69,12 @@ func TestDiffKVStores(t *testing.T) {
 	kvAs, kvBs = sdk.DiffKVStores(store1, store2, [][]byte{prefix})
 	require.Equal(t, 0, len(kvAs))
 	require.Equal(t, len(kvAs), len(kvBs))
+
+	// Prefixed keys only present in B are skipped too.
+	store2.Set(append(prefix, k2...), v2)
+	kvAs, kvBs = sdk.DiffKVStores(store1, store2, [][]byte{prefix})
+	require.Equal(t, 0, len(kvAs))
+	require.Equal(t, len(kvAs), len(kvBs))
 }
 
 func TestPrefixEndBytes(t *testing.T) {
@@ -140,6 +140,12 @@ func (m *Metrics) Gather(format string) (GatherResponse, error) {
 	}
 }
 
+// RegisterCollector registers a Prometheus collector, such as a histogram, with
+// the registry exposed by the Prometheus metrics format.
+func RegisterCollector(c prometheus.Collector) error {
+	return prometheus.Register(c)
+}
+
 func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
 	if !m.prometheusEnabled {
 		return GatherResponse{}, fmt.Errorf("prometheus metrics are not enabled")
@@ -80,7 +80,7 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true,"max_validator_fee_share":"0.000000000000000000"}`,
 		},
 		{
 			"text output",
@@ -88,6 +88,7 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0.000000000000000000"
 bonus_proposer_reward: "0.000000000000000000"
 community_tax: "0.020000000000000000"
+max_validator_fee_share: "0.000000000000000000"
 withdraw_addr_enabled: true`,
 		},
 	}
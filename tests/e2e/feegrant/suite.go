@@ -308,6 +308,79 @@ func (s *E2ETestSuite) TestCmdGetFeeGrantsByGranter() {
 	}
 }
 
+func (s *E2ETestSuite) TestCmdGetAllowanceUsageHistory() {
+	val := s.network.Validators[0]
+	granter := s.addedGranter
+	grantee := s.addedGrantee
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		resp         *feegrant.QueryAllowanceUsageHistoryResponse
+		expectLength int
+	}{
+		{
+			"wrong granter",
+			[]string{
+				"wrong_granter",
+				grantee.String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true, nil, 0,
+		},
+		{
+			"wrong grantee",
+			[]string{
+				granter.String(),
+				"wrong_grantee",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid req: unused allowance",
+			[]string{
+				granter.String(),
+				grantee.String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false, &feegrant.QueryAllowanceUsageHistoryResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryAllowanceUsageHistory()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.resp), out.String())
+				s.Require().Len(tc.resp.Records, tc.expectLength)
+			}
+		})
+	}
+}
+
+func (s *E2ETestSuite) TestCmdGetParams() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	cmd := cli.GetCmdQueryParams()
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{fmt.Sprintf("--%s=json", flags.FlagOutput)})
+	s.Require().NoError(err)
+
+	var params feegrant.Params
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &params), out.String())
+	s.Require().Equal(feegrant.DefaultParams(), params)
+}
+
 func (s *E2ETestSuite) TestNewCmdFeeGrant() {
 	val := s.network.Validators[0]
 	granter := val.Address
@@ -23,7 +23,7 @@ func (s *E2ETestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true}}`,
+			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true,"delegator_vote_override":false,"veto_deposit_decay":"0.000000000000000000","voting_mechanism":"VOTING_MECHANISM_WEIGHTED_BY_STAKE","bundled_min_deposit":[],"reconsideration_period":"86400s"}}`,
 		},
 		{
 			"text output",
@@ -35,17 +35,22 @@ deposit_params:
   - amount: "10000000"
     denom: stake
 params:
+  bundled_min_deposit: []
   burn_proposal_deposit_prevote: false
   burn_vote_quorum: false
   burn_vote_veto: true
+  delegator_vote_override: false
   max_deposit_period: 172800s
   min_deposit:
   - amount: "10000000"
     denom: stake
   min_initial_deposit_ratio: "0.000000000000000000"
   quorum: "0.334000000000000000"
+  reconsideration_period: 86400s
   threshold: "0.500000000000000000"
+  veto_deposit_decay: "0.000000000000000000"
   veto_threshold: "0.334000000000000000"
+  voting_mechanism: VOTING_MECHANISM_WEIGHTED_BY_STAKE
   voting_period: 172800s
 tally_params:
   quorum: "0.334000000000000000"
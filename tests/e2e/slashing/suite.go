@@ -66,7 +66,7 @@ func (s *E2ETestSuite) TestGetCmdQuerySigningInfo() {
 				fmt.Sprintf("--%s=1", flags.FlagHeight),
 			},
 			false,
-			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\"}", sdk.ConsAddress(val.PubKey.Address())),
+			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\",\"unjail_grace_end_height\":\"0\",\"validator_signing_window\":\"0\"}", sdk.ConsAddress(val.PubKey.Address())),
 		},
 		{
 			"valid address (text output)",
@@ -81,7 +81,9 @@ index_offset: "0"
 jailed_until: "1970-01-01T00:00:00Z"
 missed_blocks_counter: "0"
 start_height: "0"
-tombstoned: false`, sdk.ConsAddress(val.PubKey.Address())),
+tombstoned: false
+unjail_grace_end_height: "0"
+validator_signing_window: "0"`, sdk.ConsAddress(val.PubKey.Address())),
 		},
 	}
 
@@ -114,16 +116,18 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","unjail_grace_window":"0","max_evidence_age":"0s"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
 			`downtime_jail_duration: 600s
+max_evidence_age: 0s
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
-slash_fraction_downtime: "0.010000000000000000"`,
+slash_fraction_downtime: "0.010000000000000000"
+unjail_grace_window: "0"`,
 		},
 	}
 
@@ -897,17 +897,21 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
-			`bond_denom: stake
+			`absolute_max_delegations: "0"
+bond_denom: stake
+burn_fraction: "0.000000000000000000"
+commission_change_cooldown: 604800s
 historical_entries: 10000
 max_entries: 7
 max_validators: 100
 min_commission_rate: "0.000000000000000000"
+slash_redistribution: false
 unbonding_time: 1814400s`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","slash_redistribution":false,"burn_fraction":"0.000000000000000000","absolute_max_delegations":"0","commission_change_cooldown":"604800s"}`,
 		},
 	}
 	for _, tc := range testCases {
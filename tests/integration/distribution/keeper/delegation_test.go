@@ -14,6 +14,7 @@ import (
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
@@ -413,6 +414,87 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	distrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
+
+	balanceTokens := stakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+
+	// set module account coins
+	distrAcc := distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create two validators with 50% commission, the first operator also
+	// delegating to the second validator
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk0, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(addr[0], valAddrs[1], 100)
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards to each validator
+	initial := stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[1]), tokens)
+
+	// only the first delegation is withdrawn from when the number of
+	// delegations exceeds the maximum withdrawals
+	params := distrKeeper.GetParams(ctx)
+	params.MaxWithdrawalsPerTx = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	balance := bankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom)
+	first, remaining, err := distrKeeper.WithdrawAllDelegationRewards(ctx, addr[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), remaining)
+	require.False(t, first.IsZero())
+
+	// the next withdrawal only gets the rewards of the other delegation
+	params.MaxWithdrawalsPerTx = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	second, remaining, err := distrKeeper.WithdrawAllDelegationRewards(ctx, addr[0])
+	require.NoError(t, err)
+	require.Zero(t, remaining)
+	require.False(t, second.IsZero())
+
+	// half of the rewards of the first validator and a quarter of the rewards
+	// of the second one
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).Add(initial.QuoRaw(4))))
+	require.Equal(t, expRewards, first.Add(second...))
+	require.Equal(t, balance.Add(expRewards[0]), bankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom))
+
+	// no delegation
+	_, _, err = distrKeeper.WithdrawAllDelegationRewards(ctx, sdk.AccAddress([]byte("nodelegation________")))
+	require.ErrorIs(t, err, disttypes.ErrEmptyDelegationDistInfo)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	var (
 		bankKeeper    bankkeeper.Keeper
@@ -818,3 +900,68 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestRefundSlashAfterDelegationModified(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+
+	// create validator and delegate the same stake to it
+	valPower := int64(100)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk0, valPower, true)
+	tstaking.DelegateWithPower(addr[1], valAddrs[0], valPower)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// slash the validator by 50%
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+	slashHeight := uint64(ctx.BlockHeight())
+	stakingKeeper.Slash(ctx, valConsAddr0, ctx.BlockHeight(), 2*valPower, sdk.NewDecWithPrec(5, 1))
+
+	// only the slash event is stored, not the stakes of the delegators
+	_, found := distrKeeper.GetRefundableSlash(ctx, valAddrs[0], slashHeight)
+	require.True(t, found)
+	_, found = distrKeeper.GetDelegatorSlashStake(ctx, valAddrs[0], slashHeight, addr[1])
+	require.False(t, found)
+
+	// the second delegator delegates more after the slash, which records its
+	// stake at the slash before its starting info is reset
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+	tstaking.Ctx = ctx
+	tstaking.DelegateWithPower(addr[1], valAddrs[0], valPower)
+	stake, found := distrKeeper.GetDelegatorSlashStake(ctx, valAddrs[0], slashHeight, addr[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(stakingKeeper.TokensFromConsensusPower(ctx, valPower)), stake)
+
+	// the refund is divided evenly, as both delegators had the same stake at the slash
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, amount, addr[0]))
+	balances := []sdk.Coins{bankKeeper.GetAllBalances(ctx, addr[0]), bankKeeper.GetAllBalances(ctx, addr[1])}
+
+	refunded, err := distrKeeper.RefundSlash(ctx, valAddrs[0], slashHeight, amount)
+	require.NoError(t, err)
+	require.Equal(t, amount, refunded)
+	half := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(500)))
+	require.Equal(t, balances[0].Add(half...), bankKeeper.GetAllBalances(ctx, addr[0]))
+	require.Equal(t, balances[1].Add(half...), bankKeeper.GetAllBalances(ctx, addr[1]))
+
+	// a slash is refunded once
+	_, err = distrKeeper.RefundSlash(ctx, valAddrs[0], slashHeight, amount)
+	require.ErrorIs(t, err, disttypes.ErrEmptyDelegationDistInfo)
+}
@@ -89,10 +89,11 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 			"valid request",
 			func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:         sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:   sdk.ZeroDec(),
+					BonusProposerReward:  sdk.ZeroDec(),
+					WithdrawAddrEnabled:  true,
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				}
 
 				suite.NoError(suite.distrKeeper.SetParams(ctx, params))
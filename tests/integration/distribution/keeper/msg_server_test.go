@@ -21,10 +21,11 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &types.MsgUpdateParams{
 				Authority: "invalid",
 				Params: types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(2, 0),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
+					CommunityTax:         sdk.NewDecWithPrec(2, 0),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					BaseProposerReward:   sdk.ZeroDec(),
+					BonusProposerReward:  sdk.ZeroDec(),
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -35,10 +36,11 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &types.MsgUpdateParams{
 				Authority: s.distrKeeper.GetAuthority(),
 				Params: types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(2, 0),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
+					CommunityTax:         sdk.NewDecWithPrec(2, 0),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					BaseProposerReward:   sdk.ZeroDec(),
+					BonusProposerReward:  sdk.ZeroDec(),
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -49,10 +51,11 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &types.MsgUpdateParams{
 				Authority: s.distrKeeper.GetAuthority(),
 				Params: types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(-2, 1),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
+					CommunityTax:         sdk.NewDecWithPrec(-2, 1),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					BaseProposerReward:   sdk.ZeroDec(),
+					BonusProposerReward:  sdk.ZeroDec(),
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -63,10 +66,11 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &types.MsgUpdateParams{
 				Authority: s.distrKeeper.GetAuthority(),
 				Params: types.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:         communityTax,
+					BaseProposerReward:   sdk.NewDecWithPrec(1, 2),
+					BonusProposerReward:  sdk.ZeroDec(),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -77,10 +81,11 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &types.MsgUpdateParams{
 				Authority: s.distrKeeper.GetAuthority(),
 				Params: types.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.NewDecWithPrec(1, 2),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:         communityTax,
+					BaseProposerReward:   sdk.ZeroDec(),
+					BonusProposerReward:  sdk.NewDecWithPrec(1, 2),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -91,10 +96,11 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &types.MsgUpdateParams{
 				Authority: s.distrKeeper.GetAuthority(),
 				Params: types.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:         communityTax,
+					BaseProposerReward:   sdk.ZeroDec(),
+					BonusProposerReward:  sdk.ZeroDec(),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					MaxValidatorFeeShare: sdk.ZeroDec(),
 				},
 			},
 			expErr: false,
@@ -176,3 +182,53 @@ func (s *KeeperTestSuite) TestCommunityPoolSpend() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRefundSlash() {
+	valAddr := sdk.ValAddress([]byte("val1________________"))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRefundSlash
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgRefundSlash{
+				Authority:        "invalid",
+				ValidatorAddress: valAddr.String(),
+				SlashHeight:      1,
+				Amount:           sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid validator",
+			input: &types.MsgRefundSlash{
+				Authority:        s.distrKeeper.GetAuthority(),
+				ValidatorAddress: "invalid",
+				SlashHeight:      1,
+				Amount:           sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			},
+			expErrMsg: "decoding bech32 failed",
+		},
+		{
+			name: "no slash event",
+			input: &types.MsgRefundSlash{
+				Authority:        s.distrKeeper.GetAuthority(),
+				ValidatorAddress: valAddr.String(),
+				SlashHeight:      1,
+				Amount:           sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			},
+			expErrMsg: "no slash event at height",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RefundSlash(s.ctx, tc.input)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), tc.expErrMsg)
+		})
+	}
+}
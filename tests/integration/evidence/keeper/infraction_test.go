@@ -193,6 +193,41 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleDoubleSign_Expired(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, f.stakingKeeper)
+	f.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+
+	params := f.slashingKeeper.GetParams(ctx)
+	params.MaxEvidenceAge = time.Hour
+	assert.NilError(t, f.slashingKeeper.SetParams(ctx, params))
+
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             ctx.BlockTime(),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+	}
+
+	// the evidence is still valid for the consensus params but older than the
+	// max evidence age
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour + 1))
+	f.evidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed() == false)
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
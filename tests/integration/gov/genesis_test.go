@@ -86,6 +86,9 @@ func TestImportExportQueues(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, votingStarted)
 
+	override := v1.NewDelegatorVoteOverride(proposalID2, addrs[0], sdk.ValAddress(addrs[0]), v1.OptionNo)
+	s1.GovKeeper.SetDelegatorVoteOverride(ctx, override)
+
 	proposal1, ok := s1.GovKeeper.GetProposal(ctx, proposalID1)
 	require.True(t, ok)
 	proposal2, ok = s1.GovKeeper.GetProposal(ctx, proposalID2)
@@ -146,6 +149,11 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == v1.StatusDepositPeriod)
 	require.True(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	// Make sure that the delegator vote override was imported
+	importedOverride, found := s2.GovKeeper.GetDelegatorVoteOverride(ctx2, proposalID2, addrs[0], sdk.ValAddress(addrs[0]))
+	require.True(t, found)
+	require.Equal(t, override, importedOverride)
+
 	macc := s2.GovKeeper.GetGovernanceAccount(ctx2)
 	require.Equal(t, sdk.Coins(s2.GovKeeper.GetParams(ctx2).MinDeposit), s2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
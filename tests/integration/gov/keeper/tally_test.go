@@ -473,3 +473,210 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyDelegatorVoteOverride(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, vals := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.DelegatorVoteOverride = true
+	require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val1, found := app.StakingKeeper.GetValidator(ctx, vals[0])
+	require.True(t, found)
+	val2, found := app.StakingKeeper.GetValidator(ctx, vals[1])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val2, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	// only the delegation to the first validator overrides its vote
+	require.NoError(t, app.GovKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[3], vals[0], v1.OptionNo))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5+6+10).String(), tallyResults.YesCount)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 7+10).String(), tallyResults.NoCount)
+
+	// overrides are deleted once tallied
+	_, found = app.GovKeeper.GetDelegatorVoteOverride(ctx, proposalID, addrs[3], vals[0])
+	require.False(t, found)
+}
+
+func TestTallyDelegatorVoteOverrideWithDirectVote(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, vals := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.DelegatorVoteOverride = true
+	require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val1, found := app.StakingKeeper.GetValidator(ctx, vals[0])
+	require.True(t, found)
+	val2, found := app.StakingKeeper.GetValidator(ctx, vals[1])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val2, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	// the direct vote applies to all delegations but the overriding one
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[3], vals[1], v1.OptionAbstain))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5+6+7).String(), tallyResults.YesCount)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10).String(), tallyResults.NoCount)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10).String(), tallyResults.AbstainCount)
+}
+
+func TestTallyQuadraticByStake(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mechanism   v1.VotingMechanism
+		expPass     bool
+		expYesCount string
+		expNoCount  string
+	}{
+		{
+			name:        "weighted by stake",
+			mechanism:   v1.WeightedByStake,
+			expPass:     true,
+			expYesCount: "25000000",
+			expNoCount:  "18000000",
+		},
+		{
+			name:        "quadratic by stake",
+			mechanism:   v1.QuadraticByStake,
+			expPass:     false,
+			expYesCount: "5000",
+			expNoCount:  "6000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			addrs, _ := createValidators(t, ctx, app, []int64{25, 9, 9})
+
+			params := app.GovKeeper.GetParams(ctx)
+			params.VotingMechanism = tc.mechanism
+			require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+
+			tp := TestProposal
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+			require.Equal(t, tc.expYesCount, tallyResults.YesCount)
+			require.Equal(t, tc.expNoCount, tallyResults.NoCount)
+		})
+	}
+}
+
+func TestTallyQuadraticByStakeInherit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, vals := createValidators(t, ctx, app, []int64{9, 9, 9})
+
+	val1, found := app.StakingKeeper.GetValidator(ctx, vals[0])
+	require.True(t, found)
+	val2, found := app.StakingKeeper.GetValidator(ctx, vals[1])
+	require.True(t, found)
+
+	// addrs[3] inherits the vote of val1, addrs[4] splits its stake between
+	// val1 and val2 and inherits both their votes
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], app.StakingKeeper.TokensFromConsensusPower(ctx, 16), stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	val1, _ = app.StakingKeeper.GetValidator(ctx, vals[0])
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], app.StakingKeeper.TokensFromConsensusPower(ctx, 8), stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], app.StakingKeeper.TokensFromConsensusPower(ctx, 8), stakingtypes.Unbonded, val2, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.VotingMechanism = v1.QuadraticByStake
+	require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	// each voter votes with the square root of its own stake, and the stake
+	// inheriting the vote of a validator votes as a single voter: yes is
+	// sqrt(9) + sqrt(16 + 8) and no is sqrt(9) + sqrt(8) + sqrt(9), in thousands
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, "7898", tallyResults.YesCount)
+	require.Equal(t, "8828", tallyResults.NoCount)
+}
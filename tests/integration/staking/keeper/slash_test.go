@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -579,6 +580,59 @@ func TestSlashBoth(t *testing.T) {
 	require.Equal(t, int64(10), validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx)))
 }
 
+// tests the redistribution of the slashed tokens
+func TestSlashRedistribution(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	app.StakingKeeper.SetDistributionKeeper(app.DistrKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.SlashRedistribution = true
+	params.BurnFraction = sdk.NewDecWithPrec(2, 1)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+	oldSupply := app.BankKeeper.GetSupply(ctx, bondDenom)
+	oldCommunityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	slashed := app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), slashed)
+
+	// 20% of the slashed tokens are burnt
+	burnt := slashed.QuoRaw(5)
+	require.Equal(t, oldSupply.Amount.Sub(burnt), app.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+
+	// the slashed tokens leave the bonded pool whether burnt or redistributed
+	require.Equal(t, oldBonded.Amount.Sub(slashed), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+
+	// the rest is redistributed to the delegators of the other validators
+	// without commission, in proportion to their tokens, the remainder going
+	// to the community pool
+	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addrVals[0]).IsZero())
+	redistributed := sdk.NewDecFromInt(slashed.Sub(burnt))
+	val1Rewards := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addrVals[1])
+	val2Rewards := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addrVals[2])
+	require.True(t, val1Rewards.AmountOf(bondDenom).IsPositive())
+	require.Equal(t, val1Rewards, val2Rewards)
+	require.Equal(t, val1Rewards, app.DistrKeeper.GetValidatorCurrentRewards(ctx, addrVals[1]).Rewards)
+	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, addrVals[1]).Commission.IsZero())
+
+	allocated := sdk.ZeroDec()
+	app.StakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, val types.ValidatorI) bool {
+		allocated = allocated.Add(app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, val.GetOperator()).AmountOf(bondDenom))
+		return false
+	})
+	communityPoolIncrease := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).Sub(oldCommunityPool).AmountOf(bondDenom)
+	require.Equal(t, redistributed, allocated.Add(communityPoolIncrease))
+
+	// the distribution module account still matches the rewards
+	_, broken := distrkeeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+}
+
 func TestSlashAmount(t *testing.T) {
 	app, ctx, _, _ := bootstrapSlashTest(t, 10)
 	consAddr := sdk.ConsAddress(PKs[0].Address())
@@ -0,0 +1,71 @@
+// Package codec provides helpers to test the codec registrations of messages.
+package codec
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// AssertCodecDeterminism asserts that each of msgs round-trips through the
+// proto codec, through an Any resolved with the interface registry of cdc and,
+// if amino is not nil, through the legacy amino codec. A message round-trips if
+// the decoded message has the same proto encoding as the original one. It
+// catches registration omissions, such as a missing RegisterInterfaces or a
+// wrong type URL.
+func AssertCodecDeterminism(t *testing.T, cdc *codec.ProtoCodec, amino *codec.LegacyAmino, msgs []codec.ProtoMarshaler) {
+	t.Helper()
+
+	for _, msg := range msgs {
+		name := proto.MessageName(msg)
+		require.NotEmpty(t, name, "%T is not a registered proto message", msg)
+
+		// proto
+		bz, err := cdc.Marshal(msg)
+		require.NoError(t, err, "failed to proto marshal %s", name)
+		got := newMessage(msg)
+		require.NoError(t, cdc.Unmarshal(bz, got), "failed to proto unmarshal %s", name)
+		requireSameProto(t, cdc, bz, got, "%s does not round-trip through proto", name)
+
+		// Any
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err, "failed to pack %s", name)
+		resolved, err := cdc.InterfaceRegistry().Resolve(anyMsg.TypeUrl)
+		require.NoError(t, err, "type URL %s of %s is not registered", anyMsg.TypeUrl, name)
+		require.Equal(t, reflect.TypeOf(msg), reflect.TypeOf(resolved), "type URL %s resolves to another type", anyMsg.TypeUrl)
+		resolvedMsg, ok := resolved.(codec.ProtoMarshaler)
+		require.True(t, ok, "%T is not a proto marshaler", resolved)
+		require.NoError(t, cdc.Unmarshal(anyMsg.Value, resolvedMsg), "failed to unmarshal packed %s", name)
+		requireSameProto(t, cdc, bz, resolvedMsg, "%s does not round-trip through Any", name)
+
+		if amino == nil {
+			continue
+		}
+
+		// amino
+		aminoBz, err := amino.Marshal(msg)
+		require.NoError(t, err, "failed to amino marshal %s", name)
+		got = newMessage(msg)
+		require.NoError(t, amino.Unmarshal(aminoBz, got), "failed to amino unmarshal %s", name)
+		requireSameProto(t, cdc, bz, got, "%s does not round-trip through amino", name)
+	}
+}
+
+// newMessage returns a new empty message of the same type as msg.
+func newMessage(msg codec.ProtoMarshaler) codec.ProtoMarshaler {
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(codec.ProtoMarshaler)
+}
+
+// requireSameProto requires msg to have the expected proto encoding.
+func requireSameProto(t *testing.T, cdc *codec.ProtoCodec, expected []byte, msg codec.ProtoMarshaler, msgAndArgs ...interface{}) {
+	t.Helper()
+
+	bz, err := cdc.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz, msgAndArgs...)
+}
@@ -46,3 +46,27 @@ func DefaultContextWithDB(t *testing.T, key storetypes.StoreKey, tkey storetypes
 
 	return TestContext{ctx, db, cms}
 }
+
+// DefaultContextWithKeys creates a sdk.Context with a fresh MemDB, mounting the
+// given KV and transient stores, that can be used in tests.
+func DefaultContextWithKeys(
+	keys map[string]*storetypes.KVStoreKey,
+	transKeys map[string]*storetypes.TransientStoreKey,
+) sdk.Context {
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+
+	for _, tKey := range transKeys {
+		cms.MountStoreWithDB(tKey, storetypes.StoreTypeTransient, db)
+	}
+
+	if err := cms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+}
@@ -0,0 +1,41 @@
+// Package events provides helpers to assert on the typed events emitted in
+// tests, instead of comparing raw event attributes.
+package events
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AssertEventEmitted finds the first event of events emitted as a typed event
+// of type T, unmarshals its attributes into T and returns it for further
+// assertions. It fails the test if no such event is found.
+func AssertEventEmitted[T proto.Message](t testing.TB, events sdk.Events) T {
+	t.Helper()
+
+	var zero T
+	eventType := proto.MessageName(zero)
+	require.NotEmpty(t, eventType, "%T is not a registered proto message", zero)
+
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err, "failed to parse %s event", eventType)
+
+		typed, ok := msg.(T)
+		require.True(t, ok, "%s event parsed as %T, expected %T", eventType, msg, zero)
+
+		return typed
+	}
+
+	require.FailNowf(t, "event not emitted", "no %s event found in %d events", eventType, len(events))
+	return zero
+}
@@ -0,0 +1,22 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/events"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func TestAssertEventEmitted(t *testing.T) {
+	em := sdk.NewEventManager()
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("amount", "10stake")))
+	require.NoError(t, em.EmitTypedEvent(&authz.EventGrant{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Granter: "granter", Grantee: "grantee"}))
+	require.NoError(t, em.EmitTypedEvent(&authz.EventGrant{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Granter: "granter", Grantee: "grantee"}))
+
+	grant := events.AssertEventEmitted[*authz.EventGrant](t, em.Events())
+	require.Equal(t, &authz.EventGrant{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Granter: "granter", Grantee: "grantee"}, grant)
+
+}
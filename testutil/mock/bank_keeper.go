@@ -0,0 +1,155 @@
+package mock
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// BankKeeperCall is a call made to a MockBankKeeper. Module accounts are
+// recorded by their address.
+type BankKeeperCall struct {
+	Method string
+	From   sdk.AccAddress // the sender, or the burning module, if any
+	To     sdk.AccAddress // the recipient, or the minting module, if any
+	Amount sdk.Coins
+}
+
+// String implements the Stringer interface.
+func (c BankKeeperCall) String() string {
+	return fmt.Sprintf("%s(from: %s, to: %s, amount: %s)", c.Method, c.From, c.To, c.Amount)
+}
+
+// MockBankKeeper is a bank keeper recording the calls sending, minting and
+// burning coins, without any balance bookkeeping. It implements the
+// corresponding methods of the x/bank keeper, so it satisfies the bank keeper
+// interfaces expected by modules only relying on them. It is safe for
+// concurrent use.
+type MockBankKeeper struct {
+	mtx   sync.Mutex
+	calls []BankKeeperCall
+}
+
+// NewMockBankKeeper returns a new MockBankKeeper with no recorded calls.
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{}
+}
+
+func (k *MockBankKeeper) record(method string, from, to sdk.AccAddress, amt sdk.Coins) error {
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	k.calls = append(k.calls, BankKeeperCall{Method: method, From: from, To: to, Amount: append(sdk.Coins(nil), amt...)})
+	return nil
+}
+
+// SendCoins records a transfer of amt from fromAddr to toAddr.
+func (k *MockBankKeeper) SendCoins(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.record("SendCoins", fromAddr, toAddr, amt)
+}
+
+// SendCoinsFromModuleToAccount records a transfer of amt from the senderModule
+// account to recipientAddr.
+func (k *MockBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.record("SendCoinsFromModuleToAccount", address.Module(senderModule), recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule records a transfer of amt from senderAddr to the
+// recipientModule account.
+func (k *MockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return k.record("SendCoinsFromAccountToModule", senderAddr, address.Module(recipientModule), amt)
+}
+
+// SendCoinsFromModuleToModule records a transfer of amt from the senderModule
+// account to the recipientModule account.
+func (k *MockBankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return k.record("SendCoinsFromModuleToModule", address.Module(senderModule), address.Module(recipientModule), amt)
+}
+
+// MintCoins records the minting of amt by the moduleName account.
+func (k *MockBankKeeper) MintCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	return k.record("MintCoins", nil, address.Module(moduleName), amt)
+}
+
+// BurnCoins records the burning of amt by the moduleName account.
+func (k *MockBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	return k.record("BurnCoins", address.Module(moduleName), nil, amt)
+}
+
+// Calls returns a copy of the recorded calls, in order.
+func (k *MockBankKeeper) Calls() []BankKeeperCall {
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	return append([]BankKeeperCall(nil), k.calls...)
+}
+
+// Reset clears the recorded calls.
+func (k *MockBankKeeper) Reset() {
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	k.calls = nil
+}
+
+// AssertSentCoins asserts that amount was sent from `from` to `to` by one of
+// the recorded calls, module accounts being identified by their address.
+func (k *MockBankKeeper) AssertSentCoins(t testing.TB, from, to sdk.AccAddress, amount sdk.Coins) {
+	t.Helper()
+	k.assertCall(t, func(c BankKeeperCall) bool {
+		return c.Method != "MintCoins" && c.Method != "BurnCoins" && c.From.Equals(from) && c.To.Equals(to) && coinsEqual(c.Amount, amount)
+	}, "no transfer of %s from %s to %s", amount, from, to)
+}
+
+// AssertMintedCoins asserts that amount was minted by the moduleName account.
+func (k *MockBankKeeper) AssertMintedCoins(t testing.TB, moduleName string, amount sdk.Coins) {
+	t.Helper()
+	moduleAddr := address.Module(moduleName)
+	k.assertCall(t, func(c BankKeeperCall) bool {
+		return c.Method == "MintCoins" && c.To.Equals(sdk.AccAddress(moduleAddr)) && coinsEqual(c.Amount, amount)
+	}, "no minting of %s by module %s", amount, moduleName)
+}
+
+// AssertBurnedCoins asserts that amount was burned by the moduleName account.
+func (k *MockBankKeeper) AssertBurnedCoins(t testing.TB, moduleName string, amount sdk.Coins) {
+	t.Helper()
+	moduleAddr := address.Module(moduleName)
+	k.assertCall(t, func(c BankKeeperCall) bool {
+		return c.Method == "BurnCoins" && c.From.Equals(sdk.AccAddress(moduleAddr)) && coinsEqual(c.Amount, amount)
+	}, "no burning of %s by module %s", amount, moduleName)
+}
+
+func (k *MockBankKeeper) assertCall(t testing.TB, match func(BankKeeperCall) bool, msg string, args ...interface{}) {
+	t.Helper()
+
+	calls := k.Calls()
+	for _, c := range calls {
+		if match(c) {
+			return
+		}
+	}
+
+	require.FailNowf(t, fmt.Sprintf(msg, args...), "recorded calls: %v", calls)
+}
+
+// coinsEqual returns whether a and b hold the same coins, in any order, without
+// modifying them.
+func coinsEqual(a, b sdk.Coins) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = append(sdk.Coins(nil), a...).Sort(), append(sdk.Coins(nil), b...).Sort()
+	for i := range a {
+		if a[i].Denom != b[i].Denom || !a[i].Amount.Equal(b[i].Amount) {
+			return false
+		}
+	}
+
+	return true
+}
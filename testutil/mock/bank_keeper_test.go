@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestMockBankKeeper(t *testing.T) {
+	k := NewMockBankKeeper()
+	ctx := sdk.Context{}
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 5))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, k.SendCoins(ctx, from, to, coins))
+		}()
+	}
+	wg.Wait()
+
+	require.NoError(t, k.MintCoins(ctx, "mint", coins))
+	require.NoError(t, k.SendCoinsFromModuleToAccount(ctx, "mint", to, coins))
+	require.NoError(t, k.BurnCoins(ctx, "gov", coins))
+	require.Len(t, k.Calls(), 13)
+
+	k.AssertSentCoins(t, from, to, sdk.Coins{coins[1], coins[0]})
+	k.AssertSentCoins(t, address.Module("mint"), to, coins)
+	k.AssertMintedCoins(t, "mint", coins)
+	k.AssertBurnedCoins(t, "gov", coins)
+
+	k.Reset()
+	require.Empty(t, k.Calls())
+}
@@ -97,6 +97,7 @@ type Config struct {
 	AccountTokens    math.Int                   // the amount of unique validator tokens (e.g. 1000node0)
 	StakingTokens    math.Int                   // the amount of tokens each validator has available to stake
 	BondedTokens     math.Int                   // the amount of tokens each validator stakes
+	BondedTokensDist []math.Int                 // the amount of tokens staked by each validator, overriding BondedTokens if set
 	PruningStrategy  string                     // the pruning strategy each validator will have
 	EnableTMLogging  bool                       // enable Tendermint logging to STDOUT
 	CleanupDir       bool                       // remove base temporary directory during cleanup
@@ -295,8 +296,37 @@ func NewCLILogger(cmd *cobra.Command) CLILogger {
 	return CLILogger{cmd}
 }
 
+// NetworkOption defines an option applied to the network Config by New.
+type NetworkOption func(*Config)
+
+// WithBondedStakeDistribution sets the amount of tokens each validator stakes,
+// the i-th amount being the self-delegation of the i-th validator. The
+// distribution must have one positive amount per validator, none exceeding the
+// configured StakingTokens.
+func WithBondedStakeDistribution(distribution []math.Int) NetworkOption {
+	return func(cfg *Config) {
+		cfg.BondedTokensDist = distribution
+	}
+}
+
 // New creates a new Network for integration tests or in-process testnets run via the CLI
-func New(l Logger, baseDir string, cfg Config) (*Network, error) {
+func New(l Logger, baseDir string, cfg Config, opts ...NetworkOption) (*Network, error) {
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.BondedTokensDist != nil {
+		if len(cfg.BondedTokensDist) != cfg.NumValidators {
+			return nil, fmt.Errorf("bonded stake distribution has %d amounts, expected one per validator (%d)", len(cfg.BondedTokensDist), cfg.NumValidators)
+		}
+
+		for i, amount := range cfg.BondedTokensDist {
+			if amount.IsNil() || !amount.IsPositive() || amount.GT(cfg.StakingTokens) {
+				return nil, fmt.Errorf("invalid bonded stake %s for validator %d: must be positive and not exceed the staking tokens %s", amount, i, cfg.StakingTokens)
+			}
+		}
+	}
+
 	// only one caller/test can create and use a network at a time
 	l.Log("acquiring test network lock")
 	lock.Lock()
@@ -491,10 +521,15 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			return nil, err
 		}
 
+		bondedTokens := cfg.BondedTokens
+		if cfg.BondedTokensDist != nil {
+			bondedTokens = cfg.BondedTokensDist[i]
+		}
+
 		createValMsg, err := stakingtypes.NewMsgCreateValidator(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
-			sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
+			sdk.NewCoin(cfg.BondDenom, bondedTokens),
 			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
 			stakingtypes.NewCommissionRates(commission, math.LegacyOneDec(), math.LegacyOneDec()),
 			math.OneInt(),
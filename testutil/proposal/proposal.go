@@ -0,0 +1,134 @@
+// Package proposal provides helpers to test the block proposal handlers of an
+// application against a real BaseApp.
+package proposal
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimulatedBlock is the outcome of a block proposal simulated by SimulateBlock.
+type SimulatedBlock struct {
+	// CheckTxResponses are the CheckTx responses of the submitted transactions,
+	// in order.
+	CheckTxResponses []abci.ResponseCheckTx
+	// Txs are the transactions selected by PrepareProposal.
+	Txs [][]byte
+	// Status is the status of the proposal returned by ProcessProposal.
+	Status abci.ResponseProcessProposal_ProposalStatus
+	// DeliverTxResponses are the DeliverTx responses of the selected
+	// transactions if the proposal was accepted, in order.
+	DeliverTxResponses []abci.ResponseDeliverTx
+	// Events are the events emitted by the execution of the accepted block.
+	Events []abci.Event
+	// GasUsed is the gas consumed by the transactions of the accepted block.
+	GasUsed int64
+}
+
+// Option configures SimulateBlock.
+type Option func(*options)
+
+type options struct {
+	expectedStatus abci.ResponseProcessProposal_ProposalStatus
+}
+
+// WithExpectedStatus sets the status ProcessProposal is expected to return,
+// ACCEPT by default.
+func WithExpectedStatus(status abci.ResponseProcessProposal_ProposalStatus) Option {
+	return func(o *options) {
+		o.expectedStatus = status
+	}
+}
+
+// SimulateBlock submits txs to app with CheckTx, then runs PrepareProposal and
+// ProcessProposal for the next block, and asserts ProcessProposal returns the
+// expected status, ACCEPT by default. Transactions failing CheckTx are not
+// proposed. If the proposal is accepted, the block is executed to collect its
+// events and gas consumption, but it is not committed: the state changes of its
+// execution are discarded by the next BeginBlock.
+//
+// The simulation is not free of side effects on app though:
+//   - CheckTx updates the check state, which is only reset on Commit, so the
+//     sequences of the signers of the accepted transactions stay incremented
+//     for the following CheckTx calls, including those of another SimulateBlock;
+//   - the transactions accepted by CheckTx are inserted in the mempool of app,
+//     if any, and only the ones executed by DeliverTx are removed from it, so
+//     the transactions of a rejected proposal remain in the mempool.
+//
+// Use a fresh app for each simulation that must not observe the previous ones.
+func SimulateBlock(t *testing.T, app *baseapp.BaseApp, txEncoder sdk.TxEncoder, txs []sdk.Tx, opts ...Option) SimulatedBlock {
+	t.Helper()
+
+	o := options{expectedStatus: abci.ResponseProcessProposal_ACCEPT}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var (
+		block      SimulatedBlock
+		candidates [][]byte
+		maxTxBytes int64
+	)
+	for _, tx := range txs {
+		bz, err := txEncoder(tx)
+		require.NoError(t, err)
+
+		res := app.CheckTx(abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+		block.CheckTxResponses = append(block.CheckTxResponses, res)
+		if !res.IsOK() {
+			continue
+		}
+
+		candidates = append(candidates, bz)
+		maxTxBytes += int64(len(bz))
+	}
+
+	height := app.LastBlockHeight() + 1
+	now := time.Now()
+
+	prepareRes := app.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: maxTxBytes,
+		Txs:        candidates,
+		Height:     height,
+		Time:       now,
+	})
+	block.Txs = prepareRes.Txs
+
+	processRes := app.ProcessProposal(abci.RequestProcessProposal{
+		Txs:    block.Txs,
+		Height: height,
+		Time:   now,
+	})
+	block.Status = processRes.Status
+	require.Equal(t, o.expectedStatus, block.Status, "unexpected ProcessProposal status")
+
+	if block.Status != abci.ResponseProcessProposal_ACCEPT {
+		return block
+	}
+
+	beginRes := app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: app.ChainID(),
+		Height:  height,
+		Time:    now,
+	}})
+	block.Events = append(block.Events, beginRes.Events...)
+
+	for _, bz := range block.Txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+		block.DeliverTxResponses = append(block.DeliverTxResponses, res)
+		block.Events = append(block.Events, res.Events...)
+		block.GasUsed += res.GasUsed
+	}
+
+	endRes := app.EndBlock(abci.RequestEndBlock{Height: height})
+	block.Events = append(block.Events, endRes.Events...)
+
+	return block
+}
@@ -0,0 +1,91 @@
+package proposal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/proposal"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+type counterServer struct{}
+
+func (counterServer) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(100, "counter")
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("counter"))
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func TestSimulateBlock(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	anteHandler := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if tx.(sdk.TxWithMemo).GetMemo() == "invalid" {
+			return ctx, errors.New("invalid tx")
+		}
+
+		ctx.GasMeter().ConsumeGas(10, "ante")
+		ctx.EventManager().EmitEvent(sdk.NewEvent("ante"))
+		return ctx, nil
+	}
+	processProposal := func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		if len(req.Txs) > 2 {
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder(), func(app *baseapp.BaseApp) {
+		app.SetAnteHandler(anteHandler)
+		app.SetProcessProposal(processProposal)
+	})
+	app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+	app.MsgServiceRouter().SetInterfaceRegistry(cdc.InterfaceRegistry())
+	baseapptestutil.RegisterCounterServer(app.MsgServiceRouter(), counterServer{})
+	app.MountStores(sdk.NewKVStoreKey("main"))
+	require.NoError(t, app.LoadLatestVersion())
+
+	newTx := func(memo string) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: 1}))
+		builder.SetMemo(memo)
+		return builder.GetTx()
+	}
+
+	block := proposal.SimulateBlock(t, app, txConfig.TxEncoder(), []sdk.Tx{newTx("a"), newTx("invalid"), newTx("b")})
+	require.Len(t, block.CheckTxResponses, 3)
+	require.False(t, block.CheckTxResponses[1].IsOK())
+	require.Len(t, block.Txs, 2)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, block.Status)
+	require.Len(t, block.DeliverTxResponses, 2)
+	require.Equal(t, block.DeliverTxResponses[0].GasUsed+block.DeliverTxResponses[1].GasUsed, block.GasUsed)
+	require.GreaterOrEqual(t, block.GasUsed, int64(2*110))
+
+	var counterEvents int
+	for _, event := range block.Events {
+		if event.Type == "counter" {
+			counterEvents++
+		}
+	}
+	require.Equal(t, 2, counterEvents)
+
+	block = proposal.SimulateBlock(t, app, txConfig.TxEncoder(), []sdk.Tx{newTx("c"), newTx("d"), newTx("e")},
+		proposal.WithExpectedStatus(abci.ResponseProcessProposal_REJECT))
+	require.Len(t, block.Txs, 3)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, block.Status)
+	require.Empty(t, block.DeliverTxResponses)
+}
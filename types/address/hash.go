@@ -3,6 +3,7 @@ package address
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"sort"
 
@@ -90,3 +91,33 @@ func Module(moduleName string, derivationKeys ...[]byte) []byte {
 func Derive(address []byte, key []byte) []byte {
 	return Hash(conv.UnsafeBytesToStr(address), key)
 }
+
+// DeriveAddress deterministically derives an address from a namespace and a
+// sequence of keys. The namespace is used as the domain separator of Hash, with
+// a "derive/" prefix so that it can't clash with the types used by other address
+// functions, and each key is prefixed with its 8 byte big-endian length so that
+// different sequences of keys can't be concatenated into the same input. As the
+// result is a full SHA-256 digest, finding two colliding (namespace, keys)
+// pairs requires about 2^128 hashes.
+//
+// The returned bytes are meant to be converted to an sdk.AccAddress.
+func DeriveAddress(namespace string, keys ...[]byte) []byte {
+	totalLen := 0
+	for _, k := range keys {
+		totalLen += 8 + len(k)
+	}
+
+	key := make([]byte, 0, totalLen)
+	for _, k := range keys {
+		key = binary.BigEndian.AppendUint64(key, uint64(len(k)))
+		key = append(key, k...)
+	}
+
+	return Hash("derive/"+namespace, key)
+}
+
+// IBCChannelAddress derives the address of the IBC channel identified by portID
+// and channelID with DeriveAddress.
+func IBCChannelAddress(portID, channelID string) []byte {
+	return DeriveAddress("ibc-channel", []byte(portID), []byte(channelID))
+}
@@ -106,6 +106,26 @@ func (suite *AddressSuite) TestDerive() {
 	assert.NotEqual(d2, d3)
 }
 
+func (suite *AddressSuite) TestDeriveAddress() {
+	assert := suite.Assert()
+	addr := DeriveAddress("ns", []byte{1, 2}, []byte{3})
+	assert.Len(addr, Len)
+	assert.Equal(addr, DeriveAddress("ns", []byte{1, 2}, []byte{3}), "must be deterministic")
+
+	expected := Hash("derive/ns", []byte{0, 0, 0, 0, 0, 0, 0, 2, 1, 2, 0, 0, 0, 0, 0, 0, 0, 1, 3})
+	assert.Equal(expected, addr)
+
+	assert.NotEqual(addr, DeriveAddress("other", []byte{1, 2}, []byte{3}), "must be sensitive to the namespace")
+	assert.NotEqual(addr, DeriveAddress("ns", []byte{1}, []byte{2, 3}), "must be sensitive to key boundaries")
+	assert.NotEqual(addr, DeriveAddress("ns", []byte{1, 2, 3}))
+	assert.NotEqual(DeriveAddress("module", []byte("bank")), Module("bank", []byte{}), "must not clash with module addresses")
+
+	ch := IBCChannelAddress("transfer", "channel-0")
+	assert.Equal(DeriveAddress("ibc-channel", []byte("transfer"), []byte("channel-0")), ch)
+	assert.NotEqual(ch, IBCChannelAddress("transfer", "channel-1"))
+	assert.NotEqual(ch, IBCChannelAddress("transferc", "hannel-0"))
+}
+
 type addrMock struct {
 	Addr []byte
 }
@@ -167,6 +167,14 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrTxTimeout defines an error for when a tx is rejected out due to an
+	// explicitly set timeout timestamp.
+	ErrTxTimeout = Register(RootCodespace, 42, "tx timeout")
+
+	// ErrQueryTimeout defines an error for when a query is abandoned because it
+	// did not complete within the configured ABCI query timeout.
+	ErrQueryTimeout = Register(RootCodespace, 43, "query timeout")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
@@ -0,0 +1,133 @@
+/*
+Package events provides the runtime support of the typed event builders
+generated by protoc-gen-go-event-builder.
+
+For every proto message whose name starts with Event, the generator emits a
+builder with a typed setter per field, e.g.:
+
+	event, err := authz.NewEventGrantBuilder().
+		WithMsgTypeUrl(msgTypeURL).
+		WithGranter(granter).
+		WithGrantee(grantee).
+		Build()
+
+The built events are identical to the ones emitted for the same typed event
+by EventManager.EmitTypedEvent, so attribute keys never need to be written as
+string literals.
+*/
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Builder builds an sdk.Event with the same type and attributes as the event
+// returned by sdk.TypedEventToEvent for a typed event. Every attribute starts
+// with the value of the zero typed event.
+type Builder struct {
+	eventType string
+	attrs     map[string]string
+	err       error
+}
+
+// NewBuilder returns a Builder of events of the type of the given zero typed
+// event.
+func NewBuilder(zero proto.Message) *Builder {
+	event, err := sdk.TypedEventToEvent(zero)
+
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	return &Builder{
+		eventType: event.Type,
+		attrs:     attrs,
+		err:       err,
+	}
+}
+
+// Set sets the attribute key to the JSON encoding of value.
+func (b *Builder) Set(key string, value interface{}) {
+	if !b.checkKey(key) {
+		return
+	}
+
+	// nil slices are rendered as empty lists by typed events
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice && v.IsNil() && v.Type().Elem().Kind() != reflect.Uint8 {
+		b.attrs[key] = "[]"
+		return
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		b.err = fmt.Errorf("failed to encode attribute %s of event %s: %w", key, b.eventType, err)
+		return
+	}
+
+	b.attrs[key] = string(bz)
+}
+
+// SetProto sets the attribute key to the proto JSON encoding of msg.
+func (b *Builder) SetProto(key string, msg proto.Message) {
+	if !b.checkKey(key) {
+		return
+	}
+
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		b.attrs[key] = "null"
+		return
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		b.err = fmt.Errorf("failed to encode attribute %s of event %s: %w", key, b.eventType, err)
+		return
+	}
+
+	b.attrs[key] = string(bz)
+}
+
+func (b *Builder) checkKey(key string) bool {
+	if b.err != nil {
+		return false
+	}
+
+	if _, ok := b.attrs[key]; !ok {
+		b.err = fmt.Errorf("unknown attribute %s for event %s", key, b.eventType)
+		return false
+	}
+
+	return true
+}
+
+// Build returns the built event, or the first error encountered while setting
+// its attributes.
+func (b *Builder) Build() (sdk.Event, error) {
+	if b.err != nil {
+		return sdk.Event{}, b.err
+	}
+
+	// sort the keys to ensure the order is always the same
+	keys := make([]string, 0, len(b.attrs))
+	for k := range b.attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]abci.EventAttribute, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, abci.EventAttribute{Key: k, Value: b.attrs[k]})
+	}
+
+	return sdk.Event{Type: b.eventType, Attributes: attrs}, nil
+}
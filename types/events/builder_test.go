@@ -0,0 +1,81 @@
+package events_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/events"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestGeneratedBuilders(t *testing.T) {
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name     string
+		build    func() (sdk.Event, error)
+		expected *sdk.Event
+	}{
+		{
+			"addresses",
+			authz.NewEventGrantBuilder().WithMsgTypeUrl("/cosmos.bank.v1beta1.MsgSend").WithGranter(granter).WithGrantee(grantee).Build,
+			typedEvent(t, &authz.EventGrant{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Granter: granter.String(), Grantee: grantee.String()}),
+		},
+		{
+			"default values",
+			authz.NewEventRevokeBuilder().Build,
+			typedEvent(t, &authz.EventRevoke{}),
+		},
+		{
+			"uint64 and enum",
+			group.NewEventExecBuilder().WithProposalId(5).WithResult(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS).WithLogs(`"quoted" <logs>`).Build,
+			typedEvent(t, &group.EventExec{ProposalId: 5, Result: group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, Logs: `"quoted" <logs>`}),
+		},
+		{
+			"message",
+			group.NewEventProposalPrunedBuilder().WithProposalId(1).WithStatus(group.PROPOSAL_STATUS_ACCEPTED).WithTallyResult(&group.TallyResult{YesCount: "2", NoCount: "1"}).Build,
+			typedEvent(t, &group.EventProposalPruned{ProposalId: 1, Status: group.PROPOSAL_STATUS_ACCEPTED, TallyResult: &group.TallyResult{YesCount: "2", NoCount: "1"}}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := tc.build()
+			require.NoError(t, err)
+			require.Equal(t, *tc.expected, event)
+
+			_, err = sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	b := events.NewBuilder(&group.EventLeaveGroup{})
+	b.Set("group_id", "3")
+	b.Set("address", []string(nil))
+	event, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewEvent("cosmos.group.v1.EventLeaveGroup",
+		sdk.NewAttribute("address", "[]"),
+		sdk.NewAttribute("group_id", `"3"`),
+	), event)
+
+	b.Set("unknown", "value")
+	_, err = b.Build()
+	require.EqualError(t, err, "unknown attribute unknown for event cosmos.group.v1.EventLeaveGroup")
+}
+
+func typedEvent(t *testing.T, msg proto.Message) *sdk.Event {
+	t.Helper()
+	event, err := sdk.TypedEventToEvent(msg)
+	require.NoError(t, err)
+	return &event
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	sdkPackage     = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/types")
+	eventsPackage  = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/types/events")
+	strconvPackage = protogen.GoImportPath("strconv")
+
+	eventPrefix = "Event"
+)
+
+// generateFile generates the builders of the event messages of file, if any.
+func generateFile(gen *protogen.Plugin, file *protogen.File) {
+	var events []*protogen.Message
+	for _, msg := range file.Messages {
+		if strings.HasPrefix(msg.GoIdent.GoName, eventPrefix) {
+			events = append(events, msg)
+		}
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_builder.pb.go", file.GoImportPath)
+	g.P("// Code generated by protoc-gen-go-event-builder. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", file.GoPackageName)
+
+	for _, event := range events {
+		generateBuilder(g, file, event)
+	}
+}
+
+func generateBuilder(g *protogen.GeneratedFile, file *protogen.File, event *protogen.Message) {
+	eventName := event.GoIdent.GoName
+	name := eventName + "Builder"
+
+	g.P()
+	g.P("// ", name, " builds ", eventName, " events with typed attribute setters. The built")
+	g.P("// events are identical to the ones emitted for ", eventName, " by EmitTypedEvent.")
+	g.P("type ", name, " struct {")
+	g.P("b *", eventsPackage.Ident("Builder"))
+	g.P("}")
+	g.P()
+	g.P("// New", name, " returns a builder of ", eventName, " events with every attribute")
+	g.P("// set to its default value.")
+	g.P("func New", name, "() ", name, " {")
+	g.P("return ", name, "{b: ", eventsPackage.Ident("NewBuilder"), "(&", event.GoIdent, "{})}")
+	g.P("}")
+
+	for _, field := range event.Fields {
+		generateSetter(g, file, name, field)
+	}
+
+	g.P()
+	g.P("// Build returns the built event.")
+	g.P("func (b ", name, ") Build() (", sdkPackage.Ident("Event"), ", error) {")
+	g.P("return b.b.Build()")
+	g.P("}")
+}
+
+// generateSetter generates the setter of field. Fields which have no typed
+// representation in the builder, such as oneofs, maps and messages of other
+// packages, keep their default value.
+func generateSetter(g *protogen.GeneratedFile, file *protogen.File, name string, field *protogen.Field) {
+	if field.Oneof != nil || field.Desc.IsMap() {
+		return
+	}
+
+	param, value, method, ok := setterArgs(file, field)
+	if !ok {
+		return
+	}
+
+	key := string(field.Desc.Name())
+	g.P()
+	g.P("// With", field.GoName, " sets the ", key, " attribute.")
+	args := append([]interface{}{"func (b ", name, ") With", field.GoName, "(v "}, param...)
+	g.P(append(args, ") ", name, " {")...)
+	g.P(append([]interface{}{"b.b.", method, "(", strconv.Quote(key), ", "}, append(value, ")")...)...)
+	g.P("return b")
+	g.P("}")
+}
+
+// setterArgs returns the parameter type of the setter of field, the value it
+// sets and the Builder method setting it.
+func setterArgs(file *protogen.File, field *protogen.Field) (param, value []interface{}, method string, ok bool) {
+	repeated := field.Desc.IsList()
+	v := []interface{}{"v"}
+
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		switch scalar(field) {
+		case "cosmos.AddressString":
+			if !repeated {
+				return []interface{}{sdkPackage.Ident("AccAddress")}, []interface{}{"v.String()"}, "Set", true
+			}
+		case "cosmos.ValidatorAddressString":
+			if !repeated {
+				return []interface{}{sdkPackage.Ident("ValAddress")}, []interface{}{"v.String()"}, "Set", true
+			}
+		}
+		return listOf(repeated, "string"), v, "Set", true
+
+	case protoreflect.BoolKind:
+		return listOf(repeated, "bool"), v, "Set", true
+
+	case protoreflect.BytesKind:
+		return listOf(repeated, "[]byte"), v, "Set", true
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return listOf(repeated, "int32"), v, "Set", true
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return listOf(repeated, "uint32"), v, "Set", true
+
+	case protoreflect.FloatKind:
+		return listOf(repeated, "float32"), v, "Set", true
+
+	case protoreflect.DoubleKind:
+		return listOf(repeated, "float64"), v, "Set", true
+
+	// 64 bit integers are encoded as JSON strings
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if repeated {
+			return nil, nil, "", false
+		}
+		return []interface{}{"int64"}, []interface{}{strconvPackage.Ident("FormatInt"), "(v, 10)"}, "Set", true
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if repeated {
+			return nil, nil, "", false
+		}
+		return []interface{}{"uint64"}, []interface{}{strconvPackage.Ident("FormatUint"), "(v, 10)"}, "Set", true
+
+	// enums are encoded by name
+	case protoreflect.EnumKind:
+		if repeated || field.Enum.GoIdent.GoImportPath != file.GoImportPath {
+			return nil, nil, "", false
+		}
+		return []interface{}{field.Enum.GoIdent}, []interface{}{"v.String()"}, "Set", true
+
+	case protoreflect.MessageKind:
+		switch field.Message.Desc.FullName() {
+		case "cosmos.base.v1beta1.Coin":
+			if repeated {
+				return []interface{}{sdkPackage.Ident("Coins")}, v, "Set", true
+			}
+			return []interface{}{sdkPackage.Ident("Coin")}, v, "Set", true
+		case "cosmos.base.v1beta1.DecCoin":
+			if repeated {
+				return []interface{}{sdkPackage.Ident("DecCoins")}, v, "Set", true
+			}
+			return []interface{}{sdkPackage.Ident("DecCoin")}, v, "Set", true
+		}
+
+		if repeated || field.Message.GoIdent.GoImportPath != file.GoImportPath {
+			return nil, nil, "", false
+		}
+		return []interface{}{"*", field.Message.GoIdent}, v, "SetProto", true
+	}
+
+	return nil, nil, "", false
+}
+
+func listOf(repeated bool, typ string) []interface{} {
+	if repeated {
+		return []interface{}{"[]" + typ}
+	}
+	return []interface{}{typ}
+}
+
+// scalar returns the cosmos_proto.scalar option of field.
+func scalar(field *protogen.Field) string {
+	opts, ok := field.Desc.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return ""
+	}
+
+	s, _ := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return s
+}
@@ -0,0 +1,18 @@
+// protoc-gen-go-event-builder generates typed builders for the proto event
+// messages of a package, see github.com/cosmos/cosmos-sdk/types/events.
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func main() {
+	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+		for _, f := range gen.Files {
+			if f.Generate {
+				generateFile(gen, f)
+			}
+		}
+		return nil
+	})
+}
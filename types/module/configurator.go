@@ -36,6 +36,16 @@ type Configurator interface {
 	RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error
 }
 
+// MigrationChainValidator is an optional extension of a Configurator validating
+// the migrations registered for a module.
+type MigrationChainValidator interface {
+	// ValidateMigrationChain verifies that a migration is registered for a module
+	// from every version between 1 and `latestVersion`, and that no migration is
+	// registered from `latestVersion` or above. This allows catching missing
+	// migrations at startup rather than at upgrade time.
+	ValidateMigrationChain(moduleName string, latestVersion uint64) error
+}
+
 type configurator struct {
 	cdc         codec.Codec
 	msgServer   grpc.Server
@@ -55,7 +65,10 @@ func NewConfigurator(cdc codec.Codec, msgServer grpc.Server, queryServer grpc.Se
 	}
 }
 
-var _ Configurator = configurator{}
+var (
+	_ Configurator            = configurator{}
+	_ MigrationChainValidator = configurator{}
+)
 
 // MsgServer implements the Configurator.MsgServer method
 func (c configurator) MsgServer() grpc.Server {
@@ -86,6 +99,25 @@ func (c configurator) RegisterMigration(moduleName string, fromVersion uint64, h
 	return nil
 }
 
+// ValidateMigrationChain implements the MigrationChainValidator.ValidateMigrationChain method
+func (c configurator) ValidateMigrationChain(moduleName string, latestVersion uint64) error {
+	moduleMigrationsMap := c.migrations[moduleName]
+
+	for i := uint64(1); i < latestVersion; i++ {
+		if _, found := moduleMigrationsMap[i]; !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migration registered for module %s from version %d to version %d", moduleName, i, i+1)
+		}
+	}
+
+	for fromVersion := range moduleMigrationsMap {
+		if fromVersion >= latestVersion {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidVersion, "migration registered for module %s from version %d exceeds its consensus version %d", moduleName, fromVersion, latestVersion)
+		}
+	}
+
+	return nil
+}
+
 // runModuleMigrations runs all in-place store migrations for one given module from a
 // version to another version.
 func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
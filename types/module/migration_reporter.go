@@ -0,0 +1,43 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// MigrationProgressReporter is notified by Manager.RunMigrations when the
+// in-place store migrations of a module start and complete, allowing to track
+// the progress of long-running upgrades.
+type MigrationProgressReporter interface {
+	// OnModuleMigrationStart is called before running the migrations of a
+	// module from fromVersion to toVersion.
+	OnModuleMigrationStart(moduleName string, fromVersion, toVersion uint64)
+
+	// OnModuleMigrationComplete is called once the migrations of a module ran
+	// successfully, with the time they took.
+	OnModuleMigrationComplete(moduleName string, elapsed time.Duration)
+}
+
+// LoggingMigrationReporter is a MigrationProgressReporter writing structured
+// log lines.
+type LoggingMigrationReporter struct {
+	logger log.Logger
+}
+
+var _ MigrationProgressReporter = LoggingMigrationReporter{}
+
+// NewLoggingMigrationReporter returns a new LoggingMigrationReporter instance.
+func NewLoggingMigrationReporter(logger log.Logger) LoggingMigrationReporter {
+	return LoggingMigrationReporter{logger: logger}
+}
+
+// OnModuleMigrationStart implements the MigrationProgressReporter interface.
+func (r LoggingMigrationReporter) OnModuleMigrationStart(moduleName string, fromVersion, toVersion uint64) {
+	r.logger.Info("starting module migration", "module", moduleName, "from_version", fromVersion, "to_version", toVersion)
+}
+
+// OnModuleMigrationComplete implements the MigrationProgressReporter interface.
+func (r LoggingMigrationReporter) OnModuleMigrationComplete(moduleName string, elapsed time.Duration) {
+	r.logger.Info("completed module migration", "module", moduleName, "elapsed", elapsed.String())
+}
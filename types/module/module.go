@@ -32,6 +32,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -109,6 +110,33 @@ func (bm BasicManager) DefaultGenesis(cdc codec.JSONCodec) map[string]json.RawMe
 	return genesis
 }
 
+// DefaultGenesisWithOverrides provides default genesis information for all
+// modules, where the genesis of the modules present in overrides is replaced by
+// the provided one. Each overridden genesis is validated by its module.
+func (bm BasicManager) DefaultGenesisWithOverrides(
+	cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, overrides map[string]json.RawMessage,
+) (map[string]json.RawMessage, error) {
+	genesis := bm.DefaultGenesis(cdc)
+
+	moduleNames := maps.Keys(overrides)
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		mod, ok := bm[moduleName].(HasGenesisBasics)
+		if !ok {
+			return nil, fmt.Errorf("cannot override genesis of module %s: module not found or without genesis", moduleName)
+		}
+
+		if err := mod.ValidateGenesis(cdc, txEncCfg, overrides[moduleName]); err != nil {
+			return nil, fmt.Errorf("invalid genesis override for module %s: %w", moduleName, err)
+		}
+
+		genesis[moduleName] = overrides[moduleName]
+	}
+
+	return genesis, nil
+}
+
 // ValidateGenesis performs genesis state validation for all modules
 func (bm BasicManager) ValidateGenesis(cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, genesis map[string]json.RawMessage) error {
 	for _, b := range bm {
@@ -340,6 +368,30 @@ func (m *Manager) RegisterServices(cfg Configurator) {
 	}
 }
 
+// ValidateMigrationChains verifies, for each module declaring a consensus
+// version, that the migrations registered in the configurator form a complete
+// chain up to that version. It is a no-op if the configurator does not
+// implement MigrationChainValidator.
+func (m *Manager) ValidateMigrationChains(cfg Configurator) error {
+	validator, ok := cfg.(MigrationChainValidator)
+	if !ok {
+		return nil
+	}
+
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		if module, ok := m.Modules[moduleName].(HasConsensusVersion); ok {
+			if err := validator.ValidateMigrationChain(moduleName, module.ConsensusVersion()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // InitGenesis performs init genesis functionality for modules. Exactly one
 // module must return a non-empty validator set update to correctly initialize
 // the chain.
@@ -499,8 +551,11 @@ type VersionMap map[string]uint64
 //	    return app.mm.RunMigrations(ctx, cfg, fromVM)
 //	})
 //
+// Optional MigrationProgressReporters can be passed to be notified when the
+// in-place store migrations of each module start and complete.
+//
 // Please also refer to docs/core/upgrade.md for more information.
-func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap, reporters ...MigrationProgressReporter) (VersionMap, error) {
 	c, ok := cfg.(configurator)
 	if !ok {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
@@ -528,10 +583,25 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
 		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
 		if exists {
+			reportMigration := fromVersion < toVersion
+			if reportMigration {
+				for _, reporter := range reporters {
+					reporter.OnModuleMigrationStart(moduleName, fromVersion, toVersion)
+				}
+			}
+
+			start := time.Now()
 			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
 			if err != nil {
 				return nil, err
 			}
+
+			if reportMigration {
+				elapsed := time.Since(start)
+				for _, reporter := range reporters {
+					reporter.OnModuleMigrationComplete(moduleName, elapsed)
+				}
+			}
 		} else {
 			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
 			if module, ok := m.Modules[moduleName].(HasGenesis); ok {
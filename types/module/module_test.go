@@ -3,7 +3,9 @@ package module_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
@@ -62,6 +64,37 @@ func TestBasicManager(t *testing.T) {
 	require.Nil(t, module.NewBasicManager().ValidateGenesis(cdc, nil, wantDefaultGenesis))
 }
 
+func TestBasicManager_DefaultGenesisWithOverrides(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	mockAppModuleBasic1 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModuleBasic2 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModuleBasic1.EXPECT().Name().AnyTimes().Return("mockAppModuleBasic1")
+	mockAppModuleBasic2.EXPECT().Name().AnyTimes().Return("mockAppModuleBasic2")
+	mockAppModuleBasic1.EXPECT().DefaultGenesis(gomock.Eq(cdc)).AnyTimes().Return(json.RawMessage(`{"a":1}`))
+	mockAppModuleBasic2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).AnyTimes().Return(json.RawMessage(`{"b":1}`))
+	mockAppModuleBasic2.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Eq(nil), gomock.Eq(json.RawMessage(`{"b":2}`))).Times(1).Return(nil)
+	mockAppModuleBasic2.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Eq(nil), gomock.Eq(json.RawMessage(`{"b":-1}`))).Times(1).Return(errFoo)
+
+	mm := module.NewBasicManager(mockAppModuleBasic1, mockAppModuleBasic2)
+
+	genesis, err := mm.DefaultGenesisWithOverrides(cdc, nil, map[string]json.RawMessage{"mockAppModuleBasic2": json.RawMessage(`{"b":2}`)})
+	require.NoError(t, err)
+	require.Equal(t, map[string]json.RawMessage{
+		"mockAppModuleBasic1": json.RawMessage(`{"a":1}`),
+		"mockAppModuleBasic2": json.RawMessage(`{"b":2}`),
+	}, genesis)
+
+	_, err = mm.DefaultGenesisWithOverrides(cdc, nil, map[string]json.RawMessage{"mockAppModuleBasic2": json.RawMessage(`{"b":-1}`)})
+	require.ErrorIs(t, err, errFoo)
+	require.ErrorContains(t, err, "invalid genesis override for module mockAppModuleBasic2")
+
+	_, err = mm.DefaultGenesisWithOverrides(cdc, nil, map[string]json.RawMessage{"unknown": json.RawMessage(`{}`)})
+	require.ErrorContains(t, err, "cannot override genesis of module unknown")
+}
+
 func TestGenesisOnlyAppModule(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -145,6 +178,79 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 	mm.RegisterServices(cfg)
 }
 
+func TestManager_ValidateMigrationChains(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	cfg := module.NewConfigurator(cdc, mock.NewMockServer(mockCtrl), mock.NewMockServer(mockCtrl))
+	noop := func(sdk.Context) error { return nil }
+
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+	require.EqualError(t, mm.ValidateMigrationChains(cfg), "no migration registered for module module2 from version 2 to version 3: not found")
+
+	require.NoError(t, cfg.RegisterMigration("module2", 2, noop))
+	require.NoError(t, mm.ValidateMigrationChains(cfg))
+
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.EqualError(t, mm.ValidateMigrationChains(cfg), "migration registered for module module1 from version 1 exceeds its consensus version 1: invalid version")
+
+	// configurators not validating their migrations are skipped
+	require.NoError(t, mm.ValidateMigrationChains(struct{ module.Configurator }{cfg}))
+}
+
+type recordingMigrationReporter struct {
+	started   []string
+	completed []string
+}
+
+func (r *recordingMigrationReporter) OnModuleMigrationStart(moduleName string, fromVersion, toVersion uint64) {
+	r.started = append(r.started, fmt.Sprintf("%s:%d->%d", moduleName, fromVersion, toVersion))
+}
+
+func (r *recordingMigrationReporter) OnModuleMigrationComplete(moduleName string, _ time.Duration) {
+	r.completed = append(r.completed, moduleName)
+}
+
+func TestManager_RunMigrationsReporter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(2))
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, mock.NewMockServer(mockCtrl), mock.NewMockServer(mockCtrl))
+	noop := func(sdk.Context) error { return nil }
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+
+	reporter := &recordingMigrationReporter{}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 2}, reporter, module.NewLoggingMigrationReporter(log.NewNopLogger()))
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 2}, vm)
+
+	// module2 is already at its consensus version, so it is not reported
+	require.Equal(t, []string{"module1:1->3"}, reporter.started)
+	require.Equal(t, []string{"module1"}, reporter.completed)
+}
+
 func TestManager_InitGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -251,3 +357,24 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestSimulationManager_BlockInvariant(t *testing.T) {
+	sm := module.NewSimulationManager()
+	broken := false
+	sm.RegisterRoute("module1", "ok", func(sdk.Context) (string, bool) { return "", false })
+	sm.RegisterRoute("module2", "total-supply", func(sdk.Context) (string, bool) { return "supply mismatch", broken })
+
+	require.Nil(t, sm.BlockInvariant())
+
+	sm.RunInvariantsEveryBlock = true
+	invar := sm.BlockInvariant()
+	require.NotNil(t, invar)
+
+	_, stop := invar(sdk.Context{})
+	require.False(t, stop)
+
+	broken = true
+	msg, stop := invar(sdk.Context{})
+	require.True(t, stop)
+	require.Equal(t, "module2/total-supply: supply mismatch", msg)
+}
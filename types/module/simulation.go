@@ -2,6 +2,7 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"sort"
 	"time"
@@ -43,6 +44,20 @@ type HasProposalContents interface {
 type SimulationManager struct {
 	Modules       []AppModuleSimulation    // array of app modules; we use an array for deterministic simulation tests
 	StoreDecoders sdk.StoreDecoderRegistry // functions to decode the key-value pairs from each module's store
+
+	// RunInvariantsEveryBlock makes BlockInvariant assert all the registered
+	// invariants after every simulated block.
+	RunInvariantsEveryBlock bool
+
+	invariants []registeredInvariant
+}
+
+// registeredInvariant is an invariant registered on the SimulationManager along
+// with its module name and route.
+type registeredInvariant struct {
+	moduleName string
+	route      string
+	invar      sdk.Invariant
 }
 
 // NewSimulationManager creates a new SimulationManager object
@@ -121,6 +136,33 @@ func (sm *SimulationManager) RegisterStoreDecoders() {
 	}
 }
 
+// RegisterRoute registers an invariant to be asserted by BlockInvariant. It
+// implements sdk.InvariantRegistry, so the invariants of every module can be
+// registered with the module Manager's RegisterInvariants.
+func (sm *SimulationManager) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	sm.invariants = append(sm.invariants, registeredInvariant{moduleName: moduleName, route: route, invar: invar})
+}
+
+// BlockInvariant returns an invariant asserting, in registration order, all the
+// invariants registered on the SimulationManager. It returns nil if
+// RunInvariantsEveryBlock is not set, so that it can be used directly as the
+// simulation Config's BlockInvariant.
+func (sm *SimulationManager) BlockInvariant() sdk.Invariant {
+	if !sm.RunInvariantsEveryBlock {
+		return nil
+	}
+
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range sm.invariants {
+			if res, stop := inv.invar(ctx); stop {
+				return fmt.Sprintf("%s/%s: %s", inv.moduleName, inv.route, res), true
+			}
+		}
+
+		return "", false
+	}
+}
+
 // GenerateGenesisStates generates a randomized GenesisState for each of the
 // registered modules
 func (sm *SimulationManager) GenerateGenesisStates(simState *SimulationState) {
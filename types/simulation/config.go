@@ -1,5 +1,7 @@
 package simulation
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
 	GenesisFile string // custom simulation genesis file; cannot be used with params file
@@ -9,6 +11,7 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportGasPath      string // custom file path to save the gas consumed by each message type as JSON
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -22,6 +25,9 @@ type Config struct {
 	OnOperation   bool // run slow invariants every operation
 	AllInvariants bool // print all failed invariants if a broken invariant is found
 
+	BlockInvariant sdk.Invariant // invariant asserted after every simulated block, if set
+	GasRecorder    *GasRecorder  // recorder of the gas consumed by each message type, may be shared across runs
+
 	DBBackend   string // custom db backend type
 	BlockMaxGas int64  // custom max gas for block
 }
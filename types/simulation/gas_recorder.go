@@ -0,0 +1,111 @@
+package simulation
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+)
+
+// recommendedGasStep is the granularity the recommended gas constants are
+// rounded up to.
+const recommendedGasStep = 1000
+
+// GasRecord holds the gas consumed by the executions of a message type.
+type GasRecord struct {
+	Count uint64 `json:"count"`
+	Min   uint64 `json:"min"`
+	Max   uint64 `json:"max"`
+	Total uint64 `json:"total"`
+}
+
+// Mean returns the mean gas consumed by the executions of the message type.
+func (r GasRecord) Mean() uint64 {
+	if r.Count == 0 {
+		return 0
+	}
+
+	return r.Total / r.Count
+}
+
+// Recommended returns the recommended gas constant for the message type, i.e.
+// the maximum gas consumed rounded up to the next multiple of 1000.
+func (r GasRecord) Recommended() uint64 {
+	return (r.Max + recommendedGasStep - 1) / recommendedGasStep * recommendedGasStep
+}
+
+// GasTableEntry defines the gas usage of a message type as exported by the
+// GasRecorder.
+type GasTableEntry struct {
+	MsgTypeURL  string `json:"msg_type_url"`
+	Count       uint64 `json:"count"`
+	Min         uint64 `json:"min"`
+	Max         uint64 `json:"max"`
+	Mean        uint64 `json:"mean"`
+	Recommended uint64 `json:"recommended"`
+}
+
+// GasRecorder accumulates the gas consumed by each message type during
+// simulation runs. It can be shared by several runs to calibrate the gas
+// constants of the modules.
+type GasRecorder struct {
+	mtx     sync.Mutex
+	records map[string]GasRecord
+}
+
+// NewGasRecorder returns a new empty GasRecorder.
+func NewGasRecorder() *GasRecorder {
+	return &GasRecorder{records: make(map[string]GasRecord)}
+}
+
+// RecordGas records that an execution of the message with the given type URL
+// consumed gasUsed gas.
+func (gr *GasRecorder) RecordGas(msgTypeURL string, gasUsed uint64) {
+	gr.mtx.Lock()
+	defer gr.mtx.Unlock()
+
+	r, ok := gr.records[msgTypeURL]
+	if !ok || gasUsed < r.Min {
+		r.Min = gasUsed
+	}
+	if gasUsed > r.Max {
+		r.Max = gasUsed
+	}
+	r.Count++
+	r.Total += gasUsed
+
+	gr.records[msgTypeURL] = r
+}
+
+// Table returns the recorded gas usage of every message type, sorted by message
+// type URL.
+func (gr *GasRecorder) Table() []GasTableEntry {
+	gr.mtx.Lock()
+	defer gr.mtx.Unlock()
+
+	table := make([]GasTableEntry, 0, len(gr.records))
+	for msgTypeURL, r := range gr.records {
+		table = append(table, GasTableEntry{
+			MsgTypeURL:  msgTypeURL,
+			Count:       r.Count,
+			Min:         r.Min,
+			Max:         r.Max,
+			Mean:        r.Mean(),
+			Recommended: r.Recommended(),
+		})
+	}
+
+	sort.Slice(table, func(i, j int) bool { return table[i].MsgTypeURL < table[j].MsgTypeURL })
+
+	return table
+}
+
+// ExportJSON writes the gas table to the given file as JSON.
+func (gr *GasRecorder) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(gr.Table(), "", " ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
@@ -0,0 +1,38 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestGasRecorder(t *testing.T) {
+	recorder := simulation.NewGasRecorder()
+	require.Empty(t, recorder.Table())
+
+	recorder.RecordGas("/cosmos.bank.v1beta1.MsgSend", 40_000)
+	recorder.RecordGas("/cosmos.bank.v1beta1.MsgSend", 60_500)
+	recorder.RecordGas("/cosmos.bank.v1beta1.MsgSend", 50_000)
+	recorder.RecordGas("/cosmos.authz.v1beta1.MsgExec", 0)
+
+	expected := []simulation.GasTableEntry{
+		{MsgTypeURL: "/cosmos.authz.v1beta1.MsgExec", Count: 1, Min: 0, Max: 0, Mean: 0, Recommended: 0},
+		{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Count: 3, Min: 40_000, Max: 60_500, Mean: 50_166, Recommended: 61_000},
+	}
+	require.Equal(t, expected, recorder.Table())
+
+	path := filepath.Join(t.TempDir(), "gas.json")
+	require.NoError(t, recorder.ExportJSON(path))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var exported []simulation.GasTableEntry
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, expected, exported)
+}
@@ -0,0 +1,12 @@
+package tx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AbstractAccountWitnessTx defines the interface to be implemented by Txs that
+// carry the authentication data of abstract accounts.
+type AbstractAccountWitnessTx interface {
+	sdk.Tx
+	GetAbstractAccountWitness() *AbstractAccountWitness
+}
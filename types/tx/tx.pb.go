@@ -364,6 +364,10 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// timeout_timestamp is the UNIX time (in seconds) after which this
+	// transaction will not be processed by the chain. It is only enforced by
+	// chains using the TxExpiryDecorator, and ignored if zero.
+	TimeoutTimestamp int64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -428,6 +432,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetTimeoutTimestamp() int64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -462,6 +473,10 @@ type AuthInfo struct {
 	//
 	// Since: cosmos-sdk 0.46
 	Tip *Tip `protobuf:"bytes,3,opt,name=tip,proto3" json:"tip,omitempty"`
+	// abstract_account_witness is the authentication data of the transaction
+	// for the signers whose account implements custom authentication logic,
+	// which is passed to their CustomAccountHandler in place of signatures.
+	AbstractAccountWitness *AbstractAccountWitness `protobuf:"bytes,4,opt,name=abstract_account_witness,json=abstractAccountWitness,proto3" json:"abstract_account_witness,omitempty"`
 }
 
 func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
@@ -518,6 +533,13 @@ func (m *AuthInfo) GetTip() *Tip {
 	return nil
 }
 
+func (m *AuthInfo) GetAbstractAccountWitness() *AbstractAccountWitness {
+	if m != nil {
+		return m.AbstractAccountWitness
+	}
+	return nil
+}
+
 // SignerInfo describes the public key and signing mode of a single top-level
 // signer.
 type SignerInfo struct {
@@ -594,7 +616,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -1001,6 +1022,62 @@ func (m *AuxSignerData) GetSig() []byte {
 	return nil
 }
 
+// AbstractAccountWitness is the authentication data of a transaction sent by
+// an abstract account, i.e. an account with custom authentication logic.
+type AbstractAccountWitness struct {
+	// address is the address of the abstract account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// data is the authentication data, interpreted by the account.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *AbstractAccountWitness) Reset()         { *m = AbstractAccountWitness{} }
+func (m *AbstractAccountWitness) String() string { return proto.CompactTextString(m) }
+func (*AbstractAccountWitness) ProtoMessage()    {}
+func (*AbstractAccountWitness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{11}
+}
+func (m *AbstractAccountWitness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbstractAccountWitness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbstractAccountWitness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbstractAccountWitness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbstractAccountWitness.Merge(m, src)
+}
+func (m *AbstractAccountWitness) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbstractAccountWitness) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbstractAccountWitness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbstractAccountWitness proto.InternalMessageInfo
+
+func (m *AbstractAccountWitness) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AbstractAccountWitness) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
@@ -1015,76 +1092,82 @@ func init() {
 	proto.RegisterType((*Fee)(nil), "cosmos.tx.v1beta1.Fee")
 	proto.RegisterType((*Tip)(nil), "cosmos.tx.v1beta1.Tip")
 	proto.RegisterType((*AuxSignerData)(nil), "cosmos.tx.v1beta1.AuxSignerData")
+	proto.RegisterType((*AbstractAccountWitness)(nil), "cosmos.tx.v1beta1.AbstractAccountWitness")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x66, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x74, 0x14, 0x21, 0xc7, 0x51, 0xdd, 0xe0, 0xaa,
-	0xe0, 0x4b, 0x76, 0xd3, 0xf4, 0x40, 0x41, 0x08, 0xb0, 0x1b, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xea, 0x65, 0x35, 0x5e, 0x4f, 0xd6, 0xa3, 0x7a, 0x67, 0x96, 0x9d, 0x59, 0xb0, 0xff, 0x08, 0xa4,
-	0x0a, 0x09, 0x71, 0xe1, 0xc0, 0x99, 0x33, 0x7f, 0x44, 0x4f, 0xa8, 0xe2, 0xc4, 0x09, 0xaa, 0xe4,
-	0x88, 0xc4, 0xbf, 0x00, 0x9a, 0xd9, 0xd9, 0x4d, 0x5a, 0x5c, 0x1b, 0x04, 0xea, 0x69, 0xe7, 0xc7,
-	0xf7, 0xbe, 0xf9, 0xde, 0xbc, 0x6f, 0xdf, 0x40, 0x3b, 0x14, 0x32, 0x16, 0xd2, 0x57, 0x53, 0xff,
-	0x8b, 0x5b, 0x43, 0xaa, 0xc8, 0x2d, 0x5f, 0x4d, 0xbd, 0x24, 0x15, 0x4a, 0xa0, 0xab, 0xf9, 0x9e,
-	0xa7, 0xa6, 0x9e, 0xdd, 0x6b, 0x6f, 0x46, 0x22, 0x12, 0x66, 0xd7, 0xd7, 0xa3, 0x1c, 0xd8, 0xde,
-	0xb5, 0x24, 0x61, 0x3a, 0x4b, 0x94, 0xf0, 0xe3, 0x6c, 0xa2, 0x98, 0x64, 0x51, 0xc9, 0x58, 0x2c,
-	0x58, 0x78, 0xc7, 0xc2, 0x87, 0x44, 0xd2, 0x12, 0x13, 0x0a, 0xc6, 0xed, 0xfe, 0xdb, 0xe7, 0x9a,
-	0x24, 0x8b, 0x38, 0xe3, 0xe7, 0x4c, 0x76, 0x6e, 0x81, 0x5b, 0x91, 0x10, 0xd1, 0x84, 0xfa, 0x66,
-	0x36, 0xcc, 0x4e, 0x7c, 0xc2, 0x67, 0xc5, 0x56, 0xce, 0x11, 0xe4, 0x5a, 0x6d, 0x22, 0x66, 0xd2,
-	0xfd, 0xca, 0x81, 0x95, 0xe3, 0x29, 0xda, 0x85, 0xea, 0x50, 0x8c, 0x66, 0x2d, 0x67, 0xc7, 0xe9,
-	0x5d, 0xda, 0xdf, 0xf2, 0xfe, 0x96, 0xac, 0x77, 0x3c, 0x1d, 0x88, 0xd1, 0x0c, 0x1b, 0x18, 0xba,
-	0x03, 0x4d, 0x92, 0xa9, 0x71, 0xc0, 0xf8, 0x89, 0x68, 0xad, 0x98, 0x98, 0xed, 0x39, 0x31, 0xfd,
-	0x4c, 0x8d, 0xef, 0xf3, 0x13, 0x81, 0x1b, 0xc4, 0x8e, 0x50, 0x07, 0x40, 0xcb, 0x26, 0x2a, 0x4b,
-	0xa9, 0x6c, 0xb9, 0x3b, 0x6e, 0x6f, 0x0d, 0x5f, 0x58, 0xe9, 0x72, 0xa8, 0x1d, 0x4f, 0x31, 0xf9,
-	0x12, 0x5d, 0x03, 0xd0, 0x47, 0x05, 0xc3, 0x99, 0xa2, 0xd2, 0xe8, 0x5a, 0xc3, 0x4d, 0xbd, 0x32,
-	0xd0, 0x0b, 0xe8, 0x2d, 0xb8, 0x52, 0x2a, 0xb0, 0x98, 0x15, 0x83, 0x59, 0x2f, 0x8e, 0xca, 0x71,
-	0xcb, 0xce, 0xfb, 0xda, 0x81, 0xd5, 0x23, 0x16, 0xf1, 0x03, 0x11, 0xfe, 0x5f, 0x47, 0x6e, 0x41,
-	0x23, 0x1c, 0x13, 0xc6, 0x03, 0x36, 0x6a, 0xb9, 0x3b, 0x4e, 0xaf, 0x89, 0x57, 0xcd, 0xfc, 0xfe,
-	0x08, 0xdd, 0x84, 0xcb, 0x24, 0x0c, 0x45, 0xc6, 0x55, 0xc0, 0xb3, 0x78, 0x48, 0xd3, 0x56, 0x75,
-	0xc7, 0xe9, 0x55, 0xf1, 0xba, 0x5d, 0xfd, 0xd4, 0x2c, 0x76, 0xff, 0x70, 0x60, 0xc3, 0x8a, 0x3a,
-	0x60, 0x29, 0x0d, 0x55, 0x3f, 0x9b, 0x2e, 0x53, 0x77, 0x1b, 0x20, 0xc9, 0x86, 0x13, 0x16, 0x06,
-	0x8f, 0xe9, 0xcc, 0xd6, 0x64, 0xd3, 0xcb, 0x3d, 0xe1, 0x15, 0x9e, 0xf0, 0xfa, 0x7c, 0x86, 0x9b,
-	0x39, 0xee, 0x01, 0x9d, 0xfd, 0x77, 0xa9, 0xa8, 0x0d, 0x0d, 0x49, 0x3f, 0xcf, 0x28, 0x0f, 0x69,
-	0xab, 0x66, 0x00, 0xe5, 0x1c, 0xf5, 0xc0, 0x55, 0x2c, 0x69, 0xd5, 0x8d, 0x96, 0x37, 0xe6, 0x79,
-	0x8a, 0x25, 0x58, 0x43, 0xba, 0xdf, 0xac, 0x40, 0x3d, 0x37, 0x18, 0xda, 0x83, 0x46, 0x4c, 0xa5,
-	0x24, 0x91, 0x49, 0xd2, 0x7d, 0x65, 0x16, 0x25, 0x0a, 0x21, 0xa8, 0xc6, 0x34, 0xce, 0x7d, 0xd8,
-	0xc4, 0x66, 0xac, 0xd5, 0x2b, 0x16, 0x53, 0x91, 0xa9, 0x60, 0x4c, 0x59, 0x34, 0x56, 0x26, 0xbd,
-	0x2a, 0x5e, 0xb7, 0xab, 0x87, 0x66, 0x11, 0x0d, 0xe0, 0x2a, 0x9d, 0x2a, 0xca, 0x25, 0x13, 0x3c,
-	0x10, 0x89, 0x62, 0x82, 0xcb, 0xd6, 0x9f, 0xab, 0x0b, 0x8e, 0xdd, 0x28, 0xf1, 0x9f, 0xe5, 0x70,
-	0xf4, 0x08, 0x3a, 0x5c, 0xf0, 0x20, 0x4c, 0x99, 0x62, 0x21, 0x99, 0x04, 0x73, 0x08, 0xaf, 0x2c,
-	0x20, 0xdc, 0xe6, 0x82, 0xdf, 0xb5, 0xb1, 0x1f, 0xbf, 0xc4, 0xdd, 0xfd, 0xde, 0x81, 0x46, 0xf1,
-	0x13, 0xa1, 0x8f, 0x60, 0x4d, 0x1b, 0x97, 0xa6, 0xc6, 0x81, 0xc5, 0xed, 0x5c, 0x9b, 0x73, 0xaf,
-	0x47, 0x06, 0x66, 0xfe, 0xbc, 0x4b, 0xb2, 0x1c, 0x4b, 0x5d, 0x90, 0x13, 0x4a, 0xad, 0x39, 0xe6,
-	0x15, 0xe4, 0x1e, 0xa5, 0x58, 0x43, 0x8a, 0xd2, 0xb9, 0xcb, 0x4b, 0xf7, 0xad, 0x03, 0x70, 0x7e,
-	0xde, 0x4b, 0x36, 0x74, 0xfe, 0x99, 0x0d, 0xef, 0x40, 0x33, 0x16, 0x23, 0xba, 0xac, 0x9d, 0x3c,
-	0x14, 0x23, 0x9a, 0xb7, 0x93, 0xd8, 0x8e, 0x5e, 0xb0, 0x9f, 0xfb, 0xa2, 0xfd, 0xba, 0xcf, 0x57,
-	0xa0, 0x51, 0x84, 0xa0, 0xf7, 0xa1, 0x2e, 0x19, 0x8f, 0x26, 0xd4, 0x6a, 0xea, 0x2e, 0xe0, 0xf7,
-	0x8e, 0x0c, 0xf2, 0xb0, 0x82, 0x6d, 0x0c, 0x7a, 0x17, 0x6a, 0xa6, 0x6d, 0x5b, 0x71, 0x6f, 0x2e,
-	0x0a, 0x7e, 0xa8, 0x81, 0x87, 0x15, 0x9c, 0x47, 0xb4, 0xfb, 0x50, 0xcf, 0xe9, 0xd0, 0x3b, 0x50,
-	0xd5, 0xba, 0x8d, 0x80, 0xcb, 0xfb, 0x37, 0x2e, 0x70, 0x14, 0x8d, 0xfc, 0x62, 0xfd, 0x34, 0x1f,
-	0x36, 0x01, 0xed, 0x27, 0x0e, 0xd4, 0x0c, 0x2b, 0x7a, 0x00, 0x8d, 0x21, 0x53, 0x24, 0x4d, 0x49,
-	0x71, 0xb7, 0x7e, 0x41, 0x93, 0x3f, 0x37, 0x5e, 0xf9, 0xba, 0x14, 0x5c, 0x77, 0x45, 0x9c, 0x90,
-	0x50, 0x0d, 0x98, 0xea, 0xeb, 0x30, 0x5c, 0x12, 0xa0, 0xf7, 0x00, 0xca, 0x5b, 0xd7, 0xad, 0xcc,
-	0x5d, 0x76, 0xed, 0xcd, 0xe2, 0xda, 0xe5, 0xa0, 0x06, 0xae, 0xcc, 0xe2, 0xee, 0xef, 0x0e, 0xb8,
-	0xf7, 0x28, 0x45, 0x21, 0xd4, 0x49, 0xac, 0xbb, 0x82, 0x35, 0x65, 0xf9, 0x80, 0xe8, 0x57, 0xed,
-	0x82, 0x14, 0xc6, 0x07, 0x7b, 0x4f, 0x7f, 0xbd, 0x5e, 0xf9, 0xe1, 0xb7, 0xeb, 0xbd, 0x88, 0xa9,
-	0x71, 0x36, 0xf4, 0x42, 0x11, 0xfb, 0xc5, 0x8b, 0x69, 0x3e, 0xbb, 0x72, 0xf4, 0xd8, 0x57, 0xb3,
-	0x84, 0x4a, 0x13, 0x20, 0xb1, 0xa5, 0x46, 0xdb, 0xd0, 0x8c, 0x88, 0x0c, 0x26, 0x2c, 0x66, 0xca,
-	0x14, 0xa2, 0x8a, 0x1b, 0x11, 0x91, 0x9f, 0xe8, 0x39, 0xf2, 0xa0, 0x96, 0x90, 0x19, 0x4d, 0xf3,
-	0x36, 0x36, 0x68, 0xfd, 0xfc, 0xe3, 0xee, 0xa6, 0xd5, 0xd0, 0x1f, 0x8d, 0x52, 0x2a, 0xe5, 0x91,
-	0x4a, 0x19, 0x8f, 0x70, 0x0e, 0x43, 0xfb, 0xb0, 0x1a, 0xa5, 0x84, 0x2b, 0xdb, 0xd7, 0x16, 0x45,
-	0x14, 0xc0, 0xee, 0x77, 0x0e, 0xb8, 0xc7, 0x2c, 0x79, 0x3d, 0xd9, 0xee, 0x41, 0x5d, 0xb1, 0x24,
-	0xa1, 0x69, 0xde, 0xd7, 0x16, 0xe8, 0xb3, 0xb8, 0xee, 0x4f, 0x0e, 0xac, 0xf7, 0xb3, 0x69, 0xfe,
-	0x33, 0x1e, 0x10, 0x45, 0x74, 0x92, 0x24, 0x87, 0x1a, 0xb7, 0x2c, 0x4c, 0xd2, 0x02, 0xd1, 0x07,
-	0xd0, 0xd0, 0x76, 0x0c, 0x46, 0x22, 0xb4, 0x6e, 0xbf, 0xf1, 0x8a, 0x0e, 0x73, 0xf1, 0x75, 0xc2,
-	0xab, 0xd2, 0x3e, 0xa2, 0x85, 0xcb, 0xdd, 0x7f, 0xe9, 0x72, 0xb4, 0x01, 0xae, 0x64, 0x91, 0xa9,
-	0xc6, 0x1a, 0xd6, 0xc3, 0xc1, 0x87, 0x4f, 0x4f, 0x3b, 0xce, 0xb3, 0xd3, 0x8e, 0xf3, 0xfc, 0xb4,
-	0xe3, 0x3c, 0x39, 0xeb, 0x54, 0x9e, 0x9d, 0x75, 0x2a, 0xbf, 0x9c, 0x75, 0x2a, 0x8f, 0x6e, 0x2e,
-	0xbf, 0x4e, 0x5f, 0x4d, 0x87, 0x75, 0xd3, 0x70, 0x6e, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x4d,
-	0x26, 0x62, 0x70, 0xd8, 0x09, 0x00, 0x00,
+	// 1090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x74, 0x54, 0x45, 0x1b, 0x47, 0x75, 0xc3, 0x56,
+	0x05, 0x23, 0x94, 0x75, 0x9a, 0x1e, 0x28, 0x08, 0x01, 0x76, 0x43, 0x95, 0xaa, 0x14, 0xa4, 0x49,
+	0x24, 0xa4, 0x5e, 0x96, 0xf1, 0x7a, 0xb2, 0x1e, 0xd5, 0x3b, 0xb3, 0xec, 0xcc, 0x52, 0xfb, 0x03,
+	0x70, 0x44, 0xaa, 0xb8, 0x70, 0xe1, 0x13, 0x70, 0xe6, 0x13, 0x70, 0xea, 0x09, 0x55, 0x9c, 0x38,
+	0x41, 0x95, 0x1c, 0x91, 0xf8, 0x0a, 0xa0, 0x9d, 0x9d, 0xdd, 0xa4, 0xc5, 0x89, 0xf9, 0x27, 0x4e,
+	0x9e, 0x79, 0xf3, 0x7b, 0xbf, 0xfd, 0xbd, 0x79, 0x6f, 0xde, 0x33, 0xb4, 0x03, 0x21, 0x23, 0x21,
+	0x7b, 0x6a, 0xda, 0xfb, 0xfc, 0xe6, 0x90, 0x2a, 0x72, 0xb3, 0xa7, 0xa6, 0x5e, 0x9c, 0x08, 0x25,
+	0xd0, 0xe5, 0xfc, 0xcc, 0x53, 0x53, 0xcf, 0x9c, 0xb5, 0xaf, 0x84, 0x22, 0x14, 0xfa, 0xb4, 0x97,
+	0xad, 0x72, 0x60, 0x7b, 0xcb, 0x90, 0x04, 0xc9, 0x2c, 0x56, 0xa2, 0x17, 0xa5, 0x13, 0xc5, 0x24,
+	0x0b, 0x4b, 0xc6, 0xc2, 0x60, 0xe0, 0x1d, 0x03, 0x1f, 0x12, 0x49, 0x4b, 0x4c, 0x20, 0x18, 0x37,
+	0xe7, 0xaf, 0x9d, 0x68, 0x92, 0x2c, 0xe4, 0x8c, 0x9f, 0x30, 0x99, 0xbd, 0x01, 0xae, 0x87, 0x42,
+	0x84, 0x13, 0xda, 0xd3, 0xbb, 0x61, 0x7a, 0xd8, 0x23, 0x7c, 0x56, 0x1c, 0xe5, 0x1c, 0x7e, 0xae,
+	0xd5, 0x04, 0xa2, 0x37, 0xee, 0x97, 0x16, 0x54, 0x0f, 0xa6, 0x68, 0x0b, 0x6a, 0x43, 0x31, 0x9a,
+	0x39, 0xd6, 0xa6, 0xd5, 0xbd, 0xb0, 0xb3, 0xee, 0xfd, 0x29, 0x58, 0xef, 0x60, 0x3a, 0x10, 0xa3,
+	0x19, 0xd6, 0x30, 0x74, 0x1b, 0x5a, 0x24, 0x55, 0x63, 0x9f, 0xf1, 0x43, 0xe1, 0x54, 0xb5, 0xcf,
+	0xc6, 0x1c, 0x9f, 0x7e, 0xaa, 0xc6, 0xf7, 0xf8, 0xa1, 0xc0, 0x4d, 0x62, 0x56, 0xa8, 0x03, 0x90,
+	0xc9, 0x26, 0x2a, 0x4d, 0xa8, 0x74, 0xec, 0x4d, 0xbb, 0xbb, 0x8c, 0x4f, 0x59, 0x5c, 0x0e, 0xf5,
+	0x83, 0x29, 0x26, 0x8f, 0xd1, 0x55, 0x80, 0xec, 0x53, 0xfe, 0x70, 0xa6, 0xa8, 0xd4, 0xba, 0x96,
+	0x71, 0x2b, 0xb3, 0x0c, 0x32, 0x03, 0x7a, 0x15, 0x2e, 0x95, 0x0a, 0x0c, 0xa6, 0xaa, 0x31, 0x2b,
+	0xc5, 0xa7, 0x72, 0xdc, 0xa2, 0xef, 0x7d, 0x65, 0xc1, 0xd2, 0x3e, 0x0b, 0xf9, 0xae, 0x08, 0xfe,
+	0xab, 0x4f, 0xae, 0x43, 0x33, 0x18, 0x13, 0xc6, 0x7d, 0x36, 0x72, 0xec, 0x4d, 0xab, 0xdb, 0xc2,
+	0x4b, 0x7a, 0x7f, 0x6f, 0x84, 0x6e, 0xc0, 0x45, 0x12, 0x04, 0x22, 0xe5, 0xca, 0xe7, 0x69, 0x34,
+	0xa4, 0x89, 0x53, 0xdb, 0xb4, 0xba, 0x35, 0xbc, 0x62, 0xac, 0x1f, 0x69, 0xa3, 0xfb, 0x9b, 0x05,
+	0xab, 0x46, 0xd4, 0x2e, 0x4b, 0x68, 0xa0, 0xfa, 0xe9, 0x74, 0x91, 0xba, 0x5b, 0x00, 0x71, 0x3a,
+	0x9c, 0xb0, 0xc0, 0x7f, 0x44, 0x67, 0x26, 0x27, 0x57, 0xbc, 0xbc, 0x26, 0xbc, 0xa2, 0x26, 0xbc,
+	0x3e, 0x9f, 0xe1, 0x56, 0x8e, 0xbb, 0x4f, 0x67, 0xff, 0x5e, 0x2a, 0x6a, 0x43, 0x53, 0xd2, 0xcf,
+	0x52, 0xca, 0x03, 0xea, 0xd4, 0x35, 0xa0, 0xdc, 0xa3, 0x2e, 0xd8, 0x8a, 0xc5, 0x4e, 0x43, 0x6b,
+	0x59, 0x9b, 0x57, 0x53, 0x2c, 0xc6, 0x19, 0xc4, 0xfd, 0xbe, 0x0a, 0x8d, 0xbc, 0xc0, 0xd0, 0x36,
+	0x34, 0x23, 0x2a, 0x25, 0x09, 0x75, 0x90, 0xf6, 0x99, 0x51, 0x94, 0x28, 0x84, 0xa0, 0x16, 0xd1,
+	0x28, 0xaf, 0xc3, 0x16, 0xd6, 0xeb, 0x4c, 0xbd, 0x62, 0x11, 0x15, 0xa9, 0xf2, 0xc7, 0x94, 0x85,
+	0x63, 0xa5, 0xc3, 0xab, 0xe1, 0x15, 0x63, 0xdd, 0xd3, 0x46, 0xf4, 0x06, 0x5c, 0x2e, 0x60, 0xd9,
+	0xaf, 0x54, 0x24, 0x8a, 0x75, 0x18, 0x36, 0x5e, 0x35, 0x07, 0x07, 0x85, 0x1d, 0x0d, 0xe0, 0x32,
+	0x9d, 0x2a, 0xca, 0x25, 0x13, 0xdc, 0x17, 0xb1, 0x62, 0x82, 0x4b, 0xe7, 0xf7, 0xa5, 0x73, 0x34,
+	0xae, 0x96, 0xf8, 0x8f, 0x73, 0x38, 0x7a, 0x08, 0x1d, 0x2e, 0xb8, 0x1f, 0x24, 0x4c, 0xb1, 0x80,
+	0x4c, 0xfc, 0x39, 0x84, 0x97, 0xce, 0x21, 0xdc, 0xe0, 0x82, 0xdf, 0x31, 0xbe, 0x1f, 0xbc, 0xc4,
+	0xed, 0x7e, 0x51, 0x85, 0x66, 0xf1, 0xe2, 0xd0, 0xfb, 0xb0, 0x9c, 0x55, 0x39, 0x4d, 0x74, 0xb9,
+	0x16, 0x57, 0x79, 0x75, 0x4e, 0x12, 0xf6, 0x35, 0x4c, 0x3f, 0xd3, 0x0b, 0xb2, 0x5c, 0xcb, 0x2c,
+	0x7b, 0x87, 0x94, 0x3a, 0xd5, 0x33, 0xb3, 0x77, 0x97, 0x52, 0x9c, 0x41, 0x8a, 0x3c, 0xdb, 0x0b,
+	0xf3, 0x8c, 0x02, 0x70, 0xc8, 0x50, 0xaa, 0x84, 0x04, 0xca, 0x2f, 0xaa, 0xeb, 0x31, 0x53, 0x9c,
+	0x4a, 0xa9, 0xcb, 0xeb, 0xc2, 0xce, 0xeb, 0xf3, 0xda, 0x88, 0x71, 0xe9, 0xe7, 0x1e, 0x9f, 0xe4,
+	0x0e, 0x78, 0x8d, 0xcc, 0xb5, 0xbb, 0x5f, 0x5b, 0x00, 0x27, 0x41, 0xbd, 0xf4, 0x30, 0xac, 0xbf,
+	0xf6, 0x30, 0x6e, 0x43, 0x2b, 0x12, 0x23, 0xba, 0xa8, 0xc1, 0x3d, 0x10, 0x23, 0x9a, 0x37, 0xb8,
+	0xc8, 0xac, 0x5e, 0x78, 0x10, 0xf6, 0x8b, 0x0f, 0xc2, 0x7d, 0x5e, 0x85, 0x66, 0xe1, 0x82, 0xde,
+	0x81, 0x86, 0x64, 0x3c, 0x9c, 0x50, 0xa3, 0xc9, 0x3d, 0x87, 0xdf, 0xdb, 0xd7, 0xc8, 0xbd, 0x0a,
+	0x36, 0x3e, 0xe8, 0x2d, 0xa8, 0xeb, 0x41, 0x62, 0xc4, 0xbd, 0x72, 0x9e, 0xf3, 0x83, 0x0c, 0xb8,
+	0x57, 0xc1, 0xb9, 0x47, 0xbb, 0x0f, 0x8d, 0x9c, 0x0e, 0xbd, 0x09, 0xb5, 0x4c, 0xb7, 0x16, 0x70,
+	0x71, 0xe7, 0xfa, 0x29, 0x8e, 0x62, 0xb4, 0x9c, 0x2e, 0x92, 0x8c, 0x0f, 0x6b, 0x87, 0xf6, 0x13,
+	0x0b, 0xea, 0x9a, 0x15, 0xdd, 0x87, 0xe6, 0x90, 0x29, 0x92, 0x24, 0xa4, 0xb8, 0xdb, 0x5e, 0x41,
+	0x93, 0x0f, 0x40, 0xaf, 0x9c, 0x77, 0x05, 0xd7, 0x1d, 0x11, 0xc5, 0x24, 0x50, 0x03, 0xa6, 0xfa,
+	0x99, 0x1b, 0x2e, 0x09, 0xd0, 0xdb, 0x00, 0xe5, 0xad, 0x67, 0xcd, 0xd5, 0x5e, 0x74, 0xed, 0xad,
+	0xe2, 0xda, 0xe5, 0xa0, 0x0e, 0xb6, 0x4c, 0x23, 0xf7, 0x57, 0x0b, 0xec, 0xbb, 0x94, 0xa2, 0x00,
+	0x1a, 0x24, 0xca, 0xaa, 0xc2, 0x54, 0x7e, 0x39, 0xd2, 0xb2, 0x39, 0x7b, 0x4a, 0x0a, 0xe3, 0x83,
+	0xed, 0xa7, 0x3f, 0x5f, 0xab, 0x7c, 0xfb, 0xcb, 0xb5, 0x6e, 0xc8, 0xd4, 0x38, 0x1d, 0x7a, 0x81,
+	0x88, 0x7a, 0xc5, 0x0c, 0xd7, 0x3f, 0x5b, 0x72, 0xf4, 0xa8, 0xa7, 0x66, 0x31, 0x95, 0xda, 0x41,
+	0x62, 0x43, 0x8d, 0x36, 0xa0, 0x15, 0x12, 0xe9, 0x4f, 0x58, 0xc4, 0x94, 0x4e, 0x44, 0x0d, 0x37,
+	0x43, 0x22, 0x3f, 0xcc, 0xf6, 0xc8, 0x83, 0x7a, 0x4c, 0x66, 0x34, 0xc9, 0x1b, 0xeb, 0xc0, 0xf9,
+	0xf1, 0xbb, 0xad, 0x2b, 0x46, 0x43, 0x7f, 0x34, 0x4a, 0xa8, 0x94, 0xfb, 0x2a, 0x61, 0x3c, 0xc4,
+	0x39, 0x0c, 0xed, 0xc0, 0x52, 0x98, 0x10, 0xae, 0x4c, 0xa7, 0x3d, 0xcf, 0xa3, 0x00, 0xba, 0xdf,
+	0x58, 0x60, 0x1f, 0xe8, 0x77, 0xf5, 0x3f, 0x44, 0xbb, 0x0d, 0x0d, 0xc5, 0xe2, 0x98, 0x26, 0x4e,
+	0x75, 0x81, 0x3e, 0x83, 0x73, 0x7f, 0xb0, 0x60, 0xa5, 0x9f, 0x4e, 0xf3, 0xc7, 0xb8, 0x4b, 0x14,
+	0xc9, 0x82, 0x24, 0x39, 0xd4, 0xb1, 0x16, 0x90, 0x14, 0x40, 0xf4, 0x2e, 0x34, 0xb3, 0x72, 0xf4,
+	0x47, 0x22, 0x30, 0xd5, 0x7e, 0xfd, 0x8c, 0x36, 0x76, 0x7a, 0x5e, 0xe2, 0x25, 0x99, 0x5b, 0xca,
+	0x2a, 0xb7, 0xff, 0x66, 0x95, 0xa3, 0x55, 0xb0, 0x25, 0x0b, 0x75, 0x36, 0x96, 0x71, 0xb6, 0x74,
+	0x3f, 0x85, 0xb5, 0xf9, 0xcd, 0xe8, 0x1f, 0x05, 0x86, 0xa0, 0x36, 0x22, 0x8a, 0x98, 0x7f, 0x11,
+	0x7a, 0x3d, 0x78, 0xef, 0xe9, 0x51, 0xc7, 0x7a, 0x76, 0xd4, 0xb1, 0x9e, 0x1f, 0x75, 0xac, 0x27,
+	0xc7, 0x9d, 0xca, 0xb3, 0xe3, 0x4e, 0xe5, 0xa7, 0xe3, 0x4e, 0xe5, 0xe1, 0x8d, 0xc5, 0x09, 0xeb,
+	0xa9, 0xe9, 0xb0, 0xa1, 0x5b, 0xda, 0xad, 0x3f, 0x06, 0x00, 0xee, 0x0b, 0xcd, 0x0d, 0xcc, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1361,6 +1444,11 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1410,6 +1498,18 @@ func (m *AuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AbstractAccountWitness != nil {
+		{
+			size, err := m.AbstractAccountWitness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Tip != nil {
 		{
 			size, err := m.Tip.MarshalToSizedBuffer(dAtA[:i])
@@ -1808,6 +1908,43 @@ func (m *AuxSignerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AbstractAccountWitness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbstractAccountWitness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbstractAccountWitness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1939,6 +2076,9 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -1974,6 +2114,10 @@ func (m *AuthInfo) Size() (n int) {
 		l = m.Tip.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AbstractAccountWitness != nil {
+		l = m.AbstractAccountWitness.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2133,6 +2277,23 @@ func (m *AuxSignerData) Size() (n int) {
 	return n
 }
 
+func (m *AbstractAccountWitness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2952,6 +3113,25 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -3176,6 +3356,42 @@ func (m *AuthInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractAccountWitness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AbstractAccountWitness == nil {
+				m.AbstractAccountWitness = &AbstractAccountWitness{}
+			}
+			if err := m.AbstractAccountWitness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4101,6 +4317,122 @@ func (m *AuxSignerData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AbstractAccountWitness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbstractAccountWitness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbstractAccountWitness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -17,6 +19,9 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// MaxTxAge, if non-zero, enables the TxExpiryDecorator rejecting txs whose
+	// timeout timestamp is passed or more than MaxTxAge in the future.
+	MaxTxAge time.Duration
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -40,6 +45,13 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+	}
+
+	if options.MaxTxAge > 0 {
+		anteDecorators = append(anteDecorators, NewTxExpiryDecorator(options.MaxTxAge))
+	}
+
+	anteDecorators = append(anteDecorators,
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
@@ -48,7 +60,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -205,3 +207,60 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 	return next(ctx, tx, simulate)
 }
+
+type (
+	// TxExpiryDecorator defines an AnteHandler decorator that checks for a tx
+	// timestamp timeout.
+	TxExpiryDecorator struct {
+		maxTxAge time.Duration
+	}
+
+	// TxWithTimeoutTimestamp defines the interface a tx must implement in order
+	// for TxExpiryDecorator to process the tx.
+	TxWithTimeoutTimestamp interface {
+		sdk.Tx
+
+		GetTimeoutTimestamp() int64
+	}
+)
+
+// NewTxExpiryDecorator returns a TxExpiryDecorator rejecting the txs whose
+// timeout timestamp is more than maxTxAge after the block time.
+func NewTxExpiryDecorator(maxTxAge time.Duration) TxExpiryDecorator {
+	return TxExpiryDecorator{
+		maxTxAge: maxTxAge,
+	}
+}
+
+// AnteHandle implements an AnteHandler decorator for the TxExpiryDecorator type
+// where the current block time is checked against the tx's timeout timestamp.
+// If a timeout timestamp is provided (non-zero), an error is returned if it is
+// before the block time, or more than maxTxAge after it to prevent txs with a
+// far-future expiry.
+func (txe TxExpiryDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	timeoutTx, ok := tx.(TxWithTimeoutTimestamp)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithTimeoutTimestamp")
+	}
+
+	timeoutTimestamp := timeoutTx.GetTimeoutTimestamp()
+	if timeoutTimestamp == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	blockTime := ctx.BlockTime()
+	timeout := time.Unix(timeoutTimestamp, 0)
+	if blockTime.After(timeout) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeout, "block time: %d, timeout timestamp: %d", blockTime.Unix(), timeoutTimestamp,
+		)
+	}
+
+	if timeout.Sub(blockTime) > txe.maxTxAge {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "timeout timestamp %d is more than %s after the block time %d", timeoutTimestamp, txe.maxTxAge, blockTime.Unix(),
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
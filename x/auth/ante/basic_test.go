@@ -3,6 +3,7 @@ package ante_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
@@ -223,3 +224,54 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestTxExpiryDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxExpiryDecorator(time.Hour))
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	blockTime := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		name        string
+		timeout     int64
+		expectedErr error
+	}{
+		{"default value", 0, nil},
+		{"no timeout (later time)", blockTime.Add(time.Minute).Unix(), nil},
+		{"no timeout (same time)", blockTime.Unix(), nil},
+		{"no timeout (max tx age)", blockTime.Add(time.Hour).Unix(), nil},
+		{"timeout (earlier time)", blockTime.Add(-time.Second).Unix(), sdkerrors.ErrTxTimeout},
+		{"too far in the future", blockTime.Add(time.Hour + time.Second).Unix(), sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			require.NoError(t, suite.txBuilder.SetMsgs(msg))
+
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.(interface{ SetTimeoutTimestamp(int64) }).SetTimeoutTimestamp(tc.timeout)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			require.NoError(t, err)
+
+			ctx := suite.ctx.WithBlockTime(blockTime)
+			_, err = antehandler(ctx, tx, true)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -43,6 +44,7 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig signing.Sig
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
+// Signers whose account implements types.CustomAccountHandler are skipped
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SetPubKeyDecorator struct {
 	ak AccountKeeper
@@ -74,16 +76,20 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
+		// accounts with custom authentication logic have no pubkey to set
+		if _, ok := acc.(types.CustomAccountHandler); ok {
+			continue
+		}
+		// Only make check if simulate=false
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
@@ -128,6 +134,7 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
+// Signers whose account implements types.CustomAccountHandler are skipped
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigGasConsumeDecorator struct {
@@ -168,6 +175,13 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, err
 		}
 
+		// accounts with custom authentication logic have no signature to
+		// verify, the gas of their authentication being consumed by the
+		// SigVerificationDecorator
+		if _, ok := signerAcc.(types.CustomAccountHandler); ok {
+			continue
+		}
+
 		pubKey := signerAcc.GetPubKey()
 
 		// In simulate mode the transaction comes with no signatures, thus if the
@@ -197,6 +211,10 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
 //
+// Signers whose account implements types.CustomAccountHandler are not verified
+// by their signature, the tx being authenticated by their Authenticate method
+// instead, with the data of the AbstractAccountWitness the tx carries for them.
+//
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
@@ -258,12 +276,6 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
-		// retrieve pubkey
-		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
-			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
-		}
-
 		// Check account sequence number.
 		if sig.Sequence != acc.GetSequence() {
 			return ctx, sdkerrors.Wrapf(
@@ -272,6 +284,28 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			)
 		}
 
+		// accounts with custom authentication logic authenticate the tx
+		// themselves instead of having their signature verified
+		if handler, ok := acc.(types.CustomAccountHandler); ok {
+			if !simulate && !ctx.IsReCheckTx() {
+				witness, err := abstractAccountWitness(tx, acc.GetAddress())
+				if err != nil {
+					return ctx, err
+				}
+
+				if err := handler.Authenticate(ctx, tx, witness.Data); err != nil {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authentication of account %s failed: %s", acc.GetAddress(), err)
+				}
+			}
+			continue
+		}
+
+		// retrieve pubkey
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+
 		// retrieve signer data
 		genesis := ctx.BlockHeight() == 0
 		chainID := ctx.ChainID()
@@ -308,6 +342,22 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// abstractAccountWitness returns the AbstractAccountWitness carried by the tx
+// for the abstract account at addr.
+func abstractAccountWitness(tx sdk.Tx, addr sdk.AccAddress) (*txtypes.AbstractAccountWitness, error) {
+	witnessTx, ok := tx.(txtypes.AbstractAccountWitnessTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type, expected an abstract account witness")
+	}
+
+	witness := witnessTx.GetAbstractAccountWitness()
+	if witness == nil || witness.Address != addr.String() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "missing abstract account witness of account %s", addr)
+	}
+
+	return witness, nil
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
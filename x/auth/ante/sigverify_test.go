@@ -1,10 +1,12 @@
 package ante_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -14,9 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
@@ -403,3 +407,143 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.Equal(t, tc.expectedSeq, suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+// customAccount is an account authenticating the txs whose witness data is
+// its password.
+type customAccount struct {
+	*types.BaseAccount
+	password string
+}
+
+func (acc customAccount) Authenticate(_ sdk.Context, _ sdk.Tx, witnessData []byte) error {
+	if string(witnessData) != acc.password {
+		return errors.New("wrong password")
+	}
+	return nil
+}
+
+func (acc customAccount) ExecuteTx(_ sdk.Context, _ sdk.Tx) error { return nil }
+
+// customAccountKeeper returns the accounts of the given addresses as custom
+// accounts.
+type customAccountKeeper struct {
+	ante.AccountKeeper
+	addrs []sdk.AccAddress
+}
+
+func (ak customAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI {
+	acc := ak.AccountKeeper.GetAccount(ctx, addr)
+	for _, customAddr := range ak.addrs {
+		if acc != nil && customAddr.Equals(addr) {
+			return customAccount{BaseAccount: acc.(*types.BaseAccount), password: "secret"}
+		}
+	}
+	return acc
+}
+
+func TestSigVerification_CustomAccountHandler(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	for i, priv := range []cryptotypes.PrivKey{priv1, priv2} {
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(priv.PubKey().Address()))
+		require.NoError(t, acc.SetAccountNumber(uint64(i)))
+		require.NoError(t, acc.SetPubKey(priv.PubKey()))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	// addr2 is a custom account, whose signature is not verified
+	ak := customAccountKeeper{AccountKeeper: suite.accountKeeper, addrs: []sdk.AccAddress{addr2}}
+	svd := ante.NewSigVerificationDecorator(ak, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	testCases := []struct {
+		name      string
+		witness   *txtypes.AbstractAccountWitness
+		accNums   []uint64
+		expErrMsg string
+	}{
+		{"authenticated custom account", &txtypes.AbstractAccountWitness{Address: addr2.String(), Data: []byte("secret")}, []uint64{0, 1}, ""},
+		{"unauthenticated custom account", &txtypes.AbstractAccountWitness{Address: addr2.String(), Data: []byte("wrong")}, []uint64{0, 1}, "wrong password"},
+		{"missing witness", nil, []uint64{0, 1}, "missing abstract account witness"},
+		{"witness of another account", &txtypes.AbstractAccountWitness{Address: addr1.String(), Data: []byte("secret")}, []uint64{0, 1}, "missing abstract account witness"},
+		{"invalid signature of custom account", &txtypes.AbstractAccountWitness{Address: addr2.String(), Data: []byte("secret")}, []uint64{0, 7}, ""},
+		{"invalid signature of other account", &txtypes.AbstractAccountWitness{Address: addr2.String(), Data: []byte("secret")}, []uint64{7, 1}, "signature verification failed"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.(client.AbstractAccountTxBuilder).SetAbstractAccountWitness(tc.witness)
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1, priv2}, tc.accNums, []uint64{0, 0}, suite.ctx.ChainID())
+			require.NoError(t, err)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSigVerification_CustomAccountHandlerWithoutPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	acc1 := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	require.NoError(t, acc1.SetPubKey(priv1.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc1)
+
+	// addr2 is a custom account which never had a pubkey set
+	acc2 := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr2)
+	require.NoError(t, acc2.SetAccountNumber(1))
+	suite.accountKeeper.SetAccount(suite.ctx, acc2)
+
+	ak := customAccountKeeper{AccountKeeper: suite.accountKeeper, addrs: []sdk.AccAddress{addr2}}
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(ak),
+		ante.NewValidateSigCountDecorator(ak),
+		ante.NewSigGasConsumeDecorator(ak, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(ak, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.(client.AbstractAccountTxBuilder).SetAbstractAccountWitness(&txtypes.AbstractAccountWitness{Address: addr2.String(), Data: []byte("secret")})
+
+	// only addr1 signs, the signer info of addr2 carrying neither a pubkey nor
+	// a signature
+	signMode := suite.clientCtx.TxConfig.SignModeHandler().DefaultMode()
+	customSig := signing.SignatureV2{Data: &signing.SingleSignatureData{SignMode: signMode}}
+	require.NoError(t, suite.txBuilder.SetSignatures(
+		signing.SignatureV2{PubKey: priv1.PubKey(), Data: &signing.SingleSignatureData{SignMode: signMode}},
+		customSig,
+	))
+	sig1, err := clienttx.SignWithPrivKey(signMode, authsigning.SignerData{
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: 0,
+		Sequence:      0,
+	}, suite.txBuilder, priv1, suite.clientCtx.TxConfig, 0)
+	require.NoError(t, err)
+	require.NoError(t, suite.txBuilder.SetSignatures(sig1, customSig))
+	tx := suite.txBuilder.GetTx()
+
+	for _, simulate := range []bool{false, true} {
+		_, err = antehandler(suite.ctx, tx, simulate)
+		require.NoError(t, err, "simulate: %t", simulate)
+		require.Nil(t, suite.accountKeeper.GetAccount(suite.ctx, addr2).GetPubKey())
+	}
+}
@@ -23,6 +23,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "query account address by account number",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "AccountsByType",
+					Use:            "accounts-by-type [type-url]",
+					Short:          "query all the accounts of a type, e.g. /cosmos.auth.v1beta1.ModuleAccount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "type_url"}},
+				},
+				{
+					RpcMethod:      "AccountsByAddresses",
+					Use:            "accounts-by-addresses [address]...",
+					Short:          "query the existing accounts among several addresses",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -16,9 +19,19 @@ func (ak AccountKeeper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddre
 	return ak.NewAccount(ctx, acc)
 }
 
-// NewAccount sets the next account number to a given account interface
+// NewAccount sets the next account number to a given account interface. The
+// account number of a removed account is reused if any, see
+// AccountNumberRecycler.
 func (ak AccountKeeper) NewAccount(ctx sdk.Context, acc types.AccountI) types.AccountI {
-	if err := acc.SetAccountNumber(ak.NextAccountNumber(ctx)); err != nil {
+	accNum, ok := uint64(0), false
+	if addr := acc.GetAddress(); !addr.Empty() {
+		accNum, ok = ak.recycler.Acquire(ctx, addr)
+	}
+	if !ok {
+		accNum = ak.NextAccountNumber(ctx)
+	}
+
+	if err := acc.SetAccountNumber(accNum); err != nil {
 		panic(err)
 	}
 
@@ -48,6 +61,25 @@ func (ak AccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.A
 	return ak.decodeAccount(bz)
 }
 
+// GetAccountsByAddresses returns the existing accounts among the given
+// addresses, in the same order, reading them from a single store handle rather
+// than through one GetAccount call per address.
+func (ak AccountKeeper) GetAccountsByAddresses(ctx sdk.Context, addrs []sdk.AccAddress) []types.AccountI {
+	store := ctx.KVStore(ak.storeKey)
+
+	accounts := make([]types.AccountI, 0, len(addrs))
+	for _, addr := range addrs {
+		bz := store.Get(types.AddressStoreKey(addr))
+		if bz == nil {
+			continue
+		}
+
+		accounts = append(accounts, ak.decodeAccount(bz))
+	}
+
+	return accounts
+}
+
 // GetAccountAddressById returns account address by id.
 func (ak AccountKeeper) GetAccountAddressByID(ctx sdk.Context, id uint64) string {
 	store := ctx.KVStore(ak.storeKey)
@@ -78,17 +110,53 @@ func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc types.AccountI) {
 		panic(err)
 	}
 
+	// index the account by its type, unless it was already stored with the same
+	// type
+	typeURL := accountTypeURL(acc)
+	if oldTypeURL := ak.storedAccountTypeURL(store, addr); oldTypeURL != typeURL {
+		if oldTypeURL != "" {
+			store.Delete(types.AccountTypeStoreKey(oldTypeURL, addr))
+		}
+		store.Set(types.AccountTypeStoreKey(typeURL, addr), []byte{})
+	}
+
 	store.Set(types.AddressStoreKey(addr), bz)
 	store.Set(types.AccountNumberStoreKey(acc.GetAccountNumber()), addr.Bytes())
 }
 
-// RemoveAccount removes an account for the account mapper store.
+// accountTypeURL returns the type URL of an account, as set in the Any it is
+// stored as.
+func accountTypeURL(acc types.AccountI) string {
+	return "/" + proto.MessageName(acc)
+}
+
+// storedAccountTypeURL returns the type URL of the account stored at the given
+// address, or an empty string if there is none.
+func (ak AccountKeeper) storedAccountTypeURL(store sdk.KVStore, addr sdk.AccAddress) string {
+	bz := store.Get(types.AddressStoreKey(addr))
+	if bz == nil {
+		return ""
+	}
+
+	var any codectypes.Any
+	if err := ak.cdc.Unmarshal(bz, &any); err != nil {
+		panic(err)
+	}
+
+	return any.TypeUrl
+}
+
+// RemoveAccount removes an account for the account mapper store, its account
+// number being released for new accounts.
 // NOTE: this will cause supply invariant violation if called
 func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc types.AccountI) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.storeKey)
 	store.Delete(types.AddressStoreKey(addr))
 	store.Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
+	store.Delete(types.AccountTypeStoreKey(accountTypeURL(acc), addr))
+
+	ak.recycler.Release(ctx, acc.GetAccountNumber(), addr)
 }
 
 // IterateAccounts iterates over all the stored accounts and performs a callback function.
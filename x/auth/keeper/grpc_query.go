@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"google.golang.org/grpc/codes"
@@ -66,6 +69,146 @@ func (ak AccountKeeper) Accounts(c context.Context, req *types.QueryAccountsRequ
 	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, err
 }
 
+// AccountsByType returns all the accounts of a given type
+func (ak AccountKeeper) AccountsByType(c context.Context, req *types.QueryAccountsByTypeRequest) (*types.QueryAccountsByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "type url cannot be empty")
+	}
+
+	// the type URL is length prefixed in the keys of the index
+	if len(req.TypeUrl) > address.MaxAddrLen {
+		return nil, status.Errorf(codes.InvalidArgument, "type url too long: %d > %d", len(req.TypeUrl), address.MaxAddrLen)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(ak.storeKey)
+	typeStore := prefix.NewStore(store, types.AccountTypePrefixStoreKey(req.TypeUrl))
+
+	var accounts []*codectypes.Any
+	pageRes, err := query.Paginate(typeStore, req.Pagination, func(key, _ []byte) error {
+		account := ak.GetAccount(ctx, key)
+		if account == nil {
+			return fmt.Errorf("indexed account %s not found", sdk.AccAddress(key))
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, any)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryAccountsByTypeResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// AccountsByAddresses returns the existing accounts among the given addresses
+func (ak AccountKeeper) AccountsByAddresses(c context.Context, req *types.QueryAccountsByAddressesRequest) (*types.QueryAccountsByAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if limit := ak.GetParams(ctx).AccountQueryBatchLimit(); uint64(len(req.Addresses)) > limit {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses: %d > %d", len(req.Addresses), limit)
+	}
+
+	// query the addresses in the order of their bytes, as the store keys, so
+	// that the pagination keys are the addresses
+	addrs := make([]sdk.AccAddress, 0, len(req.Addresses))
+	seen := make(map[string]bool, len(req.Addresses))
+	for _, addrStr := range req.Addresses {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", addrStr, err)
+		}
+
+		if seen[string(addr)] {
+			continue
+		}
+		seen[string(addr)] = true
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+
+	accounts, pageRes, err := paginateAccounts(ak.GetAccountsByAddresses(ctx, addrs), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	anys := make([]*codectypes.Any, len(accounts))
+	for i, account := range accounts {
+		anys[i], err = codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QueryAccountsByAddressesResponse{Accounts: anys, Pagination: pageRes}, nil
+}
+
+// paginateAccounts paginates accounts sorted by address the same way
+// query.Paginate paginates a store, the keys being the account addresses.
+func paginateAccounts(accounts []types.AccountI, pageReq *query.PageRequest) ([]types.AccountI, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]types.AccountI, len(accounts))
+		for i, account := range accounts {
+			reversed[len(accounts)-1-i] = account
+		}
+		accounts = reversed
+	}
+
+	start := len(accounts)
+	if pageReq.Offset < uint64(len(accounts)) {
+		start = int(pageReq.Offset)
+	}
+	if pageReq.Key != nil {
+		start = sort.Search(len(accounts), func(i int) bool {
+			cmp := bytes.Compare(accounts[i].GetAddress(), pageReq.Key)
+			if pageReq.Reverse {
+				return cmp <= 0
+			}
+			return cmp >= 0
+		})
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	end := len(accounts)
+	if uint64(end-start) > limit {
+		end = start + int(limit)
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < len(accounts) {
+		pageRes.NextKey = accounts[end].GetAddress()
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(accounts))
+	}
+
+	return accounts[start:end], pageRes, nil
+}
+
 // Account returns account details based on address
 func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
@@ -6,11 +6,15 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -546,3 +550,117 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryAccountsByType() {
+	_, _, first := testdata.KeyTestPubAddr()
+	_, _, second := testdata.KeyTestPubAddr()
+	baseAccTypeURL := "/cosmos.auth.v1beta1.BaseAccount"
+	moduleAccTypeURL := "/cosmos.auth.v1beta1.ModuleAccount"
+
+	queryAddrs := func(typeURL string) []sdk.AccAddress {
+		res, err := suite.queryClient.AccountsByType(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountsByTypeRequest{TypeUrl: typeURL})
+		suite.Require().NoError(err)
+
+		addrs := make([]sdk.AccAddress, len(res.Accounts))
+		for i, any := range res.Accounts {
+			suite.Require().Equal(typeURL, any.TypeUrl)
+
+			var account types.AccountI
+			suite.Require().NoError(suite.encCfg.InterfaceRegistry.UnpackAny(any, &account))
+			addrs[i] = account.GetAddress()
+		}
+		return addrs
+	}
+
+	_, err := suite.queryClient.AccountsByType(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountsByTypeRequest{})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.AccountsByType(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountsByTypeRequest{TypeUrl: "/" + strings.Repeat("a", 255)})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	firstAcc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, first)
+	suite.accountKeeper.SetAccount(suite.ctx, firstAcc)
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, second))
+	moduleAcc := suite.accountKeeper.GetModuleAccount(suite.ctx, randomPerm)
+
+	suite.Require().ElementsMatch([]sdk.AccAddress{first, second}, queryAddrs(baseAccTypeURL))
+	suite.Require().Equal([]sdk.AccAddress{moduleAcc.GetAddress()}, queryAddrs(moduleAccTypeURL))
+
+	// changing the type of an account moves it in the index
+	suite.accountKeeper.SetAccount(suite.ctx, types.NewModuleAccount(firstAcc.(*types.BaseAccount), "first"))
+	suite.Require().Equal([]sdk.AccAddress{second}, queryAddrs(baseAccTypeURL))
+	suite.Require().ElementsMatch([]sdk.AccAddress{first, moduleAcc.GetAddress()}, queryAddrs(moduleAccTypeURL))
+
+	// removed accounts are removed from the index
+	suite.accountKeeper.RemoveAccount(suite.ctx, moduleAcc)
+	suite.Require().Equal([]sdk.AccAddress{first}, queryAddrs(moduleAccTypeURL))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAccountsByAddresses() {
+	addrs := make([]sdk.AccAddress, 4)
+	for i := range addrs {
+		_, _, addrs[i] = testdata.KeyTestPubAddr()
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+
+	// the last address has no account
+	for _, addr := range addrs[:3] {
+		suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	}
+
+	queryAddrs := func(req *types.QueryAccountsByAddressesRequest) ([]sdk.AccAddress, *query.PageResponse, error) {
+		res, err := suite.queryClient.AccountsByAddresses(sdk.WrapSDKContext(suite.ctx), req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resAddrs := make([]sdk.AccAddress, len(res.Accounts))
+		for i, any := range res.Accounts {
+			var account types.AccountI
+			suite.Require().NoError(suite.encCfg.InterfaceRegistry.UnpackAny(any, &account))
+			resAddrs[i] = account.GetAddress()
+		}
+		return resAddrs, res.Pagination, nil
+	}
+
+	addrStrs := []string{addrs[3].String(), addrs[1].String(), addrs[0].String(), addrs[2].String(), addrs[1].String()}
+
+	resAddrs, pageRes, err := queryAddrs(&types.QueryAccountsByAddressesRequest{Addresses: addrStrs})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[:3], resAddrs)
+	suite.Require().Nil(pageRes.NextKey)
+
+	resAddrs, pageRes, err = queryAddrs(&types.QueryAccountsByAddressesRequest{
+		Addresses:  addrStrs,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[:2], resAddrs)
+	suite.Require().Equal(uint64(3), pageRes.Total)
+
+	resAddrs, pageRes, err = queryAddrs(&types.QueryAccountsByAddressesRequest{
+		Addresses:  addrStrs,
+		Pagination: &query.PageRequest{Key: pageRes.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[2:3], resAddrs)
+	suite.Require().Nil(pageRes.NextKey)
+
+	resAddrs, _, err = queryAddrs(&types.QueryAccountsByAddressesRequest{
+		Addresses:  addrStrs,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[1:2], resAddrs)
+
+	_, _, err = queryAddrs(&types.QueryAccountsByAddressesRequest{Addresses: []string{"invalid"}})
+	suite.Require().Error(err)
+
+	// the number of addresses is capped by the MaxAccountQueryBatch parameter
+	params := types.DefaultParams()
+	params.MaxAccountQueryBatch = 4
+	suite.Require().NoError(suite.accountKeeper.SetParams(suite.ctx, params))
+
+	_, _, err = queryAddrs(&types.QueryAccountsByAddressesRequest{Addresses: addrStrs})
+	suite.Require().ErrorContains(err, "too many addresses")
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers the auth module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, ak AccountKeeper) {
+	ir.RegisterRoute(types.ModuleName, "unique-account-numbers", UniqueAccountNumbersInvariant(ak))
+}
+
+// UniqueAccountNumbersInvariant checks that no two accounts share an account
+// number
+func UniqueAccountNumbersInvariant(ak AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		holders := make(map[uint64]sdk.AccAddress)
+		ak.IterateAccounts(ctx, func(acc types.AccountI) bool {
+			accNum := acc.GetAccountNumber()
+			if holder, ok := holders[accNum]; ok {
+				count++
+				msg += fmt.Sprintf("\t%s and %s share the account number %d\n", holder, acc.GetAddress(), accNum)
+				return false
+			}
+
+			holders[accNum] = acc.GetAddress()
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "unique-account-numbers",
+			fmt.Sprintf("amount of shared account numbers found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -62,6 +62,9 @@ type AccountKeeper struct {
 	proto      func() types.AccountI
 	addressCdc address.Codec
 
+	// recycler reuses the account numbers of removed accounts.
+	recycler AccountNumberRecycler
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -92,10 +95,17 @@ func NewAccountKeeper(
 		cdc:        cdc,
 		permAddrs:  permAddrs,
 		addressCdc: bech32Codec,
+		recycler:   NewAccountNumberRecycler(storeKey),
 		authority:  authority,
 	}
 }
 
+// AccountNumberRecycler returns the component reusing the account numbers of
+// removed accounts.
+func (ak AccountKeeper) AccountNumberRecycler() AccountNumberRecycler {
+	return ak.recycler
+}
+
 // GetAuthority returns the x/auth module's authority.
 func (ak AccountKeeper) GetAuthority() string {
 	return ak.authority
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// maxAcquireScan is the maximum number of entries of the free-list Acquire
+// goes through before falling back to the global account number counter.
+const maxAcquireScan = 100
+
+// AccountNumberRecycler maintains a free-list of the account numbers of removed
+// accounts, which are reused for new accounts before incrementing the global
+// account number counter.
+//
+// An account number is not given back to the address which last held it, as
+// transactions sign over the account numbers and sequences of their signers:
+// the transactions of a removed account could otherwise be replayed once the
+// account is created again with the same account number and a reset sequence.
+// Only the last holder of a free account number is recorded, along with it in
+// the free-list, so that the records are bounded by the size of the free-list.
+type AccountNumberRecycler struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewAccountNumberRecycler returns an AccountNumberRecycler storing its
+// free-list in the given store.
+func NewAccountNumberRecycler(storeKey storetypes.StoreKey) AccountNumberRecycler {
+	return AccountNumberRecycler{storeKey: storeKey}
+}
+
+// Release adds the account number of a removed account to the free-list,
+// recording that the given address held it last.
+func (r AccountNumberRecycler) Release(ctx sdk.Context, accNum uint64, addr sdk.AccAddress) {
+	store := ctx.KVStore(r.storeKey)
+	store.Set(types.FreeAccountNumberStoreKey(accNum), addr)
+}
+
+// Acquire removes from the free-list and returns the lowest account number
+// which the given address did not hold last. It returns false if there is no
+// such account number among the first maxAcquireScan entries of the free-list.
+//
+// Account numbers of the free-list which were assigned again to an account
+// without being acquired, e.g. by migrations recreating an account with its
+// former account number, are dropped from the free-list.
+func (r AccountNumberRecycler) Acquire(ctx sdk.Context, addr sdk.AccAddress) (uint64, bool) {
+	store := ctx.KVStore(r.storeKey)
+
+	var (
+		accNum  uint64
+		found   bool
+		dropped [][]byte
+	)
+	iterator := sdk.KVStorePrefixIterator(store, types.FreeAccountNumberStoreKeyPrefix)
+	for scanned := 0; iterator.Valid() && scanned < maxAcquireScan; iterator.Next() {
+		scanned++
+
+		num := sdk.BigEndianToUint64(iterator.Key()[len(types.FreeAccountNumberStoreKeyPrefix):])
+		if store.Has(types.AccountNumberStoreKey(num)) {
+			dropped = append(dropped, iterator.Key())
+			continue
+		}
+
+		if !addr.Equals(sdk.AccAddress(iterator.Value())) {
+			accNum, found = num, true
+			break
+		}
+	}
+	iterator.Close()
+
+	for _, key := range dropped {
+		store.Delete(key)
+	}
+
+	if found {
+		store.Delete(types.FreeAccountNumberStoreKey(accNum))
+	}
+
+	return accNum, found
+}
+
+// IterateFreeAccountNumbers iterates over the free-list of account numbers in
+// ascending order and performs a callback function. Stops iteration when the
+// callback returns true.
+func (r AccountNumberRecycler) IterateFreeAccountNumbers(ctx sdk.Context, cb func(accNum uint64) (stop bool)) {
+	store := ctx.KVStore(r.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FreeAccountNumberStoreKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.BigEndianToUint64(iterator.Key()[len(types.FreeAccountNumberStoreKeyPrefix):])) {
+			break
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+func (suite *KeeperTestSuite) TestAccountNumberRecycling() {
+	ctx := suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	addr3 := sdk.AccAddress([]byte("addr3---------------"))
+
+	acc1 := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.accountKeeper.SetAccount(ctx, acc1)
+	acc2 := suite.accountKeeper.NewAccountWithAddress(ctx, addr2)
+	suite.accountKeeper.SetAccount(ctx, acc2)
+	nextAccNum := acc2.GetAccountNumber() + 1
+
+	suite.accountKeeper.RemoveAccount(ctx, acc1)
+
+	// the removed account number is not given back to its former holder
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.Require().Equal(nextAccNum, acc.GetAccountNumber())
+	suite.accountKeeper.SetAccount(ctx, acc)
+
+	// but is reused for other addresses
+	acc3 := suite.accountKeeper.NewAccountWithAddress(ctx, addr3)
+	suite.Require().Equal(acc1.GetAccountNumber(), acc3.GetAccountNumber())
+	suite.accountKeeper.SetAccount(ctx, acc3)
+
+	var free []uint64
+	suite.accountKeeper.AccountNumberRecycler().IterateFreeAccountNumbers(ctx, func(accNum uint64) bool {
+		free = append(free, accNum)
+		return false
+	})
+	suite.Require().Empty(free)
+
+	// account numbers assigned again without being recycled are dropped
+	suite.accountKeeper.RemoveAccount(ctx, acc2)
+	suite.accountKeeper.SetAccount(ctx, acc2)
+	acc = suite.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress([]byte("addr4---------------")))
+	suite.Require().Equal(nextAccNum+1, acc.GetAccountNumber())
+
+	_, broken := keeper.UniqueAccountNumbersInvariant(suite.accountKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// two accounts sharing an account number break the invariant
+	suite.Require().NoError(acc.SetAccountNumber(acc3.GetAccountNumber()))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	msg, broken := keeper.UniqueAccountNumbersInvariant(suite.accountKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "share the account number")
+}
+
+func (suite *KeeperTestSuite) TestAccountNumberRecyclingLastHolder() {
+	ctx := suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	acc1 := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.accountKeeper.SetAccount(ctx, acc1)
+	suite.accountKeeper.RemoveAccount(ctx, acc1)
+
+	acc2 := suite.accountKeeper.NewAccountWithAddress(ctx, addr2)
+	suite.Require().Equal(acc1.GetAccountNumber(), acc2.GetAccountNumber())
+	suite.accountKeeper.SetAccount(ctx, acc2)
+	suite.accountKeeper.RemoveAccount(ctx, acc2)
+
+	// only the last holder of the account number is recorded
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.Require().Equal(acc1.GetAccountNumber(), acc.GetAccountNumber())
+}
+
+func (suite *KeeperTestSuite) TestAccountNumberRecyclingScanLimit() {
+	ctx := suite.ctx
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	recycler := suite.accountKeeper.AccountNumberRecycler()
+
+	// fill the free-list with account numbers last held by addr
+	nextAccNum := suite.accountKeeper.NextAccountNumber(ctx)
+	for i := uint64(0); i < 100; i++ {
+		recycler.Release(ctx, nextAccNum+i, addr)
+	}
+	recycler.Release(ctx, nextAccNum+100, sdk.AccAddress([]byte("addr2---------------")))
+
+	// the free account number beyond the scan limit is not reached
+	_, found := recycler.Acquire(ctx, addr)
+	suite.Require().False(found)
+
+	accNum, found := recycler.Acquire(ctx, sdk.AccAddress([]byte("addr3---------------")))
+	suite.Require().True(found)
+	suite.Require().Equal(nextAccNum, accNum)
+}
@@ -0,0 +1,30 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MigrateStore performs in-place store migrations from the consensus version 4
+// to version 5. The migration includes:
+// - Index the accounts by their type URL
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressStoreKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var any codectypes.Any
+		if err := cdc.Unmarshal(iterator.Value(), &any); err != nil {
+			return err
+		}
+
+		addr := sdk.AccAddress(iterator.Key()[len(types.AddressStoreKeyPrefix):])
+		store.Set(types.AccountTypeStoreKey(any.TypeUrl, addr), []byte{})
+	}
+
+	return nil
+}
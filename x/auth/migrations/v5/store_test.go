@@ -0,0 +1,39 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	v5 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v5"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	moduleAcc := authtypes.NewEmptyModuleAccount("module")
+
+	// store the accounts without indexing them by type
+	for _, acc := range []authtypes.AccountI{baseAcc, moduleAcc} {
+		bz, err := cdc.MarshalInterface(acc)
+		require.NoError(t, err)
+		store.Set(authtypes.AddressStoreKey(acc.GetAddress()), bz)
+	}
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	require.True(t, store.Has(authtypes.AccountTypeStoreKey("/cosmos.auth.v1beta1.BaseAccount", addr)))
+	require.True(t, store.Has(authtypes.AccountTypeStoreKey("/cosmos.auth.v1beta1.ModuleAccount", moduleAcc.GetAddress())))
+	require.False(t, store.Has(authtypes.AccountTypeStoreKey("/cosmos.auth.v1beta1.ModuleAccount", addr)))
+}
@@ -34,11 +34,13 @@ type wrapper struct {
 }
 
 var (
-	_ authsigning.Tx             = &wrapper{}
-	_ client.TxBuilder           = &wrapper{}
-	_ tx.TipTx                   = &wrapper{}
-	_ ante.HasExtensionOptionsTx = &wrapper{}
-	_ ExtensionOptionsTxBuilder  = &wrapper{}
+	_ authsigning.Tx                  = &wrapper{}
+	_ client.TxBuilder                = &wrapper{}
+	_ tx.TipTx                        = &wrapper{}
+	_ tx.AbstractAccountWitnessTx     = &wrapper{}
+	_ client.AbstractAccountTxBuilder = &wrapper{}
+	_ ante.HasExtensionOptionsTx      = &wrapper{}
+	_ ExtensionOptionsTxBuilder       = &wrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -157,10 +159,19 @@ func (w *wrapper) GetTip() *tx.Tip {
 	return w.tx.AuthInfo.Tip
 }
 
+func (w *wrapper) GetAbstractAccountWitness() *tx.AbstractAccountWitness {
+	return w.tx.AuthInfo.AbstractAccountWitness
+}
+
 func (w *wrapper) GetMemo() string {
 	return w.tx.Body.Memo
 }
 
+// GetTimeoutTimestamp returns the transaction's timeout timestamp (if set).
+func (w *wrapper) GetTimeoutTimestamp() int64 {
+	return w.tx.Body.TimeoutTimestamp
+}
+
 // GetTimeoutHeight returns the transaction's timeout height (if set).
 func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
@@ -216,6 +227,15 @@ func (w *wrapper) SetMsgs(msgs ...sdk.Msg) error {
 	return nil
 }
 
+// SetTimeoutTimestamp sets the transaction's timestamp timeout, as a UNIX time
+// in seconds.
+func (w *wrapper) SetTimeoutTimestamp(timestamp int64) {
+	w.tx.Body.TimeoutTimestamp = timestamp
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 // SetTimeoutHeight sets the transaction's height timeout.
 func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.tx.Body.TimeoutHeight = height
@@ -282,6 +302,16 @@ func (w *wrapper) SetFeeGranter(feeGranter sdk.AccAddress) {
 	w.authInfoBz = nil
 }
 
+// SetAbstractAccountWitness sets the authentication data of the abstract
+// account signing the tx. As the witness is part of the AuthInfo, it must be set
+// before the tx is signed.
+func (w *wrapper) SetAbstractAccountWitness(witness *tx.AbstractAccountWitness) {
+	w.tx.AuthInfo.AbstractAccountWitness = witness
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	w.authInfoBz = nil
+}
+
 func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -400,6 +430,9 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 	if w.tx.Body.TimeoutHeight != 0 && w.tx.Body.TimeoutHeight != body.TimeoutHeight {
 		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout height %d, got %d in AuxSignerData", w.tx.Body.TimeoutHeight, body.TimeoutHeight)
 	}
+	if w.tx.Body.TimeoutTimestamp != 0 && w.tx.Body.TimeoutTimestamp != body.TimeoutTimestamp {
+		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout timestamp %d, got %d in AuxSignerData", w.tx.Body.TimeoutTimestamp, body.TimeoutTimestamp)
+	}
 	if len(w.tx.Body.ExtensionOptions) != 0 {
 		if len(w.tx.Body.ExtensionOptions) != len(body.ExtensionOptions) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has %d extension options, got %d in AuxSignerData", len(w.tx.Body.ExtensionOptions), len(body.ExtensionOptions))
@@ -441,6 +474,7 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 
 	w.SetMemo(body.Memo)
 	w.SetTimeoutHeight(body.TimeoutHeight)
+	w.SetTimeoutTimestamp(body.TimeoutTimestamp)
 	w.SetExtensionOptions(body.ExtensionOptions...)
 	w.SetNonCriticalExtensionOptions(body.NonCriticalExtensionOptions...)
 	msgs := make([]sdk.Msg, len(body.Messages))
@@ -46,6 +46,10 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support protobuf extension options", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
 
+	if body.TimeoutTimestamp != 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support timeout timestamps", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
 	addr := data.Address
 	if addr == "" {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "got empty address in %s handler", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
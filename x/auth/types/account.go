@@ -334,6 +334,22 @@ type AccountI interface {
 	String() string
 }
 
+// CustomAccountHandler defines an account with custom authentication logic,
+// e.g. a smart contract wallet. The transactions signed by accounts implementing
+// CustomAccountHandler are authenticated by calling Authenticate instead of
+// verifying their signatures.
+type CustomAccountHandler interface {
+	// Authenticate returns an error if the transaction is not authorized by the
+	// account. witnessData is the data of the AbstractAccountWitness carried by
+	// the transaction for the account.
+	Authenticate(ctx sdk.Context, tx sdk.Tx, witnessData []byte) error
+
+	// ExecuteTx executes a transaction authorized by the account. It is not
+	// called by x/auth, and is left to the applications executing the
+	// transactions of such accounts with custom logic.
+	ExecuteTx(ctx sdk.Context, tx sdk.Tx) error
+}
+
 // ModuleAccountI defines an account interface for modules that hold tokens in
 // an escrow.
 type ModuleAccountI interface {
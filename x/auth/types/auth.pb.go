@@ -174,6 +174,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// max_account_query_batch is the maximum number of addresses of an
+	// AccountsByAddresses query. The default value is used if it is zero.
+	MaxAccountQueryBatch uint64 `protobuf:"varint,6,opt,name=max_account_query_batch,json=maxAccountQueryBatch,proto3" json:"max_account_query_batch,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,6 +247,13 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxAccountQueryBatch() uint64 {
+	if m != nil {
+		return m.MaxAccountQueryBatch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -254,52 +264,53 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 707 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x4e, 0xe3, 0x46,
-	0x1c, 0x8e, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0xbb, 0x91, 0x2a, 0x52,
-	0x54, 0xec, 0x26, 0x15, 0x95, 0xca, 0x8d, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x5a, 0x0e, 0xab,
-	0x95, 0xac, 0xb1, 0x33, 0x98, 0x11, 0x19, 0x8f, 0xd7, 0x33, 0x46, 0x31, 0x4f, 0x80, 0xf6, 0xb4,
-	0x8f, 0xc0, 0xee, 0x13, 0x70, 0xe0, 0x21, 0x56, 0x7b, 0x42, 0x7b, 0xd9, 0xdd, 0x4b, 0xb4, 0x0a,
-	0x07, 0xd0, 0x3e, 0xc5, 0xca, 0x33, 0x0e, 0x24, 0x6c, 0x2e, 0x91, 0x7f, 0xdf, 0xf7, 0xfd, 0xfe,
-	0x7d, 0xfe, 0xc5, 0xa0, 0xea, 0x51, 0x46, 0x28, 0xb3, 0x60, 0xcc, 0x8f, 0xad, 0xd3, 0x86, 0x8b,
-	0x38, 0x6c, 0x88, 0xc0, 0x0c, 0x23, 0xca, 0xa9, 0xba, 0x2c, 0x79, 0x53, 0x40, 0x19, 0x5f, 0x59,
-	0x82, 0x04, 0x07, 0xd4, 0x12, 0xbf, 0x52, 0x57, 0x59, 0x95, 0x3a, 0x47, 0x44, 0x56, 0x96, 0x24,
-	0xa9, 0xb2, 0x4f, 0x7d, 0x2a, 0xf1, 0xf4, 0x69, 0x94, 0xe0, 0x53, 0xea, 0xf7, 0x90, 0x25, 0x22,
-	0x37, 0x3e, 0xb2, 0x60, 0x90, 0x48, 0xaa, 0xf6, 0x2a, 0x0f, 0x4a, 0x2d, 0xc8, 0xd0, 0xb6, 0xe7,
-	0xd1, 0x38, 0xe0, 0x6a, 0x13, 0xcc, 0xc2, 0x6e, 0x37, 0x42, 0x8c, 0x69, 0x8a, 0xa1, 0xd4, 0xe7,
-	0x5a, 0xda, 0xbb, 0xab, 0x8d, 0x72, 0xd6, 0x63, 0x5b, 0x32, 0x1d, 0x1e, 0xe1, 0xc0, 0xb7, 0x47,
-	0x42, 0xf5, 0x10, 0xcc, 0x86, 0xb1, 0xeb, 0x9c, 0xa0, 0x44, 0xcb, 0x1b, 0x4a, 0xbd, 0xd4, 0x2c,
-	0x9b, 0xb2, 0xa1, 0x39, 0x6a, 0x68, 0x6e, 0x07, 0x49, 0x6b, 0xed, 0xf3, 0x40, 0x2f, 0x87, 0xb1,
-	0xdb, 0xc3, 0x5e, 0xaa, 0xfd, 0x8d, 0x12, 0xcc, 0x11, 0x09, 0x79, 0xf2, 0xfa, 0xf6, 0x72, 0x1d,
-	0x3c, 0x10, 0xf6, 0x4c, 0x18, 0xbb, 0xbb, 0x28, 0x51, 0x7f, 0x01, 0x8b, 0x50, 0x8e, 0xe5, 0x04,
-	0x31, 0x71, 0x51, 0xa4, 0x15, 0x0c, 0xa5, 0x5e, 0xb4, 0x17, 0x32, 0x74, 0x5f, 0x80, 0x6a, 0x05,
-	0x7c, 0xcb, 0xd0, 0xf3, 0x18, 0x05, 0x1e, 0xd2, 0x8a, 0x42, 0x70, 0x1f, 0x6f, 0xb5, 0xcf, 0x2f,
-	0xf4, 0xdc, 0xdd, 0x85, 0x9e, 0x7b, 0x7b, 0xb5, 0xf1, 0xd3, 0x14, 0x7b, 0xcd, 0x6c, 0xef, 0x9d,
-	0x17, 0xb7, 0x97, 0xeb, 0x2b, 0x52, 0xb0, 0xc1, 0xba, 0x27, 0xd6, 0x98, 0x27, 0xb5, 0x8f, 0x0a,
-	0x58, 0xd8, 0xa3, 0xdd, 0xb8, 0x77, 0xef, 0xd2, 0x0e, 0x98, 0x77, 0x21, 0x43, 0x4e, 0x36, 0x88,
-	0xb0, 0xaa, 0xd4, 0x34, 0xcc, 0x69, 0x1d, 0xc6, 0x2a, 0xb5, 0x8a, 0xd7, 0x03, 0x5d, 0xb1, 0x4b,
-	0xee, 0x98, 0xe1, 0x2a, 0x28, 0x06, 0x90, 0x20, 0xe1, 0xdc, 0x9c, 0x2d, 0x9e, 0x55, 0x03, 0x94,
-	0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0x3e, 0x67, 0x8f, 0x43, 0x5b,
-	0xff, 0x9e, 0xcb, 0x9d, 0x6a, 0xd3, 0x3a, 0x4e, 0xcc, 0x2a, 0x36, 0xd3, 0xc6, 0x36, 0x9b, 0x60,
-	0x6b, 0xcf, 0xc0, 0xf7, 0x12, 0x68, 0x47, 0xa8, 0x8b, 0x02, 0x8e, 0x61, 0x4f, 0xd5, 0x41, 0x89,
-	0x08, 0xcc, 0x11, 0x93, 0x89, 0x3b, 0xb0, 0x81, 0x84, 0xf6, 0xd3, 0xf9, 0xd6, 0xc0, 0x77, 0x5d,
-	0x14, 0xe1, 0x53, 0xc8, 0x31, 0x0d, 0xd2, 0x57, 0xc6, 0xb4, 0xbc, 0x51, 0xa8, 0xcf, 0xdb, 0x8b,
-	0x0f, 0xf0, 0x2e, 0x4a, 0x58, 0xed, 0x7d, 0x1e, 0xcc, 0x1c, 0xc0, 0x08, 0x12, 0xa6, 0x9a, 0x60,
-	0x99, 0xc0, 0xbe, 0x43, 0x10, 0xa1, 0x8e, 0x77, 0x0c, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x23, 0x2b,
-	0xda, 0x4b, 0x04, 0xf6, 0xf7, 0x10, 0xa1, 0xed, 0x7b, 0x42, 0x35, 0xc0, 0x3c, 0xef, 0x3b, 0x0c,
-	0xfb, 0x4e, 0x0f, 0x13, 0xcc, 0x85, 0x3f, 0x45, 0x1b, 0xf0, 0x7e, 0x07, 0xfb, 0xff, 0xa5, 0x88,
-	0xfa, 0x3b, 0xf8, 0x41, 0x28, 0xce, 0x90, 0xe3, 0x51, 0xc6, 0x9d, 0x10, 0x45, 0x8e, 0x9b, 0x70,
-	0x94, 0x5d, 0xc9, 0x52, 0x2a, 0x3d, 0x43, 0x6d, 0xca, 0xf8, 0x01, 0x8a, 0x5a, 0x09, 0x47, 0xea,
-	0xff, 0xe0, 0xc7, 0xb4, 0xe0, 0x29, 0x8a, 0xf0, 0x51, 0x22, 0x93, 0x50, 0xb7, 0xb9, 0xb9, 0xd9,
-	0xf8, 0x4b, 0x1e, 0x4e, 0x4b, 0x1b, 0x0e, 0xf4, 0x72, 0x07, 0xfb, 0x87, 0x42, 0x91, 0xa6, 0xfe,
-	0xf3, 0xb7, 0xe0, 0xed, 0x32, 0x9b, 0x40, 0x65, 0x96, 0xfa, 0x04, 0xac, 0x3e, 0x2e, 0xc8, 0x90,
-	0x17, 0x36, 0x37, 0xff, 0x3c, 0x69, 0x68, 0xdf, 0x88, 0x92, 0x95, 0xe1, 0x40, 0x5f, 0x99, 0x28,
-	0xd9, 0x19, 0x29, 0xec, 0x15, 0x36, 0x15, 0xdf, 0xfa, 0xf9, 0xee, 0x42, 0x57, 0x1e, 0xbf, 0xb7,
-	0xbe, 0xfc, 0x6e, 0x48, 0x3b, 0x5b, 0xed, 0x37, 0xc3, 0xaa, 0x72, 0x3d, 0xac, 0x2a, 0x9f, 0x86,
-	0x55, 0xe5, 0xe5, 0x4d, 0x35, 0x77, 0x7d, 0x53, 0xcd, 0x7d, 0xb8, 0xa9, 0xe6, 0x9e, 0xfe, 0xea,
-	0x63, 0x7e, 0x1c, 0xbb, 0xa6, 0x47, 0x49, 0xf6, 0x6d, 0xb0, 0xbe, 0xae, 0xc2, 0x93, 0x10, 0x31,
-	0x77, 0x46, 0xfc, 0x3f, 0xff, 0xf8, 0x12, 0x00, 0x00, 0xff, 0xff, 0x94, 0x45, 0xcd, 0x40, 0x99,
-	0x04, 0x00, 0x00,
+	// 736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x8e, 0xdb, 0x44,
+	0x1c, 0x8e, 0x37, 0x21, 0x65, 0x27, 0xdb, 0xc2, 0xba, 0x61, 0xeb, 0x46, 0x28, 0x36, 0x91, 0x50,
+	0x43, 0xc5, 0xda, 0x24, 0x28, 0x48, 0xec, 0x6d, 0x1d, 0x10, 0xaa, 0x4a, 0x4b, 0x71, 0x44, 0x0f,
+	0x08, 0xc9, 0x1a, 0x3b, 0xbf, 0x3a, 0xa3, 0xcd, 0x78, 0x5c, 0xcf, 0x78, 0x15, 0xf7, 0x09, 0x2a,
+	0x0e, 0x88, 0x47, 0x58, 0x78, 0x82, 0x1e, 0xf6, 0x21, 0x10, 0xa7, 0x15, 0x27, 0xb8, 0x44, 0x28,
+	0x7b, 0xd8, 0x15, 0x4f, 0x81, 0x3c, 0xe3, 0xec, 0x26, 0xdb, 0x5c, 0x22, 0xcf, 0xf7, 0x7d, 0xbf,
+	0x7f, 0xdf, 0xfc, 0x32, 0xa8, 0x1d, 0x32, 0x4e, 0x19, 0x77, 0x70, 0x26, 0x26, 0xce, 0x71, 0x2f,
+	0x00, 0x81, 0x7b, 0xf2, 0x60, 0x27, 0x29, 0x13, 0x4c, 0xbf, 0xab, 0x78, 0x5b, 0x42, 0x25, 0xdf,
+	0xda, 0xc5, 0x94, 0xc4, 0xcc, 0x91, 0xbf, 0x4a, 0xd7, 0xba, 0xaf, 0x74, 0xbe, 0x3c, 0x39, 0x65,
+	0x90, 0xa2, 0x9a, 0x11, 0x8b, 0x98, 0xc2, 0x8b, 0xaf, 0x65, 0x40, 0xc4, 0x58, 0x34, 0x05, 0x47,
+	0x9e, 0x82, 0xec, 0x85, 0x83, 0xe3, 0x5c, 0x51, 0x9d, 0xdf, 0xb6, 0x50, 0xc3, 0xc5, 0x1c, 0x0e,
+	0xc3, 0x90, 0x65, 0xb1, 0xd0, 0xfb, 0xe8, 0x16, 0x1e, 0x8f, 0x53, 0xe0, 0xdc, 0xd0, 0x2c, 0xad,
+	0xbb, 0xed, 0x1a, 0x7f, 0x9d, 0xee, 0x37, 0xcb, 0x1a, 0x87, 0x8a, 0x19, 0x89, 0x94, 0xc4, 0x91,
+	0xb7, 0x14, 0xea, 0xcf, 0xd1, 0xad, 0x24, 0x0b, 0xfc, 0x23, 0xc8, 0x8d, 0x2d, 0x4b, 0xeb, 0x36,
+	0xfa, 0x4d, 0x5b, 0x15, 0xb4, 0x97, 0x05, 0xed, 0xc3, 0x38, 0x77, 0x1f, 0xfc, 0x37, 0x37, 0x9b,
+	0x49, 0x16, 0x4c, 0x49, 0x58, 0x68, 0x3f, 0x65, 0x94, 0x08, 0xa0, 0x89, 0xc8, 0x7f, 0xbf, 0x78,
+	0xf3, 0x10, 0x5d, 0x13, 0x5e, 0x3d, 0xc9, 0x82, 0xc7, 0x90, 0xeb, 0x1f, 0xa3, 0x3b, 0x58, 0xb5,
+	0xe5, 0xc7, 0x19, 0x0d, 0x20, 0x35, 0xaa, 0x96, 0xd6, 0xad, 0x79, 0xb7, 0x4b, 0xf4, 0xa9, 0x04,
+	0xf5, 0x16, 0x7a, 0x97, 0xc3, 0xcb, 0x0c, 0xe2, 0x10, 0x8c, 0x9a, 0x14, 0x5c, 0x9d, 0x0f, 0x86,
+	0xaf, 0x4f, 0xcc, 0xca, 0xe5, 0x89, 0x59, 0xf9, 0xf3, 0x74, 0xff, 0xc3, 0x0d, 0xf6, 0xda, 0xe5,
+	0xdc, 0x8f, 0x7e, 0xbe, 0x78, 0xf3, 0x70, 0x4f, 0x09, 0xf6, 0xf9, 0xf8, 0xc8, 0x59, 0xf1, 0xa4,
+	0xf3, 0x8f, 0x86, 0x6e, 0x3f, 0x61, 0xe3, 0x6c, 0x7a, 0xe5, 0xd2, 0x23, 0xb4, 0x13, 0x60, 0x0e,
+	0x7e, 0xd9, 0x88, 0xb4, 0xaa, 0xd1, 0xb7, 0xec, 0x4d, 0x15, 0x56, 0x32, 0xb9, 0xb5, 0xb3, 0xb9,
+	0xa9, 0x79, 0x8d, 0x60, 0xc5, 0x70, 0x1d, 0xd5, 0x62, 0x4c, 0x41, 0x3a, 0xb7, 0xed, 0xc9, 0x6f,
+	0xdd, 0x42, 0x8d, 0x04, 0x52, 0x4a, 0x38, 0x27, 0x2c, 0xe6, 0x46, 0xd5, 0xaa, 0x76, 0xb7, 0xbd,
+	0x55, 0xe8, 0xe0, 0x9b, 0xd7, 0x6a, 0xa6, 0xce, 0xa6, 0x8a, 0x6b, 0xbd, 0xca, 0xc9, 0x8c, 0x95,
+	0xc9, 0xd6, 0xd8, 0xce, 0x4f, 0xe8, 0x7d, 0x05, 0x0c, 0x53, 0x18, 0x43, 0x2c, 0x08, 0x9e, 0xea,
+	0x26, 0x6a, 0x50, 0x89, 0xf9, 0xb2, 0x33, 0xb9, 0x07, 0x1e, 0x52, 0xd0, 0xd3, 0xa2, 0xbf, 0x07,
+	0xe8, 0xbd, 0x31, 0xa4, 0xe4, 0x18, 0x0b, 0xc2, 0xe2, 0xe2, 0xca, 0xb8, 0xb1, 0x65, 0x55, 0xbb,
+	0x3b, 0xde, 0x9d, 0x6b, 0xf8, 0x31, 0xe4, 0xbc, 0xf3, 0x4b, 0x15, 0xd5, 0x9f, 0xe1, 0x14, 0x53,
+	0xae, 0xdb, 0xe8, 0x2e, 0xc5, 0x33, 0x9f, 0x02, 0x65, 0x7e, 0x38, 0xc1, 0x29, 0x0e, 0x05, 0xa4,
+	0x6a, 0xc9, 0x6a, 0xde, 0x2e, 0xc5, 0xb3, 0x27, 0x40, 0xd9, 0xf0, 0x8a, 0xd0, 0x2d, 0xb4, 0x23,
+	0x66, 0x3e, 0x27, 0x91, 0x3f, 0x25, 0x94, 0x08, 0xe9, 0x4f, 0xcd, 0x43, 0x62, 0x36, 0x22, 0xd1,
+	0xb7, 0x05, 0xa2, 0x7f, 0x86, 0x3e, 0x90, 0x8a, 0x57, 0xe0, 0x87, 0x8c, 0x0b, 0x3f, 0x81, 0xd4,
+	0x0f, 0x72, 0x01, 0xe5, 0x96, 0xec, 0x16, 0xd2, 0x57, 0x30, 0x64, 0x5c, 0x3c, 0x83, 0xd4, 0xcd,
+	0x05, 0xe8, 0xdf, 0xa1, 0x7b, 0x45, 0xc2, 0x63, 0x48, 0xc9, 0x8b, 0x5c, 0x05, 0xc1, 0xb8, 0x3f,
+	0x18, 0xf4, 0xbe, 0x54, 0x8b, 0xe3, 0x1a, 0x8b, 0xb9, 0xd9, 0x1c, 0x91, 0xe8, 0xb9, 0x54, 0x14,
+	0xa1, 0x5f, 0x7f, 0x25, 0x79, 0xaf, 0xc9, 0xd7, 0x50, 0x15, 0xa5, 0xff, 0x80, 0xee, 0xdf, 0x4c,
+	0xc8, 0x21, 0x4c, 0xfa, 0x83, 0x2f, 0x8e, 0x7a, 0xc6, 0x3b, 0x32, 0x65, 0x6b, 0x31, 0x37, 0xf7,
+	0xd6, 0x52, 0x8e, 0x96, 0x0a, 0x6f, 0x8f, 0x6f, 0xc4, 0xf5, 0x01, 0xba, 0x57, 0x78, 0xb5, 0x5c,
+	0xfe, 0x97, 0x19, 0xa4, 0xb9, 0x1f, 0x60, 0x11, 0x4e, 0x8c, 0xba, 0x9c, 0xad, 0x49, 0xf1, 0xac,
+	0xbc, 0xc1, 0xef, 0x0b, 0xd2, 0x2d, 0xb8, 0x83, 0x8f, 0x2e, 0x4f, 0x4c, 0xed, 0xe6, 0x75, 0xcf,
+	0xd4, 0x73, 0xa3, 0x6e, 0xc1, 0x1d, 0xfe, 0xb1, 0x68, 0x6b, 0x67, 0x8b, 0xb6, 0xf6, 0xef, 0xa2,
+	0xad, 0xfd, 0x7a, 0xde, 0xae, 0x9c, 0x9d, 0xb7, 0x2b, 0x7f, 0x9f, 0xb7, 0x2b, 0x3f, 0x7e, 0x12,
+	0x11, 0x31, 0xc9, 0x02, 0x3b, 0x64, 0xb4, 0x7c, 0x52, 0x9c, 0xb7, 0xb3, 0x88, 0x3c, 0x01, 0x1e,
+	0xd4, 0xe5, 0xdf, 0xfa, 0xf3, 0xff, 0x07, 0x00, 0x22, 0xe3, 0x91, 0xb5, 0xd0, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +347,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.MaxAccountQueryBatch != that1.MaxAccountQueryBatch {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +514,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAccountQueryBatch != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxAccountQueryBatch))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -625,6 +644,9 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.MaxAccountQueryBatch != 0 {
+		n += 1 + sovAuth(uint64(m.MaxAccountQueryBatch))
+	}
 	return n
 }
 
@@ -1178,6 +1200,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountQueryBatch", wireType)
+			}
+			m.MaxAccountQueryBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAccountQueryBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -27,6 +28,13 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// FreeAccountNumberStoreKeyPrefix prefix for the free-list of the account
+	// numbers of removed accounts, along with the addresses which held them last
+	FreeAccountNumberStoreKeyPrefix = []byte{0x02}
+
+	// AccountTypeStoreKeyPrefix prefix for the index of accounts by type URL
+	AccountTypeStoreKeyPrefix = []byte{0x04}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -38,3 +46,21 @@ func AddressStoreKey(addr sdk.AccAddress) []byte {
 func AccountNumberStoreKey(accountNumber uint64) []byte {
 	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
 }
+
+// FreeAccountNumberStoreKey turn an account number to key used to store it in
+// the free-list of account numbers
+func FreeAccountNumberStoreKey(accountNumber uint64) []byte {
+	return append(FreeAccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
+}
+
+// AccountTypePrefixStoreKey turn an account type URL to the prefix of the keys
+// indexing the accounts of this type
+func AccountTypePrefixStoreKey(typeURL string) []byte {
+	return append(AccountTypeStoreKeyPrefix, address.MustLengthPrefix([]byte(typeURL))...)
+}
+
+// AccountTypeStoreKey turn an account type URL and address to key used to index
+// the account by its type
+func AccountTypeStoreKey(typeURL string, addr sdk.AccAddress) []byte {
+	return append(AccountTypePrefixStoreKey(typeURL), addr.Bytes()...)
+}
@@ -11,6 +11,7 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultMaxAccountQueryBatch   uint64 = 100
 )
 
 // NewParams creates a new Params object
@@ -32,9 +33,21 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxAccountQueryBatch:   DefaultMaxAccountQueryBatch,
 	}
 }
 
+// AccountQueryBatchLimit returns the maximum number of addresses of an
+// AccountsByAddresses query, i.e. MaxAccountQueryBatch, or its default value
+// if it is not set, as for the parameters predating it.
+func (p Params) AccountQueryBatchLimit() uint64 {
+	if p.MaxAccountQueryBatch == 0 {
+		return DefaultMaxAccountQueryBatch
+	}
+
+	return p.MaxAccountQueryBatch
+}
+
 // SigVerifyCostSecp256r1 returns gas fee of secp256r1 signature verification.
 // Set by benchmarking current implementation:
 //
@@ -48,3 +48,16 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_AccountQueryBatchLimit(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.DefaultMaxAccountQueryBatch, params.AccountQueryBatchLimit())
+
+	params.MaxAccountQueryBatch = 10
+	require.Equal(t, uint64(10), params.AccountQueryBatchLimit())
+
+	// parameters predating MaxAccountQueryBatch use its default value
+	params.MaxAccountQueryBatch = 0
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultMaxAccountQueryBatch, params.AccountQueryBatchLimit())
+}
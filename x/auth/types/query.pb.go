@@ -962,6 +962,232 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryAccountsByTypeRequest is the request type for the Query/AccountsByType
+// RPC method.
+type QueryAccountsByTypeRequest struct {
+	// type_url is the type URL of the accounts to query, e.g.
+	// /cosmos.auth.v1beta1.ModuleAccount.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByTypeRequest) Reset()         { *m = QueryAccountsByTypeRequest{} }
+func (m *QueryAccountsByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByTypeRequest) ProtoMessage()    {}
+func (*QueryAccountsByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryAccountsByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByTypeRequest.Merge(m, src)
+}
+func (m *QueryAccountsByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryAccountsByTypeRequest) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *QueryAccountsByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountsByTypeResponse is the response type for the Query/AccountsByType
+// RPC method.
+type QueryAccountsByTypeResponse struct {
+	// accounts are the existing accounts of the given type
+	Accounts []*types.Any `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByTypeResponse) Reset()         { *m = QueryAccountsByTypeResponse{} }
+func (m *QueryAccountsByTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByTypeResponse) ProtoMessage()    {}
+func (*QueryAccountsByTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryAccountsByTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByTypeResponse.Merge(m, src)
+}
+func (m *QueryAccountsByTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByTypeResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByTypeResponse) GetAccounts() []*types.Any {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsByTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountsByAddressesRequest is the request type for the
+// Query/AccountsByAddresses RPC method.
+type QueryAccountsByAddressesRequest struct {
+	// addresses are the account address strings to query.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines an optional pagination for the request. The addresses
+	// are paginated in the order of their bytes.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByAddressesRequest) Reset()         { *m = QueryAccountsByAddressesRequest{} }
+func (m *QueryAccountsByAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByAddressesRequest) ProtoMessage()    {}
+func (*QueryAccountsByAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryAccountsByAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByAddressesRequest.Merge(m, src)
+}
+func (m *QueryAccountsByAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryAccountsByAddressesRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryAccountsByAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountsByAddressesResponse is the response type for the
+// Query/AccountsByAddresses RPC method.
+type QueryAccountsByAddressesResponse struct {
+	// accounts are the existing accounts among the queried addresses
+	Accounts []*types.Any `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByAddressesResponse) Reset()         { *m = QueryAccountsByAddressesResponse{} }
+func (m *QueryAccountsByAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByAddressesResponse) ProtoMessage()    {}
+func (*QueryAccountsByAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryAccountsByAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByAddressesResponse.Merge(m, src)
+}
+func (m *QueryAccountsByAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByAddressesResponse) GetAccounts() []*types.Any {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsByAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,79 +1209,92 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryAccountsByTypeRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsByTypeRequest")
+	proto.RegisterType((*QueryAccountsByTypeResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsByTypeResponse")
+	proto.RegisterType((*QueryAccountsByAddressesRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsByAddressesRequest")
+	proto.RegisterType((*QueryAccountsByAddressesResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsByAddressesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x34, 0x2b, 0xf1, 0xe3, 0x54, 0xef, 0x02, 0xea, 0x61, 0x51, 0x70,
-	0x57, 0x08, 0x71, 0x20, 0x72, 0x62, 0x27, 0xb5, 0xd8, 0x78, 0xb2, 0xb1, 0x03, 0x1b, 0xaa, 0x5c,
-	0x90, 0x90, 0x7a, 0x41, 0x42, 0x82, 0x3f, 0x60, 0x0f, 0x88, 0xf3, 0x22, 0x95, 0x1b, 0x7f, 0xc0,
-	0x6a, 0x4f, 0x2b, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x1b, 0xff, 0x02, 0xca, 0xcc, 0xb3, 0x63,
-	0xb7, 0x93, 0xc4, 0x85, 0x53, 0x9d, 0x99, 0xf7, 0xbe, 0xef, 0x33, 0x6f, 0x9e, 0xfd, 0x2d, 0x14,
-	0x9a, 0xd4, 0xeb, 0x50, 0x4f, 0x33, 0xfb, 0xfe, 0x91, 0xf6, 0xd9, 0x6e, 0xc3, 0xf6, 0xcd, 0x5d,
-	0xed, 0x61, 0xdf, 0xee, 0x0d, 0xd4, 0x6e, 0x8f, 0xfa, 0x94, 0xac, 0xf2, 0x00, 0x75, 0x14, 0xa0,
-	0x62, 0x80, 0xbc, 0x83, 0x59, 0x0d, 0xd3, 0xb3, 0x79, 0x74, 0x98, 0xdb, 0x35, 0xdb, 0x8e, 0x6b,
-	0xfa, 0x0e, 0x75, 0xb9, 0x80, 0x9c, 0x69, 0xd3, 0x36, 0x65, 0x8f, 0xda, 0xe8, 0x09, 0x57, 0x5f,
-	0x6e, 0x53, 0xda, 0x7e, 0x60, 0x6b, 0xec, 0x57, 0xa3, 0xdf, 0xd2, 0x4c, 0x17, 0x2b, 0xca, 0x6b,
-	0xb8, 0x65, 0x76, 0x1d, 0xcd, 0x74, 0x5d, 0xea, 0x33, 0x35, 0x0f, 0x77, 0xf3, 0x22, 0x60, 0x06,
-	0x87, 0xc2, 0x7c, 0xbf, 0xce, 0x2b, 0x22, 0x3c, 0xdf, 0xca, 0x61, 0x6a, 0x00, 0x1c, 0x3d, 0xa7,
-	0xf2, 0x09, 0x64, 0x3e, 0x18, 0xfd, 0xdc, 0x6f, 0x36, 0x69, 0xdf, 0xf5, 0x3d, 0xc3, 0x7e, 0xd8,
-	0xb7, 0x3d, 0x9f, 0xbc, 0x0b, 0x30, 0x3e, 0x52, 0x56, 0x2a, 0x4a, 0xa5, 0xe5, 0xca, 0xa6, 0x8a,
-	0xba, 0xa3, 0xf3, 0xab, 0x5c, 0x05, 0x51, 0xd4, 0x9a, 0xd9, 0xb6, 0x31, 0xd7, 0x88, 0x64, 0x2a,
-	0xa7, 0x12, 0xdc, 0xbc, 0x50, 0xc0, 0xeb, 0x52, 0xd7, 0xb3, 0x89, 0x01, 0x4b, 0x26, 0xae, 0x65,
-	0xa5, 0xe2, 0xb5, 0xd2, 0x72, 0x25, 0xa3, 0xf2, 0x16, 0xa8, 0x41, 0x77, 0xd4, 0x7d, 0x77, 0xa0,
-	0x17, 0x9f, 0x9d, 0x96, 0xd7, 0x04, 0xb7, 0xa1, 0xa2, 0xe2, 0x81, 0x11, 0xea, 0x90, 0xf7, 0x62,
-	0xd4, 0x73, 0x8c, 0x7a, 0x6b, 0x26, 0x35, 0x07, 0x8a, 0x61, 0x1f, 0xc2, 0x6a, 0x94, 0x3a, 0xe8,
-	0x4a, 0x05, 0x16, 0x4d, 0xcb, 0xea, 0xd9, 0x9e, 0xc7, 0x5a, 0x72, 0x5d, 0xcf, 0xfe, 0x72, 0x5a,
-	0xce, 0xa0, 0xfe, 0x3e, 0xdf, 0x39, 0xf4, 0x7b, 0x8e, 0xdb, 0x36, 0x82, 0xc0, 0xb7, 0x96, 0x4e,
-	0x1e, 0x17, 0x52, 0x7f, 0x3f, 0x2e, 0xa4, 0x94, 0xa3, 0x78, 0xaf, 0xc3, 0x4e, 0xd4, 0x60, 0x11,
-	0x4f, 0x80, 0x8d, 0xfe, 0xaf, 0x8d, 0x08, 0x64, 0x94, 0x0c, 0x10, 0x56, 0xa9, 0x66, 0xf6, 0xcc,
-	0x4e, 0x70, 0xa7, 0x4a, 0x0d, 0x0f, 0x15, 0xac, 0x62, 0xf9, 0x37, 0x61, 0xa1, 0xcb, 0x56, 0xb0,
-	0x7a, 0x4e, 0x15, 0x15, 0xe1, 0x49, 0xfa, 0xfc, 0xd3, 0xdf, 0x0b, 0x29, 0x03, 0x13, 0x94, 0x35,
-	0x90, 0x99, 0xe2, 0x3d, 0x6a, 0xf5, 0x1f, 0xd8, 0x17, 0x66, 0x48, 0xf9, 0x1c, 0x72, 0xc2, 0x5d,
-	0xac, 0xfb, 0x51, 0xc2, 0x01, 0xd8, 0x7c, 0x76, 0x5a, 0x56, 0x44, 0x48, 0x31, 0xdd, 0xc8, 0x18,
-	0x28, 0xb7, 0xa1, 0x70, 0xb9, 0xb0, 0x3e, 0x78, 0xdf, 0xec, 0x04, 0x33, 0x4a, 0x08, 0xcc, 0xbb,
-	0x66, 0xc7, 0xe6, 0xd7, 0x68, 0xb0, 0x67, 0xe5, 0x0b, 0x28, 0x4e, 0x4e, 0x43, 0xe8, 0x0f, 0x93,
-	0xdd, 0x55, 0x52, 0xe6, 0xf0, 0xc6, 0x6e, 0xc2, 0xaa, 0x6e, 0x37, 0x8f, 0xf6, 0x2a, 0xb5, 0x9e,
-	0xdd, 0x72, 0x1e, 0x05, 0x2d, 0x7c, 0x1b, 0x32, 0xf1, 0x65, 0xc4, 0xd8, 0x80, 0x1b, 0x0d, 0xb6,
-	0x5e, 0xef, 0xb2, 0x0d, 0x3c, 0x47, 0xba, 0x11, 0x09, 0x56, 0x74, 0xc8, 0xe1, 0x4c, 0xea, 0x03,
-	0xdf, 0xf6, 0xee, 0x53, 0x1c, 0x4d, 0x6c, 0xc1, 0x06, 0xdc, 0xc0, 0x19, 0xad, 0x37, 0x46, 0xfb,
-	0x4c, 0x23, 0x6d, 0xa4, 0xcd, 0x48, 0x8e, 0xf2, 0x0e, 0xac, 0x89, 0x35, 0x10, 0xe4, 0x55, 0x58,
-	0x09, 0x44, 0x3c, 0xb6, 0x83, 0x24, 0x81, 0x34, 0x0f, 0x57, 0xee, 0x86, 0x28, 0x7c, 0xe1, 0x3e,
-	0x65, 0x72, 0x01, 0x4a, 0x42, 0x95, 0x3b, 0x21, 0xcc, 0x05, 0x95, 0x71, 0x57, 0x66, 0x9f, 0xe8,
-	0x10, 0xf2, 0xd1, 0xb7, 0x30, 0x3c, 0xdd, 0xc1, 0xdd, 0xf1, 0x6c, 0xcc, 0x39, 0x16, 0xcb, 0xbd,
-	0xa6, 0xcf, 0x65, 0x25, 0x63, 0xce, 0xb1, 0xc8, 0x3a, 0x00, 0x5e, 0x55, 0xdd, 0xb1, 0xd8, 0x97,
-	0x65, 0xde, 0xb8, 0x8e, 0x2b, 0x07, 0x96, 0x62, 0xe1, 0xc4, 0x89, 0x44, 0x11, 0x6e, 0x1f, 0x5e,
-	0x0c, 0x14, 0x92, 0x7e, 0x43, 0x56, 0xcc, 0x98, 0x9c, 0x72, 0x0f, 0x5e, 0x8a, 0x56, 0x39, 0x70,
-	0x5b, 0xf4, 0x7f, 0x7c, 0x99, 0x94, 0x1a, 0x64, 0x2f, 0xcb, 0x21, 0x6d, 0x15, 0xe6, 0x1d, 0xb7,
-	0x45, 0x71, 0xc8, 0x8b, 0xc2, 0x4f, 0x82, 0x6e, 0x7a, 0xc1, 0x24, 0x1b, 0x2c, 0xba, 0xf2, 0x4f,
-	0x1a, 0x5e, 0x60, 0x92, 0xe4, 0x6b, 0x09, 0x96, 0x82, 0x37, 0x9e, 0x6c, 0x0b, 0xd3, 0x45, 0xbe,
-	0x23, 0xef, 0x24, 0x09, 0xe5, 0x8c, 0xca, 0xce, 0xc9, 0x5f, 0x4f, 0x76, 0xa4, 0x2f, 0x7f, 0xfd,
-	0xf3, 0xdb, 0xb9, 0x02, 0x59, 0xd7, 0x84, 0x0e, 0x19, 0x20, 0x7c, 0x27, 0xc1, 0x22, 0x0a, 0x90,
-	0xd2, 0xcc, 0x1a, 0x01, 0xcd, 0x76, 0x82, 0x48, 0x84, 0xa9, 0x8e, 0x61, 0xb6, 0xc9, 0xd6, 0x54,
-	0x18, 0xed, 0x18, 0x6f, 0x60, 0x48, 0x7e, 0x92, 0x80, 0x5c, 0x9e, 0x19, 0xb2, 0x37, 0xb3, 0xee,
-	0xe5, 0xb1, 0x95, 0xab, 0x57, 0x4b, 0xba, 0x02, 0x77, 0xf8, 0x4e, 0xd5, 0x1d, 0x4b, 0x3b, 0x76,
-	0xac, 0x21, 0xf9, 0x4a, 0x82, 0x05, 0xee, 0x08, 0x64, 0x6b, 0x72, 0xd9, 0x98, 0xfd, 0xc8, 0xa5,
-	0xd9, 0x81, 0xc8, 0x54, 0x1a, 0x33, 0xad, 0x93, 0x9c, 0x90, 0x89, 0x1b, 0x10, 0xf9, 0x41, 0x82,
-	0x95, 0xb8, 0xbd, 0x10, 0x6d, 0x72, 0x19, 0xa1, 0x4d, 0xc9, 0xaf, 0x27, 0x4f, 0x40, 0xbe, 0xdd,
-	0x31, 0xdf, 0x26, 0x79, 0x45, 0xc8, 0xd7, 0x61, 0x99, 0xf5, 0x70, 0xfe, 0x7e, 0x96, 0x60, 0x55,
-	0xe0, 0x2b, 0xa4, 0x9a, 0xb0, 0x78, 0xcc, 0xbd, 0xe4, 0xdb, 0x57, 0xcc, 0x42, 0xee, 0x37, 0xc6,
-	0xdc, 0x65, 0xf2, 0x5a, 0x12, 0x6e, 0xed, 0x78, 0xe4, 0x8c, 0x43, 0x72, 0x22, 0x41, 0x3a, 0x6a,
-	0x44, 0x13, 0xde, 0x21, 0x81, 0x85, 0x4d, 0x78, 0x87, 0x44, 0xae, 0xa6, 0x6c, 0x4c, 0xbd, 0x72,
-	0xee, 0x6d, 0xe4, 0x89, 0x04, 0x19, 0x91, 0x25, 0x11, 0xf1, 0x3d, 0x4e, 0x71, 0x40, 0x79, 0xf7,
-	0x0a, 0x19, 0x88, 0xb8, 0x37, 0xb5, 0x7b, 0x1c, 0x31, 0x7c, 0xbf, 0xb9, 0x0b, 0x0d, 0xc9, 0x8f,
-	0x63, 0xe4, 0x98, 0x71, 0x4d, 0x47, 0x16, 0x39, 0xe5, 0x74, 0x64, 0xa1, 0x2b, 0x2a, 0x55, 0x86,
-	0xac, 0x92, 0x5b, 0x89, 0x90, 0xb9, 0xff, 0x0e, 0xc9, 0xf7, 0x12, 0x2c, 0x47, 0x8c, 0x81, 0xdc,
-	0x9a, 0xf9, 0x75, 0x89, 0xd8, 0x91, 0x5c, 0x4e, 0x18, 0x9d, 0x7c, 0x30, 0x43, 0xf7, 0x75, 0x5b,
-	0x74, 0xfc, 0x01, 0xd5, 0xef, 0x3c, 0x3d, 0xcb, 0x4b, 0xcf, 0xcf, 0xf2, 0xd2, 0x1f, 0x67, 0x79,
-	0xe9, 0x9b, 0xf3, 0x7c, 0xea, 0xf9, 0x79, 0x3e, 0xf5, 0xdb, 0x79, 0x3e, 0xf5, 0xf1, 0x76, 0xdb,
-	0xf1, 0x8f, 0xfa, 0x0d, 0xb5, 0x49, 0x3b, 0x81, 0x20, 0xff, 0x53, 0xf6, 0xac, 0x4f, 0xb5, 0x47,
-	0x5c, 0xdd, 0x1f, 0x74, 0x6d, 0xaf, 0xb1, 0xc0, 0xfe, 0x77, 0xdb, 0xfb, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0xa2, 0x5f, 0xef, 0x16, 0x0e, 0x00, 0x00,
+	// 1209 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xc7, 0x7d, 0xdd, 0xfc, 0x9a, 0xe4, 0x24, 0x4d, 0xa5, 0x6b, 0x57, 0xbf, 0x74, 0x9c, 0xd8,
+	0xd6, 0x84, 0xe6, 0x45, 0x3c, 0xd3, 0x38, 0x09, 0xcf, 0x55, 0xa6, 0x05, 0x94, 0x45, 0x91, 0x99,
+	0x04, 0x84, 0x58, 0x60, 0x8d, 0x33, 0x13, 0x67, 0x44, 0x3c, 0xe3, 0x7a, 0xc6, 0x50, 0x13, 0x45,
+	0x48, 0x48, 0x48, 0xd9, 0x20, 0x21, 0xc1, 0x1f, 0x50, 0x24, 0x04, 0xdb, 0x22, 0x85, 0x0d, 0x20,
+	0xb1, 0xad, 0xba, 0xaa, 0x60, 0x01, 0x2b, 0x84, 0x12, 0x24, 0xf8, 0x33, 0x90, 0xef, 0x3d, 0xf3,
+	0x4a, 0xc6, 0xf6, 0xb8, 0xb0, 0xe9, 0xca, 0x9e, 0x7b, 0xcf, 0xe3, 0x73, 0xcf, 0x3d, 0x73, 0xe6,
+	0x0b, 0x85, 0x5d, 0xdb, 0x69, 0xd8, 0x8e, 0xac, 0xb5, 0xdd, 0x7d, 0xf9, 0xfd, 0xd5, 0x9a, 0xe1,
+	0x6a, 0xab, 0xf2, 0xdd, 0xb6, 0xd1, 0xea, 0x48, 0xcd, 0x96, 0xed, 0xda, 0x34, 0xc3, 0x0d, 0xa4,
+	0xae, 0x81, 0x84, 0x06, 0xc2, 0x32, 0x7a, 0xd5, 0x34, 0xc7, 0xe0, 0xd6, 0xbe, 0x6f, 0x53, 0xab,
+	0x9b, 0x96, 0xe6, 0x9a, 0xb6, 0xc5, 0x03, 0x08, 0xd9, 0xba, 0x5d, 0xb7, 0xd9, 0x5f, 0xb9, 0xfb,
+	0x0f, 0x57, 0xaf, 0xd7, 0x6d, 0xbb, 0x7e, 0x60, 0xc8, 0xec, 0xa9, 0xd6, 0xde, 0x93, 0x35, 0x0b,
+	0x33, 0x0a, 0x33, 0xb8, 0xa5, 0x35, 0x4d, 0x59, 0xb3, 0x2c, 0xdb, 0x65, 0xd1, 0x1c, 0xdc, 0xcd,
+	0xc7, 0x01, 0x33, 0x38, 0x0c, 0xcc, 0xf7, 0xab, 0x3c, 0x23, 0xc2, 0xf3, 0xad, 0x1c, 0xba, 0x7a,
+	0xc0, 0xe1, 0x73, 0x8a, 0xef, 0x42, 0xf6, 0x8d, 0xee, 0xe3, 0xe6, 0xee, 0xae, 0xdd, 0xb6, 0x5c,
+	0x47, 0x35, 0xee, 0xb6, 0x0d, 0xc7, 0xa5, 0xaf, 0x02, 0x04, 0x47, 0x9a, 0x26, 0x45, 0xb2, 0x38,
+	0x51, 0x9e, 0x97, 0x30, 0x6e, 0xf7, 0xfc, 0x12, 0x8f, 0x82, 0x28, 0x52, 0x45, 0xab, 0x1b, 0xe8,
+	0xab, 0x86, 0x3c, 0xc5, 0x13, 0x02, 0xd7, 0xce, 0x25, 0x70, 0x9a, 0xb6, 0xe5, 0x18, 0x54, 0x85,
+	0x31, 0x0d, 0xd7, 0xa6, 0x49, 0xf1, 0xd2, 0xe2, 0x44, 0x39, 0x2b, 0xf1, 0x12, 0x48, 0x5e, 0x75,
+	0xa4, 0x4d, 0xab, 0xa3, 0x14, 0x1f, 0x9d, 0x94, 0x66, 0x62, 0x6e, 0x43, 0xc2, 0x88, 0x5b, 0xaa,
+	0x1f, 0x87, 0xbe, 0x16, 0xa1, 0x4e, 0x33, 0xea, 0x85, 0x81, 0xd4, 0x1c, 0x28, 0x82, 0xbd, 0x0d,
+	0x99, 0x30, 0xb5, 0x57, 0x95, 0x32, 0x8c, 0x6a, 0xba, 0xde, 0x32, 0x1c, 0x87, 0x95, 0x64, 0x5c,
+	0x99, 0xfe, 0xf9, 0xa4, 0x94, 0xc5, 0xf8, 0x9b, 0x7c, 0x67, 0xdb, 0x6d, 0x99, 0x56, 0x5d, 0xf5,
+	0x0c, 0x5f, 0x1a, 0x3b, 0xbe, 0x5f, 0x48, 0xfd, 0x7d, 0xbf, 0x90, 0x12, 0xf7, 0xa3, 0xb5, 0xf6,
+	0x2b, 0x51, 0x81, 0x51, 0x3c, 0x01, 0x16, 0xfa, 0x49, 0x0b, 0xe1, 0x85, 0x11, 0xb3, 0x40, 0x59,
+	0xa6, 0x8a, 0xd6, 0xd2, 0x1a, 0xde, 0x9d, 0x8a, 0x15, 0xc8, 0x44, 0x56, 0x31, 0xfd, 0x8b, 0x70,
+	0xb9, 0xc9, 0x56, 0x30, 0x7b, 0x4e, 0x8a, 0x4b, 0xc2, 0x9d, 0x94, 0x91, 0x87, 0xbf, 0x17, 0x52,
+	0x2a, 0x3a, 0x88, 0x33, 0x20, 0xb0, 0x88, 0x77, 0x6c, 0xbd, 0x7d, 0x60, 0x9c, 0xeb, 0x21, 0xf1,
+	0x03, 0xc8, 0xc5, 0xee, 0x62, 0xde, 0xb7, 0x13, 0x36, 0xc0, 0xfc, 0xa3, 0x93, 0x92, 0x18, 0x87,
+	0x14, 0x89, 0x1b, 0x6a, 0x03, 0x71, 0x03, 0x0a, 0x17, 0x13, 0x2b, 0x9d, 0xd7, 0xb5, 0x86, 0xd7,
+	0xa3, 0x94, 0xc2, 0x88, 0xa5, 0x35, 0x0c, 0x7e, 0x8d, 0x2a, 0xfb, 0x2f, 0x7e, 0x08, 0xc5, 0xde,
+	0x6e, 0x08, 0xfd, 0x56, 0xb2, 0xbb, 0x4a, 0xca, 0xec, 0xdf, 0xd8, 0x35, 0xc8, 0x28, 0xc6, 0xee,
+	0xfe, 0x5a, 0xb9, 0xd2, 0x32, 0xf6, 0xcc, 0x7b, 0x5e, 0x09, 0x5f, 0x86, 0x6c, 0x74, 0x19, 0x31,
+	0xe6, 0xe0, 0x4a, 0x8d, 0xad, 0x57, 0x9b, 0x6c, 0x03, 0xcf, 0x31, 0x59, 0x0b, 0x19, 0x8b, 0x0a,
+	0xe4, 0xb0, 0x27, 0x95, 0x8e, 0x6b, 0x38, 0x3b, 0x36, 0xb6, 0x26, 0x96, 0x60, 0x0e, 0xae, 0x60,
+	0x8f, 0x56, 0x6b, 0xdd, 0x7d, 0x16, 0x63, 0x52, 0x9d, 0xd4, 0x42, 0x3e, 0xe2, 0x2b, 0x30, 0x13,
+	0x1f, 0x03, 0x41, 0x6e, 0xc0, 0x94, 0x17, 0xc4, 0x61, 0x3b, 0x48, 0xe2, 0x85, 0xe6, 0xe6, 0xe2,
+	0x6d, 0x1f, 0x85, 0x2f, 0xec, 0xd8, 0x2c, 0x9c, 0x87, 0x92, 0x30, 0xca, 0x2d, 0x1f, 0xe6, 0x5c,
+	0x94, 0xa0, 0x2a, 0x83, 0x4f, 0xb4, 0x0d, 0xf9, 0xf0, 0x5b, 0xe8, 0x9f, 0x6e, 0xeb, 0x76, 0xd0,
+	0x1b, 0x69, 0x53, 0x67, 0xbe, 0x97, 0x94, 0xf4, 0x34, 0x51, 0xd3, 0xa6, 0x4e, 0x67, 0x01, 0xf0,
+	0xaa, 0xaa, 0xa6, 0xce, 0x26, 0xcb, 0x88, 0x3a, 0x8e, 0x2b, 0x5b, 0xba, 0xa8, 0x43, 0xa1, 0x67,
+	0x50, 0x84, 0xdb, 0x84, 0xab, 0x5e, 0x84, 0xa4, 0x33, 0x64, 0x4a, 0x8b, 0x84, 0x13, 0xef, 0xc0,
+	0xff, 0xc3, 0x59, 0xb6, 0xac, 0x3d, 0xfb, 0x5f, 0x4c, 0x26, 0xb1, 0x02, 0xd3, 0x17, 0xc3, 0x21,
+	0xed, 0x3a, 0x8c, 0x98, 0xd6, 0x9e, 0x8d, 0x4d, 0x5e, 0x8c, 0x1d, 0x09, 0x8a, 0xe6, 0x78, 0x9d,
+	0xac, 0x32, 0x6b, 0xf1, 0x23, 0x9c, 0x07, 0xb8, 0xea, 0x28, 0x9d, 0x9d, 0x4e, 0xd3, 0x7f, 0xe7,
+	0xae, 0xc3, 0x98, 0xdb, 0x69, 0x1a, 0xd5, 0x76, 0xeb, 0x00, 0xef, 0x77, 0xb4, 0xfb, 0xfc, 0x66,
+	0xeb, 0xe0, 0xdc, 0xe7, 0x26, 0xfd, 0xc4, 0x9f, 0x9b, 0xef, 0x09, 0xe4, 0x62, 0x09, 0x9e, 0x86,
+	0x8f, 0xce, 0x97, 0x24, 0xda, 0x45, 0x8e, 0xd2, 0xc1, 0x9b, 0x0b, 0xde, 0x94, 0xe7, 0x60, 0x5c,
+	0xf3, 0xd6, 0xd8, 0x09, 0xfa, 0xdd, 0x74, 0x60, 0xfa, 0x9f, 0x15, 0xf8, 0x27, 0x02, 0xc5, 0xde,
+	0x8c, 0x4f, 0x41, 0x95, 0xcb, 0xbf, 0x5e, 0x85, 0xff, 0xb1, 0x13, 0xd0, 0x4f, 0x09, 0x8c, 0x79,
+	0xc7, 0xa0, 0x4b, 0xb1, 0x2d, 0x1e, 0xa7, 0x8d, 0x84, 0xe5, 0x24, 0xa6, 0x3c, 0xb3, 0xb8, 0x7c,
+	0xfc, 0xd7, 0x83, 0x65, 0xf2, 0xf1, 0x2f, 0x7f, 0x7e, 0x9e, 0x2e, 0xd0, 0x59, 0x39, 0x56, 0xc5,
+	0x79, 0x08, 0x5f, 0x10, 0x18, 0xc5, 0x00, 0x74, 0x71, 0x60, 0x0e, 0x8f, 0x66, 0x29, 0x81, 0x25,
+	0xc2, 0xac, 0x07, 0x30, 0x4b, 0x74, 0xa1, 0x2f, 0x8c, 0x7c, 0x88, 0xbd, 0x73, 0x44, 0xbf, 0x23,
+	0x40, 0x2f, 0xce, 0x35, 0xba, 0x36, 0x30, 0xef, 0xc5, 0xd1, 0x2a, 0xac, 0x0f, 0xe7, 0x34, 0x04,
+	0xb7, 0x3f, 0xf7, 0xab, 0xa6, 0x2e, 0x1f, 0x9a, 0xfa, 0x11, 0xfd, 0x84, 0xc0, 0x65, 0xae, 0x5a,
+	0xe8, 0x42, 0xef, 0xb4, 0x11, 0x89, 0x24, 0x2c, 0x0e, 0x36, 0x44, 0xa6, 0xc5, 0x80, 0x69, 0x96,
+	0xe6, 0x62, 0x99, 0xb8, 0x48, 0xa2, 0x5f, 0x13, 0x98, 0x8a, 0x4a, 0x20, 0x2a, 0xf7, 0x4e, 0x13,
+	0x2b, 0xa5, 0x84, 0x9b, 0xc9, 0x1d, 0x90, 0x6f, 0x35, 0xe0, 0x9b, 0xa7, 0xcf, 0xc4, 0xf2, 0x35,
+	0x98, 0x67, 0xd5, 0xef, 0xbf, 0x1f, 0x09, 0x64, 0x62, 0xb4, 0x0f, 0x5d, 0x4f, 0x98, 0x3c, 0xa2,
+	0xb0, 0x84, 0x8d, 0x21, 0xbd, 0x90, 0xfb, 0x85, 0x80, 0xbb, 0x44, 0x9f, 0x4d, 0xc2, 0x2d, 0x1f,
+	0x76, 0xd5, 0xdb, 0x11, 0x3d, 0x26, 0x30, 0x19, 0x16, 0x4b, 0x3d, 0xde, 0xa1, 0x18, 0x99, 0x25,
+	0x2c, 0x25, 0xb0, 0x44, 0xbe, 0xb9, 0xbe, 0x57, 0xce, 0xf5, 0x17, 0x7d, 0x40, 0x20, 0x1b, 0x27,
+	0x9b, 0x68, 0xfc, 0x3d, 0xf6, 0x51, 0x69, 0xc2, 0xea, 0x10, 0x1e, 0x88, 0xb8, 0xd6, 0xb7, 0x7a,
+	0x1c, 0x51, 0x3e, 0x8c, 0x28, 0xa5, 0x23, 0xfa, 0x6d, 0x80, 0x1c, 0x11, 0x57, 0xfd, 0x91, 0xe3,
+	0xd4, 0x9c, 0xb0, 0x3a, 0x84, 0x87, 0xf7, 0x86, 0x33, 0x64, 0x89, 0xae, 0x24, 0x42, 0xe6, 0x1a,
+	0xf1, 0x88, 0x7e, 0x45, 0x60, 0x22, 0x24, 0x5e, 0xe8, 0xca, 0xc0, 0xe9, 0x12, 0x92, 0x4c, 0x42,
+	0x29, 0xa1, 0x75, 0xf2, 0xc6, 0xf4, 0x15, 0xa2, 0xb5, 0x67, 0x87, 0x06, 0xe8, 0x37, 0x04, 0xa6,
+	0xa2, 0x7a, 0xa4, 0xdf, 0x00, 0x88, 0xd5, 0x4e, 0xc2, 0xcd, 0xe4, 0x0e, 0xc8, 0x5b, 0x0e, 0x78,
+	0x17, 0xe8, 0x8d, 0xbe, 0xc3, 0xbe, 0x3b, 0x35, 0xbb, 0x4a, 0x8c, 0xfe, 0x40, 0x20, 0x13, 0xf3,
+	0x61, 0xa7, 0xeb, 0x49, 0xb2, 0x9f, 0xd7, 0x2a, 0xc2, 0xc6, 0x90, 0x5e, 0x08, 0xfe, 0x7c, 0x00,
+	0xbe, 0x42, 0x97, 0x07, 0x82, 0xfb, 0x1a, 0x47, 0xb9, 0xf5, 0xf0, 0x34, 0x4f, 0x1e, 0x9f, 0xe6,
+	0xc9, 0x1f, 0xa7, 0x79, 0xf2, 0xd9, 0x59, 0x3e, 0xf5, 0xf8, 0x2c, 0x9f, 0xfa, 0xed, 0x2c, 0x9f,
+	0x7a, 0x67, 0xa9, 0x6e, 0xba, 0xfb, 0xed, 0x9a, 0xb4, 0x6b, 0x37, 0xbc, 0x78, 0xfc, 0xa7, 0xe4,
+	0xe8, 0xef, 0xc9, 0xf7, 0x78, 0xf0, 0x6e, 0x05, 0x9c, 0xda, 0x65, 0xa6, 0x50, 0xd6, 0xfe, 0x19,
+	0x00, 0x3e, 0xcf, 0x69, 0xfa, 0x22, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1107,6 +1346,14 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountsByType returns all the existing accounts of a given type.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AccountsByType(ctx context.Context, in *QueryAccountsByTypeRequest, opts ...grpc.CallOption) (*QueryAccountsByTypeResponse, error)
+	// AccountsByAddresses returns the existing accounts among the given addresses.
+	// The number of addresses is capped by the max_account_query_batch parameter.
+	AccountsByAddresses(ctx context.Context, in *QueryAccountsByAddressesRequest, opts ...grpc.CallOption) (*QueryAccountsByAddressesResponse, error)
 }
 
 type queryClient struct {
@@ -1207,6 +1454,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountsByType(ctx context.Context, in *QueryAccountsByTypeRequest, opts ...grpc.CallOption) (*QueryAccountsByTypeResponse, error) {
+	out := new(QueryAccountsByTypeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountsByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByAddresses(ctx context.Context, in *QueryAccountsByAddressesRequest, opts ...grpc.CallOption) (*QueryAccountsByAddressesResponse, error) {
+	out := new(QueryAccountsByAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountsByAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1246,6 +1511,14 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountsByType returns all the existing accounts of a given type.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AccountsByType(context.Context, *QueryAccountsByTypeRequest) (*QueryAccountsByTypeResponse, error)
+	// AccountsByAddresses returns the existing accounts among the given addresses.
+	// The number of addresses is capped by the max_account_query_batch parameter.
+	AccountsByAddresses(context.Context, *QueryAccountsByAddressesRequest) (*QueryAccountsByAddressesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1282,6 +1555,12 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) AccountsByType(ctx context.Context, req *QueryAccountsByTypeRequest) (*QueryAccountsByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByType not implemented")
+}
+func (*UnimplementedQueryServer) AccountsByAddresses(ctx context.Context, req *QueryAccountsByAddressesRequest) (*QueryAccountsByAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByAddresses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1467,6 +1746,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountsByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByType(ctx, req.(*QueryAccountsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountsByAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByAddresses(ctx, req.(*QueryAccountsByAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1511,6 +1826,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountsByType",
+			Handler:    _Query_AccountsByType_Handler,
+		},
+		{
+			MethodName: "AccountsByAddresses",
+			Handler:    _Query_AccountsByAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2147,58 +2470,242 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAccountsByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAccountsByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAccountsByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountsByTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -2414,6 +2921,80 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountsByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4031,6 +4612,482 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountsByTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsByTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &types.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsByAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsByAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &types.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
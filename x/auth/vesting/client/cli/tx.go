@@ -17,7 +17,8 @@ import (
 
 // Transaction command flags
 const (
-	FlagDelayed = "delayed"
+	FlagDelayed   = "delayed"
+	FlagCliffTime = "cliff-time"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -35,6 +36,9 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
 		NewMsgDonateAllVestingTokensCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
+		NewMsgCreateMilestoneVestingAccountCmd(),
 	)
 
 	return txCmd
@@ -50,7 +54,8 @@ func NewMsgCreateVestingAccountCmd() *cobra.Command {
 account can either be a delayed or continuous vesting account, which is determined
 by the '--delayed' flag. All vesting accounts created will have their start time
 set by the committed block's time. The end_time must be provided as a UNIX epoch
-timestamp.`,
+timestamp. A continuous vesting account vesting no tokens before a cliff is
+created if a UNIX epoch timestamp is provided with the '--cliff-time' flag.`,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -73,14 +78,17 @@ timestamp.`,
 			}
 
 			delayed, _ := cmd.Flags().GetBool(FlagDelayed)
+			cliffTime, _ := cmd.Flags().GetInt64(FlagCliffTime)
 
 			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, endTime, delayed)
+			msg.CliffTime = cliffTime
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	cmd.Flags().Int64(FlagCliffTime, 0, "Create a continuous vesting account vesting no tokens before this UNIX epoch timestamp")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -132,6 +140,11 @@ type InputPeriod struct {
 	Length int64  `json:"length_seconds"`
 }
 
+type InputMilestone struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Coins      string `json:"coins"`
+}
+
 // NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for creating a
 // MsgCreatePeriodicVestingAccountCmd transaction.
 func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
@@ -237,3 +250,142 @@ func NewMsgDonateAllVestingTokensCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new clawback vesting account funded with an allocation of tokens.",
+		Long: `Create a new continuous vesting account funded with an allocation of tokens,
+whose vesting tokens can be clawed back by the sender. The account will have its
+start time set by the committed block's time. The end_time must be provided as a
+UNIX epoch timestamp.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, endTime)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [vesting_address]",
+		Short: "Claw back the vesting tokens of a clawback vesting account.",
+		Long: `Claw back the vesting tokens of a clawback vesting account funded by the sender.
+The account must not have any delegated vesting tokens. After the clawback, the
+account will be changed to a normal "BaseAccount" holding its vested tokens.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			vestingAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), vestingAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateMilestoneVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateMilestoneVestingAccount transaction.
+func NewMsgCreateMilestoneVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-milestone-vesting-account [to_address] [milestones_json_file]",
+		Short: "Create a new vesting account funded with an allocation of tokens vesting when governance proposals pass.",
+		Long: `A sequence of coins and governance proposal IDs. The coins of a milestone vest when its governance proposal passes, and never vest if it does not pass. For instance, the following milestones.json file shows 10 "test" coins vesting when proposal 4 passes, and 20 more when proposal 7 passes.
+		Where milestones.json contains:
+
+[
+	{
+		"proposal_id": 4,
+		"coins": "10test"
+	},
+	{
+		"proposal_id": 7,
+		"coins": "20test"
+	}
+]
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var inputMilestones []InputMilestone
+			if err := json.Unmarshal(contents, &inputMilestones); err != nil {
+				return err
+			}
+
+			milestones := make([]types.VestingMilestone, 0, len(inputMilestones))
+			for _, m := range inputMilestones {
+				amount, err := sdk.ParseCoinsNormalized(m.Coins)
+				if err != nil {
+					return err
+				}
+
+				milestones = append(milestones, types.VestingMilestone{GovernanceProposalId: m.ProposalID, VestingAmount: amount})
+			}
+
+			msg := types.NewMsgCreateMilestoneVestingAccount(clientCtx.GetFromAddress(), toAddr, milestones)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,14 +7,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
-// NewHandler returns a handler for x/auth message types.
+// NewHandler returns a handler for x/auth message types. Milestone vesting
+// accounts are not routed through it, so it needs neither the vesting store nor
+// the governance keeper.
 func NewHandler(
 	ak keeper.AccountKeeper,
 	bk types.BankKeeper,
 	dk types.DistrKeeper,
 	sk types.StakingKeeper,
 ) sdk.Handler {
-	msgServer := NewMsgServerImpl(ak, bk, dk, sk)
+	msgServer := NewMsgServerImpl(nil, ak, bk, dk, sk, nil)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -0,0 +1,57 @@
+package vesting
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks vests the milestones of milestone vesting accounts when their
+// governance proposal passes.
+type GovHooks struct {
+	storeKey storetypes.StoreKey
+	ak       keeper.AccountKeeper
+	gk       types.GovKeeper
+}
+
+// NewGovHooks returns the governance hooks of the vesting module.
+func NewGovHooks(key storetypes.StoreKey, ak keeper.AccountKeeper, gk types.GovKeeper) GovHooks {
+	return GovHooks{storeKey: key, ak: ak, gk: gk}
+}
+
+// AfterProposalVotingPeriodEnded vests the milestones of the proposal if it
+// passed. Only the accounts indexed under the proposal are visited, and the
+// index is cleared whatever the outcome of the proposal.
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	addrs := getMilestoneAccounts(ctx, h.storeKey, proposalID)
+	deleteMilestoneAccounts(ctx, h.storeKey, proposalID)
+
+	proposal, found := h.gk.GetProposal(ctx, proposalID)
+	if !found || proposal.Status != govv1.StatusPassed {
+		return
+	}
+
+	for _, addr := range addrs {
+		mva, ok := h.ak.GetAccount(ctx, addr).(*types.MilestoneVestingAccount)
+		if ok && mva.PassMilestones(proposalID) {
+			h.ak.SetAccount(ctx, mva)
+		}
+	}
+}
+
+func (h GovHooks) AfterProposalSubmission(_ sdk.Context, _ uint64) {}
+
+func (h GovHooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+
+func (h GovHooks) AfterProposalVote(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+
+// AfterProposalFailedMinDeposit clears the milestone vesting accounts indexed
+// under the proposal, as it was deleted without ever being voted on.
+func (h GovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+	deleteMilestoneAccounts(ctx, h.storeKey, proposalID)
+}
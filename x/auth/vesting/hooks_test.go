@@ -0,0 +1,56 @@
+package vesting_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func (s *VestingTestSuite) TestAfterProposalVotingPeriodEnded() {
+	hooks := vesting.NewGovHooks(s.vestingKey, s.accountKeeper, s.govKeeper)
+
+	milestones := []vestingtypes.VestingMilestone{
+		{GovernanceProposalId: 1, VestingAmount: sdk.Coins{periodCoin}},
+		{GovernanceProposalId: 2, VestingAmount: sdk.Coins{periodCoin}},
+		{GovernanceProposalId: 3, VestingAmount: sdk.Coins{periodCoin}},
+	}
+	totalCoins := sdk.NewCoins(sdk.NewInt64Coin("foo", 60))
+
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, totalCoins).Return(nil)
+	for _, milestone := range milestones {
+		s.govKeeper.EXPECT().GetProposal(gomock.Any(), milestone.GovernanceProposalId).Return(govv1.Proposal{Id: milestone.GovernanceProposalId, Status: govv1.StatusVotingPeriod}, true)
+	}
+	_, err := s.msgServer.CreateMilestoneVestingAccount(s.ctx, vestingtypes.NewMsgCreateMilestoneVestingAccount(fromAddr, to1Addr, milestones))
+	s.Require().NoError(err)
+
+	vestedCoins := func() sdk.Coins {
+		acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.MilestoneVestingAccount)
+		return acc.GetVestedCoins(s.ctx.BlockTime())
+	}
+
+	// a rejected proposal does not vest its milestones
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(1)).Return(govv1.Proposal{Id: 1, Status: govv1.StatusRejected}, true)
+	hooks.AfterProposalVotingPeriodEnded(s.ctx, 1)
+	s.Require().True(vestedCoins().IsZero())
+
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(2)).Return(govv1.Proposal{Id: 2, Status: govv1.StatusPassed}, true)
+	hooks.AfterProposalVotingPeriodEnded(s.ctx, 2)
+	s.Require().Equal(sdk.Coins{periodCoin}, vestedCoins())
+
+	// the index of a proposal which failed its min deposit is cleared, so its
+	// milestones cannot vest anymore
+	hooks.AfterProposalFailedMinDeposit(s.ctx, 3)
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(3)).Return(govv1.Proposal{Id: 3, Status: govv1.StatusPassed}, true)
+	hooks.AfterProposalVotingPeriodEnded(s.ctx, 3)
+	s.Require().Equal(sdk.Coins{periodCoin}, vestedCoins())
+
+	// unknown proposals are ignored
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(4)).Return(govv1.Proposal{}, false)
+	hooks.AfterProposalVotingPeriodEnded(s.ctx, 4)
+	s.Require().Equal(sdk.Coins{periodCoin}, vestedCoins())
+}
@@ -0,0 +1,25 @@
+package vesting
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	storeKey      storetypes.StoreKey
+	accountKeeper keeper.AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(key storetypes.StoreKey, ak keeper.AccountKeeper) Migrator {
+	return Migrator{storeKey: key, accountKeeper: ak}
+}
+
+// Migrate1to2 migrates from version 1 to 2, indexing the existing milestone
+// vesting accounts by the governance proposals of their milestones.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	indexMilestoneAccounts(ctx, m.storeKey, m.accountKeeper)
+	return nil
+}
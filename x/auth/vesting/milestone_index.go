@@ -0,0 +1,54 @@
+package vesting
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// setMilestoneAccount indexes a milestone vesting account under the
+// governance proposals of its milestones which have not passed yet.
+func setMilestoneAccount(ctx sdk.Context, storeKey storetypes.StoreKey, acc *types.MilestoneVestingAccount) {
+	store := ctx.KVStore(storeKey)
+	for _, milestone := range acc.Milestones {
+		if !milestone.Passed {
+			store.Set(types.MilestoneAccountKey(milestone.GovernanceProposalId, acc.GetAddress()), []byte{})
+		}
+	}
+}
+
+// getMilestoneAccounts returns the addresses of the milestone vesting accounts
+// indexed under a governance proposal.
+func getMilestoneAccounts(ctx sdk.Context, storeKey storetypes.StoreKey, proposalID uint64) (addrs []sdk.AccAddress) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(storeKey), types.MilestoneAccountsKey(proposalID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, types.AddressFromMilestoneAccountKey(iterator.Key()))
+	}
+
+	return addrs
+}
+
+// deleteMilestoneAccounts removes the milestone vesting accounts indexed under
+// a governance proposal.
+func deleteMilestoneAccounts(ctx sdk.Context, storeKey storetypes.StoreKey, proposalID uint64) {
+	store := ctx.KVStore(storeKey)
+	for _, addr := range getMilestoneAccounts(ctx, storeKey, proposalID) {
+		store.Delete(types.MilestoneAccountKey(proposalID, addr))
+	}
+}
+
+// indexMilestoneAccounts indexes every milestone vesting account of the
+// account store. It iterates over all accounts and must therefore only be used
+// at genesis and in store migrations.
+func indexMilestoneAccounts(ctx sdk.Context, storeKey storetypes.StoreKey, ak keeper.AccountKeeper) {
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if mva, ok := acc.(*types.MilestoneVestingAccount); ok {
+			setMilestoneAccount(ctx, storeKey, mva)
+		}
+		return false
+	})
+}
@@ -1,7 +1,9 @@
 package vesting
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
@@ -22,6 +25,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -30,8 +34,8 @@ var (
 )
 
 // AppModuleBasic defines the basic application module used by the sub-vesting
-// module. The module itself contain no special logic or state other than message
-// handling.
+// module. The module itself contain no special logic other than message
+// handling, and its only state is the index of milestone vesting accounts.
 type AppModuleBasic struct{}
 
 // Name returns the module's name.
@@ -60,18 +64,21 @@ func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConf
 	return nil
 }
 
-// RegisterGRPCGatewayRoutes registers the module's gRPC Gateway routes. Currently, this
-// is a no-op.
-func (a AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the module's gRPC Gateway routes.
+func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the root tx command for the auth module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
 }
 
-// GetQueryCmd returns the module's root query command. Currently, this is a no-op.
+// GetQueryCmd returns the module's root query command.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return cli.GetQueryCmd()
 }
 
 // AppModule extends the AppModuleBasic implementation by implementing the
@@ -79,24 +86,30 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
+	storeKey      store.StoreKey
 	accountKeeper keeper.AccountKeeper
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
+	govKeeper     types.GovKeeper
 }
 
 func NewAppModule(
+	key store.StoreKey,
 	ak keeper.AccountKeeper,
 	bk types.BankKeeper,
 	dk types.DistrKeeper,
 	sk types.StakingKeeper,
+	gk types.GovKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
+		storeKey:       key,
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		distrKeeper:    dk,
 		stakingKeeper:  sk,
+		govKeeper:      gk,
 	}
 }
 
@@ -116,26 +129,37 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(
 		cfg.MsgServer(),
 		NewMsgServerImpl(
+			am.storeKey,
 			am.accountKeeper,
 			am.bankKeeper,
 			am.distrKeeper,
 			am.stakingKeeper,
+			am.govKeeper,
 		),
 	)
+	types.RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.accountKeeper))
+
+	m := NewMigrator(am.storeKey, am.accountKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
-// InitGenesis performs a no-op.
-func (am AppModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+// InitGenesis indexes the milestone vesting accounts of the auth genesis by
+// the governance proposals of their milestones.
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	indexMilestoneAccounts(ctx, am.storeKey, am.accountKeeper)
 	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis is always empty, as InitGenesis does nothing either.
+// ExportGenesis is always empty, as the milestone vesting accounts are
+// re-indexed from the auth genesis by InitGenesis.
 func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	return am.DefaultGenesis(cdc)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 //
 // App Wiring Setup
@@ -150,20 +174,23 @@ func init() {
 type VestingInputs struct {
 	depinject.In
 
+	Key           *store.KVStoreKey
 	AccountKeeper keeper.AccountKeeper
 	BankKeeper    types.BankKeeper
 	// DistrKeeper   types.DistrKeeper
 	StakingKeeper types.StakingKeeper
+	GovKeeper     types.GovKeeper `optional:"true"`
 }
 
 type VestingOutputs struct {
 	depinject.Out
 
-	Module appmodule.AppModule
+	Module   appmodule.AppModule
+	GovHooks govtypes.GovHooksWrapper
 }
 
 func ProvideModule(in VestingInputs) VestingOutputs {
-	m := NewAppModule(in.AccountKeeper, in.BankKeeper, nil, in.StakingKeeper)
+	m := NewAppModule(in.Key, in.AccountKeeper, in.BankKeeper, nil, in.StakingKeeper, in.GovKeeper)
 
-	return VestingOutputs{Module: m}
+	return VestingOutputs{Module: m, GovHooks: govtypes.GovHooksWrapper{GovHooks: NewGovHooks(in.Key, in.AccountKeeper, in.GovKeeper)}}
 }
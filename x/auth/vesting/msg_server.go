@@ -7,6 +7,7 @@ import (
 
 	"github.com/armon/go-metrics"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,6 +15,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 type msgServer struct {
@@ -21,17 +23,24 @@ type msgServer struct {
 	types.BankKeeper
 	types.DistrKeeper
 	types.StakingKeeper
+
+	storeKey storetypes.StoreKey
+	gk       types.GovKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
+// wrapping the corresponding AccountKeeper and BankKeeper. The store key and
+// the governance keeper are used to index milestone vesting accounts by the
+// proposals of their milestones.
 func NewMsgServerImpl(
+	key storetypes.StoreKey,
 	k keeper.AccountKeeper,
 	bk types.BankKeeper,
 	dk types.DistrKeeper,
 	sk types.StakingKeeper,
+	gk types.GovKeeper,
 ) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk, DistrKeeper: dk, StakingKeeper: sk}
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, DistrKeeper: dk, StakingKeeper: sk, storeKey: key, gk: gk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -62,14 +71,21 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
+	if msg.CliffTime != 0 && msg.CliffTime < ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cliff time %d is before the block time", msg.CliffTime)
+	}
+
 	baseAccount := authtypes.NewBaseAccountWithAddress(to)
 	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
 	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
 
 	var vestingAccount authtypes.AccountI
-	if msg.Delayed {
+	switch {
+	case msg.Delayed:
 		vestingAccount = types.NewDelayedVestingAccountRaw(baseVestingAccount)
-	} else {
+	case msg.CliffTime != 0:
+		vestingAccount = types.NewCliffContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix(), msg.CliffTime)
+	default:
 		vestingAccount = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
 	}
 
@@ -282,3 +298,205 @@ func (s msgServer) DonateAllVestingTokens(goCtx context.Context, msg *types.MsgD
 
 	return &types.MsgDonateAllVestingTokensResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	if msg.EndTime <= ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "end time %d must be after the block time", msg.EndTime)
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+	vestingAccount := types.NewClawbackVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix(), from)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback returns the locked coins of a clawback vesting account, i.e. its
+// vesting coins which are not delegated, to its funder. The delegated vesting
+// coins cannot be clawed back: they keep vesting until the end time, starting
+// from the block time so that the vested coins remain spendable, and can be
+// clawed back once undelegated. Accounts without delegated vesting coins are
+// converted to base accounts holding their vested coins.
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	vestingAddr, err := sdk.AccAddressFromBech32(msg.VestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := ak.GetAccount(ctx, vestingAddr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s does not exist", msg.VestingAddress)
+	}
+
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.VestingAddress)
+	}
+
+	if !vestingAcc.GetFunder().Equals(funder) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of %s", msg.FunderAddress, msg.VestingAddress)
+	}
+
+	if bk.BlockedAddr(funder) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.FunderAddress)
+	}
+
+	blockTime := ctx.BlockTime()
+	clawback := vestingAcc.LockedCoins(blockTime)
+	delegatedVesting := vestingAcc.GetVestingCoins(blockTime).Min(vestingAcc.DelegatedVesting)
+
+	if delegatedVesting.IsZero() {
+		ak.SetAccount(ctx,
+			authtypes.NewBaseAccount(
+				acc.GetAddress(),
+				acc.GetPubKey(),
+				acc.GetAccountNumber(),
+				acc.GetSequence(),
+			),
+		)
+	} else {
+		// the delegated coins which have vested since they were delegated are
+		// now delegated free coins
+		vestingAcc.DelegatedFree = vestingAcc.DelegatedFree.Add(vestingAcc.DelegatedVesting.Sub(delegatedVesting...)...)
+		vestingAcc.DelegatedVesting = delegatedVesting
+		vestingAcc.OriginalVesting = delegatedVesting
+		vestingAcc.StartTime = blockTime.Unix()
+		ak.SetAccount(ctx, vestingAcc)
+	}
+
+	if !clawback.IsZero() {
+		if err := bk.SendCoins(ctx, vestingAddr, funder, clawback); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgClawbackResponse{Amount: clawback}, nil
+}
+
+// CreateMilestoneVestingAccount creates a new milestone vesting account, whose
+// coins vest when the governance proposals of its milestones pass.
+func (s msgServer) CreateMilestoneVestingAccount(goCtx context.Context, msg *types.MsgCreateMilestoneVestingAccount) (*types.MsgCreateMilestoneVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	if s.gk == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "milestone vesting accounts require the governance module")
+	}
+
+	// only proposals which can still pass are accepted, as the milestones of
+	// the others would never vest
+	for _, milestone := range msg.Milestones {
+		proposal, found := s.gk.GetProposal(ctx, milestone.GovernanceProposalId)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "proposal %d does not exist", milestone.GovernanceProposalId)
+		}
+		if proposal.Status != govv1.StatusDepositPeriod && proposal.Status != govv1.StatusVotingPeriod {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d is already finished: %s", milestone.GovernanceProposalId, proposal.Status)
+		}
+	}
+
+	totalCoins := types.Milestones(msg.Milestones).TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewMilestoneVestingAccount(baseAccount, totalCoins.Sort(), msg.Milestones)
+
+	ak.SetAccount(ctx, vestingAccount)
+	setMilestoneAccount(ctx, s.storeKey, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_milestone_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMilestoneVestingAccountResponse{}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 var (
@@ -32,17 +34,22 @@ type VestingTestSuite struct {
 	suite.Suite
 
 	ctx           sdk.Context
+	vestingKey    *storetypes.KVStoreKey
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
 	distrKeeper   *vestingtestutil.MockDistrKeeper
 	stakingKeeper *vestingtestutil.MockStakingKeeper
+	govKeeper     *vestingtestutil.MockGovKeeper
 	msgServer     vestingtypes.MsgServer
+	queryServer   vestingtypes.QueryServer
 }
 
 func (s *VestingTestSuite) SetupTest() {
-	key := sdk.NewKVStoreKey(authtypes.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
-	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	keys := sdk.NewKVStoreKeys(authtypes.StoreKey, vestingtypes.StoreKey)
+	key := keys[authtypes.StoreKey]
+	s.vestingKey = keys[vestingtypes.StoreKey]
+	ctx := testutil.DefaultContextWithKeys(keys, sdk.NewTransientStoreKeys("transient_test"))
+	s.ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	maccPerms := map[string][]string{}
@@ -51,6 +58,7 @@ func (s *VestingTestSuite) SetupTest() {
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
 	s.distrKeeper = vestingtestutil.NewMockDistrKeeper(ctrl)
 	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
+	s.govKeeper = vestingtestutil.NewMockGovKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		key,
@@ -62,7 +70,8 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.distrKeeper, s.stakingKeeper)
+	s.msgServer = vesting.NewMsgServerImpl(s.vestingKey, s.accountKeeper, s.bankKeeper, s.distrKeeper, s.stakingKeeper, s.govKeeper)
+	s.queryServer = vesting.NewQueryServerImpl(s.accountKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -152,6 +161,30 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateCliffContinuousVestingAccount() {
+	now := s.ctx.BlockTime().Unix()
+
+	msg := vestingtypes.NewMsgCreateVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, now+100, false)
+	msg.CliffTime = now - 1
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	_, err := s.msgServer.CreateVestingAccount(s.ctx, msg)
+	s.Require().ErrorContains(err, "before the block time")
+
+	msg.CliffTime = now + 50
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.Coins{fooCoin}).Return(nil)
+	_, err = s.msgServer.CreateVestingAccount(s.ctx, msg)
+	s.Require().NoError(err)
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.CliffContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(now, acc.StartTime)
+	s.Require().Equal(now+50, acc.CliffTime)
+	s.Require().Equal(now+100, acc.EndTime)
+}
+
 func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
 	testCases := map[string]struct {
 		preRun    func()
@@ -313,6 +346,198 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false).Times(2)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.Coins{fooCoin}).Return(nil)
+
+	// the end time must be after the block time
+	_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, s.ctx.BlockTime().Unix()))
+	s.Require().ErrorContains(err, "must be after the block time")
+
+	endTime := s.ctx.BlockTime().Unix() + 100
+	_, err = s.msgServer.CreateClawbackVestingAccount(s.ctx, vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, endTime))
+	s.Require().NoError(err)
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(fromAddr.String(), acc.Funder)
+	s.Require().Equal(s.ctx.BlockTime().Unix(), acc.StartTime)
+	s.Require().Equal(endTime, acc.EndTime)
+	s.Require().Equal(sdk.Coins{fooCoin}, acc.OriginalVesting)
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	now := s.ctx.BlockTime().Unix()
+	to4Addr := sdk.AccAddress([]byte("to4__________________"))
+	to5Addr := sdk.AccAddress([]byte("to5__________________"))
+
+	// half of the coins are vested at the block time
+	setClawbackAccount := func(addr sdk.AccAddress, delegatedFree, delegatedVesting sdk.Coins) {
+		baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+		acc := vestingtypes.NewClawbackVestingAccount(baseAcc, sdk.Coins{fooCoin}, now-50, now+50, fromAddr)
+		acc.DelegatedFree = delegatedFree
+		acc.DelegatedVesting = delegatedVesting
+		s.accountKeeper.SetAccount(s.ctx, acc)
+	}
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coins
+		postRun   func(acc authtypes.AccountI)
+	}{
+		"clawback from non existing account": {
+			preRun: func() {},
+			input:  vestingtypes.NewMsgClawback(fromAddr, to1Addr),
+			expErr: true, expErrMsg: "does not exist",
+		},
+		"clawback from non clawback vesting account": {
+			preRun: func() {
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+			},
+			input:  vestingtypes.NewMsgClawback(fromAddr, to1Addr),
+			expErr: true, expErrMsg: "not a clawback vesting account",
+		},
+		"clawback by another account than the funder": {
+			preRun: func() { setClawbackAccount(to2Addr, nil, nil) },
+			input:  vestingtypes.NewMsgClawback(to3Addr, to2Addr),
+			expErr: true, expErrMsg: "is not the funder",
+		},
+		"valid clawback": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to2Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 50))).Return(nil)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to2Addr),
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
+			postRun: func(acc authtypes.AccountI) {
+				_, ok := acc.(*authtypes.BaseAccount)
+				s.Require().True(ok)
+			},
+		},
+		"clawback of an account with delegated vesting coins": {
+			preRun: func() {
+				setClawbackAccount(to4Addr, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), sdk.NewCoins(sdk.NewInt64Coin("foo", 30)))
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to4Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 20))).Return(nil)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to4Addr),
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin("foo", 20)),
+			postRun: func(acc authtypes.AccountI) {
+				// the delegated vesting coins keep vesting from the block time
+				vestingAcc, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), vestingAcc.OriginalVesting)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), vestingAcc.DelegatedVesting)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), vestingAcc.DelegatedFree)
+				s.Require().Equal(now, vestingAcc.StartTime)
+				s.Require().Equal(now+50, vestingAcc.EndTime)
+				s.Require().Empty(vestingAcc.LockedCoins(s.ctx.BlockTime()))
+			},
+		},
+		"clawback of an account whose delegated vesting coins have partly vested": {
+			preRun: func() {
+				setClawbackAccount(to5Addr, nil, sdk.NewCoins(sdk.NewInt64Coin("foo", 70)))
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to5Addr),
+			expAmount: sdk.Coins{},
+			postRun: func(acc authtypes.AccountI) {
+				vestingAcc, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 50)), vestingAcc.OriginalVesting)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 50)), vestingAcc.DelegatedVesting)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 20)), vestingAcc.DelegatedFree)
+			},
+		},
+	}
+
+	for _, name := range []string{
+		"clawback from non existing account",
+		"clawback from non clawback vesting account",
+		"clawback by another account than the funder",
+		"valid clawback",
+		"clawback of an account with delegated vesting coins",
+		"clawback of an account whose delegated vesting coins have partly vested",
+	} {
+		tc := testCases[name]
+		s.Run(name, func() {
+			tc.preRun()
+			res, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAmount, res.Amount)
+			tc.postRun(s.accountKeeper.GetAccount(s.ctx, sdk.MustAccAddressFromBech32(tc.input.VestingAddress)))
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestQueryClawbackVestingAccount() {
+	now := s.ctx.BlockTime().Unix()
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	s.accountKeeper.SetAccount(s.ctx, vestingtypes.NewClawbackVestingAccount(baseAcc, sdk.Coins{fooCoin}, now-25, now+75, fromAddr))
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+
+	res, err := s.queryServer.ClawbackVestingAccount(s.ctx, &vestingtypes.QueryClawbackVestingAccountRequest{Address: to1Addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(fromAddr.String(), res.Funder)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 25)), res.Vested)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 75)), res.Unvested)
+
+	_, err = s.queryServer.ClawbackVestingAccount(s.ctx, &vestingtypes.QueryClawbackVestingAccountRequest{Address: to2Addr.String()})
+	s.Require().ErrorContains(err, "not a clawback vesting account")
+
+	_, err = s.queryServer.ClawbackVestingAccount(s.ctx, &vestingtypes.QueryClawbackVestingAccountRequest{Address: to3Addr.String()})
+	s.Require().ErrorContains(err, "not found")
+}
+
+func (s *VestingTestSuite) TestCreateMilestoneVestingAccount() {
+	milestones := []vestingtypes.VestingMilestone{
+		{GovernanceProposalId: 1, VestingAmount: sdk.Coins{periodCoin}},
+		{GovernanceProposalId: 2, VestingAmount: sdk.Coins{periodCoin}},
+	}
+	totalCoins := sdk.NewCoins(sdk.NewInt64Coin("foo", 40))
+
+	// unknown and finished proposals are rejected
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(1)).Return(govv1.Proposal{}, false)
+	_, err := s.msgServer.CreateMilestoneVestingAccount(s.ctx, vestingtypes.NewMsgCreateMilestoneVestingAccount(fromAddr, to1Addr, milestones))
+	s.Require().ErrorContains(err, "proposal 1 does not exist")
+
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(1)).Return(govv1.Proposal{Id: 1, Status: govv1.StatusVotingPeriod}, true)
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(2)).Return(govv1.Proposal{Id: 2, Status: govv1.StatusPassed}, true)
+	_, err = s.msgServer.CreateMilestoneVestingAccount(s.ctx, vestingtypes.NewMsgCreateMilestoneVestingAccount(fromAddr, to1Addr, milestones))
+	s.Require().ErrorContains(err, "proposal 2 is already finished")
+
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(1)).Return(govv1.Proposal{Id: 1, Status: govv1.StatusVotingPeriod}, true)
+	s.govKeeper.EXPECT().GetProposal(gomock.Any(), uint64(2)).Return(govv1.Proposal{Id: 2, Status: govv1.StatusDepositPeriod}, true)
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, totalCoins).Return(nil)
+
+	_, err = s.msgServer.CreateMilestoneVestingAccount(s.ctx, vestingtypes.NewMsgCreateMilestoneVestingAccount(fromAddr, to1Addr, milestones))
+	s.Require().NoError(err)
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.MilestoneVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(totalCoins, acc.OriginalVesting)
+	s.Require().Equal(milestones, acc.Milestones)
+	s.Require().Equal(totalCoins, acc.LockedCoins(s.ctx.BlockTime()))
+
+	s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+	_, err = s.msgServer.CreateMilestoneVestingAccount(s.ctx, vestingtypes.NewMsgCreateMilestoneVestingAccount(fromAddr, to1Addr, milestones))
+	s.Require().ErrorContains(err, "already exists")
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
@@ -0,0 +1,52 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, wrapping the corresponding AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+func (s queryServer) ClawbackVestingAccount(goCtx context.Context, req *types.QueryClawbackVestingAccountRequest) (*types.QueryClawbackVestingAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a clawback vesting account", req.Address)
+	}
+
+	return &types.QueryClawbackVestingAccountResponse{
+		Funder:   vestingAcc.Funder,
+		Vested:   vestingAcc.GetVestedCoins(ctx.BlockTime()),
+		Unvested: vestingAcc.GetVestingCoins(ctx.BlockTime()),
+	}, nil
+}
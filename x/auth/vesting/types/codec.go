@@ -19,15 +19,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*exported.VestingAccount)(nil), nil)
 	cdc.RegisterConcrete(&BaseVestingAccount{}, "cosmos-sdk/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
+	cdc.RegisterConcrete(&CliffContinuousVestingAccount{}, "cosmos-sdk/CliffContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
 	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
 	cdc.RegisterConcrete(&PermanentLockedAccount{}, "cosmos-sdk/PermanentLockedAccount", nil)
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&MilestoneVestingAccount{}, "cosmos-sdk/MilestoneVestingAccount", nil)
 	cdc.RegisterConcrete(&MsgCreatePermanentLockedAccount{}, "cosmos-sdk/MsgCreatePermLockedAccount", nil)
 
 	// msg registration
 	cdc.RegisterConcrete(&MsgCreateVestingAccount{}, "cosmos-sdk/MsgCreateVestingAccount", nil)
 	cdc.RegisterConcrete(&MsgCreatePeriodicVestingAccount{}, "cosmos-sdk/MsgCreatePeriodicVestingAccount", nil)
 	cdc.RegisterConcrete(&MsgDonateAllVestingTokens{}, "cosmos-sdk/MsgDonateAllVestingTokens", nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, "cosmos-sdk/MsgCreateClawbackVestingAcc", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "cosmos-sdk/MsgClawback", nil)
+	cdc.RegisterConcrete(&MsgCreateMilestoneVestingAccount{}, "cosmos-sdk/MsgCreateMilestoneVestingAcc", nil)
 }
 
 // RegisterInterface associates protoName with AccountI and VestingAccount
@@ -40,6 +46,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&DelayedVestingAccount{},
 		&PeriodicVestingAccount{},
 		&PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
+		&MilestoneVestingAccount{},
+		&CliffContinuousVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -49,6 +58,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ContinuousVestingAccount{},
 		&PeriodicVestingAccount{},
 		&PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
+		&MilestoneVestingAccount{},
+		&CliffContinuousVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -58,6 +70,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ContinuousVestingAccount{},
 		&PeriodicVestingAccount{},
 		&PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
+		&MilestoneVestingAccount{},
+		&CliffContinuousVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -65,6 +80,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateVestingAccount{},
 		&MsgCreatePeriodicVestingAccount{},
 		&MsgDonateAllVestingTokens{},
+		&MsgCreateClawbackVestingAccount{},
+		&MsgClawback{},
+		&MsgCreateMilestoneVestingAccount{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
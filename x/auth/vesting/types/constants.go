@@ -1,9 +1,44 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
 const (
 	// ModuleName defines the module's name.
 	ModuleName = "vesting"
 
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// MilestoneAccountKeyPrefix indexes milestone vesting accounts by the
+// governance proposals of their milestones:
+// 0x01 | ProposalID (8 bytes) | len(AccAddress) (1 byte) | AccAddress -> []byte{}
+var MilestoneAccountKeyPrefix = []byte{0x01}
+
+// MilestoneAccountsKey returns the key prefix of the milestone vesting
+// accounts indexed under a governance proposal.
+func MilestoneAccountsKey(proposalID uint64) []byte {
+	return append(MilestoneAccountKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// MilestoneAccountKey returns the key indexing a milestone vesting account
+// under a governance proposal.
+func MilestoneAccountKey(proposalID uint64, addr sdk.AccAddress) []byte {
+	return append(MilestoneAccountsKey(proposalID), address.MustLengthPrefix(addr)...)
+}
+
+// AddressFromMilestoneAccountKey returns the account address of a
+// MilestoneAccountKey.
+func AddressFromMilestoneAccountKey(key []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(key, 1+8+1)
+	addrLen := key[1+8]
+	kv.AssertKeyLength(key, 1+8+1+int(addrLen))
+	return sdk.AccAddress(key[1+8+1:])
+}
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -13,6 +14,7 @@ type BankKeeper interface {
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // DistrKeeper defines the expected interface for distribution keeper
@@ -35,3 +37,9 @@ type StakingKeeper interface {
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
 	RemoveDelegation(ctx sdk.Context, delegation stakingtypes.Delegation) error
 }
+
+// GovKeeper defines the expected interface for the governance keeper, used to
+// vest the milestones of milestone vesting accounts
+type GovKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (govv1.Proposal, bool)
+}
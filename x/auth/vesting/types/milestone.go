@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Milestones stores all vesting milestones passed as part of a
+// MilestoneVestingAccount
+type Milestones []VestingMilestone
+
+// TotalAmount returns the sum of coins of the milestones
+func (m Milestones) TotalAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, milestone := range m {
+		total = total.Add(milestone.VestingAmount...)
+	}
+	return total
+}
+
+// PassedAmount returns the sum of coins of the milestones whose governance
+// proposal passed
+func (m Milestones) PassedAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, milestone := range m {
+		if milestone.Passed {
+			total = total.Add(milestone.VestingAmount...)
+		}
+	}
+	return total
+}
@@ -19,6 +19,15 @@ const TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account
 // TypeMsgDonateAllVestingTokens defines the type value for a MsgDonateAllVestingTokens.
 const TypeMsgDonateAllVestingTokens = "msg_donate_all_vesting_tokens" //nolint: gosec
 
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
+// TypeMsgCreateMilestoneVestingAccount defines the type value for a MsgCreateMilestoneVestingAccount.
+const TypeMsgCreateMilestoneVestingAccount = "msg_create_milestone_vesting_account"
+
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
@@ -27,6 +36,12 @@ var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
 var _ sdk.Msg = &MsgDonateAllVestingTokens{}
 
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
+var _ sdk.Msg = &MsgCreateMilestoneVestingAccount{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) *MsgCreateVestingAccount {
 	return &MsgCreateVestingAccount{
@@ -65,6 +80,14 @@ func (msg MsgCreateVestingAccount) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid end time")
 	}
 
+	if msg.CliffTime < 0 || msg.CliffTime > msg.EndTime {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid cliff time")
+	}
+
+	if msg.Delayed && msg.CliffTime != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cliff time cannot be set for a delayed vesting account")
+	}
+
 	return nil
 }
 
@@ -242,3 +265,152 @@ func (msg MsgDonateAllVestingTokens) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Amount:      amount,
+		EndTime:     endTime,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	if msg.EndTime <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid end time")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+func NewMsgClawback(funder, vestingAddr sdk.AccAddress) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress:  funder.String(),
+		VestingAddress: vestingAddr.String(),
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid vesting address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgCreateMilestoneVestingAccount returns a reference to a new MsgCreateMilestoneVestingAccount.
+func NewMsgCreateMilestoneVestingAccount(fromAddr, toAddr sdk.AccAddress, milestones []VestingMilestone) *MsgCreateMilestoneVestingAccount {
+	return &MsgCreateMilestoneVestingAccount{
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Milestones:  milestones,
+	}
+}
+
+// Route returns the message route for a MsgCreateMilestoneVestingAccount.
+func (msg MsgCreateMilestoneVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateMilestoneVestingAccount.
+func (msg MsgCreateMilestoneVestingAccount) Type() string {
+	return TypeMsgCreateMilestoneVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateMilestoneVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if len(msg.Milestones) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one milestone is required")
+	}
+
+	for i, milestone := range msg.Milestones {
+		if !milestone.VestingAmount.IsValid() || !milestone.VestingAmount.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrap(milestone.VestingAmount.String())
+		}
+
+		if milestone.GovernanceProposalId == 0 {
+			return fmt.Errorf("invalid governance proposal id in milestone %d, id must be greater than 0", i)
+		}
+
+		if milestone.Passed {
+			return fmt.Errorf("milestone %d cannot be created as passed", i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateMilestoneVestingAccount.
+func (msg MsgCreateMilestoneVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateMilestoneVestingAccount.
+func (msg MsgCreateMilestoneVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
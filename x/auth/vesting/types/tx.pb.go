@@ -42,6 +42,9 @@ type MsgCreateVestingAccount struct {
 	// end of vesting as unix time (in seconds).
 	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	Delayed bool  `protobuf:"varint,5,opt,name=delayed,proto3" json:"delayed,omitempty"`
+	// cliff of vesting as unix time (in seconds). If set, a cliff continuous
+	// vesting account is created, vesting no coins before the cliff time.
+	CliffTime int64 `protobuf:"varint,6,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
 }
 
 func (m *MsgCreateVestingAccount) Reset()         { *m = MsgCreateVestingAccount{} }
@@ -112,6 +115,13 @@ func (m *MsgCreateVestingAccount) GetDelayed() bool {
 	return false
 }
 
+func (m *MsgCreateVestingAccount) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
 // MsgCreateVestingAccountResponse defines the Msg/CreateVestingAccount response type.
 type MsgCreateVestingAccountResponse struct {
 }
@@ -453,6 +463,323 @@ func (m *MsgDonateAllVestingTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDonateAllVestingTokensResponse proto.InternalMessageInfo
 
+// MsgCreateClawbackVestingAccount defines a message that enables creating a
+// continuous vesting account whose vesting coins can be clawed back by its
+// funder.
+type MsgCreateClawbackVestingAccount struct {
+	// from_address is the funder of the vesting account.
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// end of vesting as unix time (in seconds).
+	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgCreateClawbackVestingAccount) Reset()         { *m = MsgCreateClawbackVestingAccount{} }
+func (m *MsgCreateClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccount) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{8}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreateClawbackVestingAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// MsgCreateClawbackVestingAccountResponse defines the
+// Msg/CreateClawbackVestingAccount response type.
+type MsgCreateClawbackVestingAccountResponse struct {
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Reset() {
+	*m = MsgCreateClawbackVestingAccountResponse{}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{9}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccountResponse proto.InternalMessageInfo
+
+// MsgClawback defines a message that enables the funder of a clawback vesting
+// account to claw back its vesting coins which are not delegated. The delegated
+// ones keep vesting, and the account is converted to a base account holding its
+// vested coins if there are none.
+type MsgClawback struct {
+	FunderAddress  string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{10}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+// MsgClawbackResponse defines the Msg/Clawback response type.
+type MsgClawbackResponse struct {
+	// amount is the amount of coins clawed back.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{11}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgCreateMilestoneVestingAccount defines a message that enables creating a
+// vesting account vesting coins when governance proposals pass.
+type MsgCreateMilestoneVestingAccount struct {
+	FromAddress string             `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string             `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Milestones  []VestingMilestone `protobuf:"bytes,3,rep,name=milestones,proto3" json:"milestones"`
+}
+
+func (m *MsgCreateMilestoneVestingAccount) Reset()         { *m = MsgCreateMilestoneVestingAccount{} }
+func (m *MsgCreateMilestoneVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMilestoneVestingAccount) ProtoMessage()    {}
+func (*MsgCreateMilestoneVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{12}
+}
+func (m *MsgCreateMilestoneVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMilestoneVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMilestoneVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMilestoneVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMilestoneVestingAccount.Merge(m, src)
+}
+func (m *MsgCreateMilestoneVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMilestoneVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMilestoneVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMilestoneVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreateMilestoneVestingAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateMilestoneVestingAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateMilestoneVestingAccount) GetMilestones() []VestingMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+// MsgCreateMilestoneVestingAccountResponse defines the
+// Msg/CreateMilestoneVestingAccount response type.
+type MsgCreateMilestoneVestingAccountResponse struct {
+}
+
+func (m *MsgCreateMilestoneVestingAccountResponse) Reset() {
+	*m = MsgCreateMilestoneVestingAccountResponse{}
+}
+func (m *MsgCreateMilestoneVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMilestoneVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreateMilestoneVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{13}
+}
+func (m *MsgCreateMilestoneVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMilestoneVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMilestoneVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMilestoneVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMilestoneVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateMilestoneVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMilestoneVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMilestoneVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMilestoneVestingAccountResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccountResponse")
@@ -462,57 +789,77 @@ func init() {
 	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccountResponse")
 	proto.RegisterType((*MsgDonateAllVestingTokens)(nil), "cosmos.vesting.v1beta1.MsgDonateAllVestingTokens")
 	proto.RegisterType((*MsgDonateAllVestingTokensResponse)(nil), "cosmos.vesting.v1beta1.MsgDonateAllVestingTokensResponse")
+	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccount")
+	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.vesting.v1beta1.MsgClawbackResponse")
+	proto.RegisterType((*MsgCreateMilestoneVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateMilestoneVestingAccount")
+	proto.RegisterType((*MsgCreateMilestoneVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateMilestoneVestingAccountResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xbf, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xe3, 0x26, 0xfd, 0x91, 0xeb, 0xab, 0x17, 0xd5, 0x94, 0xd6, 0x89, 0xa8, 0x9d, 0x1a,
-	0x10, 0x21, 0x52, 0x6d, 0xa5, 0x80, 0x2a, 0x02, 0x52, 0xd5, 0xb4, 0x1b, 0x54, 0x42, 0xa1, 0x62,
-	0x40, 0x42, 0x91, 0x63, 0x1f, 0xae, 0xd5, 0xf8, 0x2e, 0xf2, 0x5d, 0xab, 0x76, 0xab, 0x18, 0x18,
-	0x98, 0x18, 0x19, 0x18, 0x3a, 0x22, 0xa6, 0x0e, 0xcc, 0xcc, 0x9d, 0x50, 0xc5, 0xc4, 0x54, 0x50,
-	0x3b, 0xb4, 0x03, 0x53, 0xff, 0x02, 0x64, 0xdf, 0x39, 0x24, 0xe5, 0x9c, 0xb4, 0x30, 0xb0, 0xd4,
-	0xcd, 0x3d, 0xdf, 0xef, 0x73, 0x8f, 0x3f, 0xf7, 0x3c, 0x67, 0xa0, 0xd9, 0x98, 0xf8, 0x98, 0x98,
-	0x1b, 0x90, 0x50, 0x0f, 0xb9, 0xe6, 0x46, 0xb9, 0x01, 0xa9, 0x55, 0x36, 0xe9, 0xa6, 0xd1, 0x0a,
-	0x30, 0xc5, 0xf2, 0x04, 0x13, 0x18, 0x5c, 0x60, 0x70, 0x41, 0x7e, 0xdc, 0xc5, 0x2e, 0x8e, 0x24,
-	0x66, 0xf8, 0x1f, 0x53, 0xe7, 0x55, 0x9e, 0xae, 0x61, 0x11, 0xd8, 0xce, 0x65, 0x63, 0x0f, 0xf1,
-	0x78, 0x8e, 0xc5, 0xeb, 0xcc, 0xc8, 0x53, 0xb3, 0xd0, 0xf5, 0x84, 0x4a, 0xe2, 0x8d, 0x99, 0x6a,
-	0x92, 0xab, 0x7c, 0x12, 0x2a, 0xc2, 0x07, 0x0f, 0x8c, 0x59, 0xbe, 0x87, 0xb0, 0x19, 0xfd, 0x65,
-	0x4b, 0xfa, 0x8f, 0x01, 0x30, 0xb9, 0x4c, 0xdc, 0xc5, 0x00, 0x5a, 0x14, 0x3e, 0x65, 0x69, 0x16,
-	0x6c, 0x1b, 0xaf, 0x23, 0x2a, 0xdf, 0x07, 0xff, 0xbd, 0x08, 0xb0, 0x5f, 0xb7, 0x1c, 0x27, 0x80,
-	0x84, 0x28, 0x52, 0x41, 0x2a, 0x66, 0xab, 0xca, 0x97, 0x8f, 0x33, 0xe3, 0xbc, 0xaa, 0x05, 0x16,
-	0x79, 0x42, 0x03, 0x0f, 0xb9, 0xb5, 0xd1, 0x50, 0xcd, 0x97, 0xe4, 0x39, 0x00, 0x28, 0x6e, 0x5b,
-	0x07, 0xfa, 0x58, 0xb3, 0x14, 0xc7, 0xc6, 0x55, 0x30, 0x64, 0xf9, 0xe1, 0xfe, 0x4a, 0xba, 0x90,
-	0x2e, 0x8e, 0xce, 0xe6, 0x0c, 0xee, 0x08, 0x79, 0xc5, 0x68, 0x8d, 0x45, 0xec, 0xa1, 0xea, 0xdd,
-	0xbd, 0x03, 0x2d, 0xf5, 0xe1, 0x9b, 0x56, 0x74, 0x3d, 0xba, 0xba, 0xde, 0x30, 0x6c, 0xec, 0x73,
-	0x5e, 0xfc, 0x31, 0x43, 0x9c, 0x35, 0x93, 0x6e, 0xb5, 0x20, 0x89, 0x0c, 0xe4, 0xfd, 0xf1, 0x6e,
-	0x49, 0xaa, 0xf1, 0xfc, 0x72, 0x0e, 0x8c, 0x40, 0xe4, 0xd4, 0xa9, 0xe7, 0x43, 0x25, 0x53, 0x90,
-	0x8a, 0xe9, 0xda, 0x30, 0x44, 0xce, 0x8a, 0xe7, 0x43, 0x59, 0x01, 0xc3, 0x0e, 0x6c, 0x5a, 0x5b,
-	0xd0, 0x51, 0x06, 0x0b, 0x52, 0x71, 0xa4, 0x16, 0xff, 0xac, 0x3c, 0x38, 0xd9, 0xd1, 0xa4, 0x97,
-	0xc7, 0xbb, 0xa5, 0x2e, 0x36, 0xaf, 0x8f, 0x77, 0x4b, 0x7a, 0xc7, 0x9e, 0x09, 0x48, 0xf5, 0x69,
-	0xa0, 0x25, 0x84, 0x6a, 0x90, 0xb4, 0x30, 0x22, 0x50, 0xff, 0x34, 0xd0, 0xa1, 0x79, 0x0c, 0x03,
-	0xdf, 0x42, 0x10, 0xd1, 0x47, 0xd8, 0x5e, 0x83, 0x4e, 0x7c, 0x32, 0x15, 0xe1, 0xc9, 0x4c, 0x9e,
-	0x1e, 0x68, 0x97, 0xb7, 0x2c, 0xbf, 0x59, 0xd1, 0x3b, 0xa3, 0x7a, 0xf7, 0xc1, 0xdc, 0x11, 0x1c,
-	0xcc, 0x95, 0xd3, 0x03, 0x6d, 0x8c, 0x39, 0x7f, 0xc5, 0xf4, 0x7f, 0x72, 0x2a, 0x95, 0xf9, 0x44,
-	0xc0, 0x37, 0x44, 0x80, 0x43, 0x42, 0x5d, 0x70, 0xf4, 0x5b, 0xe0, 0x66, 0x1f, 0x7e, 0x6d, 0xd6,
-	0xef, 0xce, 0xb0, 0xf6, 0xb0, 0xe3, 0xd9, 0x67, 0xa6, 0x60, 0x5a, 0xc4, 0xba, 0x1b, 0xe9, 0xd4,
-	0xef, 0x48, 0x3b, 0xd9, 0x4d, 0x01, 0x40, 0xa8, 0x15, 0x50, 0xd6, 0x69, 0xe9, 0xa8, 0xd3, 0xb2,
-	0xd1, 0x4a, 0xd4, 0x6b, 0x35, 0x70, 0x89, 0xcf, 0x6f, 0xbd, 0x15, 0x95, 0x40, 0x94, 0x4c, 0xc4,
-	0x58, 0x35, 0xc4, 0xf7, 0x8a, 0xc1, 0x2a, 0xad, 0x66, 0x43, 0xd0, 0x0c, 0xde, 0xff, 0x5c, 0xc2,
-	0x22, 0xa4, 0xb2, 0x74, 0xb2, 0xa3, 0xa5, 0x84, 0x10, 0x4b, 0x09, 0x10, 0x05, 0xaf, 0x7e, 0x96,
-	0xa4, 0x40, 0xd2, 0x26, 0xf9, 0x1c, 0xe4, 0x96, 0x89, 0xbb, 0x84, 0x91, 0x45, 0xe1, 0x42, 0xb3,
-	0xc9, 0x55, 0x2b, 0x78, 0x0d, 0x22, 0xf2, 0x37, 0xed, 0x5a, 0xc9, 0x84, 0x6f, 0xa2, 0x5f, 0x03,
-	0xd3, 0x89, 0xe9, 0xe3, 0x1a, 0x66, 0x3f, 0x67, 0x40, 0x7a, 0x99, 0xb8, 0xf2, 0xb6, 0x04, 0xc6,
-	0x85, 0x17, 0x9a, 0x99, 0x04, 0x34, 0x61, 0x26, 0xf3, 0x73, 0x17, 0x34, 0xc4, 0xa5, 0xc8, 0x6f,
-	0x25, 0x70, 0xb5, 0xe7, 0x04, 0xf7, 0xcf, 0x2c, 0x36, 0xe6, 0xe7, 0xff, 0xd0, 0x28, 0x2e, 0x4d,
-	0xd4, 0xf0, 0xe7, 0x2a, 0x4d, 0x60, 0x3c, 0x5f, 0x69, 0x3d, 0x9a, 0x48, 0x7e, 0x25, 0x81, 0x89,
-	0x84, 0x16, 0x2a, 0xf7, 0xc8, 0x2d, 0xb6, 0xe4, 0xef, 0x5d, 0xd8, 0x12, 0x17, 0x92, 0x1f, 0xdc,
-	0x0e, 0xa7, 0xaa, 0xfa, 0x70, 0xef, 0x50, 0x95, 0xf6, 0x0f, 0x55, 0xe9, 0xfb, 0xa1, 0x2a, 0xbd,
-	0x39, 0x52, 0x53, 0xfb, 0x47, 0x6a, 0xea, 0xeb, 0x91, 0x9a, 0x7a, 0x56, 0xee, 0x79, 0xb7, 0x6d,
-	0x9a, 0xd6, 0x3a, 0x5d, 0x6d, 0x7f, 0xa5, 0xa3, 0xab, 0xae, 0x31, 0x14, 0x7d, 0x70, 0x6f, 0xff,
-	0x0c, 0x00, 0x00, 0xff, 0xff, 0x38, 0xb3, 0x92, 0x2c, 0x4e, 0x08, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x37, 0xdb, 0xfd, 0x31, 0x0b, 0x45, 0x75, 0x97, 0xdd, 0xc4, 0x22, 0x76, 0xd6, 0x05,
+	0x1a, 0x82, 0x6a, 0x2b, 0x05, 0x54, 0x30, 0x48, 0x69, 0xb2, 0xbd, 0x41, 0x24, 0x94, 0x56, 0x1c,
+	0x10, 0x28, 0x72, 0xec, 0x89, 0xd7, 0x4a, 0xec, 0x89, 0x3c, 0x93, 0xd2, 0x3d, 0x51, 0x71, 0xe0,
+	0x00, 0x17, 0x8e, 0x48, 0x70, 0xe8, 0x11, 0x71, 0xca, 0x81, 0x33, 0x12, 0xb7, 0x1e, 0x2b, 0x4e,
+	0x9c, 0x02, 0xda, 0x15, 0xda, 0x9e, 0xfb, 0x17, 0x20, 0x7b, 0xc6, 0xae, 0x93, 0x8e, 0x93, 0x6c,
+	0x10, 0x82, 0x03, 0x97, 0xcd, 0xc6, 0xef, 0xfb, 0x5e, 0xbe, 0xf7, 0xbd, 0x37, 0x6f, 0x12, 0xa0,
+	0x58, 0x08, 0x7b, 0x08, 0xeb, 0x77, 0x21, 0x26, 0xae, 0xef, 0xe8, 0x77, 0x6b, 0x5d, 0x48, 0xcc,
+	0x9a, 0x4e, 0xee, 0x69, 0xc3, 0x00, 0x11, 0x24, 0xee, 0x51, 0x80, 0xc6, 0x00, 0x1a, 0x03, 0x48,
+	0xbb, 0x0e, 0x72, 0x50, 0x04, 0xd1, 0xc3, 0xff, 0x28, 0x5a, 0x92, 0x59, 0xba, 0xae, 0x89, 0x61,
+	0x92, 0xcb, 0x42, 0xae, 0xcf, 0xe2, 0x45, 0x1a, 0xef, 0x50, 0x22, 0x4b, 0x4d, 0x43, 0x2f, 0x67,
+	0x28, 0x89, 0x3f, 0x98, 0xa2, 0xf6, 0x19, 0xca, 0xc3, 0x21, 0x22, 0x7c, 0x61, 0x81, 0x4b, 0xa6,
+	0xe7, 0xfa, 0x48, 0x8f, 0xfe, 0xd2, 0x47, 0xea, 0xd7, 0x79, 0xb0, 0xdf, 0xc2, 0xce, 0x61, 0x00,
+	0x4d, 0x02, 0x3f, 0xa2, 0x69, 0x1a, 0x96, 0x85, 0x46, 0x3e, 0x11, 0xdf, 0x05, 0xcf, 0xf5, 0x02,
+	0xe4, 0x75, 0x4c, 0xdb, 0x0e, 0x20, 0xc6, 0x05, 0xa1, 0x2c, 0x54, 0xb6, 0x9b, 0x85, 0x5f, 0x7f,
+	0xba, 0xb6, 0xcb, 0x54, 0x35, 0x68, 0xe4, 0x36, 0x09, 0x5c, 0xdf, 0x69, 0xef, 0x84, 0x68, 0xf6,
+	0x48, 0xbc, 0x01, 0x00, 0x41, 0x09, 0x75, 0x6d, 0x01, 0x75, 0x9b, 0xa0, 0x98, 0x78, 0x04, 0x36,
+	0x4c, 0x2f, 0xfc, 0xfc, 0x42, 0xbe, 0x9c, 0xaf, 0xec, 0x5c, 0x2f, 0x6a, 0x8c, 0x11, 0xfa, 0x15,
+	0x5b, 0xab, 0x1d, 0x22, 0xd7, 0x6f, 0xbe, 0xf5, 0x70, 0xa2, 0xe4, 0x7e, 0xfc, 0x5d, 0xa9, 0x38,
+	0x2e, 0x39, 0x1a, 0x75, 0x35, 0x0b, 0x79, 0xcc, 0x2f, 0xf6, 0x72, 0x0d, 0xdb, 0x7d, 0x9d, 0x1c,
+	0x0f, 0x21, 0x8e, 0x08, 0xf8, 0x87, 0xb3, 0x71, 0x55, 0x68, 0xb3, 0xfc, 0x62, 0x11, 0x6c, 0x41,
+	0xdf, 0xee, 0x10, 0xd7, 0x83, 0x85, 0xf5, 0xb2, 0x50, 0xc9, 0xb7, 0x37, 0xa1, 0x6f, 0xdf, 0x71,
+	0x3d, 0x28, 0x16, 0xc0, 0xa6, 0x0d, 0x07, 0xe6, 0x31, 0xb4, 0x0b, 0x17, 0xca, 0x42, 0x65, 0xab,
+	0x1d, 0xbf, 0x15, 0x4b, 0x00, 0x58, 0x03, 0xb7, 0xd7, 0xa3, 0xb4, 0x8d, 0x88, 0xb6, 0x1d, 0x3d,
+	0x09, 0x89, 0xc6, 0x7b, 0x8f, 0x1f, 0x28, 0xc2, 0x17, 0x67, 0xe3, 0xea, 0x94, 0x75, 0x5f, 0x9d,
+	0x8d, 0xab, 0x6a, 0x4a, 0x52, 0x86, 0xe3, 0xea, 0x01, 0x50, 0x32, 0x42, 0x6d, 0x88, 0x87, 0xc8,
+	0xc7, 0x50, 0xfd, 0x79, 0x2d, 0x85, 0xf9, 0x10, 0x06, 0x9e, 0xe9, 0x43, 0x9f, 0x7c, 0x80, 0xac,
+	0x3e, 0xb4, 0xe3, 0xc6, 0x19, 0xdc, 0xc6, 0xed, 0x3f, 0x99, 0x28, 0x97, 0x8f, 0x4d, 0x6f, 0x60,
+	0xa8, 0xe9, 0xa8, 0x3a, 0xdd, 0xb7, 0x37, 0x39, 0x7d, 0x7b, 0xf1, 0xc9, 0x44, 0xb9, 0x44, 0x99,
+	0x4f, 0x63, 0xea, 0xbf, 0xd2, 0x34, 0xa3, 0x9e, 0x69, 0xf0, 0x2b, 0x3c, 0x83, 0x43, 0x87, 0xa6,
+	0xcc, 0x51, 0x5f, 0x03, 0x57, 0x17, 0xf8, 0x97, 0x78, 0xfd, 0xfd, 0x8c, 0xd7, 0x2e, 0xb2, 0x5d,
+	0x6b, 0xe6, 0x90, 0x1c, 0xf0, 0xbc, 0x9e, 0xb6, 0xb4, 0xf4, 0xac, 0xa5, 0x69, 0xef, 0x4a, 0x00,
+	0x60, 0x62, 0x06, 0x84, 0x4e, 0x54, 0x9e, 0x4e, 0x54, 0xf4, 0x24, 0x1a, 0xc5, 0x36, 0x78, 0x81,
+	0x1d, 0xef, 0xce, 0x30, 0x92, 0x80, 0x0b, 0xeb, 0x91, 0xc7, 0xb2, 0xc6, 0x5f, 0x3b, 0x1a, 0x55,
+	0xda, 0xdc, 0x0e, 0x8d, 0xa6, 0xe6, 0x5d, 0x64, 0x10, 0x1a, 0xc1, 0xc6, 0xad, 0xc7, 0x0f, 0x94,
+	0x1c, 0xd7, 0xc4, 0x6a, 0x86, 0x89, 0x9c, 0xd2, 0x67, 0x9d, 0xe4, 0x40, 0x12, 0x27, 0x3f, 0x05,
+	0xc5, 0x16, 0x76, 0x6e, 0x21, 0xdf, 0x24, 0xb0, 0x31, 0x18, 0x30, 0xd4, 0x1d, 0xd4, 0x87, 0x3e,
+	0xfe, 0x3b, 0xe3, 0x6a, 0xac, 0x87, 0x95, 0xa8, 0x57, 0xc0, 0x41, 0x66, 0xfa, 0x44, 0xc3, 0x24,
+	0xdd, 0xcd, 0xc3, 0x81, 0xf9, 0x59, 0xd7, 0xb4, 0xfa, 0xff, 0xaf, 0x3c, 0xb6, 0xf2, 0x8c, 0x9b,
+	0x99, 0x07, 0xeb, 0x55, 0xde, 0x4c, 0x3c, 0x6b, 0xe0, 0xd4, 0x3c, 0xf0, 0xfd, 0x4d, 0x7a, 0xf1,
+	0x8b, 0x00, 0x76, 0x42, 0x2c, 0x43, 0x89, 0x75, 0x70, 0xb1, 0x37, 0xf2, 0x6d, 0x18, 0x2c, 0xed,
+	0xfc, 0xf3, 0x14, 0x1f, 0x5b, 0xd8, 0x78, 0x7a, 0x4a, 0x96, 0x6d, 0x40, 0x7c, 0x28, 0xe2, 0x51,
+	0xd2, 0xc2, 0xe2, 0x67, 0x64, 0x84, 0xe5, 0xef, 0xcd, 0x94, 0xcf, 0x34, 0xab, 0x9f, 0x83, 0xcb,
+	0xa9, 0xb7, 0x71, 0x69, 0xa9, 0x66, 0x0a, 0xff, 0x6c, 0x33, 0xd5, 0xf1, 0x1a, 0x28, 0x27, 0x86,
+	0xb7, 0xdc, 0x01, 0xc4, 0x04, 0xf9, 0xff, 0x8d, 0x4b, 0xfc, 0x36, 0x00, 0x5e, 0x2c, 0x08, 0xb3,
+	0xa9, 0xae, 0x64, 0xed, 0x2b, 0xa6, 0x38, 0xa9, 0x20, 0xbd, 0xb9, 0x52, 0x69, 0x8c, 0x46, 0xe6,
+	0xd6, 0xba, 0xca, 0x9b, 0x50, 0x8e, 0x23, 0x6a, 0x15, 0x54, 0x16, 0x39, 0x16, 0x37, 0xf2, 0xfa,
+	0x9f, 0x9b, 0x20, 0xdf, 0xc2, 0x8e, 0x78, 0x5f, 0x00, 0xbb, 0xdc, 0xef, 0x47, 0x7a, 0x56, 0x41,
+	0x19, 0x77, 0xb8, 0x74, 0xe3, 0x9c, 0x84, 0x64, 0xa6, 0xbe, 0x15, 0xc0, 0x4b, 0x73, 0x6f, 0xfc,
+	0xc5, 0x99, 0xf9, 0x44, 0xa9, 0xbe, 0x22, 0x91, 0x2f, 0x8d, 0x77, 0x41, 0x2e, 0x25, 0x8d, 0x43,
+	0x94, 0xea, 0x2b, 0x12, 0x13, 0x69, 0x5f, 0x0a, 0x60, 0x2f, 0xe3, 0xca, 0xa9, 0xcd, 0xc9, 0xcd,
+	0xa7, 0x48, 0xef, 0x9c, 0x9b, 0xc2, 0xf1, 0x28, 0xe3, 0xda, 0x59, 0xec, 0x11, 0x9f, 0x28, 0xd5,
+	0x57, 0x24, 0x26, 0xd2, 0x3e, 0x01, 0x5b, 0xc9, 0x12, 0xbe, 0x32, 0x2f, 0x19, 0x03, 0x49, 0xaf,
+	0x2f, 0x01, 0x4a, 0xb2, 0x7f, 0x27, 0x80, 0xd2, 0xfc, 0xf5, 0xf4, 0xf6, 0xc2, 0x02, 0x32, 0x98,
+	0xd2, 0xcd, 0x55, 0x99, 0xb1, 0x3a, 0xe9, 0xc2, 0xfd, 0x70, 0xc5, 0x34, 0xdf, 0x7f, 0x78, 0x22,
+	0x0b, 0x8f, 0x4e, 0x64, 0xe1, 0x8f, 0x13, 0x59, 0xf8, 0xe6, 0x54, 0xce, 0x3d, 0x3a, 0x95, 0x73,
+	0xbf, 0x9d, 0xca, 0xb9, 0x8f, 0x6b, 0x73, 0xf7, 0xf2, 0x3d, 0xdd, 0x1c, 0x91, 0xa3, 0xe4, 0xb7,
+	0x58, 0xb4, 0xa6, 0xbb, 0x1b, 0xd1, 0xcf, 0xaa, 0x37, 0xfe, 0x1a, 0x00, 0xe4, 0xc3, 0xd4, 0x0f,
+	0x34, 0x0e, 0x00, 0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -554,6 +901,9 @@ func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
 	if this.Delayed != that1.Delayed {
 		return false
 	}
+	if this.CliffTime != that1.CliffTime {
+		return false
+	}
 	return true
 }
 func (this *MsgCreatePermanentLockedAccount) Equal(that interface{}) bool {
@@ -591,6 +941,44 @@ func (this *MsgCreatePermanentLockedAccount) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCreateClawbackVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateClawbackVestingAccount)
+	if !ok {
+		that2, ok := that.(MsgCreateClawbackVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FromAddress != that1.FromAddress {
+		return false
+	}
+	if this.ToAddress != that1.ToAddress {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.EndTime != that1.EndTime {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -620,6 +1008,16 @@ type MsgClient interface {
 	// DonateAllVestingTokens defines a method that enables donating all vesting
 	// tokens to community pool
 	DonateAllVestingTokens(ctx context.Context, in *MsgDonateAllVestingTokens, opts ...grpc.CallOption) (*MsgDonateAllVestingTokensResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// continuous vesting account whose vesting coins can be clawed back by its
+	// funder.
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables the funder of a clawback vesting
+	// account to claw back its vesting coins.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// CreateMilestoneVestingAccount defines a method that enables creating a
+	// vesting account vesting coins when governance proposals pass.
+	CreateMilestoneVestingAccount(ctx context.Context, in *MsgCreateMilestoneVestingAccount, opts ...grpc.CallOption) (*MsgCreateMilestoneVestingAccountResponse, error)
 }
 
 type msgClient struct {
@@ -666,6 +1064,33 @@ func (c *msgClient) DonateAllVestingTokens(ctx context.Context, in *MsgDonateAll
 	return out, nil
 }
 
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMilestoneVestingAccount(ctx context.Context, in *MsgCreateMilestoneVestingAccount, opts ...grpc.CallOption) (*MsgCreateMilestoneVestingAccountResponse, error) {
+	out := new(MsgCreateMilestoneVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateMilestoneVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateVestingAccount defines a method that enables creating a vesting
@@ -684,6 +1109,16 @@ type MsgServer interface {
 	// DonateAllVestingTokens defines a method that enables donating all vesting
 	// tokens to community pool
 	DonateAllVestingTokens(context.Context, *MsgDonateAllVestingTokens) (*MsgDonateAllVestingTokensResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// continuous vesting account whose vesting coins can be clawed back by its
+	// funder.
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables the funder of a clawback vesting
+	// account to claw back its vesting coins.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// CreateMilestoneVestingAccount defines a method that enables creating a
+	// vesting account vesting coins when governance proposals pass.
+	CreateMilestoneVestingAccount(context.Context, *MsgCreateMilestoneVestingAccount) (*MsgCreateMilestoneVestingAccountResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -702,6 +1137,15 @@ func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context,
 func (*UnimplementedMsgServer) DonateAllVestingTokens(ctx context.Context, req *MsgDonateAllVestingTokens) (*MsgDonateAllVestingTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DonateAllVestingTokens not implemented")
 }
+func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) CreateMilestoneVestingAccount(ctx context.Context, req *MsgCreateMilestoneVestingAccount) (*MsgCreateMilestoneVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMilestoneVestingAccount not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -779,9 +1223,63 @@ func _Msg_DonateAllVestingTokens_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.vesting.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMilestoneVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMilestoneVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMilestoneVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateMilestoneVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMilestoneVestingAccount(ctx, req.(*MsgCreateMilestoneVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "CreateVestingAccount",
@@ -799,6 +1297,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DonateAllVestingTokens",
 			Handler:    _Msg_DonateAllVestingTokens_Handler,
 		},
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "CreateMilestoneVestingAccount",
+			Handler:    _Msg_CreateMilestoneVestingAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -824,6 +1334,11 @@ func (m *MsgCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.CliffTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Delayed {
 		i--
 		if m.Delayed {
@@ -1099,6 +1614,233 @@ func (m *MsgDonateAllVestingTokensResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMilestoneVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMilestoneVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMilestoneVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMilestoneVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMilestoneVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMilestoneVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1136,6 +1878,9 @@ func (m *MsgCreateVestingAccount) Size() (n int) {
 	if m.Delayed {
 		n += 2
 	}
+	if m.CliffTime != 0 {
+		n += 1 + sovTx(uint64(m.CliffTime))
+	}
 	return n
 }
 
@@ -1237,14 +1982,113 @@ func (m *MsgDonateAllVestingTokensResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMilestoneVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMilestoneVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1338,9 +2182,616 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delayed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDonateAllVestingTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDonateAllVestingTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDonateAllVestingTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1350,65 +2801,24 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Delayed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1430,7 +2840,7 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDonateAllVestingTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,10 +2863,10 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDonateAllVestingTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDonateAllVestingTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1480,7 +2890,7 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1503,10 +2913,10 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1607,6 +3017,25 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1628,7 +3057,7 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,10 +3080,10 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1678,7 +3107,7 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +3130,15 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,11 +3166,11 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1769,60 +3198,7 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1845,7 +3221,7 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1868,12 +3244,46 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1895,7 +3305,7 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateAllVestingTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMilestoneVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1918,10 +3328,10 @@ func (m *MsgDonateAllVestingTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateAllVestingTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMilestoneVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateAllVestingTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMilestoneVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1956,6 +3366,72 @@ func (m *MsgDonateAllVestingTokens) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, VestingMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1977,7 +3453,7 @@ func (m *MsgDonateAllVestingTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateAllVestingTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMilestoneVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,10 +3476,10 @@ func (m *MsgDonateAllVestingTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateAllVestingTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMilestoneVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateAllVestingTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMilestoneVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
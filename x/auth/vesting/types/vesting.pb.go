@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -110,6 +111,50 @@ func (m *ContinuousVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContinuousVestingAccount proto.InternalMessageInfo
 
+// CliffContinuousVestingAccount implements the VestingAccount interface. It
+// vests no coins before its cliff time, then continuously vests its coins
+// linearly with respect to time until its end time.
+type CliffContinuousVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// Vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Vesting cliff time, as unix timestamp (in seconds). Defaults to the start
+	// time if unset.
+	CliffTime int64 `protobuf:"varint,3,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+}
+
+func (m *CliffContinuousVestingAccount) Reset()      { *m = CliffContinuousVestingAccount{} }
+func (*CliffContinuousVestingAccount) ProtoMessage() {}
+func (*CliffContinuousVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{2}
+}
+func (m *CliffContinuousVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CliffContinuousVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CliffContinuousVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CliffContinuousVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CliffContinuousVestingAccount.Merge(m, src)
+}
+func (m *CliffContinuousVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CliffContinuousVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CliffContinuousVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CliffContinuousVestingAccount proto.InternalMessageInfo
+
 // DelayedVestingAccount implements the VestingAccount interface. It vests all
 // coins after a specific time, but non prior. In other words, it keeps them
 // locked until a specified time.
@@ -120,7 +165,7 @@ type DelayedVestingAccount struct {
 func (m *DelayedVestingAccount) Reset()      { *m = DelayedVestingAccount{} }
 func (*DelayedVestingAccount) ProtoMessage() {}
 func (*DelayedVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89e80273ca606d6e, []int{2}
+	return fileDescriptor_89e80273ca606d6e, []int{3}
 }
 func (m *DelayedVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +204,7 @@ type Period struct {
 func (m *Period) Reset()      { *m = Period{} }
 func (*Period) ProtoMessage() {}
 func (*Period) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89e80273ca606d6e, []int{3}
+	return fileDescriptor_89e80273ca606d6e, []int{4}
 }
 func (m *Period) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +258,7 @@ type PeriodicVestingAccount struct {
 func (m *PeriodicVestingAccount) Reset()      { *m = PeriodicVestingAccount{} }
 func (*PeriodicVestingAccount) ProtoMessage() {}
 func (*PeriodicVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89e80273ca606d6e, []int{4}
+	return fileDescriptor_89e80273ca606d6e, []int{5}
 }
 func (m *PeriodicVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +299,7 @@ type PermanentLockedAccount struct {
 func (m *PermanentLockedAccount) Reset()      { *m = PermanentLockedAccount{} }
 func (*PermanentLockedAccount) ProtoMessage() {}
 func (*PermanentLockedAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89e80273ca606d6e, []int{5}
+	return fileDescriptor_89e80273ca606d6e, []int{6}
 }
 func (m *PermanentLockedAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,13 +328,167 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It
+// continuously vests like a ContinuousVestingAccount, its funder being able to
+// claw back the coins which are still vesting.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// Vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Address of the account which funded the vesting account, and can claw back
+	// its vesting coins.
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{7}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
+// VestingMilestone defines an amount of coins vesting when a governance
+// proposal passes.
+type VestingMilestone struct {
+	// governance_proposal_id is the ID of the governance proposal which vests the
+	// amount when it passes.
+	GovernanceProposalId uint64                                   `protobuf:"varint,1,opt,name=governance_proposal_id,json=governanceProposalId,proto3" json:"governance_proposal_id,omitempty"`
+	VestingAmount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vesting_amount,json=vestingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting_amount"`
+	// passed is set once the governance proposal passed.
+	Passed bool `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+}
+
+func (m *VestingMilestone) Reset()         { *m = VestingMilestone{} }
+func (m *VestingMilestone) String() string { return proto.CompactTextString(m) }
+func (*VestingMilestone) ProtoMessage()    {}
+func (*VestingMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{8}
+}
+func (m *VestingMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingMilestone.Merge(m, src)
+}
+func (m *VestingMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingMilestone proto.InternalMessageInfo
+
+func (m *VestingMilestone) GetGovernanceProposalId() uint64 {
+	if m != nil {
+		return m.GovernanceProposalId
+	}
+	return 0
+}
+
+func (m *VestingMilestone) GetVestingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VestingAmount
+	}
+	return nil
+}
+
+func (m *VestingMilestone) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+// MilestoneVestingAccount implements the VestingAccount interface. It vests the
+// amount of each of its milestones when the governance proposal of the
+// milestone passes, and never vests the amount of milestones whose proposal
+// does not pass.
+type MilestoneVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	Milestones          []VestingMilestone `protobuf:"bytes,2,rep,name=milestones,proto3" json:"milestones"`
+}
+
+func (m *MilestoneVestingAccount) Reset()      { *m = MilestoneVestingAccount{} }
+func (*MilestoneVestingAccount) ProtoMessage() {}
+func (*MilestoneVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{9}
+}
+func (m *MilestoneVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MilestoneVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MilestoneVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MilestoneVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneVestingAccount.Merge(m, src)
+}
+func (m *MilestoneVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MilestoneVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MilestoneVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
+	proto.RegisterType((*CliffContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.CliffContinuousVestingAccount")
 	proto.RegisterType((*DelayedVestingAccount)(nil), "cosmos.vesting.v1beta1.DelayedVestingAccount")
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
+	proto.RegisterType((*VestingMilestone)(nil), "cosmos.vesting.v1beta1.VestingMilestone")
+	proto.RegisterType((*MilestoneVestingAccount)(nil), "cosmos.vesting.v1beta1.MilestoneVestingAccount")
 }
 
 func init() {
@@ -297,45 +496,58 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x21, 0xd0, 0x0b, 0xf4, 0x87, 0x55, 0xa2, 0xb4, 0x52, 0x9d, 0x60, 0x18, 0xa2,
-	0x48, 0xb5, 0x49, 0x11, 0x4b, 0x36, 0x52, 0x84, 0x84, 0x60, 0x40, 0x11, 0x62, 0x60, 0x89, 0xce,
-	0xf6, 0xe1, 0x9c, 0x1a, 0xdf, 0x45, 0xbe, 0x4b, 0xa1, 0x42, 0xec, 0x88, 0xa9, 0x23, 0x12, 0x03,
-	0x1d, 0x51, 0xc5, 0xd0, 0x3f, 0xa3, 0x1b, 0x19, 0x99, 0x0a, 0x4a, 0x86, 0xfe, 0x1b, 0xc8, 0xe7,
-	0xb3, 0x6b, 0x25, 0x69, 0xb7, 0x54, 0x2c, 0x89, 0xbf, 0x1f, 0xf7, 0xbd, 0xf7, 0x3e, 0x3f, 0xeb,
-	0xe0, 0x03, 0x97, 0xf1, 0x80, 0x71, 0x7b, 0x1f, 0x73, 0x41, 0xa8, 0x6f, 0xef, 0x37, 0x1d, 0x2c,
-	0x50, 0x33, 0x89, 0xad, 0x41, 0xc8, 0x04, 0xd3, 0xcb, 0x71, 0x97, 0x95, 0x64, 0x55, 0xd7, 0xe6,
-	0x1a, 0x0a, 0x08, 0x65, 0xb6, 0xfc, 0x8d, 0x5b, 0x37, 0xd7, 0x7d, 0xe6, 0x33, 0xf9, 0x68, 0x47,
-	0x4f, 0x2a, 0x6b, 0x28, 0x18, 0x07, 0x71, 0x9c, 0x62, 0xb8, 0x8c, 0xd0, 0xa9, 0x3a, 0x1a, 0x8a,
-	0x5e, 0x5a, 0x8f, 0x82, 0xb8, 0x6e, 0x7e, 0x2b, 0x40, 0xbd, 0x8d, 0x38, 0x7e, 0x13, 0x13, 0x78,
-	0xe2, 0xba, 0x6c, 0x48, 0x85, 0xfe, 0x1c, 0xde, 0x8e, 0x26, 0x76, 0x51, 0x1c, 0x57, 0x40, 0x0d,
-	0xd4, 0x4b, 0x3b, 0x35, 0x4b, 0xd1, 0x95, 0x03, 0xd4, 0x34, 0x2b, 0x3a, 0xae, 0xce, 0xb5, 0x0b,
-	0xa3, 0xb3, 0x2a, 0xe8, 0x94, 0x9c, 0x8b, 0x94, 0xfe, 0x11, 0xae, 0xb2, 0x90, 0xf8, 0x84, 0xa2,
-	0x7e, 0x57, 0xc9, 0xac, 0xe4, 0x6a, 0xf9, 0x7a, 0x69, 0x67, 0x23, 0x19, 0x17, 0xb5, 0xa7, 0xe3,
-	0x76, 0x19, 0xa1, 0xed, 0xc7, 0xa7, 0x67, 0x55, 0xed, 0xf8, 0x4f, 0xb5, 0xee, 0x13, 0xd1, 0x1b,
-	0x3a, 0x96, 0xcb, 0x02, 0x5b, 0x29, 0x89, 0xff, 0xb6, 0xb9, 0xb7, 0x67, 0x8b, 0x83, 0x01, 0xe6,
-	0xf2, 0x00, 0xff, 0x71, 0x7e, 0xd2, 0x00, 0x9d, 0x95, 0x04, 0x49, 0xc9, 0xd1, 0xdf, 0xc3, 0x65,
-	0x0f, 0xf7, 0xb1, 0x8f, 0x04, 0xf6, 0xba, 0xef, 0x42, 0x8c, 0x2b, 0xf9, 0x05, 0x41, 0xdf, 0x49,
-	0x71, 0x9e, 0x85, 0x18, 0xeb, 0x9f, 0xe0, 0xda, 0x05, 0x70, 0x22, 0xbb, 0xb0, 0x20, 0xec, 0xd5,
-	0x14, 0x2a, 0xd1, 0xbd, 0x01, 0x6f, 0x61, 0xea, 0x75, 0x05, 0x09, 0x70, 0xe5, 0x46, 0x0d, 0xd4,
-	0xf3, 0x9d, 0x9b, 0x98, 0x7a, 0xaf, 0x49, 0x80, 0x5b, 0x8d, 0xcf, 0x47, 0x55, 0xed, 0xeb, 0x51,
-	0x55, 0xfb, 0x72, 0x7e, 0xd2, 0xd8, 0xca, 0x8c, 0x9d, 0xb5, 0x81, 0xf9, 0x0b, 0xc0, 0xca, 0x2e,
-	0xa3, 0x82, 0xd0, 0x21, 0x1b, 0xf2, 0x29, 0x8f, 0x38, 0x70, 0x5d, 0x7a, 0x44, 0xa9, 0x9b, 0xf2,
-	0x4a, 0xc3, 0x9a, 0x6f, 0x6d, 0x6b, 0x16, 0x46, 0xb9, 0x46, 0x77, 0x66, 0x7d, 0xb8, 0x05, 0x21,
-	0x17, 0x28, 0x14, 0xb1, 0x92, 0x9c, 0x54, 0xb2, 0x24, 0x33, 0x52, 0xcb, 0xc3, 0xac, 0x96, 0xfb,
-	0x19, 0x2d, 0x97, 0x91, 0x36, 0x8f, 0x01, 0xbc, 0xfb, 0x14, 0xf7, 0xd1, 0x41, 0xba, 0xab, 0x6b,
-	0x94, 0xd3, 0xda, 0xce, 0xf2, 0xad, 0x65, 0xf8, 0xce, 0xa5, 0x64, 0x1e, 0x02, 0x58, 0x7c, 0x85,
-	0x43, 0xc2, 0x3c, 0xbd, 0x0c, 0x8b, 0x7d, 0x4c, 0x7d, 0xd1, 0x93, 0x7c, 0xf2, 0x1d, 0x15, 0xe9,
-	0x3d, 0x58, 0x44, 0x81, 0xe4, 0xb9, 0xa8, 0x6f, 0x4a, 0xcd, 0x6f, 0x15, 0x22, 0xde, 0xe6, 0xf7,
-	0x1c, 0x2c, 0xc7, 0x94, 0x88, 0xfb, 0xdf, 0xf9, 0x41, 0xef, 0xc0, 0x95, 0x04, 0x7d, 0x20, 0x49,
-	0x72, 0xf5, 0xbd, 0x1b, 0x97, 0xa1, 0xc7, 0x5a, 0xda, 0x4b, 0xd1, 0x6e, 0x62, 0xbd, 0xcb, 0xaa,
-	0x25, 0xae, 0xf0, 0x96, 0x95, 0x7d, 0x67, 0xf7, 0x32, 0x9b, 0x9a, 0xbf, 0x06, 0xf3, 0x27, 0x90,
-	0x1b, 0x0a, 0x10, 0xc5, 0x54, 0xbc, 0x64, 0xee, 0x1e, 0xf6, 0xae, 0xd3, 0x62, 0x57, 0xd1, 0x9d,
-	0xc3, 0xa9, 0xfd, 0xe2, 0x74, 0x6c, 0x80, 0xd1, 0xd8, 0x00, 0x7f, 0xc7, 0x06, 0x38, 0x9c, 0x18,
-	0xda, 0x68, 0x62, 0x68, 0xbf, 0x27, 0x86, 0xf6, 0xb6, 0x79, 0xa5, 0x4f, 0x3e, 0xa8, 0x2b, 0x45,
-	0x5d, 0x6f, 0xd2, 0x36, 0x4e, 0x51, 0x5e, 0x2a, 0x8f, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x83, 0xdb, 0x67, 0xfd, 0x06, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x4f, 0x1b, 0x49,
+	0x14, 0xf6, 0xda, 0x3e, 0x1f, 0x0c, 0xc7, 0xaf, 0x95, 0xcf, 0x67, 0x90, 0x58, 0xfb, 0xf6, 0xae,
+	0xb0, 0x2c, 0xb1, 0x06, 0xee, 0x68, 0xdc, 0x61, 0x9f, 0x4e, 0x42, 0x77, 0x91, 0xd0, 0x12, 0xa5,
+	0x48, 0x63, 0xcd, 0xee, 0x8e, 0xd7, 0x23, 0x76, 0x67, 0xac, 0x9d, 0x31, 0x04, 0x45, 0xa9, 0xd2,
+	0x44, 0xa9, 0x28, 0x23, 0xa5, 0x08, 0x65, 0x84, 0x52, 0x50, 0xe4, 0x8f, 0xa0, 0x0b, 0x4a, 0x95,
+	0x34, 0x24, 0x82, 0x82, 0x7f, 0x21, 0x4d, 0xa4, 0x68, 0x67, 0x67, 0xcd, 0xc6, 0xd8, 0x74, 0x26,
+	0x34, 0xf6, 0xce, 0x7b, 0x6f, 0xde, 0xf7, 0x7d, 0xef, 0xbd, 0x9d, 0x1d, 0xf0, 0xa7, 0x4d, 0x99,
+	0x4f, 0x59, 0x6d, 0x17, 0x31, 0x8e, 0x89, 0x5b, 0xdb, 0x5d, 0xb5, 0x10, 0x87, 0xab, 0xf1, 0xda,
+	0xe8, 0x06, 0x94, 0x53, 0xb5, 0x10, 0x45, 0x19, 0xb1, 0x55, 0x46, 0x2d, 0xce, 0x43, 0x1f, 0x13,
+	0x5a, 0x13, 0xbf, 0x51, 0xe8, 0x62, 0xde, 0xa5, 0x2e, 0x15, 0x8f, 0xb5, 0xf0, 0x49, 0x5a, 0x35,
+	0x09, 0x63, 0x41, 0x86, 0xfa, 0x18, 0x36, 0xc5, 0x64, 0xc0, 0x0f, 0x7b, 0xbc, 0xd3, 0xf7, 0x87,
+	0x0b, 0xe9, 0x5f, 0x88, 0xfc, 0xad, 0x28, 0xb1, 0x64, 0x23, 0x16, 0xfa, 0xcb, 0x2c, 0x50, 0x1b,
+	0x90, 0xa1, 0x07, 0x11, 0xb7, 0x0d, 0xdb, 0xa6, 0x3d, 0xc2, 0xd5, 0x4d, 0xf0, 0x4b, 0x08, 0xd6,
+	0x82, 0xd1, 0xba, 0xa8, 0x94, 0x95, 0xca, 0xd4, 0x5a, 0xd9, 0x90, 0x7b, 0x45, 0x6e, 0x09, 0x64,
+	0x84, 0xdb, 0xe5, 0xbe, 0x46, 0xf6, 0xf4, 0xac, 0xa4, 0x98, 0x53, 0xd6, 0x95, 0x49, 0x7d, 0x0c,
+	0xe6, 0x68, 0x80, 0x5d, 0x4c, 0xa0, 0xd7, 0x92, 0x15, 0x28, 0xa6, 0xcb, 0x99, 0xca, 0xd4, 0xda,
+	0x42, 0x9c, 0x2e, 0x0c, 0xef, 0xa7, 0x6b, 0x52, 0x4c, 0x1a, 0xeb, 0x27, 0x67, 0xa5, 0xd4, 0xd1,
+	0xa7, 0x52, 0xc5, 0xc5, 0xbc, 0xd3, 0xb3, 0x0c, 0x9b, 0xfa, 0x92, 0xb7, 0xfc, 0x5b, 0x66, 0xce,
+	0x4e, 0x8d, 0xef, 0x77, 0x11, 0x13, 0x1b, 0xd8, 0xeb, 0xcb, 0xe3, 0xaa, 0x62, 0xce, 0xc6, 0x48,
+	0x52, 0x8e, 0xba, 0x07, 0x66, 0x1c, 0xe4, 0x21, 0x17, 0x72, 0xe4, 0xb4, 0xda, 0x01, 0x42, 0xc5,
+	0xcc, 0x98, 0xa0, 0xa7, 0xfb, 0x38, 0xff, 0x06, 0x08, 0xa9, 0x4f, 0xc0, 0xfc, 0x15, 0x70, 0x2c,
+	0x3b, 0x3b, 0x26, 0xec, 0xb9, 0x3e, 0x54, 0xac, 0x7b, 0x01, 0x4c, 0x20, 0xe2, 0xb4, 0x38, 0xf6,
+	0x51, 0xf1, 0xa7, 0xb2, 0x52, 0xc9, 0x98, 0x3f, 0x23, 0xe2, 0xdc, 0xc7, 0x3e, 0xaa, 0x57, 0x9f,
+	0x1d, 0x96, 0x52, 0x2f, 0x0e, 0x4b, 0xa9, 0xe7, 0x97, 0xc7, 0xd5, 0xa5, 0x44, 0xda, 0xeb, 0x63,
+	0xa0, 0xbf, 0x53, 0x40, 0xb1, 0x49, 0x09, 0xc7, 0xa4, 0x47, 0x7b, 0x6c, 0x60, 0x46, 0x2c, 0x90,
+	0x17, 0x33, 0x22, 0xd5, 0x0d, 0xcc, 0x4a, 0xd5, 0x18, 0x3e, 0xf5, 0xc6, 0x75, 0x18, 0x39, 0x35,
+	0xaa, 0x75, 0x7d, 0x0e, 0x97, 0x00, 0x60, 0x1c, 0x06, 0x3c, 0x52, 0x92, 0x16, 0x4a, 0x26, 0x85,
+	0x45, 0x68, 0x59, 0x49, 0x6a, 0xf9, 0x23, 0xa1, 0x65, 0x14, 0x69, 0xfd, 0x8b, 0x02, 0x96, 0x9a,
+	0x1e, 0x6e, 0xb7, 0xef, 0xb0, 0xac, 0xd0, 0x6d, 0x87, 0x1c, 0x23, 0x77, 0x26, 0x72, 0x0b, 0x8b,
+	0x50, 0xbd, 0x9e, 0x54, 0x5d, 0x49, 0xaa, 0xbe, 0x49, 0x98, 0x7e, 0xa4, 0x80, 0x5f, 0xff, 0x41,
+	0x1e, 0xdc, 0x47, 0xce, 0xf7, 0x9e, 0xdb, 0x90, 0x5c, 0x5f, 0x4e, 0x92, 0x2e, 0x27, 0x48, 0x0f,
+	0xa5, 0xa4, 0x1f, 0x28, 0x20, 0xb7, 0x85, 0x02, 0x4c, 0x1d, 0xb5, 0x00, 0x72, 0x1e, 0x22, 0x2e,
+	0xef, 0x08, 0x3e, 0x19, 0x53, 0xae, 0xd4, 0x0e, 0xc8, 0x41, 0x5f, 0xf0, 0x1c, 0xd7, 0x71, 0x22,
+	0xf3, 0xd7, 0xb3, 0x21, 0x6f, 0xfd, 0x55, 0x1a, 0x14, 0x22, 0x4a, 0xd8, 0xbe, 0x7b, 0x33, 0x63,
+	0x82, 0xd9, 0x18, 0xbd, 0x2b, 0x48, 0x32, 0x79, 0xd4, 0x69, 0xa3, 0xd0, 0x23, 0x2d, 0x8d, 0xc9,
+	0xb0, 0x36, 0x91, 0xde, 0x19, 0x19, 0x12, 0x79, 0x58, 0xdd, 0x48, 0xf6, 0xec, 0xf7, 0x44, 0xa5,
+	0x86, 0x97, 0x41, 0x7f, 0xa3, 0x88, 0x0a, 0xf9, 0x90, 0x20, 0xc2, 0xff, 0xa7, 0xf6, 0x0e, 0x72,
+	0x6e, 0x73, 0xc4, 0x6e, 0xa2, 0x3b, 0x84, 0x93, 0xfe, 0x55, 0x01, 0x85, 0xa6, 0x07, 0xf7, 0x2c,
+	0x68, 0xef, 0xdc, 0xbd, 0x86, 0xae, 0x80, 0x5c, 0xbb, 0x47, 0x1c, 0x14, 0x88, 0x03, 0x60, 0xb2,
+	0x51, 0x7c, 0xff, 0x76, 0x39, 0x2f, 0x71, 0x37, 0x1c, 0x27, 0x40, 0x8c, 0x6d, 0xf3, 0x00, 0x13,
+	0xd7, 0x94, 0x71, 0xa3, 0xf5, 0x0f, 0x17, 0xa9, 0x7f, 0x54, 0xc0, 0x9c, 0x34, 0xdd, 0xc3, 0x1e,
+	0x62, 0x9c, 0x12, 0xa4, 0xfe, 0x0d, 0x0a, 0x2e, 0xdd, 0x45, 0x01, 0x81, 0xc4, 0x46, 0xe1, 0x8d,
+	0xa1, 0x4b, 0x19, 0xf4, 0x5a, 0xd8, 0x11, 0xda, 0xb3, 0x66, 0xfe, 0xca, 0xbb, 0x25, 0x9d, 0x9b,
+	0x4e, 0xf8, 0x9d, 0xed, 0x97, 0x6a, 0xbc, 0xef, 0xe4, 0xb4, 0xc4, 0xd9, 0x10, 0x30, 0xe1, 0xe1,
+	0xd0, 0x85, 0x8c, 0x21, 0x47, 0x54, 0x69, 0xc2, 0x94, 0x2b, 0xfd, 0x69, 0x1a, 0xfc, 0xd6, 0x17,
+	0xf5, 0x03, 0x9a, 0xbb, 0x0d, 0x80, 0x1f, 0xc3, 0x33, 0x59, 0x8c, 0xca, 0xa8, 0xcc, 0x83, 0x4d,
+	0x48, 0xbe, 0x93, 0x89, 0x34, 0xf5, 0x5a, 0xb2, 0xc1, 0x7a, 0xa2, 0x4a, 0x23, 0x94, 0x36, 0xfe,
+	0x3b, 0x39, 0xd7, 0x94, 0xd3, 0x73, 0x4d, 0xf9, 0x7c, 0xae, 0x29, 0x07, 0x17, 0x5a, 0xea, 0xf4,
+	0x42, 0x4b, 0x7d, 0xb8, 0xd0, 0x52, 0x0f, 0x57, 0x6f, 0xac, 0xfa, 0x23, 0x79, 0x95, 0x94, 0xd7,
+	0x5a, 0xd1, 0x04, 0x2b, 0x27, 0x6e, 0x8c, 0x7f, 0x7d, 0x1b, 0x00, 0xb8, 0xe5, 0xfb, 0x9c, 0xf5,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -460,6 +672,51 @@ func (m *ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *CliffContinuousVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CliffContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CliffContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CliffTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelayedVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -626,88 +883,255 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVesting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	if len(m.OriginalVesting) > 0 {
-		for _, e := range m.OriginalVesting {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
-		}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.DelegatedFree) > 0 {
-		for _, e := range m.DelegatedFree {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
-		}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.DelegatedVesting) > 0 {
-		for _, e := range m.DelegatedVesting {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.EndTime != 0 {
-		n += 1 + sovVesting(uint64(m.EndTime))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContinuousVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseVestingAccount != nil {
-		l = m.BaseVestingAccount.Size()
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovVesting(uint64(m.StartTime))
+func (m *VestingMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelayedVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseVestingAccount != nil {
-		l = m.BaseVestingAccount.Size()
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	return n
+func (m *VestingMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Period) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VestingMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Length != 0 {
-		n += 1 + sovVesting(uint64(m.Length))
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAmount) > 0 {
+		for iNdEx := len(m.VestingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GovernanceProposalId != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.GovernanceProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MilestoneVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MilestoneVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MilestoneVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for _, e := range m.DelegatedVesting {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *ContinuousVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *CliffContinuousVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovVesting(uint64(m.CliffTime))
+	}
+	return n
+}
+
+func (m *DelayedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *Period) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovVesting(uint64(m.Length))
 	}
 	if len(m.Amount) > 0 {
 		for _, e := range m.Amount {
@@ -753,6 +1177,66 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovernanceProposalId != 0 {
+		n += 1 + sovVesting(uint64(m.GovernanceProposalId))
+	}
+	if len(m.VestingAmount) > 0 {
+		for _, e := range m.VestingAmount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.Passed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MilestoneVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -862,7 +1346,459 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types1.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CliffContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CliffContinuousVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CliffContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Period) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -872,29 +1808,14 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthVesting
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVesting
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatedFree = append(m.DelegatedFree, types1.Coin{})
-			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -921,30 +1842,11 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
-			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVesting
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -966,7 +1868,7 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -989,10 +1891,10 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinuousVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1050,6 +1952,40 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1071,7 +2007,7 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,10 +2030,10 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermanentLockedAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1157,7 +2093,7 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Period) Unmarshal(dAtA []byte) error {
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1180,17 +2116,17 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
 			}
-			m.Length = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1200,16 +2136,52 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1219,25 +2191,23 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1260,7 +2230,7 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *VestingMilestone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,53 +2253,17 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingMilestone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVesting
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthVesting
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVesting
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BaseVestingAccount == nil {
-				m.BaseVestingAccount = &BaseVestingAccount{}
-			}
-			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceProposalId", wireType)
 			}
-			m.StartTime = 0
+			m.GovernanceProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1339,14 +2273,14 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				m.GovernanceProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1373,11 +2307,31 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingAmount = append(m.VestingAmount, types1.Coin{})
+			if err := m.VestingAmount[len(m.VestingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1399,7 +2353,7 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
+func (m *MilestoneVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1422,10 +2376,10 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermanentLockedAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MilestoneVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MilestoneVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1464,6 +2418,40 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, VestingMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
@@ -19,6 +20,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -163,8 +165,11 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	StartTime      int64              `json:"start_time,omitempty"`
+	VestingPeriods Periods            `json:"vesting_periods,omitempty"`
+	CliffTime      int64              `json:"cliff_time,omitempty"`
+	Funder         string             `json:"funder,omitempty"`
+	Milestones     []VestingMilestone `json:"milestones,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -308,6 +313,120 @@ func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
 	return marshalYaml(out)
 }
 
+// Cliff Continuous Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*CliffContinuousVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*CliffContinuousVestingAccount)(nil)
+)
+
+// NewCliffContinuousVestingAccountRaw creates a new CliffContinuousVestingAccount
+// object from BaseVestingAccount. The cliff time defaults to the start time if
+// 0.
+func NewCliffContinuousVestingAccountRaw(bva *BaseVestingAccount, startTime, cliffTime int64) *CliffContinuousVestingAccount {
+	if cliffTime == 0 {
+		cliffTime = startTime
+	}
+
+	return &CliffContinuousVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		CliffTime:          cliffTime,
+	}
+}
+
+// NewCliffContinuousVestingAccount returns a new CliffContinuousVestingAccount
+func NewCliffContinuousVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, cliffTime, endTime int64) *CliffContinuousVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return NewCliffContinuousVestingAccountRaw(baseVestingAcc, startTime, cliffTime)
+}
+
+// GetVestedCoins returns the total number of vested coins. No coins are vested
+// before the cliff time, after which the coins vest linearly until the end
+// time. If no coins are vested, nil is returned.
+func (cva CliffContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return NewContinuousVestingAccountRaw(cva.BaseVestingAccount, cva.GetCliffTime()).GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva CliffContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva CliffContinuousVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *CliffContinuousVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a cliff continuous
+// vesting account.
+func (cva CliffContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetCliffTime returns the time before which no coins vest, being the start
+// time if the cliff time is unset.
+func (cva CliffContinuousVestingAccount) GetCliffTime() int64 {
+	if cva.CliffTime == 0 {
+		return cva.StartTime
+	}
+	return cva.CliffTime
+}
+
+// Validate checks for errors on the account fields
+func (cva CliffContinuousVestingAccount) Validate() error {
+	if cva.GetStartTime() >= cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be before end-time")
+	}
+
+	if cliffTime := cva.GetCliffTime(); cliffTime < cva.GetStartTime() || cliffTime > cva.GetEndTime() {
+		return errors.New("vesting cliff-time must be between start-time and end-time")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva CliffContinuousVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a CliffContinuousVestingAccount.
+func (cva CliffContinuousVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		PubKey:           getPKString(cva),
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		CliffTime:        cva.GetCliffTime(),
+	}
+	return marshalYaml(out)
+}
+
 // Periodic Vesting Account
 
 var (
@@ -597,6 +716,232 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccountRaw creates a new ClawbackVestingAccount object from BaseVestingAccount
+func NewClawbackVestingAccountRaw(bva *BaseVestingAccount, startTime int64, funder sdk.AccAddress) *ClawbackVestingAccount {
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		Funder:             funder.String(),
+	}
+}
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, endTime int64, funder sdk.AccAddress) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return NewClawbackVestingAccountRaw(baseVestingAcc, startTime, funder)
+}
+
+// GetVestedCoins returns the total number of vested coins, vested linearly
+// like a continuous vesting account. If no coins are vested, nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return NewContinuousVestingAccountRaw(cva.BaseVestingAccount, cva.StartTime).GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetFunder returns the address of the funder of a clawback vesting account.
+func (cva ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(cva.Funder)
+	return funder
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.GetStartTime() >= cva.GetEndTime() {
+		return errors.New("vesting start-time must be before end-time")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(cva.Funder); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		PubKey:           getPKString(cva),
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		Funder:           cva.Funder,
+	}
+	return marshalYaml(out)
+}
+
+// Milestone Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*MilestoneVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*MilestoneVestingAccount)(nil)
+)
+
+// NewMilestoneVestingAccountRaw creates a new MilestoneVestingAccount object from BaseVestingAccount
+func NewMilestoneVestingAccountRaw(bva *BaseVestingAccount, milestones Milestones) *MilestoneVestingAccount {
+	return &MilestoneVestingAccount{
+		BaseVestingAccount: bva,
+		Milestones:         milestones,
+	}
+}
+
+// NewMilestoneVestingAccount returns a new MilestoneVestingAccount
+func NewMilestoneVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, milestones Milestones) *MilestoneVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+	}
+
+	return NewMilestoneVestingAccountRaw(baseVestingAcc, milestones)
+}
+
+// GetVestedCoins returns the total number of vested coins, being the coins of
+// the milestones whose governance proposal passed. If no coins are vested, nil
+// is returned.
+func (mva MilestoneVestingAccount) GetVestedCoins(_ time.Time) sdk.Coins {
+	vestedCoins := Milestones(mva.Milestones).PassedAmount()
+	if vestedCoins.IsZero() {
+		return nil
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (mva MilestoneVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return mva.OriginalVesting.Sub(mva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (mva MilestoneVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return mva.BaseVestingAccount.LockedCoinsFromVesting(mva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (mva *MilestoneVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	mva.BaseVestingAccount.TrackDelegation(balance, mva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns a vesting account's start time, we return 0 to denote
+// that a milestone vesting account does not vest over time.
+func (mva MilestoneVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// GetEndTime returns a vesting account's end time, we return 0 to denote that
+// a milestone vesting account does not vest over time.
+func (mva MilestoneVestingAccount) GetEndTime() int64 {
+	return 0
+}
+
+// PassMilestones marks the milestones of the given governance proposal as
+// passed, vesting their coins. It returns false if the account has no pending
+// milestone for the proposal.
+func (mva *MilestoneVestingAccount) PassMilestones(proposalID uint64) bool {
+	passed := false
+	for i, milestone := range mva.Milestones {
+		if milestone.GovernanceProposalId == proposalID && !milestone.Passed {
+			mva.Milestones[i].Passed = true
+			passed = true
+		}
+	}
+
+	return passed
+}
+
+// Validate checks for errors on the account fields
+func (mva MilestoneVestingAccount) Validate() error {
+	if len(mva.Milestones) == 0 {
+		return errors.New("milestone vesting account must have at least one milestone")
+	}
+
+	if !Milestones(mva.Milestones).TotalAmount().IsEqual(mva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting milestones")
+	}
+
+	return mva.BaseVestingAccount.Validate()
+}
+
+func (mva MilestoneVestingAccount) String() string {
+	out, _ := mva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a MilestoneVestingAccount.
+func (mva MilestoneVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(mva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    mva.AccountNumber,
+		PubKey:           getPKString(mva),
+		Sequence:         mva.Sequence,
+		OriginalVesting:  mva.OriginalVesting,
+		DelegatedFree:    mva.DelegatedFree,
+		DelegatedVesting: mva.DelegatedVesting,
+		EndTime:          mva.EndTime,
+		Milestones:       mva.Milestones,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -83,6 +83,40 @@ func TestGetVestedCoinsContVestingAcc(t *testing.T) {
 	require.Equal(t, origCoins, vestedCoins)
 }
 
+func TestGetVestedCoinsCliffContVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	cliffTime := now.Add(12 * time.Hour)
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewCliffContinuousVestingAccount(bacc, origCoins, now.Unix(), cliffTime.Unix(), endTime.Unix())
+	require.NoError(t, cva.Validate())
+
+	// require no coins vested before the cliff
+	require.Nil(t, cva.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Nil(t, cva.GetVestedCoins(cliffTime))
+	require.Equal(t, origCoins, cva.LockedCoins(cliffTime))
+
+	// require the coins to vest linearly from the cliff, 50% of the coins being
+	// vested half way between the cliff and the end
+	vestedCoins := cva.GetVestedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, cva.GetVestedCoins(endTime))
+
+	// require the cliff to default to the start time, vesting like a continuous
+	// vesting account
+	cva = types.NewCliffContinuousVestingAccount(bacc, origCoins, now.Unix(), 0, endTime.Unix())
+	require.Equal(t, now.Unix(), cva.CliffTime)
+	cont := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	require.Equal(t, cont.GetVestedCoins(cliffTime), cva.GetVestedCoins(cliffTime))
+
+	// require the cliff to be between the start and end time
+	cva.CliffTime = endTime.Unix() + 1
+	require.Error(t, cva.Validate())
+}
+
 func TestGetVestingCoinsContVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(24 * time.Hour)
@@ -849,6 +883,53 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func TestGetVestedCoinsMilestoneVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+
+	bacc, origCoins := initBaseAccount()
+	milestones := types.Milestones{
+		{GovernanceProposalId: 1, VestingAmount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}},
+		{GovernanceProposalId: 2, VestingAmount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	mva := types.NewMilestoneVestingAccount(bacc, origCoins, milestones)
+	require.NoError(t, mva.Validate())
+
+	// require no coins vested before any proposal passes
+	require.Nil(t, mva.GetVestedCoins(now))
+	require.Equal(t, origCoins, mva.LockedCoins(now))
+
+	// require the coins of a milestone vested once its proposal passes
+	require.True(t, mva.PassMilestones(1))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, mva.GetVestedCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, mva.GetVestingCoins(now))
+
+	// require milestones to only pass once
+	require.False(t, mva.PassMilestones(1))
+	require.False(t, mva.PassMilestones(3))
+
+	require.True(t, mva.PassMilestones(2))
+	require.Equal(t, origCoins, mva.GetVestedCoins(now))
+	require.True(t, mva.LockedCoins(now).IsZero())
+
+	// require the original vesting to match the milestones
+	mva.OriginalVesting = sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}
+	require.Error(t, mva.Validate())
+}
+
+func (s *VestingAccountTestSuite) TestMilestoneVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	acc := types.NewMilestoneVestingAccount(baseAcc, coins, types.Milestones{{GovernanceProposalId: 1, VestingAmount: coins}})
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.MilestoneVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MsgExecBuilder builds the authz MsgExec executing messages on behalf of a
+// granter. It is set on the client context of chains supporting the --exec-as
+// flag.
+type MsgExecBuilder struct{}
+
+var _ client.MsgExecBuilder = MsgExecBuilder{}
+
+// BuildMsgExec moves the signer fields of msgs from the grantee, for which
+// they were built, to the granter, and wraps them in a MsgExec signed by the
+// grantee. Messages must have a single signer, set in a top-level address
+// field.
+func (MsgExecBuilder) BuildMsgExec(granter, grantee sdk.AccAddress, msgs []sdk.Msg) (sdk.Msg, error) {
+	for _, msg := range msgs {
+		if err := setSigner(msg, grantee, granter); err != nil {
+			return nil, err
+		}
+	}
+
+	msgExec := authz.NewMsgExec(grantee, msgs)
+	if err := msgExec.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &msgExec, nil
+}
+
+// setSigner replaces the signer of msg from by to, in the fields listed by the
+// cosmos.msg.v1.signer option of the message.
+func setSigner(msg sdk.Msg, from, to sdk.AccAddress) error {
+	if isSignedBy(msg, to) {
+		return nil
+	}
+	if !isSignedBy(msg, from) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("message %s must be signed by %s only", sdk.MsgTypeURL(msg), from)
+	}
+
+	signerFields, err := getSignerFields(msg)
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return sdkerrors.ErrInvalidType.Wrapf("message %s is not a pointer to a struct", sdk.MsgTypeURL(msg))
+	}
+	v = v.Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.String && field.String() == from.String() && signerFields[protoFieldName(v.Type().Field(i))] {
+			field.SetString(to.String())
+		}
+	}
+
+	if !isSignedBy(msg, to) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("the signer of message %s cannot be set to %s", sdk.MsgTypeURL(msg), to)
+	}
+
+	return nil
+}
+
+// isSignedBy returns whether signer is the only signer of msg.
+func isSignedBy(msg sdk.Msg, signer sdk.AccAddress) bool {
+	signers := msg.GetSigners()
+	return len(signers) == 1 && signers[0].Equals(signer)
+}
+
+// getSignerFields returns the names of the signer fields of msg, as set by its
+// cosmos.msg.v1.signer option.
+func getSignerFields(msg sdk.Msg) (map[string]bool, error) {
+	name := gogoproto.MessageName(msg)
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	fields := make(map[string]bool)
+	for _, field := range proto.GetExtension(msgDesc.Options(), msgv1.E_Signer).([]string) {
+		fields[field] = true
+	}
+
+	return fields, nil
+}
+
+// protoFieldName returns the protobuf name of a generated struct field.
+func protoFieldName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
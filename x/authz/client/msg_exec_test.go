@@ -0,0 +1,47 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzclient "github.com/cosmos/cosmos-sdk/x/authz/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestBuildMsgExec(t *testing.T) {
+	granter, grantee := sdk.AccAddress("granter"), sdk.AccAddress("grantee")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the message is built for the grantee, which is also the recipient
+	msg := banktypes.NewMsgSend(grantee, grantee, coins)
+
+	wrapped, err := authzclient.MsgExecBuilder{}.BuildMsgExec(granter, grantee, []sdk.Msg{msg})
+	require.NoError(t, err)
+
+	msgExec, ok := wrapped.(*authz.MsgExec)
+	require.True(t, ok)
+	require.Equal(t, grantee.String(), msgExec.Grantee)
+	msgs, err := msgExec.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, coins)}, msgs)
+
+	// messages already built for the granter are kept as is
+	msg = banktypes.NewMsgSend(granter, grantee, coins)
+	_, err = authzclient.MsgExecBuilder{}.BuildMsgExec(granter, grantee, []sdk.Msg{msg})
+	require.NoError(t, err)
+
+	// messages signed by another account cannot be executed on behalf of the
+	// granter
+	msg = banktypes.NewMsgSend(sdk.AccAddress("other"), grantee, coins)
+	_, err = authzclient.MsgExecBuilder{}.BuildMsgExec(granter, grantee, []sdk.Msg{msg})
+	require.ErrorContains(t, err, "must be signed by")
+
+	// nor can messages whose signer is not an account address field
+	editMsg := stakingtypes.NewMsgEditValidator(sdk.ValAddress(grantee), stakingtypes.Description{}, nil, nil, nil)
+	_, err = authzclient.MsgExecBuilder{}.BuildMsgExec(granter, grantee, []sdk.Msg{editMsg})
+	require.ErrorContains(t, err, "cannot be set to")
+}
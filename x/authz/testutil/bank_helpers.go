@@ -23,3 +23,31 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) FreezeAccount(goCtx context.Context, req *bank.MsgFreezeAccount) (*bank.MsgFreezeAccountResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) UnfreezeAccount(goCtx context.Context, req *bank.MsgUnfreezeAccount) (*bank.MsgUnfreezeAccountResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateEscrow(goCtx context.Context, req *bank.MsgCreateEscrow) (*bank.MsgCreateEscrowResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) FulfillEscrow(goCtx context.Context, req *bank.MsgFulfillEscrow) (*bank.MsgFulfillEscrowResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) GrantBurnAuthorization(goCtx context.Context, req *bank.MsgGrantBurnAuthorization) (*bank.MsgGrantBurnAuthorizationResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) BurnCoins(goCtx context.Context, req *bank.MsgBurnCoins) (*bank.MsgBurnCoinsResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SendWithMemo(goCtx context.Context, req *bank.MsgSendWithMemo) (*bank.MsgSendWithMemoResponse, error) {
+	return nil, nil
+}
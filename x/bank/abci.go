@@ -0,0 +1,30 @@
+package bank
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// EndBlocker refunds the coins held in the escrows expired at the current height,
+// prunes the transfer memos older than the MemoPruneAfterBlocks param and prunes
+// the expired burn authorizations. A failure is logged and retried at the next
+// block rather than halting the chain.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.RefundExpiredEscrows(ctx); err != nil {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Error("failed to refund the expired escrows", "err", err)
+	}
+
+	if err := k.PruneTransferMemos(ctx); err != nil {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Error("failed to prune the transfer memos", "err", err)
+	}
+
+	if err := k.PruneExpiredBurnAuthorizations(ctx); err != nil {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Error("failed to prune the expired burn authorizations", "err", err)
+	}
+}
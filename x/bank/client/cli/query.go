@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -35,6 +36,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryDenomSupplyCap(),
+		GetCmdQueryFrozenAccounts(),
+		GetCmdQueryEscrow(),
+		GetCmdQueryTopDenomsBySupply(),
+		GetCmdQueryDenomHolders(),
+		GetCmdQueryTransferMemo(),
 	)
 
 	return cmd
@@ -339,3 +346,205 @@ Getting all entries:
 
 	return cmd
 }
+
+func GetCmdQueryDenomSupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-cap [denom]",
+		Short: "Query the supply cap of a coin",
+		Long: strings.TrimSpace(`Query the maximum supply of a coin, set in its denom metadata.
+A supply cap of zero means the supply of the coin is not capped.
+`,
+		),
+		Example: fmt.Sprintf("$ %s query %s supply-cap foocoin", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomSupplyCap(cmd.Context(), &types.QueryDenomSupplyCapRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-accounts",
+		Short:   "Query the frozen denoms of all the frozen accounts",
+		Example: fmt.Sprintf("$ %s query %s frozen-accounts", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
+
+	return cmd
+}
+
+func GetCmdQueryEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow [escrow_id]",
+		Short:   "Query a pending escrow by its identifier",
+		Example: fmt.Sprintf("$ %s query %s escrow 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			escrowID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Escrow(cmd.Context(), &types.QueryEscrowRequest{EscrowId: escrowID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Escrow)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTopDenomsBySupply returns a CLI command handler querying the denoms
+// with the largest total supply.
+func GetCmdQueryTopDenomsBySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-denoms [limit]",
+		Short:   "Query the denoms with the largest total supply",
+		Example: fmt.Sprintf("$ %s query %s top-denoms 10", version.AppName, types.ModuleName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var limit uint64
+			if len(args) > 0 {
+				limit, err = strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TopDenomsBySupply(cmd.Context(), &types.QueryTopDenomsBySupplyRequest{Limit: uint32(limit)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDenomHolders returns a CLI command handler querying the accounts
+// holding a denom, along with their balance.
+func GetCmdQueryDenomHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-holders [denom]",
+		Short:   "Query the accounts holding a denom and their balance",
+		Example: fmt.Sprintf("$ %s query %s denom-holders stake", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomHolders(cmd.Context(), &types.QueryDenomHoldersRequest{Denom: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom holders")
+
+	return cmd
+}
+
+// GetCmdQueryTransferMemo returns a CLI command handler querying the memo of a
+// transfer sent with MsgSendWithMemo.
+func GetCmdQueryTransferMemo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-memo [address] [sequence]",
+		Short:   "Query the memo of a transfer by its sender and sequence",
+		Example: fmt.Sprintf("$ %s query %s transfer-memo cosmos1... 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TransferMemo(cmd.Context(), &types.QueryTransferMemoRequest{Address: args[0], Sequence: sequence})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.TransferMemo)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +29,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewCreateEscrowTxCmd(),
+		NewFulfillEscrowTxCmd(),
+		NewBurnCoinsTxCmd(),
+		NewSendWithMemoTxCmd(),
 	)
 
 	return txCmd
@@ -144,3 +149,148 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.`,
 
 	return cmd
 }
+
+// NewCreateEscrowTxCmd returns a CLI command handler for creating a MsgCreateEscrow transaction.
+func NewCreateEscrowTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow [from_key_or_address] [recipient_address] [send_amount] [receive_amount] [expiry_height]",
+		Short: "Hold funds in escrow until the recipient sends funds in exchange.",
+		Long: `Hold [send_amount] in escrow until [recipient_address] sends [receive_amount] in exchange,
+up to [expiry_height] included. Once expired, the funds held in escrow are refunded.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].`,
+		Example: fmt.Sprintf("%s tx bank create-escrow cosmos1... cosmos1... 10stake 20atom 1000", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			sendAmount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			receiveAmount, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateEscrow(clientCtx.GetFromAddress(), recipient, sendAmount, receiveAmount, expiryHeight)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFulfillEscrowTxCmd returns a CLI command handler for creating a MsgFulfillEscrow transaction.
+func NewFulfillEscrowTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-escrow [escrow_id]",
+		Short:   "Exchange the funds expected by an escrow with the funds held in escrow.",
+		Example: fmt.Sprintf("%s tx bank fulfill-escrow 1 --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			escrowID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFulfillEscrow(clientCtx.GetFromAddress(), escrowID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnCoinsTxCmd returns a CLI command handler for creating a MsgBurnCoins transaction.
+func NewBurnCoinsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "burn [amount]",
+		Short:   "Burn coins from your account within the limits of your burn authorization.",
+		Example: fmt.Sprintf("%s tx bank burn 100stake --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnCoins(clientCtx.GetFromAddress(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendWithMemoTxCmd returns a CLI command handler for creating a MsgSendWithMemo transaction.
+func NewSendWithMemoTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-with-memo [from_key_or_address] [to_address] [amount] [memo]",
+		Short: "Send funds from one account to another, storing a memo on-chain.",
+		Long: `Send funds from one account to another, storing a memo of up to 256 bytes describing
+the purpose of the transfer. Unlike the '--note' of the transaction, the memo is kept in the
+state and can be queried by sender and sequence until it is pruned.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].`,
+		Example: fmt.Sprintf("%s tx bank send-with-memo cosmos1... cosmos1... 10stake \"invoice 42\"", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendWithMemo(clientCtx.GetFromAddress(), toAddr, coins, args[3])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SetAuthzKeeper sets the x/authz keeper storing the burn authorizations. It
+// applies to all the copies of the keeper.
+func (k BaseKeeper) SetAuthzKeeper(authzKeeper types.AuthzKeeper) {
+	*k.authzKeeper = authzKeeper
+}
+
+// GrantBurnAuthorization authorizes the grantee to burn up to maxAmount from
+// its own account, replacing any amount of the same denom previously
+// authorized. The authorization is stored as an x/authz grant of the module
+// authority and expires after the BurnAuthorizationTimeout param, expiring
+// grants being indexed by expiry height to be pruned at end block.
+func (k BaseKeeper) GrantBurnAuthorization(ctx sdk.Context, grantee sdk.AccAddress, maxAmount sdk.Coin) error {
+	authzKeeper := *k.authzKeeper
+	if authzKeeper == nil {
+		return sdkerrors.ErrLogic.Wrap("authz keeper is not set")
+	}
+
+	granter := sdk.MustAccAddressFromBech32(k.GetAuthority())
+	msgType := sdk.MsgTypeURL(&types.MsgBurnCoins{})
+
+	maxAmounts := sdk.NewCoins(maxAmount)
+	if existing, _ := authzKeeper.GetAuthorization(ctx, grantee, granter, msgType); existing != nil {
+		if burnAuth, ok := existing.(*types.BurnAuthorization); ok {
+			k.deleteBurnAuthorizationExpiry(ctx, burnAuth.ExpiryHeight, grantee)
+			if !burnAuth.IsExpired(ctx.BlockHeight()) {
+				for _, coin := range burnAuth.MaxAmount {
+					if coin.Denom != maxAmount.Denom {
+						maxAmounts = maxAmounts.Add(coin)
+					}
+				}
+			}
+		}
+	}
+
+	var expiryHeight int64
+	if timeout := k.GetParams(ctx).BurnAuthorizationTimeout; timeout > 0 {
+		expiryHeight = ctx.BlockHeight() + int64(timeout)
+	}
+
+	if err := authzKeeper.SaveGrant(ctx, grantee, granter, types.NewBurnAuthorization(maxAmounts, expiryHeight), nil); err != nil {
+		return err
+	}
+
+	if expiryHeight > 0 {
+		ctx.KVStore(k.storeKey).Set(types.CreateBurnAuthorizationExpiryKey(expiryHeight, grantee), []byte{})
+	}
+
+	return nil
+}
+
+// BurnAuthorizedCoins burns amounts from the account of the burner, within the
+// limits of the burn authorization granted to the burner. An expired
+// authorization is deleted on use, which only persists outside of a failing
+// transaction, so expired authorizations are also pruned at end block.
+func (k BaseKeeper) BurnAuthorizedCoins(ctx sdk.Context, burner sdk.AccAddress, amounts sdk.Coins) error {
+	authzKeeper := *k.authzKeeper
+	if authzKeeper == nil {
+		return sdkerrors.ErrLogic.Wrap("authz keeper is not set")
+	}
+
+	granter := sdk.MustAccAddressFromBech32(k.GetAuthority())
+	msg := types.NewMsgBurnCoins(burner, amounts)
+	msgType := sdk.MsgTypeURL(msg)
+
+	authorization, expiration := authzKeeper.GetAuthorization(ctx, burner, granter, msgType)
+	if authorization == nil {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s has no burn authorization", burner)
+	}
+
+	burnAuth, isBurnAuth := authorization.(*types.BurnAuthorization)
+	if isBurnAuth && burnAuth.IsExpired(ctx.BlockHeight()) {
+		k.deleteBurnAuthorizationExpiry(ctx, burnAuth.ExpiryHeight, burner)
+		if err := authzKeeper.DeleteGrant(ctx, burner, granter, msgType); err != nil {
+			return err
+		}
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.Delete:
+		if isBurnAuth {
+			k.deleteBurnAuthorizationExpiry(ctx, burnAuth.ExpiryHeight, burner)
+		}
+		err = authzKeeper.DeleteGrant(ctx, burner, granter, msgType)
+	case resp.Updated != nil:
+		err = authzKeeper.SaveGrant(ctx, burner, granter, resp.Updated, expiration)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	if err := k.burnCoins(ctx, burner, amounts); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from account", "amount", amounts.String(), "from", burner.String())
+
+	return nil
+}
+
+// PruneExpiredBurnAuthorizations deletes the burn authorizations expired at the
+// current height, up to MaxBurnAuthorizationPrunesPerBlock authorizations.
+// Malformed index entries and entries of replaced grants are skipped and
+// deleted.
+func (k BaseKeeper) PruneExpiredBurnAuthorizations(ctx sdk.Context) error {
+	authzKeeper := *k.authzKeeper
+	if authzKeeper == nil {
+		return nil
+	}
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnAuthorizationExpiryPrefix)
+
+	// the keys of the expiry index are the expiry heights, big endian encoded
+	// on 8 bytes, followed by the length prefixed grantees
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	var expired [][]byte
+	for ; iterator.Valid() && len(expired) < types.MaxBurnAuthorizationPrunesPerBlock; iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	granter := sdk.MustAccAddressFromBech32(k.GetAuthority())
+	msgType := sdk.MsgTypeURL(&types.MsgBurnCoins{})
+	for _, key := range expired {
+		expiryStore.Delete(key)
+
+		grantee, err := types.GranteeFromBurnAuthorizationExpiryStore(key)
+		if err != nil {
+			k.Logger(ctx).Error("skipping a malformed burn authorization expiry entry", "err", err)
+			continue
+		}
+
+		authorization, _ := authzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+		burnAuth, ok := authorization.(*types.BurnAuthorization)
+		if !ok || !burnAuth.IsExpired(ctx.BlockHeight()) {
+			continue
+		}
+
+		if err := authzKeeper.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteBurnAuthorizationExpiry removes the burn authorization of the grantee
+// from the expiry index.
+func (k BaseKeeper) deleteBurnAuthorizationExpiry(ctx sdk.Context, expiryHeight int64, grantee sdk.AccAddress) {
+	if expiryHeight > 0 {
+		ctx.KVStore(k.storeKey).Delete(types.CreateBurnAuthorizationExpiryKey(expiryHeight, grantee))
+	}
+}
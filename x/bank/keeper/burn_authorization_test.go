@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// mockAuthzKeeper returns an authz keeper storing the burn authorization
+// granted to accAddrs[0] by the governance account.
+func (suite *KeeperTestSuite) mockAuthzKeeper() *banktestutil.MockAuthzKeeper {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgType := sdk.MsgTypeURL(&banktypes.MsgBurnCoins{})
+
+	var grant authz.Authorization
+	authzKeeper := banktestutil.NewMockAuthzKeeper(gomock.NewController(suite.T()))
+	authzKeeper.EXPECT().GetAuthorization(gomock.Any(), gomock.Any(), authority, msgType).DoAndReturn(
+		func(_ sdk.Context, grantee, _ sdk.AccAddress, _ string) (authz.Authorization, *time.Time) {
+			if !grantee.Equals(accAddrs[0]) {
+				return nil, nil
+			}
+			return grant, nil
+		}).AnyTimes()
+	authzKeeper.EXPECT().SaveGrant(gomock.Any(), accAddrs[0], authority, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _, _ sdk.AccAddress, authorization authz.Authorization, _ *time.Time) error {
+			grant = authorization
+			return nil
+		}).AnyTimes()
+	authzKeeper.EXPECT().DeleteGrant(gomock.Any(), accAddrs[0], authority, msgType).DoAndReturn(
+		func(sdk.Context, sdk.AccAddress, sdk.AccAddress, string) error {
+			grant = nil
+			return nil
+		}).AnyTimes()
+
+	return authzKeeper
+}
+
+func (suite *KeeperTestSuite) TestBurnAuthorization() {
+	require := suite.Require()
+	ctx := suite.ctx.WithBlockHeight(10)
+	keeper := suite.bankKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	require.ErrorIs(keeper.GrantBurnAuthorization(ctx, accAddrs[0], newFooCoin(50)), sdkerrors.ErrLogic)
+
+	authzKeeper := suite.mockAuthzKeeper()
+	keeper.SetAuthzKeeper(authzKeeper)
+
+	params := banktypes.DefaultParams()
+	params.BurnAuthorizationTimeout = 5
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err := suite.msgServer.GrantBurnAuthorization(ctx, banktypes.NewMsgGrantBurnAuthorization(accAddrs[1].String(), accAddrs[0], newFooCoin(50)))
+	require.Error(err)
+
+	_, err = suite.msgServer.GrantBurnAuthorization(ctx, banktypes.NewMsgGrantBurnAuthorization(authority.String(), accAddrs[0], newFooCoin(50)))
+	require.NoError(err)
+	require.NoError(keeper.GrantBurnAuthorization(ctx, accAddrs[0], newBarCoin(20)))
+	require.NoError(keeper.GrantBurnAuthorization(ctx, accAddrs[0], newFooCoin(40)))
+
+	grant, _ := authzKeeper.GetAuthorization(ctx, accAddrs[0], authority, sdk.MsgTypeURL(&banktypes.MsgBurnCoins{}))
+	require.Equal(banktypes.NewBurnAuthorization(sdk.NewCoins(newBarCoin(20), newFooCoin(40)), 15), grant)
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, suite.ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(20))))
+
+	require.ErrorIs(keeper.BurnAuthorizedCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(60))), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(keeper.BurnAuthorizedCoins(ctx, accAddrs[1], sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.NoError(keeper.BurnAuthorizedCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(40))))
+	require.Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(20)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(newFooCoin(60), keeper.GetSupply(ctx, fooDenom))
+
+	grant, _ = authzKeeper.GetAuthorization(ctx, accAddrs[0], authority, sdk.MsgTypeURL(&banktypes.MsgBurnCoins{}))
+	require.Equal(banktypes.NewBurnAuthorization(sdk.NewCoins(newBarCoin(20)), 15), grant)
+
+	ctx = ctx.WithBlockHeight(15)
+	suite.mockSpendableCoins(ctx, acc0)
+	_, err = suite.msgServer.BurnCoins(ctx, banktypes.NewMsgBurnCoins(accAddrs[0], sdk.NewCoins(newBarCoin(20))))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(60)), keeper.GetAllBalances(ctx, accAddrs[0]))
+
+	require.ErrorIs(keeper.BurnAuthorizedCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredBurnAuthorizations() {
+	require := suite.Require()
+	ctx := suite.ctx.WithBlockHeight(10)
+	keeper := suite.bankKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgType := sdk.MsgTypeURL(&banktypes.MsgBurnCoins{})
+	store := ctx.KVStore(suite.storeKey)
+
+	require.NoError(keeper.PruneExpiredBurnAuthorizations(ctx))
+
+	authzKeeper := suite.mockAuthzKeeper()
+	keeper.SetAuthzKeeper(authzKeeper)
+
+	params := banktypes.DefaultParams()
+	params.BurnAuthorizationTimeout = 5
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// replacing a grant moves it in the expiry index
+	require.NoError(keeper.GrantBurnAuthorization(ctx, accAddrs[0], newFooCoin(50)))
+	require.True(store.Has(banktypes.CreateBurnAuthorizationExpiryKey(15, accAddrs[0])))
+	require.NoError(keeper.GrantBurnAuthorization(ctx.WithBlockHeight(12), accAddrs[0], newFooCoin(40)))
+	require.False(store.Has(banktypes.CreateBurnAuthorizationExpiryKey(15, accAddrs[0])))
+	require.True(store.Has(banktypes.CreateBurnAuthorizationExpiryKey(17, accAddrs[0])))
+
+	// a malformed entry is deleted without stopping the sweep
+	malformedKey := append(banktypes.CreateBurnAuthorizationExpiryPrefix(16), 0xFF)
+	store.Set(malformedKey, []byte{})
+
+	require.NoError(keeper.PruneExpiredBurnAuthorizations(ctx.WithBlockHeight(17)))
+	grant, _ := authzKeeper.GetAuthorization(ctx, accAddrs[0], authority, msgType)
+	require.NotNil(grant)
+	require.False(store.Has(malformedKey))
+
+	require.NoError(keeper.PruneExpiredBurnAuthorizations(ctx.WithBlockHeight(18)))
+	grant, _ = authzKeeper.GetAuthorization(ctx, accAddrs[0], authority, msgType)
+	require.Nil(grant)
+	require.False(store.Has(banktypes.CreateBurnAuthorizationExpiryKey(17, accAddrs[0])))
+
+	// an expired grant is deleted on use
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(keeper.GrantBurnAuthorization(ctx, accAddrs[0], newFooCoin(50)))
+	require.ErrorIs(keeper.BurnAuthorizedCoins(ctx.WithBlockHeight(26), accAddrs[0], sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+	grant, _ = authzKeeper.GetAuthorization(ctx, accAddrs[0], authority, msgType)
+	require.Nil(grant)
+	require.False(store.Has(banktypes.CreateBurnAuthorizationExpiryKey(25, accAddrs[0])))
+}
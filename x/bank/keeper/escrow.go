@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateEscrow holds sendAmount of the sender in escrow until the recipient
+// sends receiveAmount to the sender, and returns the identifier of the escrow.
+// The escrow can be fulfilled up to expiryHeight included, after which the
+// coins held in escrow are refunded to the sender.
+func (k BaseKeeper) CreateEscrow(
+	ctx sdk.Context, sender, recipient sdk.AccAddress, sendAmount, receiveAmount sdk.Coins, expiryHeight int64,
+) (uint64, error) {
+	if expiryHeight < ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidEscrow, "expiry height %d is before the current height %d", expiryHeight, ctx.BlockHeight())
+	}
+
+	if err := k.SendCoins(ctx, sender, types.EscrowAddress, sendAmount); err != nil {
+		return 0, err
+	}
+
+	escrow := types.Escrow{
+		Id:            k.getNextEscrowID(ctx),
+		Sender:        sender.String(),
+		Recipient:     recipient.String(),
+		SendAmount:    sendAmount,
+		ReceiveAmount: receiveAmount,
+		ExpiryHeight:  expiryHeight,
+	}
+	k.setEscrow(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(escrow.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, escrow.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, escrow.Recipient),
+		),
+	)
+
+	return escrow.Id, nil
+}
+
+// FulfillEscrow atomically sends the receive amount of an escrow from its
+// recipient to its sender, and releases the coins held in escrow to its
+// recipient.
+func (k BaseKeeper) FulfillEscrow(ctx sdk.Context, id uint64, recipient sdk.AccAddress) error {
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrEscrowNotFound, "escrow %d", id)
+	}
+
+	if escrow.Recipient != recipient.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the recipient of escrow %d", recipient, id)
+	}
+
+	if ctx.BlockHeight() > escrow.ExpiryHeight {
+		return sdkerrors.Wrapf(types.ErrInvalidEscrow, "escrow %d expired at height %d", id, escrow.ExpiryHeight)
+	}
+
+	if err := k.SendCoins(ctx, recipient, sdk.MustAccAddressFromBech32(escrow.Sender), escrow.ReceiveAmount); err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, types.EscrowAddress, recipient, escrow.SendAmount); err != nil {
+		return err
+	}
+
+	k.deleteEscrow(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFulfillEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+		),
+	)
+
+	return nil
+}
+
+// RefundExpiredEscrows refunds the coins held in escrow to the senders of the
+// escrows expired at the current height, or before, up to
+// MaxEscrowRefundsPerBlock escrows. The escrows whose refund fails are skipped
+// and retried at the next height, after the other expired escrows.
+func (k BaseKeeper) RefundExpiredEscrows(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	expiryStore := prefix.NewStore(store, types.EscrowExpiryPrefix)
+
+	// the keys of the expiry index are the expiry heights followed by the
+	// identifiers, both big endian encoded on 8 bytes
+	iterator := expiryStore.Iterator(nil, sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))))
+	var expired [][]byte
+	for ; iterator.Valid() && len(expired) < types.MaxEscrowRefundsPerBlock; iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		id := sdk.BigEndianToUint64(key[8:])
+		expiryStore.Delete(key)
+
+		escrow, found := k.GetEscrow(ctx, id)
+		if !found {
+			k.Logger(ctx).Error("skipping the refund of an unknown escrow", "id", id)
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SendCoins(cacheCtx, types.EscrowAddress, sdk.MustAccAddressFromBech32(escrow.Sender), escrow.SendAmount); err != nil {
+			k.Logger(ctx).Error("failed to refund an expired escrow", "id", id, "err", err)
+			// an expired escrow can no longer be fulfilled, so it is only
+			// reindexed to retry its refund
+			store.Set(types.CreateEscrowExpiryKey(ctx.BlockHeight()+1, id), []byte{})
+			continue
+		}
+		write()
+
+		store.Delete(types.CreateEscrowKey(id))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundEscrow,
+				sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// GetEscrow returns the escrow of the given identifier and true if it exists,
+// false otherwise.
+func (k BaseKeeper) GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateEscrowKey(id))
+	if bz == nil {
+		return types.Escrow{}, false
+	}
+
+	var escrow types.Escrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+
+	return escrow, true
+}
+
+// IterateEscrows iterates over all the pending escrows.
+func (k BaseKeeper) IterateEscrows(ctx sdk.Context, cb func(escrow types.Escrow) (stop bool)) {
+	escrowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowPrefix)
+
+	iterator := escrowStore.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.Escrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// GetAllEscrows returns all the pending escrows.
+func (k BaseKeeper) GetAllEscrows(ctx sdk.Context) []types.Escrow {
+	var escrows []types.Escrow
+	k.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+
+	return escrows
+}
+
+// setEscrow stores an escrow and indexes it by expiry height. The identifier of
+// the next escrow is bumped past the identifier of the escrow if needed.
+func (k BaseKeeper) setEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateEscrowKey(escrow.Id), k.cdc.MustMarshal(&escrow))
+	store.Set(types.CreateEscrowExpiryKey(escrow.ExpiryHeight, escrow.Id), []byte{})
+
+	if escrow.Id >= k.getNextEscrowID(ctx) {
+		store.Set(types.NextEscrowIDKey, sdk.Uint64ToBigEndian(escrow.Id+1))
+	}
+}
+
+func (k BaseKeeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateEscrowKey(escrow.Id))
+	store.Delete(types.CreateEscrowExpiryKey(escrow.ExpiryHeight, escrow.Id))
+}
+
+// getNextEscrowID returns the identifier of the next escrow, starting at 1. It
+// is reserved by setEscrow when the escrow is stored.
+func (k BaseKeeper) getNextEscrowID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextEscrowIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrow() {
+	require := suite.Require()
+	ctx := suite.ctx.WithBlockHeight(10)
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	escrowAcc := authtypes.NewBaseAccountWithAddress(banktypes.EscrowAddress)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, suite.ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(keeper, suite.ctx, accAddrs[1], sdk.NewCoins(newBarCoin(100))))
+
+	sendAmount, receiveAmount := sdk.NewCoins(newFooCoin(30)), sdk.NewCoins(newBarCoin(20))
+
+	_, err := keeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], sendAmount, receiveAmount, 9)
+	require.ErrorIs(err, banktypes.ErrInvalidEscrow)
+
+	suite.mockSendCoins(ctx, acc0, banktypes.EscrowAddress)
+	id, err := keeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], sendAmount, receiveAmount, 12)
+	require.NoError(err)
+	require.Equal(uint64(1), id)
+	require.Equal(sdk.NewCoins(newFooCoin(70)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sendAmount, keeper.GetAllBalances(ctx, banktypes.EscrowAddress))
+
+	res, err := suite.queryClient.Escrow(sdk.WrapSDKContext(ctx), &banktypes.QueryEscrowRequest{EscrowId: id})
+	require.NoError(err)
+	require.Equal(banktypes.Escrow{
+		Id:            id,
+		Sender:        accAddrs[0].String(),
+		Recipient:     accAddrs[1].String(),
+		SendAmount:    sendAmount,
+		ReceiveAmount: receiveAmount,
+		ExpiryHeight:  12,
+	}, res.Escrow)
+
+	require.ErrorIs(keeper.FulfillEscrow(ctx, id+1, accAddrs[1]), banktypes.ErrEscrowNotFound)
+	require.ErrorIs(keeper.FulfillEscrow(ctx, id, accAddrs[0]), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(keeper.FulfillEscrow(ctx.WithBlockHeight(13), id, accAddrs[1]), banktypes.ErrInvalidEscrow)
+
+	suite.mockSendCoins(ctx, acc1, accAddrs[0])
+	suite.mockSendCoins(ctx, escrowAcc, accAddrs[1])
+	require.NoError(keeper.FulfillEscrow(ctx, id, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(20)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(80)), keeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(keeper.GetAllBalances(ctx, banktypes.EscrowAddress).IsZero())
+
+	_, found := keeper.GetEscrow(ctx, id)
+	require.False(found)
+
+	// escrows are refunded at the end of their expiry height
+	suite.mockSendCoins(ctx, acc0, banktypes.EscrowAddress)
+	id, err = keeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], sendAmount, receiveAmount, 12)
+	require.NoError(err)
+	require.Equal(uint64(2), id)
+
+	require.NoError(keeper.RefundExpiredEscrows(ctx.WithBlockHeight(11)))
+	_, found = keeper.GetEscrow(ctx, id)
+	require.True(found)
+
+	// a failed refund is skipped and retried at the next height
+	keeper.FreezeAccount(ctx, banktypes.EscrowAddress, fooDenom)
+	require.NoError(keeper.RefundExpiredEscrows(ctx.WithBlockHeight(12)))
+	_, found = keeper.GetEscrow(ctx, id)
+	require.True(found)
+	require.Equal(sendAmount, keeper.GetAllBalances(ctx, banktypes.EscrowAddress))
+	keeper.UnfreezeAccount(ctx, banktypes.EscrowAddress, fooDenom)
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), banktypes.EscrowAddress).Return(escrowAcc)
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[0]).Return(true)
+	require.NoError(keeper.RefundExpiredEscrows(ctx.WithBlockHeight(13)))
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(20)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Empty(keeper.GetAllEscrows(ctx))
+}
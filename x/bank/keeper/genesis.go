@@ -42,6 +42,18 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, fa := range genState.FrozenAccounts {
+		k.FreezeAccount(ctx, sdk.MustAccAddressFromBech32(fa.Address), fa.Denom)
+	}
+
+	for _, escrow := range genState.Escrows {
+		k.setEscrow(ctx, escrow)
+	}
+
+	for _, memo := range genState.TransferMemos {
+		k.setTransferMemo(ctx, memo)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -58,5 +70,8 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.FrozenAccounts = k.GetAllFrozenAccounts(ctx)
+	rv.Escrows = k.GetAllEscrows(ctx)
+	rv.TransferMemos = k.GetAllTransferMemos(ctx)
 	return rv
 }
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -36,6 +38,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	}
 
 	suite.Require().NoError(suite.bankKeeper.SetParams(ctx, types.DefaultParams()))
+	suite.bankKeeper.FreezeAccount(ctx, accAddrs[0], "testcoin1")
 
 	exportGenesis := suite.bankKeeper.ExportGenesis(ctx)
 
@@ -44,6 +47,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expTotalSupply, exportGenesis.Supply)
 	suite.Require().Subset(exportGenesis.Balances, expectedBalances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
+	suite.Require().Equal([]types.FrozenAccount{types.NewFrozenAccount(accAddrs[0], "testcoin1")}, exportGenesis.FrozenAccounts)
 }
 
 func (suite *KeeperTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
@@ -73,6 +77,15 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *KeeperTestSuite) TestInitGenesisFrozenAccounts() {
+	g := types.DefaultGenesisState()
+	g.FrozenAccounts = []types.FrozenAccount{types.NewFrozenAccount(accAddrs[0], fooDenom)}
+	suite.bankKeeper.InitGenesis(suite.ctx, g)
+
+	suite.Require().True(suite.bankKeeper.IsAccountFrozen(suite.ctx, accAddrs[0], fooDenom))
+	suite.Require().False(suite.bankKeeper.IsAccountFrozen(suite.ctx, accAddrs[0], barDenom))
+}
+
 func (suite *KeeperTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
@@ -127,3 +140,51 @@ func (suite *KeeperTestSuite) TestTotalSupply() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInitGenesisEscrows() {
+	escrow := types.Escrow{
+		Id:            5,
+		Sender:        accAddrs[0].String(),
+		Recipient:     accAddrs[1].String(),
+		SendAmount:    sdk.NewCoins(newFooCoin(10)),
+		ReceiveAmount: sdk.NewCoins(newBarCoin(10)),
+		ExpiryHeight:  100,
+	}
+	g := types.DefaultGenesisState()
+	g.Escrows = []types.Escrow{escrow}
+	suite.bankKeeper.InitGenesis(suite.ctx, g)
+
+	suite.Require().Equal([]types.Escrow{escrow}, suite.bankKeeper.ExportGenesis(suite.ctx).Escrows)
+
+	// the identifiers of the imported escrows are not reused
+	suite.mockSendCoins(suite.ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), types.EscrowAddress)
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[0], escrow.SendAmount))
+	id, err := suite.bankKeeper.CreateEscrow(suite.ctx, accAddrs[0], accAddrs[1], escrow.SendAmount, escrow.ReceiveAmount, 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(6), id)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisTransferMemos() {
+	memo := types.TransferMemo{
+		FromAddress: accAddrs[0].String(),
+		Sequence:    5,
+		ToAddress:   accAddrs[1].String(),
+		Amount:      sdk.NewCoins(newFooCoin(10)),
+		Memo:        "invoice 42",
+		Height:      100,
+	}
+	g := types.DefaultGenesisState()
+	g.TransferMemos = []types.TransferMemo{memo}
+	suite.bankKeeper.InitGenesis(suite.ctx, g)
+
+	suite.Require().Equal([]types.TransferMemo{memo}, suite.bankKeeper.ExportGenesis(suite.ctx).TransferMemos)
+
+	// the sequences of the imported transfer memos are not reused
+	suite.mockSendCoins(suite.ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[1])
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[0], memo.Amount))
+	sequence, err := suite.bankKeeper.SendCoinsWithMemo(suite.ctx, accAddrs[0], accAddrs[1], memo.Amount, "invoice 43")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(6), sequence)
+}
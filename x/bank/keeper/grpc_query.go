@@ -216,6 +216,22 @@ func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetada
 	}, nil
 }
 
+// DenomSupplyCap implements Query/DenomSupplyCap gRPC method
+func (k BaseKeeper) DenomSupplyCap(c context.Context, req *types.QueryDenomSupplyCapRequest) (*types.QueryDenomSupplyCapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	supplyCap, _ := k.GetDenomSupplyCap(ctx, req.Denom)
+
+	return &types.QueryDenomSupplyCapResponse{SupplyCap: supplyCap}, nil
+}
+
 func (k BaseKeeper) DenomOwners(
 	goCtx context.Context,
 	req *types.QueryDenomOwnersRequest,
@@ -298,3 +314,121 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 
 	return resp, nil
 }
+
+// FrozenAccounts implements Query/FrozenAccounts gRPC method
+func (k BaseKeeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	frozenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenAccountPrefix)
+
+	var frozenAccounts []types.FrozenAccount
+	pageRes, err := query.Paginate(frozenStore, req.Pagination, func(key, _ []byte) error {
+		addr, denom, err := types.AddressAndDenomFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+
+		frozenAccounts = append(frozenAccounts, types.NewFrozenAccount(addr, denom))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{FrozenAccounts: frozenAccounts, Pagination: pageRes}, nil
+}
+
+// Escrow implements Query/Escrow gRPC method
+func (k BaseKeeper) Escrow(c context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	escrow, found := k.GetEscrow(ctx, req.EscrowId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "escrow %d", req.EscrowId)
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+// TopDenomsBySupply implements Query/TopDenomsBySupply gRPC method
+func (k BaseKeeper) TopDenomsBySupply(c context.Context, req *types.QueryTopDenomsBySupplyRequest) (*types.QueryTopDenomsBySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	supplies := k.GetTopDenomsBySupply(ctx, limit)
+
+	ranks := make([]types.DenomSupplyRank, len(supplies))
+	for i, supply := range supplies {
+		ranks[i] = types.DenomSupplyRank{Rank: uint32(i + 1), Supply: supply}
+	}
+
+	return &types.QueryTopDenomsBySupplyResponse{Ranks: ranks}, nil
+}
+
+// DenomHolders implements Query/DenomHolders gRPC method
+func (k BaseKeeper) DenomHolders(c context.Context, req *types.QueryDenomHoldersRequest) (*types.QueryDenomHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomPrefixStore := k.getDenomAddressPrefixStore(ctx, req.Denom)
+
+	var holders []types.DenomHolder
+	pageRes, err := query.Paginate(denomPrefixStore, req.Pagination, func(key, value []byte) error {
+		address, _, err := types.AddressAndDenomFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+
+		var amount math.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+
+		holders = append(holders, types.DenomHolder{Address: address.String(), Amount: amount})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+// TransferMemo implements Query/TransferMemo gRPC method
+func (k BaseKeeper) TransferMemo(c context.Context, req *types.QueryTransferMemoRequest) (*types.QueryTransferMemoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	memo, found := k.GetTransferMemo(ctx, sender, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "transfer memo %d of %s", req.Sequence, req.Address)
+	}
+
+	return &types.QueryTransferMemoResponse{TransferMemo: memo}, nil
+}
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -422,6 +424,93 @@ func (suite *KeeperTestSuite) QueryDenomMetadataRequest() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomSupplyCap() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	_, err := suite.queryClient.DenomSupplyCap(ctx, &types.QueryDenomSupplyCapRequest{})
+	require.Error(err)
+
+	metadata := suite.getTestMetadata()
+	supplyCap := sdk.NewInt(1000)
+	metadata[0].SupplyCap = &supplyCap
+	suite.bankKeeper.SetDenomMetaData(suite.ctx, metadata[0])
+	suite.bankKeeper.SetDenomMetaData(suite.ctx, metadata[1])
+
+	res, err := suite.queryClient.DenomSupplyCap(ctx, &types.QueryDenomSupplyCapRequest{Denom: metadata[0].Base})
+	require.NoError(err)
+	require.Equal(supplyCap, res.SupplyCap)
+
+	res, err = suite.queryClient.DenomSupplyCap(ctx, &types.QueryDenomSupplyCapRequest{Denom: metadata[1].Base})
+	require.NoError(err)
+	require.True(res.SupplyCap.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestQueryTopDenomsBySupply() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, multiPerm, sdk.NewCoins(newFooCoin(100), newBarCoin(300), sdk.NewInt64Coin("baz", 200))))
+
+	res, err := suite.queryClient.TopDenomsBySupply(ctx, &types.QueryTopDenomsBySupplyRequest{})
+	require.NoError(err)
+	require.Equal([]types.DenomSupplyRank{
+		{Rank: 1, Supply: newBarCoin(300)},
+		{Rank: 2, Supply: sdk.NewInt64Coin("baz", 200)},
+		{Rank: 3, Supply: newFooCoin(100)},
+	}, res.Ranks)
+
+	// the index is updated when the supply changes
+	suite.mockBurnCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.BurnCoins(suite.ctx, multiPerm, sdk.NewCoins(newBarCoin(250))))
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, multiPerm, sdk.NewCoins(newFooCoin(200))))
+
+	res, err = suite.queryClient.TopDenomsBySupply(ctx, &types.QueryTopDenomsBySupplyRequest{Limit: 2})
+	require.NoError(err)
+	require.Equal([]types.DenomSupplyRank{
+		{Rank: 1, Supply: newFooCoin(300)},
+		{Rank: 2, Supply: sdk.NewInt64Coin("baz", 200)},
+	}, res.Ranks)
+
+	suite.mockBurnCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.BurnCoins(suite.ctx, multiPerm, sdk.NewCoins(newFooCoin(300), sdk.NewInt64Coin("baz", 200))))
+
+	res, err = suite.queryClient.TopDenomsBySupply(ctx, &types.QueryTopDenomsBySupplyRequest{})
+	require.NoError(err)
+	require.Equal([]types.DenomSupplyRank{{Rank: 1, Supply: newBarCoin(50)}}, res.Ranks)
+
+	// malformed entries of the index are skipped
+	rankStore := prefix.NewStore(suite.ctx.KVStore(suite.storeKey), types.SupplyRankPrefix)
+	rankStore.Set(bytes.Repeat([]byte{0xFF}, 8), []byte{})
+
+	res, err = suite.queryClient.TopDenomsBySupply(ctx, &types.QueryTopDenomsBySupplyRequest{})
+	require.NoError(err)
+	require.Equal([]types.DenomSupplyRank{{Rank: 1, Supply: newBarCoin(50)}}, res.Ranks)
+}
+
+func (suite *KeeperTestSuite) TestQueryFrozenAccounts() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	suite.bankKeeper.FreezeAccount(suite.ctx, accAddrs[0], fooDenom, barDenom)
+	suite.bankKeeper.FreezeAccount(suite.ctx, accAddrs[1], fooDenom)
+
+	res, err := suite.queryClient.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{})
+	require.NoError(err)
+	require.ElementsMatch([]types.FrozenAccount{
+		types.NewFrozenAccount(accAddrs[0], fooDenom),
+		types.NewFrozenAccount(accAddrs[0], barDenom),
+		types.NewFrozenAccount(accAddrs[1], fooDenom),
+	}, res.FrozenAccounts)
+
+	res, err = suite.queryClient.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(err)
+	require.Len(res.FrozenAccounts, 2)
+	require.Equal(uint64(3), res.Pagination.Total)
+}
+
 func (suite *KeeperTestSuite) TestGRPCDenomOwners() {
 	ctx := suite.ctx
 
@@ -513,6 +602,62 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwners() {
 	suite.Require().True(true)
 }
 
+func (suite *KeeperTestSuite) TestGRPCDenomHolders() {
+	ctx := suite.ctx
+
+	keeper := suite.bankKeeper
+
+	suite.mockMintCoins(mintAcc)
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("account-%d", i)))
+
+		bal := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(i+1)))
+		suite.mockSendCoinsFromModuleToAccount(mintAcc, addr)
+		suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, bal))
+	}
+
+	_, err := suite.queryClient.DenomHolders(gocontext.Background(), &types.QueryDenomHoldersRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.DenomHolders(gocontext.Background(), &types.QueryDenomHoldersRequest{Denom: "foo"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Holders)
+
+	res, err = suite.queryClient.DenomHolders(gocontext.Background(), &types.QueryDenomHoldersRequest{
+		Denom:      sdk.DefaultBondDenom,
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Holders, 3)
+	// the mint module account holds the rest of the minted coins
+	suite.Require().Equal(uint64(6), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.DenomHolders(gocontext.Background(), &types.QueryDenomHoldersRequest{
+		Denom:      sdk.DefaultBondDenom,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Holders, 3)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// the amounts follow the balance updates
+	addr := sdk.AccAddress([]byte("account-0"))
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, addr)
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+
+	res, err = suite.queryClient.DenomHolders(gocontext.Background(), &types.QueryDenomHoldersRequest{Denom: sdk.DefaultBondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Holders, 6)
+	for _, holder := range res.Holders {
+		holderAddr, err := sdk.AccAddressFromBech32(holder.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(keeper.GetBalance(ctx, holderAddr, sdk.DefaultBondDenom).Amount, holder.Amount)
+	}
+}
+
 func (suite *KeeperTestSuite) TestQuerySendEnabled() {
 	ctx, bankKeeper := suite.ctx, suite.bankKeeper
 
@@ -22,6 +22,8 @@ var _ Keeper = (*BaseKeeper)(nil)
 type Keeper interface {
 	SendKeeper
 	WithMintCoinsRestriction(MintingRestrictionFn) BaseKeeper
+	SetAuthzKeeper(types.AuthzKeeper)
+	SetDistributionKeeper(types.DistributionKeeper)
 
 	InitGenesis(sdk.Context, *types.GenesisState)
 	ExportGenesis(sdk.Context) *types.GenesisState
@@ -30,7 +32,27 @@ type Keeper interface {
 	HasSupply(ctx sdk.Context, denom string) bool
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	GetTopDenomsBySupply(ctx sdk.Context, limit uint32) []sdk.Coin
 	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	GetDenomSupplyCap(ctx sdk.Context, denom string) (math.Int, bool)
+
+	CreateEscrow(ctx sdk.Context, sender, recipient sdk.AccAddress, sendAmount, receiveAmount sdk.Coins, expiryHeight int64) (uint64, error)
+	FulfillEscrow(ctx sdk.Context, id uint64, recipient sdk.AccAddress) error
+	RefundExpiredEscrows(ctx sdk.Context) error
+	GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, bool)
+	IterateEscrows(ctx sdk.Context, cb func(escrow types.Escrow) (stop bool))
+	GetAllEscrows(ctx sdk.Context) []types.Escrow
+	SendCoinsWithTransferTax(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
+	InputOutputCoinsWithTransferTax(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
+	SendCoinsWithMemo(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, memo string) (uint64, error)
+	RecordTransferMemo(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, memo string) (uint64, error)
+	PruneTransferMemos(ctx sdk.Context) error
+	GetTransferMemo(ctx sdk.Context, sender sdk.AccAddress, sequence uint64) (types.TransferMemo, bool)
+	IterateTransferMemos(ctx sdk.Context, cb func(memo types.TransferMemo) (stop bool))
+	GetAllTransferMemos(ctx sdk.Context) []types.TransferMemo
+	GrantBurnAuthorization(ctx sdk.Context, grantee sdk.AccAddress, maxAmount sdk.Coin) error
+	BurnAuthorizedCoins(ctx sdk.Context, burner sdk.AccAddress, amounts sdk.Coins) error
+	PruneExpiredBurnAuthorizations(ctx sdk.Context) error
 	HasDenomMetaData(ctx sdk.Context, denom string) bool
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	GetAllDenomMetaData(ctx sdk.Context) []types.Metadata
@@ -58,6 +80,13 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	storeKey               storetypes.StoreKey
 	mintCoinsRestrictionFn MintingRestrictionFn
+
+	// authzKeeper is shared by all the copies of the keeper so that it can be
+	// set after they are handed out to other modules, see SetAuthzKeeper.
+	authzKeeper *types.AuthzKeeper
+	// distrKeeper is shared by all the copies of the keeper so that it can be
+	// set after they are handed out to other modules, see SetDistributionKeeper.
+	distrKeeper *types.DistributionKeeper
 }
 
 type MintingRestrictionFn func(ctx sdk.Context, coins sdk.Coins) error
@@ -110,6 +139,8 @@ func NewBaseKeeper(
 		cdc:                    cdc,
 		storeKey:               storeKey,
 		mintCoinsRestrictionFn: func(ctx sdk.Context, coins sdk.Coins) error { return nil },
+		authzKeeper:            new(types.AuthzKeeper),
+		distrKeeper:            new(types.DistributionKeeper),
 	}
 }
 
@@ -263,6 +294,17 @@ func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metad
 	return metadata, true
 }
 
+// GetDenomSupplyCap returns the supply cap of the given denom and true if its
+// supply is capped, false otherwise.
+func (k BaseKeeper) GetDenomSupplyCap(ctx sdk.Context, denom string) (math.Int, bool) {
+	metadata, found := k.GetDenomMetaData(ctx, denom)
+	if !found || !metadata.HasSupplyCap() {
+		return math.ZeroInt(), false
+	}
+
+	return *metadata.SupplyCap, true
+}
+
 // HasDenomMetaData checks if the denomination metadata exists in store.
 func (k BaseKeeper) HasDenomMetaData(ctx sdk.Context, denom string) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -301,8 +343,14 @@ func (k BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metad
 	}
 }
 
-// SetDenomMetaData sets the denominations metadata
+// SetDenomMetaData sets the denominations metadata. The supply cap of a denom
+// is immutable: it can only be set along with the first metadata of the denom,
+// and is kept as is when the metadata is updated.
 func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
+	if existing, found := k.GetDenomMetaData(ctx, denomMetaData.Base); found {
+		denomMetaData.SupplyCap = existing.SupplyCap
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	denomMetaDataStore := prefix.NewStore(store, types.DenomMetadataPrefix)
 
@@ -412,14 +460,20 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
 	}
 
+	supplies := make([]sdk.Coin, len(amounts))
+	for i, amount := range amounts {
+		supplies[i] = k.GetSupply(ctx, amount.GetDenom()).Add(amount)
+		if supplyCap, ok := k.GetDenomSupplyCap(ctx, amount.GetDenom()); ok && supplies[i].Amount.GT(supplyCap) {
+			return sdkerrors.Wrapf(types.ErrSupplyCapExceeded, "minting %s would exceed the supply cap %s%s", amount, supplyCap, amount.Denom)
+		}
+	}
+
 	err = k.addCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
 	}
 
-	for _, amount := range amounts {
-		supply := k.GetSupply(ctx, amount.GetDenom())
-		supply = supply.Add(amount)
+	for _, supply := range supplies {
 		k.setSupply(ctx, supply)
 	}
 
@@ -446,7 +500,19 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	if err := k.burnCoins(ctx, acc.GetAddress(), amounts); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+
+	return nil
+}
+
+// burnCoins removes amounts from the balance of addr and from the supply.
+func (k BaseKeeper) burnCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	err := k.subUnlockedCoins(ctx, addr, amounts)
 	if err != nil {
 		return err
 	}
@@ -457,12 +523,9 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		k.setSupply(ctx, supply)
 	}
 
-	logger := k.Logger(ctx)
-	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
-
 	// emit burn event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
+		types.NewCoinBurnEvent(addr, amounts),
 	)
 
 	return nil
@@ -478,12 +541,42 @@ func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	store := ctx.KVStore(k.storeKey)
 	supplyStore := prefix.NewStore(store, types.SupplyKey)
 
+	// update the supply rank index
+	if oldSupply := k.GetSupply(ctx, coin.GetDenom()); !oldSupply.IsZero() {
+		store.Delete(types.CreateSupplyRankKey(oldSupply))
+	}
+
 	// Bank invariants and IBC requires to remove zero coins.
 	if coin.IsZero() {
 		supplyStore.Delete(conv.UnsafeStrToBytes(coin.GetDenom()))
 	} else {
 		supplyStore.Set([]byte(coin.GetDenom()), intBytes)
+		store.Set(types.CreateSupplyRankKey(coin), []byte{})
+	}
+}
+
+// GetTopDenomsBySupply returns the supply of the limit denoms with the largest
+// supply, in descending order of supply. Malformed entries of the supply rank
+// index are logged and skipped.
+func (k BaseKeeper) GetTopDenomsBySupply(ctx sdk.Context, limit uint32) []sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	rankStore := prefix.NewStore(store, types.SupplyRankPrefix)
+
+	iterator := rankStore.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	var supplies []sdk.Coin
+	for ; iterator.Valid() && len(supplies) < int(limit); iterator.Next() {
+		supply, err := types.SupplyFromSupplyRankStore(iterator.Key())
+		if err != nil {
+			k.Logger(ctx).Error("skipping a malformed supply rank entry", "key", fmt.Sprintf("%X", iterator.Key()), "err", err)
+			continue
+		}
+
+		supplies = append(supplies, supply)
 	}
+
+	return supplies
 }
 
 // trackDelegation tracks the delegation of the given account if it is a vesting account
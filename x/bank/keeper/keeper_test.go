@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -71,6 +72,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx        sdk.Context
+	storeKey   *storetypes.KVStoreKey
 	bankKeeper keeper.BaseKeeper
 	authKeeper *banktestutil.MockAccountKeeper
 
@@ -95,6 +97,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	authKeeper := banktestutil.NewMockAccountKeeper(ctrl)
 
 	suite.ctx = ctx
+	suite.storeKey = key
 	suite.authKeeper = authKeeper
 	suite.bankKeeper = keeper.NewBaseKeeper(
 		encCfg.Codec,
@@ -367,6 +370,41 @@ func (suite *KeeperTestSuite) TestSupply_MintCoins() {
 	require.Equal(initialSupply.Add(initCoins...), totalSupply)
 }
 
+func (suite *KeeperTestSuite) TestSupply_MintCoinsSupplyCap() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	metadata := suite.getTestMetadata()[0]
+	supplyCap := math.NewInt(100)
+	metadata.SupplyCap = &supplyCap
+	keeper.SetDenomMetaData(ctx, metadata)
+
+	actualCap, found := keeper.GetDenomSupplyCap(ctx, metadata.Base)
+	require.True(found)
+	require.Equal(supplyCap, actualCap)
+
+	// the supply cap cannot be changed once set
+	metadata.SupplyCap = nil
+	keeper.SetDenomMetaData(ctx, metadata)
+	actualCap, found = keeper.GetDenomSupplyCap(ctx, metadata.Base)
+	require.True(found)
+	require.Equal(supplyCap, actualCap)
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(sdk.NewInt64Coin(metadata.Base, 60))))
+
+	suite.mockMintCoins(minterAcc)
+	err := keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(sdk.NewInt64Coin(metadata.Base, 41), sdk.NewInt64Coin("uncapped", 10)))
+	require.ErrorIs(err, banktypes.ErrSupplyCapExceeded)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(metadata.Base, 60)), keeper.GetAllBalances(ctx, minterAcc.GetAddress()))
+	require.Equal(sdk.NewInt64Coin("uncapped", 0), keeper.GetSupply(ctx, "uncapped"))
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(sdk.NewInt64Coin(metadata.Base, 40))))
+	require.Equal(sdk.NewCoin(metadata.Base, supplyCap), keeper.GetSupply(ctx, metadata.Base))
+}
+
 func (suite *KeeperTestSuite) TestSupply_BurnCoins() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -567,6 +605,42 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendCoins_FrozenAccount() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	suite.bankKeeper.FreezeAccount(ctx, accAddrs[0], fooDenom)
+	require.True(suite.bankKeeper.IsAccountFrozen(ctx, accAddrs[0], fooDenom))
+	require.False(suite.bankKeeper.IsAccountFrozen(ctx, accAddrs[0], barDenom))
+	require.False(suite.bankKeeper.IsAccountFrozen(ctx, accAddrs[1], fooDenom))
+	require.Equal([]banktypes.FrozenAccount{banktypes.NewFrozenAccount(accAddrs[0], fooDenom)}, suite.bankKeeper.GetAllFrozenAccounts(ctx))
+
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	require.ErrorIs(err, banktypes.ErrAccountFrozen)
+
+	input := banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(10)))
+	output := banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	err = suite.bankKeeper.InputOutputCoins(ctx, []banktypes.Input{input}, []banktypes.Output{output})
+	require.ErrorIs(err, banktypes.ErrAccountFrozen)
+
+	// the other denoms can still be sent
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+
+	suite.bankKeeper.UnfreezeAccount(ctx, accAddrs[0], fooDenom)
+	require.False(suite.bankKeeper.IsAccountFrozen(ctx, accAddrs[0], fooDenom))
+	require.Empty(suite.bankKeeper.GetAllFrozenAccounts(ctx))
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(40)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
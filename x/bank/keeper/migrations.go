@@ -6,6 +6,9 @@ import (
 	v2 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v7"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -36,6 +39,25 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
 
+// Migrate4to5 migrates x/bank storage from version 4 to 5. Specifically, it
+// indexes the supply of the denoms by amount.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate5to6 migrates x/bank storage from version 5 to 6. Specifically, it
+// stores the balances in the denom to address reverse index.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate6to7 migrates x/bank storage from version 6 to 7. Specifically, it
+// sets the burn authorization timeout and memo pruning params to their
+// defaults.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
 // MigrateSendEnabledParams get params from x/params and update the bank params.
 // This function is only needed for chains having migrated from <= v0.47 to v0.47.0-5
 func (m Migrator) MigrateSendEnabledParams(ctx sdk.Context) {
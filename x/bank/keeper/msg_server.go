@@ -44,7 +44,7 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
-	err = k.SendCoins(ctx, from, to, msg.Amount)
+	_, err = k.SendCoinsWithTransferTax(ctx, from, to, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +82,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 		}
 	}
 
-	err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
+	err := k.InputOutputCoinsWithTransferTax(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return nil, err
 	}
@@ -118,3 +118,155 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsFreezeAuthority(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s or a module account, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.FreezeAccount(ctx, addr, msg.Denoms...)
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (k msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsFreezeAuthority(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s or a module account, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.UnfreezeAccount(ctx, addr, msg.Denoms...)
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+func (k msgServer) CreateEscrow(goCtx context.Context, msg *types.MsgCreateEscrow) (*types.MsgCreateEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.IsSendEnabledCoins(ctx, msg.SendAmount...); err != nil {
+		return nil, err
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.ReceiveAmount...); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(recipient) || k.BlockedAddr(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s and %s must both be allowed to receive funds", msg.Sender, msg.Recipient)
+	}
+
+	id, err := k.Keeper.CreateEscrow(ctx, sender, recipient, msg.SendAmount, msg.ReceiveAmount, msg.ExpiryHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEscrowResponse{EscrowId: id}, nil
+}
+
+func (k msgServer) FulfillEscrow(goCtx context.Context, msg *types.MsgFulfillEscrow) (*types.MsgFulfillEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.FulfillEscrow(ctx, msg.EscrowId, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFulfillEscrowResponse{}, nil
+}
+
+func (k msgServer) GrantBurnAuthorization(goCtx context.Context, msg *types.MsgGrantBurnAuthorization) (*types.MsgGrantBurnAuthorizationResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.GrantBurnAuthorization(ctx, grantee, msg.MaxAmount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantBurnAuthorizationResponse{}, nil
+}
+
+func (k msgServer) BurnCoins(goCtx context.Context, msg *types.MsgBurnCoins) (*types.MsgBurnCoinsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burner, err := sdk.AccAddressFromBech32(msg.Burner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.BurnAuthorizedCoins(ctx, burner, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnCoinsResponse{}, nil
+}
+
+func (k msgServer) SendWithMemo(goCtx context.Context, msg *types.MsgSendWithMemo) (*types.MsgSendWithMemoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if err := types.ValidateTransferMemo(msg.Memo); err != nil {
+		return nil, err
+	}
+
+	received, err := k.SendCoinsWithTransferTax(ctx, from, to, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the memo records the amount received by the recipient, net of the
+	// transfer tax
+	sequence, err := k.RecordTransferMemo(ctx, from, to, received, msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendWithMemoResponse{Sequence: sequence}, nil
+}
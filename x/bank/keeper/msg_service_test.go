@@ -171,3 +171,62 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgFreezeAccount() {
+	govAuthority := suite.bankKeeper.GetAuthority()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		input     *banktypes.MsgFreezeAccount
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			malleate: func() {
+				suite.authKeeper.EXPECT().GetAccount(suite.ctx, accAddrs[1]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[1]))
+			},
+			input:     banktypes.NewMsgFreezeAccount(accAddrs[1].String(), accAddrs[0], []string{fooDenom}),
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:     "governance authority",
+			malleate: func() {},
+			input:    banktypes.NewMsgFreezeAccount(govAuthority, accAddrs[0], []string{fooDenom}),
+		},
+		{
+			name: "module account authority",
+			malleate: func() {
+				suite.authKeeper.EXPECT().GetAccount(suite.ctx, holderAcc.GetAddress()).Return(holderAcc)
+			},
+			input: banktypes.NewMsgFreezeAccount(holderAcc.GetAddress().String(), accAddrs[0], []string{fooDenom, barDenom}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			_, err := suite.msgServer.FreezeAccount(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				suite.Require().Empty(suite.bankKeeper.GetAllFrozenAccounts(suite.ctx))
+				return
+			}
+
+			suite.Require().NoError(err)
+			for _, denom := range tc.input.Denoms {
+				suite.Require().True(suite.bankKeeper.IsAccountFrozen(suite.ctx, accAddrs[0], denom))
+			}
+
+			_, err = suite.msgServer.UnfreezeAccount(suite.ctx, banktypes.NewMsgUnfreezeAccount(govAuthority, accAddrs[0], tc.input.Denoms))
+			suite.Require().NoError(err)
+			suite.Require().Empty(suite.bankKeeper.GetAllFrozenAccounts(suite.ctx))
+		})
+	}
+}
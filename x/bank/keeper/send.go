@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -37,6 +38,13 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	IsFreezeAuthority(ctx sdk.Context, authority string) bool
+	IsAccountFrozen(ctx sdk.Context, addr sdk.AccAddress, denom string) bool
+	FreezeAccount(ctx sdk.Context, addr sdk.AccAddress, denoms ...string)
+	UnfreezeAccount(ctx sdk.Context, addr sdk.AccAddress, denoms ...string)
+	IterateFrozenAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress, denom string) (stop bool))
+	GetAllFrozenAccounts(ctx sdk.Context) []types.FrozenAccount
+
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
@@ -92,11 +100,15 @@ func (k BaseSendKeeper) GetAuthority() string {
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return params
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &params)
+	}
+
+	// the params set before the transfer tax was introduced have no transfer tax
+	if params.TransferTax.IsNil() {
+		params.TransferTax = sdk.ZeroDec()
 	}
 
-	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
@@ -129,6 +141,8 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) error {
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't line up or if any single transfer of tokens fails.
+// The transfers are not subject to the transfer tax, which only applies to
+// MsgMultiSend, see InputOutputCoinsWithTransferTax.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -142,6 +156,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			return err
 		}
 
+		if err := k.checkNotFrozen(ctx, inAddress, in.Coins); err != nil {
+			return err
+		}
+
 		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
 		if err != nil {
 			return err
@@ -188,8 +206,15 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned upon failure, or if the sending account is frozen for
+// any of the denoms of amt. The transfer is not subject to the transfer tax,
+// which only applies to MsgSend and MsgSendWithMemo, see
+// SendCoinsWithTransferTax.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkNotFrozen(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -321,12 +346,9 @@ func (k BaseSendKeeper) initBalances(ctx sdk.Context, addr sdk.AccAddress, balan
 				denomPrefixStores[balance.Denom] = denomPrefixStore
 			}
 
-			// Store a reverse index from denomination to account address with a
-			// sentinel value.
-			denomAddrKey := address.MustLengthPrefix(addr)
-			if !denomPrefixStore.Has(denomAddrKey) {
-				denomPrefixStore.Set(denomAddrKey, []byte{0})
-			}
+			// Store a reverse index from denomination to account address with the
+			// balance as value.
+			denomPrefixStore.Set(address.MustLengthPrefix(addr), amount)
 		}
 	}
 
@@ -354,12 +376,9 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 
 		accountStore.Set([]byte(balance.Denom), amount)
 
-		// Store a reverse index from denomination to account address with a
-		// sentinel value.
-		denomAddrKey := address.MustLengthPrefix(addr)
-		if !denomPrefixStore.Has(denomAddrKey) {
-			denomPrefixStore.Set(denomAddrKey, []byte{0})
-		}
+		// Store a reverse index from denomination to account address with the
+		// balance as value.
+		denomPrefixStore.Set(address.MustLengthPrefix(addr), amount)
 	}
 
 	return nil
@@ -521,3 +540,86 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(store sdk.KVStore, denom string,
 
 	return defaultVal
 }
+
+// IsFreezeAuthority returns true if the given address can freeze and unfreeze
+// accounts, i.e. if it is the authority of the keeper or a module account.
+func (k BaseSendKeeper) IsFreezeAuthority(ctx sdk.Context, authority string) bool {
+	if authority == k.authority {
+		return true
+	}
+
+	addr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		return false
+	}
+
+	_, ok := k.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// IsAccountFrozen returns true if the transfers of the given denom from the
+// given account are frozen.
+func (k BaseSendKeeper) IsAccountFrozen(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateFrozenAccountKey(addr, denom))
+}
+
+// FreezeAccount freezes the transfers of the given denoms from the given
+// account.
+func (k BaseSendKeeper) FreezeAccount(ctx sdk.Context, addr sdk.AccAddress, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Set(types.CreateFrozenAccountKey(addr, denom), []byte{})
+	}
+}
+
+// UnfreezeAccount unfreezes the transfers of the given denoms from the given
+// account. Denoms which are not frozen for the account are ignored.
+func (k BaseSendKeeper) UnfreezeAccount(ctx sdk.Context, addr sdk.AccAddress, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateFrozenAccountKey(addr, denom))
+	}
+}
+
+// IterateFrozenAccounts iterates over the frozen denoms of all the frozen
+// accounts.
+func (k BaseSendKeeper) IterateFrozenAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress, denom string) bool) {
+	frozenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenAccountPrefix)
+
+	iterator := frozenStore.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, denom, err := types.AddressAndDenomFromBalancesStore(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(addr, denom) {
+			break
+		}
+	}
+}
+
+// GetAllFrozenAccounts returns the frozen denoms of all the frozen accounts.
+func (k BaseSendKeeper) GetAllFrozenAccounts(ctx sdk.Context) []types.FrozenAccount {
+	var rv []types.FrozenAccount
+	k.IterateFrozenAccounts(ctx, func(addr sdk.AccAddress, denom string) bool {
+		rv = append(rv, types.NewFrozenAccount(addr, denom))
+		return false
+	})
+
+	return rv
+}
+
+// checkNotFrozen returns ErrAccountFrozen if the transfers of any of the denoms
+// of amt from the given account are frozen.
+func (k BaseSendKeeper) checkNotFrozen(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if k.IsAccountFrozen(ctx, addr, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", addr, coin.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SendCoinsWithMemo transfers amt coins from a sending account to a receiving
+// account like SendCoins, and stores the memo describing the purpose of the
+// transfer. It returns the sequence of the memo among the transfer memos of
+// the sender, which identifies it along with the sender.
+func (k BaseKeeper) SendCoinsWithMemo(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, memo string) (uint64, error) {
+	if err := types.ValidateTransferMemo(memo); err != nil {
+		return 0, err
+	}
+
+	if err := k.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return 0, err
+	}
+
+	return k.RecordTransferMemo(ctx, fromAddr, toAddr, amt, memo)
+}
+
+// RecordTransferMemo stores the memo describing the purpose of a transfer of
+// amt coins, already made from a sending account to a receiving account. amt
+// is the amount received by the recipient. It returns the sequence of the memo
+// among the transfer memos of the sender.
+func (k BaseKeeper) RecordTransferMemo(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, memo string) (uint64, error) {
+	if err := types.ValidateTransferMemo(memo); err != nil {
+		return 0, err
+	}
+
+	transferMemo := types.TransferMemo{
+		FromAddress: fromAddr.String(),
+		Sequence:    k.getNextTransferMemoSequence(ctx, fromAddr),
+		ToAddress:   toAddr.String(),
+		Amount:      amt,
+		Memo:        memo,
+		Height:      ctx.BlockHeight(),
+	}
+	k.setTransferMemo(ctx, transferMemo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferWithMemo,
+			sdk.NewAttribute(types.AttributeKeySender, transferMemo.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, transferMemo.ToAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(transferMemo.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+		),
+	)
+
+	return transferMemo.Sequence, nil
+}
+
+// PruneTransferMemos deletes the transfer memos stored MemoPruneAfterBlocks
+// blocks ago, or before, up to MaxMemoPrunesPerBlock memos. Transfer memos are
+// never pruned if the param is zero. Malformed index entries are skipped and
+// deleted.
+func (k BaseKeeper) PruneTransferMemos(ctx sdk.Context) error {
+	pruneAfter := k.GetParams(ctx).MemoPruneAfterBlocks
+	if pruneAfter == 0 || uint64(ctx.BlockHeight()) < pruneAfter {
+		return nil
+	}
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferMemoHeightPrefix)
+
+	// the keys of the height index are the heights, big endian encoded on 8
+	// bytes, followed by the keys of the transfer memos
+	pruneHeight := uint64(ctx.BlockHeight()) - pruneAfter
+	iterator := heightStore.Iterator(nil, sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(pruneHeight)))
+	var expired [][]byte
+	for ; iterator.Valid() && len(expired) < types.MaxMemoPrunesPerBlock; iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		sender, sequence, err := types.SenderAndSequenceFromTransferMemoStore(key[8:])
+		if err != nil {
+			k.Logger(ctx).Error("skipping a malformed transfer memo index entry", "err", err)
+			heightStore.Delete(key)
+			continue
+		}
+
+		memo, found := k.GetTransferMemo(ctx, sender, sequence)
+		if !found {
+			k.Logger(ctx).Error("skipping the pruning of an unknown transfer memo", "sender", sender, "sequence", sequence)
+			heightStore.Delete(key)
+			continue
+		}
+
+		k.deleteTransferMemo(ctx, memo)
+	}
+
+	return nil
+}
+
+// GetTransferMemo returns the transfer memo of the given sender and sequence
+// and true if it exists, false otherwise.
+func (k BaseKeeper) GetTransferMemo(ctx sdk.Context, sender sdk.AccAddress, sequence uint64) (types.TransferMemo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateTransferMemoKey(sender, sequence))
+	if bz == nil {
+		return types.TransferMemo{}, false
+	}
+
+	var memo types.TransferMemo
+	k.cdc.MustUnmarshal(bz, &memo)
+
+	return memo, true
+}
+
+// IterateTransferMemos iterates over all the transfer memos which are not
+// pruned yet.
+func (k BaseKeeper) IterateTransferMemos(ctx sdk.Context, cb func(memo types.TransferMemo) (stop bool)) {
+	memoStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferMemoPrefix)
+
+	iterator := memoStore.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var memo types.TransferMemo
+		k.cdc.MustUnmarshal(iterator.Value(), &memo)
+
+		if cb(memo) {
+			break
+		}
+	}
+}
+
+// GetAllTransferMemos returns all the transfer memos which are not pruned yet.
+func (k BaseKeeper) GetAllTransferMemos(ctx sdk.Context) []types.TransferMemo {
+	var memos []types.TransferMemo
+	k.IterateTransferMemos(ctx, func(memo types.TransferMemo) bool {
+		memos = append(memos, memo)
+		return false
+	})
+
+	return memos
+}
+
+// setTransferMemo stores a transfer memo and indexes it by height. The
+// sequence of the next transfer memo of the sender is bumped past the sequence
+// of the memo if needed.
+func (k BaseKeeper) setTransferMemo(ctx sdk.Context, memo types.TransferMemo) {
+	sender := sdk.MustAccAddressFromBech32(memo.FromAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateTransferMemoKey(sender, memo.Sequence), k.cdc.MustMarshal(&memo))
+	store.Set(types.CreateTransferMemoHeightKey(memo.Height, sender, memo.Sequence), []byte{})
+
+	if memo.Sequence >= k.getNextTransferMemoSequence(ctx, sender) {
+		store.Set(types.CreateNextTransferMemoSequenceKey(sender), sdk.Uint64ToBigEndian(memo.Sequence+1))
+	}
+}
+
+func (k BaseKeeper) deleteTransferMemo(ctx sdk.Context, memo types.TransferMemo) {
+	sender := sdk.MustAccAddressFromBech32(memo.FromAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateTransferMemoKey(sender, memo.Sequence))
+	store.Delete(types.CreateTransferMemoHeightKey(memo.Height, sender, memo.Sequence))
+}
+
+// getNextTransferMemoSequence returns the sequence of the next transfer memo of
+// a sender, starting at 1. It is reserved by setTransferMemo when the memo is
+// stored.
+func (k BaseKeeper) getNextTransferMemoSequence(ctx sdk.Context, sender sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateNextTransferMemoSequenceKey(sender))
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
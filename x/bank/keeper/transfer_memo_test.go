@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferMemo() {
+	require := suite.Require()
+	ctx := suite.ctx.WithBlockHeight(10)
+	keeper := suite.bankKeeper
+
+	params := banktypes.DefaultParams()
+	params.MemoPruneAfterBlocks = 5
+	require.NoError(keeper.SetParams(ctx, params))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, suite.ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	amount := sdk.NewCoins(newFooCoin(30))
+
+	_, err := keeper.SendCoinsWithMemo(ctx, accAddrs[0], accAddrs[1], amount, strings.Repeat("a", banktypes.MaxTransferMemoLength+1))
+	require.ErrorIs(err, sdkerrors.ErrMemoTooLarge)
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	sequence, err := keeper.SendCoinsWithMemo(ctx, accAddrs[0], accAddrs[1], amount, "invoice 42")
+	require.NoError(err)
+	require.Equal(uint64(1), sequence)
+	require.Equal(sdk.NewCoins(newFooCoin(70)), keeper.GetAllBalances(ctx, accAddrs[0]))
+
+	res, err := suite.queryClient.TransferMemo(sdk.WrapSDKContext(ctx), &banktypes.QueryTransferMemoRequest{Address: accAddrs[0].String(), Sequence: sequence})
+	require.NoError(err)
+	require.Equal(banktypes.TransferMemo{
+		FromAddress: accAddrs[0].String(),
+		Sequence:    sequence,
+		ToAddress:   accAddrs[1].String(),
+		Amount:      amount,
+		Memo:        "invoice 42",
+		Height:      10,
+	}, res.TransferMemo)
+
+	// the sequences are per sender
+	ctx = ctx.WithBlockHeight(12)
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	sequence, err = keeper.SendCoinsWithMemo(ctx, accAddrs[0], accAddrs[1], amount, "invoice 43")
+	require.NoError(err)
+	require.Equal(uint64(2), sequence)
+	require.Len(keeper.GetAllTransferMemos(ctx), 2)
+
+	_, err = suite.queryClient.TransferMemo(sdk.WrapSDKContext(ctx), &banktypes.QueryTransferMemoRequest{Address: accAddrs[1].String(), Sequence: 1})
+	require.Error(err)
+
+	// the memos are pruned MemoPruneAfterBlocks blocks after the transfer
+	require.NoError(keeper.PruneTransferMemos(ctx.WithBlockHeight(14)))
+	require.Len(keeper.GetAllTransferMemos(ctx), 2)
+
+	require.NoError(keeper.PruneTransferMemos(ctx.WithBlockHeight(15)))
+	_, found := keeper.GetTransferMemo(ctx, accAddrs[0], 1)
+	require.False(found)
+	_, found = keeper.GetTransferMemo(ctx, accAddrs[0], 2)
+	require.True(found)
+
+	// the sequences of the pruned memos are not reused
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	sequence, err = keeper.SendCoinsWithMemo(ctx, accAddrs[0], accAddrs[1], amount, "")
+	require.NoError(err)
+	require.Equal(uint64(3), sequence)
+}
+
+func (suite *KeeperTestSuite) TestTransferMemoNoPruning() {
+	require := suite.Require()
+	ctx := suite.ctx.WithBlockHeight(10)
+	keeper := suite.bankKeeper
+
+	params := banktypes.DefaultParams()
+	params.MemoPruneAfterBlocks = 0
+	require.NoError(keeper.SetParams(ctx, params))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, suite.ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[1])
+	_, err := keeper.SendCoinsWithMemo(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(30)), "invoice 42")
+	require.NoError(err)
+
+	require.NoError(keeper.PruneTransferMemos(ctx.WithBlockHeight(1000000)))
+	require.Len(keeper.GetAllTransferMemos(ctx), 1)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SetDistributionKeeper sets the x/distribution keeper funding the community
+// pool with the transfer tax. It applies to all the copies of the keeper.
+func (k BaseKeeper) SetDistributionKeeper(distrKeeper types.DistributionKeeper) {
+	*k.distrKeeper = distrKeeper
+}
+
+// SendCoinsWithTransferTax transfers amt coins from a sending account to a
+// receiving account like SendCoins, which is not taxed, and is used by MsgSend.
+// Unless the sender is a module account, the transfer tax is deducted from the
+// amount received by the recipient and paid by the sender to the transfer tax
+// destination. It returns the amount received by the recipient.
+func (k BaseKeeper) SendCoinsWithTransferTax(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !k.isTaxedSender(ctx, params.TransferTax, fromAddr) {
+		return amt, k.SendCoins(ctx, fromAddr, toAddr, amt)
+	}
+
+	tax := computeTransferTax(params.TransferTax, amt)
+	if tax.IsZero() {
+		return amt, k.SendCoins(ctx, fromAddr, toAddr, amt)
+	}
+
+	received := amt.Sub(tax...)
+	if err := k.SendCoins(ctx, fromAddr, toAddr, received); err != nil {
+		return nil, err
+	}
+
+	return received, k.payTransferTax(ctx, params.TransferTaxDestination, fromAddr, tax)
+}
+
+// InputOutputCoinsWithTransferTax performs multi-send functionality like
+// InputOutputCoins, which is not taxed, and is used by MsgMultiSend. Unless the
+// input is a module account, the transfer tax is deducted from the amount
+// received by each output and paid by the input to the transfer tax
+// destination. Multi-sends with several inputs, which cannot be sent with
+// MsgMultiSend, are not taxed.
+func (k BaseKeeper) InputOutputCoinsWithTransferTax(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	if len(inputs) != 1 {
+		return k.InputOutputCoins(ctx, inputs, outputs)
+	}
+
+	if err := types.ValidateInputsOutputs(inputs, outputs); err != nil {
+		return err
+	}
+
+	inAddress, err := sdk.AccAddressFromBech32(inputs[0].Address)
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if !k.isTaxedSender(ctx, params.TransferTax, inAddress) {
+		return k.InputOutputCoins(ctx, inputs, outputs)
+	}
+
+	var totalTax sdk.Coins
+	taxedOutputs := make([]types.Output, len(outputs))
+	for i, out := range outputs {
+		taxedOutputs[i] = out
+		if tax := computeTransferTax(params.TransferTax, out.Coins); !tax.IsZero() {
+			taxedOutputs[i].Coins = out.Coins.Sub(tax...)
+			totalTax = totalTax.Add(tax...)
+		}
+	}
+	if totalTax.IsZero() {
+		return k.InputOutputCoins(ctx, inputs, outputs)
+	}
+
+	taxedInputs := []types.Input{types.NewInput(inAddress, inputs[0].Coins.Sub(totalTax...))}
+	if err := k.InputOutputCoins(ctx, taxedInputs, taxedOutputs); err != nil {
+		return err
+	}
+
+	return k.payTransferTax(ctx, params.TransferTaxDestination, inAddress, totalTax)
+}
+
+// isTaxedSender returns true if the transfers sent by addr are taxed at the
+// given rate, that is if the rate is positive and addr is not a module account.
+func (k BaseKeeper) isTaxedSender(ctx sdk.Context, rate sdk.Dec, addr sdk.AccAddress) bool {
+	if !rate.IsPositive() {
+		return false
+	}
+
+	_, isModuleAccount := k.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return !isModuleAccount
+}
+
+// payTransferTax pays the transfer tax from the account of the payer to the
+// transfer tax destination.
+func (k BaseKeeper) payTransferTax(ctx sdk.Context, destination types.TransferTaxDestination, payer sdk.AccAddress, tax sdk.Coins) error {
+	switch destination {
+	case types.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN:
+		if err := k.burnCoins(ctx, payer, tax); err != nil {
+			return err
+		}
+
+	case types.TransferTaxDestination_TRANSFER_TAX_DESTINATION_COMMUNITY_POOL:
+		distrKeeper := *k.distrKeeper
+		if distrKeeper == nil {
+			return sdkerrors.ErrLogic.Wrap("distribution keeper is not set")
+		}
+
+		if err := distrKeeper.FundCommunityPool(ctx, tax, payer); err != nil {
+			return err
+		}
+
+	default:
+		return sdkerrors.ErrLogic.Wrapf("invalid transfer tax destination: %s", destination)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTax,
+			sdk.NewAttribute(types.AttributeKeySender, payer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tax.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		),
+	)
+
+	return nil
+}
+
+// computeTransferTax returns the transfer tax due on amt at the given rate,
+// truncated to integer amounts. No tax is due on invalid amounts, whose
+// transfer fails.
+func computeTransferTax(rate sdk.Dec, amt sdk.Coins) sdk.Coins {
+	if !amt.IsValid() {
+		return nil
+	}
+
+	var tax sdk.Coins
+	for _, coin := range amt {
+		if amount := rate.MulInt(coin.Amount).TruncateInt(); amount.IsPositive() {
+			tax = append(tax, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return tax
+}
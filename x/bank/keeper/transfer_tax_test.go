@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) setTransferTax(ctx sdk.Context, tax sdk.Dec, destination banktypes.TransferTaxDestination) {
+	params := banktypes.DefaultParams()
+	params.TransferTax = tax
+	params.TransferTaxDestination = destination
+	suite.Require().NoError(suite.bankKeeper.SetParams(ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsTransferTaxBurn() {
+	require := suite.Require()
+	ctx := suite.ctx
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(5))))
+
+	suite.setTransferTax(ctx, sdk.NewDecWithPrec(1, 1), banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN)
+
+	// the sender account is fetched to check it is not a module account, to send
+	// the coins and to burn the tax
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(3)
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+	received, err := keeper.SendCoinsWithTransferTax(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50), newBarCoin(5)))
+	require.NoError(err)
+
+	// the tax is truncated, no tax is due on 5bar
+	require.Equal(sdk.NewCoins(newFooCoin(45), newBarCoin(5)), received)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(45), newBarCoin(5)), keeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(newFooCoin(95), keeper.GetSupply(ctx, fooDenom))
+
+	event := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1]
+	require.Equal(banktypes.EventTypeTransferTax, event.Type)
+	require.Equal(sdk.NewEvent(
+		banktypes.EventTypeTransferTax,
+		sdk.NewAttribute(banktypes.AttributeKeySender, accAddrs[0].String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, newFooCoin(5).String()),
+		sdk.NewAttribute(banktypes.AttributeKeyDestination, banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN.String()),
+	), event)
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsTransferTaxCommunityPool() {
+	require := suite.Require()
+	ctx := suite.ctx
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.setTransferTax(ctx, sdk.NewDecWithPrec(1, 1), banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_COMMUNITY_POOL)
+
+	distrKeeper := banktestutil.NewMockDistributionKeeper(gomock.NewController(suite.T()))
+	keeper.SetDistributionKeeper(distrKeeper)
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
+	distrKeeper.EXPECT().FundCommunityPool(ctx, sdk.NewCoins(newFooCoin(5)), accAddrs[0]).Return(nil)
+	_, err := keeper.SendCoinsWithTransferTax(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50)))
+	require.NoError(err)
+
+	require.Equal(sdk.NewCoins(newFooCoin(45)), keeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// the tax cannot be paid without the distribution keeper
+	keeper.SetDistributionKeeper(nil)
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
+	_, err = keeper.SendCoinsWithTransferTax(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50)))
+	require.ErrorIs(err, sdkerrors.ErrLogic)
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsTransferTaxModuleAccount() {
+	require := suite.Require()
+	ctx := suite.ctx
+	keeper := suite.bankKeeper
+
+	suite.mockMintCoins(mintAcc)
+	require.NoError(keeper.MintCoins(ctx, mintAcc.Name, sdk.NewCoins(newFooCoin(100))))
+
+	suite.setTransferTax(ctx, sdk.NewDecWithPrec(1, 1), banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN)
+
+	// the transfers sent by module accounts are not taxed
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[0])
+	require.NoError(keeper.SendCoinsFromModuleToAccount(ctx, mintAcc.Name, accAddrs[0], sdk.NewCoins(newFooCoin(50))))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc).Times(2)
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+	received, err := keeper.SendCoinsWithTransferTax(ctx, mintAcc.GetAddress(), accAddrs[1], sdk.NewCoins(newFooCoin(50)))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), received)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// neither are the transfers to module accounts
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockSendCoinsFromAccountToModule(acc0, holderAcc)
+	require.NoError(keeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], holderAcc.Name, sdk.NewCoins(newFooCoin(50))))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	require.Equal(newFooCoin(100), keeper.GetSupply(ctx, fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoinsTransferTax() {
+	require := suite.Require()
+	ctx := suite.ctx
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.setTransferTax(ctx, sdk.NewDecWithPrec(1, 1), banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN)
+
+	inputs := []banktypes.Input{banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(60)))}
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(30))),
+		banktypes.NewOutput(accAddrs[2], sdk.NewCoins(newFooCoin(30))),
+	}
+
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, accAddrs[1:3])
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(2)
+	require.NoError(keeper.InputOutputCoinsWithTransferTax(ctx, inputs, outputs))
+
+	// the tax of each output is deducted from the amount it receives
+	require.Equal(sdk.NewCoins(newFooCoin(40)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(27)), keeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(27)), keeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(newFooCoin(94), keeper.GetSupply(ctx, fooDenom))
+
+	// the multi-sends from module accounts are not taxed
+	suite.mockMintCoins(mintAcc)
+	require.NoError(keeper.MintCoins(ctx, mintAcc.Name, sdk.NewCoins(newFooCoin(60))))
+
+	inputs = []banktypes.Input{banktypes.NewInput(mintAcc.GetAddress(), sdk.NewCoins(newFooCoin(60)))}
+	suite.mockInputOutputCoins([]authtypes.AccountI{mintAcc}, accAddrs[1:3])
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc)
+	require.NoError(keeper.InputOutputCoinsWithTransferTax(ctx, inputs, outputs))
+
+	require.Equal(sdk.NewCoins(newFooCoin(57)), keeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(57)), keeper.GetAllBalances(ctx, accAddrs[2]))
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsNotTaxed() {
+	require := suite.Require()
+	ctx := suite.ctx
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.setTransferTax(ctx, sdk.NewDecWithPrec(1, 1), banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN)
+
+	// only the send messages are taxed, the transfers made by other modules
+	// through the keeper move the full amount
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50))))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, accAddrs[1]))
+
+	inputs := []banktypes.Input{banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(50)))}
+	outputs := []banktypes.Output{banktypes.NewOutput(accAddrs[2], sdk.NewCoins(newFooCoin(50)))}
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, accAddrs[2:3])
+	require.NoError(keeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, accAddrs[2]))
+
+	require.Equal(newFooCoin(100), keeper.GetSupply(ctx, fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestMsgSendWithMemoTransferTax() {
+	require := suite.Require()
+	ctx := suite.ctx
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.setTransferTax(ctx, sdk.NewDecWithPrec(1, 1), banktypes.TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN)
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(3)
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+	res, err := suite.msgServer.SendWithMemo(ctx, banktypes.NewMsgSendWithMemo(accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50)), "invoice 42"))
+	require.NoError(err)
+
+	// the memo records the amount received, net of the tax
+	memo, found := keeper.GetTransferMemo(ctx, accAddrs[0], res.Sequence)
+	require.True(found)
+	require.Equal(sdk.NewCoins(newFooCoin(45)), memo.Amount)
+	require.Equal(sdk.NewCoins(newFooCoin(45)), keeper.GetAllBalances(ctx, accAddrs[1]))
+}
@@ -73,9 +73,15 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"escrows": [],
+	"frozen_accounts": [],
 	"params": {
+		"burn_authorization_timeout": "0",
 		"default_send_enabled": false,
-		"send_enabled": []
+		"memo_prune_after_blocks": "0",
+		"send_enabled": [],
+		"transfer_tax": "0",
+		"transfer_tax_destination": "TRANSFER_TAX_DESTINATION_BURN"
 	},
 	"send_enabled": [],
 	"supply": [
@@ -87,7 +93,8 @@ func TestMigrateJSON(t *testing.T) {
 			"amount": "10",
 			"denom": "foo"
 		}
-	]
+	],
+	"transfer_memos": []
 }`
 
 	require.Equal(t, expected, string(indentedBz))
@@ -0,0 +1,29 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateStore performs in-place store migrations from the consensus version 4
+// to version 5. The migration includes:
+// - Index the supply of the denoms by amount
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	iterator := prefix.NewStore(store, types.SupplyKey).Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+
+		supply := sdk.Coin{Denom: string(iterator.Key()), Amount: amount}
+		store.Set(types.CreateSupplyRankKey(supply), []byte{})
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v5 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// store the supply without indexing it by amount
+	supply := sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 10))
+	for _, coin := range supply {
+		bz, err := coin.Amount.Marshal()
+		require.NoError(t, err)
+		store.Set(append(types.SupplyKey, coin.Denom...), bz)
+	}
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey))
+
+	for _, coin := range supply {
+		require.True(t, store.Has(types.CreateSupplyRankKey(coin)))
+	}
+	require.False(t, store.Has(types.CreateSupplyRankKey(sdk.NewInt64Coin("foo", 20))))
+}
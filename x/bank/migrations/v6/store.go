@@ -0,0 +1,31 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateStore performs in-place store migrations from the consensus version 5
+// to version 6. The migration includes:
+// - Store the balances as values of the denom to address reverse index, in
+// place of a sentinel value
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	iterator := prefix.NewStore(store, types.BalancesPrefix).Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addr, denom, err := types.AddressAndDenomFromBalancesStore(iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		denomPrefixStore := prefix.NewStore(store, types.CreateDenomAddressPrefix(denom))
+		denomPrefixStore.Set(address.MustLengthPrefix(addr), iterator.Value())
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	v6 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	addr := sdk.AccAddress("addr")
+	balances := sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 10))
+
+	// store the balances with a sentinel value in the reverse index
+	for _, coin := range balances {
+		bz, err := coin.Amount.Marshal()
+		require.NoError(t, err)
+		store.Set(append(types.CreateAccountBalancesPrefix(addr), coin.Denom...), bz)
+		store.Set(append(types.CreateDenomAddressPrefix(coin.Denom), address.MustLengthPrefix(addr)...), []byte{0})
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey))
+
+	for _, coin := range balances {
+		var amount sdk.Int
+		require.NoError(t, amount.Unmarshal(store.Get(append(types.CreateDenomAddressPrefix(coin.Denom), address.MustLengthPrefix(addr)...))))
+		require.Equal(t, coin.Amount, amount)
+	}
+}
@@ -0,0 +1,43 @@
+package v7
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateStore performs in-place store migrations from the consensus version 6
+// to version 7. The migration includes:
+// - Set the BurnAuthorizationTimeout and MemoPruneAfterBlocks params, unset in
+// the stored params, to their defaults
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.BurnAuthorizationTimeout == 0 {
+		params.BurnAuthorizationTimeout = types.DefaultBurnAuthorizationTimeout
+	}
+	if params.MemoPruneAfterBlocks == 0 {
+		params.MemoPruneAfterBlocks = types.DefaultMemoPruneAfterBlocks
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	v7 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// the params stored before the version 7 only have the send enabled ones
+	store.Set(types.ParamsKey, cdc.MustMarshal(&types.Params{DefaultSendEnabled: false}))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.False(t, params.DefaultSendEnabled)
+	require.Equal(t, types.DefaultBurnAuthorizationTimeout, params.BurnAuthorizationTimeout)
+	require.Equal(t, types.DefaultMemoPruneAfterBlocks, params.MemoPruneAfterBlocks)
+	require.True(t, params.TransferTax.IsZero())
+}
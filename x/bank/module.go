@@ -32,10 +32,10 @@ import (
 )
 
 // ConsensusVersion defines the current x/bank module consensus version.
-const ConsensusVersion = 4
+const ConsensusVersion = 7
 
 var (
-	_ module.AppModule           = AppModule{}
+	_ module.EndBlockAppModule   = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 )
@@ -130,6 +130,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/bank from version 3 to 4: %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 4 to 5: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 5 to 6: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 6 to 7: %v", err))
+	}
 }
 
 // NewAppModule creates a new AppModule object
@@ -175,6 +187,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock returns the end blocker for the bank module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -202,6 +221,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetAuthzKeeper),
+		appmodule.Invoke(InvokeSetDistributionKeeper),
 	)
 }
 
@@ -241,6 +262,9 @@ func ProvideModule(in BankInputs) BankOutputs {
 		}
 	}
 
+	// the coins held in escrow can only be released by the escrows
+	blockedAddresses[types.EscrowAddress.String()] = true
+
 	// default to governance authority if not provided
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	if in.Config.Authority != "" {
@@ -258,3 +282,24 @@ func ProvideModule(in BankInputs) BankOutputs {
 
 	return BankOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetAuthzKeeper sets the x/authz keeper storing the burn authorizations
+// on the bank keeper, if x/authz is part of the app.
+func InvokeSetAuthzKeeper(bankKeeper keeper.BaseKeeper, authzKeeper types.AuthzKeeper) {
+	if authzKeeper == nil {
+		return
+	}
+
+	bankKeeper.SetAuthzKeeper(authzKeeper)
+}
+
+// InvokeSetDistributionKeeper sets the x/distribution keeper funding the
+// community pool with the transfer tax on the bank keeper, if x/distribution is
+// part of the app.
+func InvokeSetDistributionKeeper(bankKeeper keeper.BaseKeeper, distrKeeper types.DistributionKeeper) {
+	if distrKeeper == nil {
+		return
+	}
+
+	bankKeeper.SetDistributionKeeper(distrKeeper)
+}
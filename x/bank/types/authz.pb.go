@@ -87,36 +87,97 @@ func (m *SendAuthorization) GetAllowList() []string {
 	return nil
 }
 
+// BurnAuthorization allows the grantee to burn up to max_amount coins from its
+// own account. It is granted by governance through MsgGrantBurnAuthorization.
+type BurnAuthorization struct {
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
+	// expiry_height is the last height at which coins can be burnt, zero if the
+	// authorization never expires.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *BurnAuthorization) Reset()         { *m = BurnAuthorization{} }
+func (m *BurnAuthorization) String() string { return proto.CompactTextString(m) }
+func (*BurnAuthorization) ProtoMessage()    {}
+func (*BurnAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4d2a37888ea779f, []int{1}
+}
+func (m *BurnAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnAuthorization.Merge(m, src)
+}
+func (m *BurnAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnAuthorization proto.InternalMessageInfo
+
+func (m *BurnAuthorization) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+func (m *BurnAuthorization) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SendAuthorization)(nil), "cosmos.bank.v1beta1.SendAuthorization")
+	proto.RegisterType((*BurnAuthorization)(nil), "cosmos.bank.v1beta1.BurnAuthorization")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/authz.proto", fileDescriptor_a4d2a37888ea779f) }
 
 var fileDescriptor_a4d2a37888ea779f = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x84, 0xe8,
-	0x8e, 0x87, 0x48, 0x40, 0x8d, 0x82, 0x48, 0xc9, 0xc1, 0x6d, 0x2e, 0x4e, 0x85, 0xdb, 0x9c, 0x9c,
-	0x9f, 0x99, 0x07, 0x91, 0x57, 0xea, 0x60, 0xe2, 0x12, 0x0c, 0x4e, 0xcd, 0x4b, 0x71, 0x2c, 0x2d,
-	0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0x13, 0x2a, 0xe4, 0xe2, 0x2e, 0x2e,
-	0x48, 0xcd, 0x4b, 0x89, 0xcf, 0xc9, 0xcc, 0xcd, 0x2c, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36,
-	0x92, 0xd4, 0x83, 0x3b, 0xb2, 0x38, 0x15, 0xe6, 0x48, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0xd3,
-	0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0x85, 0x3a, 0x03, 0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16,
-	0x83, 0x35, 0x14, 0xaf, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x88, 0x0b, 0x6c, 0x89, 0x0f, 0xc8, 0x0e,
-	0x21, 0x73, 0x2e, 0xae, 0xc4, 0x9c, 0x9c, 0xfc, 0xf2, 0xf8, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x26,
-	0x05, 0x66, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x96, 0x3a, 0xa6, 0xa4, 0x14,
-	0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x71, 0x82, 0xd5, 0xfa, 0x64, 0x16,
-	0x97, 0x58, 0xb9, 0x9f, 0xda, 0xa2, 0xab, 0x04, 0x55, 0x04, 0x09, 0x52, 0x98, 0xd3, 0x50, 0xfc,
-	0xd4, 0xf5, 0x7c, 0x83, 0x96, 0x0c, 0x92, 0x63, 0x30, 0x3c, 0xed, 0xe4, 0x7c, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0x78, 0xbd, 0x55, 0x01, 0x89, 0x56, 0xb0, 0xef,
-	0x92, 0xd8, 0xc0, 0xc1, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x78, 0xc2, 0x7d, 0xd9, 0xf2,
-	0x01, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x4f, 0x8b, 0xda, 0x40,
+	0x14, 0x4f, 0x14, 0x0a, 0x8e, 0xed, 0xc1, 0xd4, 0x83, 0x4a, 0x89, 0x62, 0x2f, 0xa9, 0x60, 0x82,
+	0x2d, 0xa5, 0xd0, 0x9b, 0xf1, 0xd0, 0x1e, 0x3c, 0xe9, 0xad, 0x97, 0x30, 0x31, 0x43, 0x32, 0x98,
+	0xcc, 0xa4, 0x99, 0x49, 0x1b, 0xfd, 0x04, 0xa5, 0xa7, 0x7e, 0x8c, 0xd2, 0x93, 0x07, 0x3f, 0x84,
+	0xf4, 0x24, 0x3d, 0xf5, 0xb4, 0xbb, 0xe8, 0xc1, 0xfb, 0x7e, 0x82, 0x25, 0x33, 0x51, 0x56, 0x84,
+	0x3d, 0x2c, 0xec, 0x25, 0x79, 0xbc, 0xf7, 0x7b, 0xf3, 0xfb, 0xc3, 0x03, 0xed, 0x19, 0x65, 0x11,
+	0x65, 0x96, 0x0b, 0xc9, 0xdc, 0xfa, 0x36, 0x70, 0x11, 0x87, 0x03, 0x0b, 0xa6, 0x3c, 0x58, 0x9a,
+	0x71, 0x42, 0x39, 0xd5, 0x5e, 0x4a, 0x80, 0x99, 0x03, 0xcc, 0x02, 0xd0, 0xaa, 0xc1, 0x08, 0x13,
+	0x6a, 0x89, 0xaf, 0xc4, 0xb5, 0xea, 0x3e, 0xf5, 0xa9, 0x28, 0xad, 0xbc, 0x2a, 0xba, 0x4d, 0xb9,
+	0xed, 0xc8, 0x41, 0xf1, 0x94, 0x1c, 0xe9, 0x27, 0x66, 0x86, 0x4e, 0xcc, 0x33, 0x8a, 0x89, 0x9c,
+	0x77, 0x7f, 0x94, 0x40, 0x6d, 0x8a, 0x88, 0x37, 0x4c, 0x79, 0x40, 0x13, 0xbc, 0x84, 0x1c, 0x53,
+	0xa2, 0x7d, 0x05, 0x55, 0x16, 0x23, 0xe2, 0x39, 0x21, 0x8e, 0x30, 0x6f, 0xa8, 0x9d, 0xb2, 0x51,
+	0x7d, 0xdb, 0x34, 0x4f, 0x22, 0x19, 0x3a, 0x8a, 0x34, 0x47, 0x14, 0x13, 0xfb, 0xfd, 0xe6, 0xaa,
+	0xad, 0xfc, 0xb9, 0x6e, 0x1b, 0x3e, 0xe6, 0x41, 0xea, 0x9a, 0x33, 0x1a, 0x15, 0x32, 0x8a, 0x5f,
+	0x9f, 0x79, 0x73, 0x8b, 0x2f, 0x62, 0xc4, 0xc4, 0x02, 0xfb, 0x7d, 0x58, 0xf5, 0xd4, 0x09, 0x10,
+	0x24, 0xe3, 0x9c, 0x43, 0xfb, 0x00, 0x00, 0x0c, 0x43, 0xfa, 0xdd, 0x09, 0x31, 0xe3, 0x8d, 0x52,
+	0xa7, 0x6c, 0x54, 0xec, 0xc6, 0xbf, 0x75, 0xbf, 0x5e, 0x90, 0x0e, 0x3d, 0x2f, 0x41, 0x8c, 0x4d,
+	0x79, 0x82, 0x89, 0x3f, 0xa9, 0x08, 0xec, 0x18, 0x33, 0xfe, 0xf1, 0xd3, 0xdf, 0x75, 0xbf, 0x5b,
+	0x80, 0x64, 0xa4, 0x47, 0x69, 0x67, 0x9e, 0x7e, 0x1e, 0x56, 0xbd, 0x57, 0xf7, 0xc4, 0x5c, 0x98,
+	0xee, 0xde, 0xaa, 0xa0, 0x66, 0xa7, 0x09, 0x39, 0x8f, 0x82, 0x02, 0x10, 0xc1, 0xcc, 0x81, 0x11,
+	0x4d, 0xc9, 0xd3, 0x25, 0x51, 0x89, 0x60, 0x36, 0x14, 0x14, 0xda, 0x6b, 0xf0, 0x02, 0x65, 0x31,
+	0x4e, 0x16, 0x4e, 0x80, 0xb0, 0x1f, 0xe4, 0x59, 0xa8, 0x46, 0x79, 0xf2, 0x5c, 0x36, 0x3f, 0x8b,
+	0xde, 0x63, 0x4d, 0x5f, 0xd8, 0xb3, 0x47, 0x9b, 0x9d, 0xae, 0x6e, 0x77, 0xba, 0x7a, 0xb3, 0xd3,
+	0xd5, 0x5f, 0x7b, 0x5d, 0xd9, 0xee, 0x75, 0xe5, 0xff, 0x5e, 0x57, 0xbe, 0xbc, 0x79, 0xd0, 0x41,
+	0x26, 0x6f, 0x59, 0x18, 0x71, 0x9f, 0x89, 0x5b, 0x7a, 0x77, 0x37, 0x00, 0x5f, 0xee, 0xaf, 0x56,
+	0xe7, 0x02, 0x00, 0x00,
 }
 
 func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
@@ -165,6 +226,48 @@ func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BurnAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -197,6 +300,24 @@ func (m *SendAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *BurnAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -319,6 +440,109 @@ func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BurnAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransferTaxDestination enumerates the destinations of the transfer tax.
+type TransferTaxDestination int32
+
+const (
+	// TRANSFER_TAX_DESTINATION_BURN defines a transfer tax which is burned.
+	TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN TransferTaxDestination = 0
+	// TRANSFER_TAX_DESTINATION_COMMUNITY_POOL defines a transfer tax which is
+	// paid to the community pool.
+	TransferTaxDestination_TRANSFER_TAX_DESTINATION_COMMUNITY_POOL TransferTaxDestination = 1
+)
+
+var TransferTaxDestination_name = map[int32]string{
+	0: "TRANSFER_TAX_DESTINATION_BURN",
+	1: "TRANSFER_TAX_DESTINATION_COMMUNITY_POOL",
+}
+
+var TransferTaxDestination_value = map[string]int32{
+	"TRANSFER_TAX_DESTINATION_BURN":           0,
+	"TRANSFER_TAX_DESTINATION_COMMUNITY_POOL": 1,
+}
+
+func (x TransferTaxDestination) String() string {
+	return proto.EnumName(TransferTaxDestination_name, int32(x))
+}
+
+func (TransferTaxDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{0}
+}
+
 // Params defines the parameters for the bank module.
 type Params struct {
 	// Deprecated: Use of SendEnabled in params is deprecated.
@@ -37,6 +66,20 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// burn_authorization_timeout is the number of blocks after which a burn
+	// authorization granted by governance expires. Zero means no expiry.
+	BurnAuthorizationTimeout uint64 `protobuf:"varint,3,opt,name=burn_authorization_timeout,json=burnAuthorizationTimeout,proto3" json:"burn_authorization_timeout,omitempty"`
+	// memo_prune_after_blocks is the number of blocks after which the memos of
+	// the transfers sent with MsgSendWithMemo are pruned. Zero means no pruning.
+	MemoPruneAfterBlocks uint64 `protobuf:"varint,4,opt,name=memo_prune_after_blocks,json=memoPruneAfterBlocks,proto3" json:"memo_prune_after_blocks,omitempty"`
+	// transfer_tax is the fraction of the amount of the transfers sent with
+	// MsgSend, MsgMultiSend and MsgSendWithMemo which is deducted from the amount
+	// received by the recipient and paid to the transfer_tax_destination. The
+	// transfers made by other modules through the keeper and the transfers sent
+	// by module accounts are not taxed.
+	TransferTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=transfer_tax,json=transferTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transfer_tax"`
+	// transfer_tax_destination is where the transfer tax is paid to.
+	TransferTaxDestination TransferTaxDestination `protobuf:"varint,6,opt,name=transfer_tax_destination,json=transferTaxDestination,proto3,enum=cosmos.bank.v1beta1.TransferTaxDestination" json:"transfer_tax_destination,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +129,27 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetBurnAuthorizationTimeout() uint64 {
+	if m != nil {
+		return m.BurnAuthorizationTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMemoPruneAfterBlocks() uint64 {
+	if m != nil {
+		return m.MemoPruneAfterBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTransferTaxDestination() TransferTaxDestination {
+	if m != nil {
+		return m.TransferTaxDestination
+	}
+	return TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -357,6 +421,10 @@ type Metadata struct {
 	//
 	// Since: cosmos-sdk 0.46
 	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// supply_cap is the maximum supply of the base denom, or unset if the supply
+	// is not capped. It can only be set when the metadata of the denom is first
+	// registered and is immutable thereafter. Optional.
+	SupplyCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=supply_cap,json=supplyCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply_cap,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -448,7 +516,249 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// FrozenAccount is a denom whose transfers from an account are frozen.
+type FrozenAccount struct {
+	// address is the address of the frozen account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denom frozen for the account.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FrozenAccount) Reset()         { *m = FrozenAccount{} }
+func (m *FrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccount) ProtoMessage()    {}
+func (*FrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *FrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccount.Merge(m, src)
+}
+func (m *FrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccount proto.InternalMessageInfo
+
+func (m *FrozenAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// Escrow is an atomic exchange of tokens between two parties: the send_amount
+// of the sender is held until the recipient sends the receive_amount to the
+// sender, or refunded to the sender once the escrow expired.
+type Escrow struct {
+	// id is the unique identifier of the escrow.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account whose tokens are held in escrow.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account which can fulfill the escrow.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// send_amount is the amount held in escrow, released to the recipient.
+	SendAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=send_amount,json=sendAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"send_amount"`
+	// receive_amount is the amount the recipient sends to the sender.
+	ReceiveAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=receive_amount,json=receiveAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"receive_amount"`
+	// expiry_height is the last height at which the escrow can be fulfilled.
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Escrow) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Escrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Escrow) GetSendAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SendAmount
+	}
+	return nil
+}
+
+func (m *Escrow) GetReceiveAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReceiveAmount
+	}
+	return nil
+}
+
+func (m *Escrow) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// TransferMemo is the receipt of a transfer sent with MsgSendWithMemo, storing
+// the memo describing the purpose of the transfer.
+type TransferMemo struct {
+	// from_address is the address of the sender of the transfer.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// sequence is the sequence of the transfer among the transfers with a memo
+	// of the sender, starting at 1.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// to_address is the address of the recipient of the transfer.
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the amount transferred.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// memo describes the purpose of the transfer.
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// height is the height at which the transfer was sent.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TransferMemo) Reset()         { *m = TransferMemo{} }
+func (m *TransferMemo) String() string { return proto.CompactTextString(m) }
+func (*TransferMemo) ProtoMessage()    {}
+func (*TransferMemo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{9}
+}
+func (m *TransferMemo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferMemo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferMemo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMemo.Merge(m, src)
+}
+func (m *TransferMemo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferMemo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMemo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferMemo proto.InternalMessageInfo
+
+func (m *TransferMemo) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *TransferMemo) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TransferMemo) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *TransferMemo) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TransferMemo) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *TransferMemo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.bank.v1beta1.TransferTaxDestination", TransferTaxDestination_name, TransferTaxDestination_value)
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
@@ -456,54 +766,85 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*FrozenAccount)(nil), "cosmos.bank.v1beta1.FrozenAccount")
+	proto.RegisterType((*Escrow)(nil), "cosmos.bank.v1beta1.Escrow")
+	proto.RegisterType((*TransferMemo)(nil), "cosmos.bank.v1beta1.TransferMemo")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0x13, 0x3f,
-	0x14, 0x8f, 0x93, 0xe6, 0x47, 0x9d, 0xef, 0x77, 0xc0, 0x44, 0xe0, 0x16, 0xe9, 0x12, 0x32, 0xa0,
-	0xb4, 0x52, 0x13, 0x5a, 0xc4, 0x92, 0x05, 0x91, 0x82, 0x4a, 0x06, 0x04, 0xba, 0xaa, 0x42, 0x62,
-	0x89, 0x9c, 0x9c, 0x9b, 0x58, 0xbd, 0xb3, 0x4f, 0x67, 0x5f, 0xd5, 0xac, 0x4c, 0xa8, 0x13, 0x23,
-	0x12, 0x4b, 0x27, 0x84, 0x18, 0x50, 0x87, 0x2e, 0xfc, 0x07, 0x15, 0x53, 0xc5, 0xc4, 0x54, 0x50,
-	0x3a, 0x94, 0x3f, 0x03, 0xd9, 0xbe, 0x4b, 0x53, 0xa9, 0xb0, 0x21, 0xb1, 0x24, 0xef, 0xbd, 0xcf,
-	0xf3, 0xfb, 0x7c, 0xfc, 0xde, 0xf3, 0x41, 0x67, 0x20, 0x64, 0x20, 0x64, 0xab, 0x4f, 0xf8, 0x4e,
-	0x6b, 0x77, 0xb5, 0x4f, 0x15, 0x59, 0x35, 0x4e, 0x33, 0x8c, 0x84, 0x12, 0xe8, 0xba, 0xc5, 0x9b,
-	0x26, 0x94, 0xe0, 0x8b, 0x95, 0xa1, 0x18, 0x0a, 0x83, 0xb7, 0xb4, 0x65, 0x53, 0x17, 0x17, 0x6c,
-	0x6a, 0xcf, 0x02, 0xc9, 0x39, 0x0b, 0x5d, 0xb0, 0x48, 0x3a, 0x65, 0x19, 0x08, 0xc6, 0x13, 0xfc,
-	0x66, 0x82, 0x07, 0x72, 0xd8, 0xda, 0x5d, 0xd5, 0x7f, 0x09, 0x70, 0x8d, 0x04, 0x8c, 0x8b, 0x96,
-	0xf9, 0xb5, 0xa1, 0xfa, 0x7b, 0x00, 0x0b, 0xcf, 0x49, 0x44, 0x02, 0x89, 0x36, 0xe0, 0x7f, 0x92,
-	0x72, 0xaf, 0x47, 0x39, 0xe9, 0xfb, 0xd4, 0xc3, 0xa0, 0x96, 0x6b, 0x94, 0xd7, 0x6a, 0xcd, 0x2b,
-	0x34, 0x37, 0x37, 0x29, 0xf7, 0x1e, 0xdb, 0xbc, 0x4e, 0x16, 0x03, 0xb7, 0x2c, 0x2f, 0x02, 0xe8,
-	0x2e, 0xac, 0x78, 0x74, 0x9b, 0xc4, 0xbe, 0xea, 0x5d, 0x2a, 0x98, 0xad, 0x81, 0x46, 0xc9, 0x45,
-	0x09, 0x36, 0x53, 0xa2, 0x7d, 0xfb, 0xed, 0x41, 0x35, 0xb3, 0x7f, 0x7e, 0xb8, 0x8c, 0x2d, 0xd9,
-	0x8a, 0xf4, 0x76, 0x5a, 0x7b, 0xb6, 0x8d, 0x56, 0x5d, 0x7d, 0x03, 0x96, 0x67, 0x4e, 0xa0, 0x0a,
-	0xcc, 0x7b, 0x94, 0x8b, 0x00, 0x83, 0x1a, 0x68, 0xcc, 0xbb, 0xd6, 0x41, 0x18, 0x16, 0x2f, 0x93,
-	0xa5, 0x6e, 0xbb, 0xa4, 0x19, 0x7e, 0x1e, 0x54, 0x41, 0xfd, 0x33, 0x80, 0xf9, 0x2e, 0x0f, 0x63,
-	0x85, 0xd6, 0x60, 0x91, 0x78, 0x5e, 0x44, 0xa5, 0xb4, 0x55, 0x3a, 0xf8, 0xeb, 0xd1, 0x4a, 0x25,
-	0xb9, 0xee, 0x43, 0x8b, 0x6c, 0xaa, 0x88, 0xf1, 0xa1, 0x9b, 0x26, 0xa2, 0x6d, 0x98, 0xd7, 0x9d,
-	0x96, 0x38, 0x6b, 0xba, 0xb3, 0x70, 0xd1, 0x1d, 0x49, 0xa7, 0xdd, 0x59, 0x17, 0x8c, 0x77, 0xee,
-	0x1f, 0x9f, 0x56, 0x33, 0x1f, 0xbf, 0x57, 0x1b, 0x43, 0xa6, 0x46, 0x71, 0xbf, 0x39, 0x10, 0x41,
-	0x32, 0xc6, 0xd6, 0xcc, 0x25, 0xd5, 0x38, 0xa4, 0xd2, 0x1c, 0x90, 0x1f, 0xce, 0x0f, 0x97, 0x81,
-	0x6b, 0xcb, 0xb7, 0x2b, 0xaf, 0xad, 0xde, 0xcc, 0xab, 0xf3, 0xc3, 0xe5, 0x94, 0xbd, 0xfe, 0x09,
-	0xc0, 0xc2, 0xb3, 0x58, 0xfd, 0xeb, 0xe2, 0x4b, 0xa9, 0xf8, 0xfa, 0x3b, 0x00, 0x0b, 0x9b, 0x71,
-	0x18, 0xfa, 0x63, 0x4d, 0xae, 0x84, 0x22, 0x7e, 0xb2, 0x57, 0x7f, 0x81, 0xdc, 0x94, 0x6f, 0x2f,
-	0x25, 0xe4, 0xe0, 0xcb, 0xd1, 0xca, 0xad, 0x2b, 0x77, 0xd7, 0xe8, 0xe9, 0x62, 0x50, 0x7f, 0x01,
-	0xe7, 0x1f, 0xe9, 0xbd, 0xd9, 0xe2, 0x4c, 0xfd, 0x66, 0xa3, 0x16, 0x61, 0x89, 0xee, 0x85, 0x82,
-	0x53, 0xae, 0xcc, 0x4a, 0xfd, 0xef, 0x4e, 0x7d, 0xbd, 0x6d, 0xc4, 0x67, 0x44, 0x52, 0x89, 0x73,
-	0xb5, 0x5c, 0x63, 0xde, 0x4d, 0xdd, 0xfa, 0x7e, 0x16, 0x96, 0x9e, 0x52, 0x45, 0x3c, 0xa2, 0x08,
-	0xaa, 0xc1, 0xb2, 0x47, 0xe5, 0x20, 0x62, 0xa1, 0x62, 0x82, 0x27, 0xe5, 0x67, 0x43, 0xe8, 0x81,
-	0xce, 0xe0, 0x22, 0xe8, 0xc5, 0x9c, 0xa9, 0x74, 0x3a, 0xce, 0x95, 0x0f, 0x6f, 0xaa, 0xd7, 0x85,
-	0x5e, 0x6a, 0x4a, 0x84, 0xe0, 0x9c, 0x6e, 0x23, 0xce, 0x99, 0xda, 0xc6, 0xd6, 0xea, 0x3c, 0x26,
-	0x43, 0x9f, 0x8c, 0xf1, 0x9c, 0x09, 0xa7, 0xae, 0xce, 0xe6, 0x24, 0xa0, 0x38, 0x6f, 0xb3, 0xb5,
-	0x8d, 0x6e, 0xc0, 0x82, 0x1c, 0x07, 0x7d, 0xe1, 0xe3, 0x82, 0x89, 0x26, 0x1e, 0x5a, 0x80, 0xb9,
-	0x38, 0x62, 0xb8, 0x68, 0x56, 0xac, 0x38, 0x39, 0xad, 0xe6, 0xb6, 0xdc, 0xae, 0xab, 0x63, 0xe8,
-	0x0e, 0x2c, 0xc5, 0x11, 0xeb, 0x8d, 0x88, 0x1c, 0xe1, 0x92, 0xc1, 0xcb, 0x93, 0xd3, 0x6a, 0x71,
-	0xcb, 0xed, 0x3e, 0x21, 0x72, 0xe4, 0x16, 0xe3, 0x88, 0x69, 0xa3, 0xb3, 0x7e, 0x3c, 0x71, 0xc0,
-	0xc9, 0xc4, 0x01, 0x3f, 0x26, 0x0e, 0x78, 0x73, 0xe6, 0x64, 0x4e, 0xce, 0x9c, 0xcc, 0xb7, 0x33,
-	0x27, 0xf3, 0x72, 0xe9, 0x8f, 0x03, 0x4e, 0xde, 0xbf, 0x99, 0x73, 0xbf, 0x60, 0x3e, 0x57, 0xf7,
-	0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x31, 0xf6, 0x7e, 0x4e, 0x62, 0x05, 0x00, 0x00,
+	// 1117 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6b, 0x1b, 0x47,
+	0x14, 0xd7, 0x4a, 0xb2, 0x3e, 0x46, 0x76, 0x48, 0xa7, 0x22, 0xd9, 0xb8, 0x54, 0x52, 0x54, 0x48,
+	0x15, 0x07, 0x4b, 0x8d, 0x4b, 0xda, 0xe2, 0x16, 0x8a, 0x64, 0x3b, 0x89, 0xa0, 0xfe, 0x60, 0x2c,
+	0x93, 0xa6, 0x97, 0x65, 0xb4, 0x3b, 0x96, 0x06, 0x6b, 0x67, 0x36, 0x3b, 0xb3, 0x8e, 0x9d, 0x63,
+	0x4f, 0xa5, 0xa7, 0x42, 0x2f, 0x85, 0x5e, 0x72, 0x2c, 0x3d, 0x14, 0x1f, 0x4c, 0xa1, 0xff, 0x41,
+	0xe8, 0x29, 0xe4, 0x54, 0x7a, 0x70, 0x8b, 0x7d, 0x70, 0xfe, 0x8c, 0x32, 0xb3, 0xbb, 0x92, 0x0c,
+	0x4a, 0x6d, 0x0a, 0x86, 0x5e, 0xa4, 0x79, 0xef, 0xf7, 0xe6, 0xfd, 0xde, 0xbc, 0x8f, 0x99, 0x05,
+	0x25, 0x9b, 0x0b, 0x97, 0x8b, 0x46, 0x17, 0xb3, 0x9d, 0xc6, 0xee, 0xdd, 0x2e, 0x91, 0xf8, 0xae,
+	0x16, 0xea, 0x9e, 0xcf, 0x25, 0x87, 0x6f, 0x87, 0x78, 0x5d, 0xab, 0x22, 0x7c, 0xb6, 0xd8, 0xe3,
+	0x3d, 0xae, 0xf1, 0x86, 0x5a, 0x85, 0xa6, 0xb3, 0x37, 0x42, 0x53, 0x2b, 0x04, 0xa2, 0x7d, 0x21,
+	0x34, 0x62, 0x11, 0x64, 0xc8, 0x62, 0x73, 0xca, 0x22, 0xfc, 0x7a, 0x84, 0xbb, 0xa2, 0xd7, 0xd8,
+	0xbd, 0xab, 0xfe, 0x22, 0xe0, 0x2d, 0xec, 0x52, 0xc6, 0x1b, 0xfa, 0x37, 0x54, 0x55, 0x5f, 0xa7,
+	0x40, 0x66, 0x03, 0xfb, 0xd8, 0x15, 0xf0, 0x01, 0x98, 0x16, 0x84, 0x39, 0x16, 0x61, 0xb8, 0x3b,
+	0x20, 0x8e, 0x69, 0x54, 0x52, 0xb5, 0xc2, 0x42, 0xa5, 0x3e, 0x21, 0xe6, 0xfa, 0x26, 0x61, 0xce,
+	0x4a, 0x68, 0xd7, 0x4a, 0x9a, 0x06, 0x2a, 0x88, 0x91, 0x02, 0x7e, 0x00, 0x8a, 0x0e, 0xd9, 0xc6,
+	0xc1, 0x40, 0x5a, 0x67, 0x1c, 0x26, 0x2b, 0x46, 0x2d, 0x87, 0x60, 0x84, 0x8d, 0xb9, 0x80, 0x9f,
+	0x81, 0xd9, 0x6e, 0xe0, 0x33, 0x0b, 0x07, 0xb2, 0xcf, 0x7d, 0xfa, 0x0c, 0x4b, 0xca, 0x99, 0x25,
+	0xa9, 0x4b, 0x78, 0x20, 0xcd, 0x54, 0xc5, 0xa8, 0xa5, 0x91, 0xa9, 0x2c, 0x9a, 0xe3, 0x06, 0x9d,
+	0x10, 0x87, 0xf7, 0xc0, 0x75, 0x97, 0xb8, 0xdc, 0xf2, 0xfc, 0x80, 0x11, 0x0b, 0x6f, 0x4b, 0xe2,
+	0x5b, 0xdd, 0x01, 0xb7, 0x77, 0x84, 0x99, 0xd6, 0x5b, 0x8b, 0x0a, 0xde, 0x50, 0x68, 0x53, 0x81,
+	0x2d, 0x8d, 0x41, 0x07, 0x4c, 0x4b, 0x1f, 0x33, 0xb1, 0x4d, 0x7c, 0x4b, 0xe2, 0x3d, 0x73, 0xaa,
+	0x62, 0xd4, 0xf2, 0xad, 0xe6, 0x8b, 0xa3, 0x72, 0xe2, 0xcf, 0xa3, 0xf2, 0xad, 0x1e, 0x95, 0xfd,
+	0xa0, 0x5b, 0xb7, 0xb9, 0x1b, 0x65, 0x3f, 0xfa, 0x9b, 0x17, 0xce, 0x4e, 0x43, 0xee, 0x7b, 0x44,
+	0xd4, 0x97, 0x89, 0xfd, 0xea, 0x70, 0x1e, 0x44, 0x09, 0x5a, 0x26, 0xf6, 0x4f, 0xa7, 0x07, 0x73,
+	0x06, 0x2a, 0xc4, 0x6e, 0x3b, 0x78, 0x0f, 0x12, 0x60, 0x8e, 0xb3, 0x58, 0x0e, 0x11, 0x92, 0x32,
+	0x1d, 0xbf, 0x99, 0xa9, 0x18, 0xb5, 0x2b, 0x0b, 0x77, 0x26, 0x66, 0xb8, 0x33, 0xf2, 0xb1, 0x3c,
+	0xda, 0x82, 0xae, 0xc9, 0x89, 0xfa, 0xc5, 0x9b, 0x3f, 0x3c, 0x2f, 0x27, 0xbe, 0x3d, 0x3d, 0x98,
+	0x33, 0xc7, 0xa2, 0xdc, 0x0b, 0x1b, 0x31, 0xac, 0x6f, 0xf5, 0x01, 0x28, 0x8c, 0xe7, 0xbc, 0x08,
+	0xa6, 0x1c, 0xc2, 0xb8, 0x6b, 0x1a, 0xea, 0xdc, 0x28, 0x14, 0xa0, 0x09, 0xb2, 0x67, 0xcb, 0x15,
+	0x8b, 0x8b, 0x39, 0xc5, 0xf0, 0xfa, 0x79, 0xd9, 0xa8, 0xfe, 0x66, 0x80, 0xa9, 0x36, 0xf3, 0x02,
+	0x09, 0x17, 0x40, 0x16, 0x3b, 0x8e, 0x4f, 0x84, 0x08, 0xbd, 0xb4, 0xcc, 0x57, 0x87, 0xf3, 0xc5,
+	0xe8, 0x38, 0xcd, 0x10, 0xd9, 0x94, 0x3e, 0x65, 0x3d, 0x14, 0x1b, 0xc2, 0x6d, 0x30, 0xa5, 0x7a,
+	0x55, 0x98, 0x49, 0xdd, 0x5f, 0x37, 0x46, 0xa7, 0x17, 0x64, 0x78, 0xfa, 0x25, 0x4e, 0x59, 0xeb,
+	0x9e, 0x2a, 0xc5, 0xcf, 0x7f, 0x95, 0x6b, 0x17, 0x28, 0x85, 0xda, 0x20, 0xc2, 0xf4, 0x87, 0xee,
+	0x17, 0x8b, 0xdf, 0x84, 0xf1, 0x26, 0xbe, 0x3e, 0x3d, 0x98, 0x8b, 0xd9, 0xab, 0xbf, 0x18, 0x20,
+	0xb3, 0x1e, 0xc8, 0xff, 0x7b, 0xf0, 0xb9, 0x38, 0xf8, 0xea, 0x8f, 0x06, 0xc8, 0x6c, 0x06, 0x9e,
+	0x37, 0xd8, 0x57, 0xe4, 0x92, 0x4b, 0x3c, 0x30, 0x8d, 0xcb, 0x22, 0xd7, 0xee, 0x17, 0x6f, 0x47,
+	0xe4, 0xc6, 0xef, 0x87, 0xf3, 0xef, 0x4c, 0x9c, 0x7e, 0x1d, 0x4f, 0xdb, 0x34, 0xaa, 0x8f, 0x40,
+	0x7e, 0x59, 0xf5, 0xcd, 0x16, 0xa3, 0xf2, 0x0d, 0x1d, 0x35, 0x0b, 0x72, 0x64, 0xcf, 0xe3, 0x8c,
+	0x30, 0xa9, 0x5b, 0x6a, 0x06, 0x0d, 0x65, 0xd5, 0x6d, 0x78, 0x40, 0xb1, 0x20, 0xc2, 0x4c, 0x55,
+	0x52, 0xb5, 0x3c, 0x8a, 0xc5, 0xea, 0x49, 0x12, 0xe4, 0x56, 0x89, 0xc4, 0x0e, 0x96, 0x18, 0x56,
+	0x40, 0xc1, 0x21, 0xc2, 0xf6, 0xa9, 0xa7, 0xc7, 0x26, 0x74, 0x3f, 0xae, 0x82, 0x9f, 0x2b, 0x0b,
+	0xc6, 0x5d, 0x2b, 0x60, 0x54, 0xc6, 0xd5, 0x29, 0x4d, 0x1c, 0xac, 0x61, 0xbc, 0x08, 0x38, 0xf1,
+	0x52, 0x40, 0x08, 0xd2, 0x2a, 0x8d, 0xfa, 0xae, 0xc9, 0x23, 0xbd, 0x56, 0xd1, 0x39, 0x54, 0x78,
+	0x03, 0xbc, 0xaf, 0xef, 0x91, 0x3c, 0x8a, 0x45, 0x65, 0xcd, 0xb0, 0x4b, 0xc2, 0x2b, 0x03, 0xe9,
+	0x35, 0xbc, 0x06, 0x32, 0x62, 0xdf, 0xed, 0xf2, 0x81, 0x1e, 0xeb, 0x3c, 0x8a, 0x24, 0x78, 0x03,
+	0xa4, 0x02, 0x9f, 0x9a, 0x59, 0xdd, 0x62, 0xd9, 0xe3, 0xa3, 0x72, 0x6a, 0x0b, 0xb5, 0x91, 0xd2,
+	0xc1, 0x5b, 0x20, 0x17, 0xf8, 0xd4, 0xea, 0x63, 0xd1, 0x37, 0x73, 0x1a, 0x2f, 0x1c, 0x1f, 0x95,
+	0xb3, 0x5b, 0xa8, 0xfd, 0x10, 0x8b, 0x3e, 0xca, 0x06, 0x3e, 0x55, 0x0b, 0xf8, 0x08, 0x00, 0xa1,
+	0x53, 0x6e, 0xd9, 0xd8, 0x33, 0xf3, 0xda, 0xf2, 0x93, 0x0b, 0xde, 0x51, 0x6d, 0x26, 0xc7, 0xee,
+	0xa8, 0x36, 0x93, 0x28, 0x1f, 0xfa, 0x5a, 0xc2, 0x5e, 0xf5, 0x31, 0x98, 0xb9, 0xef, 0xf3, 0x67,
+	0x84, 0x35, 0x6d, 0x9b, 0x07, 0xec, 0xbf, 0xcd, 0xc4, 0xb0, 0xec, 0xc9, 0xb1, 0xb2, 0x57, 0xbf,
+	0x4f, 0x81, 0xcc, 0x8a, 0xb0, 0x7d, 0xfe, 0x14, 0x5e, 0x01, 0x49, 0xea, 0x68, 0x7f, 0x69, 0x94,
+	0xa4, 0xea, 0x7d, 0xc8, 0xa8, 0x77, 0x81, 0xf8, 0x66, 0xf2, 0x1c, 0x8e, 0xc8, 0x0e, 0x7e, 0x04,
+	0xf2, 0x3e, 0xb1, 0xa9, 0x47, 0x55, 0x13, 0xa5, 0xce, 0xd9, 0x34, 0x32, 0x85, 0x4f, 0x80, 0x7e,
+	0x98, 0x2c, 0xec, 0xaa, 0xd3, 0x99, 0xe9, 0x4b, 0x9a, 0x1b, 0xa0, 0x48, 0x9a, 0x9a, 0x03, 0x3e,
+	0x05, 0x57, 0x7c, 0x62, 0x13, 0xba, 0x4b, 0x62, 0xd6, 0xa9, 0x4b, 0x62, 0x9d, 0x89, 0x78, 0x22,
+	0xe2, 0xf7, 0xc0, 0x0c, 0xd9, 0xf3, 0xa8, 0xbf, 0x6f, 0xf5, 0x09, 0xed, 0xf5, 0xa5, 0x6e, 0xc3,
+	0x14, 0x9a, 0x0e, 0x95, 0x0f, 0xb5, 0xae, 0xfa, 0x6b, 0x12, 0x4c, 0xc7, 0x2f, 0xcb, 0x2a, 0x71,
+	0x39, 0xfc, 0x14, 0x4c, 0x6f, 0xfb, 0xdc, 0xb5, 0x2e, 0x5a, 0xf5, 0x82, 0xb2, 0x8e, 0x54, 0x6a,
+	0xb4, 0x05, 0x79, 0x12, 0x10, 0x66, 0x13, 0x5d, 0xca, 0x34, 0x1a, 0xca, 0xf0, 0x63, 0x00, 0x24,
+	0x1f, 0xba, 0x3d, 0xb7, 0x66, 0x92, 0xc7, 0x4e, 0xfb, 0x20, 0x73, 0xc9, 0xe5, 0x8a, 0xfc, 0xab,
+	0x29, 0x56, 0x1f, 0x06, 0xf1, 0x14, 0xab, 0xb5, 0x9a, 0xe2, 0x33, 0xe9, 0x8b, 0xa4, 0xb9, 0x3e,
+	0xb8, 0x36, 0xf9, 0x45, 0x86, 0x37, 0xc1, 0xbb, 0x1d, 0xd4, 0x5c, 0xdb, 0xbc, 0xbf, 0x82, 0xac,
+	0x4e, 0xf3, 0x4b, 0x6b, 0x79, 0x65, 0xb3, 0xd3, 0x5e, 0x6b, 0x76, 0xda, 0xeb, 0x6b, 0x56, 0x6b,
+	0x0b, 0xad, 0x5d, 0x4d, 0xc0, 0x3b, 0xe0, 0xfd, 0x37, 0x9a, 0x2c, 0xad, 0xaf, 0xae, 0x6e, 0xad,
+	0xb5, 0x3b, 0x8f, 0xad, 0x8d, 0xf5, 0xf5, 0x2f, 0xae, 0x1a, 0xad, 0xa5, 0x17, 0xc7, 0x25, 0xe3,
+	0xe5, 0x71, 0xc9, 0xf8, 0xfb, 0xb8, 0x64, 0x7c, 0x77, 0x52, 0x4a, 0xbc, 0x3c, 0x29, 0x25, 0xfe,
+	0x38, 0x29, 0x25, 0xbe, 0xba, 0xfd, 0xaf, 0xc7, 0x8c, 0x1e, 0x7b, 0x7d, 0xda, 0x6e, 0x46, 0x7f,
+	0xdd, 0x7d, 0xf8, 0xcf, 0x00, 0xf9, 0x45, 0x5a, 0x30, 0x91, 0x0a, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -582,6 +923,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferTaxDestination != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.TransferTaxDestination))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TransferTax.Size()
+		i -= size
+		if _, err := m.TransferTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MemoPruneAfterBlocks != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MemoPruneAfterBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BurnAuthorizationTimeout != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.BurnAuthorizationTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -838,6 +1204,18 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SupplyCap != nil {
+		{
+			size := m.SupplyCap.Size()
+			i -= size
+			if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBank(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.URIHash) > 0 {
 		i -= len(m.URIHash)
 		copy(dAtA[i:], m.URIHash)
@@ -904,68 +1282,259 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FrozenAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SendEnabled) > 0 {
-		for _, e := range m.SendEnabled {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
-		}
-	}
-	if m.DefaultSendEnabled {
-		n += 2
-	}
-	return n
+
+func (m *FrozenAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Enabled {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	if len(m.ReceiveAmount) > 0 {
+		for iNdEx := len(m.ReceiveAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return n
+	if len(m.SendAmount) > 0 {
+		for iNdEx := len(m.SendAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferMemo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMemo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DefaultSendEnabled {
+		n += 2
+	}
+	if m.BurnAuthorizationTimeout != 0 {
+		n += 1 + sovBank(uint64(m.BurnAuthorizationTimeout))
+	}
+	if m.MemoPruneAfterBlocks != 0 {
+		n += 1 + sovBank(uint64(m.MemoPruneAfterBlocks))
+	}
+	l = m.TransferTax.Size()
+	n += 1 + l + sovBank(uint64(l))
+	if m.TransferTaxDestination != 0 {
+		n += 1 + sovBank(uint64(m.TransferTaxDestination))
+	}
+	return n
+}
+
+func (m *SendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *Output) Size() (n int) {
@@ -1064,6 +1633,95 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBank(uint64(l))
 	}
+	if m.SupplyCap != nil {
+		l = m.SupplyCap.Size()
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *FrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.SendAmount) > 0 {
+		for _, e := range m.SendAmount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.ReceiveAmount) > 0 {
+		for _, e := range m.ReceiveAmount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovBank(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *TransferMemo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBank(uint64(m.Sequence))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
 	return n
 }
 
@@ -1156,6 +1814,515 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAuthorizationTimeout", wireType)
+			}
+			m.BurnAuthorizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnAuthorizationTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoPruneAfterBlocks", wireType)
+			}
+			m.MemoPruneAfterBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoPruneAfterBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTaxDestination", wireType)
+			}
+			m.TransferTaxDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferTaxDestination |= TransferTaxDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1177,7 +2344,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendEnabled) Unmarshal(dAtA []byte) error {
+func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1200,10 +2367,10 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1240,9 +2407,9 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			var v int
+			m.Exponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1252,12 +2419,43 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Exponent |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1279,7 +2477,7 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1302,15 +2500,15 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1338,11 +2536,11 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1369,64 +2567,78 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBank(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Output) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBank
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1454,13 +2666,13 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1470,81 +2682,93 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBank(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Supply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBank
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1554,23 +2778,25 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.SupplyCap = &v
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1595,7 +2821,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+func (m *FrozenAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1618,15 +2844,15 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrozenAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1654,30 +2880,11 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
-			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBank
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1705,7 +2912,7 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1728,7 +2935,7 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *Escrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1751,15 +2958,34 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1787,11 +3013,43 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1818,16 +3076,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
-			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendAmount = append(m.SendAmount, types.Coin{})
+			if err := m.SendAmount[len(m.SendAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1837,29 +3095,31 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.ReceiveAmount = append(m.ReceiveAmount, types.Coin{})
+			if err := m.ReceiveAmount[len(m.ReceiveAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1869,27 +3129,64 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBank
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBank
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferMemo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMemo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1917,11 +3214,30 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1949,13 +3265,13 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1965,27 +3281,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2013,8 +3331,27 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &BurnAuthorization{}
+
+// NewBurnAuthorization creates a new BurnAuthorization object expiring after
+// expiryHeight, or never if expiryHeight is zero.
+func NewBurnAuthorization(maxAmount sdk.Coins, expiryHeight int64) *BurnAuthorization {
+	return &BurnAuthorization{
+		MaxAmount:    maxAmount,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BurnAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgBurnCoins{})
+}
+
+// IsExpired returns true if coins can no longer be burnt under the
+// authorization at the given height.
+func (a BurnAuthorization) IsExpired(height int64) bool {
+	return a.ExpiryHeight > 0 && height > a.ExpiryHeight
+}
+
+// Accept implements Authorization.Accept.
+func (a BurnAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mBurn, ok := msg.(*MsgBurnCoins)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.IsExpired(ctx.BlockHeight()) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("burn authorization expired at height %d", a.ExpiryHeight)
+	}
+
+	limitLeft, isNegative := a.MaxAmount.SafeSub(mBurn.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than burn limit")
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: NewBurnAuthorization(limitLeft, a.ExpiryHeight)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BurnAuthorization) ValidateBasic() error {
+	if len(a.MaxAmount) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("max amount cannot be nil")
+	}
+	if !a.MaxAmount.IsValid() || !a.MaxAmount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("max amount must be positive")
+	}
+	if a.ExpiryHeight < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiry height cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBurnAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(types.StoreKey), sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{Height: 10})
+	authorization := types.NewBurnAuthorization(coins1000, 10)
+
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgBurnCoins", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+	require.Error(t, types.NewBurnAuthorization(nil, 10).ValidateBasic())
+	require.Error(t, types.NewBurnAuthorization(coins1000, -1).ValidateBasic())
+
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	_, err = authorization.Accept(ctx, types.NewMsgBurnCoins(fromAddr, coins1000.Add(coins500...)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	resp, err := authorization.Accept(ctx, types.NewMsgBurnCoins(fromAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewBurnAuthorization(coins500, 10), resp.Updated)
+
+	resp, err = resp.Updated.Accept(ctx, types.NewMsgBurnCoins(fromAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = authorization.Accept(ctx.WithBlockHeight(11), types.NewMsgBurnCoins(fromAddr, coins500))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = types.NewBurnAuthorization(coins1000, 0).Accept(ctx.WithBlockHeight(1000), types.NewMsgBurnCoins(fromAddr, coins500))
+	require.NoError(t, err)
+}
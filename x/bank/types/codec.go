@@ -20,8 +20,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeAccount{}, "cosmos-sdk/MsgFreezeAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeAccount{}, "cosmos-sdk/MsgUnfreezeAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateEscrow{}, "cosmos-sdk/MsgCreateEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgFulfillEscrow{}, "cosmos-sdk/MsgFulfillEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantBurnAuthorization{}, "cosmos-sdk/MsgGrantBurnAuthorization")
+	legacy.RegisterAminoMsg(cdc, &MsgBurnCoins{}, "cosmos-sdk/MsgBurnCoins")
+	legacy.RegisterAminoMsg(cdc, &MsgSendWithMemo{}, "cosmos-sdk/MsgSendWithMemo")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&BurnAuthorization{}, "cosmos-sdk/BurnAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
 }
 
@@ -34,6 +42,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&SendAuthorization{},
+		&BurnAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
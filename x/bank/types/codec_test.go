@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	codectestutil "github.com/cosmos/cosmos-sdk/testutil/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCodecDeterminism(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 5))
+
+	codectestutil.AssertCodecDeterminism(t, codec.NewProtoCodec(registry), amino, []codec.ProtoMarshaler{
+		NewMsgSend(sdk.MustAccAddressFromBech32(addr1), sdk.MustAccAddressFromBech32(addr2), coins),
+		NewMsgMultiSend([]Input{NewInput(sdk.MustAccAddressFromBech32(addr1), coins)}, []Output{NewOutput(sdk.MustAccAddressFromBech32(addr2), coins)}),
+		&MsgUpdateParams{Authority: addr1, Params: DefaultParams()},
+		&MsgSetSendEnabled{Authority: addr1, SendEnabled: []*SendEnabled{{Denom: "atom", Enabled: true}}, UseDefaultFor: []string{"stake"}},
+		&MsgFreezeAccount{Authority: addr1, Address: addr2},
+		&MsgUnfreezeAccount{Authority: addr1, Address: addr2},
+		&MsgCreateEscrow{Sender: addr1, Recipient: addr2, SendAmount: coins, ReceiveAmount: coins, ExpiryHeight: 10},
+		&MsgGrantBurnAuthorization{Authority: addr1, Grantee: addr2, MaxAmount: coins[0]},
+		&MsgBurnCoins{Burner: addr1, Amount: coins},
+		NewSendAuthorization(coins, nil),
+	})
+}
@@ -14,4 +14,9 @@ var (
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = sdkerrors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = sdkerrors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrSupplyCapExceeded     = sdkerrors.Register(ModuleName, 10, "supply cap exceeded")
+	ErrAccountFrozen         = sdkerrors.Register(ModuleName, 11, "account frozen")
+	ErrEscrowNotFound        = sdkerrors.Register(ModuleName, 12, "escrow not found")
+	ErrInvalidEscrow         = sdkerrors.Register(ModuleName, 13, "invalid escrow")
+	ErrTransferMemoNotFound  = sdkerrors.Register(ModuleName, 14, "transfer memo not found")
 )
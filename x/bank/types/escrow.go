@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a basic validation of the escrow fields, except its
+// identifier.
+func (e Escrow) Validate() error {
+	sender, err := sdk.AccAddressFromBech32(e.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(e.Recipient)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if sender.Equals(recipient) {
+		return ErrInvalidEscrow.Wrap("sender and recipient cannot be the same")
+	}
+
+	if !e.SendAmount.IsValid() || e.SendAmount.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid send amount: %s", e.SendAmount)
+	}
+
+	if !e.ReceiveAmount.IsValid() || e.ReceiveAmount.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid receive amount: %s", e.ReceiveAmount)
+	}
+
+	if e.ExpiryHeight <= 0 {
+		return ErrInvalidEscrow.Wrapf("expiry height must be positive: %d", e.ExpiryHeight)
+	}
+
+	return nil
+}
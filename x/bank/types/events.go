@@ -21,6 +21,24 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// escrow events name and attributes
+	EventTypeCreateEscrow  = "create_escrow"
+	EventTypeFulfillEscrow = "fulfill_escrow"
+	EventTypeRefundEscrow  = "refund_escrow"
+
+	AttributeKeyEscrowID = "escrow_id"
+
+	// transfer memo events name and attributes
+	EventTypeTransferWithMemo = "transfer_with_memo"
+
+	AttributeKeyMemo     = "memo"
+	AttributeKeySequence = "sequence"
+
+	// transfer tax events name and attributes
+	EventTypeTransferTax = "transfer_tax"
+
+	AttributeKeyDestination = "destination"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFrozenAccount creates a new FrozenAccount object.
+func NewFrozenAccount(addr sdk.AccAddress, denom string) FrozenAccount {
+	return FrozenAccount{
+		Address: addr.String(),
+		Denom:   denom,
+	}
+}
+
+// Validate gets any errors with this FrozenAccount entry.
+func (fa FrozenAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(fa.Address); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(fa.Denom)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Validate performs basic validation of supply genesis data returning an
@@ -74,6 +75,56 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	seenFrozenAccounts := make(map[string]bool)
+	for _, fa := range gs.FrozenAccounts {
+		if err := fa.Validate(); err != nil {
+			return fmt.Errorf("invalid frozen account %s: %w", fa.Address, err)
+		}
+
+		key := fa.Address + "/" + fa.Denom
+		if seenFrozenAccounts[key] {
+			return fmt.Errorf("duplicate frozen denom %s for account %s", fa.Denom, fa.Address)
+		}
+		seenFrozenAccounts[key] = true
+	}
+
+	seenEscrows := make(map[uint64]bool)
+	for _, escrow := range gs.Escrows {
+		if seenEscrows[escrow.Id] {
+			return fmt.Errorf("duplicate escrow %d", escrow.Id)
+		}
+
+		if err := escrow.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow %d: %w", escrow.Id, err)
+		}
+
+		seenEscrows[escrow.Id] = true
+	}
+
+	seenTransferMemos := make(map[string]bool)
+	for _, memo := range gs.TransferMemos {
+		key := fmt.Sprintf("%s/%d", memo.FromAddress, memo.Sequence)
+		if seenTransferMemos[key] {
+			return fmt.Errorf("duplicate transfer memo %d of %s", memo.Sequence, memo.FromAddress)
+		}
+
+		if err := memo.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer memo %d of %s: %w", memo.Sequence, memo.FromAddress, err)
+		}
+
+		seenTransferMemos[key] = true
+	}
+
+	for _, metadata := range gs.DenomMetadata {
+		if !metadata.HasSupplyCap() {
+			continue
+		}
+
+		if supply := totalSupply.AmountOf(metadata.Base); supply.GT(*metadata.SupplyCap) {
+			return sdkerrors.Wrapf(ErrSupplyCapExceeded, "genesis supply %s%s exceeds the supply cap %s", supply, metadata.Base, metadata.SupplyCap)
+		}
+	}
+
 	return nil
 }
 
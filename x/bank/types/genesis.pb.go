@@ -42,6 +42,13 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// frozen_accounts defines the frozen denoms of the frozen accounts.
+	FrozenAccounts []FrozenAccount `protobuf:"bytes,6,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts"`
+	// escrows defines the pending escrows.
+	Escrows []Escrow `protobuf:"bytes,7,rep,name=escrows,proto3" json:"escrows"`
+	// transfer_memos defines the memos of the transfers sent with
+	// MsgSendWithMemo which are not pruned yet.
+	TransferMemos []TransferMemo `protobuf:"bytes,8,rep,name=transfer_memos,json=transferMemos,proto3" json:"transfer_memos"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +119,27 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferMemos() []TransferMemo {
+	if m != nil {
+		return m.TransferMemos
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -162,35 +190,41 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x13, 0xca, 0xed, 0xbd, 0xd7, 0xbd, 0x20, 0x61, 0x3a, 0xa4, 0x05, 0x92, 0xd2, 0xa9,
-	0x20, 0x35, 0x51, 0x8b, 0x58, 0x18, 0x90, 0x48, 0x85, 0x98, 0xf8, 0x50, 0xbb, 0xb1, 0x54, 0x4e,
-	0x6c, 0xd2, 0xa8, 0x8d, 0x1d, 0xc5, 0x2e, 0xa2, 0x6f, 0xc0, 0xc8, 0x13, 0xa0, 0x8e, 0x88, 0x05,
-	0x06, 0x1e, 0xa2, 0x63, 0xc5, 0xc4, 0x04, 0xa8, 0x1d, 0xe0, 0x31, 0x50, 0x6c, 0x37, 0x8d, 0x44,
-	0xc4, 0xc4, 0x92, 0x44, 0x3e, 0xe7, 0xfc, 0xfe, 0x27, 0xb6, 0xc1, 0xed, 0x90, 0xf1, 0x84, 0x71,
-	0x2f, 0x40, 0x74, 0xee, 0xbd, 0x1e, 0x04, 0x44, 0xa0, 0x81, 0x17, 0x11, 0x4a, 0x78, 0xcc, 0xdd,
-	0x34, 0x63, 0x82, 0xc1, 0xeb, 0xca, 0xe2, 0xe6, 0x16, 0x57, 0x5b, 0xda, 0xcd, 0x88, 0x45, 0x4c,
-	0xea, 0x5e, 0xfe, 0xa5, 0xac, 0x6d, 0xbb, 0xa0, 0x71, 0x52, 0xd0, 0x42, 0x16, 0xd3, 0xbf, 0xf4,
-	0xd2, 0x34, 0xc9, 0x55, 0x7a, 0x4b, 0xe9, 0x53, 0x05, 0xd6, 0x73, 0x95, 0x74, 0x0d, 0x25, 0x31,
-	0x65, 0x9e, 0x7c, 0xaa, 0xa5, 0xee, 0xfb, 0x1a, 0xb8, 0x78, 0xa2, 0xaa, 0x4e, 0x04, 0x12, 0x04,
-	0x3e, 0x04, 0xf5, 0x14, 0x65, 0x28, 0xe1, 0x96, 0xd9, 0x31, 0x7b, 0x8d, 0xe1, 0x0d, 0xb7, 0xa2,
-	0xba, 0xfb, 0x42, 0x5a, 0xfc, 0xf3, 0xcd, 0x77, 0xc7, 0xf8, 0xf0, 0xeb, 0xf3, 0x5d, 0x73, 0xac,
-	0x53, 0x70, 0x04, 0xce, 0x02, 0xb4, 0x40, 0x34, 0x24, 0xdc, 0xba, 0xd4, 0xa9, 0xf5, 0x1a, 0xc3,
-	0x9b, 0x95, 0x04, 0x5f, 0x99, 0xca, 0x88, 0x22, 0x08, 0x67, 0xa0, 0xce, 0x97, 0x69, 0xba, 0x58,
-	0x59, 0x35, 0x89, 0x68, 0x1d, 0x11, 0x9c, 0x14, 0x88, 0x11, 0x8b, 0xa9, 0x7f, 0x3f, 0xcf, 0x7f,
-	0xfc, 0xe1, 0xf4, 0xa2, 0x58, 0xcc, 0x96, 0x81, 0x1b, 0xb2, 0x44, 0xff, 0xb4, 0x7e, 0xf5, 0x39,
-	0x9e, 0x7b, 0x62, 0x95, 0x12, 0x2e, 0x03, 0x5c, 0xd7, 0x55, 0x7c, 0xf8, 0x1c, 0x5c, 0xc5, 0x84,
-	0xb2, 0x64, 0x9a, 0x10, 0x81, 0x30, 0x12, 0xc8, 0xba, 0x2c, 0x27, 0xde, 0xaa, 0x2c, 0xfd, 0x54,
-	0x9b, 0xca, 0xad, 0xaf, 0xc8, 0xfc, 0x41, 0x81, 0xcf, 0xc0, 0x05, 0x27, 0x14, 0x4f, 0x09, 0x45,
-	0xc1, 0x82, 0x60, 0xeb, 0x44, 0xe2, 0x3a, 0x95, 0xb8, 0x09, 0xa1, 0xf8, 0xb1, 0xf2, 0x95, 0x89,
-	0x0d, 0x7e, 0x5c, 0xef, 0x7e, 0x32, 0xc1, 0xa9, 0xde, 0x2b, 0x38, 0x04, 0xa7, 0x08, 0xe3, 0x8c,
-	0x70, 0x75, 0x38, 0xe7, 0xbe, 0xf5, 0xf5, 0x4b, 0xbf, 0xa9, 0xc9, 0x8f, 0x94, 0x32, 0x11, 0x59,
-	0x4c, 0xa3, 0xf1, 0xc1, 0x08, 0x5f, 0x81, 0x93, 0xfc, 0xf2, 0x1c, 0x0e, 0xe3, 0xff, 0xef, 0xa4,
-	0xc2, 0x3f, 0x38, 0x7b, 0xbb, 0x76, 0x8c, 0xdf, 0x6b, 0xc7, 0xf0, 0x47, 0x9b, 0x9d, 0x6d, 0x6e,
-	0x77, 0xb6, 0xf9, 0x73, 0x67, 0x9b, 0xef, 0xf6, 0xb6, 0xb1, 0xdd, 0xdb, 0xc6, 0xb7, 0xbd, 0x6d,
-	0xbc, 0xbc, 0xf3, 0x4f, 0xf2, 0x1b, 0x75, 0xa5, 0xe5, 0x80, 0xa0, 0x2e, 0xaf, 0xe7, 0xbd, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x88, 0xa3, 0x71, 0x5c, 0x03, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6d, 0x92, 0x26, 0xe9, 0xa5, 0x0d, 0xe2, 0xe8, 0xe0, 0x16, 0x70, 0xd2, 0x4c, 0x01,
+	0xa9, 0xb6, 0x1a, 0xc4, 0x02, 0x12, 0x52, 0x1d, 0x15, 0xa6, 0x16, 0x48, 0x98, 0x58, 0xa2, 0xb3,
+	0x7d, 0x49, 0xad, 0xc6, 0x77, 0x96, 0xdf, 0x05, 0x28, 0x9f, 0x80, 0x91, 0x8f, 0xd0, 0x11, 0xb1,
+	0xc0, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x06, 0xf8, 0x14, 0x08, 0xf9, 0xee, 0x92,
+	0x5a, 0xc2, 0x30, 0x75, 0x49, 0xac, 0xfb, 0xff, 0xde, 0xef, 0x3d, 0xcb, 0xef, 0xd0, 0x76, 0xc0,
+	0x21, 0xe6, 0xe0, 0xfa, 0x84, 0x1d, 0xbb, 0x2f, 0x77, 0x7d, 0x2a, 0xc8, 0xae, 0x3b, 0xa6, 0x8c,
+	0x42, 0x04, 0x4e, 0x92, 0x72, 0xc1, 0xf1, 0x75, 0x85, 0x38, 0x19, 0xe2, 0x68, 0x64, 0x6b, 0x63,
+	0xcc, 0xc7, 0x5c, 0xe6, 0x6e, 0xf6, 0xa4, 0xd0, 0x2d, 0x7b, 0x69, 0x03, 0xba, 0xb4, 0x05, 0x3c,
+	0x62, 0x7f, 0xe5, 0xb9, 0x6e, 0xd2, 0xab, 0xf2, 0x4d, 0x95, 0x0f, 0x95, 0x58, 0xf7, 0x55, 0xd1,
+	0x35, 0x12, 0x47, 0x8c, 0xbb, 0xf2, 0x57, 0x1d, 0xb5, 0x7f, 0x97, 0xd1, 0xda, 0x63, 0x35, 0xea,
+	0x40, 0x10, 0x41, 0xf1, 0x43, 0x54, 0x49, 0x48, 0x4a, 0x62, 0xb0, 0xcc, 0x96, 0xd9, 0xa9, 0x77,
+	0x6f, 0x38, 0x05, 0xa3, 0x3b, 0x4f, 0x25, 0xe2, 0xad, 0x9e, 0x7d, 0x6b, 0x1a, 0xef, 0x7f, 0x7e,
+	0xba, 0x63, 0xf6, 0x75, 0x15, 0xee, 0xa1, 0x9a, 0x4f, 0x26, 0x84, 0x05, 0x14, 0xac, 0x2b, 0xad,
+	0x52, 0xa7, 0xde, 0xbd, 0x59, 0x68, 0xf0, 0x14, 0x94, 0x57, 0x2c, 0x0b, 0xf1, 0x11, 0xaa, 0xc0,
+	0x34, 0x49, 0x26, 0x27, 0x56, 0x49, 0x2a, 0x36, 0x2f, 0x14, 0x40, 0x97, 0x8a, 0x1e, 0x8f, 0x98,
+	0x77, 0x2f, 0xab, 0xff, 0xf0, 0xbd, 0xd9, 0x19, 0x47, 0xe2, 0x68, 0xea, 0x3b, 0x01, 0x8f, 0xf5,
+	0x4b, 0xeb, 0xbf, 0x1d, 0x08, 0x8f, 0x5d, 0x71, 0x92, 0x50, 0x90, 0x05, 0xa0, 0xc7, 0x55, 0x7e,
+	0xfc, 0x04, 0x35, 0x42, 0xca, 0x78, 0x3c, 0x8c, 0xa9, 0x20, 0x21, 0x11, 0xc4, 0x2a, 0xcb, 0x8e,
+	0xb7, 0x0a, 0x87, 0x3e, 0xd0, 0x50, 0x7e, 0xea, 0x75, 0x59, 0xbf, 0x48, 0xf0, 0x21, 0x5a, 0x03,
+	0xca, 0xc2, 0x21, 0x65, 0xc4, 0x9f, 0xd0, 0xd0, 0x5a, 0x91, 0xba, 0x56, 0xa1, 0x6e, 0x40, 0x59,
+	0xb8, 0xaf, 0xb8, 0xbc, 0xb1, 0x0e, 0x17, 0xe7, 0xf8, 0x19, 0xba, 0x3a, 0x4a, 0xf9, 0x1b, 0xca,
+	0x86, 0x24, 0x08, 0xf8, 0x94, 0x09, 0xb0, 0x2a, 0x52, 0xd9, 0x2e, 0x54, 0x3e, 0x92, 0xec, 0x9e,
+	0x42, 0xbd, 0x72, 0x26, 0xed, 0x37, 0x46, 0xf9, 0x43, 0xc0, 0x0f, 0x50, 0x95, 0x42, 0x90, 0xf2,
+	0x57, 0x60, 0x55, 0x5b, 0xa5, 0x7f, 0x7e, 0xe3, 0x7d, 0xc9, 0x68, 0xc7, 0xa2, 0x02, 0x1f, 0xa2,
+	0x86, 0x48, 0x09, 0x83, 0x11, 0x4d, 0x87, 0x31, 0x8d, 0x39, 0x58, 0x35, 0xe9, 0xd8, 0x2e, 0x74,
+	0x3c, 0xd7, 0xe8, 0x01, 0x8d, 0xb9, 0x36, 0xad, 0x8b, 0xdc, 0x19, 0xb4, 0x3f, 0x9a, 0xa8, 0xaa,
+	0x77, 0x01, 0x77, 0x51, 0x95, 0x84, 0x61, 0x4a, 0x41, 0x2d, 0xdf, 0xaa, 0x67, 0x7d, 0xf9, 0xbc,
+	0xb3, 0xa1, 0xbd, 0x7b, 0x2a, 0x19, 0x88, 0x34, 0x62, 0xe3, 0xfe, 0x02, 0xc4, 0x23, 0xb4, 0x92,
+	0x5d, 0x8e, 0xc5, 0xb2, 0x5d, 0xfe, 0xa6, 0x28, 0xfd, 0xfd, 0xda, 0xdb, 0xd3, 0xa6, 0xf1, 0xeb,
+	0xb4, 0x69, 0x78, 0xbd, 0xb3, 0x99, 0x6d, 0x9e, 0xcf, 0x6c, 0xf3, 0xc7, 0xcc, 0x36, 0xdf, 0xcd,
+	0x6d, 0xe3, 0x7c, 0x6e, 0x1b, 0x5f, 0xe7, 0xb6, 0xf1, 0xe2, 0xf6, 0x7f, 0xcd, 0xaf, 0xd5, 0x95,
+	0x95, 0x0d, 0xfc, 0x8a, 0xbc, 0x7e, 0x77, 0xff, 0x0c, 0x00, 0x55, 0x59, 0x8f, 0x5d, 0x3c, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +247,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferMemos) > 0 {
+		for iNdEx := len(m.TransferMemos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferMemos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +445,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferMemos) > 0 {
+		for _, e := range m.TransferMemos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +689,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMemos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMemos = append(m.TransferMemos, TransferMemo{})
+			if err := m.TransferMemos[len(m.TransferMemos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
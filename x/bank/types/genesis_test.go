@@ -135,6 +135,56 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"supply within the supply cap",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				DenomMetadata: []Metadata{cappedMetadata(10)},
+			},
+			false,
+		},
+		{
+			"supply exceeding the supply cap",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 11)},
+					},
+				},
+				DenomMetadata: []Metadata{cappedMetadata(10)},
+			},
+			true,
+		},
+		{
+			"negative supply cap",
+			GenesisState{
+				DenomMetadata: []Metadata{cappedMetadata(-1)},
+			},
+			true,
+		},
+		{
+			"duplicate frozen account",
+			GenesisState{
+				FrozenAccounts: []FrozenAccount{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom"},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid frozen account",
+			GenesisState{
+				FrozenAccounts: []FrozenAccount{{Address: "invalid", Denom: "uatom"}},
+			},
+			true,
+		},
 		{
 			"invalid supply",
 			GenesisState{
@@ -158,6 +208,18 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func cappedMetadata(supplyCap int64) Metadata {
+	capInt := math.NewInt(supplyCap)
+	return Metadata{
+		Name:       "Cosmos Hub Atom",
+		Symbol:     "ATOM",
+		DenomUnits: []*DenomUnit{{"uatom", uint32(0), nil}},
+		Base:       "uatom",
+		Display:    "uatom",
+		SupplyCap:  &capInt,
+	}
+}
+
 func TestMigrateSendEnabled(t *testing.T) {
 	tests := []struct {
 		name     string
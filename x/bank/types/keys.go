@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -20,6 +22,25 @@ const (
 	ModuleQueryPath = "store/bank/key"
 )
 
+const (
+	// MaxEscrowRefundsPerBlock is the maximum number of expired escrows refunded
+	// at the end of a block, the others being refunded at the next blocks.
+	MaxEscrowRefundsPerBlock = 100
+
+	// MaxMemoPrunesPerBlock is the maximum number of transfer memos pruned at
+	// the end of a block, the others being pruned at the next blocks.
+	MaxMemoPrunesPerBlock = 1000
+
+	// MaxBurnAuthorizationPrunesPerBlock is the maximum number of expired burn
+	// authorizations pruned at the end of a block, the others being pruned at
+	// the next blocks.
+	MaxBurnAuthorizationPrunesPerBlock = 100
+)
+
+// supplyRankAmountLen is the length of the amounts in the supply rank keys,
+// enough to hold any sdk.Int.
+const supplyRankAmountLen = 32
+
 // KVStore keys
 var (
 	SupplyKey           = []byte{0x00}
@@ -35,6 +56,41 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = []byte{0x05}
+
+	// FrozenAccountPrefix is the prefix for the frozen denoms of the accounts.
+	FrozenAccountPrefix = []byte{0x06}
+
+	// EscrowPrefix is the prefix for the escrows, indexed by identifier.
+	EscrowPrefix = []byte{0x07}
+
+	// EscrowExpiryPrefix is the prefix for the escrows, indexed by expiry height.
+	EscrowExpiryPrefix = []byte{0x08}
+
+	// NextEscrowIDKey is the key of the identifier of the next escrow.
+	NextEscrowIDKey = []byte{0x09}
+
+	// SupplyRankPrefix is the prefix for the supply of the denoms, indexed by
+	// amount.
+	SupplyRankPrefix = []byte{0x0A}
+
+	// TransferMemoPrefix is the prefix for the transfer memos, indexed by sender
+	// and sequence.
+	TransferMemoPrefix = []byte{0x0B}
+
+	// TransferMemoHeightPrefix is the prefix for the transfer memos, indexed by
+	// height.
+	TransferMemoHeightPrefix = []byte{0x0C}
+
+	// NextTransferMemoSequencePrefix is the prefix for the sequence of the next
+	// transfer memo of the senders.
+	NextTransferMemoSequencePrefix = []byte{0x0D}
+
+	// BurnAuthorizationExpiryPrefix is the prefix for the grantees of the burn
+	// authorizations, indexed by expiry height.
+	BurnAuthorizationExpiryPrefix = []byte{0x0E}
+
+	// EscrowAddress is the address of the account holding the coins in escrow.
+	EscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("escrow")))
 )
 
 // AddressAndDenomFromBalancesStore returns an account address and denom from a balances prefix
@@ -87,3 +143,120 @@ func CreateSendEnabledKey(denom string) []byte {
 	copy(key[len(SendEnabledPrefix):], denom)
 	return key
 }
+
+// CreateFrozenAccountKey creates the key of the frozen flag of a denom for an
+// account. Its layout, once the prefix removed, is the same as the one of the
+// balances store, so it can be parsed by AddressAndDenomFromBalancesStore.
+func CreateFrozenAccountKey(addr sdk.AccAddress, denom string) []byte {
+	return append(append(FrozenAccountPrefix, address.MustLengthPrefix(addr)...), denom...)
+}
+
+// CreateEscrowKey creates the key of an escrow.
+func CreateEscrowKey(id uint64) []byte {
+	return append(EscrowPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// CreateEscrowExpiryPrefix creates the prefix of the escrows expiring at the
+// given height in the expiry index.
+func CreateEscrowExpiryPrefix(expiryHeight int64) []byte {
+	return append(EscrowExpiryPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// CreateEscrowExpiryKey creates the key of an escrow in the expiry index.
+func CreateEscrowExpiryKey(expiryHeight int64, id uint64) []byte {
+	return append(CreateEscrowExpiryPrefix(expiryHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// CreateBurnAuthorizationExpiryPrefix creates the prefix of the burn
+// authorizations expiring at the given height in the expiry index.
+func CreateBurnAuthorizationExpiryPrefix(expiryHeight int64) []byte {
+	return append(BurnAuthorizationExpiryPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// CreateBurnAuthorizationExpiryKey creates the key of the burn authorization of
+// a grantee in the expiry index.
+func CreateBurnAuthorizationExpiryKey(expiryHeight int64, grantee sdk.AccAddress) []byte {
+	return append(CreateBurnAuthorizationExpiryPrefix(expiryHeight), address.MustLengthPrefix(grantee)...)
+}
+
+// GranteeFromBurnAuthorizationExpiryStore returns the grantee of a burn
+// authorization from a key of the expiry index. The key must not contain the
+// prefix BurnAuthorizationExpiryPrefix.
+func GranteeFromBurnAuthorizationExpiryStore(key []byte) (sdk.AccAddress, error) {
+	if len(key) <= 9 {
+		return nil, ErrInvalidKey
+	}
+
+	addrLen := int(key[8])
+	if len(key) != 9+addrLen {
+		return nil, ErrInvalidKey
+	}
+
+	return sdk.AccAddress(key[9:]), nil
+}
+
+// CreateSupplyRankKey creates the key of the supply of a denom in the supply
+// rank index. The amount is encoded in big endian over a fixed length so that
+// the keys are ordered by amount.
+func CreateSupplyRankKey(supply sdk.Coin) []byte {
+	amount := supply.Amount.BigInt().FillBytes(make([]byte, supplyRankAmountLen))
+	return append(append(SupplyRankPrefix, amount...), supply.Denom...)
+}
+
+// SupplyFromSupplyRankStore returns the supply of a denom from a supply rank
+// prefix store. The key must not contain the prefix SupplyRankPrefix as the
+// prefix store iterator discards the actual prefix.
+//
+// If invalid key is passed, SupplyFromSupplyRankStore returns ErrInvalidKey.
+func SupplyFromSupplyRankStore(key []byte) (sdk.Coin, error) {
+	if len(key) <= supplyRankAmountLen {
+		return sdk.Coin{}, ErrInvalidKey
+	}
+
+	amount := sdk.NewIntFromBigInt(new(big.Int).SetBytes(key[:supplyRankAmountLen]))
+	return sdk.Coin{Denom: string(key[supplyRankAmountLen:]), Amount: amount}, nil
+}
+
+// CreateTransferMemoKey creates the key of the transfer memo of a sender with
+// the given sequence.
+func CreateTransferMemoKey(sender sdk.AccAddress, sequence uint64) []byte {
+	return append(append(TransferMemoPrefix, address.MustLengthPrefix(sender)...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// CreateTransferMemoHeightPrefix creates the prefix of the transfer memos sent
+// at the given height in the height index.
+func CreateTransferMemoHeightPrefix(height int64) []byte {
+	return append(TransferMemoHeightPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// CreateTransferMemoHeightKey creates the key of a transfer memo in the height
+// index.
+func CreateTransferMemoHeightKey(height int64, sender sdk.AccAddress, sequence uint64) []byte {
+	return append(append(CreateTransferMemoHeightPrefix(height), address.MustLengthPrefix(sender)...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// CreateNextTransferMemoSequenceKey creates the key of the sequence of the next
+// transfer memo of a sender.
+func CreateNextTransferMemoSequenceKey(sender sdk.AccAddress) []byte {
+	return append(NextTransferMemoSequencePrefix, address.MustLengthPrefix(sender)...)
+}
+
+// SenderAndSequenceFromTransferMemoStore returns the sender and the sequence of
+// a transfer memo from a transfer memo prefix store, or from a height index
+// prefix store once the height removed. The key must not contain the prefix
+// as the prefix store iterator discards the actual prefix.
+//
+// If invalid key is passed, SenderAndSequenceFromTransferMemoStore returns
+// ErrInvalidKey.
+func SenderAndSequenceFromTransferMemoStore(key []byte) (sdk.AccAddress, uint64, error) {
+	if len(key) == 0 {
+		return nil, 0, ErrInvalidKey
+	}
+
+	addrBound := int(key[0])
+	if len(key) != 1+addrBound+8 {
+		return nil, 0, ErrInvalidKey
+	}
+
+	return key[1 : addrBound+1], sdk.BigEndianToUint64(key[addrBound+1:]), nil
+}
@@ -15,6 +15,7 @@ import (
 //   - Base denomination has exponent 0
 //   - Denomination units are sorted in ascending order
 //   - Denomination units not duplicated
+//   - Supply cap is not negative
 func (m Metadata) Validate() error {
 	if strings.TrimSpace(m.Name) == "" {
 		return errors.New("name field cannot be blank")
@@ -74,9 +75,19 @@ func (m Metadata) Validate() error {
 		return fmt.Errorf("metadata must contain a denomination unit with display denom '%s'", m.Display)
 	}
 
+	if m.SupplyCap != nil && !m.SupplyCap.IsNil() && m.SupplyCap.IsNegative() {
+		return fmt.Errorf("supply cap of denom %s cannot be negative: %s", m.Base, m.SupplyCap)
+	}
+
 	return nil
 }
 
+// HasSupplyCap returns true if the supply of the base denomination is capped,
+// i.e. if the supply cap is set and non-zero.
+func (m Metadata) HasSupplyCap() bool {
+	return m.SupplyCap != nil && !m.SupplyCap.IsNil() && !m.SupplyCap.IsZero()
+}
+
 // Validate performs a basic validation of the denomination unit fields
 func (du DenomUnit) Validate() error {
 	if err := sdk.ValidateDenom(du.Denom); err != nil {
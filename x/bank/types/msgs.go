@@ -17,6 +17,13 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+	_ sdk.Msg = &MsgCreateEscrow{}
+	_ sdk.Msg = &MsgFulfillEscrow{}
+	_ sdk.Msg = &MsgGrantBurnAuthorization{}
+	_ sdk.Msg = &MsgBurnCoins{}
+	_ sdk.Msg = &MsgSendWithMemo{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -261,3 +268,243 @@ func (msg MsgSetSendEnabled) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgFreezeAccount creates a message to freeze the transfers of some denoms
+// from an account.
+func NewMsgFreezeAccount(authority string, addr sdk.AccAddress, denoms []string) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Authority: authority,
+		Address:   addr.String(),
+		Denoms:    denoms,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgFreezeAccount.
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgFreezeAccount.
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(msg.Authority, msg.Address, msg.Denoms)
+}
+
+// NewMsgUnfreezeAccount creates a message to unfreeze the transfers of some
+// denoms from an account.
+func NewMsgUnfreezeAccount(authority string, addr sdk.AccAddress, denoms []string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Authority: authority,
+		Address:   addr.String(),
+		Denoms:    denoms,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgUnfreezeAccount.
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgUnfreezeAccount.
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(msg.Authority, msg.Address, msg.Denoms)
+}
+
+// validateFreezeMsg validates the fields shared by MsgFreezeAccount and
+// MsgUnfreezeAccount.
+func validateFreezeMsg(authority, address string, denoms []string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if len(denoms) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("denoms cannot be empty")
+	}
+
+	seen := map[string]bool{}
+	for _, denom := range denoms {
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom %q", denom)
+		}
+		seen[denom] = true
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %q: %s", denom, err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgCreateEscrow creates a message to hold sendAmount of the sender in
+// escrow until the recipient sends receiveAmount to the sender.
+func NewMsgCreateEscrow(sender, recipient sdk.AccAddress, sendAmount, receiveAmount sdk.Coins, expiryHeight int64) *MsgCreateEscrow {
+	return &MsgCreateEscrow{
+		Sender:        sender.String(),
+		Recipient:     recipient.String(),
+		SendAmount:    sendAmount,
+		ReceiveAmount: receiveAmount,
+		ExpiryHeight:  expiryHeight,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCreateEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgCreateEscrow.
+func (msg MsgCreateEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgCreateEscrow.
+func (msg MsgCreateEscrow) ValidateBasic() error {
+	return Escrow{
+		Sender:        msg.Sender,
+		Recipient:     msg.Recipient,
+		SendAmount:    msg.SendAmount,
+		ReceiveAmount: msg.ReceiveAmount,
+		ExpiryHeight:  msg.ExpiryHeight,
+	}.Validate()
+}
+
+// NewMsgFulfillEscrow creates a message to fulfill an escrow.
+func NewMsgFulfillEscrow(recipient sdk.AccAddress, escrowID uint64) *MsgFulfillEscrow {
+	return &MsgFulfillEscrow{
+		Recipient: recipient.String(),
+		EscrowId:  escrowID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgFulfillEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgFulfillEscrow.
+func (msg MsgFulfillEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Recipient)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgFulfillEscrow.
+func (msg MsgFulfillEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgGrantBurnAuthorization creates a message authorizing the grantee to
+// burn up to maxAmount from its own account.
+func NewMsgGrantBurnAuthorization(authority string, grantee sdk.AccAddress, maxAmount sdk.Coin) *MsgGrantBurnAuthorization {
+	return &MsgGrantBurnAuthorization{
+		Authority: authority,
+		Grantee:   grantee.String(),
+		MaxAmount: maxAmount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgGrantBurnAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgGrantBurnAuthorization.
+func (msg MsgGrantBurnAuthorization) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgGrantBurnAuthorization.
+func (msg MsgGrantBurnAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	if !msg.MaxAmount.IsValid() || !msg.MaxAmount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.MaxAmount.String())
+	}
+
+	return nil
+}
+
+// NewMsgBurnCoins creates a message to burn coins from the burner's account.
+func NewMsgBurnCoins(burner sdk.AccAddress, amount sdk.Coins) *MsgBurnCoins {
+	return &MsgBurnCoins{
+		Burner: burner.String(),
+		Amount: amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgBurnCoins) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgBurnCoins.
+func (msg MsgBurnCoins) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Burner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgBurnCoins.
+func (msg MsgBurnCoins) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Burner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid burner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgSendWithMemo creates a message to send coins from one account to
+// another, storing a memo describing the purpose of the transfer.
+func NewMsgSendWithMemo(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, memo string) *MsgSendWithMemo {
+	return &MsgSendWithMemo{FromAddress: fromAddr.String(), ToAddress: toAddr.String(), Amount: amount, Memo: memo}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSendWithMemo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSendWithMemo.
+func (msg MsgSendWithMemo) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic runs basic validation on this MsgSendWithMemo.
+func (msg MsgSendWithMemo) ValidateBasic() error {
+	if err := (MsgSend{FromAddress: msg.FromAddress, ToAddress: msg.ToAddress, Amount: msg.Amount}).ValidateBasic(); err != nil {
+		return err
+	}
+
+	return ValidateTransferMemo(msg.Memo)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -414,3 +415,149 @@ func TestMsgSetSendEnabledValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgFreezeAccountValidateBasic(t *testing.T) {
+	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr := sdk.AccAddress([]byte("frozen"))
+	tests := []struct {
+		name string
+		msg  *MsgFreezeAccount
+		exp  string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgFreezeAccount(govModuleAddr, addr, []string{"foocoin", "barcoin"}),
+		},
+		{
+			name: "bad authority",
+			msg:  NewMsgFreezeAccount("farva", addr, []string{"foocoin"}),
+			exp:  "invalid authority address: decoding bech32 failed: invalid bech32 string length 5: invalid address",
+		},
+		{
+			name: "bad address",
+			msg:  &MsgFreezeAccount{Authority: govModuleAddr, Address: "farva", Denoms: []string{"foocoin"}},
+			exp:  "invalid address: decoding bech32 failed: invalid bech32 string length 5: invalid address",
+		},
+		{
+			name: "no denoms",
+			msg:  NewMsgFreezeAccount(govModuleAddr, addr, nil),
+			exp:  "denoms cannot be empty: invalid request",
+		},
+		{
+			name: "duplicate denoms",
+			msg:  NewMsgFreezeAccount(govModuleAddr, addr, []string{"foocoin", "foocoin"}),
+			exp:  `duplicate denom "foocoin": invalid request`,
+		},
+		{
+			name: "bad denom",
+			msg:  NewMsgFreezeAccount(govModuleAddr, addr, []string{"!"}),
+			exp:  `invalid denom "!": invalid denom: !: invalid request`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualError(tt, actual, tc.exp)
+			} else {
+				require.NoError(tt, actual)
+			}
+
+			unfreeze := MsgUnfreezeAccount{Authority: tc.msg.Authority, Address: tc.msg.Address, Denoms: tc.msg.Denoms}
+			if len(tc.exp) > 0 {
+				require.EqualError(tt, unfreeze.ValidateBasic(), tc.exp)
+			} else {
+				require.NoError(tt, unfreeze.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgCreateEscrowValidateBasic(t *testing.T) {
+	sender, recipient := sdk.AccAddress([]byte("sender")), sdk.AccAddress([]byte("recipient"))
+	sendAmount, receiveAmount := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), sdk.NewCoins(sdk.NewInt64Coin("barcoin", 20))
+
+	tests := []struct {
+		name   string
+		msg    *MsgCreateEscrow
+		expErr bool
+	}{
+		{"valid", NewMsgCreateEscrow(sender, recipient, sendAmount, receiveAmount, 10), false},
+		{"bad sender", &MsgCreateEscrow{Sender: "farva", Recipient: recipient.String(), SendAmount: sendAmount, ReceiveAmount: receiveAmount, ExpiryHeight: 10}, true},
+		{"same sender and recipient", NewMsgCreateEscrow(sender, sender, sendAmount, receiveAmount, 10), true},
+		{"no send amount", NewMsgCreateEscrow(sender, recipient, nil, receiveAmount, 10), true},
+		{"no receive amount", NewMsgCreateEscrow(sender, recipient, sendAmount, nil, 10), true},
+		{"no expiry height", NewMsgCreateEscrow(sender, recipient, sendAmount, receiveAmount, 0), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(tt, err)
+			} else {
+				require.NoError(tt, err)
+			}
+		})
+	}
+}
+
+func TestMsgBurnAuthorizationValidateBasic(t *testing.T) {
+	authority, grantee := sdk.AccAddress([]byte("authority")), sdk.AccAddress([]byte("grantee"))
+	coin := sdk.NewInt64Coin("foocoin", 10)
+
+	tests := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"valid grant", NewMsgGrantBurnAuthorization(authority.String(), grantee, coin), false},
+		{"grant bad authority", NewMsgGrantBurnAuthorization("farva", grantee, coin), true},
+		{"grant bad grantee", &MsgGrantBurnAuthorization{Authority: authority.String(), Grantee: "farva", MaxAmount: coin}, true},
+		{"grant zero amount", NewMsgGrantBurnAuthorization(authority.String(), grantee, sdk.NewInt64Coin("foocoin", 0)), true},
+		{"valid burn", NewMsgBurnCoins(grantee, sdk.NewCoins(coin)), false},
+		{"burn bad burner", &MsgBurnCoins{Burner: "farva", Amount: sdk.NewCoins(coin)}, true},
+		{"burn no amount", NewMsgBurnCoins(grantee, nil), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(tt, err)
+			} else {
+				require.NoError(tt, err)
+			}
+		})
+	}
+}
+
+func TestMsgSendWithMemoValidateBasic(t *testing.T) {
+	from, to := sdk.AccAddress([]byte("from")), sdk.AccAddress([]byte("to"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+
+	tests := []struct {
+		name   string
+		msg    *MsgSendWithMemo
+		expErr bool
+	}{
+		{"valid", NewMsgSendWithMemo(from, to, amount, "invoice 42"), false},
+		{"empty memo", NewMsgSendWithMemo(from, to, amount, ""), false},
+		{"max length memo", NewMsgSendWithMemo(from, to, amount, strings.Repeat("a", MaxTransferMemoLength)), false},
+		{"memo too long", NewMsgSendWithMemo(from, to, amount, strings.Repeat("a", MaxTransferMemoLength+1)), true},
+		{"bad from address", &MsgSendWithMemo{FromAddress: "farva", ToAddress: to.String(), Amount: amount}, true},
+		{"no amount", NewMsgSendWithMemo(from, to, nil, "invoice 42"), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(tt, err)
+			} else {
+				require.NoError(tt, err)
+			}
+		})
+	}
+}
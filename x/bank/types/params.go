@@ -12,19 +12,42 @@ import (
 // DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
 var DefaultDefaultSendEnabled = true
 
+// DefaultBurnAuthorizationTimeout is the number of blocks after which burn
+// authorizations expire by default, about a week with 6 second blocks.
+var DefaultBurnAuthorizationTimeout uint64 = 100800
+
+// DefaultMemoPruneAfterBlocks is the number of blocks after which transfer
+// memos are pruned by default, about a month with 6 second blocks.
+var DefaultMemoPruneAfterBlocks uint64 = 432000
+
+// DefaultTransferTax is the fraction of the transfers paid as transfer tax by
+// default, transfers are not taxed.
+var DefaultTransferTax = sdk.ZeroDec()
+
+// DefaultTransferTaxDestination is where the transfer tax is paid to by default.
+var DefaultTransferTaxDestination = TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN
+
 // NewParams creates a new parameter configuration for the bank module
 func NewParams(defaultSendEnabled bool) Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: defaultSendEnabled,
+		SendEnabled:              nil,
+		DefaultSendEnabled:       defaultSendEnabled,
+		BurnAuthorizationTimeout: DefaultBurnAuthorizationTimeout,
+		MemoPruneAfterBlocks:     DefaultMemoPruneAfterBlocks,
+		TransferTax:              DefaultTransferTax,
+		TransferTaxDestination:   DefaultTransferTaxDestination,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		SendEnabled:              nil,
+		DefaultSendEnabled:       DefaultDefaultSendEnabled,
+		BurnAuthorizationTimeout: DefaultBurnAuthorizationTimeout,
+		MemoPruneAfterBlocks:     DefaultMemoPruneAfterBlocks,
+		TransferTax:              DefaultTransferTax,
+		TransferTaxDestination:   DefaultTransferTaxDestination,
 	}
 }
 
@@ -33,7 +56,13 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	if err := validateTransferTax(p.TransferTax); err != nil {
+		return err
+	}
+	return validateTransferTaxDestination(p.TransferTaxDestination)
 }
 
 // String implements the Stringer interface.
@@ -43,7 +72,8 @@ func (p Params) String() string {
 	if len(sendEnabled) > 0 && sendEnabled[0] == '-' {
 		d = "\n"
 	}
-	return fmt.Sprintf("default_send_enabled: %t\nsend_enabled:%s%s", p.DefaultSendEnabled, d, sendEnabled)
+	return fmt.Sprintf("burn_authorization_timeout: %d\ndefault_send_enabled: %t\nmemo_prune_after_blocks: %d\nsend_enabled:%s%stransfer_tax: %s\ntransfer_tax_destination: %s\n",
+		p.BurnAuthorizationTimeout, p.DefaultSendEnabled, p.MemoPruneAfterBlocks, d, sendEnabled, p.TransferTax, p.TransferTaxDestination)
 }
 
 // Validate gets any errors with this SendEnabled entry.
@@ -73,3 +103,25 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+// validateTransferTax validates the transfer tax, a nil transfer tax as found in
+// the params set before it was introduced means no tax.
+func validateTransferTax(v sdk.Dec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("transfer tax must be positive: %s", v)
+	}
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("transfer tax must be less than one: %s", v)
+	}
+	return nil
+}
+
+func validateTransferTaxDestination(d TransferTaxDestination) error {
+	if _, ok := TransferTaxDestination_name[int32(d)]; !ok {
+		return fmt.Errorf("invalid transfer tax destination: %s", d)
+	}
+	return nil
+}
@@ -38,23 +38,23 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
-			expected: "default_send_enabled: true\nsend_enabled: []\n",
+			params:   Params{[]*SendEnabled{}, true, 0, 0, sdk.ZeroDec(), TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN},
+			expected: "burn_authorization_timeout: 0\ndefault_send_enabled: true\nmemo_prune_after_blocks: 0\nsend_enabled: []\ntransfer_tax: 0.000000000000000000\ntransfer_tax_destination: TRANSFER_TAX_DESTINATION_BURN\n",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
-			expected: "default_send_enabled: false\nsend_enabled: []\n",
+			params:   Params{[]*SendEnabled{}, false, 0, 0, sdk.ZeroDec(), TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN},
+			expected: "burn_authorization_timeout: 0\ndefault_send_enabled: false\nmemo_prune_after_blocks: 0\nsend_enabled: []\ntransfer_tax: 0.000000000000000000\ntransfer_tax_destination: TRANSFER_TAX_DESTINATION_BURN\n",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
-			expected: "default_send_enabled: true\nsend_enabled:\n- denom: foocoin\n  enabled: true\n",
+			params:   Params{[]*SendEnabled{{"foocoin", true}}, true, 0, 0, sdk.ZeroDec(), TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN},
+			expected: "burn_authorization_timeout: 0\ndefault_send_enabled: true\nmemo_prune_after_blocks: 0\nsend_enabled:\n- denom: foocoin\n  enabled: true\ntransfer_tax: 0.000000000000000000\ntransfer_tax_destination: TRANSFER_TAX_DESTINATION_BURN\n",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
-			expected: "default_send_enabled: true\nsend_enabled:\n- denom: barcoin\n",
+			params:   Params{[]*SendEnabled{{"barcoin", false}}, true, 0, 0, sdk.ZeroDec(), TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN},
+			expected: "burn_authorization_timeout: 0\ndefault_send_enabled: true\nmemo_prune_after_blocks: 0\nsend_enabled:\n- denom: barcoin\ntransfer_tax: 0.000000000000000000\ntransfer_tax_destination: TRANSFER_TAX_DESTINATION_BURN\n",
 		},
 	}
 	for _, tc := range tests {
@@ -69,5 +69,18 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true}.Validate(), "with SendEnabled entry")
+	params := DefaultParams()
+	params.TransferTax = sdk.NewDecWithPrec(1, 2)
+	params.TransferTaxDestination = TransferTaxDestination_TRANSFER_TAX_DESTINATION_COMMUNITY_POOL
+	assert.NoError(t, params.Validate(), "with transfer tax")
+	params.TransferTax = sdk.OneDec()
+	assert.Error(t, params.Validate(), "with transfer tax of one")
+	params.TransferTax = sdk.NewDecWithPrec(-1, 2)
+	assert.Error(t, params.Validate(), "with negative transfer tax")
+	params.TransferTax = sdk.Dec{}
+	assert.NoError(t, params.Validate(), "with nil transfer tax")
+	params.TransferTax = DefaultTransferTax
+	params.TransferTaxDestination = 2
+	assert.Error(t, params.Validate(), "with invalid transfer tax destination")
+	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true, 0, 0, sdk.ZeroDec(), TransferTaxDestination_TRANSFER_TAX_DESTINATION_BURN}.Validate(), "with SendEnabled entry")
 }
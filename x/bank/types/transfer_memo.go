@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxTransferMemoLength is the maximum length in bytes of the memo of a
+// transfer sent with MsgSendWithMemo.
+const MaxTransferMemoLength = 256
+
+// ValidateTransferMemo checks that a transfer memo is not longer than
+// MaxTransferMemoLength.
+func ValidateTransferMemo(memo string) error {
+	if len(memo) > MaxTransferMemoLength {
+		return sdkerrors.ErrMemoTooLarge.Wrapf("memo length %d exceeds the maximum of %d bytes", len(memo), MaxTransferMemoLength)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the transfer memo fields.
+func (m TransferMemo) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if m.Sequence == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sequence must be positive")
+	}
+
+	if !m.Amount.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", m.Amount)
+	}
+
+	return ValidateTransferMemo(m.Memo)
+}
@@ -31,4 +31,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// prune the delegator stakes at the slash events which can no longer be refunded
+	k.PruneExpiredDelegatorSlashStakes(ctx)
 }
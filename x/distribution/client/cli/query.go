@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryHistoricalAPR(),
 	)
 
 	return distQueryCmd
@@ -364,3 +365,44 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryHistoricalAPR returns the command for fetching the historical APR.
+func GetCmdQueryHistoricalAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-apr [num-blocks]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the annualised staking reward rate over the last blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the annualised rate of the rewards allocated to delegators, net of
+commission, over the given number of past blocks.
+
+Example:
+$ %s query distribution historical-apr 1000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numBlocks, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("number of blocks %s not a valid int, please input a valid number of blocks", args[0])
+			}
+
+			res, err := queryClient.HistoricalAPR(cmd.Context(), &types.QueryHistoricalAPRRequest{NumBlocks: numBlocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
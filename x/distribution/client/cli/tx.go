@@ -40,6 +40,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetValidatorFeeShareCmd(),
 	)
 
 	return distTxCmd
@@ -254,3 +255,43 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 
 	return cmd
 }
+
+// NewSetValidatorFeeShareCmd returns a CLI command handler for creating a
+// MsgSetValidatorFeeShare transaction.
+func NewSetValidatorFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-fee-share [fee-share]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the fraction of the commission earned on proposed blocks shared with delegators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the fraction of the commission earned by the validator on the blocks it
+proposed which is shared with its delegators, in addition to the standard
+rewards. The fee share cannot exceed the max_validator_fee_share param.
+
+Example:
+$ %s tx distribution set-validator-fee-share 0.25 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			feeShare, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetValidatorFeeShare(sdk.ValAddress(valAddr), feeShare)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -31,6 +31,7 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 	if totalPreviousPower == 0 {
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
 		k.SetFeePool(ctx, feePool)
+		k.recordRewardRate(ctx, sdk.DecCoins{}, math.ZeroInt())
 		return
 	}
 
@@ -40,11 +41,20 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
+	// the collected fees are those of the previous block, proposed by the
+	// previous proposer
+	var previousProposer sdk.ConsAddress
+	if ctx.KVStore(k.storeKey).Has(types.ProposerKey) {
+		previousProposer = k.GetPreviousProposerConsAddr(ctx)
+	}
+
 	// allocate tokens proportionally to voting power
 	//
 	// TODO: Consider parallelizing later
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
+	delegatorRewards := sdk.DecCoins{}
+	bondedTokens := math.ZeroInt()
 	for _, vote := range bondedVotes {
 		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
 
@@ -54,20 +64,100 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
 		reward := feeMultiplier.MulDecTruncate(powerFraction)
 
-		k.AllocateTokensToValidator(ctx, validator, reward)
+		var shared sdk.DecCoins
+		if previousProposer.Equals(sdk.ConsAddress(vote.Validator.Address)) {
+			shared = k.allocateTokensToProposer(ctx, validator, reward)
+		} else {
+			shared = k.allocateTokensToValidator(ctx, validator, reward, sdk.ZeroDec())
+		}
 		remaining = remaining.Sub(reward)
+
+		delegatorRewards = delegatorRewards.Add(shared...)
+		bondedTokens = bondedTokens.Add(validator.GetTokens())
 	}
 
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.SetFeePool(ctx, feePool)
+
+	k.recordRewardRate(ctx, delegatorRewards, bondedTokens)
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator,
 // splitting according to commission.
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) {
+	k.allocateTokensToValidator(ctx, val, tokens, sdk.ZeroDec())
+}
+
+// AllocateSlashedTokens redistributes the tokens slashed from the given
+// validator, held by the given x/staking pool, to the delegators of the other
+// bonded validators, in proportion to the tokens of these validators. No
+// commission is taken on them. The remainder, or all the tokens if there is no
+// other bonded validator, is allocated to the community pool.
+func (k Keeper) AllocateSlashedTokens(ctx sdk.Context, slashedVal sdk.ValAddress, poolName string, tokens sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, poolName, types.ModuleName, tokens); err != nil {
+		return err
+	}
+
+	var validators []stakingtypes.ValidatorI
+	totalTokens := math.ZeroInt()
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, val stakingtypes.ValidatorI) bool {
+		if !val.GetOperator().Equals(slashedVal) && val.GetTokens().IsPositive() {
+			validators = append(validators, val)
+			totalTokens = totalTokens.Add(val.GetTokens())
+		}
+		return false
+	})
+
+	slashedTokens := sdk.NewDecCoinsFromCoins(tokens...)
+	remaining := slashedTokens
+	for _, val := range validators {
+		tokensFraction := sdk.NewDecFromInt(val.GetTokens()).QuoTruncate(sdk.NewDecFromInt(totalTokens))
+		reward := slashedTokens.MulDecTruncate(tokensFraction)
+		k.allocateTokensToDelegators(ctx, val, reward)
+		remaining = remaining.Sub(reward)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
+// allocateTokensToProposer allocates tokens to the proposer of the block the
+// fees were collected in. The validator fee share, capped by the maximum
+// validator fee share, of its commission is shared with its delegators. It
+// returns the tokens allocated to the delegators.
+func (k Keeper) allocateTokensToProposer(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) sdk.DecCoins {
+	feeShare := k.GetValidatorCurrentRewards(ctx, val.GetOperator()).ValidatorFeeShare
+	if feeShare.IsNil() {
+		feeShare = sdk.ZeroDec()
+	}
+	feeShare = sdk.MinDec(feeShare, k.GetMaxValidatorFeeShare(ctx))
+
+	return k.allocateTokensToValidator(ctx, val, tokens, feeShare)
+}
+
+// allocateTokensToValidator allocates tokens to a particular validator,
+// splitting according to commission, the given fraction of the commission
+// being shared with the delegators. It returns the tokens allocated to the
+// delegators.
+func (k Keeper) allocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins, feeShare sdk.Dec) sdk.DecCoins {
 	// split tokens between validator and delegators according to commission
 	commission := tokens.MulDec(val.GetCommission())
+	if feeShare.IsPositive() {
+		sharedCommission := commission.MulDecTruncate(feeShare)
+		commission = commission.Sub(sharedCommission)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFeeShare,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sharedCommission.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+			),
+		)
+	}
 	shared := tokens.Sub(commission)
 
 	// update current commission
@@ -99,4 +189,28 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.Vali
 	outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
 	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
 	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
+
+	return shared
+}
+
+// allocateTokensToDelegators allocates tokens to the delegators of a
+// particular validator, without any commission.
+func (k Keeper) allocateTokensToDelegators(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) {
+	// update current rewards
+	currentRewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
+	currentRewards.Rewards = currentRewards.Rewards.Add(tokens...)
+	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), currentRewards)
+
+	// update outstanding rewards
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+		),
+	)
+
+	outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
+	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
 }
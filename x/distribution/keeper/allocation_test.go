@@ -164,6 +164,89 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(490, 1)}}, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr1).Rewards)
 }
 
+func TestAllocateTokensToProposerWithFeeShare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool & set params with a 50% max validator fee share
+	params := disttypes.DefaultParams()
+	params.MaxValidatorFeeShare = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// create two validators with 50% commission
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr0).Return(val0).AnyTimes()
+
+	valAddr1 := sdk.ValAddress(valConsAddr1)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	val1.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr1).Return(val1).AnyTimes()
+
+	// both validators share half of their commission, the fee share cannot
+	// exceed the max validator fee share
+	require.ErrorIs(t, distrKeeper.SetValidatorFeeShare(ctx, valAddr0, sdk.NewDecWithPrec(6, 1)), disttypes.ErrFeeShareTooHigh)
+	require.NoError(t, distrKeeper.SetValidatorFeeShare(ctx, valAddr0, sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, distrKeeper.SetValidatorFeeShare(ctx, valAddr1, sdk.NewDecWithPrec(5, 1)))
+
+	// allocate tokens as if both had voted and the first was proposer
+	distrKeeper.SetPreviousProposerConsAddr(ctx, valConsAddr0)
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk0.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+
+	// 49 outstanding rewards each (100 less 2 to community pool)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(490, 1)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr0).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(490, 1)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr1).Rewards)
+
+	// the proposer shares half of its 24.50 commission with its delegators
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(1225, 2)}}, distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr0).Commission)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(3675, 2)}}, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr0).Rewards)
+
+	// the other validator keeps its whole commission
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(2450, 2)}}, distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr1).Commission)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(2450, 2)}}, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr1).Rewards)
+}
+
 func TestAllocateTokensTruncation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := sdk.NewKVStoreKey(disttypes.StoreKey)
@@ -262,3 +345,201 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr1).Rewards.IsValid())
 	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr2).Rewards.IsValid())
 }
+
+func TestAllocateSlashedTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool & set params
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// create the slashed validator
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	// create second validator with 50% commission
+	valAddr1 := sdk.ValAddress(valConsAddr1)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	val1.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	// create third validator with twice the tokens of the second one
+	valAddr2 := sdk.ValAddress(valConsAddr2)
+	val2, err := distrtestutil.CreateValidator(valConsPk2, math.NewInt(200))
+	require.NoError(t, err)
+
+	bondedValidators := []stakingtypes.ValidatorI{val2, val0, val1}
+	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, fn func(int64, stakingtypes.ValidatorI) bool) {
+			for i, val := range bondedValidators {
+				if fn(int64(i), val) {
+					return
+				}
+			}
+		},
+	).Times(2)
+
+	// the slashed tokens are redistributed to the delegators of the other
+	// validators in proportion to their tokens, without commission
+	slashed := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, disttypes.ModuleName, slashed)
+	require.NoError(t, distrKeeper.AllocateSlashedTokens(ctx, valAddr0, stakingtypes.BondedPoolName, slashed))
+
+	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr0).Rewards.IsZero())
+	require.True(t, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr0).Rewards.IsZero())
+
+	expRewards1 := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.MustNewDecFromStr("33.333333333333333300")}}
+	require.Equal(t, expRewards1, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr1).Rewards)
+	require.Equal(t, expRewards1, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr1).Rewards)
+	require.True(t, distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr1).Commission.IsZero())
+
+	expRewards2 := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.MustNewDecFromStr("66.666666666666666600")}}
+	require.Equal(t, expRewards2, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr2).Rewards)
+	require.Equal(t, expRewards2, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr2).Rewards)
+
+	// the truncation remainder goes to the community pool
+	require.Equal(t, sdk.NewDecCoinsFromCoins(slashed...).Sub(expRewards1).Sub(expRewards2), distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// without any other bonded validator, the slashed tokens all go to the
+	// community pool
+	bondedValidators = []stakingtypes.ValidatorI{val0}
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, disttypes.ModuleName, slashed)
+	require.NoError(t, distrKeeper.AllocateSlashedTokens(ctx, valAddr0, stakingtypes.NotBondedPoolName, slashed))
+
+	require.Equal(t, sdk.NewDecCoinsFromCoins(slashed...), distrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, expRewards1, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr1).Rewards)
+}
+
+func TestHistoricalAPR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 2, Time: start})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool & set params
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// create validator with 50% commission
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val).AnyTimes()
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).AnyTimes()
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk0.Address(), Power: 1000},
+			SignedLastBlock: true,
+		},
+	}
+
+	// one block a day, each allocating (100 * 98% * 50%) / 1000 = 0.049 per bonded token to delegators
+	distrKeeper.AllocateTokens(ctx, 1000, votes)
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(start.Add(24 * time.Hour))
+	distrKeeper.AllocateTokens(ctx, 1000, votes)
+
+	snapshot, found := distrKeeper.GetRewardRateSnapshot(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(98, 3)}}, snapshot.CumulativeRewardRatio)
+
+	// 0.049 * 365.25 days
+	apr, err := distrKeeper.GetHistoricalAPR(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("17.89725"), apr)
+
+	_, err = distrKeeper.GetHistoricalAPR(ctx, 0)
+	require.Error(t, err)
+	_, err = distrKeeper.GetHistoricalAPR(ctx, 3)
+	require.ErrorIs(t, err, disttypes.ErrNoRewardHistory)
+
+	// the next block comes two days later, halving the rate, but the cached APR
+	// is served until it expires
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(start.Add(72 * time.Hour))
+	distrKeeper.AllocateTokens(ctx, 1000, votes)
+
+	apr, err = distrKeeper.GetHistoricalAPR(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("17.89725"), apr)
+
+	ctx = ctx.WithBlockHeight(4 + disttypes.APRCacheTTL).WithBlockTime(start.Add(120 * time.Hour))
+	distrKeeper.AllocateTokens(ctx, 1000, votes)
+
+	apr, err = distrKeeper.GetHistoricalAPR(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("8.948625"), apr)
+
+	// only the snapshots at multiples of the interval are kept besides the
+	// latest two
+	interval := uint64(disttypes.RewardRateSnapshotInterval)
+	snapshot, _ = distrKeeper.GetRewardRateSnapshot(ctx, 4+uint64(disttypes.APRCacheTTL))
+	distrKeeper.SetRewardRateSnapshot(ctx, interval, snapshot)
+	distrKeeper.SetRewardRateSnapshot(ctx, 2*interval, snapshot)
+	distrKeeper.SetRewardRateSnapshot(ctx, 2*interval+1, snapshot)
+
+	ctx = ctx.WithBlockHeight(int64(2*interval) + 3).WithBlockTime(start.Add(168 * time.Hour))
+	distrKeeper.AllocateTokens(ctx, 1000, votes)
+	_, found = distrKeeper.GetRewardRateSnapshot(ctx, 2*interval+1)
+	require.False(t, found)
+	_, found = distrKeeper.GetRewardRateSnapshot(ctx, 2*interval)
+	require.True(t, found)
+
+	// the snapshots falling out of the window are pruned, keeping the one a
+	// query over MaxAPRBlocks starts from
+	height := disttypes.MaxAPRBlocks + 2*disttypes.RewardRateSnapshotInterval
+	ctx = ctx.WithBlockHeight(height).WithBlockTime(start.Add(216 * time.Hour))
+	distrKeeper.AllocateTokens(ctx, 1000, votes)
+	_, found = distrKeeper.GetRewardRateSnapshot(ctx, interval)
+	require.False(t, found)
+	_, found = distrKeeper.GetRewardRateSnapshot(ctx, 2*interval)
+	require.True(t, found)
+
+	_, err = distrKeeper.GetHistoricalAPR(ctx, disttypes.MaxAPRBlocks)
+	require.NoError(t, err)
+}
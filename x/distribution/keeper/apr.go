@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"sync"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// aprCache holds the historical APRs computed by the query server, keyed by
+// the number of blocks they were computed over. It is not part of the state.
+type aprCache struct {
+	mu      sync.Mutex
+	entries map[int64]aprCacheEntry
+}
+
+type aprCacheEntry struct {
+	height int64
+	apr    sdk.Dec
+}
+
+func newAPRCache() *aprCache {
+	return &aprCache{entries: make(map[int64]aprCacheEntry)}
+}
+
+// get returns the APR computed over numBlocks if it was computed less than
+// APRCacheTTL blocks before height.
+func (c *aprCache) get(numBlocks, height int64) (sdk.Dec, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[numBlocks]
+	if !ok || height < entry.height || height >= entry.height+types.APRCacheTTL {
+		return sdk.Dec{}, false
+	}
+	return entry.apr, true
+}
+
+func (c *aprCache) set(numBlocks, height int64, apr sdk.Dec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[numBlocks] = aprCacheEntry{height: height, apr: apr}
+}
+
+// recordRewardRate stores the reward rate snapshot of the current block, adding
+// the rewards allocated to delegators per bonded token to the cumulative ratio
+// of the previous snapshot. Only the snapshots at multiples of
+// RewardRateSnapshotInterval are kept, besides the latest two, and those
+// falling out of the MaxAPRBlocks window are pruned.
+func (k Keeper) recordRewardRate(ctx sdk.Context, delegatorRewards sdk.DecCoins, bondedTokens math.Int) {
+	height := ctx.BlockHeight()
+
+	var ratio sdk.DecCoins
+	if prev, found := k.GetLatestRewardRateSnapshot(ctx, uint64(height-1)); found {
+		ratio = prev.CumulativeRewardRatio
+	}
+	if bondedTokens.IsPositive() {
+		ratio = ratio.Add(delegatorRewards.QuoDecTruncate(sdk.NewDecFromInt(bondedTokens))...)
+	}
+
+	k.SetRewardRateSnapshot(ctx, uint64(height), types.NewRewardRateSnapshot(ctx.BlockTime(), ratio))
+
+	// the snapshot before the previous one was only kept for queries over a
+	// single block
+	if stale := height - 2; stale > 0 && stale%types.RewardRateSnapshotInterval != 0 {
+		k.DeleteRewardRateSnapshot(ctx, uint64(stale))
+	}
+
+	// the snapshot at the latest multiple of the interval at or below
+	// height-MaxAPRBlocks is the oldest one a query can start from
+	if pruned := height - types.MaxAPRBlocks - types.RewardRateSnapshotInterval; pruned > 0 {
+		k.IterateRewardRateSnapshotsBelow(ctx, uint64(pruned)+1, func(height uint64, _ types.RewardRateSnapshot) (stop bool) {
+			k.DeleteRewardRateSnapshot(ctx, height)
+			return false
+		})
+	}
+}
+
+// GetHistoricalAPR returns the annualised rate, in the bond denomination, of the
+// rewards allocated to delegators over the last numBlocks blocks, starting from
+// the latest snapshot at or below height-numBlocks. Rewards
+// include the inflation minted by x/mint, which reaches the distribution module
+// through the fee collector, and exclude the validators' commission. Results
+// are cached for APRCacheTTL blocks.
+func (k Keeper) GetHistoricalAPR(ctx sdk.Context, numBlocks int64) (sdk.Dec, error) {
+	if numBlocks <= 0 || numBlocks > types.MaxAPRBlocks {
+		return sdk.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("number of blocks must be between 1 and %d", types.MaxAPRBlocks)
+	}
+
+	height := ctx.BlockHeight()
+	if apr, ok := k.aprCache.get(numBlocks, height); ok {
+		return apr, nil
+	}
+
+	end, found := k.GetLatestRewardRateSnapshot(ctx, uint64(height))
+	if !found || height-numBlocks <= 0 {
+		return sdk.Dec{}, types.ErrNoRewardHistory
+	}
+	start, found := k.GetLatestRewardRateSnapshot(ctx, uint64(height-numBlocks))
+	if !found {
+		return sdk.Dec{}, types.ErrNoRewardHistory
+	}
+
+	apr := end.AnnualRate(start, k.stakingKeeper.BondDenom(ctx))
+	k.aprCache.set(numBlocks, height, apr)
+
+	return apr, nil
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -28,3 +31,100 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// RefundSlash refunds, from the community pool, the delegators of a validator
+// slashed at the given height. The amount is divided between the delegators
+// bonded at that height in proportion to their stake right before the slash,
+// whatever they did since. Delegators unbonding or redelegating from the
+// validator are included if their stake was slashed too. The remainder of the
+// division stays in the community pool and the amount actually refunded is
+// returned. A slash can only be refunded once, and only until it expires after
+// the unbonding time.
+func (k Keeper) RefundSlash(ctx sdk.Context, valAddr sdk.ValAddress, slashHeight uint64, amount sdk.Coins) (sdk.Coins, error) {
+	slashed := false
+	k.IterateValidatorSlashEventsBetween(ctx, valAddr, slashHeight, slashHeight, func(_ uint64, _ types.ValidatorSlashEvent) (stop bool) {
+		slashed = true
+		return true
+	})
+	if !slashed {
+		return nil, sdkerrors.Wrapf(types.ErrNoSlashEvent, "validator %s, height %d", valAddr, slashHeight)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	amountDec := sdk.NewDecCoinsFromCoins(amount...)
+	if _, negative := feePool.CommunityPool.SafeSub(amountDec); negative {
+		return nil, types.ErrBadDistribution
+	}
+
+	if _, refundable := k.GetRefundableSlash(ctx, valAddr, slashHeight); !refundable {
+		return nil, sdkerrors.Wrapf(types.ErrEmptyDelegationDistInfo, "the slash of validator %s at height %d was already refunded or expired", valAddr, slashHeight)
+	}
+
+	type bondedDelegator struct {
+		addr  sdk.AccAddress
+		stake sdk.Dec
+	}
+
+	var delegators []bondedDelegator
+	indexes := make(map[string]int)
+	totalStake := sdk.ZeroDec()
+	addStake := func(del sdk.AccAddress, stake sdk.Dec) {
+		if i, ok := indexes[del.String()]; ok {
+			delegators[i].stake = delegators[i].stake.Add(stake)
+		} else {
+			indexes[del.String()] = len(delegators)
+			delegators = append(delegators, bondedDelegator{addr: del, stake: stake})
+		}
+		totalStake = totalStake.Add(stake)
+	}
+
+	// the stakes of the delegators which modified their delegation since the
+	// slash, or whose unbonding delegation or redelegation was slashed, were
+	// recorded, and those of the others are derived from their starting infos
+	k.IterateDelegatorSlashStakesAt(ctx, valAddr, slashHeight, func(del sdk.AccAddress, stake sdk.Dec) (stop bool) {
+		addStake(del, stake)
+		return false
+	})
+	k.IterateValidatorDelegatorStartingInfos(ctx, valAddr, func(del sdk.AccAddress, info types.DelegatorStartingInfo) (stop bool) {
+		if stake, ok := k.delegatorStakeAtSlash(ctx, valAddr, info, slashHeight); ok && stake.IsPositive() {
+			addStake(del, stake)
+		}
+		return false
+	})
+	if !totalStake.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrEmptyDelegationDistInfo, "no stake at the slash of validator %s at height %d", valAddr, slashHeight)
+	}
+
+	refunded := sdk.NewCoins()
+	for _, del := range delegators {
+		refund, _ := amountDec.MulDecTruncate(del.stake.QuoTruncate(totalStake)).TruncateDecimal()
+		if refund.IsZero() {
+			continue
+		}
+
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.addr)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, refund); err != nil {
+			return nil, err
+		}
+		refunded = refunded.Add(refund...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashRefunded,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, del.addr.String()),
+				sdk.NewAttribute(types.AttributeKeySlashHeight, strconv.FormatUint(slashHeight, 10)),
+			),
+		)
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(refunded...))
+	k.SetFeePool(ctx, feePool)
+
+	// a slash is refunded at most once
+	k.DeleteDelegatorSlashStakesAt(ctx, valAddr, slashHeight)
+	k.DeleteRefundableSlash(ctx, valAddr, slashHeight)
+
+	return refunded, nil
+}
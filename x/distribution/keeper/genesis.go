@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -79,6 +80,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, stake := range data.DelegatorSlashStakes {
+		valAddr, err := sdk.ValAddressFromBech32(stake.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		delegatorAddress := sdk.MustAccAddressFromBech32(stake.DelegatorAddress)
+
+		k.SetDelegatorSlashStake(ctx, valAddr, stake.Height, delegatorAddress, stake.Stake)
+	}
+	for _, snapshot := range data.RewardRateSnapshots {
+		k.SetRewardRateSnapshot(ctx, snapshot.Height, snapshot.Snapshot)
+	}
+	for _, slash := range data.RefundableSlashes {
+		valAddr, err := sdk.ValAddressFromBech32(slash.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetRefundableSlash(ctx, valAddr, slash.Height, slash.Time)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -184,5 +204,41 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	slashStakes := make([]types.DelegatorSlashStakeRecord, 0)
+	k.IterateDelegatorSlashStakes(ctx,
+		func(val sdk.ValAddress, height uint64, del sdk.AccAddress, stake sdk.Dec) (stop bool) {
+			slashStakes = append(slashStakes, types.DelegatorSlashStakeRecord{
+				ValidatorAddress: val.String(),
+				Height:           height,
+				DelegatorAddress: del.String(),
+				Stake:            stake,
+			})
+			return false
+		},
+	)
+
+	snapshots := make([]types.RewardRateSnapshotRecord, 0)
+	k.IterateRewardRateSnapshots(ctx,
+		func(height uint64, snapshot types.RewardRateSnapshot) (stop bool) {
+			snapshots = append(snapshots, types.RewardRateSnapshotRecord{
+				Height:   height,
+				Snapshot: snapshot,
+			})
+			return false
+		},
+	)
+
+	refundableSlashes := make([]types.RefundableSlashRecord, 0)
+	k.IterateRefundableSlashes(ctx,
+		func(val sdk.ValAddress, height uint64, slashTime time.Time) (stop bool) {
+			refundableSlashes = append(refundableSlashes, types.RefundableSlashRecord{
+				ValidatorAddress: val.String(),
+				Height:           height,
+				Time:             slashTime,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, slashStakes, snapshots, refundableSlashes)
 }
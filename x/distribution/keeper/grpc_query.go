@@ -293,3 +293,19 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// HistoricalAPR queries the annualised rate of the rewards allocated to
+// delegators over the last blocks
+func (k Querier) HistoricalAPR(c context.Context, req *types.QueryHistoricalAPRRequest) (*types.QueryHistoricalAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	apr, err := k.GetHistoricalAPR(ctx, req.NumBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoricalAPRResponse{Apr: apr}, nil
+}
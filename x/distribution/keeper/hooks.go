@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -87,11 +89,14 @@ func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
-// withdraw delegation rewards (which also increments period)
+// record the stakes at the refundable slash events and withdraw delegation
+// rewards (which also increments period)
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
 	del := h.k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
 
+	h.k.recordDelegatorSlashStakes(ctx, valAddr, delAddr)
+
 	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
 		return err
 	}
@@ -130,3 +135,11 @@ func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// record the stake of an unbonding or redelegating delegator at the slash event
+func (h Hooks) AfterUnbondingSlashed(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, stake math.Int) error {
+	height := uint64(ctx.BlockHeight())
+	h.k.addDelegatorSlashStake(ctx, valAddr, height, delAddr, sdk.NewDecFromInt(stake))
+	h.k.SetRefundableSlash(ctx, valAddr, height, ctx.BlockTime())
+	return nil
+}
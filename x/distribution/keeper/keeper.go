@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -24,6 +25,8 @@ type Keeper struct {
 	authority string
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	aprCache *aprCache
 }
 
 // NewKeeper creates a new distribution Keeper instance
@@ -45,6 +48,7 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
+		aprCache:         newAPRCache(),
 	}
 }
 
@@ -102,6 +106,42 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of a delegator from the
+// first MaxWithdrawalsPerTx validators it delegates to, and returns the total
+// withdrawn amount along with the number of delegations left to withdraw from.
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, uint64, error) {
+	maxWithdrawals := int(k.GetMaxWithdrawalsPerTx(ctx))
+
+	var (
+		valAddrs  []sdk.ValAddress
+		remaining uint64
+	)
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		if len(valAddrs) < maxWithdrawals {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+		} else {
+			remaining++
+		}
+		return false
+	})
+
+	if len(valAddrs) == 0 {
+		return nil, 0, types.ErrEmptyDelegationDistInfo
+	}
+
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	return total, remaining, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -136,6 +176,34 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// SetValidatorFeeShare sets the fraction of the commission earned by a
+// validator on the blocks it proposed which is shared with its delegators. The
+// fee share cannot exceed the maximum validator fee share.
+func (k Keeper) SetValidatorFeeShare(ctx sdk.Context, valAddr sdk.ValAddress, feeShare sdk.Dec) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	maxFeeShare := k.GetMaxValidatorFeeShare(ctx)
+	if feeShare.GT(maxFeeShare) {
+		return sdkerrors.Wrapf(types.ErrFeeShareTooHigh, "%s > %s", feeShare, maxFeeShare)
+	}
+
+	currentRewards := k.GetValidatorCurrentRewards(ctx, valAddr)
+	currentRewards.ValidatorFeeShare = feeShare
+	k.SetValidatorCurrentRewards(ctx, valAddr, currentRewards)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeShare,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeShare, feeShare.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
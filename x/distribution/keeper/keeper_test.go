@@ -199,3 +199,126 @@ func TestFundCommunityPool(t *testing.T) {
 
 	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
 }
+
+func TestRefundSlash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	feePool := types.InitialFeePool()
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 100))
+	distrKeeper.SetFeePool(ctx, feePool)
+
+	// the validator was slashed by half at height 5 and again at height 10, the
+	// stake of the first delegator at the second slash is derived from its
+	// starting info, the second delegator modified its delegation since and its
+	// stake was recorded, and the last delegator delegated after the slash and
+	// has a larger stake now than the others had at the slash
+	distrKeeper.SetValidatorSlashEvent(ctx, valAddr, 5, 2, types.NewValidatorSlashEvent(2, sdk.NewDecWithPrec(5, 1)))
+	distrKeeper.SetValidatorSlashEvent(ctx, valAddr, 10, 3, types.NewValidatorSlashEvent(3, sdk.NewDecWithPrec(5, 2)))
+	distrKeeper.SetRefundableSlash(ctx, valAddr, 10, ctx.BlockTime())
+	distrKeeper.SetDelegatorStartingInfo(ctx, valAddr, addrs[1], types.NewDelegatorStartingInfo(1, math.LegacyNewDec(60), 2))
+	distrKeeper.SetDelegatorSlashStake(ctx, valAddr, 10, addrs[2], math.LegacyNewDec(10))
+	distrKeeper.SetDelegatorStartingInfo(ctx, valAddr, addrs[2], types.NewDelegatorStartingInfo(3, math.LegacyNewDec(20), 12))
+	distrKeeper.SetDelegatorStartingInfo(ctx, valAddr, addrs[3], types.NewDelegatorStartingInfo(3, math.LegacyNewDec(100), 20))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	// no slash at that height
+	_, err := distrKeeper.RefundSlash(ctx, valAddr, 11, amount)
+	require.ErrorIs(t, err, types.ErrNoSlashEvent)
+
+	// the slash at that height expired
+	_, err = distrKeeper.RefundSlash(ctx, valAddr, 5, amount)
+	require.ErrorIs(t, err, types.ErrEmptyDelegationDistInfo)
+
+	// insufficient community pool
+	_, err = distrKeeper.RefundSlash(ctx, valAddr, 10, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+
+	// the refund is divided between the delegators bonded at the slash height,
+	// 37.5 and 12.5 being truncated
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 37))).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[2], sdk.NewCoins(sdk.NewInt64Coin("stake", 12))).Return(nil)
+	refunded, err := distrKeeper.RefundSlash(ctx, valAddr, 10, amount)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 49)), refunded)
+
+	// the remainder stays in the community pool
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 51)), distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// a slash is refunded once
+	_, err = distrKeeper.RefundSlash(ctx, valAddr, 10, amount)
+	require.ErrorIs(t, err, types.ErrEmptyDelegationDistInfo)
+}
+
+func TestDelegatorSlashStakes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	slashTime := time.Now().UTC()
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 10, Time: slashTime})
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21 * 24 * time.Hour).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// the stakes of the unbonding delegations and redelegations of a delegator
+	// slashed along with the validator add up
+	require.NoError(t, distrKeeper.Hooks().AfterUnbondingSlashed(ctx, addrs[1], valAddr, math.NewInt(30)))
+	require.NoError(t, distrKeeper.Hooks().AfterUnbondingSlashed(ctx, addrs[1], valAddr, math.NewInt(20)))
+	stake, found := distrKeeper.GetDelegatorSlashStake(ctx, valAddr, 10, addrs[1])
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(50), stake)
+	_, found = distrKeeper.GetRefundableSlash(ctx, valAddr, 10)
+	require.True(t, found)
+
+	// the stakes are kept for the unbonding time after the slash
+	distrKeeper.PruneExpiredDelegatorSlashStakes(ctx.WithBlockTime(slashTime.Add(20 * 24 * time.Hour)))
+	_, found = distrKeeper.GetDelegatorSlashStake(ctx, valAddr, 10, addrs[1])
+	require.True(t, found)
+
+	distrKeeper.PruneExpiredDelegatorSlashStakes(ctx.WithBlockTime(slashTime.Add(21 * 24 * time.Hour)))
+	_, found = distrKeeper.GetDelegatorSlashStake(ctx, valAddr, 10, addrs[1])
+	require.False(t, found)
+	_, found = distrKeeper.GetRefundableSlash(ctx, valAddr, 10)
+	require.False(t, found)
+}
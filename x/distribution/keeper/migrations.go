@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/exported"
 	v2 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v3"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -30,3 +31,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates the x/distribution module state from the consensus
+// version 3 to version 4. Specifically, it sets the default max validator fee
+// share param.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
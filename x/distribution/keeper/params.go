@@ -40,6 +40,18 @@ func (k Keeper) GetCommunityTax(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).CommunityTax
 }
 
+// GetMaxValidatorFeeShare returns the current distribution maximum validator
+// fee share.
+func (k Keeper) GetMaxValidatorFeeShare(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).MaxValidatorFeeShare
+}
+
+// GetMaxWithdrawalsPerTx returns the current distribution maximum number of
+// delegations withdrawn at once by MsgWithdrawAllDelegatorRewards.
+func (k Keeper) GetMaxWithdrawalsPerTx(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).WithdrawalsPerTxLimit()
+}
+
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
 func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
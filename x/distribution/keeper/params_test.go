@@ -52,10 +52,11 @@ func TestParams(t *testing.T) {
 		{
 			name: "community tax > 1",
 			input: types.Params{
-				CommunityTax:        sdk.NewDecWithPrec(2, 0),
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:         sdk.NewDecWithPrec(2, 0),
+				BaseProposerReward:   sdk.ZeroDec(),
+				BonusProposerReward:  sdk.ZeroDec(),
+				WithdrawAddrEnabled:  withdrawAddrEnabled,
+				MaxValidatorFeeShare: sdk.ZeroDec(),
 			},
 			expErr:    true,
 			expErrMsg: "community tax should be non-negative and less than one",
@@ -63,21 +64,35 @@ func TestParams(t *testing.T) {
 		{
 			name: "negative community tax",
 			input: types.Params{
-				CommunityTax:        sdk.NewDecWithPrec(-2, 1),
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:         sdk.NewDecWithPrec(-2, 1),
+				BaseProposerReward:   sdk.ZeroDec(),
+				BonusProposerReward:  sdk.ZeroDec(),
+				WithdrawAddrEnabled:  withdrawAddrEnabled,
+				MaxValidatorFeeShare: sdk.ZeroDec(),
 			},
 			expErr:    true,
 			expErrMsg: "community tax should be non-negative and less than one",
 		},
+		{
+			name: "max validator fee share > 1",
+			input: types.Params{
+				CommunityTax:         communityTax,
+				BaseProposerReward:   sdk.ZeroDec(),
+				BonusProposerReward:  sdk.ZeroDec(),
+				WithdrawAddrEnabled:  withdrawAddrEnabled,
+				MaxValidatorFeeShare: sdk.NewDecWithPrec(11, 1),
+			},
+			expErr:    true,
+			expErrMsg: "max validator fee share should be non-negative and less than one",
+		},
 		{
 			name: "base proposer reward > 1",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:         communityTax,
+				BaseProposerReward:   sdk.NewDecWithPrec(1, 2),
+				BonusProposerReward:  sdk.ZeroDec(),
+				WithdrawAddrEnabled:  withdrawAddrEnabled,
+				MaxValidatorFeeShare: sdk.ZeroDec(),
 			},
 			expErr:    false,
 			expErrMsg: "base proposer rewards should not be taken into account",
@@ -85,10 +100,11 @@ func TestParams(t *testing.T) {
 		{
 			name: "bonus proposer reward > 1",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:         communityTax,
+				BaseProposerReward:   sdk.NewDecWithPrec(1, 2),
+				BonusProposerReward:  sdk.ZeroDec(),
+				WithdrawAddrEnabled:  withdrawAddrEnabled,
+				MaxValidatorFeeShare: sdk.ZeroDec(),
 			},
 			expErr:    false,
 			expErrMsg: "bonus proposer rewards should not be taken into account",
@@ -96,10 +112,11 @@ func TestParams(t *testing.T) {
 		{
 			name: "all good",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:         communityTax,
+				BaseProposerReward:   sdk.ZeroDec(),
+				BonusProposerReward:  sdk.ZeroDec(),
+				WithdrawAddrEnabled:  withdrawAddrEnabled,
+				MaxValidatorFeeShare: sdk.ZeroDec(),
 			},
 			expErr: false,
 		},
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -124,6 +126,21 @@ func (k Keeper) IterateDelegatorStartingInfos(ctx sdk.Context, handler func(val
 	}
 }
 
+// iterate over the starting infos of the delegators of a validator
+func (k Keeper) IterateValidatorDelegatorStartingInfos(ctx sdk.Context, val sdk.ValAddress, handler func(del sdk.AccAddress, info types.DelegatorStartingInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorStartingInfoPrefix(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.DelegatorStartingInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		_, del := types.GetDelegatorStartingInfoAddresses(iter.Key())
+		if handler(del, info) {
+			break
+		}
+	}
+}
+
 // get historical rewards for a particular period
 func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64) (rewards types.ValidatorHistoricalRewards) {
 	store := ctx.KVStore(k.storeKey)
@@ -364,22 +381,251 @@ func (k Keeper) IterateValidatorSlashEvents(ctx sdk.Context, handler func(val sd
 	}
 }
 
-// delete slash events for a particular validator
+// delete slash events for a particular validator, along with the stakes of
+// its delegators at these slash events and their refundability
 func (k Keeper) DeleteValidatorSlashEvents(ctx sdk.Context, val sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorSlashEventPrefix(val))
+	for _, prefix := range [][]byte{
+		types.GetValidatorSlashEventPrefix(val), types.GetValidatorDelegatorSlashStakesPrefix(val), types.GetValidatorRefundableSlashesPrefix(val),
+	} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			store.Delete(iter.Key())
+		}
+		iter.Close()
+	}
+}
+
+// delete all slash events, along with the stakes of the delegators at these
+// slash events and their refundability
+func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{
+		types.ValidatorSlashEventPrefix, types.DelegatorSlashStakePrefix, types.DelegatorSlashStakeQueue, types.RefundableSlashPrefix,
+	} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			store.Delete(iter.Key())
+		}
+		iter.Close()
+	}
+}
+
+// get the stake of a delegator at the slash event of a validator at a height
+func (k Keeper) GetDelegatorSlashStake(ctx sdk.Context, val sdk.ValAddress, height uint64, del sdk.AccAddress) (stake sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorSlashStakeKey(val, height, del))
+	if b == nil {
+		return sdk.Dec{}, false
+	}
+	var dp sdk.DecProto
+	k.cdc.MustUnmarshal(b, &dp)
+	return dp.Dec, true
+}
+
+// set the stake of a delegator at the slash event of a validator at a height
+func (k Keeper) SetDelegatorSlashStake(ctx sdk.Context, val sdk.ValAddress, height uint64, del sdk.AccAddress, stake sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&sdk.DecProto{Dec: stake})
+	store.Set(types.GetDelegatorSlashStakeKey(val, height, del), b)
+}
+
+// iterate over the stakes of the delegators at the slash event of a validator at a height
+func (k Keeper) IterateDelegatorSlashStakesAt(ctx sdk.Context, val sdk.ValAddress, height uint64, handler func(del sdk.AccAddress, stake sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorSlashStakesPrefix(val, height))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+		_, _, del := types.GetDelegatorSlashStakeAddressesHeight(iter.Key())
+		if handler(del, dp.Dec) {
+			break
+		}
+	}
+}
+
+// delete the stakes of the delegators at the slash event of a validator at a height
+func (k Keeper) DeleteDelegatorSlashStakesAt(ctx sdk.Context, val sdk.ValAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorSlashStakesPrefix(val, height))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
 
-// delete all slash events
-func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
+// queue the stakes of the delegators at the slash event of a validator at a height and time for pruning
+func (k Keeper) SetDelegatorSlashStakeQueue(ctx sdk.Context, slashTime time.Time, val sdk.ValAddress, height uint64) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.ValidatorSlashEventPrefix)
+	store.Set(types.GetDelegatorSlashStakeQueueKey(slashTime, val, height), []byte{})
+}
+
+// prune the stakes of the delegators at the slash events older than the
+// unbonding time, which can no longer be refunded
+func (k Keeper) PruneExpiredDelegatorSlashStakes(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.stakingKeeper.UnbondingTime(ctx))
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.DelegatorSlashStakeQueue, sdk.PrefixEndBytes(types.GetDelegatorSlashStakeQueueTimeKey(cutoff)))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		val, height := types.GetDelegatorSlashStakeQueueAddressHeight(key)
+		k.DeleteDelegatorSlashStakesAt(ctx, val, height)
+		k.DeleteRefundableSlash(ctx, val, height)
+		store.Delete(key)
+	}
+}
+
+// iterate over the stakes of the delegators at all slash events
+func (k Keeper) IterateDelegatorSlashStakes(ctx sdk.Context, handler func(val sdk.ValAddress, height uint64, del sdk.AccAddress, stake sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorSlashStakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+		val, height, del := types.GetDelegatorSlashStakeAddressesHeight(iter.Key())
+		if handler(val, height, del, dp.Dec) {
+			break
+		}
+	}
+}
+
+// get the time of the slash event of a validator at a height, if it can still be refunded
+func (k Keeper) GetRefundableSlash(ctx sdk.Context, val sdk.ValAddress, height uint64) (slashTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetRefundableSlashKey(val, height))
+	if b == nil {
+		return time.Time{}, false
+	}
+	slashTime, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return slashTime, true
+}
+
+// set the slash event of a validator at a height and time as refundable, and
+// queue it for pruning once the unbonding time has passed
+func (k Keeper) SetRefundableSlash(ctx sdk.Context, val sdk.ValAddress, height uint64, slashTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRefundableSlashKey(val, height), sdk.FormatTimeBytes(slashTime))
+	k.SetDelegatorSlashStakeQueue(ctx, slashTime, val, height)
+}
+
+// delete the refundability of the slash event of a validator at a height
+func (k Keeper) DeleteRefundableSlash(ctx sdk.Context, val sdk.ValAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRefundableSlashKey(val, height))
+}
+
+// iterate over the refundable slash events of a validator, in ascending order of height
+func (k Keeper) IterateValidatorRefundableSlashes(ctx sdk.Context, val sdk.ValAddress, handler func(height uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorRefundableSlashesPrefix(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, height := types.GetRefundableSlashAddressHeight(iter.Key())
+		if handler(height) {
+			break
+		}
+	}
+}
+
+// iterate over the refundable slash events of all validators
+func (k Keeper) IterateRefundableSlashes(ctx sdk.Context, handler func(val sdk.ValAddress, height uint64, slashTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RefundableSlashPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		val, height := types.GetRefundableSlashAddressHeight(iter.Key())
+		slashTime, err := sdk.ParseTimeBytes(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		if handler(val, height, slashTime) {
+			break
+		}
+	}
+}
+
+// get the reward rate snapshot for height
+func (k Keeper) GetRewardRateSnapshot(ctx sdk.Context, height uint64) (snapshot types.RewardRateSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetRewardRateSnapshotKey(height))
+	if b == nil {
+		return types.RewardRateSnapshot{}, false
+	}
+	k.cdc.MustUnmarshal(b, &snapshot)
+	return snapshot, true
+}
+
+// set the reward rate snapshot for height
+func (k Keeper) SetRewardRateSnapshot(ctx sdk.Context, height uint64, snapshot types.RewardRateSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetRewardRateSnapshotKey(height), b)
+}
+
+// delete the reward rate snapshot for height
+func (k Keeper) DeleteRewardRateSnapshot(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardRateSnapshotKey(height))
+}
+
+// iterate over the reward rate snapshots below height, in ascending order of height
+func (k Keeper) IterateRewardRateSnapshotsBelow(ctx sdk.Context, height uint64, handler func(height uint64, snapshot types.RewardRateSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.RewardRateSnapshotPrefix, types.GetRewardRateSnapshotKey(height))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.RewardRateSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if handler(types.GetRewardRateSnapshotHeight(iter.Key()), snapshot) {
+			break
+		}
+	}
+}
+
+// iterate over all reward rate snapshots
+func (k Keeper) IterateRewardRateSnapshots(ctx sdk.Context, handler func(height uint64, snapshot types.RewardRateSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardRateSnapshotPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.RewardRateSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if handler(types.GetRewardRateSnapshotHeight(iter.Key()), snapshot) {
+			break
+		}
+	}
+}
+
+// delete all reward rate snapshots
+func (k Keeper) DeleteAllRewardRateSnapshots(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardRateSnapshotPrefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
+
+// get the latest reward rate snapshot at or below height
+func (k Keeper) GetLatestRewardRateSnapshot(ctx sdk.Context, height uint64) (snapshot types.RewardRateSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.RewardRateSnapshotPrefix, types.GetRewardRateSnapshotKey(height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.RewardRateSnapshot{}, false
+	}
+	k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+	return snapshot, true
+}
@@ -58,8 +58,12 @@ func (k Keeper) IncrementValidatorPeriod(ctx sdk.Context, val stakingtypes.Valid
 	// set new historical rewards with reference count of 1
 	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period, types.NewValidatorHistoricalRewards(historical.Add(current...), 1))
 
-	// set current rewards, incrementing period by 1
-	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), types.NewValidatorCurrentRewards(sdk.DecCoins{}, rewards.Period+1))
+	// set current rewards, incrementing period by 1 and keeping the fee share
+	currentRewards := types.NewValidatorCurrentRewards(sdk.DecCoins{}, rewards.Period+1)
+	if !rewards.ValidatorFeeShare.IsNil() {
+		currentRewards.ValidatorFeeShare = rewards.ValidatorFeeShare
+	}
+	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), currentRewards)
 
 	return rewards.Period
 }
@@ -105,4 +109,58 @@ func (k Keeper) updateValidatorSlashFraction(ctx sdk.Context, valAddr sdk.ValAdd
 	height := uint64(ctx.BlockHeight())
 
 	k.SetValidatorSlashEvent(ctx, valAddr, height, newPeriod, slashEvent)
+	k.SetRefundableSlash(ctx, valAddr, height, ctx.BlockTime())
+}
+
+// addDelegatorSlashStake adds to the stake of a delegator recorded at the slash
+// event of a validator at the given height.
+func (k Keeper) addDelegatorSlashStake(ctx sdk.Context, valAddr sdk.ValAddress, height uint64, del sdk.AccAddress, stake sdk.Dec) {
+	if recorded, found := k.GetDelegatorSlashStake(ctx, valAddr, height, del); found {
+		stake = stake.Add(recorded)
+	}
+
+	k.SetDelegatorSlashStake(ctx, valAddr, height, del, stake)
+}
+
+// delegatorStakeAtSlash returns the stake of a delegation with the given
+// starting info right before the slash event of its validator at the given
+// height, derived from the starting stake and the slash events in between,
+// and false if the delegation started at or after the slash event.
+func (k Keeper) delegatorStakeAtSlash(ctx sdk.Context, valAddr sdk.ValAddress, startingInfo types.DelegatorStartingInfo, height uint64) (sdk.Dec, bool) {
+	if startingInfo.Height >= height {
+		return sdk.ZeroDec(), false
+	}
+
+	startingPeriod := startingInfo.PreviousPeriod
+	stake := startingInfo.Stake
+	k.IterateValidatorSlashEventsBetween(ctx, valAddr, startingInfo.Height, height-1,
+		func(_ uint64, event types.ValidatorSlashEvent) (stop bool) {
+			if event.ValidatorPeriod > startingPeriod {
+				stake = stake.MulTruncate(math.LegacyOneDec().Sub(event.Fraction))
+				startingPeriod = event.ValidatorPeriod
+			}
+			return false
+		},
+	)
+
+	return stake, true
+}
+
+// recordDelegatorSlashStakes records the stake of a delegator at each
+// refundable slash event of its validator, before its delegation is modified
+// and its starting info no longer tells its stake at these slash events. The
+// stakes of the other delegators are derived from their starting infos when
+// the slash is refunded.
+func (k Keeper) recordDelegatorSlashStakes(ctx sdk.Context, valAddr sdk.ValAddress, del sdk.AccAddress) {
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, del) {
+		return
+	}
+
+	startingInfo := k.GetDelegatorStartingInfo(ctx, valAddr, del)
+	k.IterateValidatorRefundableSlashes(ctx, valAddr, func(height uint64) (stop bool) {
+		if stake, ok := k.delegatorStakeAtSlash(ctx, valAddr, startingInfo, height); ok && stake.IsPositive() {
+			k.addDelegatorSlashStake(ctx, valAddr, height, del, stake)
+		}
+		return false
+	})
 }
@@ -14,5 +14,10 @@ func MigrateJSON(oldState *types.GenesisState) *types.GenesisState {
 	oldState.Params.BaseProposerReward = sdk.ZeroDec()
 	oldState.Params.BonusProposerReward = sdk.ZeroDec()
 
+	// the max validator fee share is not a legacy param
+	if oldState.Params.MaxValidatorFeeShare.IsNil() {
+		oldState.Params.MaxValidatorFeeShare = types.DefaultParams().MaxValidatorFeeShare
+	}
+
 	return oldState
 }
@@ -41,6 +41,7 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"delegator_slash_stakes": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
@@ -51,9 +52,13 @@ func TestMigrateJSON(t *testing.T) {
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
+		"max_validator_fee_share": "0.000000000000000000",
+		"max_withdrawals_per_tx": 50,
 		"withdraw_addr_enabled": true
 	},
 	"previous_proposer": "",
+	"refundable_slashes": [],
+	"reward_rate_snapshots": [],
 	"validator_accumulated_commissions": [],
 	"validator_current_rewards": [],
 	"validator_historical_rewards": [],
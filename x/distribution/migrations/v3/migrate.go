@@ -27,6 +27,11 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 	currParams.BaseProposerReward = sdk.ZeroDec()
 	currParams.BonusProposerReward = sdk.ZeroDec()
 
+	// the max validator fee share is not a legacy param
+	if currParams.MaxValidatorFeeShare.IsNil() {
+		currParams.MaxValidatorFeeShare = types.DefaultParams().MaxValidatorFeeShare
+	}
+
 	if err := currParams.ValidateBasic(); err != nil {
 		return err
 	}
@@ -0,0 +1,42 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	ModuleName = "distribution"
+)
+
+var ParamsKey = []byte{0x09}
+
+// MigrateStore migrates the x/distribution module state from the consensus version 3 to
+// version 4. Specifically, it sets the default max validator fee share param.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	if params.MaxValidatorFeeShare.IsNil() {
+		params.MaxValidatorFeeShare = types.DefaultParams().MaxValidatorFeeShare
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
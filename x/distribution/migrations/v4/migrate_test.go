@@ -0,0 +1,36 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the max validator fee share was introduced
+	oldParams := types.DefaultParams()
+	oldParams.MaxValidatorFeeShare = sdk.Dec{}
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v4.ParamsKey, bz)
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, types.DefaultParams().CommunityTax, res.CommunityTax)
+	require.True(t, types.DefaultParams().MaxValidatorFeeShare.Equal(res.MaxValidatorFeeShare))
+}
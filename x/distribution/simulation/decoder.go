@@ -63,6 +63,34 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.RewardRateSnapshotPrefix):
+			var snapshotA, snapshotB types.RewardRateSnapshot
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorSlashStakePrefix):
+			var stakeA, stakeB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &stakeA)
+			cdc.MustUnmarshal(kvB.Value, &stakeB)
+			return fmt.Sprintf("%v\n%v", stakeA.Dec, stakeB.Dec)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorSlashStakeQueue):
+			valA, heightA := types.GetDelegatorSlashStakeQueueAddressHeight(kvA.Key)
+			valB, heightB := types.GetDelegatorSlashStakeQueueAddressHeight(kvB.Key)
+			return fmt.Sprintf("%v %d\n%v %d", valA, heightA, valB, heightB)
+
+		case bytes.Equal(kvA.Key[:1], types.RefundableSlashPrefix):
+			timeA, err := sdk.ParseTimeBytes(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			timeB, err := sdk.ParseTimeBytes(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -40,6 +41,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, math.LegacyOneDec())
+	snapshot := types.NewRewardRateSnapshot(time.Unix(0, 0).UTC(), decCoins)
+	slashStake := math.LegacyNewDec(10)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -52,6 +55,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetRewardRateSnapshotKey(13), Value: cdc.MustMarshal(&snapshot)},
+			{Key: types.GetDelegatorSlashStakeKey(valAddr1, 13, delAddr1), Value: cdc.MustMarshal(&sdk.DecProto{Dec: slashStake})},
+			{Key: types.GetDelegatorSlashStakeQueueKey(time.Unix(13, 0).UTC(), valAddr1, 13), Value: []byte{}},
+			{Key: types.GetRefundableSlashKey(valAddr1, 13), Value: sdk.FormatTimeBytes(time.Unix(13, 0).UTC())},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -69,6 +76,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"RewardRateSnapshot", fmt.Sprintf("%v\n%v", snapshot, snapshot)},
+		{"DelegatorSlashStake", fmt.Sprintf("%v\n%v", slashStake, slashStake)},
+		{"DelegatorSlashStakeQueue", fmt.Sprintf("%v 13\n%v 13", valAddr1, valAddr1)},
+		{"RefundableSlash", fmt.Sprintf("%v\n%v", time.Unix(13, 0).UTC(), time.Unix(13, 0).UTC())},
 		{"other", ""},
 	}
 	for i, tt := range tests {
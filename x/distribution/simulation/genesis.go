@@ -15,8 +15,9 @@ import (
 
 // Simulation parameter constants
 const (
-	CommunityTax    = "community_tax"
-	WithdrawEnabled = "withdraw_enabled"
+	CommunityTax         = "community_tax"
+	WithdrawEnabled      = "withdraw_enabled"
+	MaxValidatorFeeShare = "max_validator_fee_share"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -29,6 +30,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenMaxValidatorFeeShare randomized MaxValidatorFeeShare
+func GenMaxValidatorFeeShare(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -43,11 +49,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var maxValidatorFeeShare sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxValidatorFeeShare, &maxValidatorFeeShare, simState.Rand,
+		func(r *rand.Rand) { maxValidatorFeeShare = GenMaxValidatorFeeShare(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:         communityTax,
+			WithdrawAddrEnabled:  withdrawEnabled,
+			MaxValidatorFeeShare: maxValidatorFeeShare,
 		},
 	}
 
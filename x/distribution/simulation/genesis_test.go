@@ -41,11 +41,13 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &distrGenesis)
 
 	dec1, _ := sdk.NewDecFromStr("0.210000000000000000")
+	dec2, _ := sdk.NewDecFromStr("0.480000000000000000")
 
 	require.Equal(t, sdk.ZeroDec(), distrGenesis.Params.BaseProposerReward)  //nolint:staticcheck
 	require.Equal(t, sdk.ZeroDec(), distrGenesis.Params.BonusProposerReward) //nolint:staticcheck
 	require.Equal(t, dec1, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, dec2, distrGenesis.Params.MaxValidatorFeeShare)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
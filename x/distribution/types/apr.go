@@ -0,0 +1,46 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxAPRBlocks is the number of past blocks reward rate snapshots are kept
+	// for, and thus the largest window the historical APR can be computed over.
+	MaxAPRBlocks int64 = 100_000
+
+	// RewardRateSnapshotInterval is the number of blocks between two kept
+	// reward rate snapshots. A historical APR may thus start up to
+	// RewardRateSnapshotInterval-1 blocks before the requested window.
+	RewardRateSnapshotInterval int64 = 100
+
+	// APRCacheTTL is the number of blocks a computed historical APR is served
+	// from the cache before being recomputed.
+	APRCacheTTL int64 = 10
+)
+
+// secondsPerYear is the number of seconds in a 365.25 day year.
+var secondsPerYear = sdk.NewDec(int64(365.25 * 24 * 60 * 60))
+
+// create a new RewardRateSnapshot
+func NewRewardRateSnapshot(t time.Time, cumulativeRewardRatio sdk.DecCoins) RewardRateSnapshot {
+	return RewardRateSnapshot{
+		Time:                  t,
+		CumulativeRewardRatio: cumulativeRewardRatio,
+	}
+}
+
+// AnnualRate returns the rate, in the given denomination, at which rewards were
+// allocated per bonded token between the start snapshot and s, annualised
+// using the time elapsed between the two snapshots.
+func (s RewardRateSnapshot) AnnualRate(start RewardRateSnapshot, denom string) sdk.Dec {
+	elapsed := int64(s.Time.Sub(start.Time) / time.Second)
+	if elapsed <= 0 {
+		return sdk.ZeroDec()
+	}
+
+	growth := s.CumulativeRewardRatio.AmountOf(denom).Sub(start.CumulativeRewardRatio.AmountOf(denom))
+	return growth.Mul(secondsPerYear).QuoInt64(elapsed)
+}
@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,14 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// max_validator_fee_share is the maximum fraction of the commission earned
+	// by a validator on the blocks it proposed which it can share with its
+	// delegators.
+	MaxValidatorFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_validator_fee_share,json=maxValidatorFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_fee_share"`
+	// max_withdrawals_per_tx is the maximum number of delegations a delegator
+	// can withdraw the rewards of at once with MsgWithdrawAllDelegatorRewards.
+	// The default of 50 applies when zero.
+	MaxWithdrawalsPerTx uint32 `protobuf:"varint,6,opt,name=max_withdrawals_per_tx,json=maxWithdrawalsPerTx,proto3" json:"max_withdrawals_per_tx,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +90,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxWithdrawalsPerTx() uint32 {
+	if m != nil {
+		return m.MaxWithdrawalsPerTx
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -149,6 +168,9 @@ func (m *ValidatorHistoricalRewards) GetReferenceCount() uint32 {
 type ValidatorCurrentRewards struct {
 	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 	Period  uint64                                      `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// validator_fee_share is the fraction of the commission earned by the
+	// validator on the blocks it proposed which is shared with its delegators.
+	ValidatorFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=validator_fee_share,json=validatorFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_share"`
 }
 
 func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards{} }
@@ -339,6 +361,62 @@ func (m *ValidatorSlashEvent) GetValidatorPeriod() uint64 {
 	return 0
 }
 
+// RewardRateSnapshot records, at the height implicit within the store key, the
+// cumulative sum of the rewards allocated to delegators per bonded token.
+// Commission is excluded from the allocated rewards. Snapshots are used to
+// estimate the historical APR.
+type RewardRateSnapshot struct {
+	Time                  time.Time                                   `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
+}
+
+func (m *RewardRateSnapshot) Reset()         { *m = RewardRateSnapshot{} }
+func (m *RewardRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RewardRateSnapshot) ProtoMessage()    {}
+func (*RewardRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{6}
+}
+func (m *RewardRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardRateSnapshot.Merge(m, src)
+}
+func (m *RewardRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardRateSnapshot proto.InternalMessageInfo
+
+func (m *RewardRateSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *RewardRateSnapshot) GetCumulativeRewardRatio() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeRewardRatio
+	}
+	return nil
+}
+
 // ValidatorSlashEvents is a collection of ValidatorSlashEvent messages.
 type ValidatorSlashEvents struct {
 	ValidatorSlashEvents []ValidatorSlashEvent `protobuf:"bytes,1,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
@@ -347,7 +425,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +470,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +526,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +571,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +625,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +668,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,6 +704,7 @@ func init() {
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
 	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
 	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
+	proto.RegisterType((*RewardRateSnapshot)(nil), "cosmos.distribution.v1beta1.RewardRateSnapshot")
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
@@ -639,69 +718,79 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x34, 0x8e, 0x93, 0x4c, 0x69, 0x42, 0x27, 0x4e, 0xea, 0xb8, 0x95, 0x6d, 0xad, 0x44,
-	0x49, 0x43, 0x63, 0x93, 0x22, 0x24, 0x64, 0x21, 0xa4, 0xd8, 0x0e, 0x82, 0x53, 0xa3, 0x0d, 0x02,
-	0xc4, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xee, 0xce, 0x2c, 0x33, 0x63, 0x27, 0x3d, 0x70, 0xaf,
-	0x7a, 0x00, 0x8e, 0x15, 0xa7, 0x08, 0x2e, 0x15, 0xa7, 0x1c, 0xf2, 0x21, 0x2a, 0x4e, 0x55, 0x0f,
-	0x80, 0x2a, 0x14, 0x50, 0x72, 0x08, 0xe2, 0x53, 0xa0, 0xd9, 0x19, 0xaf, 0x9d, 0x10, 0xa2, 0x4a,
-	0xad, 0xd5, 0x4b, 0xe2, 0x79, 0x6f, 0xf7, 0xfd, 0xfe, 0xcc, 0x9b, 0x37, 0x0b, 0xab, 0x3e, 0x97,
-	0x11, 0x97, 0xb5, 0x80, 0x4a, 0x25, 0x68, 0xbb, 0xa7, 0x28, 0x67, 0xb5, 0xfe, 0x5a, 0x9b, 0x28,
-	0xbc, 0x76, 0x2a, 0x58, 0x8d, 0x05, 0x57, 0x1c, 0x5d, 0x37, 0xcf, 0x57, 0x4f, 0xa5, 0xec, 0xf3,
-	0xc5, 0x7c, 0x87, 0x77, 0x78, 0xf2, 0x5c, 0x4d, 0xff, 0x32, 0xaf, 0x14, 0x4b, 0x16, 0xa2, 0x8d,
-	0x25, 0x49, 0x4b, 0xfb, 0x9c, 0xda, 0x92, 0xc5, 0x25, 0x93, 0xf7, 0xcc, 0x8b, 0xb6, 0xbe, 0x49,
-	0x5d, 0xc5, 0x11, 0x65, 0xbc, 0x96, 0xfc, 0x35, 0x21, 0x67, 0x7f, 0x02, 0xe6, 0x36, 0xb1, 0xc0,
-	0x91, 0x44, 0x18, 0x5e, 0xf1, 0x79, 0x14, 0xf5, 0x18, 0x55, 0xf7, 0x3d, 0x85, 0x77, 0x0b, 0xa0,
-	0x02, 0x96, 0x67, 0x1a, 0x1f, 0x3e, 0x39, 0x2c, 0x67, 0x9e, 0x1f, 0x96, 0x6f, 0x76, 0xa8, 0xea,
-	0xf6, 0xda, 0x55, 0x9f, 0x47, 0xb6, 0xaa, 0xfd, 0xb7, 0x2a, 0x83, 0x7b, 0x35, 0x75, 0x3f, 0x26,
-	0xb2, 0xda, 0x22, 0xfe, 0xb3, 0x83, 0x55, 0x68, 0x41, 0x5b, 0xc4, 0x77, 0xdf, 0x48, 0x4b, 0x7e,
-	0x86, 0x77, 0x51, 0x0c, 0xf3, 0x9a, 0xb6, 0xe6, 0x16, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x0e, 0x16,
-	0x41, 0xe1, 0x52, 0x82, 0xf4, 0xd1, 0xcb, 0x20, 0x15, 0x80, 0x8b, 0x74, 0xed, 0x4d, 0x5b, 0xda,
-	0x4d, 0x2a, 0x23, 0x01, 0x17, 0xda, 0x9c, 0xf5, 0xe4, 0x7f, 0x20, 0x27, 0x5e, 0x09, 0xe4, 0x7c,
-	0x52, 0xfc, 0x0c, 0xe6, 0x1d, 0xb8, 0xb0, 0x43, 0x55, 0x37, 0x10, 0x78, 0xc7, 0xc3, 0x41, 0x20,
-	0x3c, 0xc2, 0x70, 0x3b, 0x24, 0x41, 0x21, 0x5b, 0x01, 0xcb, 0xd3, 0xee, 0xfc, 0x20, 0xb9, 0x1e,
-	0x04, 0x62, 0xc3, 0xa4, 0xea, 0xb7, 0x1e, 0xed, 0x95, 0x33, 0x0f, 0x4f, 0xf6, 0x57, 0x2a, 0x23,
-	0xb8, 0xbb, 0xa7, 0xfb, 0xc8, 0xec, 0x93, 0xf3, 0x2b, 0x80, 0xc5, 0xcf, 0x71, 0x48, 0x03, 0xac,
-	0xb8, 0xf8, 0x84, 0x4a, 0xc5, 0x05, 0xf5, 0x71, 0x68, 0xc0, 0x25, 0xfa, 0x16, 0xc0, 0x6b, 0x7e,
-	0x2f, 0xea, 0x85, 0x58, 0xd1, 0x3e, 0xb1, 0x72, 0x3d, 0x81, 0x15, 0xe5, 0x05, 0x50, 0x99, 0x58,
-	0xbe, 0x7c, 0xe7, 0x86, 0xed, 0xd2, 0xaa, 0xf6, 0x6b, 0xd0, 0x6d, 0x5a, 0x50, 0x93, 0x53, 0xd6,
-	0xf8, 0x40, 0x5b, 0xf2, 0xf3, 0x9f, 0xe5, 0x77, 0x5e, 0xcc, 0x12, 0xfd, 0x8e, 0x7c, 0x7c, 0xb2,
-	0xbf, 0x02, 0xdc, 0x85, 0x21, 0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x36, 0x9c, 0x13, 0x64, 0x9b,
-	0x08, 0xc2, 0x7c, 0xe2, 0xf9, 0xbc, 0xc7, 0x54, 0xb2, 0xdf, 0x57, 0xdc, 0xd9, 0x34, 0xdc, 0xd4,
-	0x51, 0xe7, 0x27, 0x00, 0xaf, 0xa5, 0xc2, 0x9a, 0x3d, 0x21, 0x08, 0x53, 0x03, 0x55, 0x31, 0x9c,
-	0x32, 0x4a, 0xe4, 0x98, 0x45, 0x0c, 0x60, 0xd0, 0x22, 0xcc, 0xc5, 0x44, 0x50, 0x6e, 0xba, 0x33,
-	0xeb, 0xda, 0x95, 0xf3, 0x08, 0xc0, 0x52, 0xca, 0x72, 0xdd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51,
-	0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84, 0x7e, 0xba, 0x1a, 0x33, 0xdf, 0x11, 0x24, 0xe7, 0x3b,
-	0x00, 0xaf, 0xa7, 0xd4, 0xee, 0xf6, 0x94, 0x54, 0x98, 0x05, 0x94, 0x75, 0x5e, 0x9b, 0x89, 0xce,
-	0x0f, 0x00, 0xce, 0xa7, 0x8c, 0xb6, 0x42, 0x2c, 0xbb, 0x1b, 0x7d, 0xc2, 0x14, 0xba, 0x05, 0xdf,
-	0xec, 0x0f, 0xc2, 0x9e, 0xb5, 0x19, 0x24, 0x36, 0xcf, 0xa5, 0xf1, 0xcd, 0x24, 0x8c, 0xbe, 0x84,
-	0xd3, 0xdb, 0x02, 0xfb, 0xfa, 0x04, 0xd8, 0x39, 0xf1, 0x72, 0x13, 0x29, 0xad, 0xa6, 0xed, 0xca,
-	0x9f, 0x43, 0x4e, 0xa2, 0xaf, 0xe1, 0xe2, 0x90, 0x9d, 0xd4, 0x09, 0x8f, 0x24, 0x19, 0x6b, 0xdb,
-	0xbb, 0xd5, 0x0b, 0xc6, 0x76, 0xf5, 0x9c, 0x92, 0x8d, 0x19, 0x4d, 0xd9, 0x78, 0x93, 0xef, 0x9f,
-	0x03, 0x59, 0xcf, 0xea, 0xf3, 0xef, 0x3c, 0x00, 0x70, 0xea, 0x63, 0x42, 0x36, 0x39, 0x0f, 0xd1,
-	0x37, 0x70, 0x76, 0x38, 0x8e, 0x63, 0xce, 0xc3, 0x31, 0xef, 0xd9, 0x70, 0xf8, 0x6b, 0x78, 0xe7,
-	0xe1, 0x25, 0x58, 0x6c, 0x8e, 0x46, 0xb6, 0x62, 0xc2, 0x02, 0x33, 0xe9, 0x70, 0x88, 0xf2, 0x70,
-	0x52, 0x51, 0x15, 0x12, 0x73, 0x49, 0xb8, 0x66, 0x81, 0x2a, 0xf0, 0x72, 0x40, 0xa4, 0x2f, 0x68,
-	0x3c, 0xdc, 0x2e, 0x77, 0x34, 0x84, 0x6e, 0xc0, 0x19, 0x41, 0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x99,
-	0xc1, 0xee, 0x30, 0x80, 0xba, 0x30, 0x87, 0xa3, 0x64, 0x42, 0x64, 0x13, 0xad, 0x4b, 0xe7, 0x6a,
-	0x4d, 0x84, 0xbe, 0x6f, 0x85, 0x2e, 0xbf, 0x80, 0xd0, 0x11, 0x95, 0xb6, 0x7e, 0xfd, 0xf6, 0x83,
-	0xbd, 0x72, 0x46, 0x7b, 0xfe, 0xf7, 0x5e, 0x39, 0xf3, 0xcb, 0xc1, 0x6a, 0xd1, 0x02, 0x75, 0x78,
-	0x7f, 0x04, 0x87, 0x29, 0x4d, 0x13, 0x38, 0xcf, 0x01, 0x5c, 0x68, 0x91, 0x90, 0x74, 0x92, 0x6d,
-	0x53, 0x58, 0x28, 0xca, 0x3a, 0x9f, 0xb2, 0xed, 0x64, 0xb8, 0xc5, 0x82, 0xf4, 0x29, 0xd7, 0x57,
-	0xcc, 0x68, 0x1f, 0xcf, 0x0e, 0xc2, 0xb6, 0x8d, 0x5d, 0x38, 0x29, 0x15, 0xbe, 0x47, 0x5e, 0x49,
-	0x0f, 0x9b, 0x52, 0xa8, 0x05, 0x73, 0x5d, 0x42, 0x3b, 0x5d, 0xe3, 0x64, 0xb6, 0x71, 0xfb, 0x9f,
-	0xc3, 0xf2, 0x9c, 0x2f, 0x88, 0x1e, 0xbb, 0xcc, 0x33, 0xa9, 0x1f, 0x4f, 0xf6, 0x57, 0xce, 0xc6,
-	0xac, 0x15, 0x66, 0xe1, 0xfc, 0x01, 0xe0, 0x92, 0x15, 0x47, 0x39, 0x4b, 0x65, 0xda, 0xcb, 0x6c,
-	0x03, 0x5e, 0x1d, 0x9e, 0x05, 0x7d, 0x9b, 0x11, 0x29, 0xed, 0x97, 0x41, 0xe1, 0xd9, 0xc1, 0x6a,
-	0xde, 0xb2, 0x5a, 0x37, 0x99, 0x2d, 0x25, 0xf4, 0xbc, 0x19, 0x1e, 0x6e, 0x1b, 0x47, 0x0c, 0xe6,
-	0xd2, 0xbb, 0x7e, 0x9c, 0x5d, 0x6c, 0x51, 0xea, 0xd3, 0x76, 0x7f, 0x81, 0xf3, 0x1b, 0x80, 0x6f,
-	0xfd, 0x7f, 0x23, 0x7f, 0x41, 0x55, 0xb7, 0x45, 0x62, 0x2e, 0xa9, 0x1a, 0x53, 0x4f, 0x2f, 0x8e,
-	0xf4, 0xb4, 0x4e, 0xd9, 0x15, 0x2a, 0xc0, 0xa9, 0xc0, 0x00, 0x17, 0x26, 0x93, 0xc4, 0x60, 0x59,
-	0xbf, 0x39, 0xe0, 0x7e, 0x71, 0x5f, 0x36, 0xee, 0x3e, 0x3e, 0x2a, 0x81, 0x27, 0x47, 0x25, 0xf0,
-	0xf4, 0xa8, 0x04, 0xfe, 0x3a, 0x2a, 0x81, 0xef, 0x8f, 0x4b, 0x99, 0xa7, 0xc7, 0xa5, 0xcc, 0xef,
-	0xc7, 0xa5, 0xcc, 0x57, 0x6b, 0x17, 0x7a, 0x77, 0xe6, 0xd3, 0x22, 0xb1, 0xb2, 0x9d, 0x4b, 0xbe,
-	0x09, 0xdf, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x9e, 0xb6, 0x03, 0xc6, 0x0a, 0x00, 0x00,
+	// 1137 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0xf7, 0x10, 0x63, 0x60, 0x28, 0xd0, 0x0c, 0x06, 0x8c, 0x13, 0xd9, 0xd6, 0x4a, 0x4d, 0x09,
+	0x0d, 0x76, 0x21, 0xaa, 0x54, 0xa1, 0xb6, 0x12, 0x06, 0xa2, 0xf6, 0x14, 0xb4, 0xa0, 0xb6, 0xea,
+	0x65, 0x35, 0xde, 0x1d, 0xec, 0x51, 0x76, 0x77, 0xb6, 0x33, 0x63, 0xe3, 0x1c, 0x7a, 0x8f, 0x72,
+	0x68, 0xd3, 0x5b, 0xd4, 0x13, 0xea, 0x29, 0xea, 0x89, 0x03, 0x1f, 0x22, 0xea, 0x29, 0xca, 0xa1,
+	0xad, 0x50, 0x45, 0x2a, 0xa8, 0x44, 0xd5, 0x4f, 0x51, 0xcd, 0xce, 0xec, 0xda, 0x50, 0x8a, 0x22,
+	0x15, 0x94, 0x0b, 0x78, 0xde, 0xdb, 0x79, 0xbf, 0xf7, 0xe7, 0xf7, 0xe6, 0x3d, 0x58, 0x75, 0x99,
+	0x08, 0x98, 0xa8, 0x79, 0x54, 0x48, 0x4e, 0x1b, 0x6d, 0x49, 0x59, 0x58, 0xeb, 0x2c, 0x36, 0x88,
+	0xc4, 0x8b, 0xa7, 0x84, 0xd5, 0x88, 0x33, 0xc9, 0xd0, 0x0d, 0xfd, 0x7d, 0xf5, 0x94, 0xca, 0x7c,
+	0x5f, 0xcc, 0x37, 0x59, 0x93, 0xc5, 0xdf, 0xd5, 0xd4, 0x2f, 0x7d, 0xa5, 0x58, 0x32, 0x10, 0x0d,
+	0x2c, 0x48, 0x6a, 0xda, 0x65, 0xd4, 0x98, 0x2c, 0xce, 0x6a, 0xbd, 0xa3, 0x2f, 0x1a, 0xfb, 0x5a,
+	0x75, 0x1d, 0x07, 0x34, 0x64, 0xb5, 0xf8, 0xaf, 0x11, 0x95, 0x9b, 0x8c, 0x35, 0x7d, 0x52, 0x8b,
+	0x4f, 0x8d, 0xf6, 0x76, 0x4d, 0xd2, 0x80, 0x08, 0x89, 0x83, 0x48, 0x7f, 0x60, 0x1d, 0x64, 0x61,
+	0x6e, 0x03, 0x73, 0x1c, 0x08, 0x84, 0xe1, 0x98, 0xcb, 0x82, 0xa0, 0x1d, 0x52, 0xf9, 0xd0, 0x91,
+	0xb8, 0x5b, 0x00, 0x15, 0x30, 0x37, 0x52, 0xff, 0xe8, 0xf9, 0x61, 0x39, 0x73, 0x70, 0x58, 0xbe,
+	0xd5, 0xa4, 0xb2, 0xd5, 0x6e, 0x54, 0x5d, 0x16, 0x18, 0x58, 0xf3, 0x6f, 0x41, 0x78, 0x0f, 0x6a,
+	0xf2, 0x61, 0x44, 0x44, 0x75, 0x8d, 0xb8, 0x2f, 0xf7, 0x17, 0xa0, 0xf1, 0x6a, 0x8d, 0xb8, 0xf6,
+	0x5b, 0xa9, 0xc9, 0x2d, 0xdc, 0x45, 0x11, 0xcc, 0xab, 0xb8, 0x94, 0xf3, 0x11, 0x13, 0x84, 0x3b,
+	0x9c, 0xec, 0x60, 0xee, 0x15, 0x06, 0x62, 0xa4, 0x4f, 0xfe, 0x0f, 0x52, 0x01, 0xd8, 0x48, 0xd9,
+	0xde, 0x30, 0xa6, 0xed, 0xd8, 0x32, 0xe2, 0x70, 0xaa, 0xc1, 0xc2, 0xb6, 0xf8, 0x17, 0xe4, 0xb5,
+	0x4b, 0x81, 0x9c, 0x8c, 0x8d, 0x9f, 0xc1, 0x5c, 0x82, 0x53, 0x3b, 0x54, 0xb6, 0x3c, 0x8e, 0x77,
+	0x1c, 0xec, 0x79, 0xdc, 0x21, 0x21, 0x6e, 0xf8, 0xc4, 0x2b, 0x64, 0x2b, 0x60, 0x6e, 0xd8, 0x9e,
+	0x4c, 0x94, 0x2b, 0x9e, 0xc7, 0xd7, 0xb5, 0x0a, 0x09, 0x38, 0x13, 0xe0, 0xae, 0xd3, 0xc1, 0x3e,
+	0xf5, 0xb0, 0x64, 0xdc, 0xd9, 0x26, 0xc4, 0x11, 0x2d, 0xcc, 0x49, 0x61, 0xf0, 0x12, 0xca, 0x90,
+	0x0f, 0x70, 0xf7, 0xf3, 0xc4, 0xf6, 0x3d, 0x42, 0x36, 0x95, 0x65, 0x74, 0x17, 0x4e, 0x2b, 0xd0,
+	0xc4, 0x1f, 0xec, 0x0b, 0x27, 0x22, 0xdc, 0x91, 0xdd, 0x42, 0xae, 0x02, 0xe6, 0xc6, 0xec, 0xc9,
+	0x00, 0x77, 0xbf, 0xe8, 0x29, 0x37, 0x08, 0xdf, 0xea, 0x2e, 0xdf, 0x7e, 0xba, 0x5b, 0xce, 0x3c,
+	0x3e, 0xd9, 0x9b, 0xaf, 0xf4, 0xe1, 0x76, 0x4f, 0xb7, 0x84, 0x66, 0x94, 0xf5, 0x0b, 0x80, 0xc5,
+	0x14, 0xf5, 0x53, 0x2a, 0x24, 0xe3, 0xd4, 0xc5, 0xbe, 0x4e, 0x93, 0x40, 0xdf, 0x02, 0x38, 0xe3,
+	0xb6, 0x83, 0xb6, 0x8f, 0x25, 0xed, 0x10, 0x53, 0x18, 0x87, 0x63, 0x49, 0x59, 0x01, 0x54, 0xae,
+	0xcd, 0x8d, 0x2e, 0xdd, 0x34, 0x0d, 0x57, 0x55, 0x95, 0x4d, 0x1a, 0x47, 0x05, 0xb4, 0xca, 0x68,
+	0x58, 0xff, 0x50, 0xa5, 0xe4, 0xa7, 0x57, 0xe5, 0xf7, 0x5e, 0x2f, 0x25, 0xea, 0x8e, 0x78, 0x76,
+	0xb2, 0x37, 0x0f, 0xec, 0xa9, 0x1e, 0xac, 0x76, 0xc6, 0x56, 0xa0, 0xe8, 0x5d, 0x38, 0xc1, 0xc9,
+	0x36, 0xe1, 0x24, 0x74, 0x89, 0xe3, 0xb2, 0x76, 0x28, 0x63, 0x66, 0x8e, 0xd9, 0xe3, 0xa9, 0x78,
+	0x55, 0x49, 0xad, 0xef, 0x07, 0xe0, 0x4c, 0x1a, 0xd8, 0x6a, 0x9b, 0x73, 0x12, 0xca, 0x24, 0xaa,
+	0x08, 0x0e, 0xe9, 0x48, 0xc4, 0x15, 0x07, 0x91, 0xc0, 0xa0, 0x69, 0x98, 0x8b, 0x08, 0xa7, 0x4c,
+	0xf7, 0x51, 0xd6, 0x36, 0x27, 0xe4, 0xc3, 0xc9, 0xf3, 0xf8, 0x74, 0xed, 0x12, 0xf8, 0x74, 0xbd,
+	0x73, 0x96, 0x4c, 0xd6, 0x53, 0x00, 0x4b, 0x69, 0x4e, 0x56, 0x5c, 0x93, 0x61, 0xe2, 0xad, 0xb2,
+	0x20, 0xa0, 0x42, 0x50, 0x16, 0xa2, 0x0e, 0x84, 0x6e, 0x7a, 0xba, 0xe2, 0xec, 0xf4, 0x21, 0x59,
+	0xdf, 0x01, 0x78, 0x23, 0x75, 0xed, 0x7e, 0x5b, 0x0a, 0x89, 0x43, 0x8f, 0x86, 0xcd, 0x37, 0x56,
+	0x32, 0xeb, 0x07, 0x00, 0x27, 0x53, 0x8f, 0x36, 0x7d, 0x2c, 0x5a, 0xeb, 0x1d, 0x12, 0x4a, 0x74,
+	0x1b, 0xbe, 0xdd, 0x2b, 0x99, 0x29, 0x2a, 0x88, 0x8b, 0x3a, 0x91, 0xca, 0x37, 0x74, 0x75, 0xbf,
+	0x84, 0xc3, 0xdb, 0x1c, 0xbb, 0xaa, 0xdf, 0x0a, 0x03, 0x97, 0x50, 0xd2, 0xd4, 0x9a, 0xf5, 0x27,
+	0x80, 0x28, 0x6d, 0x0b, 0xb2, 0x19, 0xe2, 0x48, 0xb4, 0x98, 0x44, 0x1f, 0xc3, 0xac, 0x9a, 0x1e,
+	0xb1, 0x3f, 0xa3, 0x4b, 0xc5, 0xaa, 0x1e, 0x2d, 0xd5, 0x64, 0xb4, 0x54, 0xb7, 0x92, 0xd1, 0x52,
+	0x1f, 0x53, 0x8e, 0x3c, 0x79, 0x55, 0x06, 0x3a, 0xea, 0xf8, 0xda, 0x85, 0xdd, 0x3e, 0xf0, 0x06,
+	0xba, 0x5d, 0xb1, 0x22, 0x7f, 0x4e, 0x0d, 0x04, 0xfa, 0x1a, 0x4e, 0xf7, 0x8a, 0x20, 0x94, 0xc2,
+	0x21, 0xb1, 0xc6, 0xb0, 0xe3, 0xfd, 0xea, 0x05, 0x63, 0xbd, 0x7a, 0x8e, 0xc9, 0xfa, 0x88, 0xf2,
+	0x5d, 0x3b, 0x93, 0xef, 0x9c, 0x03, 0xb9, 0x9c, 0x55, 0x8f, 0xaa, 0xf5, 0x08, 0xc0, 0xa1, 0x7b,
+	0x84, 0x6c, 0x30, 0xe6, 0xa3, 0x6f, 0xe0, 0x78, 0x6f, 0x1a, 0x47, 0x8c, 0xf9, 0x57, 0x4c, 0xcd,
+	0xde, 0xec, 0x57, 0xf0, 0xd6, 0xe3, 0x01, 0x58, 0x5c, 0xed, 0x97, 0x6c, 0x46, 0x24, 0xf4, 0xf4,
+	0xa0, 0xc3, 0x3e, 0xca, 0xc3, 0x41, 0x49, 0xa5, 0xaf, 0xc9, 0x30, 0x62, 0xeb, 0x03, 0xaa, 0xc0,
+	0x51, 0x8f, 0x08, 0x97, 0xd3, 0xa8, 0xc7, 0x4a, 0xbb, 0x5f, 0x84, 0x6e, 0xc2, 0x11, 0x4e, 0x5c,
+	0x1a, 0x51, 0x12, 0x4a, 0xfd, 0x10, 0xd9, 0x3d, 0x01, 0x6a, 0xc1, 0x1c, 0x0e, 0xe2, 0x67, 0x37,
+	0x1b, 0xc7, 0x3a, 0x7b, 0x6e, 0xac, 0x71, 0xa0, 0x1f, 0x98, 0x40, 0xe7, 0x5e, 0x23, 0xd0, 0xbe,
+	0x28, 0x8d, 0xfd, 0xe5, 0x3b, 0x8f, 0x76, 0xcb, 0x19, 0x95, 0xf3, 0xbf, 0x76, 0xcb, 0x99, 0x9f,
+	0xf7, 0x17, 0x8a, 0x06, 0xa8, 0xc9, 0x3a, 0x7d, 0x38, 0xa1, 0x54, 0x6e, 0x02, 0xeb, 0x00, 0xc0,
+	0xa9, 0x35, 0xe2, 0x93, 0x66, 0x5c, 0x36, 0x89, 0xb9, 0xa4, 0x61, 0xf3, 0xb3, 0x70, 0x3b, 0x9e,
+	0x18, 0x11, 0x27, 0x1d, 0xca, 0xda, 0xe2, 0x74, 0xbb, 0x8e, 0x27, 0x62, 0xd3, 0xad, 0x36, 0x1c,
+	0x14, 0x12, 0x3f, 0x20, 0x97, 0xd2, 0xaa, 0xda, 0x14, 0x5a, 0x83, 0xb9, 0x16, 0xa1, 0xcd, 0x96,
+	0xce, 0x64, 0xb6, 0x7e, 0xe7, 0xef, 0xc3, 0xf2, 0x84, 0xcb, 0x89, 0x62, 0x77, 0xe8, 0x68, 0xd5,
+	0x8f, 0x27, 0x7b, 0xf3, 0x67, 0x65, 0x26, 0x15, 0xfa, 0x60, 0xfd, 0x0e, 0xe0, 0xac, 0x09, 0x8e,
+	0xb2, 0x30, 0x0d, 0xd3, 0xec, 0x32, 0xeb, 0xb0, 0xf7, 0xd4, 0xc7, 0xcb, 0x0c, 0x11, 0xc2, 0x2c,
+	0x86, 0x85, 0x97, 0xfb, 0x0b, 0x79, 0xe3, 0xd5, 0x8a, 0xd6, 0x6c, 0x4a, 0xae, 0x9e, 0xd5, 0xde,
+	0x1b, 0x66, 0xe4, 0x28, 0x84, 0xb9, 0x74, 0xd5, 0xbb, 0x4a, 0x16, 0x1b, 0x94, 0xe5, 0x61, 0x53,
+	0x5f, 0x60, 0xfd, 0x0a, 0xe0, 0x3b, 0xff, 0x4d, 0x64, 0xb5, 0xdd, 0xac, 0x91, 0x88, 0x09, 0x2a,
+	0xaf, 0x88, 0xd3, 0xd3, 0x7d, 0x9c, 0x56, 0x2a, 0x73, 0x42, 0x05, 0x38, 0xe4, 0x69, 0x60, 0xbd,
+	0xe0, 0xd9, 0xc9, 0x71, 0xf9, 0x56, 0xe2, 0xfb, 0xc5, 0xbc, 0xac, 0xdf, 0x7f, 0x76, 0x54, 0x02,
+	0xcf, 0x8f, 0x4a, 0xe0, 0xc5, 0x51, 0x09, 0xfc, 0x71, 0x54, 0x02, 0x4f, 0x8e, 0x4b, 0x99, 0x17,
+	0xc7, 0xa5, 0xcc, 0x6f, 0xc7, 0xa5, 0xcc, 0x57, 0x8b, 0x17, 0xe6, 0xee, 0xcc, 0xbe, 0x16, 0xa7,
+	0xb2, 0x91, 0x8b, 0x9f, 0xf2, 0xbb, 0xff, 0x0c, 0x00, 0x08, 0x2f, 0x66, 0x2f, 0xe6, 0x0c, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -735,6 +824,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.MaxValidatorFeeShare.Equal(that1.MaxValidatorFeeShare) {
+		return false
+	}
+	if this.MaxWithdrawalsPerTx != that1.MaxWithdrawalsPerTx {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -799,6 +894,9 @@ func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
 	if this.Period != that1.Period {
 		return false
 	}
+	if !this.ValidatorFeeShare.Equal(that1.ValidatorFeeShare) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
@@ -886,6 +984,38 @@ func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RewardRateSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardRateSnapshot)
+	if !ok {
+		that2, ok := that.(RewardRateSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
+		return false
+	}
+	for i := range this.CumulativeRewardRatio {
+		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1062,6 +1192,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxWithdrawalsPerTx != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxWithdrawalsPerTx))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxValidatorFeeShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1167,6 +1312,16 @@ func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorFeeShare.Size()
+		i -= size
+		if _, err := m.ValidatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Period != 0 {
 		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
 		i--
@@ -1301,6 +1456,51 @@ func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorSlashEvents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1604,6 +1804,11 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.MaxValidatorFeeShare.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.MaxWithdrawalsPerTx != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxWithdrawalsPerTx))
+	}
 	return n
 }
 
@@ -1640,6 +1845,8 @@ func (m *ValidatorCurrentRewards) Size() (n int) {
 	if m.Period != 0 {
 		n += 1 + sovDistribution(uint64(m.Period))
 	}
+	l = m.ValidatorFeeShare.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1687,6 +1894,23 @@ func (m *ValidatorSlashEvent) Size() (n int) {
 	return n
 }
 
+func (m *RewardRateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorSlashEvents) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1966,6 +2190,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawalsPerTx", wireType)
+			}
+			m.MaxWithdrawalsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawalsPerTx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2172,6 +2449,40 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2464,6 +2775,123 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
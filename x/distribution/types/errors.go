@@ -18,4 +18,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrFeeShareTooHigh         = sdkerrors.Register(ModuleName, 14, "validator fee share exceeds the maximum fee share")
+	ErrNoSlashEvent            = sdkerrors.Register(ModuleName, 15, "no slash event at height")
+	ErrNoRewardHistory         = sdkerrors.Register(ModuleName, 16, "not enough reward history")
 )
@@ -8,8 +8,13 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeFeeShare           = "fee_share"
+	EventTypeSetFeeShare        = "set_fee_share"
+	EventTypeSlashRefunded      = "slash_refunded"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyFeeShare        = "fee_share"
+	AttributeKeySlashHeight     = "slash_height"
 )
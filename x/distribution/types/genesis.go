@@ -9,6 +9,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	slashStakes []DelegatorSlashStakeRecord, snapshots []RewardRateSnapshotRecord, refundableSlashes []RefundableSlashRecord,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +22,9 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorSlashStakes:            slashStakes,
+		RewardRateSnapshots:             snapshots,
+		RefundableSlashes:               refundableSlashes,
 	}
 }
 
@@ -37,6 +41,9 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorSlashStakes:            []DelegatorSlashStakeRecord{},
+		RewardRateSnapshots:             []RewardRateSnapshotRecord{},
+		RefundableSlashes:               []RefundableSlashRecord{},
 	}
 }
 
@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -348,6 +352,15 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// delegator_slash_stakes defines the stakes of the delegators at the slash
+	// events of their validators at genesis.
+	DelegatorSlashStakes []DelegatorSlashStakeRecord `protobuf:"bytes,11,rep,name=delegator_slash_stakes,json=delegatorSlashStakes,proto3" json:"delegator_slash_stakes"`
+	// reward_rate_snapshots defines the reward rate snapshots, used to compute
+	// the historical APR, at genesis.
+	RewardRateSnapshots []RewardRateSnapshotRecord `protobuf:"bytes,12,rep,name=reward_rate_snapshots,json=rewardRateSnapshots,proto3" json:"reward_rate_snapshots"`
+	// refundable_slashes defines the slash events of the validators that can
+	// still be refunded to their delegators at genesis.
+	RefundableSlashes []RefundableSlashRecord `protobuf:"bytes,13,rep,name=refundable_slashes,json=refundableSlashes,proto3" json:"refundable_slashes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -383,6 +396,139 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// DelegatorSlashStakeRecord is the stake of a delegator of a validator at the
+// slash event of the validator at the given height, used for import / export
+// via genesis json.
+type DelegatorSlashStakeRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height defines the block height at which the slash event occurred.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// stake is the stake of the delegator before the slash.
+	Stake github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=stake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stake"`
+}
+
+func (m *DelegatorSlashStakeRecord) Reset()         { *m = DelegatorSlashStakeRecord{} }
+func (m *DelegatorSlashStakeRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorSlashStakeRecord) ProtoMessage()    {}
+func (*DelegatorSlashStakeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{8}
+}
+func (m *DelegatorSlashStakeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorSlashStakeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorSlashStakeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorSlashStakeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorSlashStakeRecord.Merge(m, src)
+}
+func (m *DelegatorSlashStakeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorSlashStakeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorSlashStakeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorSlashStakeRecord proto.InternalMessageInfo
+
+// RewardRateSnapshotRecord is the reward rate snapshot at the given height,
+// used for import / export via genesis json.
+type RewardRateSnapshotRecord struct {
+	// height is the block height of the snapshot.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// snapshot is the reward rate snapshot.
+	Snapshot RewardRateSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *RewardRateSnapshotRecord) Reset()         { *m = RewardRateSnapshotRecord{} }
+func (m *RewardRateSnapshotRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardRateSnapshotRecord) ProtoMessage()    {}
+func (*RewardRateSnapshotRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{9}
+}
+func (m *RewardRateSnapshotRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardRateSnapshotRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardRateSnapshotRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardRateSnapshotRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardRateSnapshotRecord.Merge(m, src)
+}
+func (m *RewardRateSnapshotRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardRateSnapshotRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardRateSnapshotRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardRateSnapshotRecord proto.InternalMessageInfo
+
+// RefundableSlashRecord is a slash event of a validator that can still be
+// refunded to its delegators, used for import / export via genesis json.
+type RefundableSlashRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height defines the block height at which the slash event occurred.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time defines the block time at which the slash event occurred.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *RefundableSlashRecord) Reset()         { *m = RefundableSlashRecord{} }
+func (m *RefundableSlashRecord) String() string { return proto.CompactTextString(m) }
+func (*RefundableSlashRecord) ProtoMessage()    {}
+func (*RefundableSlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{10}
+}
+func (m *RefundableSlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundableSlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundableSlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundableSlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundableSlashRecord.Merge(m, src)
+}
+func (m *RefundableSlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundableSlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundableSlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundableSlashRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
@@ -392,6 +538,9 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
+	proto.RegisterType((*DelegatorSlashStakeRecord)(nil), "cosmos.distribution.v1beta1.DelegatorSlashStakeRecord")
+	proto.RegisterType((*RewardRateSnapshotRecord)(nil), "cosmos.distribution.v1beta1.RewardRateSnapshotRecord")
+	proto.RegisterType((*RefundableSlashRecord)(nil), "cosmos.distribution.v1beta1.RefundableSlashRecord")
 }
 
 func init() {
@@ -399,65 +548,79 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x25, 0x4d, 0xc6, 0x45, 0xb4, 0xdb, 0x34, 0x6c, 0xd2, 0xb2, 0x4e, 0x4b, 0x0f,
-	0x05, 0xd4, 0x35, 0x31, 0x08, 0xaa, 0x22, 0x90, 0x62, 0xb7, 0xe5, 0xe3, 0xd2, 0xc8, 0x96, 0x40,
-	0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x5e, 0x8f, 0xb0, 0x77, 0xac, 0x99, 0xf1, 0x1a, 0x90, 0x38, 0x70,
-	0x02, 0x21, 0x90, 0x38, 0xc2, 0xad, 0xc7, 0x0a, 0x09, 0x89, 0x03, 0x7f, 0x44, 0x25, 0x2e, 0x15,
-	0x27, 0x4e, 0x7c, 0x38, 0x07, 0xe0, 0x9f, 0x40, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xda, 0xed, 0xd6,
-	0x69, 0x93, 0x4b, 0xe2, 0x9d, 0x79, 0x1f, 0xbf, 0xdf, 0x7b, 0x3f, 0xbd, 0x37, 0xf0, 0x39, 0x8f,
-	0xf2, 0x31, 0xe5, 0x0d, 0x9f, 0x70, 0xc1, 0x48, 0x7f, 0x2a, 0x08, 0x0d, 0x1b, 0xd1, 0x6e, 0x1f,
-	0x0b, 0xb4, 0xdb, 0x08, 0x70, 0x88, 0x39, 0xe1, 0xee, 0x84, 0x51, 0x41, 0xad, 0xf3, 0xca, 0xd4,
-	0x35, 0x4d, 0x5d, 0x6d, 0xba, 0xbd, 0x11, 0xd0, 0x80, 0x4a, 0xbb, 0x46, 0xfc, 0x4b, 0xb9, 0x6c,
-	0x3b, 0x3a, 0x7a, 0x1f, 0x71, 0x9c, 0x46, 0xf5, 0x28, 0x09, 0xf5, 0xbd, 0x5b, 0x96, 0x7d, 0x21,
-	0x8f, 0xb2, 0xdf, 0x52, 0xf6, 0x3d, 0x95, 0x48, 0xe3, 0x51, 0x57, 0x67, 0xd0, 0x98, 0x84, 0xb4,
-	0x21, 0xff, 0xaa, 0xa3, 0x4b, 0x3f, 0x02, 0x78, 0xee, 0x06, 0x1e, 0xe1, 0x00, 0x09, 0xca, 0xde,
-	0x23, 0x62, 0xe8, 0x33, 0x34, 0x7b, 0x3b, 0x1c, 0x50, 0xeb, 0x26, 0x3c, 0xe3, 0x27, 0x17, 0x3d,
-	0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x83, 0x1d, 0x70, 0x65, 0xbd, 0x65, 0xff, 0xfa, 0xf3, 0xd5, 0x0d,
-	0x1d, 0x79, 0x4f, 0xdd, 0x74, 0x05, 0x23, 0x61, 0xd0, 0x39, 0x9d, 0xba, 0xe8, 0x73, 0xab, 0x0d,
-	0x4f, 0xcf, 0x74, 0xd8, 0x34, 0x4a, 0xf5, 0x21, 0x51, 0x9e, 0x4a, 0x3c, 0xf4, 0xf1, 0xf5, 0xb5,
-	0x2f, 0xef, 0xd4, 0x2b, 0xff, 0xdc, 0xa9, 0x57, 0x2e, 0xfd, 0x07, 0xe0, 0xc5, 0x77, 0xd1, 0x88,
-	0xf8, 0x71, 0x8e, 0xdb, 0x53, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0xcf, 0x10, 0xf3, 0x79, 0x07,
-	0x7b, 0x94, 0xf9, 0x31, 0xf6, 0x28, 0x31, 0x5a, 0x1e, 0x7b, 0xea, 0x92, 0x60, 0xff, 0x02, 0xc0,
-	0xb3, 0x34, 0xcb, 0xd1, 0x63, 0x2a, 0x89, 0x5d, 0xdd, 0x59, 0xb9, 0x52, 0x6b, 0x5e, 0xd0, 0x9d,
-	0x71, 0xe3, 0xce, 0x25, 0x4d, 0x76, 0x6f, 0x60, 0xaf, 0x4d, 0x49, 0xd8, 0xba, 0x76, 0xef, 0xf7,
-	0x7a, 0xe5, 0x87, 0x3f, 0xea, 0x2f, 0x04, 0x44, 0x0c, 0xa7, 0x7d, 0xd7, 0xa3, 0x63, 0xdd, 0x0c,
-	0xfd, 0xef, 0x2a, 0xf7, 0x3f, 0x6a, 0x88, 0x4f, 0x26, 0x98, 0x27, 0x3e, 0xfc, 0xee, 0xdf, 0x3f,
-	0x3d, 0x0f, 0x3a, 0x16, 0xcd, 0xd1, 0x32, 0x0a, 0xf0, 0x17, 0x80, 0x97, 0xd3, 0x02, 0xec, 0x79,
-	0xde, 0x74, 0x3c, 0x1d, 0x21, 0x81, 0xfd, 0x36, 0x1d, 0x8f, 0x09, 0xe7, 0x84, 0x86, 0x47, 0x5b,
-	0x83, 0x21, 0xac, 0xa1, 0x2c, 0x8b, 0x6c, 0x5d, 0xad, 0xf9, 0x9a, 0x5b, 0xa2, 0x73, 0xb7, 0x1c,
-	0x5e, 0x6b, 0x3d, 0xae, 0x8c, 0xa2, 0x6a, 0x86, 0x36, 0x38, 0xfe, 0x0b, 0xe0, 0x4e, 0x1a, 0xe4,
-	0x2d, 0xc2, 0x05, 0x65, 0xc4, 0x43, 0xa3, 0x63, 0xe9, 0xf1, 0x26, 0x5c, 0x9d, 0x60, 0x46, 0xa8,
-	0xa2, 0x76, 0xa2, 0xa3, 0xbf, 0xac, 0x0f, 0xe1, 0xc9, 0xa4, 0xdd, 0x2b, 0x92, 0xf3, 0xab, 0xcb,
-	0x71, 0xce, 0xc1, 0x35, 0xf9, 0x26, 0x21, 0x0d, 0xae, 0xbf, 0x00, 0xf8, 0x4c, 0xea, 0xdc, 0x9e,
-	0x32, 0x86, 0x43, 0x71, 0x2c, 0x44, 0xdf, 0xcf, 0x08, 0xa9, 0x26, 0xbe, 0xbc, 0x1c, 0xa1, 0x45,
-	0x4c, 0x0f, 0x61, 0xf3, 0x7d, 0x15, 0x9e, 0x4f, 0xc7, 0x49, 0x57, 0x20, 0x26, 0x48, 0x18, 0xc4,
-	0xe3, 0x24, 0xe3, 0x72, 0x14, 0x43, 0xa5, 0xb0, 0x24, 0xd5, 0x43, 0x97, 0xa4, 0x0f, 0x9f, 0xe4,
-	0x1a, 0x63, 0x8f, 0x84, 0x03, 0xaa, 0x3b, 0xdd, 0x2c, 0x2d, 0x4c, 0x21, 0x3d, 0xb3, 0x2c, 0xa7,
-	0xb8, 0x71, 0x61, 0xd4, 0xe6, 0x9b, 0x2a, 0xdc, 0x4a, 0xab, 0xda, 0x1d, 0x21, 0x3e, 0xbc, 0x19,
-	0xc9, 0xc2, 0x1e, 0xb1, 0x9c, 0x87, 0x98, 0x04, 0x43, 0x91, 0xc8, 0x59, 0x7d, 0x19, 0x32, 0x5f,
-	0x59, 0x90, 0x39, 0x85, 0xe7, 0xb2, 0xb4, 0x3c, 0x06, 0xd5, 0xc3, 0x31, 0x2a, 0xfb, 0x84, 0x2c,
-	0xc5, 0x8b, 0xcb, 0x69, 0x24, 0x63, 0x63, 0x16, 0xe2, 0x6c, 0x94, 0xbf, 0x37, 0xea, 0xf1, 0xf5,
-	0x3a, 0x3c, 0xf5, 0xa6, 0xda, 0x9e, 0x5d, 0x81, 0x04, 0xb6, 0x6e, 0xc1, 0xd5, 0x09, 0x62, 0x68,
-	0xac, 0x78, 0xd7, 0x9a, 0xcf, 0x96, 0x26, 0xdf, 0x97, 0xa6, 0x66, 0x3e, 0xed, 0x6d, 0xbd, 0x03,
-	0xd7, 0x06, 0x18, 0xf7, 0x26, 0x94, 0x8e, 0xb4, 0xd4, 0x2f, 0x97, 0x46, 0xba, 0x85, 0xf1, 0x3e,
-	0xa5, 0xa3, 0x05, 0x69, 0x0f, 0xd4, 0x99, 0x35, 0x83, 0x76, 0x26, 0xd8, 0x74, 0x91, 0xc5, 0x62,
-	0x89, 0xe7, 0xc2, 0xca, 0xf2, 0x6a, 0x31, 0x77, 0xab, 0x99, 0x69, 0xd3, 0x2f, 0xb2, 0x90, 0x12,
-	0x9f, 0x30, 0x1c, 0x11, 0x3a, 0x95, 0xab, 0x7c, 0x42, 0x39, 0x66, 0xb2, 0x29, 0xa5, 0x7a, 0x48,
-	0x5c, 0xf6, 0xb5, 0x87, 0xf5, 0x69, 0xf1, 0x06, 0x7b, 0x42, 0x42, 0x7f, 0x63, 0xb9, 0xee, 0x3e,
-	0x68, 0xcd, 0x9a, 0x34, 0x0a, 0x96, 0x96, 0xf5, 0x1d, 0x80, 0x17, 0x0d, 0x4d, 0x67, 0xa3, 0xbe,
-	0xe7, 0xa5, 0xdb, 0x80, 0xdb, 0xab, 0x12, 0xca, 0xde, 0x63, 0x6c, 0x94, 0x3c, 0x9a, 0x7a, 0x54,
-	0xea, 0xc0, 0xad, 0xaf, 0x00, 0xbc, 0x90, 0x41, 0x1b, 0xa6, 0x33, 0x3b, 0x2d, 0xd0, 0x49, 0x89,
-	0xea, 0xf5, 0x47, 0x9c, 0xf9, 0x79, 0x44, 0xdb, 0xd1, 0x03, 0x8d, 0xad, 0xcf, 0x01, 0xdc, 0xca,
-	0xc0, 0x78, 0x6a, 0xde, 0xa6, 0x48, 0xd6, 0x24, 0x92, 0xeb, 0x8f, 0x32, 0xac, 0xf3, 0x30, 0x9e,
-	0x8e, 0x8a, 0x2d, 0xad, 0xcf, 0x4c, 0x9d, 0x2f, 0x0c, 0x45, 0x6e, 0xaf, 0x4b, 0x04, 0xd7, 0x0e,
-	0x3f, 0x15, 0xf3, 0xf9, 0x33, 0xb5, 0x9b, 0x76, 0xdc, 0x9a, 0xc1, 0xcd, 0xc2, 0x31, 0xc4, 0x6d,
-	0x28, 0x93, 0xbf, 0x72, 0xd8, 0x39, 0x94, 0x4f, 0xbd, 0x51, 0x30, 0x8d, 0x8c, 0xd5, 0xd5, 0xba,
-	0x7d, 0x77, 0xee, 0x80, 0x7b, 0x73, 0x07, 0xdc, 0x9f, 0x3b, 0xe0, 0xcf, 0xb9, 0x03, 0xbe, 0x3d,
-	0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x83, 0xdd, 0xd2, 0x67, 0xdc, 0xc7,
-	0x8b, 0xaf, 0x73, 0xf9, 0xaa, 0xeb, 0xaf, 0xca, 0x17, 0xf6, 0x4b, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x88, 0x91, 0xf2, 0x3f, 0x0c, 0x00, 0x00,
+	// 1148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x38, 0x69, 0x9a, 0x8c, 0x13, 0xd1, 0x6c, 0x3e, 0xd8, 0xa4, 0xc5, 0x4e, 0x4b, 0x85,
+	0x0a, 0x28, 0x6b, 0x12, 0xbe, 0xaa, 0xa2, 0x22, 0xe5, 0xa3, 0xe5, 0xe3, 0xd2, 0xc8, 0x46, 0xad,
+	0x40, 0x48, 0xd6, 0x78, 0x77, 0xbc, 0x1e, 0x75, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0x40, 0xe2, 0xc0,
+	0x09, 0x84, 0x84, 0xd4, 0x03, 0x07, 0xb8, 0xf5, 0xc0, 0xa1, 0x42, 0x42, 0xe2, 0xd0, 0x3b, 0xd7,
+	0x4a, 0x5c, 0xaa, 0x9e, 0x10, 0x87, 0x16, 0x92, 0x03, 0xf0, 0x4f, 0x20, 0xb4, 0x33, 0xb3, 0xbb,
+	0x63, 0xed, 0xc6, 0x71, 0x4a, 0x72, 0x69, 0xb3, 0x3b, 0xef, 0xbd, 0xdf, 0xef, 0xf7, 0xde, 0xf3,
+	0x7b, 0x3b, 0xf0, 0x45, 0x97, 0xf2, 0x0e, 0xe5, 0x55, 0x8f, 0x70, 0xc1, 0x48, 0xb3, 0x27, 0x08,
+	0x0d, 0xab, 0xfd, 0xb5, 0x26, 0x16, 0x68, 0xad, 0xea, 0xe3, 0x10, 0x73, 0xc2, 0x9d, 0x2e, 0xa3,
+	0x82, 0x5a, 0x67, 0x95, 0xa9, 0x63, 0x9a, 0x3a, 0xda, 0x74, 0x79, 0xde, 0xa7, 0x3e, 0x95, 0x76,
+	0xd5, 0xe8, 0x2f, 0xe5, 0xb2, 0x5c, 0xd6, 0xd1, 0x9b, 0x88, 0xe3, 0x24, 0xaa, 0x4b, 0x49, 0xa8,
+	0xcf, 0x9d, 0x61, 0xe8, 0x03, 0x38, 0xca, 0x7e, 0x49, 0xd9, 0x37, 0x14, 0x90, 0xe6, 0xa3, 0x8e,
+	0x66, 0x51, 0x87, 0x84, 0xb4, 0x2a, 0xff, 0xd5, 0xaf, 0x2a, 0x3e, 0xa5, 0x7e, 0x80, 0xab, 0xf2,
+	0xa9, 0xd9, 0x6b, 0x55, 0x05, 0xe9, 0x60, 0x2e, 0x50, 0xa7, 0xab, 0x0c, 0x2e, 0xfc, 0x04, 0xe0,
+	0xc2, 0x36, 0x0e, 0xb0, 0x8f, 0x04, 0x65, 0xb7, 0x88, 0x68, 0x7b, 0x0c, 0xed, 0xbe, 0x17, 0xb6,
+	0xa8, 0x75, 0x0d, 0xce, 0x7a, 0xf1, 0x41, 0x03, 0x79, 0x1e, 0xc3, 0x9c, 0xdb, 0x60, 0x05, 0x5c,
+	0x9a, 0xda, 0xb4, 0x1f, 0xdd, 0x5f, 0x9d, 0xd7, 0xd0, 0x1b, 0xea, 0xa4, 0x2e, 0x18, 0x09, 0xfd,
+	0xda, 0x99, 0xc4, 0x45, 0xbf, 0xb7, 0xb6, 0xe0, 0x99, 0x5d, 0x1d, 0x36, 0x89, 0x52, 0x3c, 0x24,
+	0xca, 0x33, 0xb1, 0x87, 0x7e, 0x7d, 0x65, 0xf2, 0xab, 0xbb, 0x95, 0xc2, 0xdf, 0x77, 0x2b, 0x85,
+	0x0b, 0xff, 0x02, 0x78, 0xfe, 0x26, 0x0a, 0x88, 0x17, 0x61, 0xdc, 0xe8, 0x09, 0x2e, 0x50, 0xe8,
+	0x45, 0x3e, 0x78, 0x17, 0x31, 0x8f, 0xd7, 0xb0, 0x4b, 0x99, 0x17, 0x71, 0xef, 0xc7, 0x46, 0xa3,
+	0x73, 0x4f, 0x5c, 0x62, 0xee, 0x5f, 0x02, 0x38, 0x47, 0x53, 0x8c, 0x06, 0x53, 0x20, 0x76, 0x71,
+	0x65, 0xec, 0x52, 0x69, 0xfd, 0x9c, 0x2e, 0x9d, 0x13, 0x95, 0x36, 0xee, 0x02, 0x67, 0x1b, 0xbb,
+	0x5b, 0x94, 0x84, 0x9b, 0x97, 0x1f, 0x3c, 0xae, 0x14, 0x7e, 0x7c, 0x52, 0x79, 0xd9, 0x27, 0xa2,
+	0xdd, 0x6b, 0x3a, 0x2e, 0xed, 0xe8, 0x6a, 0xe9, 0xff, 0x56, 0xb9, 0x77, 0xbb, 0x2a, 0x3e, 0xed,
+	0x62, 0x1e, 0xfb, 0xf0, 0x7b, 0x7f, 0xfd, 0xfc, 0x12, 0xa8, 0x59, 0x34, 0x23, 0xcb, 0x48, 0xc0,
+	0x9f, 0x00, 0x5e, 0x4c, 0x12, 0xb0, 0xe1, 0xba, 0xbd, 0x4e, 0x2f, 0x40, 0x02, 0x7b, 0x5b, 0xb4,
+	0xd3, 0x21, 0x9c, 0x13, 0x1a, 0x1e, 0x6f, 0x0e, 0xda, 0xb0, 0x84, 0x52, 0x14, 0x59, 0xba, 0xd2,
+	0xfa, 0x5b, 0xce, 0x90, 0x1f, 0x82, 0x33, 0x9c, 0xde, 0xe6, 0x54, 0x94, 0x19, 0x25, 0xd5, 0x0c,
+	0x6d, 0x68, 0xfc, 0x07, 0xc0, 0x95, 0x24, 0xc8, 0xbb, 0x84, 0x0b, 0xca, 0x88, 0x8b, 0x82, 0x13,
+	0xa9, 0xf1, 0x22, 0x9c, 0xe8, 0x62, 0x46, 0xa8, 0x92, 0x36, 0x5e, 0xd3, 0x4f, 0xd6, 0xc7, 0xf0,
+	0x74, 0x5c, 0xee, 0x31, 0xa9, 0xf9, 0xcd, 0xd1, 0x34, 0x67, 0xe8, 0x9a, 0x7a, 0xe3, 0x90, 0x86,
+	0xd6, 0x5f, 0x01, 0x7c, 0x2e, 0x71, 0xde, 0xea, 0x31, 0x86, 0x43, 0x71, 0x22, 0x42, 0x3f, 0x4c,
+	0x05, 0xa9, 0x22, 0xbe, 0x36, 0x9a, 0xa0, 0x41, 0x4e, 0x87, 0xa8, 0xf9, 0xbe, 0x08, 0xcf, 0x26,
+	0xe3, 0xa4, 0x2e, 0x10, 0x13, 0x24, 0xf4, 0xa3, 0x71, 0x92, 0x6a, 0x39, 0x8e, 0xa1, 0x92, 0x9b,
+	0x92, 0xe2, 0x91, 0x53, 0xd2, 0x84, 0x33, 0x5c, 0x73, 0x6c, 0x90, 0xb0, 0x45, 0x75, 0xa5, 0xd7,
+	0x87, 0x26, 0x26, 0x57, 0x9e, 0x99, 0x96, 0x69, 0x6e, 0x1c, 0x18, 0xb9, 0xf9, 0xa6, 0x08, 0x97,
+	0x92, 0xac, 0xd6, 0x03, 0xc4, 0xdb, 0xd7, 0xfa, 0x32, 0xb1, 0xc7, 0xdc, 0xce, 0x6d, 0x4c, 0xfc,
+	0xb6, 0x88, 0xdb, 0x59, 0x3d, 0x19, 0x6d, 0x3e, 0x36, 0xd0, 0xe6, 0x14, 0x2e, 0xa4, 0xb0, 0x3c,
+	0x22, 0xd5, 0xc0, 0x11, 0x2b, 0x7b, 0x5c, 0xa6, 0xe2, 0x95, 0xd1, 0x7a, 0x24, 0x55, 0x63, 0x26,
+	0x62, 0xae, 0x9f, 0x3d, 0x37, 0x7f, 0xe5, 0x25, 0x38, 0xfd, 0x8e, 0x5a, 0xaf, 0x75, 0x81, 0x04,
+	0xb6, 0xae, 0xc3, 0x89, 0x2e, 0x62, 0xa8, 0xa3, 0x74, 0x97, 0xd6, 0x9f, 0x1f, 0x0a, 0xbe, 0x23,
+	0x4d, 0x4d, 0x3c, 0xed, 0x6d, 0xbd, 0x0f, 0x27, 0x5b, 0x18, 0x37, 0xba, 0x94, 0x06, 0xba, 0xd5,
+	0x2f, 0x0e, 0x8d, 0x74, 0x1d, 0xe3, 0x1d, 0x4a, 0x83, 0x81, 0xd6, 0x6e, 0xa9, 0x77, 0xd6, 0x2e,
+	0xb4, 0xd3, 0x86, 0x4d, 0x16, 0x59, 0xd4, 0x2c, 0xd1, 0x5c, 0x18, 0x1b, 0xbd, 0x5b, 0xcc, 0xdd,
+	0x6a, 0x22, 0x2d, 0x7a, 0x79, 0x16, 0xb2, 0xc5, 0xbb, 0x0c, 0xf7, 0x09, 0xed, 0xc9, 0x5d, 0xdf,
+	0xa5, 0x1c, 0x33, 0x7b, 0xfc, 0xb0, 0x7e, 0x88, 0x5d, 0x76, 0xb4, 0x87, 0xf5, 0x59, 0xfe, 0x06,
+	0x3b, 0x25, 0xa9, 0xbf, 0x3d, 0x5a, 0x75, 0x0f, 0x5a, 0xb3, 0xa6, 0x8c, 0x9c, 0xa5, 0x65, 0x7d,
+	0x07, 0xe0, 0x79, 0xa3, 0xa7, 0xd3, 0x51, 0xdf, 0x70, 0x93, 0x6d, 0xc0, 0xed, 0x09, 0x49, 0x65,
+	0xe3, 0x7f, 0x6c, 0x94, 0x2c, 0x9b, 0x4a, 0x7f, 0xa8, 0x03, 0xb7, 0xbe, 0x06, 0xf0, 0x5c, 0x4a,
+	0xad, 0x9d, 0xcc, 0xec, 0x24, 0x41, 0xa7, 0x25, 0xab, 0xab, 0x4f, 0x39, 0xf3, 0xb3, 0x8c, 0x96,
+	0xfb, 0x07, 0x1a, 0x5b, 0x5f, 0x00, 0xb8, 0x94, 0x92, 0x71, 0xd5, 0xbc, 0x4d, 0x98, 0x4c, 0x4a,
+	0x26, 0x57, 0x9e, 0x66, 0x58, 0x67, 0x69, 0x3c, 0xdb, 0xcf, 0xb7, 0xb4, 0x3e, 0x37, 0xfb, 0x7c,
+	0x60, 0x28, 0x72, 0x7b, 0x4a, 0x32, 0xb8, 0x7c, 0xf4, 0xa9, 0x98, 0xc5, 0x5f, 0xf4, 0xf2, 0xec,
+	0xb8, 0xb5, 0x0b, 0x17, 0x73, 0xc7, 0x10, 0xb7, 0xa1, 0x04, 0x7f, 0xe3, 0xa8, 0x73, 0x28, 0x0b,
+	0x3d, 0x9f, 0x33, 0x8d, 0x24, 0xb0, 0xa1, 0x5b, 0x02, 0x73, 0x81, 0x6e, 0x63, 0x6e, 0x97, 0x46,
+	0x00, 0x4e, 0x55, 0x47, 0x9e, 0xf5, 0xc8, 0x31, 0x07, 0xd8, 0xcb, 0x5a, 0x71, 0x4b, 0xc0, 0x05,
+	0x55, 0xe1, 0x06, 0x43, 0x02, 0x37, 0x78, 0x88, 0xba, 0xbc, 0x4d, 0x05, 0xb7, 0xa7, 0x25, 0xee,
+	0xeb, 0x43, 0x71, 0x55, 0xd5, 0x6a, 0x48, 0xe0, 0xba, 0xf6, 0xcb, 0xc2, 0xce, 0xb1, 0x8c, 0x11,
+	0xb7, 0x02, 0x68, 0x31, 0xdc, 0xea, 0x85, 0x1e, 0x6a, 0x06, 0x58, 0xe9, 0xc5, 0xdc, 0x9e, 0x19,
+	0x61, 0x90, 0xd5, 0x12, 0x37, 0xa9, 0x22, 0x8b, 0x37, 0xcb, 0x06, 0x2d, 0xb0, 0xf9, 0x5d, 0xf0,
+	0x43, 0x11, 0x2e, 0x1d, 0x98, 0xac, 0x93, 0xde, 0x7d, 0xb9, 0x1f, 0x1d, 0x63, 0x47, 0xfe, 0xe8,
+	0xb8, 0x05, 0x4f, 0xc9, 0xd6, 0xd0, 0x53, 0x78, 0x23, 0x92, 0xfe, 0xfb, 0xe3, 0xca, 0x0b, 0xa3,
+	0x7d, 0xe0, 0x3f, 0xba, 0xbf, 0x0a, 0x35, 0xd0, 0x36, 0x76, 0x55, 0xca, 0x54, 0x3c, 0x23, 0x4d,
+	0xdf, 0x02, 0x68, 0x1f, 0x54, 0x5b, 0x43, 0x1e, 0x18, 0x90, 0x77, 0x13, 0x4e, 0xc6, 0xdd, 0xa3,
+	0xd7, 0x5d, 0xf5, 0x88, 0xcd, 0x63, 0x96, 0x31, 0x89, 0x65, 0xd0, 0xfa, 0x05, 0xc0, 0x85, 0xdc,
+	0xfa, 0x9f, 0x74, 0xe5, 0xae, 0xc2, 0xf1, 0xe8, 0xc2, 0xaa, 0xbf, 0xcb, 0x96, 0x1d, 0x75, 0x9b,
+	0x75, 0xe2, 0xdb, 0xac, 0xf3, 0x41, 0x7c, 0x9b, 0xdd, 0x9c, 0x89, 0x14, 0xdc, 0x79, 0x52, 0x01,
+	0x4a, 0x85, 0x74, 0x4b, 0x15, 0x6c, 0xde, 0xb8, 0xb7, 0x57, 0x06, 0x0f, 0xf6, 0xca, 0xe0, 0xe1,
+	0x5e, 0x19, 0xfc, 0xb1, 0x57, 0x06, 0x77, 0xf6, 0xcb, 0x85, 0x87, 0xfb, 0xe5, 0xc2, 0x6f, 0xfb,
+	0xe5, 0xc2, 0x47, 0x6b, 0x43, 0x4b, 0xf8, 0xc9, 0xe0, 0xdd, 0x5c, 0x56, 0xb4, 0x39, 0x21, 0x39,
+	0xbc, 0xfa, 0xdf, 0x00, 0xfc, 0x68, 0xfc, 0x7c, 0x3d, 0x10, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -783,6 +946,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundableSlashes) > 0 {
+		for iNdEx := len(m.RefundableSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundableSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RewardRateSnapshots) > 0 {
+		for iNdEx := len(m.RewardRateSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRateSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DelegatorSlashStakes) > 0 {
+		for iNdEx := len(m.DelegatorSlashStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorSlashStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -911,6 +1116,139 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorSlashStakeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorSlashStakeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorSlashStakeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardRateSnapshotRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardRateSnapshotRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardRateSnapshotRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundableSlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundableSlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundableSlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -1102,20 +1440,92 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegatorSlashStakes) > 0 {
+		for _, e := range m.DelegatorSlashStakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardRateSnapshots) > 0 {
+		for _, e := range m.RewardRateSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefundableSlashes) > 0 {
+		for _, e := range m.RefundableSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DelegatorSlashStakeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
 }
-func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *RewardRateSnapshotRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RefundableSlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2370,6 +2780,511 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashStakes = append(m.DelegatorSlashStakes, DelegatorSlashStakeRecord{})
+			if err := m.DelegatorSlashStakes[len(m.DelegatorSlashStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRateSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRateSnapshots = append(m.RewardRateSnapshots, RewardRateSnapshotRecord{})
+			if err := m.RewardRateSnapshots[len(m.RewardRateSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundableSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundableSlashes = append(m.RefundableSlashes, RefundableSlashRecord{})
+			if err := m.RefundableSlashes[len(m.RefundableSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorSlashStakeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorSlashStakeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorSlashStakeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardRateSnapshotRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardRateSnapshotRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardRateSnapshotRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundableSlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundableSlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundableSlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
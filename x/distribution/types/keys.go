@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -41,6 +42,14 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<height>: RewardRateSnapshot
+//
+// - 0x0B<valAddrLen (1 Byte)><valAddr_Bytes><height><accAddrLen (1 Byte)><accAddr_Bytes>: DelegatorSlashStake
+//
+// - 0x0C<time_Bytes><valAddrLen (1 Byte)><valAddr_Bytes><height>: DelegatorSlashStakeQueue
+//
+// - 0x0D<valAddrLen (1 Byte)><valAddr_Bytes><height>: RefundableSlash
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +63,11 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	RewardRateSnapshotPrefix  = []byte{0x0A} // key for reward rate snapshots
+	DelegatorSlashStakePrefix = []byte{0x0B} // key for the stakes of the delegators at the slash events
+	DelegatorSlashStakeQueue  = []byte{0x0C} // key for the queue of the delegator stakes at the slash events by slash time
+	RefundableSlashPrefix     = []byte{0x0D} // key for the slash events which can still be refunded
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -153,6 +167,48 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetDelegatorSlashStakeAddressesHeight creates the addresses and height from a delegator slash stake key.
+func GetDelegatorSlashStakeAddressesHeight(key []byte) (valAddr sdk.ValAddress, height uint64, delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0B<valAddrLen (1 Byte)><valAddr_Bytes><height><accAddrLen (1 Byte)><accAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	valAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+valAddrLen)
+	valAddr = sdk.ValAddress(key[2 : 2+valAddrLen])
+	startB := 2 + valAddrLen
+	kv.AssertKeyAtLeastLength(key, startB+10)
+	height = binary.BigEndian.Uint64(key[startB : startB+8])
+	delAddrLen := int(key[startB+8])
+	delAddr = sdk.AccAddress(key[startB+9:])
+	kv.AssertKeyLength(delAddr.Bytes(), delAddrLen)
+	return
+}
+
+// GetDelegatorSlashStakeQueueAddressHeight creates the address and height from a delegator slash stake queue key.
+func GetDelegatorSlashStakeQueueAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
+	// key is in the format:
+	// 0x0C<time_Bytes><valAddrLen (1 Byte)><valAddr_Bytes><height>
+	startB := 1 + len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, startB+1)
+	valAddrLen := int(key[startB])
+	kv.AssertKeyLength(key, startB+1+valAddrLen+8)
+	valAddr = sdk.ValAddress(key[startB+1 : startB+1+valAddrLen])
+	height = binary.BigEndian.Uint64(key[startB+1+valAddrLen:])
+	return
+}
+
+// GetRefundableSlashAddressHeight creates the address and height from a refundable slash key.
+func GetRefundableSlashAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
+	// key is in the format:
+	// 0x0D<valAddrLen (1 Byte)><valAddr_Bytes><height>
+	kv.AssertKeyAtLeastLength(key, 2)
+	valAddrLen := int(key[1])
+	kv.AssertKeyLength(key, 2+valAddrLen+8)
+	valAddr = sdk.ValAddress(key[2 : 2+valAddrLen])
+	height = binary.BigEndian.Uint64(key[2+valAddrLen:])
+	return
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -168,6 +224,11 @@ func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
 }
 
+// GetDelegatorStartingInfoPrefix creates the prefix key for the starting infos of a validator's delegators.
+func GetDelegatorStartingInfoPrefix(v sdk.ValAddress) []byte {
+	return append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // GetValidatorHistoricalRewardsPrefix creates the prefix key for a validator's historical rewards.
 func GetValidatorHistoricalRewardsPrefix(v sdk.ValAddress) []byte {
 	return append(ValidatorHistoricalRewardsPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -214,3 +275,61 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetRewardRateSnapshotKey creates the key for the reward rate snapshot at a height.
+func GetRewardRateSnapshotKey(height uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, height)
+
+	return append(RewardRateSnapshotPrefix, heightBz...)
+}
+
+// GetRewardRateSnapshotHeight returns the height from a reward rate snapshot key.
+func GetRewardRateSnapshotHeight(key []byte) uint64 {
+	kv.AssertKeyLength(key, 1+8)
+	return binary.BigEndian.Uint64(key[1:])
+}
+
+// GetValidatorDelegatorSlashStakesPrefix creates the prefix key for the delegator stakes at a validator's slash events.
+func GetValidatorDelegatorSlashStakesPrefix(v sdk.ValAddress) []byte {
+	return append(DelegatorSlashStakePrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetDelegatorSlashStakesPrefix creates the prefix key for the delegator stakes at a validator's slash event at a height.
+func GetDelegatorSlashStakesPrefix(v sdk.ValAddress, height uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, height)
+
+	return append(GetValidatorDelegatorSlashStakesPrefix(v), heightBz...)
+}
+
+// GetDelegatorSlashStakeKey creates the key for a delegator's stake at a validator's slash event at a height.
+func GetDelegatorSlashStakeKey(v sdk.ValAddress, height uint64, d sdk.AccAddress) []byte {
+	return append(GetDelegatorSlashStakesPrefix(v, height), address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetDelegatorSlashStakeQueueTimeKey creates the prefix key for the delegator stakes at the slash events at a time.
+func GetDelegatorSlashStakeQueueTimeKey(slashTime time.Time) []byte {
+	return append(DelegatorSlashStakeQueue, sdk.FormatTimeBytes(slashTime)...)
+}
+
+// GetDelegatorSlashStakeQueueKey creates the key for the delegator stakes at a validator's slash event at a height and time.
+func GetDelegatorSlashStakeQueueKey(slashTime time.Time, v sdk.ValAddress, height uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, height)
+
+	return append(append(GetDelegatorSlashStakeQueueTimeKey(slashTime), address.MustLengthPrefix(v.Bytes())...), heightBz...)
+}
+
+// GetValidatorRefundableSlashesPrefix creates the prefix key for a validator's refundable slash events.
+func GetValidatorRefundableSlashesPrefix(v sdk.ValAddress) []byte {
+	return append(RefundableSlashPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetRefundableSlashKey creates the key for a validator's refundable slash event at a height.
+func GetRefundableSlashKey(v sdk.ValAddress, height uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, height)
+
+	return append(GetValidatorRefundableSlashesPrefix(v), heightBz...)
+}
@@ -11,19 +11,25 @@ import (
 const (
 	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
+	TypeMsgWithdrawAllDelegatorRewards = "withdraw_all_delegator_rewards"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgCommunityPoolSpend          = "community_pool_spend"
+	TypeMsgSetValidatorFeeShare        = "set_validator_fee_share"
+	TypeMsgRefundSlash                 = "refund_slash"
 )
 
 // Verify interface at compile time
 var (
 	_ sdk.Msg = (*MsgSetWithdrawAddress)(nil)
 	_ sdk.Msg = (*MsgWithdrawDelegatorReward)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgSetValidatorFeeShare)(nil)
+	_ sdk.Msg = (*MsgRefundSlash)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -93,6 +99,35 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+	}
+}
+
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+func (msg MsgWithdrawAllDelegatorRewards) Type() string  { return TypeMsgWithdrawAllDelegatorRewards }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr.String(),
@@ -224,3 +259,93 @@ func (msg MsgCommunityPoolSpend) ValidateBasic() error {
 
 	return msg.Amount.Validate()
 }
+
+// NewMsgSetValidatorFeeShare returns a new MsgSetValidatorFeeShare with a
+// validator and the fee share it sets.
+func NewMsgSetValidatorFeeShare(valAddr sdk.ValAddress, feeShare sdk.Dec) *MsgSetValidatorFeeShare {
+	return &MsgSetValidatorFeeShare{
+		ValidatorAddress: valAddr.String(),
+		FeeShare:         feeShare,
+	}
+}
+
+// Route returns the MsgSetValidatorFeeShare message route.
+func (msg MsgSetValidatorFeeShare) Route() string { return ModuleName }
+
+// Type returns the MsgSetValidatorFeeShare message type.
+func (msg MsgSetValidatorFeeShare) Type() string { return TypeMsgSetValidatorFeeShare }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the validator operator.
+func (msg MsgSetValidatorFeeShare) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetValidatorFeeShare message
+// that the expected signer needs to sign.
+func (msg MsgSetValidatorFeeShare) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetValidatorFeeShare message validation.
+func (msg MsgSetValidatorFeeShare) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.FeeShare.IsNil() || msg.FeeShare.IsNegative() || msg.FeeShare.GT(sdk.OneDec()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("fee share should be non-negative and less than one: %s", msg.FeeShare)
+	}
+
+	return nil
+}
+
+// NewMsgRefundSlash returns a new MsgRefundSlash refunding the delegators of a
+// validator slashed at the given height.
+func NewMsgRefundSlash(authority sdk.AccAddress, valAddr sdk.ValAddress, slashHeight uint64, amount sdk.Coins) *MsgRefundSlash {
+	return &MsgRefundSlash{
+		Authority:        authority.String(),
+		ValidatorAddress: valAddr.String(),
+		SlashHeight:      slashHeight,
+		Amount:           amount,
+	}
+}
+
+// Route returns the MsgRefundSlash message route.
+func (msg MsgRefundSlash) Route() string { return ModuleName }
+
+// Type returns the MsgRefundSlash message type.
+func (msg MsgRefundSlash) Type() string { return TypeMsgRefundSlash }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgRefundSlash) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgRefundSlash message that the
+// expected signer needs to sign.
+func (msg MsgRefundSlash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgRefundSlash message validation.
+func (msg MsgRefundSlash) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.SlashHeight == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("slash height must be positive")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
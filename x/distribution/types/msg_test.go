@@ -93,3 +93,55 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetValidatorFeeShare
+func TestMsgSetValidatorFeeShare(t *testing.T) {
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		feeShare      sdk.Dec
+		expectPass    bool
+	}{
+		{valAddr1, sdk.NewDecWithPrec(5, 1), true},
+		{valAddr1, sdk.ZeroDec(), true},
+		{valAddr1, sdk.OneDec(), true},
+		{valAddr1, sdk.NewDecWithPrec(11, 1), false},
+		{valAddr1, sdk.NewDecWithPrec(-1, 1), false},
+		{valAddr1, sdk.Dec{}, false},
+		{emptyValAddr, sdk.NewDecWithPrec(5, 1), false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetValidatorFeeShare(tc.validatorAddr, tc.feeShare)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRefundSlash
+func TestMsgRefundSlash(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	tests := []struct {
+		authority     sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		slashHeight   uint64
+		amount        sdk.Coins
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, 10, amount, true},
+		{emptyDelAddr, valAddr1, 10, amount, false},
+		{delAddr1, emptyValAddr, 10, amount, false},
+		{delAddr1, valAddr1, 0, amount, false},
+		{delAddr1, valAddr1, 10, sdk.NewCoins(), false},
+		{delAddr1, valAddr1, 10, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgRefundSlash(tc.authority, tc.validatorAddr, tc.slashHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -9,14 +9,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultMaxWithdrawalsPerTx is the default maximum number of delegations
+// withdrawn at once by MsgWithdrawAllDelegatorRewards.
+const DefaultMaxWithdrawalsPerTx uint32 = 50
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.ZeroDec(),            // deprecated
-		BonusProposerReward: sdk.ZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:         sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   sdk.ZeroDec(),            // deprecated
+		BonusProposerReward:  sdk.ZeroDec(),            // deprecated
+		WithdrawAddrEnabled:  true,
+		MaxValidatorFeeShare: sdk.ZeroDec(),
+		MaxWithdrawalsPerTx:  DefaultMaxWithdrawalsPerTx,
+	}
+}
+
+// WithdrawalsPerTxLimit returns the maximum number of delegations withdrawn
+// at once by MsgWithdrawAllDelegatorRewards, i.e. MaxWithdrawalsPerTx, or its
+// default value if it is not set, as for the parameters predating it.
+func (p Params) WithdrawalsPerTxLimit() uint32 {
+	if p.MaxWithdrawalsPerTx == 0 {
+		return DefaultMaxWithdrawalsPerTx
 	}
+
+	return p.MaxWithdrawalsPerTx
 }
 
 func (p Params) String() string {
@@ -32,6 +49,12 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	if p.MaxValidatorFeeShare.IsNil() || p.MaxValidatorFeeShare.IsNegative() || p.MaxValidatorFeeShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf(
+			"max validator fee share should be non-negative and less than one: %s", p.MaxValidatorFeeShare,
+		)
+	}
+
 	return nil
 }
 
@@ -54,6 +77,25 @@ func validateCommunityTax(i interface{}) error {
 	return nil
 }
 
+func validateMaxValidatorFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max validator fee share must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max validator fee share must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator fee share too large: %s", v)
+	}
+
+	return nil
+}
+
 func validateWithdrawAddrEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
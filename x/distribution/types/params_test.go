@@ -13,28 +13,34 @@ func TestParams_ValidateBasic(t *testing.T) {
 	toDec := sdk.MustNewDecFromStr
 
 	type fields struct {
-		CommunityTax        sdk.Dec
-		BaseProposerReward  sdk.Dec
-		BonusProposerReward sdk.Dec
-		WithdrawAddrEnabled bool
+		CommunityTax         sdk.Dec
+		BaseProposerReward   sdk.Dec
+		BonusProposerReward  sdk.Dec
+		WithdrawAddrEnabled  bool
+		MaxValidatorFeeShare sdk.Dec
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false}, true},
-		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("0")}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false, toDec("0")}, true},
+		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, toDec("0")}, false},
+		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, toDec("0")}, false},
+		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, toDec("0")}, false},
+		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false, toDec("0")}, true},
+		{"max validator fee share", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("0.5")}, false},
+		{"negative max validator fee share", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("-0.1")}, true},
+		{"max validator fee share greater than 1", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("1.1")}, true},
+		{"nil max validator fee share", fields{toDec("0.1"), toDec("0"), toDec("0"), false, sdk.Dec{}}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				CommunityTax:         tt.fields.CommunityTax,
+				WithdrawAddrEnabled:  tt.fields.WithdrawAddrEnabled,
+				MaxValidatorFeeShare: tt.fields.MaxValidatorFeeShare,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
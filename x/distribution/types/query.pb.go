@@ -952,6 +952,94 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryHistoricalAPRRequest is the request type for the Query/HistoricalAPR RPC
+// method.
+type QueryHistoricalAPRRequest struct {
+	// num_blocks defines the number of past blocks the APR is computed over.
+	NumBlocks int64 `protobuf:"varint,1,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+}
+
+func (m *QueryHistoricalAPRRequest) Reset()         { *m = QueryHistoricalAPRRequest{} }
+func (m *QueryHistoricalAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalAPRRequest) ProtoMessage()    {}
+func (*QueryHistoricalAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryHistoricalAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalAPRRequest.Merge(m, src)
+}
+func (m *QueryHistoricalAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalAPRRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalAPRRequest) GetNumBlocks() int64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+// QueryHistoricalAPRResponse is the response type for the Query/HistoricalAPR
+// RPC method.
+type QueryHistoricalAPRResponse struct {
+	// apr defines the annualised rate of the rewards allocated to delegators, net
+	// of commission, in the bond denomination.
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+}
+
+func (m *QueryHistoricalAPRResponse) Reset()         { *m = QueryHistoricalAPRResponse{} }
+func (m *QueryHistoricalAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalAPRResponse) ProtoMessage()    {}
+func (*QueryHistoricalAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryHistoricalAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalAPRResponse.Merge(m, src)
+}
+func (m *QueryHistoricalAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalAPRResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -973,6 +1061,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryHistoricalAPRRequest)(nil), "cosmos.distribution.v1beta1.QueryHistoricalAPRRequest")
+	proto.RegisterType((*QueryHistoricalAPRResponse)(nil), "cosmos.distribution.v1beta1.QueryHistoricalAPRResponse")
 }
 
 func init() {
@@ -980,86 +1070,92 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
+	// 1346 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xf6, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x99, 0x46, 0xc8, 0xd9, 0x04, 0x3b, 0xda,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x93, 0x48, 0xa5, 0x34, 0x54, 0x10, 0x3b, 0x09, 0x45, 0xad, 0xfa,
-	0xc7, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0xac, 0x17, 0xec, 0x1d, 0x67, 0x67, 0x9d, 0x10,
-	0x55, 0xbd, 0x14, 0x21, 0x15, 0x4e, 0x08, 0x2e, 0x3d, 0xe6, 0x88, 0x38, 0x71, 0x00, 0x71, 0xe4,
-	0xc2, 0xa1, 0xc7, 0x0a, 0x24, 0xc4, 0x09, 0x50, 0x02, 0xa2, 0x1c, 0x90, 0xb8, 0x71, 0x45, 0x9e,
-	0x99, 0xb5, 0x77, 0xe3, 0xf5, 0xfa, 0x5f, 0x7c, 0x49, 0x56, 0x6f, 0xe6, 0x7d, 0xef, 0x7d, 0xef,
-	0xcd, 0x9b, 0xf9, 0x12, 0x98, 0xcd, 0x13, 0x5a, 0x22, 0x54, 0xd1, 0x4c, 0xea, 0xd8, 0x66, 0xae,
-	0xe2, 0x98, 0xc4, 0x52, 0x76, 0x16, 0x73, 0xba, 0xa3, 0x2e, 0x2a, 0xdb, 0x15, 0xdd, 0xde, 0x4b,
-	0x96, 0x6d, 0xe2, 0x10, 0x3c, 0xc9, 0x37, 0x26, 0xbd, 0x1b, 0x93, 0x62, 0xa3, 0x74, 0x5e, 0xa0,
-	0xe4, 0x54, 0xaa, 0x73, 0xaf, 0x1a, 0x46, 0x59, 0x35, 0x4c, 0x4b, 0x65, 0xbb, 0x19, 0x90, 0x34,
-	0x6e, 0x10, 0x83, 0xb0, 0x4f, 0xa5, 0xfa, 0x25, 0xac, 0x53, 0x06, 0x21, 0x46, 0x51, 0x57, 0xd4,
-	0xb2, 0xa9, 0xa8, 0x96, 0x45, 0x1c, 0xe6, 0x42, 0xc5, 0x6a, 0xdc, 0x8b, 0xef, 0x22, 0xe7, 0x89,
-	0xe9, 0x62, 0x26, 0xc3, 0x58, 0xf8, 0x32, 0xe6, 0xfb, 0x27, 0xf8, 0xfe, 0x2c, 0x4f, 0x43, 0x30,
-	0xe3, 0x4b, 0x63, 0x6a, 0xc9, 0xb4, 0x88, 0xc2, 0x7e, 0x72, 0x93, 0x3c, 0x0e, 0xf8, 0x76, 0x95,
-	0xd3, 0x2d, 0xd5, 0x56, 0x4b, 0x34, 0xa3, 0x6f, 0x57, 0x74, 0xea, 0xc8, 0xf7, 0xe0, 0x8c, 0xcf,
-	0x4a, 0xcb, 0xc4, 0xa2, 0x3a, 0xde, 0x80, 0xa1, 0x32, 0xb3, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xb5,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x3b, 0xa7, 0x86, 0x9f, 0xfc, 0x92, 0x88, 0x7c, 0xf1, 0xe7,
-	0x57, 0xe7, 0x51, 0x46, 0x78, 0xcb, 0x16, 0x9c, 0x65, 0xf0, 0x6f, 0xa9, 0x45, 0x53, 0x53, 0x1d,
-	0x62, 0xaf, 0x79, 0xfc, 0xdf, 0xb0, 0xb6, 0x88, 0xc8, 0x03, 0xaf, 0xc3, 0xd8, 0x8e, 0xbb, 0x27,
-	0xab, 0x6a, 0x9a, 0xad, 0x53, 0x1e, 0x7b, 0x38, 0x15, 0xfb, 0xe1, 0xeb, 0x85, 0x71, 0x11, 0x7e,
-	0x95, 0xaf, 0xdc, 0x71, 0x6c, 0xd3, 0x32, 0x32, 0xa3, 0x35, 0x17, 0x61, 0x97, 0xff, 0x88, 0xc2,
-	0xb9, 0x56, 0x01, 0x05, 0xc5, 0x34, 0x8c, 0x92, 0xb2, 0x6e, 0x77, 0x14, 0x70, 0xc4, 0xf5, 0x10,
-	0x66, 0xfc, 0x10, 0xc1, 0x18, 0xd5, 0x8b, 0x5b, 0xd9, 0x1c, 0xb1, 0xb4, 0xac, 0xad, 0xef, 0xaa,
-	0xb6, 0x46, 0x63, 0xd1, 0xe9, 0x81, 0xb9, 0x53, 0x4b, 0x53, 0x6e, 0xcd, 0xaa, 0xfd, 0xae, 0xd5,
-	0x6a, 0x4d, 0xcf, 0xa7, 0x89, 0x69, 0xa5, 0x2e, 0x55, 0x8b, 0xf5, 0xe5, 0xaf, 0x89, 0x79, 0xc3,
-	0x74, 0x0a, 0x95, 0x5c, 0x32, 0x4f, 0x4a, 0xa2, 0x85, 0xe2, 0xd7, 0x02, 0xd5, 0xde, 0x57, 0x9c,
-	0xbd, 0xb2, 0x4e, 0x5d, 0x1f, 0xca, 0x6b, 0x3b, 0x52, 0x0d, 0x98, 0x22, 0x96, 0x96, 0xe1, 0xe1,
-	0xf0, 0x36, 0x40, 0x9e, 0x94, 0x4a, 0x26, 0xa5, 0x26, 0xb1, 0x62, 0x03, 0x6d, 0x04, 0x5f, 0xee,
-	0x22, 0x78, 0xc6, 0x13, 0x44, 0x2e, 0xc3, 0xac, 0xbf, 0xcc, 0x37, 0x2b, 0x0e, 0x75, 0x54, 0x4b,
-	0xab, 0x56, 0x89, 0xa7, 0x75, 0xcc, 0x9d, 0xfd, 0x18, 0xc1, 0x5c, 0xeb, 0x90, 0xa2, 0xb7, 0xf7,
-	0xe0, 0xa4, 0xdb, 0x0b, 0x7e, 0x7e, 0x2f, 0x85, 0x9e, 0xdf, 0x10, 0x48, 0xef, 0xa1, 0x76, 0x31,
-	0xe5, 0x02, 0x24, 0xfc, 0xa9, 0xa4, 0x6b, 0x95, 0x39, 0x66, 0xd6, 0x9f, 0x20, 0x98, 0x6e, 0x1e,
-	0x4a, 0xb0, 0xdd, 0xf2, 0xf5, 0x9f, 0x13, 0x5e, 0x69, 0x8f, 0xf0, 0x6a, 0x3e, 0x5f, 0x29, 0x55,
-	0x8a, 0xaa, 0xa3, 0x6b, 0x75, 0x60, 0x2f, 0x67, 0x6f, 0xd3, 0x3f, 0x8a, 0xc2, 0x94, 0x3f, 0x99,
-	0x3b, 0x45, 0x95, 0x16, 0xf4, 0x63, 0x6e, 0x35, 0x9e, 0x85, 0x11, 0xea, 0xa8, 0xb6, 0x63, 0x5a,
-	0x46, 0xb6, 0xa0, 0x9b, 0x46, 0xc1, 0x89, 0x45, 0xa7, 0xd1, 0xdc, 0x60, 0xe6, 0x39, 0xd7, 0x7c,
-	0x95, 0x59, 0xf1, 0x0c, 0x9c, 0xd6, 0x59, 0xb3, 0xdc, 0x6d, 0x03, 0x6c, 0xdb, 0xff, 0xb9, 0x51,
-	0x6c, 0xda, 0x00, 0xa8, 0xdf, 0xde, 0xb1, 0x41, 0x56, 0x9d, 0x73, 0xbe, 0xe9, 0xe0, 0x0f, 0x44,
-	0xfd, 0x32, 0x33, 0x74, 0x41, 0x28, 0xe3, 0xf1, 0xbc, 0xfc, 0xbf, 0x47, 0xfb, 0x89, 0xc8, 0xe3,
-	0xfd, 0x04, 0x92, 0xbf, 0x43, 0xf0, 0x42, 0x93, 0x3a, 0x88, 0x8e, 0xbc, 0x09, 0x27, 0x29, 0x37,
-	0xc5, 0x10, 0x1b, 0xc7, 0x0b, 0xed, 0xb5, 0x83, 0xe1, 0xac, 0xef, 0xe8, 0x96, 0xe3, 0x3b, 0x77,
-	0x02, 0x0b, 0xbf, 0xee, 0xa3, 0x12, 0x65, 0x54, 0x66, 0x5b, 0x52, 0xe1, 0x39, 0x79, 0xb9, 0xc8,
-	0xdf, 0xba, 0x0c, 0xd6, 0xf4, 0xa2, 0x6e, 0x30, 0x5b, 0xe3, 0xd4, 0x6a, 0x7c, 0xad, 0x93, 0x56,
-	0xd6, 0x5c, 0xdc, 0x56, 0x06, 0x9e, 0x88, 0x68, 0xa7, 0x27, 0x82, 0xd7, 0xfe, 0xd9, 0x7e, 0x22,
-	0x22, 0x7f, 0x86, 0x20, 0xde, 0x2c, 0x73, 0x51, 0xfc, 0xb2, 0x77, 0xf8, 0xfb, 0x79, 0x11, 0xd7,
-	0xee, 0x83, 0x0a, 0xc8, 0x47, 0x72, 0xba, 0x4b, 0x1c, 0xb5, 0xd8, 0x97, 0x92, 0x7a, 0x6a, 0xf1,
-	0x0f, 0x82, 0x99, 0xd0, 0xb8, 0xa2, 0x20, 0xef, 0x1e, 0x2d, 0xc8, 0xc5, 0xd0, 0xd3, 0x58, 0x47,
-	0x5b, 0x73, 0x63, 0x73, 0xc4, 0xa0, 0xbb, 0x10, 0x17, 0xe1, 0x84, 0x53, 0x0d, 0xda, 0xe7, 0x47,
-	0x8f, 0x07, 0x91, 0x6d, 0x71, 0xf3, 0xd6, 0x32, 0xab, 0x8d, 0x4e, 0xff, 0xca, 0x7c, 0x5d, 0x5c,
-	0xc1, 0x81, 0x31, 0x45, 0x89, 0xe3, 0x00, 0xb5, 0x43, 0xcb, 0xab, 0x3c, 0x9c, 0xf1, 0x58, 0x3c,
-	0x68, 0xbb, 0xf0, 0xa2, 0x1f, 0x6d, 0xd3, 0x74, 0x0a, 0x9a, 0xad, 0xee, 0x8a, 0xc0, 0x7d, 0xa3,
-	0xb1, 0x23, 0xa4, 0x58, 0xf3, 0xc0, 0x75, 0x61, 0xb4, 0x2b, 0x96, 0xda, 0x17, 0x46, 0xbb, 0x7e,
-	0x30, 0x4f, 0xdc, 0x49, 0x98, 0x60, 0x71, 0xab, 0xef, 0x4b, 0xc5, 0x32, 0x9d, 0xbd, 0x5b, 0x84,
-	0x14, 0x5d, 0xf9, 0xf9, 0x08, 0x81, 0x14, 0xb4, 0x2a, 0x52, 0x79, 0x0f, 0x06, 0xcb, 0x84, 0x14,
-	0xfb, 0x3c, 0xc7, 0x2c, 0xc6, 0xd2, 0xf7, 0x63, 0x70, 0x82, 0xa5, 0x82, 0x1f, 0x23, 0x18, 0xe2,
-	0x92, 0x16, 0x2b, 0xa1, 0x93, 0xd2, 0xa8, 0xa7, 0xa5, 0x0b, 0xed, 0x3b, 0x70, 0x8e, 0xf2, 0xfc,
-	0xc3, 0x1f, 0x7f, 0xff, 0x3c, 0x7a, 0x16, 0xcf, 0x28, 0x61, 0xf2, 0x9f, 0xeb, 0x69, 0xfc, 0x17,
-	0x82, 0x89, 0xa6, 0xd2, 0x16, 0xa7, 0x5a, 0x07, 0x6f, 0x25, 0xc4, 0xa5, 0x74, 0x4f, 0x18, 0x82,
-	0x53, 0x9a, 0x71, 0xba, 0x82, 0x57, 0x42, 0x39, 0xd5, 0xe7, 0x43, 0xb9, 0xdf, 0xf0, 0x4a, 0x3c,
-	0xc0, 0x1f, 0x46, 0x61, 0x32, 0x44, 0x99, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x2a, 0x4f, 0xa5, 0xf5,
-	0x1e, 0x51, 0x04, 0xe3, 0x4d, 0xc6, 0xf8, 0x36, 0xbe, 0xd9, 0x03, 0x63, 0x85, 0xd4, 0xf1, 0xdd,
-	0xbf, 0x25, 0xf0, 0x01, 0x82, 0x33, 0x01, 0xe2, 0x0f, 0xbf, 0xd2, 0x41, 0xde, 0x0d, 0xf2, 0x54,
-	0xba, 0xd2, 0xa5, 0xb7, 0x60, 0x7b, 0x83, 0xb1, 0xbd, 0x8a, 0x37, 0x7a, 0x61, 0x5b, 0x57, 0x96,
-	0xf8, 0x27, 0x04, 0xa3, 0x47, 0xc5, 0x14, 0x7e, 0xb9, 0x83, 0x1c, 0xfd, 0x42, 0x54, 0xba, 0xdc,
-	0x8d, 0xab, 0xe0, 0x76, 0x8d, 0x71, 0x5b, 0xc7, 0xe9, 0x5e, 0xb8, 0xb9, 0x8a, 0xed, 0x6f, 0x04,
-	0x63, 0x0d, 0x4a, 0x05, 0xb7, 0x91, 0x5e, 0x33, 0x61, 0x26, 0xad, 0x74, 0xe5, 0x2b, 0xb8, 0x65,
-	0x19, 0xb7, 0xb7, 0xf1, 0x66, 0x28, 0xb7, 0xda, 0x23, 0x42, 0x95, 0xfb, 0x0d, 0x6f, 0xd0, 0x03,
-	0x45, 0x9c, 0xcc, 0xc0, 0x99, 0x7d, 0x86, 0xe0, 0xf9, 0x60, 0x35, 0x82, 0x5f, 0xed, 0x24, 0xf1,
-	0x00, 0xfd, 0x24, 0xbd, 0xd6, 0x3d, 0x40, 0x47, 0xad, 0x6d, 0x8f, 0x3e, 0x1b, 0xcc, 0x00, 0x49,
-	0xd0, 0xce, 0x60, 0x36, 0x57, 0x2f, 0xed, 0x0c, 0x66, 0x88, 0x0e, 0x69, 0x73, 0x30, 0x5b, 0x30,
-	0xac, 0x9f, 0x6d, 0xfc, 0x2f, 0x82, 0x58, 0x33, 0xc1, 0x80, 0x57, 0x3b, 0xc8, 0x35, 0x58, 0xe5,
-	0x48, 0xa9, 0x5e, 0x20, 0x04, 0xe7, 0xbb, 0x8c, 0xf3, 0x0d, 0x7c, 0xbd, 0x17, 0xce, 0x47, 0x15,
-	0x0f, 0xfe, 0x06, 0xc1, 0x69, 0x9f, 0x28, 0xc1, 0x17, 0x5b, 0xe7, 0x1a, 0xa4, 0x71, 0xa4, 0x97,
-	0x3a, 0xf6, 0x13, 0xc4, 0x96, 0x19, 0xb1, 0x05, 0x3c, 0x1f, 0x4a, 0x2c, 0xef, 0xfa, 0x66, 0xab,
-	0x32, 0x26, 0x75, 0xed, 0xc9, 0x41, 0x1c, 0x3d, 0x3d, 0x88, 0xa3, 0xdf, 0x0e, 0xe2, 0xe8, 0xd3,
-	0xc3, 0x78, 0xe4, 0xe9, 0x61, 0x3c, 0xf2, 0xf3, 0x61, 0x3c, 0xf2, 0xce, 0x62, 0xa8, 0x30, 0xfa,
-	0xc0, 0x8f, 0xce, 0x74, 0x52, 0x6e, 0x88, 0xfd, 0xeb, 0x70, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x58, 0xdf, 0x9a, 0x20, 0x60, 0x15, 0x00, 0x00,
+	0x17, 0xf6, 0x38, 0xfd, 0xf3, 0xcb, 0xeb, 0xaf, 0xb4, 0x99, 0x56, 0xc8, 0xdd, 0xb6, 0x76, 0xb4,
+	0xa1, 0x4d, 0xd4, 0x2a, 0xde, 0xb6, 0x91, 0xda, 0x92, 0x34, 0x82, 0xd8, 0x4e, 0x08, 0x6a, 0x95,
+	0xa6, 0x6e, 0x21, 0x02, 0x54, 0x59, 0x6b, 0xef, 0xc6, 0x5e, 0xba, 0xde, 0xd9, 0xec, 0xae, 0x13,
+	0xa2, 0xaa, 0x97, 0x22, 0xa4, 0xc2, 0x09, 0xc1, 0xa5, 0xc7, 0x1c, 0x11, 0x27, 0x0e, 0x45, 0x1c,
+	0xb9, 0xf6, 0x58, 0x15, 0x09, 0x21, 0x0e, 0x05, 0x25, 0x20, 0xca, 0x01, 0x89, 0x1b, 0x17, 0x0e,
+	0xc8, 0x33, 0xb3, 0xf6, 0x6e, 0xbc, 0x5e, 0x7b, 0xed, 0xf8, 0xd2, 0x5a, 0x6f, 0xe7, 0x7d, 0xef,
+	0xfb, 0xde, 0x9b, 0xd9, 0xf9, 0x36, 0x30, 0x5e, 0x22, 0x76, 0x95, 0xd8, 0x92, 0xa2, 0xd9, 0x8e,
+	0xa5, 0x15, 0x6b, 0x8e, 0x46, 0x0c, 0x69, 0xfd, 0x62, 0x51, 0x75, 0xe4, 0x8b, 0xd2, 0x5a, 0x4d,
+	0xb5, 0x36, 0xd3, 0xa6, 0x45, 0x1c, 0x82, 0x4f, 0xb2, 0x85, 0x69, 0xef, 0xc2, 0x34, 0x5f, 0x28,
+	0x9c, 0xe3, 0x28, 0x45, 0xd9, 0x56, 0x59, 0x56, 0x03, 0xc3, 0x94, 0xcb, 0x9a, 0x21, 0xd3, 0xd5,
+	0x14, 0x48, 0x38, 0x5e, 0x26, 0x65, 0x42, 0x7f, 0x4a, 0xf5, 0x5f, 0x3c, 0x7a, 0xaa, 0x4c, 0x48,
+	0x59, 0x57, 0x25, 0xd9, 0xd4, 0x24, 0xd9, 0x30, 0x88, 0x43, 0x53, 0x6c, 0xfe, 0x34, 0xe9, 0xc5,
+	0x77, 0x91, 0x4b, 0x44, 0x73, 0x31, 0xd3, 0x61, 0x2a, 0x7c, 0x8c, 0xd9, 0xfa, 0x13, 0x6c, 0x7d,
+	0x81, 0xd1, 0xe0, 0xca, 0xd8, 0xa3, 0x11, 0xb9, 0xaa, 0x19, 0x44, 0xa2, 0xff, 0xb2, 0x90, 0x78,
+	0x1c, 0xf0, 0xad, 0xba, 0xa6, 0x65, 0xd9, 0x92, 0xab, 0x76, 0x5e, 0x5d, 0xab, 0xa9, 0xb6, 0x23,
+	0xde, 0x85, 0x63, 0xbe, 0xa8, 0x6d, 0x12, 0xc3, 0x56, 0xf1, 0x02, 0x1c, 0x30, 0x69, 0x24, 0x81,
+	0x46, 0xd1, 0xc4, 0xa1, 0x4b, 0x63, 0xe9, 0x90, 0xc6, 0xa5, 0x59, 0x72, 0x66, 0xf8, 0xe9, 0x8b,
+	0x54, 0xec, 0xab, 0x3f, 0xbe, 0x39, 0x87, 0xf2, 0x3c, 0x5b, 0x34, 0xe0, 0x0c, 0x85, 0x7f, 0x57,
+	0xd6, 0x35, 0x45, 0x76, 0x88, 0x95, 0xf3, 0xe4, 0xbf, 0x6d, 0xac, 0x12, 0xce, 0x03, 0xcf, 0xc3,
+	0xc8, 0xba, 0xbb, 0xa6, 0x20, 0x2b, 0x8a, 0xa5, 0xda, 0xac, 0xf6, 0x70, 0x26, 0xf1, 0xfc, 0xc9,
+	0xe4, 0x71, 0x5e, 0x7e, 0x8e, 0x3d, 0xb9, 0xed, 0x58, 0x9a, 0x51, 0xce, 0x1f, 0x6d, 0xa4, 0xf0,
+	0xb8, 0xf8, 0x7b, 0x1c, 0xce, 0x76, 0x2a, 0xc8, 0x25, 0x66, 0xe1, 0x28, 0x31, 0x55, 0x2b, 0x52,
+	0xc1, 0x23, 0x6e, 0x06, 0x0f, 0xe3, 0x87, 0x08, 0x46, 0x6c, 0x55, 0x5f, 0x2d, 0x14, 0x89, 0xa1,
+	0x14, 0x2c, 0x75, 0x43, 0xb6, 0x14, 0x3b, 0x11, 0x1f, 0x1d, 0x9a, 0x38, 0x74, 0xe9, 0x94, 0xdb,
+	0xb3, 0xfa, 0xbc, 0x1b, 0xbd, 0xca, 0xa9, 0xa5, 0x2c, 0xd1, 0x8c, 0xcc, 0xd5, 0x7a, 0xb3, 0xbe,
+	0xfe, 0x25, 0x75, 0xbe, 0xac, 0x39, 0x95, 0x5a, 0x31, 0x5d, 0x22, 0x55, 0x3e, 0x42, 0xfe, 0xdf,
+	0xa4, 0xad, 0xdc, 0x93, 0x9c, 0x4d, 0x53, 0xb5, 0xdd, 0x1c, 0x9b, 0xf5, 0xf6, 0x48, 0xbd, 0x60,
+	0x86, 0x18, 0x4a, 0x9e, 0x95, 0xc3, 0x6b, 0x00, 0x25, 0x52, 0xad, 0x6a, 0xb6, 0xad, 0x11, 0x23,
+	0x31, 0xd4, 0x45, 0xf1, 0xa9, 0x1e, 0x8a, 0xe7, 0x3d, 0x45, 0x44, 0x13, 0xc6, 0xfd, 0x6d, 0xbe,
+	0x59, 0x73, 0x6c, 0x47, 0x36, 0x94, 0x7a, 0x97, 0x18, 0xad, 0x3d, 0x9e, 0xec, 0xa7, 0x08, 0x26,
+	0x3a, 0x97, 0xe4, 0xb3, 0xbd, 0x0b, 0x07, 0xdd, 0x59, 0xb0, 0xfd, 0x7b, 0x35, 0x74, 0xff, 0x86,
+	0x40, 0x7a, 0x37, 0xb5, 0x8b, 0x29, 0x56, 0x20, 0xe5, 0xa7, 0x92, 0x6d, 0x74, 0x66, 0x8f, 0x55,
+	0x7f, 0x86, 0x60, 0xb4, 0x7d, 0x29, 0xae, 0x76, 0xd5, 0x37, 0x7f, 0x26, 0x78, 0xa6, 0x3b, 0xc1,
+	0x73, 0xa5, 0x52, 0xad, 0x5a, 0xd3, 0x65, 0x47, 0x55, 0x9a, 0xc0, 0x5e, 0xcd, 0xde, 0xa1, 0x7f,
+	0x12, 0x87, 0x53, 0x7e, 0x32, 0xb7, 0x75, 0xd9, 0xae, 0xa8, 0x7b, 0x3c, 0x6a, 0x3c, 0x0e, 0x47,
+	0x6c, 0x47, 0xb6, 0x1c, 0xcd, 0x28, 0x17, 0x2a, 0xaa, 0x56, 0xae, 0x38, 0x89, 0xf8, 0x28, 0x9a,
+	0xd8, 0x97, 0x7f, 0xc5, 0x0d, 0x2f, 0xd2, 0x28, 0x1e, 0x83, 0xc3, 0xaa, 0xa1, 0x78, 0x96, 0x0d,
+	0xd1, 0x65, 0xff, 0x67, 0x41, 0xbe, 0x68, 0x01, 0xa0, 0xf9, 0xf6, 0x4e, 0xec, 0xa3, 0xdd, 0x39,
+	0xeb, 0x3b, 0x1d, 0xec, 0x82, 0x68, 0xbe, 0xcc, 0xca, 0x2a, 0x17, 0x94, 0xf7, 0x64, 0x4e, 0xff,
+	0xef, 0xd1, 0x56, 0x2a, 0xf6, 0x78, 0x2b, 0x85, 0xc4, 0xef, 0x11, 0x9c, 0x6e, 0xd3, 0x07, 0x3e,
+	0x91, 0x77, 0xe0, 0xa0, 0xcd, 0x42, 0x09, 0x44, 0x8f, 0xe3, 0x85, 0xee, 0xc6, 0x41, 0x71, 0xe6,
+	0xd7, 0x55, 0xc3, 0xf1, 0xed, 0x3b, 0x8e, 0x85, 0xdf, 0xf2, 0x49, 0x89, 0x53, 0x29, 0xe3, 0x1d,
+	0xa5, 0x30, 0x4e, 0x5e, 0x2d, 0xe2, 0x77, 0xae, 0x82, 0x9c, 0xaa, 0xab, 0x65, 0x1a, 0x6b, 0x3d,
+	0xb5, 0x0a, 0x7b, 0x16, 0x65, 0x94, 0x8d, 0x14, 0x77, 0x94, 0x81, 0x3b, 0x22, 0x1e, 0x75, 0x47,
+	0xb0, 0xde, 0xbf, 0xdc, 0x4a, 0xc5, 0xc4, 0x2f, 0x10, 0x24, 0xdb, 0x31, 0xe7, 0xcd, 0x37, 0xbd,
+	0x87, 0x7f, 0x90, 0x2f, 0xe2, 0xc6, 0xfb, 0xa0, 0x06, 0xe2, 0x2e, 0x4e, 0x77, 0x88, 0x23, 0xeb,
+	0x03, 0x69, 0xa9, 0xa7, 0x17, 0x7f, 0x23, 0x18, 0x0b, 0xad, 0xcb, 0x1b, 0xf2, 0xc1, 0xee, 0x86,
+	0x5c, 0x0e, 0xdd, 0x8d, 0x4d, 0xb4, 0x9c, 0x5b, 0x9b, 0x21, 0x06, 0xbd, 0x0b, 0xb1, 0x0e, 0xfb,
+	0x9d, 0x7a, 0xd1, 0x01, 0x5f, 0x7a, 0xac, 0x88, 0x68, 0xf1, 0x37, 0x6f, 0x83, 0x59, 0xe3, 0xe8,
+	0x0c, 0xae, 0xcd, 0x37, 0x60, 0xb4, 0x7d, 0x4d, 0xde, 0xe2, 0x24, 0x40, 0x63, 0xd3, 0xb2, 0x2e,
+	0x0f, 0xe7, 0x3d, 0x11, 0x0f, 0xda, 0x06, 0xbc, 0xe6, 0x47, 0x5b, 0xd1, 0x9c, 0x8a, 0x62, 0xc9,
+	0x1b, 0xbc, 0xf0, 0xc0, 0x64, 0xac, 0xc3, 0x99, 0x0e, 0x85, 0x9b, 0xc6, 0x68, 0x83, 0x3f, 0xea,
+	0xde, 0x18, 0x6d, 0xf8, 0xc1, 0x3c, 0x75, 0x4f, 0xc2, 0x09, 0x5a, 0xb7, 0x7e, 0xbf, 0xd4, 0x0c,
+	0xcd, 0xd9, 0x5c, 0x26, 0x44, 0x77, 0xed, 0xe7, 0x23, 0x04, 0x42, 0xd0, 0x53, 0x4e, 0xe5, 0x43,
+	0xd8, 0x67, 0x12, 0xa2, 0x0f, 0xf8, 0x1c, 0xd3, 0x1a, 0xe2, 0x34, 0xe7, 0xb9, 0xa8, 0xd9, 0x0e,
+	0xb1, 0xb4, 0x92, 0xac, 0xcf, 0x2d, 0xe7, 0xdd, 0x69, 0x9c, 0x06, 0x30, 0x6a, 0xd5, 0x42, 0x51,
+	0x27, 0xa5, 0x7b, 0xac, 0x1b, 0x43, 0xf9, 0x61, 0xa3, 0x56, 0xcd, 0xd0, 0x80, 0xa8, 0x83, 0x10,
+	0x94, 0xcb, 0x55, 0x2c, 0xc1, 0x90, 0x6c, 0x5a, 0xbc, 0x87, 0xd7, 0xea, 0x34, 0x7f, 0x7e, 0x91,
+	0x3a, 0xdb, 0x1d, 0xcd, 0xe7, 0x4f, 0x26, 0x81, 0xab, 0xce, 0xa9, 0xa5, 0x7c, 0x1d, 0xe8, 0xd2,
+	0xbf, 0x18, 0xf6, 0xd3, 0x72, 0xf8, 0x31, 0x82, 0x03, 0xcc, 0x7c, 0x63, 0x29, 0xf4, 0x4c, 0xb7,
+	0x3a, 0x7f, 0xe1, 0x42, 0xf7, 0x09, 0x4c, 0x87, 0x78, 0xfe, 0xe1, 0x0f, 0xbf, 0x7d, 0x19, 0x3f,
+	0x83, 0xc7, 0xa4, 0xb0, 0x0f, 0x15, 0xe6, 0xfc, 0xf1, 0x9f, 0x08, 0x4e, 0xb4, 0x35, 0xe1, 0x38,
+	0xd3, 0xb9, 0x78, 0xa7, 0x4f, 0x06, 0x21, 0xdb, 0x17, 0x06, 0xd7, 0x94, 0xa5, 0x9a, 0x66, 0xf1,
+	0x4c, 0xa8, 0xa6, 0xe6, 0x49, 0x96, 0xee, 0xb7, 0xdc, 0x67, 0x0f, 0xf0, 0xc7, 0x71, 0x38, 0x19,
+	0xe2, 0x21, 0x71, 0x2e, 0x02, 0xd3, 0xb6, 0x46, 0x5a, 0x98, 0xef, 0x13, 0x85, 0x2b, 0x5e, 0xa1,
+	0x8a, 0x6f, 0xe1, 0x9b, 0x7d, 0x28, 0x96, 0x48, 0x13, 0xdf, 0xfd, 0xea, 0xc1, 0xdb, 0x08, 0x8e,
+	0x05, 0xd8, 0x54, 0x7c, 0x2d, 0x02, 0xef, 0x16, 0x23, 0x2d, 0xcc, 0xf6, 0x98, 0xcd, 0xd5, 0x2e,
+	0x51, 0xb5, 0x8b, 0x78, 0xa1, 0x1f, 0xb5, 0x4d, 0x0f, 0x8c, 0x7f, 0x44, 0x70, 0x74, 0xb7, 0xed,
+	0xc3, 0xaf, 0x47, 0xe0, 0xe8, 0xb7, 0xcc, 0xc2, 0x74, 0x2f, 0xa9, 0x5c, 0xdb, 0x75, 0xaa, 0x6d,
+	0x1e, 0x67, 0xfb, 0xd1, 0xe6, 0x7a, 0xcb, 0xbf, 0x10, 0x8c, 0xb4, 0x78, 0x2a, 0xdc, 0x05, 0xbd,
+	0x76, 0x16, 0x52, 0x98, 0xe9, 0x29, 0x97, 0x6b, 0x2b, 0x50, 0x6d, 0xef, 0xe1, 0x95, 0x50, 0x6d,
+	0x8d, 0xeb, 0xce, 0x96, 0xee, 0xb7, 0xdc, 0x96, 0x0f, 0x24, 0xbe, 0x33, 0x03, 0xcf, 0xec, 0x4b,
+	0x04, 0xaf, 0x06, 0xfb, 0x26, 0xfc, 0x46, 0x14, 0xe2, 0x01, 0x4e, 0x4f, 0x78, 0xb3, 0x77, 0x80,
+	0x48, 0xa3, 0xed, 0x4e, 0x3e, 0x3d, 0x98, 0x01, 0xe6, 0xa5, 0x9b, 0x83, 0xd9, 0xde, 0x67, 0x09,
+	0xb3, 0x3d, 0x66, 0x47, 0x3a, 0x98, 0x1d, 0x14, 0x36, 0xf7, 0x36, 0xfe, 0x07, 0x41, 0xa2, 0x9d,
+	0xb5, 0xc1, 0x73, 0x11, 0xb8, 0x06, 0xfb, 0x31, 0x21, 0xd3, 0x0f, 0x04, 0xd7, 0x7c, 0x87, 0x6a,
+	0x5e, 0xc2, 0x37, 0xfa, 0xd1, 0xbc, 0xdb, 0x9b, 0xe1, 0x6f, 0x11, 0x1c, 0xf6, 0xd9, 0x27, 0x7c,
+	0xb9, 0x33, 0xd7, 0x20, 0x37, 0x26, 0x5c, 0x89, 0x9c, 0xc7, 0x85, 0x4d, 0x51, 0x61, 0x93, 0xf8,
+	0x7c, 0xa8, 0xb0, 0x92, 0x9b, 0x5b, 0xa8, 0x1b, 0x2e, 0xca, 0xdb, 0x67, 0x98, 0xba, 0xe1, 0x1d,
+	0xe4, 0xce, 0x84, 0x2b, 0x91, 0xf3, 0x22, 0xf1, 0xae, 0x34, 0x72, 0x0b, 0xb2, 0x69, 0x65, 0xae,
+	0x3f, 0xdd, 0x4e, 0xa2, 0x67, 0xdb, 0x49, 0xf4, 0xeb, 0x76, 0x12, 0x7d, 0xbe, 0x93, 0x8c, 0x3d,
+	0xdb, 0x49, 0xc6, 0x7e, 0xda, 0x49, 0xc6, 0xde, 0xbf, 0x18, 0xea, 0xe9, 0x3e, 0xf2, 0xa3, 0x53,
+	0x8b, 0x57, 0x3c, 0x40, 0xff, 0x38, 0x3b, 0xf5, 0xdf, 0x00, 0x12, 0x16, 0x92, 0x55, 0xc2, 0x16,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1095,6 +1191,9 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// HistoricalAPR queries the annualised rate of the rewards allocated to
+	// delegators over the last num_blocks blocks.
+	HistoricalAPR(ctx context.Context, in *QueryHistoricalAPRRequest, opts ...grpc.CallOption) (*QueryHistoricalAPRResponse, error)
 }
 
 type queryClient struct {
@@ -1195,6 +1294,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) HistoricalAPR(ctx context.Context, in *QueryHistoricalAPRRequest, opts ...grpc.CallOption) (*QueryHistoricalAPRResponse, error) {
+	out := new(QueryHistoricalAPRResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/HistoricalAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1218,6 +1326,9 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// HistoricalAPR queries the annualised rate of the rewards allocated to
+	// delegators over the last num_blocks blocks.
+	HistoricalAPR(context.Context, *QueryHistoricalAPRRequest) (*QueryHistoricalAPRResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1254,6 +1365,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) HistoricalAPR(ctx context.Context, req *QueryHistoricalAPRRequest) (*QueryHistoricalAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalAPR not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1439,6 +1553,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/HistoricalAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalAPR(ctx, req.(*QueryHistoricalAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1483,6 +1615,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "HistoricalAPR",
+			Handler:    _Query_HistoricalAPR_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2196,6 +2332,67 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHistoricalAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2499,6 +2696,29 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHistoricalAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumBlocks))
+	}
+	return n
+}
+
+func (m *QueryHistoricalAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4336,6 +4556,159 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHistoricalAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
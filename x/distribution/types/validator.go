@@ -18,8 +18,9 @@ func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.DecCoins, reference
 // create a new ValidatorCurrentRewards
 func NewValidatorCurrentRewards(rewards sdk.DecCoins, period uint64) ValidatorCurrentRewards {
 	return ValidatorCurrentRewards{
-		Rewards: rewards,
-		Period:  period,
+		Rewards:           rewards,
+		Period:            period,
+		ValidatorFeeShare: sdk.ZeroDec(),
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 //
 // The evidence is considered invalid if:
 // - the evidence is too old
+// - the evidence is older than the max evidence age of the slashing module
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
@@ -74,6 +75,18 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		}
 	}
 
+	// Reject evidence older than the max evidence age of the slashing module.
+	if err := k.slashingKeeper.ValidateEvidenceAge(ctx, infractionTime); err != nil {
+		logger.Info(
+			"ignored equivocation; evidence expired",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+			"err", err,
+		)
+		return
+	}
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
@@ -206,6 +206,20 @@ func (mr *MockSlashingKeeperMockRecorder) Tombstone(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstone", reflect.TypeOf((*MockSlashingKeeper)(nil).Tombstone), arg0, arg1)
 }
 
+// ValidateEvidenceAge mocks base method.
+func (m *MockSlashingKeeper) ValidateEvidenceAge(arg0 types0.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateEvidenceAge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateEvidenceAge indicates an expected call of ValidateEvidenceAge.
+func (mr *MockSlashingKeeperMockRecorder) ValidateEvidenceAge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEvidenceAge", reflect.TypeOf((*MockSlashingKeeper)(nil).ValidateEvidenceAge), arg0, arg1)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
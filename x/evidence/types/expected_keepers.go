@@ -30,6 +30,7 @@ type (
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		ValidateEvidenceAge(sdk.Context, time.Time) error
 	}
 
 	// AccountKeeper define the account keeper interface contracted needed by the evidence module
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryAllowanceUsageHistory(),
+		GetCmdQueryParams(),
 	)
 
 	return feegrantQueryCmd
@@ -175,3 +177,90 @@ $ %s query feegrant grants-by-granter [granter]
 
 	return cmd
 }
+
+// GetCmdQueryAllowanceUsageHistory returns cmd to query for the records of the
+// uses of the allowance granted by a granter to a grantee.
+func GetCmdQueryAllowanceUsageHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance-usage-history [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the usage history of a single grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the records of the uses of the allowance granted by a granter to a grantee,
+oldest first. The records older than the allowance audit retention are pruned.
+
+Example:
+$ %s query feegrant allowance-usage-history [granter] [grantee]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowanceUsageHistory(
+				cmd.Context(),
+				&feegrant.QueryAllowanceUsageHistoryRequest{
+					Granter:    granterAddr.String(),
+					Grantee:    granteeAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowance usage records")
+
+	return cmd
+}
+
+// GetCmdQueryParams returns cmd to query for the feegrant module parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current feegrant parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the current parameters of the feegrant module.
+
+Example:
+$ %s query feegrant params
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &feegrant.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -283,11 +283,143 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+// Params defines the parameters for the feegrant module.
+type Params struct {
+	// allowance_audit_retention is the number of blocks after which the
+	// allowance usage records are pruned. Zero means no pruning.
+	AllowanceAuditRetention uint64 `protobuf:"varint,1,opt,name=allowance_audit_retention,json=allowanceAuditRetention,proto3" json:"allowance_audit_retention,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowanceAuditRetention() uint64 {
+	if m != nil {
+		return m.AllowanceAuditRetention
+	}
+	return 0
+}
+
+// AllowanceUsageRecord records a use of the allowance granted by a granter to
+// a grantee to pay the fee of a transaction.
+type AllowanceUsageRecord struct {
+	// tx_hash is the hash of the transaction whose fee was paid.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// fee_used is the fee paid with the allowance.
+	FeeUsed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_used,json=feeUsed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_used"`
+	// height is the height of the block the allowance was used at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// grantee is the address of the user who used the allowance.
+	Grantee string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// granter is the address of the user who granted the allowance.
+	Granter string `protobuf:"bytes,5,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *AllowanceUsageRecord) Reset()         { *m = AllowanceUsageRecord{} }
+func (m *AllowanceUsageRecord) String() string { return proto.CompactTextString(m) }
+func (*AllowanceUsageRecord) ProtoMessage()    {}
+func (*AllowanceUsageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *AllowanceUsageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowanceUsageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowanceUsageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowanceUsageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowanceUsageRecord.Merge(m, src)
+}
+func (m *AllowanceUsageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowanceUsageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowanceUsageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowanceUsageRecord proto.InternalMessageInfo
+
+func (m *AllowanceUsageRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *AllowanceUsageRecord) GetFeeUsed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeUsed
+	}
+	return nil
+}
+
+func (m *AllowanceUsageRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AllowanceUsageRecord) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *AllowanceUsageRecord) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*Params)(nil), "cosmos.feegrant.v1beta1.Params")
+	proto.RegisterType((*AllowanceUsageRecord)(nil), "cosmos.feegrant.v1beta1.AllowanceUsageRecord")
 }
 
 func init() {
@@ -295,47 +427,55 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xb6, 0x28, 0x17, 0x28, 0xad, 0xa9, 0x84, 0x53, 0x21, 0xbb, 0x8a, 0x04, 0x4d,
-	0x2b, 0xd5, 0x56, 0x8b, 0x58, 0x3a, 0x35, 0x2e, 0xa2, 0x80, 0x5a, 0xa9, 0x72, 0x99, 0x90, 0x50,
-	0x74, 0xb6, 0xaf, 0xe6, 0x44, 0xec, 0x33, 0x3e, 0x17, 0x1a, 0x06, 0x66, 0xc4, 0x80, 0x32, 0x32,
-	0x32, 0x22, 0xa6, 0x0e, 0xe5, 0x3b, 0x54, 0x0c, 0xa8, 0x62, 0x62, 0x22, 0x28, 0x19, 0x3a, 0xf3,
-	0x0d, 0x90, 0xef, 0xce, 0x8e, 0x9b, 0x50, 0x68, 0x25, 0xba, 0x24, 0x77, 0xef, 0xde, 0xfb, 0xfd,
-	0x79, 0xef, 0x45, 0x01, 0xb7, 0x1c, 0x42, 0x7d, 0x42, 0x8d, 0x1d, 0x84, 0xbc, 0x08, 0x06, 0xb1,
-	0xf1, 0x62, 0xc9, 0x46, 0x31, 0x5c, 0xca, 0x02, 0x7a, 0x18, 0x91, 0x98, 0xc8, 0xd7, 0x79, 0x9e,
-	0x9e, 0x85, 0x45, 0xde, 0xcc, 0xb4, 0x47, 0x3c, 0xc2, 0x72, 0x8c, 0xe4, 0xc4, 0xd3, 0x67, 0x2a,
-	0x1e, 0x21, 0x5e, 0x13, 0x19, 0xec, 0x66, 0xef, 0xee, 0x18, 0x30, 0x68, 0xa5, 0x4f, 0x1c, 0xa9,
-	0xc1, 0x6b, 0x04, 0x2c, 0x7f, 0x52, 0x85, 0x18, 0x1b, 0x52, 0x94, 0x09, 0x71, 0x08, 0x0e, 0xc4,
-	0xfb, 0x14, 0xf4, 0x71, 0x40, 0x0c, 0xf6, 0x29, 0x42, 0xda, 0x20, 0x51, 0x8c, 0x7d, 0x44, 0x63,
-	0xe8, 0x87, 0x29, 0xe6, 0x60, 0x82, 0xbb, 0x1b, 0xc1, 0x18, 0x13, 0x81, 0x59, 0x7d, 0x37, 0x02,
-	0x26, 0x4c, 0x48, 0xb1, 0x53, 0x6f, 0x36, 0xc9, 0x4b, 0x18, 0x38, 0x48, 0x7e, 0x0e, 0xca, 0x34,
-	0x44, 0x81, 0xdb, 0x68, 0x62, 0x1f, 0xc7, 0x8a, 0x34, 0x5b, 0xac, 0x95, 0x97, 0x2b, 0xba, 0x90,
-	0x9a, 0x88, 0x4b, 0xdd, 0xeb, 0x6b, 0x04, 0x07, 0xe6, 0x9d, 0xc3, 0x1f, 0x5a, 0xe1, 0x53, 0x47,
-	0xab, 0x79, 0x38, 0x7e, 0xba, 0x6b, 0xeb, 0x0e, 0xf1, 0x85, 0x2f, 0xf1, 0xb5, 0x48, 0xdd, 0x67,
-	0x46, 0xdc, 0x0a, 0x11, 0x65, 0x05, 0xf4, 0xe3, 0xf1, 0xfe, 0x82, 0x64, 0x01, 0x46, 0xb2, 0x91,
-	0x70, 0xc8, 0xab, 0x00, 0xa0, 0xbd, 0x10, 0x73, 0x65, 0xca, 0xc8, 0xac, 0x54, 0x2b, 0x2f, 0xcf,
-	0xe8, 0x5c, 0xba, 0x9e, 0x4a, 0xd7, 0x1f, 0xa5, 0xde, 0xcc, 0xd1, 0x76, 0x47, 0x93, 0xac, 0x5c,
-	0xcd, 0xca, 0xfa, 0x97, 0x83, 0xc5, 0x9b, 0xa7, 0x0c, 0x49, 0xbf, 0x87, 0x50, 0x66, 0xef, 0xc1,
-	0xdb, 0xe3, 0xfd, 0x85, 0x4a, 0x4e, 0xd8, 0x49, 0xf7, 0xd5, 0xcf, 0xa3, 0x60, 0x6a, 0x0b, 0x45,
-	0x98, 0xb8, 0xf9, 0x9e, 0xdc, 0x07, 0x63, 0x76, 0x92, 0xa7, 0x48, 0x4c, 0xdb, 0x9c, 0x7e, 0x1a,
-	0xd5, 0x49, 0x34, 0xb3, 0x94, 0xf4, 0x86, 0xfb, 0xe5, 0x00, 0xf2, 0x2a, 0x18, 0x0f, 0x19, 0xbc,
-	0xb0, 0x59, 0x19, 0xb2, 0x79, 0x57, 0x4c, 0xc8, 0xbc, 0x92, 0x14, 0xbf, 0xef, 0x68, 0x12, 0x07,
-	0x10, 0x75, 0xf2, 0x6b, 0x20, 0xf3, 0x53, 0x23, 0x3f, 0xa6, 0xe2, 0x05, 0x8d, 0x69, 0x92, 0x73,
-	0x6d, 0xf7, 0x87, 0xf5, 0x0a, 0x88, 0x58, 0xc3, 0x81, 0x01, 0xd7, 0xa0, 0x8c, 0x5e, 0x10, 0xfb,
-	0x04, 0x67, 0x5a, 0x83, 0x01, 0x13, 0x20, 0x6f, 0x80, 0xcb, 0x82, 0x3b, 0x42, 0x14, 0xc5, 0xca,
-	0xd8, 0x3f, 0x57, 0x85, 0x35, 0xb1, 0x9d, 0x35, 0xb1, 0xcc, 0xcb, 0xad, 0xa4, 0x7a, 0xe5, 0xe1,
-	0xb9, 0x96, 0xe6, 0x46, 0x4e, 0xe8, 0xd0, 0x86, 0x54, 0x7f, 0x49, 0xe0, 0x1a, 0xbb, 0x21, 0x77,
-	0x93, 0x7a, 0xfd, 0xcd, 0x79, 0x02, 0x4a, 0x30, 0xbd, 0x88, 0xed, 0x99, 0x1e, 0x92, 0x5b, 0x0f,
-	0x5a, 0xe6, 0xfc, 0x99, 0xc5, 0x58, 0x7d, 0x44, 0x79, 0x1e, 0x4c, 0x42, 0xce, 0xda, 0xf0, 0x11,
-	0xa5, 0xd0, 0x43, 0x54, 0x19, 0x99, 0x2d, 0xd6, 0x4a, 0xd6, 0x55, 0x11, 0xdf, 0x14, 0xe1, 0x95,
-	0xad, 0x37, 0x1f, 0xb4, 0xc2, 0xb9, 0x1c, 0xab, 0x39, 0xc7, 0x7f, 0xf0, 0x56, 0xfd, 0x2a, 0x81,
-	0xb1, 0xf5, 0x04, 0x42, 0x5e, 0x06, 0x97, 0x18, 0x16, 0x8a, 0x98, 0xc7, 0x92, 0xa9, 0x7c, 0x3b,
-	0x58, 0x9c, 0x16, 0x44, 0x75, 0xd7, 0x8d, 0x10, 0xa5, 0xdb, 0x71, 0x84, 0x03, 0xcf, 0x4a, 0x13,
-	0xfb, 0x35, 0x88, 0xfd, 0x14, 0xce, 0x50, 0x33, 0xd0, 0xcd, 0xe2, 0xff, 0xee, 0xa6, 0x59, 0x3f,
-	0xec, 0xaa, 0xd2, 0x51, 0x57, 0x95, 0x7e, 0x76, 0x55, 0xa9, 0xdd, 0x53, 0x0b, 0x47, 0x3d, 0xb5,
-	0xf0, 0xbd, 0xa7, 0x16, 0x1e, 0xcf, 0xfd, 0x75, 0x6f, 0xf7, 0xb2, 0xff, 0x0b, 0x7b, 0x9c, 0xc9,
-	0xb8, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x3d, 0x09, 0x1d, 0x5a, 0x06, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0xf3, 0x8f, 0x66, 0xd2, 0x52, 0x70, 0xa3, 0xe2, 0xa0, 0xca, 0xa1, 0x91, 0x5a, 0x02,
+	0x12, 0x8e, 0xa0, 0xea, 0x25, 0x27, 0x62, 0xaa, 0x42, 0x2b, 0x90, 0x90, 0x29, 0x97, 0x4a, 0x95,
+	0x35, 0x89, 0x5f, 0x1c, 0x8b, 0xd8, 0x93, 0x7a, 0x26, 0x6d, 0xe8, 0xa1, 0xe7, 0xaa, 0x87, 0x15,
+	0xc7, 0x3d, 0xec, 0x61, 0x8f, 0xab, 0x3d, 0x71, 0x60, 0xbf, 0x03, 0xda, 0xc3, 0x0a, 0xed, 0x69,
+	0x4f, 0xcb, 0x0a, 0x0e, 0x9c, 0xf7, 0x1b, 0xac, 0xec, 0x19, 0x3b, 0x26, 0x2c, 0xbb, 0x41, 0x5a,
+	0x2e, 0x89, 0xe7, 0xcd, 0x7b, 0xbf, 0x3f, 0xef, 0xbd, 0xc4, 0xe8, 0xfb, 0x36, 0xa1, 0x2e, 0xa1,
+	0xf5, 0x0e, 0x80, 0xed, 0x63, 0x8f, 0xd5, 0xff, 0x5a, 0x6d, 0x01, 0xc3, 0xab, 0x71, 0x40, 0xeb,
+	0xfb, 0x84, 0x11, 0x79, 0x8e, 0xe7, 0x69, 0x71, 0x58, 0xe4, 0xcd, 0x97, 0x6c, 0x62, 0x93, 0x30,
+	0xa7, 0x1e, 0x3c, 0xf1, 0xf4, 0xf9, 0xb2, 0x4d, 0x88, 0xdd, 0x83, 0x7a, 0x78, 0x6a, 0x0d, 0x3a,
+	0x75, 0xec, 0x1d, 0x46, 0x57, 0x1c, 0xc9, 0xe4, 0x35, 0x02, 0x96, 0x5f, 0xa9, 0x42, 0x4c, 0x0b,
+	0x53, 0x88, 0x85, 0xb4, 0x89, 0xe3, 0x89, 0xfb, 0x59, 0xec, 0x3a, 0x1e, 0xa9, 0x87, 0x9f, 0x22,
+	0x54, 0x19, 0x27, 0x62, 0x8e, 0x0b, 0x94, 0x61, 0xb7, 0x1f, 0x61, 0x8e, 0x27, 0x58, 0x03, 0x1f,
+	0x33, 0x87, 0x08, 0xcc, 0xea, 0x83, 0x34, 0x9a, 0xd6, 0x31, 0x75, 0xda, 0xcd, 0x5e, 0x8f, 0xfc,
+	0x8d, 0xbd, 0x36, 0xc8, 0x7f, 0xa2, 0x22, 0xed, 0x83, 0x67, 0x99, 0x3d, 0xc7, 0x75, 0x98, 0x22,
+	0x2d, 0x64, 0x6a, 0xc5, 0xb5, 0xb2, 0x26, 0xa4, 0x06, 0xe2, 0x22, 0xf7, 0xda, 0x06, 0x71, 0x3c,
+	0xfd, 0xc7, 0xd3, 0xd7, 0x95, 0xd4, 0xd3, 0xf3, 0x4a, 0xcd, 0x76, 0x58, 0x77, 0xd0, 0xd2, 0xda,
+	0xc4, 0x15, 0xbe, 0xc4, 0xd7, 0x0a, 0xb5, 0x0e, 0xea, 0xec, 0xb0, 0x0f, 0x34, 0x2c, 0xa0, 0x4f,
+	0xae, 0x8e, 0x97, 0x25, 0x03, 0x85, 0x24, 0xdb, 0x01, 0x87, 0xbc, 0x8e, 0x10, 0x0c, 0xfb, 0x0e,
+	0x57, 0xa6, 0xa4, 0x17, 0xa4, 0x5a, 0x71, 0x6d, 0x5e, 0xe3, 0xd2, 0xb5, 0x48, 0xba, 0xf6, 0x5b,
+	0xe4, 0x4d, 0xcf, 0x1e, 0x9d, 0x57, 0x24, 0x23, 0x51, 0xd3, 0xd8, 0x7c, 0x7e, 0xb2, 0xf2, 0xdd,
+	0x2d, 0x43, 0xd2, 0x7e, 0x06, 0x88, 0xed, 0xfd, 0xf2, 0xff, 0xd5, 0xf1, 0x72, 0x39, 0x21, 0xec,
+	0xba, 0xfb, 0xea, 0xb3, 0x2c, 0x9a, 0xdd, 0x05, 0xdf, 0x21, 0x56, 0xb2, 0x27, 0x5b, 0x28, 0xd7,
+	0x0a, 0xf2, 0x14, 0x29, 0xd4, 0xb6, 0xa8, 0xdd, 0x46, 0x75, 0x1d, 0x4d, 0x2f, 0x04, 0xbd, 0xe1,
+	0x7e, 0x39, 0x80, 0xbc, 0x8e, 0xf2, 0xfd, 0x10, 0x5e, 0xd8, 0x2c, 0xdf, 0xb0, 0xf9, 0x93, 0x98,
+	0x90, 0xfe, 0x45, 0x50, 0xfc, 0xf0, 0xbc, 0x22, 0x71, 0x00, 0x51, 0x27, 0xff, 0x8b, 0x64, 0xfe,
+	0x64, 0x26, 0xc7, 0x94, 0xb9, 0xa7, 0x31, 0xcd, 0x70, 0xae, 0xbd, 0xd1, 0xb0, 0xfe, 0x41, 0x22,
+	0x66, 0xb6, 0xb1, 0xc7, 0x35, 0x28, 0xd9, 0x7b, 0x62, 0x9f, 0xe6, 0x4c, 0x1b, 0xd8, 0x0b, 0x05,
+	0xc8, 0xdb, 0xe8, 0x73, 0xc1, 0xed, 0x03, 0x05, 0xa6, 0xe4, 0x3e, 0xba, 0x2a, 0x61, 0x13, 0x8f,
+	0xe2, 0x26, 0x16, 0x79, 0xb9, 0x11, 0x54, 0x37, 0x7e, 0xbd, 0xd3, 0xd2, 0x7c, 0x93, 0x10, 0x7a,
+	0x63, 0x43, 0xaa, 0x6f, 0x25, 0xf4, 0x55, 0x78, 0x02, 0x6b, 0x87, 0xda, 0xa3, 0xcd, 0xf9, 0x03,
+	0x15, 0x70, 0x74, 0x10, 0xdb, 0x53, 0xba, 0x21, 0xb7, 0xe9, 0x1d, 0xea, 0x4b, 0x13, 0x8b, 0x31,
+	0x46, 0x88, 0xf2, 0x12, 0x9a, 0xc1, 0x9c, 0xd5, 0x74, 0x81, 0x52, 0x6c, 0x03, 0x55, 0xd2, 0x0b,
+	0x99, 0x5a, 0xc1, 0xf8, 0x52, 0xc4, 0x77, 0x44, 0xb8, 0xb1, 0xfb, 0xdf, 0xe3, 0x4a, 0xea, 0x4e,
+	0x8e, 0xd5, 0x84, 0xe3, 0xf7, 0x78, 0xab, 0xbe, 0x90, 0x50, 0x6e, 0x33, 0x80, 0x90, 0xd7, 0xd0,
+	0x54, 0x88, 0x05, 0x7e, 0xe8, 0xb1, 0xa0, 0x2b, 0x2f, 0x4f, 0x56, 0x4a, 0x82, 0xa8, 0x69, 0x59,
+	0x3e, 0x50, 0xba, 0xc7, 0x7c, 0xc7, 0xb3, 0x8d, 0x28, 0x71, 0x54, 0x03, 0x4a, 0x7a, 0xb2, 0x9a,
+	0xb1, 0x6e, 0x66, 0x3e, 0x75, 0x37, 0xab, 0x36, 0xca, 0xef, 0x62, 0x1f, 0xbb, 0x54, 0x6e, 0xa0,
+	0x72, 0x1c, 0x36, 0xf1, 0xc0, 0x72, 0x98, 0xe9, 0x03, 0x03, 0x2f, 0xfc, 0x83, 0x0a, 0x2c, 0x66,
+	0x8d, 0xb9, 0x38, 0xa1, 0x19, 0xdc, 0x1b, 0xd1, 0x75, 0xe3, 0xdb, 0xf1, 0x6d, 0x19, 0x8e, 0xde,
+	0x2f, 0x1c, 0xbe, 0xfa, 0x28, 0x8d, 0x4a, 0xb1, 0x84, 0xfd, 0x60, 0x3e, 0x06, 0xb4, 0x89, 0x6f,
+	0xc9, 0x73, 0x68, 0x8a, 0x0d, 0xcd, 0x2e, 0xa6, 0x5d, 0xde, 0x48, 0x23, 0xcf, 0x86, 0x5b, 0x98,
+	0x76, 0xe5, 0x03, 0xf4, 0x59, 0x07, 0xc0, 0x1c, 0x50, 0xb0, 0x94, 0xf4, 0x3d, 0xfd, 0xda, 0xa6,
+	0x3a, 0x00, 0xfb, 0x14, 0x2c, 0xf9, 0x6b, 0x94, 0xef, 0x82, 0x63, 0x77, 0x59, 0xd8, 0xe3, 0x8c,
+	0x21, 0x4e, 0xc9, 0x91, 0x65, 0x27, 0x1d, 0x59, 0x62, 0x35, 0x72, 0x13, 0xae, 0x86, 0xde, 0x3c,
+	0xbd, 0x50, 0xa5, 0xb3, 0x0b, 0x55, 0x7a, 0x73, 0xa1, 0x4a, 0x47, 0x97, 0x6a, 0xea, 0xec, 0x52,
+	0x4d, 0xbd, 0xba, 0x54, 0x53, 0xbf, 0x2f, 0x7e, 0xd0, 0xd1, 0xa8, 0xd1, 0xad, 0x7c, 0xb8, 0x0e,
+	0x3f, 0xbc, 0x1b, 0x00, 0x5a, 0xa0, 0x6e, 0xd7, 0xe2, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -555,6 +695,97 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowanceAuditRetention != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.AllowanceAuditRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowanceUsageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowanceUsageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowanceUsageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeeUsed) > 0 {
+		for iNdEx := len(m.FeeUsed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeUsed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -652,6 +883,48 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowanceAuditRetention != 0 {
+		n += 1 + sovFeegrant(uint64(m.AllowanceAuditRetention))
+	}
+	return n
+}
+
+func (m *AllowanceUsageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.FeeUsed) > 0 {
+		for _, e := range m.FeeUsed {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovFeegrant(uint64(m.Height))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1263,6 +1536,274 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceAuditRetention", wireType)
+			}
+			m.AllowanceAuditRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowanceAuditRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowanceUsageRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowanceUsageRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowanceUsageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUsed = append(m.FeeUsed, types.Coin{})
+			if err := m.FeeUsed[len(m.FeeUsed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeegrant(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,18 +2,21 @@ package feegrant
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(entries []Grant) *GenesisState {
+func NewGenesisState(params Params, entries []Grant) *GenesisState {
 	return &GenesisState{
 		Allowances: entries,
+		Params:     params,
 	}
 }
 
-// ValidateGenesis ensures all grants in the genesis state are valid
+// ValidateGenesis ensures all grants, params and allowance usage records in
+// the genesis state are valid
 func ValidateGenesis(data GenesisState) error {
 	for _, f := range data.Allowances {
 		grant, err := f.GetGrant()
@@ -25,12 +28,25 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, record := range data.AllowanceUsageRecords {
+		if err := record.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid allowance usage record of tx %s", record.TxHash)
+		}
+	}
+
 	return nil
 }
 
 // DefaultGenesisState returns default state for feegrant module.
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
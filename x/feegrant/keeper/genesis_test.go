@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	feegranttestutil "github.com/cosmos/cosmos-sdk/x/feegrant/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type GenesisTestSuite struct {
@@ -34,7 +35,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 	accountKeeper := feegranttestutil.NewMockAccountKeeper(ctrl)
 	accountKeeper.EXPECT().GetAccount(gomock.Any(), granteeAddr).Return(authtypes.NewBaseAccountWithAddress(granteeAddr)).AnyTimes()
 
-	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper)
+	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	suite.ctx = testCtx.Ctx
 }
@@ -55,9 +56,17 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	allowance := &feegrant.BasicAllowance{SpendLimit: coins, Expiration: &oneYear}
 	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
 	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.SetParams(suite.ctx, feegrant.DefaultParams()))
+
+	// use the allowance to export an allowance usage record
+	fee := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10)))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx.WithTxBytes([]byte("tx")), granterAddr, granteeAddr, fee, []sdk.Msg{})
+	suite.Require().NoError(err)
 
 	genesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.DefaultParams(), genesis.Params)
+	suite.Require().Len(genesis.AllowanceUsageRecords, 1)
 	// revoke fee allowance
 	_, err = msgSrvr.RevokeAllowance(sdk.WrapSDKContext(suite.ctx), &feegrant.MsgRevokeAllowance{
 		Granter: granterAddr.String(),
@@ -125,3 +125,52 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// Params returns the parameters of the feegrant module.
+func (q Keeper) Params(c context.Context, req *feegrant.QueryParamsRequest) (*feegrant.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &feegrant.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
+
+// AllowanceUsageHistory returns the records of the uses of the allowance
+// granted by the granter to the grantee, oldest first.
+func (q Keeper) AllowanceUsageHistory(c context.Context, req *feegrant.QueryAllowanceUsageHistoryRequest) (*feegrant.QueryAllowanceUsageHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []feegrant.AllowanceUsageRecord
+	store := ctx.KVStore(q.storeKey)
+	recordStore := prefix.NewStore(store, feegrant.AllowanceUsageRecordPrefixByGrant(granterAddr, granteeAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record feegrant.AllowanceUsageRecord
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowanceUsageHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -21,19 +21,29 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	authKeeper feegrant.AccountKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper, authority string) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		authKeeper: ak,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/feegrant module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feegrant.ModuleName))
@@ -249,6 +259,7 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		}
 
 		emitUseGrantEvent(ctx, granter.String(), grantee.String())
+		k.recordAllowanceUsage(ctx, granter, grantee, fee)
 
 		return nil
 	}
@@ -258,6 +269,7 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	}
 
 	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+	k.recordAllowanceUsage(ctx, granter, grantee, fee)
 
 	// if fee allowance is accepted, store the updated state of the allowance
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
@@ -295,6 +307,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, record := range data.AllowanceUsageRecords {
+		k.setAllowanceUsageRecord(ctx, record)
+	}
+
 	return nil
 }
 
@@ -307,9 +328,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		return false
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	var records []feegrant.AllowanceUsageRecord
+	k.IterateAllowanceUsageRecords(ctx, func(record feegrant.AllowanceUsageRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
 	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+		Allowances:            grants,
+		Params:                k.GetParams(ctx),
+		AllowanceUsageRecords: records,
+	}, nil
 }
 
 func (k Keeper) removeFromGrantQueue(ctx sdk.Context, exp *time.Time, allowanceKey []byte) {
@@ -337,3 +370,27 @@ func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context) {
 		store.Delete(feegrant.FeeAllowanceKey(granter, grantee))
 	}
 }
+
+// SetParams sets the x/feegrant module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params feegrant.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.ParamsKey, k.cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// GetParams returns the current x/feegrant module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feegrant.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
@@ -7,6 +7,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -76,3 +77,17 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// UpdateParams updates the x/feegrant module parameters.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *feegrant.MsgUpdateParams) (*feegrant.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgUpdateParamsResponse{}, nil
+}
@@ -269,3 +269,45 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *feegrant.MsgUpdateParams
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"error: invalid authority",
+			&feegrant.MsgUpdateParams{
+				Authority: suite.addrs[0].String(),
+				Params:    feegrant.NewParams(10),
+			},
+			true,
+			"invalid authority",
+		},
+		{
+			"valid params update",
+			&feegrant.MsgUpdateParams{
+				Authority: suite.feegrantKeeper.GetAuthority(),
+				Params:    feegrant.NewParams(10),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateParams(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.request.Params, suite.feegrantKeeper.GetParams(suite.ctx))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// recordAllowanceUsage stores the record of the use of the allowance granted
+// by granter to grantee to pay the fee of the current transaction. The fees
+// are summed up if the allowance is used several times in the transaction.
+func (k Keeper) recordAllowanceUsage(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) {
+	txHash := fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+	record := feegrant.NewAllowanceUsageRecord(granter, grantee, txHash, fee, ctx.BlockHeight())
+
+	bz := ctx.KVStore(k.storeKey).Get(feegrant.AllowanceUsageRecordKey(granter, grantee, record.Height, txHash))
+	if bz != nil {
+		var existing feegrant.AllowanceUsageRecord
+		k.cdc.MustUnmarshal(bz, &existing)
+		record.FeeUsed = record.FeeUsed.Add(existing.FeeUsed...)
+	}
+
+	k.setAllowanceUsageRecord(ctx, record)
+}
+
+// setAllowanceUsageRecord stores an allowance usage record and indexes it by
+// height for pruning.
+func (k Keeper) setAllowanceUsageRecord(ctx sdk.Context, record feegrant.AllowanceUsageRecord) {
+	granter := sdk.MustAccAddressFromBech32(record.Granter)
+	grantee := sdk.MustAccAddressFromBech32(record.Grantee)
+	key := feegrant.AllowanceUsageRecordKey(granter, grantee, record.Height, record.TxHash)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&record))
+	store.Set(feegrant.AllowanceUsageRecordHeightKey(record.Height, key[1:]), []byte{})
+}
+
+// IterateAllowanceUsageRecords iterates over all the allowance usage records
+// which are not pruned yet.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateAllowanceUsageRecords(ctx sdk.Context, cb func(record feegrant.AllowanceUsageRecord) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowanceUsageRecordKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record feegrant.AllowanceUsageRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// PruneAllowanceUsageRecords deletes the allowance usage records stored
+// AllowanceAuditRetention blocks ago, or before. The records are never pruned
+// if the param is zero.
+func (k Keeper) PruneAllowanceUsageRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).AllowanceAuditRetention
+	if retention == 0 || uint64(ctx.BlockHeight()) < retention {
+		return
+	}
+
+	pruneHeight := int64(uint64(ctx.BlockHeight()) - retention)
+	store := ctx.KVStore(k.storeKey)
+	heightStore := prefix.NewStore(store, feegrant.AllowanceUsageRecordHeightKeyPrefix)
+
+	// the keys of the height index are the heights, big endian encoded on 8
+	// bytes, followed by the keys of the records without their prefix
+	iterator := heightStore.Iterator(nil, sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(uint64(pruneHeight))))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		heightStore.Delete(iterator.Key())
+		store.Delete(append(feegrant.AllowanceUsageRecordKeyPrefix, iterator.Key()[8:]...))
+	}
+}
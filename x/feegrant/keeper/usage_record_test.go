@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func (suite *KeeperTestSuite) TestAllowanceUsageHistory() {
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	suite.grantFeeAllowance(suite.addrs[2], suite.addrs[1])
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	tx1, tx2 := []byte("tx1"), []byte("tx2")
+
+	ctx := suite.ctx.WithBlockHeight(10).WithTxBytes(tx1)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+
+	// the fees are summed up if the allowance is used several times in a tx
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+
+	// the failed uses are not recorded
+	hugeFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	suite.Require().Error(suite.feegrantKeeper.UseGrantedFees(ctx.WithTxBytes(tx2), suite.addrs[0], suite.addrs[1], hugeFee, []sdk.Msg{}))
+
+	ctx = suite.ctx.WithBlockHeight(11).WithTxBytes(tx2)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[2], suite.addrs[1], fee, []sdk.Msg{}))
+
+	record1 := feegrant.NewAllowanceUsageRecord(suite.addrs[0], suite.addrs[1], fmt.Sprintf("%X", tmhash.Sum(tx1)), fee.Add(fee...), 10)
+	record2 := feegrant.NewAllowanceUsageRecord(suite.addrs[0], suite.addrs[1], fmt.Sprintf("%X", tmhash.Sum(tx2)), fee, 11)
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryAllowanceUsageHistoryRequest
+		expectErr bool
+		expected  []feegrant.AllowanceUsageRecord
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"fail: invalid granter",
+			&feegrant.QueryAllowanceUsageHistoryRequest{
+				Granter: "invalid_granter",
+				Grantee: suite.addrs[1].String(),
+			},
+			true,
+			nil,
+		},
+		{
+			"valid query: oldest first",
+			&feegrant.QueryAllowanceUsageHistoryRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			[]feegrant.AllowanceUsageRecord{record1, record2},
+		},
+		{
+			"valid query: paginated",
+			&feegrant.QueryAllowanceUsageHistoryRequest{
+				Granter:    suite.addrs[0].String(),
+				Grantee:    suite.addrs[1].String(),
+				Pagination: &query.PageRequest{Offset: 1},
+			},
+			false,
+			[]feegrant.AllowanceUsageRecord{record2},
+		},
+		{
+			"valid query: no uses",
+			&feegrant.QueryAllowanceUsageHistoryRequest{
+				Granter: suite.addrs[1].String(),
+				Grantee: suite.addrs[0].String(),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, err := suite.feegrantKeeper.AllowanceUsageHistory(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, resp.Records)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneAllowanceUsageRecords() {
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+
+	for height := int64(1); height <= 3; height++ {
+		ctx := suite.ctx.WithBlockHeight(height).WithTxBytes([]byte{byte(height)})
+		suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+	}
+
+	history := func() []int64 {
+		resp, err := suite.feegrantKeeper.AllowanceUsageHistory(suite.ctx, &feegrant.QueryAllowanceUsageHistoryRequest{
+			Granter: suite.addrs[0].String(),
+			Grantee: suite.addrs[1].String(),
+		})
+		suite.Require().NoError(err)
+
+		var heights []int64
+		for _, record := range resp.Records {
+			heights = append(heights, record.Height)
+		}
+		return heights
+	}
+
+	// the records are never pruned with a zero retention
+	suite.Require().NoError(suite.feegrantKeeper.SetParams(suite.ctx, feegrant.NewParams(0)))
+	suite.feegrantKeeper.PruneAllowanceUsageRecords(suite.ctx.WithBlockHeight(100))
+	suite.Require().Equal([]int64{1, 2, 3}, history())
+
+	// the records are pruned once the retention elapsed
+	suite.Require().NoError(suite.feegrantKeeper.SetParams(suite.ctx, feegrant.NewParams(10)))
+	suite.feegrantKeeper.PruneAllowanceUsageRecords(suite.ctx.WithBlockHeight(11))
+	suite.Require().Equal([]int64{2, 3}, history())
+
+	suite.feegrantKeeper.PruneAllowanceUsageRecords(suite.ctx.WithBlockHeight(13))
+	suite.Require().Empty(history())
+
+	genesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(genesis.AllowanceUsageRecords)
+}
@@ -29,6 +29,17 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// AllowanceUsageRecordKeyPrefix is the set of the kvstore for allowance usage records
+	// - 0x02<allowance_usage_record_key_bytes>: allowance usage record
+	AllowanceUsageRecordKeyPrefix = []byte{0x02}
+
+	// AllowanceUsageRecordHeightKeyPrefix is the set of the kvstore for allowance usage record keys indexed by height
+	// - 0x03<height_bytes><allowance_usage_record_key_bytes>: <empty value>
+	AllowanceUsageRecordHeightKeyPrefix = []byte{0x03}
+
+	// ParamsKey is the key of the module parameters
+	ParamsKey = []byte{0x04}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -66,6 +77,44 @@ func AllowanceByExpTimeKey(exp *time.Time) []byte {
 	return append(FeeAllowanceQueueKeyPrefix, sdk.FormatTimeBytes(*exp)...)
 }
 
+// AllowanceUsageRecordKey is the canonical key to store the record of a use of
+// the allowance granted by granter to grantee. The records of an allowance are
+// ordered by height.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes><len(grantee_address_bytes)><grantee_address_bytes><height_bytes><tx_hash>
+func AllowanceUsageRecordKey(granter, grantee sdk.AccAddress, height int64, txHash string) []byte {
+	key := append(AllowanceUsageRecordPrefixByGrant(granter, grantee), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, txHash...)
+}
+
+// AllowanceUsageRecordPrefixByGrant returns a prefix to scan for all the
+// records of the uses of the allowance granted by granter to grantee.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes><len(grantee_address_bytes)><grantee_address_bytes>
+func AllowanceUsageRecordPrefixByGrant(granter, grantee sdk.AccAddress) []byte {
+	key := append(AllowanceUsageRecordKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+	return append(key, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// AllowanceUsageRecordHeightKey is the key to index the record of a use of an
+// allowance by height. `key` is the allowance usage record key without its prefix.
+//
+// Key format:
+// - <0x03><height_bytes><len(granter_address_bytes)><granter_address_bytes><len(grantee_address_bytes)><grantee_address_bytes><height_bytes><tx_hash>
+func AllowanceUsageRecordHeightKey(height int64, key []byte) []byte {
+	return append(AllowanceUsageRecordByHeightKey(height), key...)
+}
+
+// AllowanceUsageRecordByHeightKey returns a key with `AllowanceUsageRecordHeightKeyPrefix`, height
+//
+// Key format:
+// - <0x03><height_bytes>
+func AllowanceUsageRecordByHeightKey(height int64) []byte {
+	return append(AllowanceUsageRecordHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // ParseAddressesFromFeeAllowanceKey extracts and returns the granter, grantee from the given key.
 func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
 	// key is of format:
@@ -0,0 +1,8 @@
+package v3
+
+var (
+	ModuleName = "feegrant"
+
+	// ParamsKey is the key of the module parameters
+	ParamsKey = []byte{0x04}
+)
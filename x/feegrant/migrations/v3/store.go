@@ -0,0 +1,23 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// MigrateStore migrates the x/feegrant module state from the consensus version
+// 2 to version 3. Specifically, it stores the default parameters, which
+// introduce the retention of the allowance usage records.
+func MigrateStore(ctx types.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	params := feegrant.DefaultParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
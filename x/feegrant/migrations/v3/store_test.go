@@ -0,0 +1,30 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	v3 "github.com/cosmos/cosmos-sdk/x/feegrant/migrations/v3"
+	feegranttestutil "github.com/cosmos/cosmos-sdk/x/feegrant/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigration(t *testing.T) {
+	var cdc codec.Codec
+	depinject.Inject(feegranttestutil.AppConfig, &cdc)
+
+	feegrantKey := sdk.NewKVStoreKey(v3.ModuleName)
+	ctx := testutil.DefaultContext(feegrantKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(feegrantKey)
+	require.Nil(t, store.Get(v3.ParamsKey))
+
+	require.NoError(t, v3.MigrateStore(ctx, feegrantKey, cdc))
+
+	var params feegrant.Params
+	cdc.MustUnmarshal(store.Get(v3.ParamsKey), &params)
+	require.Equal(t, feegrant.DefaultParams(), params)
+}
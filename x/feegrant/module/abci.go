@@ -7,4 +7,5 @@ import (
 
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.RemoveExpiredAllowances(ctx)
+	k.PruneAllowanceUsageRecords(ctx)
 }
@@ -134,6 +134,17 @@ func TestMsgRevokeAllowance(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateParams(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+
+	msg := feegrant.MsgUpdateParams{Authority: addr.String(), Params: feegrant.DefaultParams()}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	msg.Authority = "invalid"
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	var msg legacytx.LegacyMsg
@@ -160,4 +171,11 @@ func TestAminoJSON(t *testing.T) {
 		`{"account_number":"1","chain_id":"foo","fee":{"amount":[],"gas":"0"},"memo":"memo","msgs":[{"type":"cosmos-sdk/MsgRevokeAllowance","value":{"grantee":"cosmos1def","granter":"cosmos1abc"}}],"sequence":"1","timeout_height":"1"}`,
 		string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{msg}, "memo", nil)),
 	)
+
+	msg = &feegrant.MsgUpdateParams{Authority: "cosmos1abc", Params: feegrant.NewParams(10)}
+	tx.Msgs = []sdk.Msg{msg}
+	require.Equal(t,
+		`{"account_number":"1","chain_id":"foo","fee":{"amount":[],"gas":"0"},"memo":"memo","msgs":[{"type":"cosmos-sdk/x/feegrant/MsgUpdateParams","value":{"authority":"cosmos1abc","params":{"allowance_audit_retention":"10"}}}],"sequence":"1","timeout_height":"1"}`,
+		string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{msg}, "memo", nil)),
+	)
 }
@@ -0,0 +1,23 @@
+package feegrant
+
+// DefaultAllowanceAuditRetention is the default number of blocks the allowance
+// usage records are kept for.
+const DefaultAllowanceAuditRetention uint64 = 100_000
+
+// NewParams creates a new Params instance.
+func NewParams(allowanceAuditRetention uint64) Params {
+	return Params{
+		AllowanceAuditRetention: allowanceAuditRetention,
+	}
+}
+
+// DefaultParams returns the default x/feegrant module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultAllowanceAuditRetention)
+}
+
+// Validate validates the set of params. Any retention is valid, zero disabling
+// the pruning of the allowance usage records.
+func (p Params) Validate() error {
+	return nil
+}
@@ -19,6 +19,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], feegrant.AllowanceUsageRecordKeyPrefix):
+			var recordA, recordB feegrant.AllowanceUsageRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], feegrant.ParamsKey):
+			var paramsA, paramsB feegrant.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
@@ -36,9 +36,19 @@ func TestDecodeStore(t *testing.T) {
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
 
+	record := feegrant.NewAllowanceUsageRecord(granterAddr, granteeAddr, "AB", sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10))), 1)
+	recordBz, err := cdc.Marshal(&record)
+	require.NoError(t, err)
+
+	params := feegrant.DefaultParams()
+	paramsBz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(feegrant.FeeAllowanceKeyPrefix), Value: grantBz},
+			{Key: feegrant.AllowanceUsageRecordKey(granterAddr, granteeAddr, 1, "AB"), Value: recordBz},
+			{Key: feegrant.ParamsKey, Value: paramsBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -48,6 +58,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"AllowanceUsageRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
 		{"other", ""},
 	}
 
@@ -10,6 +10,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
+// Simulation parameter constants
+const (
+	allowanceAuditRetention = "allowance_audit_retention"
+)
+
+// genAllowanceAuditRetention returns a randomized retention of the allowance
+// usage records, zero disabling the pruning.
+func genAllowanceAuditRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
 // genFeeGrants returns a slice of randomly generated allowances.
 func genFeeGrants(r *rand.Rand, accounts []simtypes.Account) []feegrant.Grant {
 	allowances := make([]feegrant.Grant, len(accounts)-1)
@@ -67,7 +78,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { feegrants = genFeeGrants(r, simState.Accounts) },
 	)
 
-	feegrantGenesis := feegrant.NewGenesisState(feegrants)
+	var retention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, allowanceAuditRetention, &retention, simState.Rand,
+		func(r *rand.Rand) { retention = genAllowanceAuditRetention(r) },
+	)
+
+	feegrantGenesis := feegrant.NewGenesisState(feegrant.NewParams(retention), feegrants)
 	bz, err := simState.Cdc.MarshalJSON(feegrantGenesis)
 	if err != nil {
 		panic(err)
@@ -38,4 +38,5 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[feegrant.ModuleName], &feegrantGenesis)
 
 	require.Len(t, feegrantGenesis.Allowances, len(accounts)-1)
+	require.Less(t, feegrantGenesis.Params.AllowanceAuditRetention, uint64(100))
 }
@@ -0,0 +1,36 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAllowanceUsageRecord creates the record of the use of the allowance
+// granted by granter to grantee to pay the fee of a transaction.
+func NewAllowanceUsageRecord(granter, grantee sdk.AccAddress, txHash string, feeUsed sdk.Coins, height int64) AllowanceUsageRecord {
+	return AllowanceUsageRecord{
+		TxHash:  txHash,
+		FeeUsed: feeUsed,
+		Height:  height,
+		Grantee: grantee.String(),
+		Granter: granter.String(),
+	}
+}
+
+// Validate performs a basic validation of the allowance usage record.
+func (r AllowanceUsageRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if r.TxHash == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty tx hash")
+	}
+	if r.Height < 0 {
+		return sdkerrors.ErrInvalidHeight.Wrapf("negative height %d", r.Height)
+	}
+
+	return r.FeeUsed.Validate()
+}
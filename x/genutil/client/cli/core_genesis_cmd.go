@@ -11,7 +11,8 @@ import (
 )
 
 // GenesisCoreCommand adds core sdk's sub-commands into genesis command:
-// -> gentx, migrate, collect-gentxs, validate-genesis, add-genesis-account
+// -> gentx, migrate, collect-gentxs, validate-genesis, add-genesis-account,
+// migrate-vesting-accounts
 func GenesisCoreCommand(txConfig client.TxConfig, moduleBasics module.BasicManager, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "genesis",
@@ -30,6 +31,7 @@ func GenesisCoreCommand(txConfig client.TxConfig, moduleBasics module.BasicManag
 			gentxModule.GenTxValidator),
 		ValidateGenesisCmd(moduleBasics),
 		AddGenesisAccountCmd(defaultNodeHome),
+		MigrateVestingAccountsCmd(defaultNodeHome),
 	)
 
 	return cmd
@@ -3,46 +3,34 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
-	"sort"
 	"time"
 
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"golang.org/x/exp/maps"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
-	v043 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v043"
-	v046 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v046"
-	v047 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const flagGenesisTime = "genesis-time"
-
-// Allow applications to extend and modify the migration process.
-//
-// Ref: https://github.com/cosmos/cosmos-sdk/issues/5041
-var migrationMap = types.MigrationMap{
-	"v0.43": v043.Migrate, // NOTE: v0.43, v0.44 and v0.45 are genesis compatible.
-	"v0.46": v046.Migrate,
-	"v0.47": v047.Migrate,
-}
+const (
+	flagGenesisTime = "genesis-time"
+	flagFrom        = "from"
+	flagTo          = "to"
+)
 
 // GetMigrationCallback returns a MigrationCallback for a given version.
 func GetMigrationCallback(version string) types.MigrationCallback {
-	return migrationMap[version]
+	return genutil.GetMigrationCallback(version)
 }
 
 // GetMigrationVersions get all migration version in a sorted slice.
 func GetMigrationVersions() []string {
-	versions := maps.Keys(migrationMap)
-	sort.Strings(versions)
-
-	return versions
+	return genutil.GetMigrationVersions()
 }
 
 // MigrateGenesisCmd returns a command to execute genesis state migration.
@@ -52,17 +40,24 @@ func MigrateGenesisCmd() *cobra.Command {
 		Short: "Migrate genesis to a specified target version",
 		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
 
+When --from is set, every registered migration after the source version up to
+and including the target version is applied in order. The target version can
+also be given with --to, in which case the genesis file is the only argument.
+
 Example:
 $ %s migrate v0.36 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=2019-04-22T17:00:00Z
-`, version.AppName),
-		Args: cobra.ExactArgs(2),
+$ %s migrate --from v0.46 --to v0.47 /path/to/genesis.json
+`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return MigrateHandler(cmd, args, migrationMap)
+			return MigrateHandler(cmd, args, genutil.GetMigrationMap())
 		},
 	}
 
 	cmd.Flags().String(flagGenesisTime, "", "override genesis_time with this flag")
 	cmd.Flags().String(flags.FlagChainID, "", "override chain_id with this flag")
+	cmd.Flags().String(flagFrom, "", "source version of the genesis; all migrations up to the target version are applied")
+	cmd.Flags().String(flagTo, "", "target version of the genesis, replaces the [target-version] argument")
 
 	return cmd
 }
@@ -74,8 +69,18 @@ func MigrateHandler(cmd *cobra.Command, args []string, migrations types.Migratio
 
 	var err error
 
-	target := args[0]
-	importGenesis := args[1]
+	from, _ := cmd.Flags().GetString(flagFrom)
+	target, _ := cmd.Flags().GetString(flagTo)
+
+	var importGenesis string
+	switch {
+	case target != "" && len(args) == 1:
+		importGenesis = args[0]
+	case target == "" && len(args) == 2:
+		target, importGenesis = args[0], args[1]
+	default:
+		return fmt.Errorf("expected [target-version] [genesis-file] arguments, or a single [genesis-file] argument when --%s is set", flagTo)
+	}
 
 	genDoc, err := validateGenDoc(importGenesis)
 	if err != nil {
@@ -95,13 +100,21 @@ func MigrateHandler(cmd *cobra.Command, args []string, migrations types.Migratio
 		return errors.Wrap(err, "failed to JSON unmarshal initial genesis state")
 	}
 
-	migrationFunc := migrations[target]
-	if migrationFunc == nil {
-		return fmt.Errorf("unknown migration function for version: %s", target)
-	}
+	var newGenState types.AppMap
+	if from != "" {
+		newGenState, err = genutil.MigrateAppState(clientCtx, initialState, migrations, from, target)
+		if err != nil {
+			return err
+		}
+	} else {
+		migrationFunc := migrations[target]
+		if migrationFunc == nil {
+			return fmt.Errorf("unknown migration function for version: %s", target)
+		}
 
-	// TODO: handler error from migrationFunc call
-	newGenState := migrationFunc(initialState, clientCtx)
+		// TODO: handler error from migrationFunc call
+		newGenState = migrationFunc(initialState, clientCtx)
+	}
 
 	genDoc.AppState, err = json.Marshal(newGenState)
 	if err != nil {
@@ -20,7 +20,7 @@ func (s *CLITestSuite) TestMigrateGenesis() {
 	testCases := []struct {
 		name      string
 		genesis   string
-		target    string
+		args      []string
 		expErr    bool
 		expErrMsg string
 		check     func(jsonOut string)
@@ -28,26 +28,47 @@ func (s *CLITestSuite) TestMigrateGenesis() {
 		{
 			"migrate 0.37 to 0.42",
 			v037Exported,
-			"v0.42",
+			[]string{"v0.42"},
 			true, "Make sure that you have correctly migrated all Tendermint consensus params", func(_ string) {},
 		},
 		{
 			"migrate 0.42 to 0.43",
 			v040Valid,
-			"v0.43",
+			[]string{"v0.43"},
 			false, "",
 			func(jsonOut string) {
 				// Make sure the json output contains the ADR-037 gov weighted votes.
 				s.Require().Contains(jsonOut, "\"weight\":\"1.000000000000000000\"")
 			},
 		},
+		{
+			"migrate 0.42 to 0.43 with source and target flags",
+			v040Valid,
+			[]string{"--from=v0.42", "--to=v0.43"},
+			false, "",
+			func(jsonOut string) {
+				s.Require().Contains(jsonOut, "\"weight\":\"1.000000000000000000\"")
+			},
+		},
+		{
+			"target flag with positional target version",
+			v040Valid,
+			[]string{"--to=v0.43", "v0.43"},
+			true, "expected [target-version] [genesis-file]", func(_ string) {},
+		},
+		{
+			"source version not lower than target version",
+			v040Valid,
+			[]string{"--from=v0.46", "v0.43"},
+			true, "must be lower than target version", func(_ string) {},
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
 			genesisFile := testutil.WriteToNewTempFile(s.T(), tc.genesis)
-			jsonOutput, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.MigrateGenesisCmd(), []string{tc.target, genesisFile.Name()})
+			jsonOutput, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.MigrateGenesisCmd(), append(tc.args, genesisFile.Name()))
 			if tc.expErr {
 				s.Require().Contains(err.Error(), tc.expErrMsg)
 			} else {
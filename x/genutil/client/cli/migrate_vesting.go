@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const flagChecksum = "checksum"
+
+// MigrateVestingAccountsCmd returns a command to convert genesis base accounts
+// to clawback vesting accounts in bulk.
+func MigrateVestingAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-vesting-accounts [schedule-file]",
+		Short: "Convert genesis base accounts to clawback vesting accounts",
+		Long: `Convert the genesis base accounts listed in the schedule file to clawback vesting
+accounts vesting their whole genesis balance. The schedule file is a JSON list of entries:
+
+[{"address": "cosmos1...", "start_time": 1700000000, "end_time": 1800000000, "funder": "cosmos1..."}]
+
+If --checksum is provided, it must match the SHA-256 checksum of the genesis file.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			scheduleBz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read vesting schedule: %w", err)
+			}
+
+			var schedule []types.VestingEntry
+			if err := json.Unmarshal(scheduleBz, &schedule); err != nil {
+				return fmt.Errorf("failed to parse vesting schedule: %w", err)
+			}
+
+			checksum, _ := cmd.Flags().GetString(flagChecksum)
+
+			return genutil.MigrateBaseAccountsToVesting(clientCtx.Codec, config.GenesisFile(), checksum, schedule)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagChecksum, "", "expected SHA-256 checksum (hex) of the genesis file")
+
+	return cmd
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+)
+
+// InvariantsApp defines the application methods required to assert the
+// registered module invariants against a genesis state.
+type InvariantsApp interface {
+	servertypes.Application
+
+	NewContext(isCheckTx bool, header tmproto.Header) sdk.Context
+	RegisterInvariants(ir sdk.InvariantRegistry)
+}
+
+// InvariantResult is the outcome of asserting a single invariant.
+type InvariantResult struct {
+	Route   string
+	Message string
+	Broken  bool
+}
+
+// invariantRoutes collects the invariants registered by the app modules.
+type invariantRoutes struct {
+	routes []string
+	invars []sdk.Invariant
+}
+
+var _ sdk.InvariantRegistry = (*invariantRoutes)(nil)
+
+func (ir *invariantRoutes) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, fmt.Sprintf("%s/%s", moduleName, route))
+	ir.invars = append(ir.invars, invar)
+}
+
+// ValidateInvariantsCmd loads a genesis file into an in-memory application and
+// asserts every invariant registered by its modules.
+func ValidateInvariantsCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-invariants [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "loads the genesis file in an in-memory store and asserts all registered module invariants",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			// Load default if passed no args, otherwise load passed file
+			var genesis string
+			if len(args) == 0 {
+				genesis = serverCtx.Config.GenesisFile()
+			} else {
+				genesis = args[0]
+			}
+
+			genDoc, err := validateGenDoc(genesis)
+			if err != nil {
+				return err
+			}
+
+			// the app must be created for the chain of the genesis file being validated,
+			// and x/crisis must not halt on the first broken invariant as all of them
+			// are reported below.
+			serverCtx.Viper.Set(flags.FlagChainID, genDoc.ChainID)
+			serverCtx.Viper.Set(crisis.FlagSkipGenesisInvariants, true)
+
+			app, ok := appCreator(serverCtx.Logger, dbm.NewMemDB(), nil, serverCtx.Viper).(InvariantsApp)
+			if !ok {
+				return fmt.Errorf("application does not support invariant assertion")
+			}
+
+			results, err := ValidateGenesisInvariants(app, genDoc)
+			if err != nil {
+				return fmt.Errorf("error loading genesis file %s: %w", genesis, err)
+			}
+
+			var broken int
+			for _, res := range results {
+				if !res.Broken {
+					cmd.Printf("PASS %s\n", res.Route)
+					continue
+				}
+
+				broken++
+				cmd.Printf("FAIL %s\n%s\n", res.Route, res.Message)
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d of %d invariants broken in genesis file %s", broken, len(results), genesis)
+			}
+
+			cmd.Printf("All %d invariants hold for genesis file %s\n", len(results), genesis)
+			return nil
+		},
+	}
+}
+
+// ValidateGenesisInvariants initializes the application from the given genesis
+// document and asserts all invariants registered by its modules. An error is
+// returned if the genesis cannot be loaded.
+func ValidateGenesisInvariants(app InvariantsApp, genDoc *tmtypes.GenesisDoc) ([]InvariantResult, error) {
+	if err := initChain(app, genDoc); err != nil {
+		return nil, err
+	}
+
+	ctx := app.NewContext(false, tmproto.Header{
+		ChainID: genDoc.ChainID,
+		Height:  genDoc.InitialHeight,
+		Time:    genDoc.GenesisTime,
+	})
+
+	registry := &invariantRoutes{}
+	app.RegisterInvariants(registry)
+
+	results := make([]InvariantResult, len(registry.routes))
+	for i, invar := range registry.invars {
+		msg, broken := invar(ctx)
+		results[i] = InvariantResult{Route: registry.routes[i], Message: msg, Broken: broken}
+	}
+
+	return results, nil
+}
+
+// initChain runs InitChain on the application with the given genesis document,
+// converting any panic raised while loading the genesis state into an error.
+func initChain(app InvariantsApp, genDoc *tmtypes.GenesisDoc) (err error) {
+	validators := make([]*tmtypes.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = tmtypes.NewValidator(val.PubKey, val.Power)
+	}
+
+	consensusParams := genDoc.ConsensusParams.ToProto()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to initialize chain: %v", r)
+		}
+	}()
+
+	app.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: &consensusParams,
+		Validators:      tmtypes.TM2PB.ValidatorUpdates(tmtypes.NewValidatorSet(validators)),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+
+	return nil
+}
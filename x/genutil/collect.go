@@ -17,6 +17,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -97,6 +98,11 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
 
+	// gentx file names by validator consensus public key and operator address,
+	// used to detect gentxs that would overwrite each other
+	pubKeyFiles := make(map[string]string)
+	valAddrFiles := make(map[string]string)
+
 	for _, fo := range fos {
 		if fo.IsDir() {
 			continue
@@ -141,6 +147,25 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 			return appGenTxs, persistentPeers, err
 		}
 
+		pubKey, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return appGenTxs, persistentPeers, fmt.Errorf("expected cryptotypes.PubKey in %s, got %T", fo.Name(), msg.Pubkey.GetCachedValue())
+		}
+
+		if file, found := pubKeyFiles[pubKey.String()]; found {
+			return appGenTxs, persistentPeers, fmt.Errorf(
+				"duplicate validator consensus public key %s in gentx files %s and %s", pubKey, file, fo.Name(),
+			)
+		}
+		pubKeyFiles[pubKey.String()] = fo.Name()
+
+		if file, found := valAddrFiles[msg.ValidatorAddress]; found {
+			return appGenTxs, persistentPeers, fmt.Errorf(
+				"duplicate validator operator address %s in gentx files %s and %s", msg.ValidatorAddress, file, fo.Name(),
+			)
+		}
+		valAddrFiles[msg.ValidatorAddress] = fo.Name()
+
 		delBal, delOk := balancesMap[delAddr]
 		if !delOk {
 			_, file, no, ok := runtime.Caller(1)
@@ -7,16 +7,24 @@ import (
 	"testing"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	gtypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type doNothingUnmarshalJSON struct {
@@ -34,6 +42,19 @@ type doNothingIterator struct {
 func (dni *doNothingIterator) IterateGenesisBalances(_ codec.JSONCodec, _ map[string]json.RawMessage, _ func(bankexported.GenesisBalance) bool) {
 }
 
+type balancesIterator struct {
+	gtypes.GenesisBalancesIterator
+	balances []banktypes.Balance
+}
+
+func (bi *balancesIterator) IterateGenesisBalances(_ codec.JSONCodec, _ map[string]json.RawMessage, cb func(bankexported.GenesisBalance) bool) {
+	for _, balance := range bi.balances {
+		if cb(balance) {
+			break
+		}
+	}
+}
+
 // Ensures that CollectTx correctly traverses directories and won't error out on encountering
 // a directory during traversal of the first level. See issue https://github.com/cosmos/cosmos-sdk/issues/6788.
 func TestCollectTxsHandlesDirectories(t *testing.T) {
@@ -65,3 +86,84 @@ func TestCollectTxsHandlesDirectories(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCollectTxsDuplicates(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(genutil.AppModuleBasic{})
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	pk1 := secp256k1.GenPrivKey().PubKey()
+	pk2 := secp256k1.GenPrivKey().PubKey()
+	pk3 := secp256k1.GenPrivKey().PubKey()
+	valAddr1 := types.ValAddress(pk1.Address())
+	valAddr2 := types.ValAddress(pk2.Address())
+
+	amount := types.NewInt64Coin(types.DefaultBondDenom, 50)
+	coins := types.NewCoins(types.NewInt64Coin(types.DefaultBondDenom, 1000))
+	balItr := &balancesIterator{balances: []banktypes.Balance{
+		{Address: types.AccAddress(valAddr1).String(), Coins: coins},
+		{Address: types.AccAddress(valAddr2).String(), Coins: coins},
+	}}
+
+	newGenTx := func(valAddr types.ValAddress, pubKey cryptotypes.PubKey) []byte {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, pubKey, amount, stakingtypes.NewDescription(valAddr.String(), "", "", "", ""),
+			stakingtypes.CommissionRates{}, math.OneInt())
+		require.NoError(t, err)
+
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		bz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name   string
+		genTxs map[string][]byte
+		expErr string
+	}{
+		{
+			"distinct validators",
+			map[string][]byte{
+				"gentx-1.json": newGenTx(valAddr1, pk1),
+				"gentx-2.json": newGenTx(valAddr2, pk2),
+			},
+			"",
+		},
+		{
+			"duplicate consensus public key",
+			map[string][]byte{
+				"gentx-1.json": newGenTx(valAddr1, pk1),
+				"gentx-2.json": newGenTx(valAddr2, pk1),
+			},
+			"duplicate validator consensus public key " + pk1.String() + " in gentx files gentx-1.json and gentx-2.json",
+		},
+		{
+			"duplicate operator address",
+			map[string][]byte{
+				"gentx-1.json": newGenTx(valAddr1, pk1),
+				"gentx-2.json": newGenTx(valAddr1, pk3),
+			},
+			"duplicate validator operator address " + valAddr1.String() + " in gentx files gentx-1.json and gentx-2.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			for name, bz := range tc.genTxs {
+				require.NoError(t, os.WriteFile(filepath.Join(testDir, name), bz, 0o600))
+			}
+
+			gdoc := tmtypes.GenesisDoc{AppState: []byte("{}")}
+			appGenTxs, _, err := genutil.CollectTxs(encCfg.Codec, encCfg.TxConfig.TxJSONDecoder(), "foo", testDir, gdoc, balItr, gtypes.DefaultMessageValidator)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, appGenTxs, len(tc.genTxs))
+		})
+	}
+}
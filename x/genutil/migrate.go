@@ -0,0 +1,121 @@
+package genutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"golang.org/x/exp/maps"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v043 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v043"
+	v046 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// migrationMap defines the genesis migrations known to the SDK, keyed by the
+// version they migrate to. Applications may extend it via RegisterMigration.
+//
+// Ref: https://github.com/cosmos/cosmos-sdk/issues/5041
+var migrationMap = types.MigrationMap{
+	"v0.43": v043.Migrate, // NOTE: v0.43, v0.44 and v0.45 are genesis compatible.
+	"v0.46": v046.Migrate,
+	"v0.47": v047.Migrate,
+}
+
+// RegisterMigration registers a genesis migration to the given target version.
+// It returns an error if a migration is already registered for that version.
+func RegisterMigration(version string, callback types.MigrationCallback) error {
+	if callback == nil {
+		return fmt.Errorf("nil migration callback for version %s", version)
+	}
+
+	if _, ok := migrationMap[version]; ok {
+		return fmt.Errorf("genesis migration for version %s already registered", version)
+	}
+
+	migrationMap[version] = callback
+	return nil
+}
+
+// GetMigrationMap returns a copy of all registered genesis migrations.
+func GetMigrationMap() types.MigrationMap {
+	return maps.Clone(migrationMap)
+}
+
+// GetMigrationCallback returns a MigrationCallback for a given version.
+func GetMigrationCallback(version string) types.MigrationCallback {
+	return migrationMap[version]
+}
+
+// GetMigrationVersions get all migration version in a sorted slice.
+func GetMigrationVersions() []string {
+	versions := maps.Keys(migrationMap)
+	sort.Strings(versions)
+
+	return versions
+}
+
+// MigrateAppState applies, in version order, every migration of the given map
+// whose target version is strictly greater than fromVersion and lower or equal
+// to toVersion. An error is returned if toVersion has no registered migration.
+func MigrateAppState(
+	clientCtx client.Context, appState types.AppMap, migrations types.MigrationMap, fromVersion, toVersion string,
+) (types.AppMap, error) {
+	if _, ok := migrations[toVersion]; !ok {
+		return nil, fmt.Errorf("unknown migration function for version: %s", toVersion)
+	}
+
+	if fromVersion >= toVersion {
+		return nil, fmt.Errorf("source version %s must be lower than target version %s", fromVersion, toVersion)
+	}
+
+	versions := maps.Keys(migrations)
+	sort.Strings(versions)
+
+	for _, version := range versions {
+		if version <= fromVersion || version > toVersion {
+			continue
+		}
+
+		appState = migrations[version](appState, clientCtx)
+	}
+
+	return appState, nil
+}
+
+// MigrateGenesis reads the genesis file at genesisPath and migrates its
+// application state from fromVersion to toVersion using the registered
+// genesis migrations. It returns the migrated genesis document as sorted JSON.
+func MigrateGenesis(clientCtx client.Context, fromVersion, toVersion, genesisPath string) (json.RawMessage, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis doc from file %s: %w", genesisPath, err)
+	}
+
+	var appState types.AppMap
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return nil, fmt.Errorf("failed to JSON unmarshal initial genesis state: %w", err)
+	}
+
+	appState, err = MigrateAppState(clientCtx, appState, migrationMap, fromVersion, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	genDoc.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to JSON marshal migrated genesis state: %w", err)
+	}
+
+	bz, err := tmjson.Marshal(genDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal genesis doc: %w", err)
+	}
+
+	return sdk.SortJSON(bz)
+}
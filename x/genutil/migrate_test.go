@@ -0,0 +1,60 @@
+package genutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestMigrateAppState(t *testing.T) {
+	var applied []string
+	migration := func(version string) types.MigrationCallback {
+		return func(appState types.AppMap, _ client.Context) types.AppMap {
+			applied = append(applied, version)
+			return appState
+		}
+	}
+
+	migrations := types.MigrationMap{
+		"v0.43": migration("v0.43"),
+		"v0.46": migration("v0.46"),
+		"v0.47": migration("v0.47"),
+	}
+
+	testCases := []struct {
+		name       string
+		from, to   string
+		expErr     string
+		expApplied []string
+	}{
+		{"single migration", "v0.46", "v0.47", "", []string{"v0.47"}},
+		{"chained migrations", "v0.42", "v0.47", "", []string{"v0.43", "v0.46", "v0.47"}},
+		{"stop at target version", "v0.42", "v0.46", "", []string{"v0.43", "v0.46"}},
+		{"unknown target version", "v0.46", "v0.48", "unknown migration function", nil},
+		{"source equals target", "v0.47", "v0.47", "must be lower than target version", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			applied = nil
+			_, err := genutil.MigrateAppState(client.Context{}, types.AppMap{}, migrations, tc.from, tc.to)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expApplied, applied)
+		})
+	}
+}
+
+func TestRegisterMigration(t *testing.T) {
+	require.Error(t, genutil.RegisterMigration("v0.47", func(appState types.AppMap, _ client.Context) types.AppMap { return appState }))
+	require.Error(t, genutil.RegisterMigration("v0.99", nil))
+	require.Contains(t, genutil.GetMigrationVersions(), "v0.46")
+}
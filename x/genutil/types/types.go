@@ -43,3 +43,16 @@ func NewInitConfig(chainID, genTxsDir, nodeID string, valPubKey cryptotypes.PubK
 		ValPubKey: valPubKey,
 	}
 }
+
+// VestingEntry defines the clawback vesting schedule applied to a genesis
+// account when migrating it to a ClawbackVestingAccount.
+type VestingEntry struct {
+	// Address is the bech32 address of the genesis account to convert.
+	Address string `json:"address"`
+	// StartTime is the vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `json:"start_time"`
+	// EndTime is the vesting end time, as unix timestamp (in seconds).
+	EndTime int64 `json:"end_time"`
+	// Funder is the bech32 address which can claw back the vesting coins.
+	Funder string `json:"funder"`
+}
@@ -0,0 +1,135 @@
+package genutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// genesisChecksum returns the hex encoded SHA-256 checksum of the given genesis
+// file content.
+func genesisChecksum(bz []byte) string {
+	sum := sha256.Sum256(bz)
+	return hex.EncodeToString(sum[:])
+}
+
+// MigrateBaseAccountsToVesting converts the genesis BaseAccounts listed in
+// vestingSchedule to ClawbackVestingAccounts vesting their whole genesis
+// balance. If checksum is not empty, it must match the SHA-256 checksum of the
+// genesis file. The modified genesis is written to a temporary file which then
+// atomically replaces the original one, unless the original file was modified
+// in the meantime.
+func MigrateBaseAccountsToVesting(cdc codec.Codec, genesisPath, checksum string, vestingSchedule []types.VestingEntry) error {
+	genesisBz, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return fmt.Errorf("failed to read genesis file: %w", err)
+	}
+
+	inputChecksum := genesisChecksum(genesisBz)
+	if checksum != "" && checksum != inputChecksum {
+		return fmt.Errorf("genesis file checksum mismatch: expected %s, got %s", checksum, inputChecksum)
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromJSON(genesisBz)
+	if err != nil {
+		return fmt.Errorf("failed to read genesis doc: %w", err)
+	}
+
+	appState, err := types.GenesisStateFromGenDoc(*genDoc)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+
+	accIndexes := make(map[string]int, len(accs))
+	for i, acc := range accs {
+		accIndexes[acc.GetAddress().String()] = i
+	}
+
+	for _, entry := range vestingSchedule {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return fmt.Errorf("invalid vesting entry address %s: %w", entry.Address, err)
+		}
+
+		funder, err := sdk.AccAddressFromBech32(entry.Funder)
+		if err != nil {
+			return fmt.Errorf("invalid funder address for %s: %w", entry.Address, err)
+		}
+
+		i, found := accIndexes[addr.String()]
+		if !found {
+			return fmt.Errorf("account %s not found in genesis state", addr)
+		}
+
+		baseAcc, ok := accs[i].(*authtypes.BaseAccount)
+		if !ok {
+			return fmt.Errorf("account %s is a %T, expected a base account", addr, accs[i])
+		}
+
+		vestingAcc := authvesting.NewClawbackVestingAccount(baseAcc, balances[addr.String()], entry.StartTime, entry.EndTime, funder)
+		if err := vestingAcc.Validate(); err != nil {
+			return fmt.Errorf("failed to validate vesting account %s: %w", addr, err)
+		}
+
+		accs[i] = vestingAcc
+	}
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+	genDoc.AppState = appStateJSON
+
+	tmpPath := genesisPath + ".tmp"
+	if err := ExportGenesisFile(genDoc, tmpPath); err != nil {
+		return err
+	}
+
+	// ensure the genesis file was not modified while it was being migrated
+	currentBz, err := os.ReadFile(genesisPath)
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to read genesis file: %w", err)
+	}
+	if genesisChecksum(currentBz) != inputChecksum {
+		os.Remove(tmpPath)
+		return fmt.Errorf("genesis file %s was modified during the migration", genesisPath)
+	}
+
+	return os.Rename(tmpPath, genesisPath)
+}
@@ -0,0 +1,151 @@
+package genutil_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestMigrateBaseAccountsToVesting(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, vesting.AppModuleBasic{}, bank.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	writeGenesis := func(t *testing.T) string {
+		genAccs, err := authtypes.PackAccounts(authtypes.GenesisAccounts{
+			authtypes.NewBaseAccount(addr1, nil, 0, 0),
+			authvesting.NewDelayedVestingAccount(authtypes.NewBaseAccount(addr2, nil, 1, 0), coins, 100),
+		})
+		require.NoError(t, err)
+
+		authGenState := authtypes.DefaultGenesisState()
+		authGenState.Accounts = genAccs
+		bankGenState := banktypes.DefaultGenesisState()
+		bankGenState.Balances = []banktypes.Balance{
+			{Address: addr1.String(), Coins: coins},
+			{Address: addr2.String(), Coins: coins},
+		}
+
+		appState, err := json.Marshal(map[string]json.RawMessage{
+			authtypes.ModuleName: cdc.MustMarshalJSON(authGenState),
+			banktypes.ModuleName: cdc.MustMarshalJSON(bankGenState),
+		})
+		require.NoError(t, err)
+
+		genesisPath := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, genutil.ExportGenesisFileWithTime(genesisPath, "test-chain", nil, appState, time.Now()))
+		return genesisPath
+	}
+
+	checksum := func(t *testing.T, path string) string {
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		sum := sha256.Sum256(bz)
+		return hex.EncodeToString(sum[:])
+	}
+
+	testCases := []struct {
+		name     string
+		checksum func(genesisPath string) string
+		schedule []types.VestingEntry
+		expErr   string
+	}{
+		{
+			"checksum mismatch",
+			func(string) string { return "abcd" },
+			[]types.VestingEntry{{Address: addr1.String(), StartTime: 100, EndTime: 200, Funder: funder.String()}},
+			"genesis file checksum mismatch",
+		},
+		{
+			"account not found",
+			func(string) string { return "" },
+			[]types.VestingEntry{{Address: funder.String(), StartTime: 100, EndTime: 200, Funder: funder.String()}},
+			"not found in genesis state",
+		},
+		{
+			"not a base account",
+			func(string) string { return "" },
+			[]types.VestingEntry{{Address: addr2.String(), StartTime: 100, EndTime: 200, Funder: funder.String()}},
+			"expected a base account",
+		},
+		{
+			"invalid funder",
+			func(string) string { return "" },
+			[]types.VestingEntry{{Address: addr1.String(), StartTime: 100, EndTime: 200, Funder: "funder"}},
+			"invalid funder address",
+		},
+		{
+			"invalid schedule",
+			func(string) string { return "" },
+			[]types.VestingEntry{{Address: addr1.String(), StartTime: 200, EndTime: 100, Funder: funder.String()}},
+			"failed to validate vesting account",
+		},
+		{
+			"valid migration",
+			func(genesisPath string) string { return checksum(t, genesisPath) },
+			[]types.VestingEntry{{Address: addr1.String(), StartTime: 100, EndTime: 200, Funder: funder.String()}},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesisPath := writeGenesis(t)
+			before := checksum(t, genesisPath)
+
+			err := genutil.MigrateBaseAccountsToVesting(cdc, genesisPath, tc.checksum(genesisPath), tc.schedule)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Equal(t, before, checksum(t, genesisPath))
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = os.Stat(genesisPath + ".tmp")
+			require.True(t, os.IsNotExist(err))
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genesisPath)
+			require.NoError(t, err)
+			appState, err := types.GenesisStateFromGenDoc(*genDoc)
+			require.NoError(t, err)
+
+			accs, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(cdc, appState).Accounts)
+			require.NoError(t, err)
+			require.Len(t, accs, 2)
+
+			vestingAcc, ok := accs[0].(*authvesting.ClawbackVestingAccount)
+			require.True(t, ok)
+			require.Equal(t, addr1, vestingAcc.GetAddress())
+			require.Equal(t, coins, vestingAcc.OriginalVesting)
+			require.Equal(t, int64(100), vestingAcc.GetStartTime())
+			require.Equal(t, int64(200), vestingAcc.GetEndTime())
+			require.Equal(t, funder, vestingAcc.GetFunder())
+
+			_, ok = accs[1].(*authvesting.DelayedVestingAccount)
+			require.True(t, ok)
+		})
+	}
+}
@@ -59,15 +59,18 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+		} else if decay := keeper.DepositDecay(ctx, tallyResults); decay.IsPositive() {
+			keeper.RefundDecayedAndDeleteDeposits(ctx, proposal.Id, decay)
 		} else {
 			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
 		}
 
 		if passes {
 			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
+				idx     int
+				events  sdk.Events
+				msg     sdk.Msg
+				results []*v1.MessageResult
 			)
 
 			// attempt to execute all messages within the passed proposal
@@ -82,9 +85,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 					var res *sdk.Result
 					res, err = safeExecuteHandler(cacheCtx, msg, handler)
 					if err != nil {
+						results = append(results, &v1.MessageResult{MsgTypeUrl: sdk.MsgTypeURL(msg), Error: err.Error()})
 						break
 					}
 
+					results = append(results, &v1.MessageResult{MsgTypeUrl: sdk.MsgTypeURL(msg), Success: true})
 					events = append(events, res.GetEvents()...)
 				}
 			}
@@ -106,6 +111,8 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
 			}
+
+			proposal.MessageResults = results
 		} else {
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
@@ -340,6 +340,58 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestProposalVetoDepositDecayEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	params := suite.GovKeeper.GetParams(ctx)
+	params.VetoDepositDecay = sdk.NewDecWithPrec(5, 1).String()
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+	createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{7, 3})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[2])
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	res, err := govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[2], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	depositorCoins := suite.BankKeeper.GetAllBalances(ctx, addrs[2])
+
+	// 30% of the votes are NoWithVeto, between half the veto threshold and the
+	// veto threshold
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), ""))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*suite.GovKeeper.GetParams(ctx).MaxDepositPeriod).Add(*suite.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// half of the deposit is burned, the other half refunded
+	refund := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
+	require.True(t, suite.BankKeeper.GetAllBalances(ctx, addrs[2]).IsEqual(depositorCoins.Add(refund...)))
+
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	suite := createTestSuite(t)
 	app := suite.App
@@ -382,6 +434,10 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
 	require.True(t, ok)
 	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Len(t, proposal.MessageResults, 1)
+	require.Equal(t, sdk.MsgTypeURL(msg), proposal.MessageResults[0].MsgTypeUrl)
+	require.False(t, proposal.MessageResults[0].Success)
+	require.NotEmpty(t, proposal.MessageResults[0].Error)
 }
 
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
@@ -29,7 +29,9 @@ func GetQueryCmd() *cobra.Command {
 
 	govQueryCmd.AddCommand(
 		GetCmdQueryProposal(),
+		GetCmdQueryProposalMessageResults(),
 		GetCmdQueryProposals(),
+		GetCmdQueryProposalsByKeyword(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
 		GetCmdQueryParams(),
@@ -90,6 +92,54 @@ $ %s query gov proposal 1
 	return cmd
 }
 
+// GetCmdQueryProposalMessageResults implements the query proposal message
+// results command.
+func GetCmdQueryProposalMessageResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-results [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the execution results of the messages of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the execution results of the messages of a passed proposal.
+The messages are executed atomically: execution stops at the first failing
+message and the state changes of all of them are discarded.
+
+Example:
+$ %s query gov message-results 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposalMessageResults(
+				cmd.Context(),
+				&v1.QueryProposalMessageResultsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposals implements a query proposals command. Command to Get
 // Proposals Information.
 func GetCmdQueryProposals() *cobra.Command {
@@ -178,6 +228,53 @@ $ %s query gov proposals --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryProposalsByKeyword implements the query proposals by keyword
+// command.
+func GetCmdQueryProposalsByKeyword() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-keyword [keyword]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query proposals whose title or summary contains a word",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all paginated proposals whose title or summary contains a word,
+case-insensitively.
+
+Example:
+$ %s query gov proposals-by-keyword upgrade
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalsByKeyword(
+				cmd.Context(),
+				&v1.QueryProposalsByKeywordRequest{Keyword: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "proposals-by-keyword")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryVote implements the query proposal vote command. Command to Get a
 // Vote Information.
 func GetCmdQueryVote() *cobra.Command {
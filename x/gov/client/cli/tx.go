@@ -31,6 +31,7 @@ const (
 	flagStatus       = "status"
 	FlagMetadata     = "metadata"
 	FlagSummary      = "summary"
+	FlagBeforeHeight = "before-height"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -67,10 +68,14 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
+		NewCmdWithdrawDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdVoteOnBehalfOf(),
 		NewCmdSubmitProposal(),
+		NewCmdAmendProposal(),
 		NewCmdDraftProposal(),
+		NewCmdPruneOldProposals(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -150,6 +155,57 @@ metadata example:
 	return cmd
 }
 
+// NewCmdAmendProposal implements amending a proposal in its deposit period.
+func NewCmdAmendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-proposal [proposal-id] [path/to/proposal.json]",
+		Short: "Replace the messages, metadata, title and summary of a proposal in its deposit period",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the messages, metadata, title and summary of a proposal in its
+deposit period. Only the proposer can amend a proposal. They should be defined
+in a JSON file with the same format as for submit-proposal, without deposit.
+
+The deposits made before the amendment can be withdrawn by their depositors for
+24 hours, while the proposal stays in its deposit period.
+
+Example:
+$ %s tx gov amend-proposal 1 path/to/proposal.json --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msgs, metadata, title, summary, _, err := parseSubmitProposal(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgAmendProposal(proposalID, msgs, clientCtx.GetFromAddress(), metadata, title, summary)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdSubmitLegacyProposal implements submitting a proposal transaction command.
 // Deprecated: please use NewCmdSubmitProposal instead.
 func NewCmdSubmitLegacyProposal() *cobra.Command {
@@ -267,6 +323,47 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdWithdrawDeposit implements withdrawing a deposit from an amended
+// proposal.
+func NewCmdWithdrawDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-deposit [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the deposit made on a proposal before it was amended",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the deposit made on a proposal before it was amended. The
+deposit can be withdrawn for 24 hours after the amendment, while the proposal
+stays in its deposit period.
+
+Example:
+$ %s tx gov withdraw-deposit 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgWithdrawDeposit(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -321,6 +418,57 @@ $ %s tx gov vote 1 yes --from mykey
 	return cmd
 }
 
+// NewCmdVoteOnBehalfOf implements overriding the vote of a validator command.
+func NewCmdVoteOnBehalfOf() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-on-behalf-of [validator-addr] [proposal-id] [option]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Override the vote of a validator you delegate to on an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Override, with your own vote, the vote of a validator you delegate to
+on an active proposal. Only the voting power of your delegation to that validator
+is voted with the given option. The delegator vote override must be enabled in
+the governance parameters.
+
+Example:
+$ %s tx gov vote-on-behalf-of cosmosvaloper1... 1 yes --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[1])
+			}
+
+			byteVoteOption, err := v1.VoteOptionFromString(govutils.NormalizeVoteOption(args[2]))
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgVoteOnBehalfOf(clientCtx.GetFromAddress(), valAddr, proposalID, byteVoteOption)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdWeightedVote implements creating a new weighted vote command.
 func NewCmdWeightedVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -374,3 +522,46 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdPruneOldProposals implements pruning the finished proposals submitted
+// before a given height.
+func NewCmdPruneOldProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-proposals",
+		Args:  cobra.NoArgs,
+		Short: "Prune the finished proposals submitted before a given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delete the passed, rejected and failed proposals submitted before the given
+height, along with their votes and deposits. Only the governance authority can
+prune proposals, so the message is generally generated and embedded in a
+proposal.
+
+Example:
+$ %s tx gov prune-proposals --before-height 100000 --from cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn --generate-only
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			beforeHeight, err := cmd.Flags().GetInt64(FlagBeforeHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgPruneOldProposals(clientCtx.GetFromAddress(), beforeHeight)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagBeforeHeight, 0, "Prune the finished proposals submitted before this height")
+	_ = cmd.MarkFlagRequired(FlagBeforeHeight)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
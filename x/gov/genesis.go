@@ -30,6 +30,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, *vote)
 	}
 
+	for _, override := range data.DelegatorVoteOverrides {
+		k.SetDelegatorVoteOverride(ctx, *override)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -38,6 +42,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 		}
 		k.SetProposal(ctx, *proposal)
+		k.SetProposalKeywordIndex(ctx, *proposal)
 	}
 
 	// if account has zero balance it probably means it's not set, so we set it
@@ -60,19 +65,26 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 
 	var proposalsDeposits v1.Deposits
 	var proposalsVotes v1.Votes
+	var proposalsVoteOverrides []*v1.DelegatorVoteOverride
 	for _, proposal := range proposals {
 		deposits := k.GetDeposits(ctx, proposal.Id)
 		proposalsDeposits = append(proposalsDeposits, deposits...)
 
 		votes := k.GetVotes(ctx, proposal.Id)
 		proposalsVotes = append(proposalsVotes, votes...)
+
+		k.IterateDelegatorVoteOverrides(ctx, proposal.Id, func(override v1.DelegatorVoteOverride) bool {
+			proposalsVoteOverrides = append(proposalsVoteOverrides, &override)
+			return false
+		})
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
+		StartingProposalId:     startingProposalID,
+		Deposits:               proposalsDeposits,
+		Votes:                  proposalsVotes,
+		Proposals:              proposals,
+		Params:                 &params,
+		DelegatorVoteOverrides: proposalsVoteOverrides,
 	}
 }
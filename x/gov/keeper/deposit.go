@@ -131,7 +131,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).ProposalMinDeposit(len(proposal.Messages))) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -162,6 +162,53 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// WithdrawDeposit refunds the part of a deposit made on a proposal before its
+// last amendment, while the proposal is in its reconsideration period, and
+// returns the withdrawn amount.
+func (keeper Keeper) WithdrawDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) (sdk.Coins, error) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if !proposal.InReconsiderationPeriod(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrNoWithdrawableDeposit, "proposal %d is not in its reconsideration period", proposalID)
+	}
+
+	deposit, found := keeper.GetDeposit(ctx, proposalID, depositorAddr)
+	if !found || sdk.Coins(deposit.WithdrawableAmount).IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNoWithdrawableDeposit, "depositor %s on proposal %d", depositorAddr, proposalID)
+	}
+
+	amount := sdk.NewCoins(deposit.WithdrawableAmount...)
+	err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositorAddr, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.TotalDeposit = sdk.NewCoins(proposal.TotalDeposit...).Sub(amount...)
+	keeper.SetProposal(ctx, proposal)
+
+	deposit.Amount = sdk.NewCoins(deposit.Amount...).Sub(amount...)
+	deposit.WithdrawableAmount = nil
+	if sdk.Coins(deposit.Amount).IsZero() {
+		store := ctx.KVStore(keeper.storeKey)
+		store.Delete(types.DepositKey(proposalID, depositorAddr))
+	} else {
+		keeper.SetDeposit(ctx, deposit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositorAddr.String()),
+		),
+	)
+
+	return amount, nil
+}
+
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -179,10 +226,44 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// RefundDecayedAndDeleteDeposits burns the given ratio of each of the deposits
+// on a specific proposal, refunds the remainder and deletes them.
+func (keeper Keeper) RefundDecayedAndDeleteDeposits(ctx sdk.Context, proposalID uint64, decay sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		burnAmount := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			burnAmount = burnAmount.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(decay).TruncateInt()))
+		}
+
+		if !burnAmount.IsZero() {
+			err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		refundAmount := sdk.NewCoins(deposit.Amount...).Sub(burnAmount...)
+		if !refundAmount.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+// the deposit parameters and the number of proposal messages. Returns nil on success,
+// error otherwise.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, numMsgs int) error {
 	params := keeper.GetParams(ctx)
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
 	if err != nil {
@@ -191,7 +272,7 @@ func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.
 	if minInitialDepositRatio.IsZero() {
 		return nil
 	}
-	minDepositCoins := params.MinDeposit
+	minDepositCoins := params.ProposalMinDeposit(numMsgs)
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
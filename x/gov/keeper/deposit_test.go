@@ -7,6 +7,8 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
@@ -114,13 +116,100 @@ func TestDeposits(t *testing.T) {
 	deposits = govKeeper.GetDeposits(ctx, proposalID)
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+
+	// Test refund decayed deposits
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[1])
+	require.NoError(t, err)
+	proposalID = proposal.Id
+	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake)
+	require.NoError(t, err)
+	govKeeper.RefundDecayedAndDeleteDeposits(ctx, proposalID, sdk.NewDecWithPrec(25, 2))
+	deposits = govKeeper.GetDeposits(ctx, proposalID)
+	require.Len(t, deposits, 0)
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	require.Equal(t, addr1Initial.Sub(oneStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+}
+
+func TestAmendProposalWithdrawDeposit(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper)
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", TestAddrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.Id
+
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 4)))
+
+	addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+
+	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake)
+	require.NoError(t, err)
+
+	// deposits can't be withdrawn before an amendment
+	_, err = govKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[1])
+	require.ErrorIs(t, err, types.ErrNoWithdrawableDeposit)
+
+	// only the proposer can amend the proposal
+	err = govKeeper.AmendProposal(ctx, proposalID, TestAddrs[1], TestProposal, "", "new title", "new description")
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	err = govKeeper.AmendProposal(ctx, proposalID, TestAddrs[0], TestProposal[:1], "", "new title", "new description")
+	require.NoError(t, err)
+	proposal, ok := govKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, "new title", proposal.Title)
+	require.Len(t, proposal.Messages, 1)
+	reconsiderationPeriod := *govKeeper.GetParams(ctx).ReconsiderationPeriod
+	require.Equal(t, ctx.BlockTime().Add(reconsiderationPeriod), *proposal.ReconsiderationEndTime)
+
+	// deposits made after the amendment are not withdrawable
+	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], oneStake)
+	require.NoError(t, err)
+
+	amount, err := govKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[1])
+	require.NoError(t, err)
+	require.Equal(t, fourStake, amount)
+	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+	_, found := govKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
+	require.False(t, found)
+	_, err = govKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[1])
+	require.ErrorIs(t, err, types.ErrNoWithdrawableDeposit)
+
+	// proposals can't be amended after their deposit period
+	proposal, ok = govKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	err = govKeeper.AmendProposal(ctx, proposalID, TestAddrs[0], TestProposal, "", "title", "description")
+	require.ErrorIs(t, err, types.ErrNotInDepositPeriod)
+
+	// deposits can't be withdrawn after the reconsideration period
+	_, err = govKeeper.WithdrawDeposit(ctx.WithBlockTime(ctx.BlockTime().Add(reconsiderationPeriod)), proposalID, TestAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoWithdrawableDeposit)
+
+	// but stay withdrawable during the voting period until then
+	amount, err = govKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, fourStake, amount)
+	deposit, found := govKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, oneStake, sdk.NewCoins(deposit.Amount...))
+	proposal, ok = govKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, oneStake, sdk.NewCoins(proposal.TotalDeposit...))
 }
 
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
+		bundledMinDeposit        sdk.Coins
 		minInitialDepositPercent int64
 		initialDeposit           sdk.Coins
+		numMsgs                  int
 
 		expectError bool
 	}{
@@ -184,6 +273,29 @@ func TestValidateInitialDeposit(t *testing.T) {
 				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
 			),
 		},
+		"bundled min deposit * initial percent > initial deposit (single message): success": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			bundledMinDeposit:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			numMsgs:                  1,
+		},
+		"bundled min deposit * initial percent > initial deposit (multiple messages): error": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			bundledMinDeposit:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			numMsgs:                  2,
+
+			expectError: true,
+		},
+		"bundled min deposit * initial percent == initial deposit (multiple messages): success": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			bundledMinDeposit:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100))),
+			numMsgs:                  2,
+		},
 		"0 initial percent: success": {
 			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
 			minInitialDepositPercent: 0,
@@ -197,11 +309,12 @@ func TestValidateInitialDeposit(t *testing.T) {
 
 			params := v1.DefaultParams()
 			params.MinDeposit = tc.minDeposit
+			params.BundledMinDeposit = tc.bundledMinDeposit
 			params.MinInitialDepositRatio = sdk.NewDec(tc.minInitialDepositPercent).Quo(sdk.NewDec(100)).String()
 
 			govKeeper.SetParams(ctx, params)
 
-			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit)
+			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit, tc.numMsgs)
 
 			if tc.expectError {
 				require.Error(t, err)
@@ -211,3 +324,61 @@ func TestValidateInitialDeposit(t *testing.T) {
 		})
 	}
 }
+
+func TestDepositDecay(t *testing.T) {
+	testcases := []struct {
+		name             string
+		vetoDepositDecay string
+		tallyResults     v1.TallyResult
+		expDecay         sdk.Dec
+	}{
+		{
+			name:             "no decay",
+			vetoDepositDecay: "0",
+			tallyResults:     v1.NewTallyResult(sdk.NewInt(70), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(30)),
+			expDecay:         sdk.ZeroDec(),
+		},
+		{
+			name:             "no votes",
+			vetoDepositDecay: "0.5",
+			tallyResults:     v1.EmptyTallyResult(),
+			expDecay:         sdk.ZeroDec(),
+		},
+		{
+			name:             "veto below half the veto threshold",
+			vetoDepositDecay: "0.5",
+			tallyResults:     v1.NewTallyResult(sdk.NewInt(90), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(10)),
+			expDecay:         sdk.ZeroDec(),
+		},
+		{
+			name:             "veto at half the veto threshold",
+			vetoDepositDecay: "0.5",
+			tallyResults:     v1.NewTallyResult(sdk.NewInt(833), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(167)),
+			expDecay:         sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:             "veto between half the veto threshold and the veto threshold",
+			vetoDepositDecay: "0.5",
+			tallyResults:     v1.NewTallyResult(sdk.NewInt(40), sdk.NewInt(20), sdk.NewInt(10), sdk.NewInt(30)),
+			expDecay:         sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:             "veto above the veto threshold",
+			vetoDepositDecay: "0.5",
+			tallyResults:     v1.NewTallyResult(sdk.NewInt(60), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(40)),
+			expDecay:         sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, _, _, _, ctx := setupGovKeeper(t)
+
+			params := v1.DefaultParams()
+			params.VetoDepositDecay = tc.vetoDepositDecay
+			govKeeper.SetParams(ctx, params)
+
+			require.Equal(t, tc.expDecay, govKeeper.DepositDecay(ctx, tc.tallyResults))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -90,6 +91,62 @@ func (q Keeper) Proposals(c context.Context, req *v1.QueryProposalsRequest) (*v1
 	return &v1.QueryProposalsResponse{Proposals: filteredProposals, Pagination: pageRes}, nil
 }
 
+// ProposalMessageResults implements the Query/ProposalMessageResults gRPC method
+func (q Keeper) ProposalMessageResults(c context.Context, req *v1.QueryProposalMessageResultsRequest) (*v1.QueryProposalMessageResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := q.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &v1.QueryProposalMessageResultsResponse{
+		Status:         proposal.Status,
+		MessageResults: proposal.MessageResults,
+	}, nil
+}
+
+// ProposalsByKeyword implements the Query/ProposalsByKeyword gRPC method
+func (q Keeper) ProposalsByKeyword(c context.Context, req *v1.QueryProposalsByKeywordRequest) (*v1.QueryProposalsByKeywordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keywords := types.Keywords(req.Keyword)
+	if len(keywords) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "keyword must be a single word of at most %d bytes", types.MaxKeywordLength)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(q.storeKey)
+	indexStore := prefix.NewStore(store, types.ProposalsByKeywordKey(keywords[0]))
+
+	var proposals []*v1.Proposal
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, _ []byte) error {
+		proposalID := types.GetProposalIDFromBytes(key)
+		proposal, found := q.GetProposal(ctx, proposalID)
+		if !found {
+			return fmt.Errorf("indexed proposal %d not found", proposalID)
+		}
+
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalsByKeywordResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
 // Vote returns Voted information based on proposalID, voterAddr
 func (q Keeper) Vote(c context.Context, req *v1.QueryVoteRequest) (*v1.QueryVoteResponse, error) {
 	if req == nil {
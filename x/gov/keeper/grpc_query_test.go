@@ -1633,3 +1633,83 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalsByKeyword() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+
+	submit := func(title, summary string) uint64 {
+		govAddress := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+		msgs := []sdk.Msg{v1.NewMsgVote(govAddress, 1, v1.OptionYes, "")}
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, msgs, "", title, summary, proposer)
+		suite.Require().NoError(err)
+		return proposal.Id
+	}
+
+	queryIDs := func(keyword string, pageReq *query.PageRequest) []uint64 {
+		res, err := queryClient.ProposalsByKeyword(gocontext.Background(), &v1.QueryProposalsByKeywordRequest{Keyword: keyword, Pagination: pageReq})
+		suite.Require().NoError(err)
+
+		ids := make([]uint64, len(res.Proposals))
+		for i, proposal := range res.Proposals {
+			ids[i] = proposal.Id
+		}
+		return ids
+	}
+
+	upgradeID := submit("Software upgrade v2", "Upgrade the chain to v2")
+	spendID := submit("Community pool spend", "Fund the v2 audit")
+	textID := submit("Signaling", "Adopt a new logo")
+
+	suite.Require().Equal([]uint64{upgradeID}, queryIDs("upgrade", nil))
+	suite.Require().Equal([]uint64{upgradeID}, queryIDs("UPGRADE", nil))
+	suite.Require().Equal([]uint64{upgradeID, spendID}, queryIDs("v2", nil))
+	suite.Require().Equal([]uint64{spendID}, queryIDs("v2", &query.PageRequest{Offset: 1}))
+	suite.Require().Equal([]uint64{textID}, queryIDs("logo", nil))
+	suite.Require().Empty(queryIDs("unknown", nil))
+
+	// deleted proposals are removed from the index
+	suite.govKeeper.DeleteProposal(ctx, upgradeID)
+	suite.Require().Equal([]uint64{spendID}, queryIDs("v2", nil))
+	suite.Require().Empty(queryIDs("upgrade", nil))
+
+	for _, keyword := range []string{"", "two words", "!?"} {
+		_, err := queryClient.ProposalsByKeyword(gocontext.Background(), &v1.QueryProposalsByKeywordRequest{Keyword: keyword})
+		suite.Require().Error(err, keyword)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalMessageResults() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.ProposalMessageResults(gocontext.Background(), &v1.QueryProposalMessageResultsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ProposalMessageResults(gocontext.Background(), &v1.QueryProposalMessageResultsRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	govAddress := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	msgs := []sdk.Msg{v1.NewMsgVote(govAddress, 1, v1.OptionYes, ""), v1.NewMsgVote(govAddress, 1, v1.OptionNo, "")}
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	suite.Require().NoError(err)
+
+	res, err := queryClient.ProposalMessageResults(gocontext.Background(), &v1.QueryProposalMessageResultsRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusDepositPeriod, res.Status)
+	suite.Require().Empty(res.MessageResults)
+
+	results := []*v1.MessageResult{
+		{MsgTypeUrl: sdk.MsgTypeURL(msgs[0]), Success: true},
+		{MsgTypeUrl: sdk.MsgTypeURL(msgs[1]), Error: "failed"},
+	}
+	proposal.Status = v1.StatusFailed
+	proposal.MessageResults = results
+	suite.govKeeper.SetProposal(ctx, proposal)
+
+	res, err = queryClient.ProposalMessageResults(gocontext.Background(), &v1.QueryProposalMessageResultsRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusFailed, res.Status)
+	suite.Require().Equal(results, res.MessageResults)
+}
@@ -77,6 +77,11 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If MaxPrunedProposals not set by app developer, set to default value.
+	if config.MaxPrunedProposals == 0 {
+		config.MaxPrunedProposals = types.DefaultConfig().MaxPrunedProposals
+	}
+
 	return &Keeper{
 		storeKey:   key,
 		authKeeper: authKeeper,
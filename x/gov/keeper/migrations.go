@@ -6,6 +6,9 @@ import (
 	v2 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v7 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v7"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -36,3 +39,24 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates from version 5 to 6. Specifically, it indexes the
+// existing proposals by the keywords of their title and summary.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.IterateProposals(ctx, func(proposal v1.Proposal) bool {
+		m.keeper.SetProposalKeywordIndex(ctx, proposal)
+		return false
+	})
+
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
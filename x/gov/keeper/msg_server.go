@@ -29,12 +29,12 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	initialDeposit := msg.GetInitialDeposit()
 
-	if err := k.validateInitialDeposit(ctx, initialDeposit); err != nil {
+	proposalMsgs, err := msg.GetMsgs()
+	if err != nil {
 		return nil, err
 	}
 
-	proposalMsgs, err := msg.GetMsgs()
-	if err != nil {
+	if err := k.validateInitialDeposit(ctx, initialDeposit, len(proposalMsgs)); err != nil {
 		return nil, err
 	}
 
@@ -134,6 +134,25 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	return &v1.MsgVoteWeightedResponse{}, nil
 }
 
+// VoteOnBehalfOf implements the MsgServer.VoteOnBehalfOf method.
+func (k msgServer) VoteOnBehalfOf(goCtx context.Context, msg *v1.MsgVoteOnBehalfOf) (*v1.MsgVoteOnBehalfOfResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.AddDelegatorVoteOverride(ctx, msg.ProposalId, accAddr, valAddr, msg.Option)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteOnBehalfOfResponse{}, nil
+}
+
 // Deposit implements the MsgServer.Deposit method.
 func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -158,6 +177,40 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	return &v1.MsgDepositResponse{}, nil
 }
 
+// AmendProposal implements the MsgServer.AmendProposal method.
+func (k msgServer) AmendProposal(goCtx context.Context, msg *v1.MsgAmendProposal) (*v1.MsgAmendProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	proposalMsgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.AmendProposal(ctx, msg.ProposalId, proposer, proposalMsgs, msg.Metadata, msg.Title, msg.Summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAmendProposalResponse{}, nil
+}
+
+// WithdrawDeposit implements the MsgServer.WithdrawDeposit method.
+func (k msgServer) WithdrawDeposit(goCtx context.Context, msg *v1.MsgWithdrawDeposit) (*v1.MsgWithdrawDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.WithdrawDeposit(ctx, msg.ProposalId, accAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgWithdrawDepositResponse{Amount: amount}, nil
+}
+
 // UpdateParams implements the MsgServer.UpdateParams method.
 func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams) (*v1.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
@@ -172,6 +225,18 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// PruneOldProposals implements the MsgServer.PruneOldProposals method.
+func (k msgServer) PruneOldProposals(goCtx context.Context, msg *v1.MsgPruneOldProposals) (*v1.MsgPruneOldProposalsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalIDs := k.Keeper.PruneOldProposals(ctx, msg.BeforeHeight)
+
+	return &v1.MsgPruneOldProposalsResponse{ProposalIds: proposalIDs}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
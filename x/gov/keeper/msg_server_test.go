@@ -1052,6 +1052,41 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgPruneOldProposals() {
+	authority := suite.govKeeper.GetAuthority()
+	testCases := []struct {
+		name      string
+		input     *v1.MsgPruneOldProposals
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:   "valid",
+			input:  &v1.MsgPruneOldProposals{Authority: authority, BeforeHeight: 100},
+			expErr: false,
+		},
+		{
+			name:      "invalid authority",
+			input:     &v1.MsgPruneOldProposals{Authority: suite.addrs[0].String(), BeforeHeight: 100},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.PruneOldProposals(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
 	baseDepositRatioDec := sdk.NewDec(baseDepositTestPercent).Quo(sdk.NewDec(100))
@@ -7,27 +7,70 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
-	err := keeper.assertMetadataLength(metadata)
+	msgsStr, err := keeper.validateProposalContent(ctx, messages, metadata, title, summary)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.SubmitHeight = ctx.BlockHeight()
+
+	keeper.SetProposal(ctx, proposal)
+	keeper.SetProposalKeywordIndex(ctx, proposal)
+	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
+	keeper.SetProposalID(ctx, proposalID+1)
+
+	// called right after a proposal is submitted
+	keeper.Hooks().AfterProposalSubmission(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+		),
+	)
+
+	return proposal, nil
+}
+
+// validateProposalContent checks the metadata, title and summary lengths and
+// that each message is valid, routable and signed by the governance module
+// account only. It returns a comma-separated string of all Msg type URLs.
+func (keeper Keeper) validateProposalContent(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string) (string, error) {
+	err := keeper.assertMetadataLength(metadata)
+	if err != nil {
+		return "", err
+	}
 
 	// assert summary is no longer than predefined max length of metadata
 	err = keeper.assertMetadataLength(summary)
 	if err != nil {
-		return v1.Proposal{}, err
+		return "", err
 	}
 
 	// assert title is no longer than predefined max length of metadata
 	err = keeper.assertMetadataLength(title)
 	if err != nil {
-		return v1.Proposal{}, err
+		return "", err
 	}
 
 	// Will hold a comma-separated string of all Msg type URLs.
@@ -40,23 +83,23 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 
 		// perform a basic validation of the message
 		if err := msg.ValidateBasic(); err != nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+			return "", sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
 		}
 
 		signers := msg.GetSigners()
 		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
+			return "", types.ErrInvalidSigner
 		}
 
 		// assert that the governance module account is the only signer of the messages
 		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+			return "", sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
 		}
 
 		// use the msg service router to see that there is a valid route for that message.
 		handler := keeper.router.Handler(msg)
 		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+			return "", sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -68,43 +111,70 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			cacheCtx, _ := ctx.CacheContext()
 			if _, err := handler(cacheCtx, msg); err != nil {
 				if errors.Is(types.ErrNoProposalHandlerExists, err) {
-					return v1.Proposal{}, err
+					return "", err
 				}
-				return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+				return "", sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 			}
 		}
+	}
 
+	return msgsStr, nil
+}
+
+// AmendProposal replaces the messages, metadata, title and summary of a
+// proposal in its deposit period. The deposits made so far become withdrawable
+// until the end of the reconsideration period.
+func (keeper Keeper) AmendProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress, messages []sdk.Msg, metadata, title, summary string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != v1.StatusDepositPeriod {
+		return sdkerrors.Wrapf(types.ErrNotInDepositPeriod, "%d", proposalID)
+	}
+	if proposal.Proposer != proposer.String() {
+		return sdkerrors.ErrUnauthorized.Wrapf("expected proposer %s, got %s", proposal.Proposer, proposer)
 	}
 
-	proposalID, err := keeper.GetProposalID(ctx)
+	msgsStr, err := keeper.validateProposalContent(ctx, messages, metadata, title, summary)
 	if err != nil {
-		return v1.Proposal{}, err
+		return err
 	}
 
-	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
-
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
+	anys, err := sdktx.SetMsgs(messages)
 	if err != nil {
-		return v1.Proposal{}, err
+		return err
 	}
 
+	keeper.deleteProposalKeywordIndex(ctx, proposal)
+
+	reconsiderationEndTime := ctx.BlockTime().Add(*keeper.GetParams(ctx).ReconsiderationPeriod)
+	proposal.Messages = anys
+	proposal.Metadata = metadata
+	proposal.Title = title
+	proposal.Summary = summary
+	proposal.ReconsiderationEndTime = &reconsiderationEndTime
+
 	keeper.SetProposal(ctx, proposal)
-	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
-	keeper.SetProposalID(ctx, proposalID+1)
+	keeper.SetProposalKeywordIndex(ctx, proposal)
 
-	// called right after a proposal is submitted
-	keeper.Hooks().AfterProposalSubmission(ctx, proposalID)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+		sdk.NewAttribute(types.AttributeKeyReconsiderationEnd, reconsiderationEndTime.String()),
+	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSubmitProposal,
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
-		),
-	)
+	// all the current deposits were made on the original proposal
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		deposit.WithdrawableAmount = deposit.Amount
+		keeper.SetDeposit(ctx, *deposit)
 
-	return proposal, nil
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeProposalAmended, attrs...))
+
+	return nil
 }
 
 // GetProposal gets a proposal from store by ProposalID.
@@ -161,9 +231,63 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 		store.Delete(types.VotingPeriodProposalKey(proposalID))
 	}
 
+	keeper.deleteProposalKeywordIndex(ctx, proposal)
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// SetProposalKeywordIndex indexes a proposal by the keywords of its title and
+// summary.
+func (keeper Keeper) SetProposalKeywordIndex(ctx sdk.Context, proposal v1.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, keyword := range types.ProposalKeywords(proposal.Title, proposal.Summary) {
+		store.Set(types.ProposalKeywordKey(keyword, proposal.Id), []byte{})
+	}
+}
+
+func (keeper Keeper) deleteProposalKeywordIndex(ctx sdk.Context, proposal v1.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, keyword := range types.ProposalKeywords(proposal.Title, proposal.Summary) {
+		store.Delete(types.ProposalKeywordKey(keyword, proposal.Id))
+	}
+}
+
+// PruneOldProposals deletes the finished proposals submitted before the given
+// height, along with their remaining votes and deposits, and returns their ids.
+// Remaining deposits are refunded. Proposals submitted before submission
+// heights were recorded are considered submitted at height 0.
+//
+// At most MaxPrunedProposals proposals of the keeper config are pruned at once,
+// the oldest first. As proposal ids are assigned in submission order, the
+// proposals are walked in the order of their ids until the first one submitted
+// at or after the given height.
+func (keeper Keeper) PruneOldProposals(ctx sdk.Context, beforeHeight int64) []uint64 {
+	var proposalIDs []uint64
+	keeper.IterateProposals(ctx, func(proposal v1.Proposal) bool {
+		if proposal.SubmitHeight >= beforeHeight {
+			return true
+		}
+
+		switch proposal.Status {
+		case v1.StatusPassed, v1.StatusRejected, v1.StatusFailed:
+			proposalIDs = append(proposalIDs, proposal.Id)
+		}
+
+		return uint64(len(proposalIDs)) >= keeper.config.MaxPrunedProposals
+	})
+
+	for _, proposalID := range proposalIDs {
+		keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+			keeper.deleteVote(ctx, proposalID, sdk.MustAccAddressFromBech32(vote.Voter))
+			return false
+		})
+		keeper.deleteDelegatorVoteOverrides(ctx, proposalID)
+		keeper.RefundAndDeleteDeposits(ctx, proposalID)
+		keeper.DeleteProposal(ctx, proposalID)
+	}
+
+	return proposalIDs
+}
+
 // IterateProposals iterates over all the proposals and performs a callback function.
 // Panics when the iterator encounters a proposal which can't be unmarshaled.
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
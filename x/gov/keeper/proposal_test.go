@@ -142,6 +142,63 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPruneOldProposals() {
+	suite.reset()
+	tp := TestProposal
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	finished, err := suite.govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), finished.SubmitHeight)
+	pending, err := suite.govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+	recent, err := suite.govKeeper.SubmitProposal(ctx.WithBlockHeight(20), tp, "", "test", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+
+	for _, proposal := range []v1.Proposal{finished, recent} {
+		suite.govKeeper.SetVote(ctx, v1.NewVote(proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		suite.govKeeper.SetDeposit(ctx, v1.NewDeposit(proposal.Id, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+		proposal.Status = v1.StatusPassed
+		suite.govKeeper.SetProposal(ctx, proposal)
+	}
+
+	proposalIDs := suite.govKeeper.PruneOldProposals(ctx, 11)
+	suite.Require().Equal([]uint64{finished.Id}, proposalIDs)
+
+	_, ok := suite.govKeeper.GetProposal(ctx, finished.Id)
+	suite.Require().False(ok)
+	suite.Require().Empty(suite.govKeeper.GetVotes(ctx, finished.Id))
+	suite.Require().Empty(suite.govKeeper.GetDeposits(ctx, finished.Id))
+
+	// proposals still in deposit period or submitted after the height are kept
+	_, ok = suite.govKeeper.GetProposal(ctx, pending.Id)
+	suite.Require().True(ok)
+	_, ok = suite.govKeeper.GetProposal(ctx, recent.Id)
+	suite.Require().True(ok)
+	suite.Require().Len(suite.govKeeper.GetVotes(ctx, recent.Id), 1)
+	suite.Require().Len(suite.govKeeper.GetDeposits(ctx, recent.Id), 1)
+}
+
+func (suite *KeeperTestSuite) TestPruneOldProposalsLimit() {
+	suite.reset()
+	ctx := suite.ctx.WithBlockHeight(10)
+	maxPruned := int(types.DefaultConfig().MaxPrunedProposals)
+
+	var proposalIDs []uint64
+	for i := 0; i <= maxPruned; i++ {
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+		suite.Require().NoError(err)
+		proposal.Status = v1.StatusRejected
+		suite.govKeeper.SetProposal(ctx, proposal)
+		proposalIDs = append(proposalIDs, proposal.Id)
+	}
+
+	// the oldest proposals are pruned first
+	suite.Require().Equal(proposalIDs[:maxPruned], suite.govKeeper.PruneOldProposals(ctx, 11))
+	suite.Require().Equal(proposalIDs[maxPruned:], suite.govKeeper.PruneOldProposals(ctx, 11))
+	suite.Require().Empty(suite.govKeeper.PruneOldProposals(ctx, 11))
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
@@ -21,20 +21,70 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
 
+	params := keeper.GetParams(ctx)
+	quadratic := params.VotingMechanism == v1.QuadraticByStake
+
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = v1.NewValidatorGovInfo(
+		val := v1.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			math.LegacyZeroDec(),
 			v1.WeightedVoteOptions{},
 		)
+		currValidators[validator.GetOperator().String()] = val
 
 		return false
 	})
 
+	// under the quadratic voting mechanism, the voting power of a delegator is
+	// the square root of its whole bonded stake, split between its delegations
+	// pro rata. The stakes are only computed for the delegators who voted or
+	// overrode the vote of a validator.
+	delegatorStakes := make(map[string]sdk.Dec)
+	delegatorStake := func(delegator sdk.AccAddress) sdk.Dec {
+		if stake, ok := delegatorStakes[delegator.String()]; ok {
+			return stake
+		}
+
+		stake := math.LegacyZeroDec()
+		keeper.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			if val, ok := currValidators[delegation.GetValidatorAddr().String()]; ok {
+				stake = stake.Add(delegationStake(val, delegation.GetShares()))
+			}
+			return false
+		})
+		delegatorStakes[delegator.String()] = stake
+
+		return stake
+	}
+
+	// delegationVotingPower returns the voting power of the given shares of a
+	// validator delegated by the given delegator
+	delegationVotingPower := func(val v1.ValidatorGovInfo, delegator sdk.AccAddress, shares sdk.Dec) sdk.Dec {
+		stake := delegationStake(val, shares)
+		if !quadratic {
+			return stake
+		}
+
+		wholeStake := delegatorStake(delegator)
+		if wholeStake.IsZero() {
+			return math.LegacyZeroDec()
+		}
+
+		return stake.Mul(stakeVotingPower(params.VotingMechanism, wholeStake)).Quo(wholeStake)
+	}
+
+	// totalVotingStake is the stake behind the votes, against which the quorum
+	// is measured whatever the voting mechanism
+	totalVotingStake := math.LegacyZeroDec()
+
+	voted := make(map[string]bool)
+
 	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
+		voted[vote.Voter] = true
+
 		// if validator, just record it in the map
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
@@ -54,15 +104,23 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
 				currValidators[valAddrStr] = val
 
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				votingPower := delegationVotingPower(val, voter, delegation.GetShares())
 
-				for _, option := range vote.Options {
+				// the voter may have overridden the vote of this validator only
+				options := vote.Options
+				if params.DelegatorVoteOverride {
+					if override, found := keeper.GetDelegatorVoteOverride(ctx, vote.ProposalId, voter, delegation.GetValidatorAddr()); found {
+						options = v1.NewNonSplitVoteOption(override.Option)
+					}
+				}
+
+				for _, option := range options {
 					weight, _ := sdk.NewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
 					results[option.Option] = results[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
+				totalVotingStake = totalVotingStake.Add(delegationStake(val, delegation.GetShares()))
 			}
 
 			return false
@@ -72,14 +130,49 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false
 	})
 
-	// iterate over the validators again to tally their voting power
+	// tally the overrides of delegators who did not vote directly, deducting
+	// only the shares of the overriding delegation from the validator
+	if params.DelegatorVoteOverride {
+		keeper.IterateDelegatorVoteOverrides(ctx, proposal.Id, func(override v1.DelegatorVoteOverride) bool {
+			if voted[override.Voter] {
+				return false
+			}
+
+			val, ok := currValidators[override.ValidatorAddress]
+			if !ok {
+				return false
+			}
+
+			voter := sdk.MustAccAddressFromBech32(override.Voter)
+			delegation := keeper.sk.Delegation(ctx, voter, val.Address)
+			if delegation == nil {
+				return false
+			}
+
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			currValidators[override.ValidatorAddress] = val
+
+			votingPower := delegationVotingPower(val, voter, delegation.GetShares())
+			results[override.Option] = results[override.Option].Add(votingPower)
+			totalVotingPower = totalVotingPower.Add(votingPower)
+			totalVotingStake = totalVotingStake.Add(delegationStake(val, delegation.GetShares()))
+
+			return false
+		})
+	}
+	keeper.deleteDelegatorVoteOverrides(ctx, proposal.Id)
+
+	// iterate over the validators again to tally their voting power. Under the
+	// quadratic voting mechanism, the stake inheriting the vote of a validator
+	// votes as the stake of a single voter.
 	for _, val := range currValidators {
 		if len(val.Vote) == 0 {
 			continue
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		stake := delegationStake(val, sharesAfterDeductions)
+		votingPower := stakeVotingPower(params.VotingMechanism, stake)
 
 		for _, option := range val.Vote {
 			weight, _ := sdk.NewDecFromStr(option.Weight)
@@ -87,9 +180,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			results[option.Option] = results[option.Option].Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+		totalVotingStake = totalVotingStake.Add(stake)
 	}
 
-	params := keeper.GetParams(ctx)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -98,8 +191,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false, false, tallyResults
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
+	// If there is not enough quorum of votes, the proposal fails. The quorum
+	// is measured on the stake behind the votes, which is their voting power
+	// when weighted by stake.
+	percentVoting := totalVotingStake.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
 	quorum, _ := sdk.NewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults
@@ -125,3 +220,61 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// delegationStake returns the stake of the given shares of the given validator.
+func delegationStake(val v1.ValidatorGovInfo, shares sdk.Dec) sdk.Dec {
+	// delegation shares * bonded / total shares
+	return shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+}
+
+// stakeVotingPower returns the voting power of the given stake under the given
+// voting mechanism: the stake itself when weighted by stake, its square root
+// when quadratic.
+func stakeVotingPower(mechanism v1.VotingMechanism, stake sdk.Dec) sdk.Dec {
+	if mechanism != v1.QuadraticByStake {
+		return stake
+	}
+
+	votingPower, err := stake.ApproxSqrt()
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+
+	return votingPower
+}
+
+// DepositDecay returns the ratio of the deposits of a proposal to burn before
+// refunding them, given its tally results. It is the VetoDepositDecay param if
+// the ratio of NoWithVeto votes to total votes is between half of the veto
+// threshold and the veto threshold, and zero otherwise.
+func (keeper Keeper) DepositDecay(ctx sdk.Context, tallyResults v1.TallyResult) sdk.Dec {
+	params := keeper.GetParams(ctx)
+
+	decay, err := sdk.NewDecFromStr(params.VetoDepositDecay)
+	if err != nil || !decay.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	totalVotes := math.ZeroInt()
+	for _, count := range []string{tallyResults.YesCount, tallyResults.AbstainCount, tallyResults.NoCount, tallyResults.NoWithVetoCount} {
+		votes, ok := math.NewIntFromString(count)
+		if !ok {
+			return math.LegacyZeroDec()
+		}
+		totalVotes = totalVotes.Add(votes)
+	}
+
+	if totalVotes.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	vetoVotes, _ := math.NewIntFromString(tallyResults.NoWithVetoCount)
+	vetoRatio := sdk.NewDecFromInt(vetoVotes).QuoInt(totalVotes)
+
+	vetoThreshold, _ := sdk.NewDecFromStr(params.VetoThreshold)
+	if vetoRatio.LT(vetoThreshold.QuoInt64(2)) || vetoRatio.GT(vetoThreshold) {
+		return math.LegacyZeroDec()
+	}
+
+	return decay
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// AddDelegatorVoteOverride adds the vote of a delegator overriding the vote of a
+// validator it delegates to on a specific proposal. Only the voting power of
+// the delegation to that validator is voted with the given option, the
+// delegations to other validators still inherit their votes.
+func (keeper Keeper) AddDelegatorVoteOverride(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress, option v1.VoteOption) error {
+	if !keeper.GetParams(ctx).DelegatorVoteOverride {
+		return types.ErrVoteOverrideDisabled
+	}
+
+	// Check if proposal is in voting period.
+	store := ctx.KVStore(keeper.storeKey)
+	if !store.Has(types.VotingPeriodProposalKey(proposalID)) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if !v1.ValidVoteOption(option) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	}
+
+	if keeper.sk.Delegation(ctx, voterAddr, valAddr) == nil {
+		return sdkerrors.Wrapf(types.ErrNoDelegation, "delegator %s, validator %s", voterAddr, valAddr)
+	}
+
+	keeper.SetDelegatorVoteOverride(ctx, v1.NewDelegatorVoteOverride(proposalID, voterAddr, valAddr, option))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteOverride,
+			sdk.NewAttribute(types.AttributeKeyVoter, voterAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// GetDelegatorVoteOverride gets the vote of a delegator overriding the vote of
+// a validator on a specific proposal
+func (keeper Keeper) GetDelegatorVoteOverride(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress) (override v1.DelegatorVoteOverride, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DelegatorVoteOverrideKey(proposalID, voterAddr, valAddr))
+	if bz == nil {
+		return override, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &override)
+
+	return override, true
+}
+
+// SetDelegatorVoteOverride sets a DelegatorVoteOverride to the gov store
+func (keeper Keeper) SetDelegatorVoteOverride(ctx sdk.Context, override v1.DelegatorVoteOverride) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&override)
+	voter := sdk.MustAccAddressFromBech32(override.Voter)
+	valAddr, err := sdk.ValAddressFromBech32(override.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.DelegatorVoteOverrideKey(override.ProposalId, voter, valAddr), bz)
+}
+
+// IterateDelegatorVoteOverrides iterates over all the delegator vote overrides
+// of a proposal and performs a callback function
+func (keeper Keeper) IterateDelegatorVoteOverrides(ctx sdk.Context, proposalID uint64, cb func(override v1.DelegatorVoteOverride) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegatorVoteOverridesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var override v1.DelegatorVoteOverride
+		keeper.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		if cb(override) {
+			break
+		}
+	}
+}
+
+// deleteDelegatorVoteOverrides deletes all the delegator vote overrides of a
+// proposal from the store
+func (keeper Keeper) deleteDelegatorVoteOverrides(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegatorVoteOverridesKey(proposalID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -7,7 +7,9 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestVotes(t *testing.T) {
@@ -86,3 +88,45 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes[1].Options[2].Weight, sdk.NewDecWithPrec(5, 2).String())
 	require.Equal(t, votes[1].Options[3].Weight, sdk.NewDecWithPrec(5, 2).String())
 }
+
+func TestDelegatorVoteOverrides(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
+	valAddr := sdk.ValAddress(addrs[1])
+
+	tp := TestProposal
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	govKeeper.SetProposal(ctx, proposal)
+
+	err = govKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[0], valAddr, v1.OptionNo)
+	require.ErrorIs(t, err, types.ErrVoteOverrideDisabled)
+
+	params := govKeeper.GetParams(ctx)
+	params.DelegatorVoteOverride = true
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	stakingKeeper.EXPECT().Delegation(ctx, addrs[0], valAddr).Return(stakingtypes.NewDelegation(addrs[0], valAddr, sdk.OneDec())).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(ctx, addrs[1], valAddr).Return(nil).AnyTimes()
+
+	require.Error(t, govKeeper.AddDelegatorVoteOverride(ctx, 10, addrs[0], valAddr, v1.OptionNo), "invalid proposal ID")
+	require.Error(t, govKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[0], valAddr, v1.VoteOption(0x10)), "invalid option")
+	err = govKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[1], valAddr, v1.OptionNo)
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	require.NoError(t, govKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[0], valAddr, v1.OptionNo))
+	override, found := govKeeper.GetDelegatorVoteOverride(ctx, proposalID, addrs[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, v1.NewDelegatorVoteOverride(proposalID, addrs[0], valAddr, v1.OptionNo), override)
+
+	// Test change of override
+	require.NoError(t, govKeeper.AddDelegatorVoteOverride(ctx, proposalID, addrs[0], valAddr, v1.OptionYes))
+	override, found = govKeeper.GetDelegatorVoteOverride(ctx, proposalID, addrs[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, v1.OptionYes, override.Option)
+
+	_, found = govKeeper.GetDelegatorVoteOverride(ctx, proposalID, addrs[1], valAddr)
+	require.False(t, found)
+}
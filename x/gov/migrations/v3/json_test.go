@@ -73,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"delegator_vote_overrides": [],
 	"deposit_params": {
 		"max_deposit_period": "172800s",
 		"min_deposit": [
@@ -94,6 +95,7 @@ func TestMigrateJSON(t *testing.T) {
 				"yes_count": "0"
 			},
 			"id": "1",
+			"message_results": [],
 			"messages": [
 				{
 					"@type": "/cosmos.gov.v1.MsgExecLegacyContent",
@@ -107,7 +109,9 @@ func TestMigrateJSON(t *testing.T) {
 			],
 			"metadata": "",
 			"proposer": "",
+			"reconsideration_end_time": null,
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
+			"submit_height": "0",
 			"submit_time": "2001-09-09T01:46:40Z",
 			"summary": "my desc",
 			"title": "my title",
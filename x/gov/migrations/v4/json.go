@@ -24,6 +24,10 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.DelegatorVoteOverride,
+		defaultParams.VetoDepositDecay,
+		defaultParams.VotingMechanism,
+		*defaultParams.ReconsiderationPeriod,
 	)
 
 	return &v1.GenesisState{
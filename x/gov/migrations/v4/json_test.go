@@ -56,12 +56,15 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"delegator_vote_overrides": [],
 	"deposit_params": null,
 	"deposits": [],
 	"params": {
+		"bundled_min_deposit": [],
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"delegator_vote_override": false,
 		"max_deposit_period": "172800s",
 		"min_deposit": [
 			{
@@ -71,8 +74,11 @@ func TestMigrateJSON(t *testing.T) {
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
 		"quorum": "0.334000000000000000",
+		"reconsideration_period": "86400s",
 		"threshold": "0.500000000000000000",
+		"veto_deposit_decay": "0.000000000000000000",
 		"veto_threshold": "0.334000000000000000",
+		"voting_mechanism": "VOTING_MECHANISM_WEIGHTED_BY_STAKE",
 		"voting_period": "172800s"
 	},
 	"proposals": [],
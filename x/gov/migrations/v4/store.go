@@ -36,6 +36,10 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.DelegatorVoteOverride,
+		defaultParams.VetoDepositDecay,
+		defaultParams.VotingMechanism,
+		*defaultParams.ReconsiderationPeriod,
 	)
 
 	bz, err := cdc.Marshal(&params)
@@ -0,0 +1,35 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The migration
+// includes:
+// - Set the default VetoDepositDecay param
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params govv1.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	if params.VetoDepositDecay == "" {
+		params.VetoDepositDecay = govv1.DefaultVetoDepositDecay.String()
+	}
+
+	// the params are validated by the v7 migration, once they have all
+	// been set
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}).Codec
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// store params without the veto deposit decay
+	params := v1.DefaultParams()
+	params.VetoDepositDecay = ""
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+
+	var res v1.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &res))
+	require.Equal(t, v1.DefaultVetoDepositDecay.String(), res.VetoDepositDecay)
+
+	// a decay set after the params migration is kept
+	res.VetoDepositDecay = "0.100000000000000000"
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&res))
+
+	require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &res))
+	require.Equal(t, "0.100000000000000000", res.VetoDepositDecay)
+}
@@ -0,0 +1,6 @@
+package v7
+
+var (
+	// ParamsKey is the key of x/gov params
+	ParamsKey = []byte{0x30}
+)
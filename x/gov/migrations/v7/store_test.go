@@ -0,0 +1,43 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v7 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}).Codec
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// store params without the reconsideration period
+	params := v1.DefaultParams()
+	params.ReconsiderationPeriod = nil
+	store.Set(v7.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v7.MigrateStore(ctx, govKey, cdc))
+
+	var res v1.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v7.ParamsKey), &res))
+	require.Equal(t, v1.DefaultReconsiderationPeriod, *res.ReconsiderationPeriod)
+
+	// a period set after the params migration is kept
+	reconsiderationPeriod := time.Hour
+	res.ReconsiderationPeriod = &reconsiderationPeriod
+	store.Set(v7.ParamsKey, cdc.MustMarshal(&res))
+
+	require.NoError(t, v7.MigrateStore(ctx, govKey, cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(v7.ParamsKey), &res))
+	require.Equal(t, time.Hour, *res.ReconsiderationPeriod)
+}
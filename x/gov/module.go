@@ -37,7 +37,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-const ConsensusVersion = 4
+const ConsensusVersion = 7
 
 var (
 	_ module.EndBlockAppModule   = AppModule{}
@@ -294,6 +294,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/gov from version 3 to 4: %v", err))
 	}
+	err = cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
+	}
+	err = cfg.RegisterMigration(govtypes.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 5 to 6: %v", err))
+	}
+	err = cfg.RegisterMigration(govtypes.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 6 to 7: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
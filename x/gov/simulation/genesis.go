@@ -19,13 +19,15 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	DepositMinInitialRatio     = "deposit_params_min_initial_ratio"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit     = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod  = "deposit_params_deposit_period"
+	DepositMinInitialRatio      = "deposit_params_min_initial_ratio"
+	VotingParamsVotingPeriod    = "voting_params_voting_period"
+	TallyParamsQuorum           = "tally_params_quorum"
+	TallyParamsThreshold        = "tally_params_threshold"
+	TallyParamsVeto             = "tally_params_veto"
+	TallyParamsVetoDepositDecay = "tally_params_veto_deposit_decay"
+	ReconsiderationPeriod       = "reconsideration_period"
 )
 
 // GenDepositParamsDepositPeriod returns randomized DepositParamsDepositPeriod
@@ -63,6 +65,16 @@ func GenTallyParamsVeto(r *rand.Rand) math.LegacyDec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsVetoDepositDecay returns randomized TallyParamsVetoDepositDecay
+func GenTallyParamsVetoDepositDecay(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 500)), 3)
+}
+
+// GenReconsiderationPeriod returns randomized ReconsiderationPeriod
+func GenReconsiderationPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*24*2)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -109,9 +121,21 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var vetoDepositDecay sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsVetoDepositDecay, &vetoDepositDecay, simState.Rand,
+		func(r *rand.Rand) { vetoDepositDecay = GenTallyParamsVetoDepositDecay(r) },
+	)
+
+	var reconsiderationPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReconsiderationPeriod, &reconsiderationPeriod, simState.Rand,
+		func(r *rand.Rand) { reconsiderationPeriod = GenReconsiderationPeriod(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
+		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, vetoDepositDecay.String(), v1.VotingMechanism(simState.Rand.Intn(2)), reconsiderationPeriod),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -46,6 +46,7 @@ func TestRandomizedGenState(t *testing.T) {
 		tallyThreshold       = "0.539000000000000000"
 		tallyVetoThreshold   = "0.314000000000000000"
 		minInitialDepositDec = "0.590000000000000000"
+		vetoDepositDecay     = "0.274000000000000000"
 	)
 
 	require.Equal(t, "905stake", govGenesis.Params.MinDeposit[0].String())
@@ -54,6 +55,8 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, tallyQuorum, govGenesis.Params.Quorum)
 	require.Equal(t, tallyThreshold, govGenesis.Params.Threshold)
 	require.Equal(t, tallyVetoThreshold, govGenesis.Params.VetoThreshold)
+	require.Equal(t, vetoDepositDecay, govGenesis.Params.VetoDepositDecay)
+	require.Equal(t, "21h25m29s", govGenesis.Params.ReconsiderationPeriod.String())
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
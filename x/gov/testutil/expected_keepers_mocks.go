@@ -174,6 +174,20 @@ func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
 }
 
+// BurnAuthorizedCoins mocks base method.
+func (m *MockBankKeeper) BurnAuthorizedCoins(ctx types.Context, burner types.AccAddress, amounts types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnAuthorizedCoins", ctx, burner, amounts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnAuthorizedCoins indicates an expected call of BurnAuthorizedCoins.
+func (mr *MockBankKeeperMockRecorder) BurnAuthorizedCoins(ctx, burner, amounts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnAuthorizedCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnAuthorizedCoins), ctx, burner, amounts)
+}
+
 // BurnCoins mocks base method.
 func (m *MockBankKeeper) BurnCoins(ctx types.Context, moduleName string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -188,6 +202,21 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// CreateEscrow mocks base method.
+func (m *MockBankKeeper) CreateEscrow(ctx types.Context, sender, recipient types.AccAddress, sendAmount, receiveAmount types.Coins, expiryHeight int64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrow", ctx, sender, recipient, sendAmount, receiveAmount, expiryHeight)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrow indicates an expected call of CreateEscrow.
+func (mr *MockBankKeeperMockRecorder) CreateEscrow(ctx, sender, recipient, sendAmount, receiveAmount, expiryHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrow", reflect.TypeOf((*MockBankKeeper)(nil).CreateEscrow), ctx, sender, recipient, sendAmount, receiveAmount, expiryHeight)
+}
+
 // DelegateCoins mocks base method.
 func (m *MockBankKeeper) DelegateCoins(ctx types.Context, delegatorAddr, moduleAccAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -233,6 +262,21 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx interface{}, denoms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DenomHolders mocks base method.
+func (m *MockBankKeeper) DenomHolders(arg0 context.Context, arg1 *types1.QueryDenomHoldersRequest) (*types1.QueryDenomHoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomHolders", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomHoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomHolders indicates an expected call of DenomHolders.
+func (mr *MockBankKeeperMockRecorder) DenomHolders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomHolders", reflect.TypeOf((*MockBankKeeper)(nil).DenomHolders), arg0, arg1)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types1.QueryDenomMetadataRequest) (*types1.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +307,21 @@ func (mr *MockBankKeeperMockRecorder) DenomOwners(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomOwners", reflect.TypeOf((*MockBankKeeper)(nil).DenomOwners), arg0, arg1)
 }
 
+// DenomSupplyCap mocks base method.
+func (m *MockBankKeeper) DenomSupplyCap(arg0 context.Context, arg1 *types1.QueryDenomSupplyCapRequest) (*types1.QueryDenomSupplyCapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomSupplyCap", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomSupplyCapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomSupplyCap indicates an expected call of DenomSupplyCap.
+func (mr *MockBankKeeperMockRecorder) DenomSupplyCap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomSupplyCap", reflect.TypeOf((*MockBankKeeper)(nil).DenomSupplyCap), arg0, arg1)
+}
+
 // DenomsMetadata mocks base method.
 func (m *MockBankKeeper) DenomsMetadata(arg0 context.Context, arg1 *types1.QueryDenomsMetadataRequest) (*types1.QueryDenomsMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -278,6 +337,21 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// Escrow mocks base method.
+func (m *MockBankKeeper) Escrow(arg0 context.Context, arg1 *types1.QueryEscrowRequest) (*types1.QueryEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Escrow", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Escrow indicates an expected call of Escrow.
+func (mr *MockBankKeeperMockRecorder) Escrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Escrow", reflect.TypeOf((*MockBankKeeper)(nil).Escrow), arg0, arg1)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 types.Context) *types1.GenesisState {
 	m.ctrl.T.Helper()
@@ -292,6 +366,52 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// FreezeAccount mocks base method.
+func (m *MockBankKeeper) FreezeAccount(ctx types.Context, addr types.AccAddress, denoms ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, addr}
+	for _, a := range denoms {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "FreezeAccount", varargs...)
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockBankKeeperMockRecorder) FreezeAccount(ctx, addr interface{}, denoms ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, addr}, denoms...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockBankKeeper)(nil).FreezeAccount), varargs...)
+}
+
+// FrozenAccounts mocks base method.
+func (m *MockBankKeeper) FrozenAccounts(arg0 context.Context, arg1 *types1.QueryFrozenAccountsRequest) (*types1.QueryFrozenAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FrozenAccounts", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryFrozenAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FrozenAccounts indicates an expected call of FrozenAccounts.
+func (mr *MockBankKeeperMockRecorder) FrozenAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FrozenAccounts", reflect.TypeOf((*MockBankKeeper)(nil).FrozenAccounts), arg0, arg1)
+}
+
+// FulfillEscrow mocks base method.
+func (m *MockBankKeeper) FulfillEscrow(ctx types.Context, id uint64, recipient types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FulfillEscrow", ctx, id, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FulfillEscrow indicates an expected call of FulfillEscrow.
+func (mr *MockBankKeeperMockRecorder) FulfillEscrow(ctx, id, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FulfillEscrow", reflect.TypeOf((*MockBankKeeper)(nil).FulfillEscrow), ctx, id, recipient)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx types.Context) []types1.Balance {
 	m.ctrl.T.Helper()
@@ -334,6 +454,34 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllEscrows mocks base method.
+func (m *MockBankKeeper) GetAllEscrows(ctx types.Context) []types1.Escrow {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEscrows", ctx)
+	ret0, _ := ret[0].([]types1.Escrow)
+	return ret0
+}
+
+// GetAllEscrows indicates an expected call of GetAllEscrows.
+func (mr *MockBankKeeperMockRecorder) GetAllEscrows(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEscrows", reflect.TypeOf((*MockBankKeeper)(nil).GetAllEscrows), ctx)
+}
+
+// GetAllFrozenAccounts mocks base method.
+func (m *MockBankKeeper) GetAllFrozenAccounts(ctx types.Context) []types1.FrozenAccount {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFrozenAccounts", ctx)
+	ret0, _ := ret[0].([]types1.FrozenAccount)
+	return ret0
+}
+
+// GetAllFrozenAccounts indicates an expected call of GetAllFrozenAccounts.
+func (mr *MockBankKeeperMockRecorder) GetAllFrozenAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFrozenAccounts", reflect.TypeOf((*MockBankKeeper)(nil).GetAllFrozenAccounts), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx types.Context) []types1.SendEnabled {
 	m.ctrl.T.Helper()
@@ -348,6 +496,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllSendEnabledEntries(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendEnabledEntries), ctx)
 }
 
+// GetAllTransferMemos mocks base method.
+func (m *MockBankKeeper) GetAllTransferMemos(ctx types.Context) []types1.TransferMemo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTransferMemos", ctx)
+	ret0, _ := ret[0].([]types1.TransferMemo)
+	return ret0
+}
+
+// GetAllTransferMemos indicates an expected call of GetAllTransferMemos.
+func (mr *MockBankKeeperMockRecorder) GetAllTransferMemos(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTransferMemos", reflect.TypeOf((*MockBankKeeper)(nil).GetAllTransferMemos), ctx)
+}
+
 // GetAuthority mocks base method.
 func (m *MockBankKeeper) GetAuthority() string {
 	m.ctrl.T.Helper()
@@ -405,6 +567,36 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetDenomSupplyCap mocks base method.
+func (m *MockBankKeeper) GetDenomSupplyCap(ctx types.Context, denom string) (math.Int, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomSupplyCap", ctx, denom)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomSupplyCap indicates an expected call of GetDenomSupplyCap.
+func (mr *MockBankKeeperMockRecorder) GetDenomSupplyCap(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomSupplyCap", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomSupplyCap), ctx, denom)
+}
+
+// GetEscrow mocks base method.
+func (m *MockBankKeeper) GetEscrow(ctx types.Context, id uint64) (types1.Escrow, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrow", ctx, id)
+	ret0, _ := ret[0].(types1.Escrow)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetEscrow indicates an expected call of GetEscrow.
+func (mr *MockBankKeeperMockRecorder) GetEscrow(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrow", reflect.TypeOf((*MockBankKeeper)(nil).GetEscrow), ctx, id)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx types.Context, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -464,6 +656,49 @@ func (mr *MockBankKeeperMockRecorder) GetSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), ctx, denom)
 }
 
+// GetTopDenomsBySupply mocks base method.
+func (m *MockBankKeeper) GetTopDenomsBySupply(ctx types.Context, limit uint32) []types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopDenomsBySupply", ctx, limit)
+	ret0, _ := ret[0].([]types.Coin)
+	return ret0
+}
+
+// GetTopDenomsBySupply indicates an expected call of GetTopDenomsBySupply.
+func (mr *MockBankKeeperMockRecorder) GetTopDenomsBySupply(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopDenomsBySupply", reflect.TypeOf((*MockBankKeeper)(nil).GetTopDenomsBySupply), ctx, limit)
+}
+
+// GetTransferMemo mocks base method.
+func (m *MockBankKeeper) GetTransferMemo(ctx types.Context, sender types.AccAddress, sequence uint64) (types1.TransferMemo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferMemo", ctx, sender, sequence)
+	ret0, _ := ret[0].(types1.TransferMemo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetTransferMemo indicates an expected call of GetTransferMemo.
+func (mr *MockBankKeeperMockRecorder) GetTransferMemo(ctx, sender, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferMemo", reflect.TypeOf((*MockBankKeeper)(nil).GetTransferMemo), ctx, sender, sequence)
+}
+
+// GrantBurnAuthorization mocks base method.
+func (m *MockBankKeeper) GrantBurnAuthorization(ctx types.Context, grantee types.AccAddress, maxAmount types.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantBurnAuthorization", ctx, grantee, maxAmount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantBurnAuthorization indicates an expected call of GrantBurnAuthorization.
+func (mr *MockBankKeeperMockRecorder) GrantBurnAuthorization(ctx, grantee, maxAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantBurnAuthorization", reflect.TypeOf((*MockBankKeeper)(nil).GrantBurnAuthorization), ctx, grantee, maxAmount)
+}
+
 // HasBalance mocks base method.
 func (m *MockBankKeeper) HasBalance(ctx types.Context, addr types.AccAddress, amt types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -532,6 +767,48 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, inputs, outputs inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, inputs, outputs)
 }
 
+// InputOutputCoinsWithTransferTax mocks base method.
+func (m *MockBankKeeper) InputOutputCoinsWithTransferTax(ctx types.Context, inputs []types1.Input, outputs []types1.Output) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InputOutputCoinsWithTransferTax", ctx, inputs, outputs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InputOutputCoinsWithTransferTax indicates an expected call of InputOutputCoinsWithTransferTax.
+func (mr *MockBankKeeperMockRecorder) InputOutputCoinsWithTransferTax(ctx, inputs, outputs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoinsWithTransferTax", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoinsWithTransferTax), ctx, inputs, outputs)
+}
+
+// IsAccountFrozen mocks base method.
+func (m *MockBankKeeper) IsAccountFrozen(ctx types.Context, addr types.AccAddress, denom string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccountFrozen", ctx, addr, denom)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAccountFrozen indicates an expected call of IsAccountFrozen.
+func (mr *MockBankKeeperMockRecorder) IsAccountFrozen(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccountFrozen", reflect.TypeOf((*MockBankKeeper)(nil).IsAccountFrozen), ctx, addr, denom)
+}
+
+// IsFreezeAuthority mocks base method.
+func (m *MockBankKeeper) IsFreezeAuthority(ctx types.Context, authority string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFreezeAuthority", ctx, authority)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsFreezeAuthority indicates an expected call of IsFreezeAuthority.
+func (mr *MockBankKeeperMockRecorder) IsFreezeAuthority(ctx, authority interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFreezeAuthority", reflect.TypeOf((*MockBankKeeper)(nil).IsFreezeAuthority), ctx, authority)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx types.Context, coin types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -615,6 +892,30 @@ func (mr *MockBankKeeperMockRecorder) IterateAllDenomMetaData(ctx, cb interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllDenomMetaData), ctx, cb)
 }
 
+// IterateEscrows mocks base method.
+func (m *MockBankKeeper) IterateEscrows(ctx types.Context, cb func(types1.Escrow) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateEscrows", ctx, cb)
+}
+
+// IterateEscrows indicates an expected call of IterateEscrows.
+func (mr *MockBankKeeperMockRecorder) IterateEscrows(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateEscrows", reflect.TypeOf((*MockBankKeeper)(nil).IterateEscrows), ctx, cb)
+}
+
+// IterateFrozenAccounts mocks base method.
+func (m *MockBankKeeper) IterateFrozenAccounts(ctx types.Context, cb func(types.AccAddress, string) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateFrozenAccounts", ctx, cb)
+}
+
+// IterateFrozenAccounts indicates an expected call of IterateFrozenAccounts.
+func (mr *MockBankKeeperMockRecorder) IterateFrozenAccounts(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateFrozenAccounts", reflect.TypeOf((*MockBankKeeper)(nil).IterateFrozenAccounts), ctx, cb)
+}
+
 // IterateSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) IterateSendEnabledEntries(ctx types.Context, cb func(string, bool) bool) {
 	m.ctrl.T.Helper()
@@ -639,6 +940,18 @@ func (mr *MockBankKeeperMockRecorder) IterateTotalSupply(ctx, cb interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).IterateTotalSupply), ctx, cb)
 }
 
+// IterateTransferMemos mocks base method.
+func (m *MockBankKeeper) IterateTransferMemos(ctx types.Context, cb func(types1.TransferMemo) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateTransferMemos", ctx, cb)
+}
+
+// IterateTransferMemos indicates an expected call of IterateTransferMemos.
+func (mr *MockBankKeeperMockRecorder) IterateTransferMemos(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTransferMemos", reflect.TypeOf((*MockBankKeeper)(nil).IterateTransferMemos), ctx, cb)
+}
+
 // LockedCoins mocks base method.
 func (m *MockBankKeeper) LockedCoins(ctx types.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -682,6 +995,63 @@ func (mr *MockBankKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockBankKeeper)(nil).Params), arg0, arg1)
 }
 
+// PruneExpiredBurnAuthorizations mocks base method.
+func (m *MockBankKeeper) PruneExpiredBurnAuthorizations(ctx types.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneExpiredBurnAuthorizations", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneExpiredBurnAuthorizations indicates an expected call of PruneExpiredBurnAuthorizations.
+func (mr *MockBankKeeperMockRecorder) PruneExpiredBurnAuthorizations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneExpiredBurnAuthorizations", reflect.TypeOf((*MockBankKeeper)(nil).PruneExpiredBurnAuthorizations), ctx)
+}
+
+// PruneTransferMemos mocks base method.
+func (m *MockBankKeeper) PruneTransferMemos(ctx types.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneTransferMemos", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneTransferMemos indicates an expected call of PruneTransferMemos.
+func (mr *MockBankKeeperMockRecorder) PruneTransferMemos(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneTransferMemos", reflect.TypeOf((*MockBankKeeper)(nil).PruneTransferMemos), ctx)
+}
+
+// RecordTransferMemo mocks base method.
+func (m *MockBankKeeper) RecordTransferMemo(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins, memo string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTransferMemo", ctx, fromAddr, toAddr, amt, memo)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordTransferMemo indicates an expected call of RecordTransferMemo.
+func (mr *MockBankKeeperMockRecorder) RecordTransferMemo(ctx, fromAddr, toAddr, amt, memo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTransferMemo", reflect.TypeOf((*MockBankKeeper)(nil).RecordTransferMemo), ctx, fromAddr, toAddr, amt, memo)
+}
+
+// RefundExpiredEscrows mocks base method.
+func (m *MockBankKeeper) RefundExpiredEscrows(ctx types.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundExpiredEscrows", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundExpiredEscrows indicates an expected call of RefundExpiredEscrows.
+func (mr *MockBankKeeperMockRecorder) RefundExpiredEscrows(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundExpiredEscrows", reflect.TypeOf((*MockBankKeeper)(nil).RefundExpiredEscrows), ctx)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -738,6 +1108,36 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderMod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
 }
 
+// SendCoinsWithMemo mocks base method.
+func (m *MockBankKeeper) SendCoinsWithMemo(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins, memo string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsWithMemo", ctx, fromAddr, toAddr, amt, memo)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCoinsWithMemo indicates an expected call of SendCoinsWithMemo.
+func (mr *MockBankKeeperMockRecorder) SendCoinsWithMemo(ctx, fromAddr, toAddr, amt, memo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsWithMemo", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsWithMemo), ctx, fromAddr, toAddr, amt, memo)
+}
+
+// SendCoinsWithTransferTax mocks base method.
+func (m *MockBankKeeper) SendCoinsWithTransferTax(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsWithTransferTax", ctx, fromAddr, toAddr, amt)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCoinsWithTransferTax indicates an expected call of SendCoinsWithTransferTax.
+func (mr *MockBankKeeperMockRecorder) SendCoinsWithTransferTax(ctx, fromAddr, toAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsWithTransferTax", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsWithTransferTax), ctx, fromAddr, toAddr, amt)
+}
+
 // SendEnabled mocks base method.
 func (m *MockBankKeeper) SendEnabled(arg0 context.Context, arg1 *types1.QuerySendEnabledRequest) (*types1.QuerySendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -765,6 +1165,18 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetAuthzKeeper mocks base method.
+func (m *MockBankKeeper) SetAuthzKeeper(arg0 types1.AuthzKeeper) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuthzKeeper", arg0)
+}
+
+// SetAuthzKeeper indicates an expected call of SetAuthzKeeper.
+func (mr *MockBankKeeperMockRecorder) SetAuthzKeeper(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuthzKeeper", reflect.TypeOf((*MockBankKeeper)(nil).SetAuthzKeeper), arg0)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx types.Context, denomMetaData types1.Metadata) {
 	m.ctrl.T.Helper()
@@ -777,6 +1189,18 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetDistributionKeeper mocks base method.
+func (m *MockBankKeeper) SetDistributionKeeper(arg0 types1.DistributionKeeper) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDistributionKeeper", arg0)
+}
+
+// SetDistributionKeeper indicates an expected call of SetDistributionKeeper.
+func (mr *MockBankKeeperMockRecorder) SetDistributionKeeper(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDistributionKeeper", reflect.TypeOf((*MockBankKeeper)(nil).SetDistributionKeeper), arg0)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx types.Context, params types1.Params) error {
 	m.ctrl.T.Helper()
@@ -876,6 +1300,21 @@ func (mr *MockBankKeeperMockRecorder) SupplyOf(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyOf", reflect.TypeOf((*MockBankKeeper)(nil).SupplyOf), arg0, arg1)
 }
 
+// TopDenomsBySupply mocks base method.
+func (m *MockBankKeeper) TopDenomsBySupply(arg0 context.Context, arg1 *types1.QueryTopDenomsBySupplyRequest) (*types1.QueryTopDenomsBySupplyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopDenomsBySupply", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryTopDenomsBySupplyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopDenomsBySupply indicates an expected call of TopDenomsBySupply.
+func (mr *MockBankKeeperMockRecorder) TopDenomsBySupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopDenomsBySupply", reflect.TypeOf((*MockBankKeeper)(nil).TopDenomsBySupply), arg0, arg1)
+}
+
 // TotalSupply mocks base method.
 func (m *MockBankKeeper) TotalSupply(arg0 context.Context, arg1 *types1.QueryTotalSupplyRequest) (*types1.QueryTotalSupplyResponse, error) {
 	m.ctrl.T.Helper()
@@ -891,6 +1330,21 @@ func (mr *MockBankKeeperMockRecorder) TotalSupply(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).TotalSupply), arg0, arg1)
 }
 
+// TransferMemo mocks base method.
+func (m *MockBankKeeper) TransferMemo(arg0 context.Context, arg1 *types1.QueryTransferMemoRequest) (*types1.QueryTransferMemoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferMemo", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryTransferMemoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferMemo indicates an expected call of TransferMemo.
+func (mr *MockBankKeeperMockRecorder) TransferMemo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferMemo", reflect.TypeOf((*MockBankKeeper)(nil).TransferMemo), arg0, arg1)
+}
+
 // UndelegateCoins mocks base method.
 func (m *MockBankKeeper) UndelegateCoins(ctx types.Context, moduleAccAddr, delegatorAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -919,6 +1373,23 @@ func (mr *MockBankKeeperMockRecorder) UndelegateCoinsFromModuleToAccount(ctx, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).UndelegateCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// UnfreezeAccount mocks base method.
+func (m *MockBankKeeper) UnfreezeAccount(ctx types.Context, addr types.AccAddress, denoms ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, addr}
+	for _, a := range denoms {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "UnfreezeAccount", varargs...)
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockBankKeeperMockRecorder) UnfreezeAccount(ctx, addr interface{}, denoms ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, addr}, denoms...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockBankKeeper)(nil).UnfreezeAccount), varargs...)
+}
+
 // ValidateBalance mocks base method.
 func (m *MockBankKeeper) ValidateBalance(ctx types.Context, addr types.AccAddress) error {
 	m.ctrl.T.Helper()
@@ -984,6 +1455,20 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// Delegation mocks base method.
+func (m *MockStakingKeeper) Delegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) types2.DelegationI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types2.DelegationI)
+	return ret0
+}
+
+// Delegation indicates an expected call of Delegation.
+func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), ctx, delAddr, valAddr)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
 func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 types.Context, arg1 func(int64, types2.ValidatorI) bool) {
 	m.ctrl.T.Helper()
@@ -4,11 +4,16 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+
+	// MaxPrunedProposals defines the maximum number of proposals pruned by a
+	// MsgPruneOldProposals.
+	MaxPrunedProposals uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:     255,
+		MaxPrunedProposals: 100,
 	}
 }
@@ -22,4 +22,8 @@ var (
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 14, "signal message is invalid")
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 15, "metadata too long")
 	ErrMinDepositTooSmall      = sdkerrors.Register(ModuleName, 16, "minimum deposit is too small")
+	ErrVoteOverrideDisabled    = sdkerrors.Register(ModuleName, 17, "delegator vote override is disabled")
+	ErrNoDelegation            = sdkerrors.Register(ModuleName, 18, "no delegation to validator")
+	ErrNotInDepositPeriod      = sdkerrors.Register(ModuleName, 19, "proposal is not in deposit period")
+	ErrNoWithdrawableDeposit   = sdkerrors.Register(ModuleName, 20, "no withdrawable deposit")
 )
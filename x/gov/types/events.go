@@ -4,17 +4,23 @@ package types
 const (
 	EventTypeSubmitProposal   = "submit_proposal"
 	EventTypeProposalDeposit  = "proposal_deposit"
+	EventTypeProposalAmended  = "proposal_amended"
+	EventTypeWithdrawDeposit  = "withdraw_deposit"
 	EventTypeProposalVote     = "proposal_vote"
+	EventTypeVoteOverride     = "proposal_vote_override"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
 
 	AttributeKeyVoter              = "voter"
+	AttributeKeyDepositor          = "depositor"
+	AttributeKeyValidator          = "validator"
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
 	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
+	AttributeKeyReconsiderationEnd = "reconsideration_end_time"
 	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
 	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
@@ -33,10 +33,14 @@ const (
 //
 // - 0x04<proposalID_Bytes>: []byte{0x01} if proposalID is in the voting period
 //
+// - 0x05<keywordLen (1 Byte)><keyword_Bytes><proposalID_Bytes>: []byte{} if the proposal title or summary contains keyword
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
+// - 0x21<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: DelegatorVoteOverride
+//
 // - 0x30: Params
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
@@ -44,10 +48,12 @@ var (
 	InactiveProposalQueuePrefix   = []byte{0x02}
 	ProposalIDKey                 = []byte{0x03}
 	VotingPeriodProposalKeyPrefix = []byte{0x04}
+	ProposalKeywordIndexPrefix    = []byte{0x05}
 
 	DepositsKeyPrefix = []byte{0x10}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix                  = []byte{0x20}
+	DelegatorVoteOverridesKeyPrefix = []byte{0x21}
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
@@ -77,6 +83,17 @@ func VotingPeriodProposalKey(proposalID uint64) []byte {
 	return append(VotingPeriodProposalKeyPrefix, GetProposalIDBytes(proposalID)...)
 }
 
+// ProposalsByKeywordKey gets the first part of the keyword index key based on
+// the keyword
+func ProposalsByKeywordKey(keyword string) []byte {
+	return append(ProposalKeywordIndexPrefix, address.MustLengthPrefix([]byte(keyword))...)
+}
+
+// ProposalKeywordKey returns the key indexing a proposal by one of its keywords
+func ProposalKeywordKey(keyword string, proposalID uint64) []byte {
+	return append(ProposalsByKeywordKey(keyword), GetProposalIDBytes(proposalID)...)
+}
+
 // ActiveProposalByTimeKey gets the active proposal queue key by endTime
 func ActiveProposalByTimeKey(endTime time.Time) []byte {
 	return append(ActiveProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
@@ -117,6 +134,19 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// DelegatorVoteOverridesKey gets the first part of the delegator vote overrides
+// key based on the proposalID
+func DelegatorVoteOverridesKey(proposalID uint64) []byte {
+	return append(DelegatorVoteOverridesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// DelegatorVoteOverrideKey key of the vote of a delegator overriding the vote of
+// a specific validator from the store
+func DelegatorVoteOverrideKey(proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	key := append(DelegatorVoteOverridesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+	return append(key, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
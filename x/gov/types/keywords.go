@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxProposalKeywords is the maximum number of keywords a proposal is
+	// indexed by, bounding the storage used by the keyword index.
+	MaxProposalKeywords = 1000
+
+	// MaxKeywordLength is the maximum length in bytes of an indexed keyword.
+	// Longer words are not indexed.
+	MaxKeywordLength = 64
+)
+
+// Keywords splits the given text into lowercase words made of letters and
+// numbers, skipping the duplicates and the words longer than MaxKeywordLength.
+func Keywords(texts ...string) []string {
+	var keywords []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+
+		for _, word := range words {
+			if len(word) > MaxKeywordLength || seen[word] {
+				continue
+			}
+
+			seen[word] = true
+			keywords = append(keywords, word)
+		}
+	}
+
+	return keywords
+}
+
+// ProposalKeywords returns the keywords a proposal with the given title and
+// summary is indexed by, i.e. the first MaxProposalKeywords keywords of its
+// title and summary.
+func ProposalKeywords(title, summary string) []string {
+	keywords := Keywords(title, summary)
+	if len(keywords) > MaxProposalKeywords {
+		keywords = keywords[:MaxProposalKeywords]
+	}
+
+	return keywords
+}
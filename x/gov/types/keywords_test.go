@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeywords(t *testing.T) {
+	require.Empty(t, Keywords(""))
+	require.Equal(t, []string{"upgrade", "to", "v0", "47", "the", "chain"}, Keywords("Upgrade to v0.47!", "Upgrade the chain to v0.47."))
+	require.Equal(t, []string{"réseau", "ünïcode"}, Keywords("Réseau, ÜNÏCODE"))
+
+	// long words are not indexed
+	require.Equal(t, []string{"short"}, Keywords(strings.Repeat("a", MaxKeywordLength+1)+" short"))
+}
+
+func TestProposalKeywords(t *testing.T) {
+	words := make([]string, MaxProposalKeywords+10)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%d", i)
+	}
+
+	keywords := ProposalKeywords("title", strings.Join(words, " "))
+	require.Len(t, keywords, MaxProposalKeywords)
+	require.Equal(t, "title", keywords[0])
+	require.Equal(t, "w0", keywords[1])
+}
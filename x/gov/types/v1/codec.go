@@ -16,10 +16,14 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "cosmos-sdk/v1/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgDeposit{}, "cosmos-sdk/v1/MsgDeposit")
+	legacy.RegisterAminoMsg(cdc, &MsgAmendProposal{}, "cosmos-sdk/v1/MsgAmendProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawDeposit{}, "cosmos-sdk/v1/MsgWithdrawDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteOnBehalfOf{}, "cosmos-sdk/v1/MsgVoteOnBehalfOf")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgPruneOldProposals{}, "cosmos-sdk/v1/MsgPruneOldProposals")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -28,9 +32,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgVoteOnBehalfOf{},
 		&MsgDeposit{},
+		&MsgAmendProposal{},
+		&MsgWithdrawDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgPruneOldProposals{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
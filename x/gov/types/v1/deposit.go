@@ -10,7 +10,7 @@ import (
 //
 //nolint:interfacer
 func NewDeposit(proposalID uint64, depositor sdk.AccAddress, amount sdk.Coins) Deposit {
-	return Deposit{proposalID, depositor.String(), amount}
+	return Deposit{ProposalId: proposalID, Depositor: depositor.String(), Amount: amount}
 }
 
 // Deposits is a collection of Deposit objects
@@ -33,7 +33,8 @@ func (data GenesisState) Empty() bool {
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
 // It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// that there are no duplicate deposit, vote or delegator vote override records and no vote, vote override
+// or deposits for non-existent proposals
 func ValidateGenesis(data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -97,6 +98,30 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate delegator vote overrides
+	errGroup.Go(func() error {
+		type overrideKey struct {
+			ProposalId       uint64
+			Voter            string
+			ValidatorAddress string
+		}
+		overrideIds := make(map[overrideKey]struct{})
+		for _, o := range data.DelegatorVoteOverrides {
+			if _, ok := proposalIds[o.ProposalId]; !ok {
+				return fmt.Errorf("delegator vote override %v has non-existent proposal id: %d", o, o.ProposalId)
+			}
+
+			ovk := overrideKey{o.ProposalId, o.Voter, o.ValidatorAddress}
+			if _, ok := overrideIds[ovk]; ok {
+				return fmt.Errorf("duplicate delegator vote override: %v", o)
+			}
+
+			overrideIds[ovk] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
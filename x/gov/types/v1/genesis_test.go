@@ -90,6 +90,16 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "veto threshold too large",
 		},
+		{
+			name: "invalid voting mechanism",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.VotingMechanism = v1.VotingMechanism(2)
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid voting mechanism",
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -120,6 +130,27 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "duplicate vote",
 		},
+		{
+			name: "duplicate delegator vote overrides",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.DelegatorVoteOverrides = append(state.DelegatorVoteOverrides,
+					&v1.DelegatorVoteOverride{
+						ProposalId:       1,
+						Voter:            "voter",
+						ValidatorAddress: "validator",
+					},
+					&v1.DelegatorVoteOverride{
+						ProposalId:       1,
+						Voter:            "voter",
+						ValidatorAddress: "validator",
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate delegator vote override",
+		},
 		{
 			name: "duplicate deposits",
 			genesisState: func() *v1.GenesisState {
@@ -153,6 +184,21 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "vote proposal_id:1 voter:\"voter\"  has non-existent proposal id: 1",
 		},
+		{
+			name: "non-existent proposal id in delegator vote overrides",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DelegatorVoteOverrides = append(state.DelegatorVoteOverrides,
+					&v1.DelegatorVoteOverride{
+						ProposalId:       1,
+						Voter:            "voter",
+						ValidatorAddress: "validator",
+					})
+
+				return state
+			},
+			expErrMsg: "has non-existent proposal id: 1",
+		},
 		{
 			name: "non-existent proposal id in deposits",
 			genesisState: func() *v1.GenesisState {
@@ -72,6 +72,37 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// VotingMechanism enumerates the ways the voting power of a voter is derived
+// from its stake.
+type VotingMechanism int32
+
+const (
+	// VOTING_MECHANISM_WEIGHTED_BY_STAKE defines a voting power equal to the
+	// stake of the voter.
+	VotingMechanism_VOTING_MECHANISM_WEIGHTED_BY_STAKE VotingMechanism = 0
+	// VOTING_MECHANISM_QUADRATIC_BY_STAKE defines a voting power equal to the
+	// square root of the stake of the voter.
+	VotingMechanism_VOTING_MECHANISM_QUADRATIC_BY_STAKE VotingMechanism = 1
+)
+
+var VotingMechanism_name = map[int32]string{
+	0: "VOTING_MECHANISM_WEIGHTED_BY_STAKE",
+	1: "VOTING_MECHANISM_QUADRATIC_BY_STAKE",
+}
+
+var VotingMechanism_value = map[string]int32{
+	"VOTING_MECHANISM_WEIGHTED_BY_STAKE":  0,
+	"VOTING_MECHANISM_QUADRATIC_BY_STAKE": 1,
+}
+
+func (x VotingMechanism) String() string {
+	return proto.EnumName(VotingMechanism_name, int32(x))
+}
+
+func (VotingMechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -118,7 +149,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -185,6 +216,10 @@ type Deposit struct {
 	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// amount to be deposited by depositor.
 	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	// withdrawable_amount is the part of the amount deposited before the last
+	// amendment of the proposal, which the depositor can withdraw until the
+	// reconsideration end time of the proposal.
+	WithdrawableAmount []types.Coin `protobuf:"bytes,4,rep,name=withdrawable_amount,json=withdrawableAmount,proto3" json:"withdrawable_amount"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
@@ -241,6 +276,13 @@ func (m *Deposit) GetAmount() []types.Coin {
 	return nil
 }
 
+func (m *Deposit) GetWithdrawableAmount() []types.Coin {
+	if m != nil {
+		return m.WithdrawableAmount
+	}
+	return nil
+}
+
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	// id defines the unique id of the proposal.
@@ -277,6 +319,16 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// submit_height is the block height at which the proposal was submitted.
+	SubmitHeight int64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// message_results are the execution results of the proposal messages, in
+	// order, populated once a passed proposal has been executed. Execution stops
+	// at the first failing message, so messages after it have no result.
+	MessageResults []*MessageResult `protobuf:"bytes,15,rep,name=message_results,json=messageResults,proto3" json:"message_results,omitempty"`
+	// reconsideration_end_time is the end of the window, following the last
+	// amendment of the proposal, during which the deposits made before the
+	// amendment can be withdrawn.
+	ReconsiderationEndTime *time.Time `protobuf:"bytes,16,opt,name=reconsideration_end_time,json=reconsiderationEndTime,proto3,stdtime" json:"reconsideration_end_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -403,6 +455,93 @@ func (m *Proposal) GetProposer() string {
 	return ""
 }
 
+func (m *Proposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetMessageResults() []*MessageResult {
+	if m != nil {
+		return m.MessageResults
+	}
+	return nil
+}
+
+func (m *Proposal) GetReconsiderationEndTime() *time.Time {
+	if m != nil {
+		return m.ReconsiderationEndTime
+	}
+	return nil
+}
+
+// MessageResult defines the execution result of a single proposal message.
+// The messages of a proposal are executed atomically: if any of them fails,
+// the state changes of all of them are discarded.
+type MessageResult struct {
+	// msg_type_url is the type URL of the executed message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// success is true if the message was executed without error.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the execution error of the message, if it failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MessageResult) Reset()         { *m = MessageResult{} }
+func (m *MessageResult) String() string { return proto.CompactTextString(m) }
+func (*MessageResult) ProtoMessage()    {}
+func (*MessageResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+func (m *MessageResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageResult.Merge(m, src)
+}
+func (m *MessageResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageResult proto.InternalMessageInfo
+
+func (m *MessageResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MessageResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -419,7 +558,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +632,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,6 +689,80 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+// DelegatorVoteOverride defines a vote of a delegator overriding the vote of a
+// single validator it delegates to on a proposal.
+type DelegatorVoteOverride struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the delegator overriding the validator vote.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// validator_address is the address of the validator whose vote is overridden.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// option defines the vote option of the delegator.
+	Option VoteOption `protobuf:"varint,4,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
+}
+
+func (m *DelegatorVoteOverride) Reset()         { *m = DelegatorVoteOverride{} }
+func (m *DelegatorVoteOverride) String() string { return proto.CompactTextString(m) }
+func (*DelegatorVoteOverride) ProtoMessage()    {}
+func (*DelegatorVoteOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
+}
+func (m *DelegatorVoteOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorVoteOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorVoteOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorVoteOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorVoteOverride.Merge(m, src)
+}
+func (m *DelegatorVoteOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorVoteOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorVoteOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorVoteOverride proto.InternalMessageInfo
+
+func (m *DelegatorVoteOverride) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *DelegatorVoteOverride) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *DelegatorVoteOverride) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorVoteOverride) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	// Minimum deposit for a proposal to enter voting period.
@@ -563,7 +776,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +829,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +881,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -729,15 +942,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -745,13 +958,27 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// allow delegators to override the vote of a single validator they delegate
+	// to with MsgVoteOnBehalfOf
+	DelegatorVoteOverride bool `protobuf:"varint,16,opt,name=delegator_vote_override,json=delegatorVoteOverride,proto3" json:"delegator_vote_override,omitempty"`
+	// The ratio of the deposits burned when the ratio of Veto votes to total
+	// votes is at least half of the veto threshold, without exceeding it. The
+	// remainder of the deposits is refunded.
+	VetoDepositDecay string `protobuf:"bytes,17,opt,name=veto_deposit_decay,json=vetoDepositDecay,proto3" json:"veto_deposit_decay,omitempty"`
+	// the way the voting power of voters is derived from their stake when
+	// tallying proposals
+	VotingMechanism VotingMechanism `protobuf:"varint,18,opt,name=voting_mechanism,json=votingMechanism,proto3,enum=cosmos.gov.v1.VotingMechanism" json:"voting_mechanism,omitempty"`
+	// Minimum deposit for a proposal bundling more than one message to enter
+	// voting period. min_deposit applies when empty.
+	BundledMinDeposit     []types.Coin   `protobuf:"bytes,19,rep,name=bundled_min_deposit,json=bundledMinDeposit,proto3" json:"bundled_min_deposit"`
+	ReconsiderationPeriod *time.Duration `protobuf:"bytes,20,opt,name=reconsideration_period,json=reconsiderationPeriod,proto3,stdduration" json:"reconsideration_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,14 +1077,52 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetDelegatorVoteOverride() bool {
+	if m != nil {
+		return m.DelegatorVoteOverride
+	}
+	return false
+}
+
+func (m *Params) GetVetoDepositDecay() string {
+	if m != nil {
+		return m.VetoDepositDecay
+	}
+	return ""
+}
+
+func (m *Params) GetVotingMechanism() VotingMechanism {
+	if m != nil {
+		return m.VotingMechanism
+	}
+	return VotingMechanism_VOTING_MECHANISM_WEIGHTED_BY_STAKE
+}
+
+func (m *Params) GetBundledMinDeposit() []types.Coin {
+	if m != nil {
+		return m.BundledMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetReconsiderationPeriod() *time.Duration {
+	if m != nil {
+		return m.ReconsiderationPeriod
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.VotingMechanism", VotingMechanism_name, VotingMechanism_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*MessageResult)(nil), "cosmos.gov.v1.MessageResult")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
+	proto.RegisterType((*DelegatorVoteOverride)(nil), "cosmos.gov.v1.DelegatorVoteOverride")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
@@ -867,87 +1132,110 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xd3, 0xc6,
-	0x17, 0x8f, 0x6c, 0xd9, 0x71, 0x9e, 0x63, 0x47, 0x2c, 0xf9, 0x82, 0x12, 0x88, 0x1d, 0x3c, 0x0c,
-	0x93, 0x2f, 0x3f, 0xec, 0x6f, 0xe0, 0x4b, 0x2f, 0xf4, 0xe2, 0xc4, 0xa2, 0x88, 0xa1, 0xb1, 0x2b,
-	0x8b, 0x30, 0xf4, 0xa2, 0x91, 0xa3, 0xc5, 0xd9, 0xa9, 0xa5, 0x75, 0xa5, 0xb5, 0xc1, 0x7f, 0x42,
-	0x6f, 0x1c, 0x3b, 0x3d, 0xf5, 0xd8, 0x63, 0x0f, 0x4c, 0xef, 0xbd, 0x71, 0x6a, 0x19, 0x2e, 0x6d,
-	0x2f, 0xb4, 0x03, 0x87, 0xce, 0xf0, 0x57, 0x74, 0x76, 0xb5, 0xb2, 0x1d, 0xc7, 0x9d, 0x04, 0x2e,
-	0xb1, 0xf4, 0xde, 0xe7, 0xf3, 0xde, 0xdb, 0xf7, 0x6b, 0x15, 0x38, 0x7f, 0x40, 0x23, 0x9f, 0x46,
-	0xb5, 0x2e, 0x1d, 0xd6, 0x86, 0xdb, 0xfc, 0xa7, 0xda, 0x0f, 0x29, 0xa3, 0xa8, 0x10, 0x2b, 0xaa,
-	0x5c, 0x32, 0xdc, 0x5e, 0x2f, 0x49, 0x5c, 0xc7, 0x8d, 0x70, 0x6d, 0xb8, 0xdd, 0xc1, 0xcc, 0xdd,
-	0xae, 0x1d, 0x50, 0x12, 0xc4, 0xf0, 0xf5, 0xd5, 0x2e, 0xed, 0x52, 0xf1, 0x58, 0xe3, 0x4f, 0x52,
-	0x5a, 0xee, 0x52, 0xda, 0xed, 0xe1, 0x9a, 0x78, 0xeb, 0x0c, 0x9e, 0xd4, 0x18, 0xf1, 0x71, 0xc4,
-	0x5c, 0xbf, 0x2f, 0x01, 0x6b, 0xb3, 0x00, 0x37, 0x18, 0x49, 0x55, 0x69, 0x56, 0xe5, 0x0d, 0x42,
-	0x97, 0x11, 0x9a, 0x78, 0x5c, 0x8b, 0x23, 0x72, 0x62, 0xa7, 0x32, 0xda, 0x58, 0x75, 0xc6, 0xf5,
-	0x49, 0x40, 0x6b, 0xe2, 0x6f, 0x2c, 0xaa, 0x50, 0x40, 0x8f, 0x30, 0xe9, 0x1e, 0x32, 0xec, 0xed,
-	0x53, 0x86, 0x9b, 0x7d, 0x6e, 0x09, 0x6d, 0x43, 0x96, 0x8a, 0x27, 0x5d, 0xd9, 0x54, 0xb6, 0x8a,
-	0x37, 0xd7, 0xaa, 0x47, 0x4e, 0x5d, 0x9d, 0x40, 0x2d, 0x09, 0x44, 0x57, 0x20, 0xfb, 0x54, 0x18,
-	0xd2, 0x53, 0x9b, 0xca, 0xd6, 0xd2, 0x4e, 0xf1, 0xf5, 0x8b, 0x1b, 0x20, 0x59, 0x0d, 0x7c, 0x60,
-	0x49, 0x6d, 0xe5, 0x7b, 0x05, 0x16, 0x1b, 0xb8, 0x4f, 0x23, 0xc2, 0x50, 0x19, 0xf2, 0xfd, 0x90,
-	0xf6, 0x69, 0xe4, 0xf6, 0x1c, 0xe2, 0x09, 0x5f, 0xaa, 0x05, 0x89, 0xc8, 0xf4, 0xd0, 0x27, 0xb0,
-	0xe4, 0xc5, 0x58, 0x1a, 0x4a, 0xbb, 0xfa, 0xeb, 0x17, 0x37, 0x56, 0xa5, 0xdd, 0xba, 0xe7, 0x85,
-	0x38, 0x8a, 0xda, 0x2c, 0x24, 0x41, 0xd7, 0x9a, 0x40, 0xd1, 0xa7, 0x90, 0x75, 0x7d, 0x3a, 0x08,
-	0x98, 0x9e, 0xde, 0x4c, 0x6f, 0xe5, 0x27, 0xf1, 0xf3, 0x32, 0x55, 0x65, 0x99, 0xaa, 0xbb, 0x94,
-	0x04, 0x3b, 0x4b, 0x2f, 0xdf, 0x94, 0x17, 0x7e, 0xf8, 0xfb, 0xc7, 0xab, 0x8a, 0x25, 0x39, 0x95,
-	0x9f, 0x33, 0x90, 0x6b, 0xc9, 0x20, 0x50, 0x11, 0x52, 0xe3, 0xd0, 0x52, 0xc4, 0x43, 0xff, 0x83,
-	0x9c, 0x8f, 0xa3, 0xc8, 0xed, 0xe2, 0x48, 0x4f, 0x09, 0xe3, 0xab, 0xd5, 0xb8, 0x22, 0xd5, 0xa4,
-	0x22, 0xd5, 0x7a, 0x30, 0xb2, 0xc6, 0x28, 0x74, 0x1b, 0xb2, 0x11, 0x73, 0xd9, 0x20, 0xd2, 0xd3,
-	0x22, 0x99, 0x1b, 0x33, 0xc9, 0x4c, 0x5c, 0xb5, 0x05, 0xc8, 0x92, 0x60, 0x74, 0x0f, 0xd0, 0x13,
-	0x12, 0xb8, 0x3d, 0x87, 0xb9, 0xbd, 0xde, 0xc8, 0x09, 0x71, 0x34, 0xe8, 0x31, 0x5d, 0xdd, 0x54,
-	0xb6, 0xf2, 0x37, 0xd7, 0x67, 0x4c, 0xd8, 0x1c, 0x62, 0x09, 0x84, 0xa5, 0x09, 0xd6, 0x94, 0x04,
-	0xd5, 0x21, 0x1f, 0x0d, 0x3a, 0x3e, 0x61, 0x0e, 0x6f, 0x33, 0x3d, 0x23, 0x4d, 0xcc, 0x46, 0x6d,
-	0x27, 0x3d, 0xb8, 0xa3, 0x3e, 0xff, 0xb3, 0xac, 0x58, 0x10, 0x93, 0xb8, 0x18, 0xdd, 0x07, 0x4d,
-	0x66, 0xd7, 0xc1, 0x81, 0x17, 0xdb, 0xc9, 0x9e, 0xd2, 0x4e, 0x51, 0x32, 0x8d, 0xc0, 0x13, 0xb6,
-	0x4c, 0x28, 0x30, 0xca, 0xdc, 0x9e, 0x23, 0xe5, 0xfa, 0xe2, 0x07, 0xd4, 0x68, 0x59, 0x50, 0x93,
-	0x06, 0x7a, 0x00, 0x67, 0x86, 0x94, 0x91, 0xa0, 0xeb, 0x44, 0xcc, 0x0d, 0xe5, 0xf9, 0x72, 0xa7,
-	0x8c, 0x6b, 0x25, 0xa6, 0xb6, 0x39, 0x53, 0x04, 0x76, 0x0f, 0xa4, 0x68, 0x72, 0xc6, 0xa5, 0x53,
-	0xda, 0x2a, 0xc4, 0xc4, 0xe4, 0x88, 0xeb, 0xbc, 0x49, 0x98, 0xeb, 0xb9, 0xcc, 0xd5, 0x81, 0xb7,
-	0xad, 0x35, 0x7e, 0x47, 0xab, 0x90, 0x61, 0x84, 0xf5, 0xb0, 0x9e, 0x17, 0x8a, 0xf8, 0x05, 0xe9,
-	0xb0, 0x18, 0x0d, 0x7c, 0xdf, 0x0d, 0x47, 0xfa, 0xb2, 0x90, 0x27, 0xaf, 0xe8, 0xff, 0x90, 0x8b,
-	0x27, 0x02, 0x87, 0x7a, 0xe1, 0x84, 0x11, 0x18, 0x23, 0x2b, 0xbf, 0x29, 0x90, 0x9f, 0xee, 0x81,
-	0x6b, 0xb0, 0x34, 0xc2, 0x91, 0x73, 0x20, 0x86, 0x42, 0x39, 0x36, 0xa1, 0x66, 0xc0, 0xac, 0xdc,
-	0x08, 0x47, 0xbb, 0x5c, 0x8f, 0x6e, 0x41, 0xc1, 0xed, 0x44, 0xcc, 0x25, 0x81, 0x24, 0xa4, 0xe6,
-	0x12, 0x96, 0x25, 0x28, 0x26, 0xfd, 0x17, 0x72, 0x01, 0x95, 0xf8, 0xf4, 0x5c, 0xfc, 0x62, 0x40,
-	0x63, 0xe8, 0x1d, 0x40, 0x01, 0x75, 0x9e, 0x12, 0x76, 0xe8, 0x0c, 0x31, 0x4b, 0x48, 0xea, 0x5c,
-	0xd2, 0x4a, 0x40, 0x1f, 0x11, 0x76, 0xb8, 0x8f, 0x59, 0x4c, 0xae, 0xfc, 0xa4, 0x80, 0xca, 0xf7,
-	0xcf, 0xc9, 0xdb, 0xa3, 0x0a, 0x99, 0x21, 0x65, 0xf8, 0xe4, 0xcd, 0x11, 0xc3, 0xd0, 0x1d, 0x58,
-	0x8c, 0x97, 0x59, 0xa4, 0xab, 0xa2, 0x25, 0x2f, 0xcd, 0x8c, 0xd9, 0xf1, 0x4d, 0x69, 0x25, 0x8c,
-	0x23, 0x25, 0xcf, 0x1c, 0x2d, 0xf9, 0x7d, 0x35, 0x97, 0xd6, 0xd4, 0xca, 0x1f, 0x0a, 0x14, 0x64,
-	0xe3, 0xb6, 0xdc, 0xd0, 0xf5, 0x23, 0xf4, 0x18, 0xf2, 0x3e, 0x09, 0xc6, 0x73, 0xa0, 0x9c, 0x34,
-	0x07, 0x1b, 0x7c, 0x0e, 0xde, 0xbf, 0x29, 0xff, 0x67, 0x8a, 0x75, 0x9d, 0xfa, 0x84, 0x61, 0xbf,
-	0xcf, 0x46, 0x16, 0xf8, 0x24, 0x48, 0x26, 0xc3, 0x07, 0xe4, 0xbb, 0xcf, 0x12, 0x90, 0xd3, 0xc7,
-	0x21, 0xa1, 0x9e, 0x48, 0x04, 0xf7, 0x30, 0xdb, 0xce, 0x0d, 0x79, 0x85, 0xec, 0x5c, 0x7e, 0xff,
-	0xa6, 0x7c, 0xf1, 0x38, 0x71, 0xe2, 0xe4, 0x5b, 0xde, 0xed, 0x9a, 0xef, 0x3e, 0x4b, 0x4e, 0x22,
-	0xf4, 0x15, 0x1b, 0x96, 0xf7, 0xc5, 0x04, 0xc8, 0x93, 0x35, 0x40, 0x4e, 0x44, 0xe2, 0x59, 0x39,
-	0xc9, 0xb3, 0x2a, 0x2c, 0x2f, 0xc7, 0x2c, 0x69, 0xf5, 0xbb, 0xa4, 0x89, 0xa5, 0xd5, 0x2b, 0x90,
-	0xfd, 0x7a, 0x40, 0xc3, 0x81, 0x3f, 0xa7, 0x83, 0xc5, 0x1d, 0x13, 0x6b, 0xd1, 0x75, 0x58, 0x62,
-	0x87, 0x21, 0x8e, 0x0e, 0x69, 0xcf, 0xfb, 0x97, 0xeb, 0x68, 0x02, 0x40, 0xb7, 0xa1, 0x28, 0xba,
-	0x70, 0x42, 0x49, 0xcf, 0xa5, 0x14, 0x38, 0xca, 0x4e, 0x40, 0x95, 0x5f, 0x55, 0xc8, 0xca, 0xb8,
-	0x8c, 0x0f, 0xac, 0xe3, 0xd4, 0x3e, 0x9b, 0xae, 0xd9, 0xe7, 0x1f, 0x57, 0x33, 0x75, 0x7e, 0x4d,
-	0x8e, 0xd7, 0x20, 0xfd, 0x11, 0x35, 0x98, 0xca, 0xb9, 0x7a, 0xfa, 0x9c, 0x67, 0x3e, 0x3c, 0xe7,
-	0xd9, 0x53, 0xe4, 0x1c, 0x99, 0xb0, 0xc6, 0x13, 0x4d, 0x02, 0xc2, 0xc8, 0xe4, 0x02, 0x71, 0x44,
-	0xf8, 0xfa, 0xe2, 0x5c, 0x0b, 0xe7, 0x7c, 0x12, 0x98, 0x31, 0x5e, 0xa6, 0xc7, 0xe2, 0x68, 0xb4,
-	0x05, 0x5a, 0x67, 0x10, 0x06, 0x0e, 0x1f, 0x7d, 0x47, 0x9e, 0x90, 0xaf, 0xd7, 0x9c, 0x55, 0xe4,
-	0x72, 0x3e, 0xe2, 0x5f, 0xc4, 0x27, 0xab, 0xc3, 0x86, 0x40, 0x8e, 0x97, 0xcd, 0xb8, 0x40, 0x21,
-	0xe6, 0x6c, 0xbd, 0x28, 0x68, 0xeb, 0x1c, 0x94, 0xdc, 0xe5, 0x49, 0x25, 0x62, 0x04, 0xba, 0x0c,
-	0xc5, 0x89, 0x33, 0x7e, 0x24, 0x7d, 0x45, 0x70, 0x96, 0x13, 0x57, 0x7c, 0xbd, 0x5d, 0xfd, 0x46,
-	0x01, 0x98, 0xfa, 0x08, 0xbb, 0x00, 0xe7, 0xf7, 0x9b, 0xb6, 0xe1, 0x34, 0x5b, 0xb6, 0xd9, 0xdc,
-	0x73, 0x1e, 0xee, 0xb5, 0x5b, 0xc6, 0xae, 0x79, 0xd7, 0x34, 0x1a, 0xda, 0x02, 0x3a, 0x0b, 0x2b,
-	0xd3, 0xca, 0xc7, 0x46, 0x5b, 0x53, 0xd0, 0x79, 0x38, 0x3b, 0x2d, 0xac, 0xef, 0xb4, 0xed, 0xba,
-	0xb9, 0xa7, 0xa5, 0x10, 0x82, 0xe2, 0xb4, 0x62, 0xaf, 0xa9, 0xa5, 0xd1, 0x45, 0xd0, 0x8f, 0xca,
-	0x9c, 0x47, 0xa6, 0x7d, 0xcf, 0xd9, 0x37, 0xec, 0xa6, 0xa6, 0x5e, 0xfd, 0x45, 0x81, 0xe2, 0xd1,
-	0x0f, 0x13, 0x54, 0x86, 0x0b, 0x2d, 0xab, 0xd9, 0x6a, 0xb6, 0xeb, 0x0f, 0x9c, 0xb6, 0x5d, 0xb7,
-	0x1f, 0xb6, 0x67, 0x62, 0xaa, 0x40, 0x69, 0x16, 0xd0, 0x30, 0x5a, 0xcd, 0xb6, 0x69, 0x3b, 0x2d,
-	0xc3, 0x32, 0x9b, 0x0d, 0x4d, 0x41, 0x97, 0x60, 0x63, 0x16, 0xb3, 0xdf, 0xb4, 0xcd, 0xbd, 0xcf,
-	0x12, 0x48, 0x0a, 0xad, 0xc3, 0xb9, 0x59, 0x48, 0xab, 0xde, 0x6e, 0x1b, 0x8d, 0x38, 0xe8, 0x59,
-	0x9d, 0x65, 0xdc, 0x37, 0x76, 0x6d, 0xa3, 0xa1, 0xa9, 0xf3, 0x98, 0x77, 0xeb, 0xe6, 0x03, 0xa3,
-	0xa1, 0x65, 0x76, 0x8c, 0x97, 0x6f, 0x4b, 0xca, 0xab, 0xb7, 0x25, 0xe5, 0xaf, 0xb7, 0x25, 0xe5,
-	0xf9, 0xbb, 0xd2, 0xc2, 0xab, 0x77, 0xa5, 0x85, 0xdf, 0xdf, 0x95, 0x16, 0xbe, 0xbc, 0xd6, 0x25,
-	0xec, 0x70, 0xd0, 0xa9, 0x1e, 0x50, 0x5f, 0x7e, 0x2e, 0xcb, 0x9f, 0x1b, 0x91, 0xf7, 0x55, 0xed,
-	0x99, 0xf8, 0x17, 0x80, 0x8d, 0xfa, 0x38, 0xe2, 0xdf, 0xf7, 0x59, 0x31, 0x35, 0xb7, 0xfe, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0xbc, 0x24, 0x6f, 0xb5, 0x20, 0x0c, 0x00, 0x00,
+	// 1639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x14, 0x45, 0x3d, 0x89, 0xd4, 0x6a, 0x24, 0xdb, 0x6b, 0xc5, 0xa2, 0x64, 0x26,
+	0x70, 0x55, 0x27, 0x26, 0xab, 0xa4, 0xc9, 0x25, 0xb9, 0x50, 0xe2, 0x26, 0x5a, 0xd7, 0x12, 0x99,
+	0xe5, 0x5a, 0x86, 0x73, 0x59, 0x2c, 0xb9, 0x13, 0x72, 0x51, 0xee, 0x0e, 0xbb, 0x33, 0xa4, 0xcd,
+	0x8f, 0x90, 0x5b, 0x8e, 0x45, 0x3f, 0x41, 0x8f, 0x3d, 0x18, 0x05, 0xfa, 0x0d, 0x72, 0x2a, 0x02,
+	0x5f, 0xda, 0x5e, 0xdc, 0xc2, 0x3e, 0x14, 0x48, 0x6f, 0xfd, 0x04, 0xc5, 0xfc, 0x59, 0x92, 0x5a,
+	0xb1, 0x10, 0x6d, 0x20, 0x17, 0x89, 0xf3, 0xe6, 0xf7, 0x7b, 0x6f, 0xde, 0xdf, 0x19, 0x12, 0x6e,
+	0x75, 0x08, 0x0d, 0x09, 0xad, 0x76, 0xc9, 0xa8, 0x3a, 0x3a, 0xe2, 0xff, 0x2a, 0x83, 0x98, 0x30,
+	0x82, 0x0a, 0x72, 0xa3, 0xc2, 0x25, 0xa3, 0xa3, 0xdd, 0x92, 0xc2, 0xb5, 0x3d, 0x8a, 0xab, 0xa3,
+	0xa3, 0x36, 0x66, 0xde, 0x51, 0xb5, 0x43, 0x82, 0x48, 0xc2, 0x77, 0x77, 0xba, 0xa4, 0x4b, 0xc4,
+	0xc7, 0x2a, 0xff, 0xa4, 0xa4, 0xfb, 0x5d, 0x42, 0xba, 0x7d, 0x5c, 0x15, 0xab, 0xf6, 0xf0, 0xdb,
+	0x2a, 0x0b, 0x42, 0x4c, 0x99, 0x17, 0x0e, 0x14, 0xe0, 0x76, 0x1a, 0xe0, 0x45, 0x63, 0xb5, 0x55,
+	0x4a, 0x6f, 0xf9, 0xc3, 0xd8, 0x63, 0x01, 0x49, 0x2c, 0xde, 0x96, 0x27, 0x72, 0xa5, 0x51, 0x75,
+	0x5a, 0xb9, 0xb5, 0xe5, 0x85, 0x41, 0x44, 0xaa, 0xe2, 0xaf, 0x14, 0x95, 0x09, 0xa0, 0x27, 0x38,
+	0xe8, 0xf6, 0x18, 0xf6, 0x2f, 0x08, 0xc3, 0x8d, 0x01, 0xd7, 0x84, 0x8e, 0x20, 0x47, 0xc4, 0x27,
+	0x43, 0x3b, 0xd0, 0x0e, 0x8b, 0x1f, 0xdf, 0xae, 0x5c, 0xf2, 0xba, 0x32, 0x85, 0xda, 0x0a, 0x88,
+	0xee, 0x41, 0xee, 0x99, 0x50, 0x64, 0x2c, 0x1f, 0x68, 0x87, 0x6b, 0xc7, 0xc5, 0x97, 0x2f, 0x1e,
+	0x80, 0x62, 0xd5, 0x71, 0xc7, 0x56, 0xbb, 0xe5, 0xff, 0x6a, 0xb0, 0x5a, 0xc7, 0x03, 0x42, 0x03,
+	0x86, 0xf6, 0x61, 0x7d, 0x10, 0x93, 0x01, 0xa1, 0x5e, 0xdf, 0x0d, 0x7c, 0x61, 0x2b, 0x6b, 0x43,
+	0x22, 0xb2, 0x7c, 0xf4, 0x19, 0xac, 0xf9, 0x12, 0x4b, 0x62, 0xa5, 0xd7, 0x78, 0xf9, 0xe2, 0xc1,
+	0x8e, 0xd2, 0x5b, 0xf3, 0xfd, 0x18, 0x53, 0xda, 0x62, 0x71, 0x10, 0x75, 0xed, 0x29, 0x14, 0x7d,
+	0x01, 0x39, 0x2f, 0x24, 0xc3, 0x88, 0x19, 0x99, 0x83, 0xcc, 0xe1, 0xfa, 0xf4, 0xfc, 0x3c, 0x4d,
+	0x15, 0x95, 0xa6, 0xca, 0x09, 0x09, 0xa2, 0xe3, 0xb5, 0x1f, 0x5e, 0xed, 0x2f, 0xfd, 0xf1, 0xdf,
+	0x7f, 0xba, 0xaf, 0xd9, 0x8a, 0x83, 0x9a, 0xb0, 0xfd, 0x2c, 0x60, 0x3d, 0x3f, 0xf6, 0x9e, 0x79,
+	0xed, 0x3e, 0x76, 0x95, 0xaa, 0xec, 0x75, 0xaa, 0xb2, 0x5c, 0x95, 0x8d, 0x66, 0xb9, 0x35, 0x41,
+	0x2d, 0x7f, 0xb7, 0x0a, 0xf9, 0xa6, 0x72, 0x0b, 0x15, 0x61, 0x79, 0xe2, 0xec, 0x72, 0xe0, 0xa3,
+	0x5f, 0x41, 0x3e, 0xc4, 0x94, 0x7a, 0x5d, 0x4c, 0x8d, 0x65, 0x61, 0x63, 0xa7, 0x22, 0x73, 0x5c,
+	0x49, 0x72, 0x5c, 0xa9, 0x45, 0x63, 0x7b, 0x82, 0x42, 0x9f, 0x42, 0x8e, 0x32, 0x8f, 0x0d, 0xa9,
+	0x91, 0x11, 0xe9, 0xd9, 0x4b, 0xa5, 0x27, 0x31, 0xd5, 0x12, 0x20, 0x5b, 0x81, 0xd1, 0x29, 0xa0,
+	0x6f, 0x83, 0xc8, 0xeb, 0xbb, 0xcc, 0xeb, 0xf7, 0xc7, 0x6e, 0x8c, 0xe9, 0xb0, 0xcf, 0xdd, 0xd2,
+	0x0e, 0xd7, 0x3f, 0xde, 0x4d, 0xa9, 0x70, 0x38, 0xc4, 0x16, 0x08, 0x5b, 0x17, 0xac, 0x19, 0x09,
+	0xaa, 0xc1, 0x3a, 0x1d, 0xb6, 0xc3, 0x80, 0xb9, 0xbc, 0x70, 0x8d, 0x15, 0xa5, 0x22, 0x7d, 0x6a,
+	0x27, 0xa9, 0xea, 0xe3, 0xec, 0xf7, 0xff, 0xdc, 0xd7, 0x6c, 0x90, 0x24, 0x2e, 0x46, 0x0f, 0x41,
+	0x57, 0xf9, 0x72, 0x71, 0xe4, 0x4b, 0x3d, 0xb9, 0x05, 0xf5, 0x14, 0x15, 0xd3, 0x8c, 0x7c, 0xa1,
+	0xcb, 0x82, 0x02, 0x23, 0xcc, 0xeb, 0xbb, 0x4a, 0x6e, 0xac, 0xbe, 0x45, 0xd6, 0x37, 0x04, 0x35,
+	0x29, 0xc9, 0x47, 0xb0, 0x35, 0x22, 0x2c, 0x88, 0xba, 0x2e, 0x65, 0x5e, 0xac, 0xfc, 0xcb, 0x2f,
+	0x78, 0xae, 0x4d, 0x49, 0x6d, 0x71, 0xa6, 0x38, 0xd8, 0x29, 0x28, 0xd1, 0xd4, 0xc7, 0xb5, 0x05,
+	0x75, 0x15, 0x24, 0x31, 0x71, 0x71, 0x97, 0x17, 0x09, 0xf3, 0x7c, 0x8f, 0x79, 0x06, 0xf0, 0x46,
+	0xb0, 0x27, 0x6b, 0xb4, 0x03, 0x2b, 0x2c, 0x60, 0x7d, 0x6c, 0xac, 0x8b, 0x0d, 0xb9, 0x40, 0x06,
+	0xac, 0xd2, 0x61, 0x18, 0x7a, 0xf1, 0xd8, 0xd8, 0x10, 0xf2, 0x64, 0x89, 0x7e, 0x0d, 0x79, 0xd9,
+	0x63, 0x38, 0x36, 0x0a, 0xd7, 0x34, 0xd5, 0x04, 0x89, 0xde, 0x87, 0x82, 0xca, 0x79, 0x4f, 0xf6,
+	0x79, 0xf1, 0x40, 0x3b, 0xcc, 0xd8, 0x1b, 0x52, 0x78, 0x2a, 0x64, 0xc8, 0x84, 0x4d, 0x55, 0xa5,
+	0xaa, 0xbc, 0xa8, 0xb1, 0x29, 0x72, 0x71, 0x27, 0x55, 0x5f, 0x67, 0x12, 0xa5, 0x2a, 0xac, 0x18,
+	0xce, 0x2e, 0x29, 0xfa, 0x06, 0x8c, 0x18, 0x77, 0x48, 0x44, 0x03, 0x1f, 0xcb, 0xe1, 0x36, 0x0d,
+	0xa0, 0xbe, 0x60, 0x00, 0x6f, 0xa6, 0x34, 0xa8, 0x48, 0x96, 0x3d, 0x28, 0x5c, 0x32, 0x8e, 0x0e,
+	0x60, 0x23, 0xa4, 0x5d, 0x97, 0x8d, 0x07, 0xd8, 0x1d, 0xc6, 0x7d, 0xd1, 0x99, 0x6b, 0x36, 0x84,
+	0xb4, 0xeb, 0x8c, 0x07, 0xf8, 0x71, 0xdc, 0x97, 0xa1, 0xec, 0x74, 0x30, 0xa5, 0x62, 0x08, 0xe5,
+	0xed, 0x64, 0xc9, 0x43, 0x8f, 0xe3, 0x98, 0xc4, 0xa2, 0x11, 0xd7, 0x6c, 0xb9, 0x28, 0xff, 0x4d,
+	0x83, 0xf5, 0xd9, 0x76, 0xf9, 0x10, 0xd6, 0xc6, 0x98, 0xba, 0x1d, 0x31, 0x46, 0xb4, 0x2b, 0xe3,
+	0xd1, 0x8a, 0x98, 0x9d, 0x1f, 0x63, 0x7a, 0x22, 0xa6, 0xcf, 0x27, 0x50, 0xf0, 0xda, 0x94, 0x79,
+	0x41, 0xa4, 0x08, 0xcb, 0x73, 0x09, 0x1b, 0x0a, 0x24, 0x49, 0xbf, 0x84, 0x7c, 0x44, 0x14, 0x3e,
+	0x33, 0x17, 0xbf, 0x1a, 0x11, 0x09, 0xfd, 0x1c, 0x50, 0x44, 0x5c, 0x3e, 0xa4, 0xdc, 0x11, 0x66,
+	0x09, 0x29, 0x3b, 0x97, 0xb4, 0x19, 0x91, 0x27, 0x01, 0xeb, 0x5d, 0x60, 0x26, 0xc9, 0xe5, 0x3f,
+	0x6b, 0x90, 0xe5, 0xc3, 0xff, 0xfa, 0xd1, 0x5d, 0x81, 0x95, 0x11, 0x61, 0xf8, 0xfa, 0xb1, 0x2d,
+	0x61, 0xe8, 0x73, 0x58, 0x95, 0x37, 0x09, 0x55, 0x83, 0xf6, 0x6e, 0xaa, 0x62, 0xae, 0x5e, 0x53,
+	0x76, 0xc2, 0xb8, 0xd4, 0x1d, 0x2b, 0x97, 0xbb, 0xe3, 0x61, 0x36, 0x9f, 0xd1, 0xb3, 0xe5, 0xff,
+	0x68, 0x70, 0xa3, 0x8e, 0xfb, 0xb8, 0xeb, 0x31, 0x12, 0x0b, 0x15, 0x23, 0x1c, 0xc7, 0x81, 0xff,
+	0x33, 0x78, 0x72, 0x0e, 0x5b, 0x23, 0xaf, 0x1f, 0xf8, 0xdc, 0x92, 0xeb, 0x49, 0x84, 0x4a, 0xca,
+	0xdd, 0x97, 0x2f, 0x1e, 0xec, 0x29, 0xee, 0x45, 0x82, 0xb9, 0xac, 0x44, 0x1f, 0xa5, 0xe4, 0x33,
+	0x97, 0x71, 0x76, 0xc1, 0xcb, 0xb8, 0xfc, 0x0f, 0x0d, 0x0a, 0x6a, 0xa2, 0x35, 0xbd, 0xd8, 0x0b,
+	0x29, 0x7a, 0x0a, 0xeb, 0x61, 0x10, 0x4d, 0x06, 0xa4, 0x76, 0xdd, 0x80, 0xdc, 0xe3, 0x03, 0xf2,
+	0xa7, 0x57, 0xfb, 0x37, 0x66, 0x58, 0x1f, 0x91, 0x30, 0x60, 0x38, 0x1c, 0xb0, 0xb1, 0x0d, 0x61,
+	0x10, 0x25, 0x23, 0x33, 0x04, 0x14, 0x7a, 0xcf, 0x13, 0x90, 0x3b, 0xc0, 0x71, 0x40, 0x7c, 0x11,
+	0x2c, 0x6e, 0x21, 0xdd, 0xa6, 0x75, 0xf5, 0x5a, 0x39, 0xfe, 0xe0, 0xa7, 0x57, 0xfb, 0x77, 0xae,
+	0x12, 0xa7, 0x46, 0x7e, 0xcf, 0xbb, 0x58, 0x0f, 0xbd, 0xe7, 0x89, 0x27, 0x62, 0xbf, 0xec, 0xc0,
+	0xc6, 0x85, 0x18, 0x8d, 0xca, 0xb3, 0x3a, 0xa8, 0x51, 0x99, 0x58, 0xd6, 0xae, 0xb3, 0x9c, 0x15,
+	0x9a, 0x37, 0x24, 0x4b, 0x69, 0xfd, 0x43, 0xd2, 0xb2, 0x4a, 0xeb, 0x3d, 0xc8, 0xfd, 0x6e, 0x48,
+	0xe2, 0x61, 0x68, 0x68, 0xf3, 0x9f, 0x33, 0x72, 0x17, 0x7d, 0x04, 0x6b, 0xac, 0x17, 0x63, 0xda,
+	0x23, 0x7d, 0xff, 0xff, 0xbc, 0x7c, 0xa6, 0x00, 0xf4, 0x29, 0x14, 0x45, 0xcf, 0x4d, 0x29, 0x99,
+	0xb9, 0x94, 0x02, 0x47, 0x39, 0x09, 0xa8, 0xfc, 0x97, 0x55, 0xc8, 0xa9, 0x73, 0x99, 0x6f, 0x99,
+	0xc7, 0x99, 0x8b, 0x6e, 0x36, 0x67, 0x67, 0xef, 0x96, 0xb3, 0xec, 0xfc, 0x9c, 0x5c, 0xcd, 0x41,
+	0xe6, 0x1d, 0x72, 0x30, 0x13, 0xf3, 0xec, 0xe2, 0x31, 0x5f, 0x79, 0xfb, 0x98, 0xe7, 0x16, 0x88,
+	0x39, 0xb2, 0xe0, 0x36, 0x0f, 0x74, 0x10, 0x05, 0x2c, 0x98, 0xbe, 0x2c, 0x5c, 0x71, 0x7c, 0x63,
+	0x75, 0xae, 0x86, 0x9b, 0x61, 0x10, 0x59, 0x12, 0xaf, 0xc2, 0x63, 0x73, 0x34, 0x3a, 0x04, 0xbd,
+	0x3d, 0x8c, 0x23, 0x97, 0x8f, 0x07, 0x57, 0x79, 0x58, 0x10, 0xf7, 0x48, 0x91, 0xcb, 0x79, 0xff,
+	0x7e, 0x2d, 0x3d, 0xab, 0xc1, 0x9e, 0x40, 0x4e, 0x06, 0xd2, 0x24, 0x41, 0x31, 0xe6, 0x6c, 0x71,
+	0xe7, 0xe6, 0xed, 0x5d, 0x0e, 0x4a, 0x1e, 0x79, 0x49, 0x26, 0x24, 0x02, 0x7d, 0x00, 0xc5, 0xa9,
+	0x31, 0xee, 0x92, 0xb1, 0x29, 0x38, 0x1b, 0x89, 0x29, 0x3e, 0xcc, 0xd1, 0x67, 0x70, 0xcb, 0x4f,
+	0xa6, 0xa1, 0x84, 0x12, 0x35, 0x0f, 0xc5, 0xfd, 0x9a, 0xb7, 0x6f, 0xf8, 0x73, 0x87, 0xe5, 0x17,
+	0x80, 0x44, 0x30, 0x93, 0x73, 0xf9, 0xb8, 0xe3, 0x8d, 0x8d, 0xad, 0xb9, 0xe1, 0xd0, 0x39, 0x52,
+	0x9d, 0xae, 0xce, 0x71, 0xc8, 0x02, 0x5d, 0x95, 0x49, 0x88, 0x3b, 0x3d, 0x2f, 0x0a, 0x68, 0x68,
+	0x20, 0x31, 0xd3, 0x4a, 0x57, 0x67, 0x5a, 0x10, 0x75, 0xcf, 0x12, 0x54, 0xf2, 0xb2, 0x9a, 0x08,
+	0x50, 0x03, 0xb6, 0xdb, 0xc3, 0xc8, 0xef, 0x63, 0xdf, 0x9d, 0xed, 0x87, 0xed, 0xc5, 0xde, 0xe8,
+	0x5b, 0x8a, 0x7b, 0x36, 0xed, 0x88, 0x0b, 0x48, 0x3f, 0x18, 0x92, 0x5a, 0xde, 0x59, 0xac, 0x96,
+	0x6f, 0xa4, 0xe8, 0xb2, 0xa8, 0xef, 0x7f, 0xa7, 0x01, 0xcc, 0x7c, 0xb3, 0x7a, 0x0f, 0x6e, 0x5d,
+	0x34, 0x1c, 0xd3, 0x6d, 0x34, 0x1d, 0xab, 0x71, 0xee, 0x3e, 0x3e, 0x6f, 0x35, 0xcd, 0x13, 0xeb,
+	0x4b, 0xcb, 0xac, 0xeb, 0x4b, 0x68, 0x1b, 0x36, 0x67, 0x37, 0x9f, 0x9a, 0x2d, 0x5d, 0x43, 0xb7,
+	0x60, 0x7b, 0x56, 0x58, 0x3b, 0x6e, 0x39, 0x35, 0xeb, 0x5c, 0x5f, 0x46, 0x08, 0x8a, 0xb3, 0x1b,
+	0xe7, 0x0d, 0x3d, 0x83, 0xee, 0x80, 0x71, 0x59, 0xe6, 0x3e, 0xb1, 0x9c, 0x53, 0xf7, 0xc2, 0x74,
+	0x1a, 0x7a, 0xf6, 0x7e, 0x1b, 0x36, 0x53, 0x81, 0x45, 0xf7, 0xa0, 0x7c, 0xd1, 0x70, 0xac, 0xf3,
+	0xaf, 0xdc, 0x33, 0xf3, 0xe4, 0xb4, 0x76, 0x6e, 0xb5, 0xce, 0xdc, 0x27, 0xa6, 0xf5, 0xd5, 0xa9,
+	0x63, 0xd6, 0xdd, 0xe3, 0xa7, 0x6e, 0xcb, 0xa9, 0xfd, 0xc6, 0xd4, 0x97, 0xd0, 0x2f, 0xe0, 0xfd,
+	0x2b, 0xb8, 0xaf, 0x1f, 0xd7, 0xea, 0x76, 0xcd, 0xb1, 0x4e, 0xa6, 0x40, 0xed, 0xfe, 0x5f, 0x35,
+	0x28, 0x5e, 0xfe, 0xfe, 0x81, 0xf6, 0xe1, 0xbd, 0xa6, 0xdd, 0x68, 0x36, 0x5a, 0xb5, 0x47, 0x1c,
+	0xe6, 0x3c, 0x6e, 0xa5, 0xfc, 0x2e, 0x43, 0x29, 0x0d, 0xa8, 0x9b, 0xcd, 0x46, 0xcb, 0x72, 0xdc,
+	0xa6, 0x69, 0x5b, 0x8d, 0xba, 0xae, 0xa1, 0xbb, 0xb0, 0x97, 0xc6, 0xa8, 0x03, 0x29, 0xc8, 0x32,
+	0xda, 0x85, 0x9b, 0x69, 0x48, 0xb3, 0xd6, 0x6a, 0x99, 0x75, 0x19, 0x98, 0xf4, 0x9e, 0x6d, 0x3e,
+	0x34, 0x4f, 0x1c, 0xb3, 0xae, 0x67, 0xe7, 0x31, 0xbf, 0xac, 0x59, 0x8f, 0xcc, 0xba, 0xbe, 0x72,
+	0x6c, 0xfe, 0xf0, 0xba, 0xa4, 0xfd, 0xf8, 0xba, 0xa4, 0xfd, 0xeb, 0x75, 0x49, 0xfb, 0xfe, 0x4d,
+	0x69, 0xe9, 0xc7, 0x37, 0xa5, 0xa5, 0xbf, 0xbf, 0x29, 0x2d, 0x7d, 0xf3, 0x61, 0x37, 0x60, 0xbd,
+	0x61, 0xbb, 0xd2, 0x21, 0xa1, 0xfa, 0x9e, 0xad, 0xfe, 0x3d, 0xa0, 0xfe, 0x6f, 0xab, 0xcf, 0xc5,
+	0x6f, 0x07, 0xfc, 0x55, 0x49, 0xf9, 0x0f, 0x03, 0x39, 0x51, 0x37, 0x9f, 0xfc, 0x6f, 0x00, 0x66,
+	0x58, 0x6f, 0x89, 0x59, 0x10, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1005,6 +1293,20 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WithdrawableAmount) > 0 {
+		for iNdEx := len(m.WithdrawableAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawableAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1054,6 +1356,37 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReconsiderationEndTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReconsiderationEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReconsiderationEndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.MessageResults) > 0 {
+		for iNdEx := len(m.MessageResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -1083,22 +1416,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1117,22 +1450,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1175,6 +1508,53 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1282,7 +1662,7 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorVoteOverride) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1292,23 +1672,70 @@ func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorVoteOverride) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorVoteOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1350,12 +1777,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1426,6 +1853,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReconsiderationPeriod != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ReconsiderationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReconsiderationPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.BundledMinDeposit) > 0 {
+		for iNdEx := len(m.BundledMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundledMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.VotingMechanism != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VotingMechanism))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.VetoDepositDecay) > 0 {
+		i -= len(m.VetoDepositDecay)
+		copy(dAtA[i:], m.VetoDepositDecay)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoDepositDecay)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.DelegatorVoteOverride {
+		i--
+		if m.DelegatorVoteOverride {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1485,22 +1968,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1567,6 +2050,12 @@ func (m *Deposit) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
+	if len(m.WithdrawableAmount) > 0 {
+		for _, e := range m.WithdrawableAmount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1630,6 +2119,39 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGov(uint64(m.SubmitHeight))
+	}
+	if len(m.MessageResults) > 0 {
+		for _, e := range m.MessageResults {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ReconsiderationEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReconsiderationEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1684,6 +2206,29 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorVoteOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	return n
+}
+
 func (m *DepositParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1782,6 +2327,26 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	if m.DelegatorVoteOverride {
+		n += 3
+	}
+	l = len(m.VetoDepositDecay)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.VotingMechanism != 0 {
+		n += 2 + sovGov(uint64(m.VotingMechanism))
+	}
+	if len(m.BundledMinDeposit) > 0 {
+		for _, e := range m.BundledMinDeposit {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ReconsiderationPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReconsiderationPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2006,6 +2571,40 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawableAmount = append(m.WithdrawableAmount, types.Coin{})
+			if err := m.WithdrawableAmount[len(m.WithdrawableAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2470,61 +3069,30 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2534,13 +3102,267 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageResults = append(m.MessageResults, &MessageResult{})
+			if err := m.MessageResults[len(m.MessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconsiderationEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReconsiderationEndTime == nil {
+				m.ReconsiderationEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReconsiderationEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
 			postIndex := iNdEx + intStringLen
@@ -2836,6 +3658,158 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorVoteOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorVoteOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorVoteOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3511,6 +4485,147 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorVoteOverride", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DelegatorVoteOverride = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoDepositDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoDepositDecay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMechanism", wireType)
+			}
+			m.VotingMechanism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMechanism |= VotingMechanism(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundledMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundledMinDeposit = append(m.BundledMinDeposit, types.Coin{})
+			if err := m.BundledMinDeposit[len(m.BundledMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconsiderationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReconsiderationPeriod == nil {
+				m.ReconsiderationPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ReconsiderationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -15,8 +15,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}
-	_, _             codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgVoteOnBehalfOf{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgPruneOldProposals{}, &MsgAmendProposal{}, &MsgWithdrawDeposit{}
+	_, _, _                      codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgAmendProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -164,6 +164,123 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{depositor}
 }
 
+// NewMsgAmendProposal creates a new MsgAmendProposal.
+//
+//nolint:interfacer
+func NewMsgAmendProposal(proposalID uint64, messages []sdk.Msg, proposer sdk.AccAddress, metadata, title, summary string) (*MsgAmendProposal, error) {
+	m := &MsgAmendProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer.String(),
+		Metadata:   metadata,
+		Title:      title,
+		Summary:    summary,
+	}
+
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Messages = anys
+
+	return m, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *MsgAmendProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgAmendProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgAmendProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgAmendProposal) ValidateBasic() error {
+	if m.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
+	}
+	if m.Summary == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	// Check that either metadata or Msgs length is non nil.
+	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
+		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for idx, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidProposalMsg,
+				fmt.Sprintf("msg: %d, err: %s", idx, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (m MsgAmendProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgAmendProposal.
+func (m MsgAmendProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgAmendProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// NewMsgWithdrawDeposit creates a new MsgWithdrawDeposit instance
+//
+//nolint:interfacer
+func NewMsgWithdrawDeposit(depositor sdk.AccAddress, proposalID uint64) *MsgWithdrawDeposit {
+	return &MsgWithdrawDeposit{proposalID, depositor.String()}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWithdrawDeposit) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWithdrawDeposit) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWithdrawDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWithdrawDeposit) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawDeposit.
+func (msg MsgWithdrawDeposit) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
 // NewMsgVote creates a message to cast a vote on an active proposal
 //
 //nolint:interfacer
@@ -201,6 +318,47 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{voter}
 }
 
+// NewMsgVoteOnBehalfOf creates a message for a delegator to override the vote
+// of a validator it delegates to on an active proposal
+//
+//nolint:interfacer
+func NewMsgVoteOnBehalfOf(voter sdk.AccAddress, validator sdk.ValAddress, proposalID uint64, option VoteOption) *MsgVoteOnBehalfOf {
+	return &MsgVoteOnBehalfOf{proposalID, voter.String(), validator.String(), option}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVoteOnBehalfOf) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVoteOnBehalfOf) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgVoteOnBehalfOf) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if !ValidVoteOption(msg.Option) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, msg.Option.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgVoteOnBehalfOf) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgVoteOnBehalfOf.
+func (msg MsgVoteOnBehalfOf) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 //
 //nolint:interfacer
@@ -321,3 +479,42 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgPruneOldProposals creates a new MsgPruneOldProposals instance
+func NewMsgPruneOldProposals(authority sdk.AccAddress, beforeHeight int64) *MsgPruneOldProposals {
+	return &MsgPruneOldProposals{
+		Authority:    authority.String(),
+		BeforeHeight: beforeHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgPruneOldProposals) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgPruneOldProposals) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPruneOldProposals) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.BeforeHeight <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("before height must be positive: %d", msg.BeforeHeight)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPruneOldProposals) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgPruneOldProposals.
+func (msg MsgPruneOldProposals) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -88,6 +88,53 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgVoteOnBehalfOf
+func TestMsgVoteOnBehalfOf(t *testing.T) {
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		valAddr    sdk.ValAddress
+		option     v1.VoteOption
+		expectPass bool
+	}{
+		{addrs[0], sdk.ValAddress(addrs[1]), v1.OptionYes, true},
+		{addrs[0], sdk.ValAddress(addrs[0]), v1.OptionNoWithVeto, true},
+		{sdk.AccAddress{}, sdk.ValAddress(addrs[1]), v1.OptionYes, false},
+		{addrs[0], sdk.ValAddress{}, v1.OptionYes, false},
+		{addrs[0], sdk.ValAddress(addrs[1]), v1.VoteOption(0x13), false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgVoteOnBehalfOf(tc.voterAddr, tc.valAddr, 0, tc.option)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgPruneOldProposals(t *testing.T) {
+	tests := []struct {
+		authority    sdk.AccAddress
+		beforeHeight int64
+		expectPass   bool
+	}{
+		{addrs[0], 100, true},
+		{sdk.AccAddress{}, 100, false},
+		{addrs[0], 0, false},
+		{addrs[0], -1, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgPruneOldProposals(tc.authority, tc.beforeHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVoteWeighted
 func TestMsgVoteWeighted(t *testing.T) {
 	metadata := "metadata"
@@ -173,6 +220,43 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgAmendProposal_ValidateBasic(t *testing.T) {
+	metadata := "metadata"
+	// Valid msg
+	msg1, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Title", "description"), addrs[0].String())
+	require.NoError(t, err)
+	// Invalid msg
+	msg2, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Title", "description"), "foo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		proposer sdk.AccAddress
+		messages []sdk.Msg
+		metadata string
+		title    string
+		summary  string
+		expErr   bool
+	}{
+		{"invalid addr", sdk.AccAddress{}, []sdk.Msg{msg1}, metadata, "Title", "Summary", true},
+		{"empty msgs and metadata", addrs[0], nil, "", "Title", "Summary", true},
+		{"empty title and summary", addrs[0], []sdk.Msg{msg1}, metadata, "", "", true},
+		{"invalid msg", addrs[0], []sdk.Msg{msg1, msg2}, metadata, "Title", "Summary", true},
+		{"valid with no Msg", addrs[0], nil, metadata, "Title", "Summary", false},
+		{"valid with everything", addrs[0], []sdk.Msg{msg1}, metadata, "Title", "Summary", false},
+	}
+
+	for _, tc := range tests {
+		msg, err := v1.NewMsgAmendProposal(1, tc.messages, tc.proposer, tc.metadata, tc.title, tc.summary)
+		require.NoError(t, err)
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	testcases := []struct {
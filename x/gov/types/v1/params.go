@@ -11,7 +11,14 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod                time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultReconsiderationPeriod time.Duration = time.Hour * 24     // 1 day
+)
+
+// Voting mechanisms
+const (
+	WeightedByStake  = VotingMechanism_VOTING_MECHANISM_WEIGHTED_BY_STAKE
+	QuadraticByStake = VotingMechanism_VOTING_MECHANISM_QUADRATIC_BY_STAKE
 )
 
 // Default governance params
@@ -24,6 +31,9 @@ var (
 	DefaultBurnProposalPrevote    = false // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom         = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto           = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultDelegatorVoteOverride  = false
+	DefaultVetoDepositDecay       = sdk.ZeroDec()
+	DefaultVotingMechanism        = WeightedByStake
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -54,6 +64,7 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 func NewParams(
 	minDeposit sdk.Coins, maxDepositPeriod, votingPeriod time.Duration,
 	quorum, threshold, vetoThreshold, minInitialDepositRatio string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	delegatorVoteOverride bool, vetoDepositDecay string, votingMechanism VotingMechanism, reconsiderationPeriod time.Duration,
 ) Params {
 	return Params{
 		MinDeposit:                 minDeposit,
@@ -66,6 +77,10 @@ func NewParams(
 		BurnProposalDepositPrevote: burnProposalDeposit,
 		BurnVoteQuorum:             burnVoteQuorum,
 		BurnVoteVeto:               burnVoteVeto,
+		DelegatorVoteOverride:      delegatorVoteOverride,
+		VetoDepositDecay:           vetoDepositDecay,
+		VotingMechanism:            votingMechanism,
+		ReconsiderationPeriod:      &reconsiderationPeriod,
 	}
 }
 
@@ -82,6 +97,10 @@ func DefaultParams() Params {
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
+		DefaultDelegatorVoteOverride,
+		DefaultVetoDepositDecay.String(),
+		DefaultVotingMechanism,
+		DefaultReconsiderationPeriod,
 	)
 }
 
@@ -91,6 +110,15 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
 	}
 
+	if bundledMinDeposit := sdk.Coins(p.BundledMinDeposit); !bundledMinDeposit.Empty() {
+		if !bundledMinDeposit.IsValid() {
+			return fmt.Errorf("invalid bundled minimum deposit: %s", bundledMinDeposit)
+		}
+		if !bundledMinDeposit.IsAllGTE(p.MinDeposit) {
+			return fmt.Errorf("bundled minimum deposit %s must not be lower than minimum deposit %s", bundledMinDeposit, sdk.Coins(p.MinDeposit))
+		}
+	}
+
 	if p.MaxDepositPeriod == nil {
 		return fmt.Errorf("maximum deposit period must not be nil: %d", p.MaxDepositPeriod)
 	}
@@ -151,5 +179,38 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("mininum initial deposit ratio of proposal is too large: %s", minInitialDepositRatio)
 	}
 
+	vetoDepositDecay, err := math.LegacyNewDecFromStr(p.VetoDepositDecay)
+	if err != nil {
+		return fmt.Errorf("invalid veto deposit decay string: %w", err)
+	}
+	if vetoDepositDecay.IsNegative() {
+		return fmt.Errorf("veto deposit decay cannot be negative: %s", vetoDepositDecay)
+	}
+	if vetoDepositDecay.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto deposit decay too large: %s", vetoDepositDecay)
+	}
+
+	if _, ok := VotingMechanism_name[int32(p.VotingMechanism)]; !ok {
+		return fmt.Errorf("invalid voting mechanism: %s", p.VotingMechanism)
+	}
+
+	if p.ReconsiderationPeriod == nil {
+		return fmt.Errorf("reconsideration period must not be nil: %d", p.ReconsiderationPeriod)
+	}
+
+	if p.ReconsiderationPeriod.Seconds() <= 0 {
+		return fmt.Errorf("reconsideration period must be positive: %s", p.ReconsiderationPeriod)
+	}
+
 	return nil
 }
+
+// ProposalMinDeposit returns the minimum deposit for a proposal with the given
+// number of messages to enter voting period. Proposals bundling more than one
+// message require BundledMinDeposit, unless it is empty.
+func (p Params) ProposalMinDeposit(numMsgs int) sdk.Coins {
+	if numMsgs > 1 && len(p.BundledMinDeposit) > 0 {
+		return p.BundledMinDeposit
+	}
+	return p.MinDeposit
+}
@@ -52,6 +52,18 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// InReconsiderationPeriod returns true if the proposal was amended and the
+// deposits made before the amendment can still be withdrawn at the given time.
+// The window stays open when the proposal enters its voting period before the
+// end of the window, but closes once the proposal is finished.
+func (p Proposal) InReconsiderationPeriod(blockTime time.Time) bool {
+	if p.Status != StatusDepositPeriod && p.Status != StatusVotingPeriod {
+		return false
+	}
+
+	return p.ReconsiderationEndTime != nil && blockTime.Before(*p.ReconsiderationEndTime)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
@@ -893,6 +893,222 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryProposalsByKeywordRequest is the request type for the
+// Query/ProposalsByKeyword RPC method.
+type QueryProposalsByKeywordRequest struct {
+	// keyword defines the word to search in the proposal titles and summaries,
+	// case-insensitively.
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByKeywordRequest) Reset()         { *m = QueryProposalsByKeywordRequest{} }
+func (m *QueryProposalsByKeywordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByKeywordRequest) ProtoMessage()    {}
+func (*QueryProposalsByKeywordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryProposalsByKeywordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByKeywordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByKeywordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByKeywordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByKeywordRequest.Merge(m, src)
+}
+func (m *QueryProposalsByKeywordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByKeywordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByKeywordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByKeywordRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByKeywordRequest) GetKeyword() string {
+	if m != nil {
+		return m.Keyword
+	}
+	return ""
+}
+
+func (m *QueryProposalsByKeywordRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByKeywordResponse is the response type for the
+// Query/ProposalsByKeyword RPC method.
+type QueryProposalsByKeywordResponse struct {
+	// proposals defines the proposals containing the keyword, by increasing id.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByKeywordResponse) Reset()         { *m = QueryProposalsByKeywordResponse{} }
+func (m *QueryProposalsByKeywordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByKeywordResponse) ProtoMessage()    {}
+func (*QueryProposalsByKeywordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryProposalsByKeywordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByKeywordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByKeywordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByKeywordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByKeywordResponse.Merge(m, src)
+}
+func (m *QueryProposalsByKeywordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByKeywordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByKeywordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByKeywordResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByKeywordResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsByKeywordResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalMessageResultsRequest is the request type for the
+// Query/ProposalMessageResults RPC method.
+type QueryProposalMessageResultsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalMessageResultsRequest) Reset()         { *m = QueryProposalMessageResultsRequest{} }
+func (m *QueryProposalMessageResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalMessageResultsRequest) ProtoMessage()    {}
+func (*QueryProposalMessageResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryProposalMessageResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalMessageResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalMessageResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalMessageResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalMessageResultsRequest.Merge(m, src)
+}
+func (m *QueryProposalMessageResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalMessageResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalMessageResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalMessageResultsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalMessageResultsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalMessageResultsResponse is the response type for the
+// Query/ProposalMessageResults RPC method.
+type QueryProposalMessageResultsResponse struct {
+	// status defines the status of the proposal.
+	Status ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// message_results are the execution results of the proposal messages.
+	MessageResults []*MessageResult `protobuf:"bytes,2,rep,name=message_results,json=messageResults,proto3" json:"message_results,omitempty"`
+}
+
+func (m *QueryProposalMessageResultsResponse) Reset()         { *m = QueryProposalMessageResultsResponse{} }
+func (m *QueryProposalMessageResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalMessageResultsResponse) ProtoMessage()    {}
+func (*QueryProposalMessageResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryProposalMessageResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalMessageResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalMessageResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalMessageResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalMessageResultsResponse.Merge(m, src)
+}
+func (m *QueryProposalMessageResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalMessageResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalMessageResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalMessageResultsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalMessageResultsResponse) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *QueryProposalMessageResultsResponse) GetMessageResults() []*MessageResult {
+	if m != nil {
+		return m.MessageResults
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -910,73 +1126,87 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryProposalsByKeywordRequest)(nil), "cosmos.gov.v1.QueryProposalsByKeywordRequest")
+	proto.RegisterType((*QueryProposalsByKeywordResponse)(nil), "cosmos.gov.v1.QueryProposalsByKeywordResponse")
+	proto.RegisterType((*QueryProposalMessageResultsRequest)(nil), "cosmos.gov.v1.QueryProposalMessageResultsRequest")
+	proto.RegisterType((*QueryProposalMessageResultsResponse)(nil), "cosmos.gov.v1.QueryProposalMessageResultsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0xdc, 0x54,
-	0x14, 0x8e, 0x27, 0x8f, 0xce, 0x9c, 0x34, 0x01, 0x4e, 0x1f, 0x19, 0x4c, 0x99, 0x06, 0x87, 0x26,
-	0x81, 0x12, 0x5f, 0x26, 0x7d, 0x49, 0x50, 0x16, 0x0d, 0x25, 0x05, 0x89, 0x45, 0x98, 0x56, 0x2c,
-	0xd8, 0x44, 0x4e, 0xc6, 0x32, 0x16, 0x13, 0x5f, 0x77, 0xee, 0x9d, 0x11, 0x21, 0x8d, 0x90, 0x2a,
-	0x21, 0x58, 0x01, 0x12, 0x15, 0xf0, 0x43, 0xf8, 0x11, 0x2c, 0x2b, 0xd8, 0x20, 0x56, 0x28, 0xe1,
-	0x87, 0x20, 0xdf, 0x7b, 0xec, 0xb1, 0x1d, 0x8f, 0x33, 0x53, 0x55, 0xac, 0x22, 0xdf, 0xfb, 0x9d,
-	0xef, 0x7c, 0xe7, 0x79, 0x33, 0xf0, 0xf2, 0x2e, 0x17, 0x7b, 0x5c, 0x30, 0x8f, 0xf7, 0x59, 0xbf,
-	0xc9, 0x1e, 0xf6, 0xdc, 0xee, 0xbe, 0x1d, 0x76, 0xb9, 0xe4, 0x38, 0xa7, 0xaf, 0x6c, 0x8f, 0xf7,
-	0xed, 0x7e, 0xd3, 0x7c, 0x93, 0x90, 0x3b, 0x8e, 0x70, 0x35, 0x8e, 0xf5, 0x9b, 0x3b, 0xae, 0x74,
-	0x9a, 0x2c, 0x74, 0x3c, 0x3f, 0x70, 0xa4, 0xcf, 0x03, 0x6d, 0x6a, 0x5e, 0xf2, 0x38, 0xf7, 0x3a,
-	0x2e, 0x73, 0x42, 0x9f, 0x39, 0x41, 0xc0, 0xa5, 0xba, 0x14, 0x74, 0xbb, 0x90, 0xf5, 0x19, 0xf1,
-	0xeb, 0x0b, 0x12, 0xb3, 0xad, 0xbe, 0x18, 0xb9, 0x57, 0x1f, 0xd6, 0x2d, 0x38, 0xff, 0x49, 0xe4,
-	0x73, 0xab, 0xcb, 0x43, 0x2e, 0x9c, 0x4e, 0xcb, 0x7d, 0xd8, 0x73, 0x85, 0xc4, 0xcb, 0x30, 0x1b,
-	0xd2, 0xd1, 0xb6, 0xdf, 0xae, 0x1b, 0x8b, 0xc6, 0xea, 0x54, 0x0b, 0xe2, 0xa3, 0x8f, 0xda, 0xd6,
-	0xc7, 0x70, 0x21, 0x67, 0x28, 0x42, 0x1e, 0x08, 0x17, 0xaf, 0x41, 0x35, 0x86, 0x29, 0xb3, 0xd9,
-	0xf5, 0x05, 0x3b, 0x13, 0xb1, 0x9d, 0x98, 0x24, 0x40, 0xeb, 0x87, 0x4a, 0x8e, 0x4e, 0xc4, 0x42,
-	0x36, 0xe1, 0x85, 0x44, 0x88, 0x90, 0x8e, 0xec, 0x09, 0xc5, 0x3a, 0xbf, 0xfe, 0xea, 0x10, 0xd6,
-	0xfb, 0x0a, 0xd4, 0x9a, 0x0f, 0x33, 0xdf, 0x68, 0xc3, 0x74, 0x9f, 0x4b, 0xb7, 0x5b, 0xaf, 0x2c,
-	0x1a, 0xab, 0xb5, 0x8d, 0xfa, 0x1f, 0xbf, 0xad, 0x9d, 0x27, 0x82, 0x3b, 0xed, 0x76, 0xd7, 0x15,
-	0xe2, 0xbe, 0xec, 0xfa, 0x81, 0xd7, 0xd2, 0x30, 0xbc, 0x09, 0xb5, 0xb6, 0x1b, 0x72, 0xe1, 0x4b,
-	0xde, 0xad, 0x4f, 0x9e, 0x62, 0x33, 0x80, 0xe2, 0x26, 0xc0, 0xa0, 0x6c, 0xf5, 0x29, 0x95, 0x80,
-	0xe5, 0x58, 0x6a, 0x54, 0x63, 0x5b, 0xf7, 0x02, 0xd5, 0xd8, 0xde, 0x72, 0x3c, 0x97, 0x62, 0x6d,
-	0xa5, 0x2c, 0xad, 0x5f, 0x0d, 0xb8, 0x98, 0xcf, 0x08, 0x65, 0xf8, 0x06, 0xd4, 0xe2, 0xe0, 0xa2,
-	0x64, 0x4c, 0x96, 0xa5, 0x78, 0x80, 0xc4, 0x7b, 0x19, 0x65, 0x15, 0xa5, 0x6c, 0xe5, 0x54, 0x65,
-	0xda, 0x67, 0x46, 0xda, 0x2e, 0xbc, 0xa8, 0x94, 0x7d, 0xca, 0xa5, 0x3b, 0x6a, 0xbf, 0x8c, 0x9b,
-	0x7f, 0xeb, 0x36, 0xbc, 0x94, 0x72, 0x42, 0x91, 0xaf, 0xc0, 0x54, 0x74, 0x4b, 0x7d, 0x75, 0x2e,
-	0x17, 0xb4, 0x82, 0x2a, 0x80, 0xf5, 0x28, 0x65, 0x2d, 0x46, 0xd6, 0xb8, 0x59, 0x90, 0xa1, 0x67,
-	0xa9, 0xdd, 0x77, 0x06, 0x60, 0xda, 0x3d, 0xa9, 0x7f, 0x43, 0xa7, 0x20, 0xae, 0x59, 0xa1, 0x7c,
-	0x8d, 0x78, 0x7e, 0xb5, 0xba, 0x41, 0x4a, 0xb6, 0x9c, 0xae, 0xb3, 0x97, 0xc9, 0x84, 0x3a, 0xd8,
-	0x96, 0xfb, 0xa1, 0x4e, 0x67, 0x2d, 0x32, 0x8b, 0x8e, 0x1e, 0xec, 0x87, 0xae, 0xf5, 0x73, 0x05,
-	0xce, 0x65, 0xec, 0x28, 0x84, 0xbb, 0x30, 0xd7, 0xe7, 0xd2, 0x0f, 0xbc, 0x6d, 0x0d, 0xa6, 0x4a,
-	0xbc, 0x72, 0x32, 0x14, 0x3f, 0xf0, 0xb4, 0xed, 0x46, 0xa5, 0x6e, 0xb4, 0xce, 0xf6, 0x53, 0x27,
-	0x78, 0x0f, 0xe6, 0x69, 0x60, 0x62, 0x1a, 0x1d, 0xe1, 0xa5, 0x1c, 0xcd, 0x5d, 0x0d, 0x4a, 0xf1,
-	0xcc, 0xb5, 0xd3, 0x47, 0x78, 0x07, 0xce, 0x4a, 0xa7, 0xd3, 0xd9, 0x8f, 0x69, 0x26, 0x15, 0x8d,
-	0x99, 0xa3, 0x79, 0x10, 0x41, 0x52, 0x24, 0xb3, 0x72, 0x70, 0x80, 0x6b, 0x30, 0x43, 0xc6, 0x7a,
-	0x56, 0x2f, 0xe4, 0x27, 0x49, 0x27, 0x80, 0x40, 0x56, 0x40, 0x79, 0x21, 0x69, 0x23, 0xb7, 0x56,
-	0x66, 0x9d, 0x54, 0x46, 0x5e, 0x27, 0xd6, 0x87, 0xb4, 0x9f, 0x13, 0x7f, 0x54, 0x88, 0xb7, 0xe1,
-	0x0c, 0x81, 0xa8, 0x04, 0x17, 0x8b, 0x73, 0xd7, 0x8a, 0x61, 0xd6, 0xd7, 0x59, 0xa6, 0xff, 0x7f,
-	0x2a, 0x9e, 0x18, 0xb4, 0xe3, 0x07, 0x0a, 0x28, 0x98, 0x75, 0xa8, 0x92, 0xca, 0x78, 0x36, 0x86,
-	0x45, 0x93, 0xe0, 0x9e, 0xdf, 0x84, 0xbc, 0x03, 0x0b, 0x4a, 0x95, 0xea, 0x92, 0x96, 0x2b, 0x7a,
-	0x1d, 0x39, 0xc6, 0x23, 0x58, 0x3f, 0x69, 0x9b, 0x54, 0x68, 0x5a, 0xf5, 0x19, 0xd5, 0xa7, 0xb0,
-	0x29, 0xc9, 0x44, 0x03, 0xd7, 0xff, 0xae, 0xc2, 0xb4, 0xa2, 0xc3, 0x6f, 0x0c, 0xa8, 0xc6, 0x2b,
-	0x1c, 0x97, 0x72, 0x96, 0x45, 0xef, 0xb5, 0xf9, 0x7a, 0x39, 0x48, 0x6b, 0xb2, 0xec, 0xc7, 0x7f,
-	0xfe, 0xfb, 0x53, 0x65, 0x15, 0x97, 0x59, 0xf6, 0x5f, 0x85, 0xe4, 0x91, 0x60, 0x07, 0xa9, 0x80,
-	0x0f, 0xf1, 0x2b, 0xa8, 0x25, 0xcf, 0x0f, 0x96, 0xba, 0x88, 0xdb, 0xc9, 0xbc, 0x72, 0x0a, 0x8a,
-	0x94, 0x2c, 0x2a, 0x25, 0x26, 0xd6, 0x87, 0x29, 0xc1, 0x6f, 0x0d, 0x98, 0x8a, 0x56, 0x22, 0x5e,
-	0x2e, 0x62, 0x4c, 0xbd, 0x3d, 0xe6, 0xe2, 0x70, 0x00, 0x79, 0xbb, 0xad, 0xbc, 0xdd, 0xc4, 0xeb,
-	0xa3, 0xc5, 0xcd, 0xd4, 0x12, 0x66, 0x07, 0xea, 0x25, 0x3a, 0xc4, 0xc7, 0x06, 0x4c, 0xab, 0x4d,
-	0x8e, 0x43, 0x3d, 0x25, 0xe1, 0xbf, 0x56, 0x82, 0x20, 0x31, 0xd7, 0x95, 0x18, 0x1b, 0xdf, 0x1a,
-	0x47, 0x0c, 0x3e, 0x82, 0x19, 0xda, 0x58, 0x85, 0x2e, 0x32, 0xfb, 0xdd, 0xb4, 0xca, 0x20, 0x24,
-	0xe3, 0xaa, 0x92, 0x71, 0x05, 0x97, 0xf2, 0x32, 0x14, 0x8c, 0x1d, 0xa4, 0x1e, 0x88, 0x43, 0xfc,
-	0xc5, 0x80, 0x33, 0x34, 0x83, 0x58, 0x48, 0x9e, 0xdd, 0x87, 0xe6, 0x52, 0x29, 0x86, 0x14, 0xbc,
-	0xaf, 0x14, 0xbc, 0x87, 0xef, 0x8e, 0x98, 0x88, 0x78, 0xf6, 0xd9, 0x41, 0xb2, 0x1f, 0x0f, 0xf1,
-	0x7b, 0x03, 0xaa, 0xf1, 0x42, 0xc1, 0x32, 0xb7, 0xa2, 0x74, 0x54, 0xf2, 0x3b, 0xc9, 0xba, 0xa5,
-	0xc4, 0x35, 0x91, 0x8d, 0x29, 0x0e, 0x9f, 0x18, 0x30, 0x9b, 0x1a, 0x6e, 0x5c, 0x2e, 0x72, 0x77,
-	0x72, 0xd9, 0x98, 0x2b, 0xa7, 0xe2, 0x9e, 0xb1, 0x7f, 0xd4, 0x72, 0xd9, 0xf8, 0xe0, 0xf7, 0xa3,
-	0x86, 0xf1, 0xf4, 0xa8, 0x61, 0xfc, 0x73, 0xd4, 0x30, 0x7e, 0x3c, 0x6e, 0x4c, 0x3c, 0x3d, 0x6e,
-	0x4c, 0xfc, 0x75, 0xdc, 0x98, 0xf8, 0xec, 0xaa, 0xe7, 0xcb, 0xcf, 0x7b, 0x3b, 0xf6, 0x2e, 0xdf,
-	0x8b, 0x19, 0xf5, 0x9f, 0x35, 0xd1, 0xfe, 0x82, 0x7d, 0xa9, 0xe8, 0xa3, 0x2e, 0x10, 0xd1, 0xef,
-	0x92, 0x19, 0xf5, 0xb3, 0xe1, 0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xe4, 0xf6, 0xe9,
-	0xe0, 0x0c, 0x00, 0x00,
+	// 1121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x38, 0xbf, 0x5f, 0x9a, 0xf4, 0xfb, 0x7d, 0xcd, 0x8f, 0x65, 0x29, 0x4e, 0xd8, 0xd0,
+	0x24, 0x50, 0xb2, 0x8b, 0xd3, 0x26, 0x45, 0x50, 0x90, 0x1a, 0x9a, 0x14, 0x04, 0x48, 0xc1, 0xad,
+	0x38, 0x70, 0xb1, 0x36, 0xf1, 0xca, 0x58, 0x75, 0x3c, 0xee, 0xce, 0xda, 0x60, 0x52, 0x0b, 0x29,
+	0x12, 0x82, 0x13, 0x20, 0x51, 0x01, 0x57, 0xc4, 0x8d, 0x33, 0x77, 0xae, 0x70, 0xab, 0xe0, 0xc2,
+	0x11, 0x25, 0xfc, 0x21, 0x68, 0x67, 0xde, 0x6e, 0x76, 0x37, 0xeb, 0x8d, 0x5d, 0x45, 0x88, 0x93,
+	0xbb, 0x33, 0x9f, 0xf7, 0x79, 0x9f, 0xf7, 0xde, 0xbc, 0x79, 0xd3, 0xc0, 0x53, 0x7b, 0x5c, 0xec,
+	0x73, 0x61, 0x55, 0x78, 0xcb, 0x6a, 0x15, 0xac, 0x07, 0x4d, 0xc7, 0x6d, 0x9b, 0x0d, 0x97, 0x7b,
+	0x1c, 0x27, 0xd5, 0x96, 0x59, 0xe1, 0x2d, 0xb3, 0x55, 0xd0, 0x5f, 0x20, 0xe4, 0xae, 0x2d, 0x1c,
+	0x85, 0xb3, 0x5a, 0x85, 0x5d, 0xc7, 0xb3, 0x0b, 0x56, 0xc3, 0xae, 0x54, 0xeb, 0xb6, 0x57, 0xe5,
+	0x75, 0x65, 0xaa, 0x5f, 0xae, 0x70, 0x5e, 0xa9, 0x39, 0x96, 0xdd, 0xa8, 0x5a, 0x76, 0xbd, 0xce,
+	0x3d, 0xb9, 0x29, 0x68, 0x77, 0x2e, 0xee, 0xd3, 0xe7, 0x57, 0x1b, 0x24, 0xa6, 0x24, 0xbf, 0x2c,
+	0x72, 0x2f, 0x3f, 0x8c, 0x1b, 0x30, 0xfd, 0x9e, 0xef, 0x73, 0xc7, 0xe5, 0x0d, 0x2e, 0xec, 0x5a,
+	0xd1, 0x79, 0xd0, 0x74, 0x84, 0x87, 0xf3, 0x30, 0xd1, 0xa0, 0xa5, 0x52, 0xb5, 0xac, 0xb1, 0x05,
+	0xb6, 0x32, 0x54, 0x84, 0x60, 0xe9, 0xad, 0xb2, 0xf1, 0x0e, 0xcc, 0x24, 0x0c, 0x45, 0x83, 0xd7,
+	0x85, 0x83, 0xd7, 0x60, 0x2c, 0x80, 0x49, 0xb3, 0x89, 0xb5, 0x39, 0x33, 0x16, 0xb1, 0x19, 0x9a,
+	0x84, 0x40, 0xe3, 0xab, 0x5c, 0x82, 0x4e, 0x04, 0x42, 0xb6, 0xe1, 0x62, 0x28, 0x44, 0x78, 0xb6,
+	0xd7, 0x14, 0x92, 0x75, 0x6a, 0xed, 0x99, 0x2e, 0xac, 0x77, 0x25, 0xa8, 0x38, 0xd5, 0x88, 0x7d,
+	0xa3, 0x09, 0xc3, 0x2d, 0xee, 0x39, 0xae, 0x96, 0x5b, 0x60, 0x2b, 0xe3, 0x9b, 0xda, 0xef, 0x3f,
+	0xaf, 0x4e, 0x13, 0xc1, 0xad, 0x72, 0xd9, 0x75, 0x84, 0xb8, 0xeb, 0xb9, 0xd5, 0x7a, 0xa5, 0xa8,
+	0x60, 0xb8, 0x01, 0xe3, 0x65, 0xa7, 0xc1, 0x45, 0xd5, 0xe3, 0xae, 0x36, 0x78, 0x86, 0xcd, 0x09,
+	0x14, 0xb7, 0x01, 0x4e, 0xca, 0xa6, 0x0d, 0xc9, 0x04, 0x2c, 0x05, 0x52, 0xfd, 0x1a, 0x9b, 0xea,
+	0x2c, 0x50, 0x8d, 0xcd, 0x1d, 0xbb, 0xe2, 0x50, 0xac, 0xc5, 0x88, 0xa5, 0xf1, 0x3d, 0x83, 0xd9,
+	0x64, 0x46, 0x28, 0xc3, 0xeb, 0x30, 0x1e, 0x04, 0xe7, 0x27, 0x63, 0x30, 0x2b, 0xc5, 0x27, 0x48,
+	0xbc, 0x13, 0x53, 0x96, 0x93, 0xca, 0x96, 0xcf, 0x54, 0xa6, 0x7c, 0xc6, 0xa4, 0xed, 0xc1, 0xff,
+	0xa4, 0xb2, 0xf7, 0xb9, 0xe7, 0xf4, 0x7a, 0x5e, 0xfa, 0xcd, 0xbf, 0x71, 0x13, 0xfe, 0x1f, 0x71,
+	0x42, 0x91, 0x2f, 0xc3, 0x90, 0xbf, 0x4b, 0xe7, 0xea, 0x52, 0x22, 0x68, 0x09, 0x95, 0x00, 0xe3,
+	0x61, 0xc4, 0x5a, 0xf4, 0xac, 0x71, 0x3b, 0x25, 0x43, 0x4f, 0x52, 0xbb, 0x2f, 0x18, 0x60, 0xd4,
+	0x3d, 0xa9, 0x7f, 0x5e, 0xa5, 0x20, 0xa8, 0x59, 0xaa, 0x7c, 0x85, 0x38, 0xbf, 0x5a, 0xad, 0x93,
+	0x92, 0x1d, 0xdb, 0xb5, 0xf7, 0x63, 0x99, 0x90, 0x0b, 0x25, 0xaf, 0xdd, 0x50, 0xe9, 0x1c, 0x2f,
+	0x82, 0x5a, 0xba, 0xd7, 0x6e, 0x38, 0xc6, 0xb7, 0x39, 0xb8, 0x14, 0xb3, 0xa3, 0x10, 0x6e, 0xc3,
+	0x64, 0x8b, 0x7b, 0xd5, 0x7a, 0xa5, 0xa4, 0xc0, 0x54, 0x89, 0xa7, 0x4f, 0x87, 0x52, 0xad, 0x57,
+	0x94, 0xed, 0x66, 0x4e, 0x63, 0xc5, 0x0b, 0xad, 0xc8, 0x0a, 0xde, 0x81, 0x29, 0x6a, 0x98, 0x80,
+	0x46, 0x45, 0x78, 0x39, 0x41, 0x73, 0x5b, 0x81, 0x22, 0x3c, 0x93, 0xe5, 0xe8, 0x12, 0xde, 0x82,
+	0x0b, 0x9e, 0x5d, 0xab, 0xb5, 0x03, 0x9a, 0x41, 0x49, 0xa3, 0x27, 0x68, 0xee, 0xf9, 0x90, 0x08,
+	0xc9, 0x84, 0x77, 0xb2, 0x80, 0xab, 0x30, 0x42, 0xc6, 0xaa, 0x57, 0x67, 0x92, 0x9d, 0xa4, 0x12,
+	0x40, 0x20, 0xa3, 0x4e, 0x79, 0x21, 0x69, 0x3d, 0x1f, 0xad, 0xd8, 0x75, 0x92, 0xeb, 0xf9, 0x3a,
+	0x31, 0xde, 0x84, 0xe9, 0xb8, 0x3f, 0x2a, 0xc4, 0x4b, 0x30, 0x4a, 0x20, 0x2a, 0xc1, 0x6c, 0x7a,
+	0xee, 0x8a, 0x01, 0xcc, 0xf8, 0x34, 0xce, 0xf4, 0xef, 0x77, 0xc5, 0x23, 0x06, 0x33, 0x09, 0x05,
+	0x14, 0xcc, 0x1a, 0x8c, 0x91, 0xca, 0xa0, 0x37, 0xba, 0x45, 0x13, 0xe2, 0xce, 0xaf, 0x43, 0x5e,
+	0x81, 0x39, 0xa9, 0x4a, 0x9e, 0x92, 0xa2, 0x23, 0x9a, 0x35, 0xaf, 0x8f, 0x21, 0xa8, 0x9d, 0xb6,
+	0x0d, 0x2b, 0x34, 0x2c, 0xcf, 0x99, 0xc6, 0xba, 0x1f, 0x4a, 0x32, 0x51, 0x40, 0xe3, 0x90, 0x41,
+	0x3e, 0x7e, 0xe5, 0x6f, 0xb6, 0xdf, 0x76, 0xda, 0x1f, 0x71, 0xb7, 0x1c, 0x28, 0xd2, 0x60, 0xf4,
+	0xbe, 0x5a, 0xa1, 0xa6, 0x0d, 0x3e, 0xcf, 0xad, 0x4a, 0x3f, 0x30, 0x98, 0xef, 0x2a, 0xe2, 0x3f,
+	0x32, 0x80, 0xb6, 0xc0, 0x88, 0x49, 0x7c, 0xd7, 0x11, 0x42, 0x61, 0x9b, 0xb5, 0xde, 0x0f, 0xb6,
+	0xf1, 0x23, 0x83, 0xc5, 0x4c, 0x9e, 0x30, 0xdc, 0x91, 0x7e, 0x5e, 0x1e, 0x04, 0xc6, 0x2d, 0xb8,
+	0xb8, 0xaf, 0x08, 0x4b, 0xae, 0x62, 0xd4, 0x72, 0x0b, 0x83, 0x29, 0xd7, 0x5c, 0xcc, 0x6d, 0x71,
+	0x6a, 0x3f, 0xfa, 0x29, 0xd6, 0x7e, 0x9b, 0x80, 0x61, 0xa9, 0x12, 0x3f, 0x63, 0x30, 0x16, 0xf8,
+	0xc2, 0xc5, 0x04, 0x49, 0xda, 0x2b, 0x4e, 0x7f, 0x2e, 0x1b, 0xa4, 0xe2, 0x33, 0xcc, 0xc3, 0x3f,
+	0xfe, 0xfe, 0x26, 0xb7, 0x82, 0x4b, 0x56, 0xfc, 0x01, 0x19, 0x56, 0xce, 0x3a, 0x88, 0x24, 0xb2,
+	0x83, 0x9f, 0xc0, 0xf8, 0x4e, 0x58, 0xd4, 0x4c, 0x17, 0x41, 0x2d, 0xf4, 0x2b, 0x67, 0xa0, 0x48,
+	0xc9, 0x82, 0x54, 0xa2, 0xa3, 0xd6, 0x4d, 0x09, 0x7e, 0xce, 0x60, 0xc8, 0x1f, 0x94, 0x38, 0x9f,
+	0xc6, 0x18, 0x79, 0x91, 0xe8, 0x0b, 0xdd, 0x01, 0xe4, 0xed, 0xa6, 0xf4, 0xb6, 0x81, 0xd7, 0x7b,
+	0x8b, 0xdb, 0x92, 0xa3, 0xd9, 0x3a, 0xf0, 0x7f, 0xdc, 0x0e, 0x1e, 0x32, 0x18, 0xf6, 0xe9, 0x04,
+	0x76, 0xf5, 0x14, 0x86, 0xff, 0x6c, 0x06, 0x82, 0xc4, 0x5c, 0x97, 0x62, 0x4c, 0x7c, 0xb1, 0x1f,
+	0x31, 0xf8, 0x10, 0x46, 0x68, 0x8e, 0xa5, 0xba, 0x88, 0x4d, 0x7d, 0xdd, 0xc8, 0x82, 0x90, 0x8c,
+	0xab, 0x52, 0xc6, 0x15, 0x5c, 0x4c, 0xca, 0x90, 0x30, 0xeb, 0x20, 0xf2, 0x6c, 0xe8, 0xe0, 0x77,
+	0x0c, 0x46, 0xe9, 0x66, 0xc6, 0x54, 0xf2, 0xf8, 0x94, 0xd4, 0x17, 0x33, 0x31, 0xa4, 0xe0, 0x0d,
+	0xa9, 0xe0, 0x35, 0x7c, 0xb5, 0xc7, 0x44, 0x04, 0x13, 0xc1, 0x3a, 0x08, 0xa7, 0x66, 0x07, 0xbf,
+	0x64, 0x30, 0x46, 0xc4, 0x02, 0xb3, 0xdc, 0x8a, 0xcc, 0x56, 0x49, 0x4e, 0x2a, 0xe3, 0x86, 0x14,
+	0x57, 0x40, 0xab, 0x4f, 0x71, 0xf8, 0x88, 0xc1, 0x44, 0xe4, 0xca, 0xc7, 0xa5, 0x34, 0x77, 0xa7,
+	0x47, 0x90, 0xbe, 0x7c, 0x26, 0xee, 0x09, 0xcf, 0x8f, 0x1c, 0x39, 0xf8, 0x13, 0x03, 0x3c, 0x7d,
+	0xd1, 0xe3, 0x6a, 0x66, 0xbb, 0x26, 0xa7, 0x92, 0x6e, 0xf6, 0x0a, 0x27, 0xad, 0xeb, 0x52, 0xab,
+	0x85, 0xab, 0xdd, 0xb4, 0x96, 0x76, 0xdb, 0x25, 0x1a, 0x6c, 0xd6, 0x01, 0xfd, 0xa3, 0x83, 0xbf,
+	0x30, 0x98, 0x4d, 0xbf, 0xaa, 0xb1, 0x90, 0xa5, 0x20, 0x75, 0x3c, 0xe8, 0x6b, 0xfd, 0x98, 0x90,
+	0xf0, 0xd7, 0xa5, 0xf0, 0x97, 0x71, 0xa3, 0xc7, 0x24, 0x27, 0xee, 0xff, 0xcd, 0xad, 0x5f, 0x8f,
+	0xf2, 0xec, 0xf1, 0x51, 0x9e, 0xfd, 0x75, 0x94, 0x67, 0x5f, 0x1f, 0xe7, 0x07, 0x1e, 0x1f, 0xe7,
+	0x07, 0xfe, 0x3c, 0xce, 0x0f, 0x7c, 0x70, 0xb5, 0x52, 0xf5, 0x3e, 0x6c, 0xee, 0x9a, 0x7b, 0x7c,
+	0x3f, 0xe0, 0x56, 0x3f, 0xab, 0xa2, 0x7c, 0xdf, 0xfa, 0x58, 0x3a, 0xf2, 0x9b, 0x4e, 0xf8, 0x7f,
+	0x1c, 0x18, 0x91, 0xff, 0x77, 0xbf, 0xf6, 0xcf, 0x00, 0xe7, 0xcc, 0xce, 0x7a, 0x65, 0x10, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,6 +1237,12 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalsByKeyword queries the proposals whose title or summary contains a
+	// given word.
+	ProposalsByKeyword(ctx context.Context, in *QueryProposalsByKeywordRequest, opts ...grpc.CallOption) (*QueryProposalsByKeywordResponse, error)
+	// ProposalMessageResults queries the execution results of the messages of
+	// a proposal.
+	ProposalMessageResults(ctx context.Context, in *QueryProposalMessageResultsRequest, opts ...grpc.CallOption) (*QueryProposalMessageResultsResponse, error)
 }
 
 type queryClient struct {
@@ -1089,6 +1325,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByKeyword(ctx context.Context, in *QueryProposalsByKeywordRequest, opts ...grpc.CallOption) (*QueryProposalsByKeywordResponse, error) {
+	out := new(QueryProposalsByKeywordResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalsByKeyword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalMessageResults(ctx context.Context, in *QueryProposalMessageResultsRequest, opts ...grpc.CallOption) (*QueryProposalMessageResultsResponse, error) {
+	out := new(QueryProposalMessageResultsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalMessageResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1107,6 +1361,12 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalsByKeyword queries the proposals whose title or summary contains a
+	// given word.
+	ProposalsByKeyword(context.Context, *QueryProposalsByKeywordRequest) (*QueryProposalsByKeywordResponse, error)
+	// ProposalMessageResults queries the execution results of the messages of
+	// a proposal.
+	ProposalMessageResults(context.Context, *QueryProposalMessageResultsRequest) (*QueryProposalMessageResultsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1137,6 +1397,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ProposalsByKeyword(ctx context.Context, req *QueryProposalsByKeywordRequest) (*QueryProposalsByKeywordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByKeyword not implemented")
+}
+func (*UnimplementedQueryServer) ProposalMessageResults(ctx context.Context, req *QueryProposalMessageResultsRequest) (*QueryProposalMessageResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalMessageResults not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1286,6 +1552,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByKeyword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByKeywordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByKeyword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalsByKeyword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByKeyword(ctx, req.(*QueryProposalsByKeywordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalMessageResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalMessageResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalMessageResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalMessageResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalMessageResults(ctx, req.(*QueryProposalMessageResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1322,6 +1624,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalsByKeyword",
+			Handler:    _Query_ProposalsByKeyword_Handler,
+		},
+		{
+			MethodName: "ProposalMessageResults",
+			Handler:    _Query_ProposalMessageResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1975,61 +2285,222 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryProposalsByKeywordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	return n
+
+func (m *QueryProposalsByKeywordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalsByKeywordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Keyword) > 0 {
+		i -= len(m.Keyword)
+		copy(dAtA[i:], m.Keyword)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Keyword)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalsByKeywordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByKeywordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByKeywordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalMessageResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalMessageResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalMessageResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalMessageResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalMessageResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalMessageResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageResults) > 0 {
+		for iNdEx := len(m.MessageResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -2245,6 +2716,72 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalsByKeywordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keyword)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByKeywordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalMessageResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalMessageResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if len(m.MessageResults) > 0 {
+		for _, e := range m.MessageResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3950,6 +4487,416 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalsByKeywordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByKeywordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByKeywordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByKeywordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByKeywordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByKeywordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalMessageResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalMessageResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalMessageResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalMessageResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalMessageResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalMessageResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageResults = append(m.MessageResults, &MessageResult{})
+			if err := m.MessageResults[len(m.MessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
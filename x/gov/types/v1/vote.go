@@ -24,6 +24,13 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOption
 	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
+// NewDelegatorVoteOverride creates a new DelegatorVoteOverride instance
+//
+//nolint:interfacer
+func NewDelegatorVoteOverride(proposalID uint64, voter sdk.AccAddress, validator sdk.ValAddress, option VoteOption) DelegatorVoteOverride {
+	return DelegatorVoteOverride{ProposalId: proposalID, Voter: voter.String(), ValidatorAddress: validator.String(), Option: option}
+}
+
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
 	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
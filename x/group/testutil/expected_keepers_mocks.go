@@ -112,6 +112,66 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(arg0 context.Context, arg1 *types1.MsgBurnCoins) (*types1.MsgBurnCoinsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgBurnCoinsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), arg0, arg1)
+}
+
+// CreateEscrow mocks base method.
+func (m *MockBankKeeper) CreateEscrow(arg0 context.Context, arg1 *types1.MsgCreateEscrow) (*types1.MsgCreateEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgCreateEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrow indicates an expected call of CreateEscrow.
+func (mr *MockBankKeeperMockRecorder) CreateEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrow", reflect.TypeOf((*MockBankKeeper)(nil).CreateEscrow), arg0, arg1)
+}
+
+// FreezeAccount mocks base method.
+func (m *MockBankKeeper) FreezeAccount(arg0 context.Context, arg1 *types1.MsgFreezeAccount) (*types1.MsgFreezeAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccount", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgFreezeAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockBankKeeperMockRecorder) FreezeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockBankKeeper)(nil).FreezeAccount), arg0, arg1)
+}
+
+// FulfillEscrow mocks base method.
+func (m *MockBankKeeper) FulfillEscrow(arg0 context.Context, arg1 *types1.MsgFulfillEscrow) (*types1.MsgFulfillEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FulfillEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgFulfillEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FulfillEscrow indicates an expected call of FulfillEscrow.
+func (mr *MockBankKeeperMockRecorder) FulfillEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FulfillEscrow", reflect.TypeOf((*MockBankKeeper)(nil).FulfillEscrow), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx types.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -126,6 +186,21 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// GrantBurnAuthorization mocks base method.
+func (m *MockBankKeeper) GrantBurnAuthorization(arg0 context.Context, arg1 *types1.MsgGrantBurnAuthorization) (*types1.MsgGrantBurnAuthorizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantBurnAuthorization", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgGrantBurnAuthorizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantBurnAuthorization indicates an expected call of GrantBurnAuthorization.
+func (mr *MockBankKeeperMockRecorder) GrantBurnAuthorization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantBurnAuthorization", reflect.TypeOf((*MockBankKeeper)(nil).GrantBurnAuthorization), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(ctx types.Context, moduleName string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -184,6 +259,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendWithMemo mocks base method.
+func (m *MockBankKeeper) SendWithMemo(arg0 context.Context, arg1 *types1.MsgSendWithMemo) (*types1.MsgSendWithMemoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWithMemo", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgSendWithMemoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendWithMemo indicates an expected call of SendWithMemo.
+func (mr *MockBankKeeperMockRecorder) SendWithMemo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWithMemo", reflect.TypeOf((*MockBankKeeper)(nil).SendWithMemo), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types1.MsgSetSendEnabled) (*types1.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +303,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// UnfreezeAccount mocks base method.
+func (m *MockBankKeeper) UnfreezeAccount(arg0 context.Context, arg1 *types1.MsgUnfreezeAccount) (*types1.MsgUnfreezeAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccount", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgUnfreezeAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockBankKeeperMockRecorder) UnfreezeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockBankKeeper)(nil).UnfreezeAccount), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types1.MsgUpdateParams) (*types1.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
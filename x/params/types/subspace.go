@@ -163,21 +163,31 @@ func (s Subspace) Modified(ctx sdk.Context, key []byte) bool {
 	return tstore.Has(key)
 }
 
-// checkType verifies that the provided key and value are comptable and registered.
-func (s Subspace) checkType(key []byte, value interface{}) {
+// ValidateType verifies that the provided key is registered and that the
+// value, or the value it points to, matches the registered parameter type.
+func (s Subspace) ValidateType(key []byte, value interface{}) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
-		panic(fmt.Sprintf("parameter %s not registered", key))
+		return fmt.Errorf("parameter %s not registered", key)
 	}
 
 	ty := attr.ty
 	pty := reflect.TypeOf(value)
-	if pty.Kind() == reflect.Ptr {
+	if pty != nil && pty.Kind() == reflect.Ptr {
 		pty = pty.Elem()
 	}
 
 	if pty != ty {
-		panic("type mismatch with registered table")
+		return fmt.Errorf("type mismatch with registered table: expected %s, got %s", ty, pty)
+	}
+
+	return nil
+}
+
+// checkType verifies that the provided key and value are comptable and registered.
+func (s Subspace) checkType(key []byte, value interface{}) {
+	if err := s.ValidateType(key, value); err != nil {
+		panic(err.Error())
 	}
 }
 
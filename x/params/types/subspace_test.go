@@ -76,6 +76,14 @@ func (suite *SubspaceTestSuite) TestGetSet() {
 	suite.Require().Equal(t, v)
 }
 
+func (suite *SubspaceTestSuite) TestValidateType() {
+	suite.Require().NoError(suite.ss.ValidateType(keyUnbondingTime, time.Hour))
+	suite.Require().NoError(suite.ss.ValidateType(keyMaxValidators, new(uint16)))
+	suite.Require().ErrorContains(suite.ss.ValidateType(keyUnbondingTime, uint16(1)), "type mismatch with registered table")
+	suite.Require().ErrorContains(suite.ss.ValidateType(keyUnbondingTime, nil), "type mismatch with registered table")
+	suite.Require().ErrorContains(suite.ss.ValidateType([]byte("invalid"), time.Hour), "parameter invalid not registered")
+}
+
 func (suite *SubspaceTestSuite) TestGetIfExists() {
 	var v time.Duration
 
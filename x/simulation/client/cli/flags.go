@@ -10,21 +10,23 @@ const DefaultSeedValue = 42
 
 // List of available flags for the simulator
 var (
-	FlagGenesisFileValue        string
-	FlagParamsFileValue         string
-	FlagExportParamsPathValue   string
-	FlagExportParamsHeightValue int
-	FlagExportStatePathValue    string
-	FlagExportStatsPathValue    string
-	FlagSeedValue               int64
-	FlagInitialBlockHeightValue int
-	FlagNumBlocksValue          int
-	FlagBlockSizeValue          int
-	FlagLeanValue               bool
-	FlagCommitValue             bool
-	FlagOnOperationValue        bool // TODO: Remove in favor of binary search for invariant violation
-	FlagAllInvariantsValue      bool
-	FlagDBBackendValue          string
+	FlagGenesisFileValue          string
+	FlagParamsFileValue           string
+	FlagExportParamsPathValue     string
+	FlagExportParamsHeightValue   int
+	FlagExportStatePathValue      string
+	FlagExportStatsPathValue      string
+	FlagExportGasPathValue        string
+	FlagSeedValue                 int64
+	FlagInitialBlockHeightValue   int
+	FlagNumBlocksValue            int
+	FlagBlockSizeValue            int
+	FlagLeanValue                 bool
+	FlagCommitValue               bool
+	FlagOnOperationValue          bool // TODO: Remove in favor of binary search for invariant violation
+	FlagAllInvariantsValue        bool
+	FlagInvariantsEveryBlockValue bool
+	FlagDBBackendValue            string
 
 	FlagEnabledValue     bool
 	FlagVerboseValue     bool
@@ -41,6 +43,7 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagExportGasPathValue, "ExportGasPath", "", "custom file path to save the gas consumed by each message type as JSON")
 	flag.Int64Var(&FlagSeedValue, "Seed", DefaultSeedValue, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -49,6 +52,7 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagCommitValue, "Commit", false, "have the simulation commit")
 	flag.BoolVar(&FlagOnOperationValue, "SimulateEveryOperation", false, "run slow invariants every operation")
 	flag.BoolVar(&FlagAllInvariantsValue, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
+	flag.BoolVar(&FlagInvariantsEveryBlockValue, "InvariantsEveryBlock", false, "run all registered invariants after every simulated block")
 	flag.StringVar(&FlagDBBackendValue, "DBBackend", "goleveldb", "custom db backend type")
 
 	// simulation flags
@@ -67,6 +71,7 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ExportGasPath:      FlagExportGasPathValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		NumBlocks:          FlagNumBlocksValue,
@@ -84,6 +84,29 @@ func SimulateFromSeed(
 
 	config.ChainID = chainID
 
+	// record the gas consumed by each message type, exporting it once the
+	// simulation is over
+	gasRecorder := config.GasRecorder
+	if gasRecorder == nil && config.ExportGasPath != "" {
+		gasRecorder = simulation.NewGasRecorder()
+	}
+
+	if gasRecorder != nil {
+		app.SetMsgGasRecorder(gasRecorder)
+		defer func() {
+			app.SetMsgGasRecorder(nil)
+
+			if config.ExportGasPath == "" {
+				return
+			}
+
+			fmt.Println("Exporting gas usage...")
+			if exportErr := gasRecorder.ExportJSON(config.ExportGasPath); exportErr != nil && err == nil {
+				err = exportErr
+			}
+		}()
+	}
+
 	fmt.Printf(
 		"Starting the simulation from time %v (unixtime %v)\n",
 		genesisTimestamp.UTC().Format(time.UnixDate), genesisTimestamp.Unix(),
@@ -190,6 +213,14 @@ func SimulateFromSeed(
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
 
 		res := app.EndBlock(abci.RequestEndBlock{})
+
+		if config.BlockInvariant != nil {
+			if msg, broken := config.BlockInvariant(ctx); broken {
+				logWriter.PrintLogs()
+				tb.Fatalf("invariant broken after block %d:\n%s", height, msg)
+			}
+		}
+
 		header.Height++
 		header.Time = header.Time.Add(
 			time.Duration(minTimePerBlock) * time.Second)
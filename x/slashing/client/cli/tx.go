@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,7 +23,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	slashingTxCmd.AddCommand(NewUnjailTxCmd())
+	slashingTxCmd.AddCommand(
+		NewUnjailTxCmd(),
+		NewSetSigningWindowTxCmd(),
+	)
 	return slashingTxCmd
 }
 
@@ -51,3 +57,37 @@ $ <appd> tx slashing unjail --from mykey
 
 	return cmd
 }
+
+// NewSetSigningWindowTxCmd returns a CLI command handler for creating a MsgSetSigningWindow transaction.
+func NewSetSigningWindowTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-signing-window [window]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the signing window of a validator, shorter than the signed blocks window",
+		Long: `set the signing window of a validator, shorter than the signed blocks window
+param, 0 reverting to the param:
+
+$ <appd> tx slashing set-signing-window 50 --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+
+			window, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid signing window %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgSetSigningWindow(sdk.ValAddress(valAddr), window)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
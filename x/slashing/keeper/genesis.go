@@ -9,6 +9,12 @@ import (
 // InitGenesis initialize default parameters
 // and the keeper's address to pubkey map
 func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKeeper, data *types.GenesisState) {
+	if cp := ctx.ConsensusParams(); cp != nil {
+		if err := data.Params.ValidateMaxEvidenceAge(cp.Evidence); err != nil {
+			panic(err)
+		}
+	}
+
 	stakingKeeper.IterateValidators(ctx,
 		func(index int64, validator stakingtypes.ValidatorI) bool {
 			consPk, err := validator.ConsPubKey()
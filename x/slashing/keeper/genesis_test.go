@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/golang/mock/gomock"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,3 +56,19 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
 }
+
+func (s *KeeperTestSuite) TestInitGenesisMaxEvidenceAge() {
+	require := s.Require()
+
+	genesisState := types.DefaultGenesisState()
+	genesisState.Params.MaxEvidenceAge = 48 * time.Hour
+
+	ctx := s.ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Evidence: &tmproto.EvidenceParams{MaxAgeNumBlocks: 100000, MaxAgeDuration: 24 * time.Hour},
+	})
+
+	require.PanicsWithError(
+		"max evidence age 48h0m0s exceeds the consensus evidence max age duration 24h0m0s (max age num blocks 100000)",
+		func() { s.slashingKeeper.InitGenesis(ctx, s.stakingKeeper, genesisState) },
+	)
+}
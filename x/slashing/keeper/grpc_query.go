@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,20 +37,38 @@ func (k Keeper) SigningInfo(c context.Context, req *types.QuerySigningInfoReques
 		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
 	}
 
-	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	consAddr, err := parseConsAddress(req.ConsAddress)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid consensus address %s: %s", req.ConsAddress, err)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
-		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", consAddr)
 	}
 
 	return &types.QuerySigningInfoResponse{ValSigningInfo: signingInfo}, nil
 }
 
+// parseConsAddress parses a consensus address given either in bech32 or in
+// 0x-prefixed hex format.
+func parseConsAddress(address string) (sdk.ConsAddress, error) {
+	if !strings.HasPrefix(address, "0x") && !strings.HasPrefix(address, "0X") {
+		return sdk.ConsAddressFromBech32(address)
+	}
+
+	consAddr, err := sdk.ConsAddressFromHex(address[2:])
+	if err != nil {
+		return nil, err
+	}
+	if err := sdk.VerifyAddressFormat(consAddr); err != nil {
+		return nil, err
+	}
+
+	return consAddr, nil
+}
+
 // SigningInfos returns signing-infos of all validators.
 func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
 	if req == nil {
@@ -2,8 +2,12 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"encoding/hex"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
@@ -45,6 +49,20 @@ func (s *KeeperTestSuite) TestGRPCSigningInfo() {
 		&slashingtypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
 	require.NoError(err)
 	require.Equal(info, infoResp.ValSigningInfo)
+
+	// hex encoded consensus address
+	infoResp, err = queryClient.SigningInfo(gocontext.Background(),
+		&slashingtypes.QuerySigningInfoRequest{ConsAddress: "0x" + hex.EncodeToString(consAddr)})
+	require.NoError(err)
+	require.Equal(info, infoResp.ValSigningInfo)
+
+	// invalid consensus addresses
+	for _, addr := range []string{"0xzz", "0x", "cosmosvalcons1invalid"} {
+		infoResp, err = queryClient.SigningInfo(gocontext.Background(),
+			&slashingtypes.QuerySigningInfoRequest{ConsAddress: addr})
+		require.Equal(codes.InvalidArgument, status.Code(err), addr)
+		require.Nil(infoResp)
+	}
 }
 
 func (s *KeeperTestSuite) TestGRPCSigningInfos() {
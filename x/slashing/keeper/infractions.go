@@ -30,28 +30,40 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 	// this is a relative index, so it counts blocks the validator *should* have signed
 	// will use the 0-value default signing info if not present, except for start height
-	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
+	// The missed blocks are recorded over the signed blocks window whatever the
+	// signing window of the validator, so that none is forgotten if its window
+	// shrinks then grows back, while the counter only tracks its own window.
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	signingWindow := signInfo.SigningWindow(signedBlocksWindow)
+	index := signInfo.IndexOffset % signedBlocksWindow
+
+	// the block leaving the signing window of the validator is no longer counted
+	if signInfo.IndexOffset >= signingWindow &&
+		k.GetValidatorMissedBlockBitArray(ctx, consAddr, (signInfo.IndexOffset-signingWindow)%signedBlocksWindow) {
+		signInfo.MissedBlocksCounter--
+	}
 	signInfo.IndexOffset++
 
 	// Update signed block bit array & counter
-	// This counter just tracks the sum of the bit array
+	// This counter just tracks the sum of the bit array over the signing window
 	// That way we avoid needing to read/write the whole array each time
 	previous := k.GetValidatorMissedBlockBitArray(ctx, consAddr, index)
 	missed := !signed
-	switch {
-	case !previous && missed:
-		// Array value has changed from not missed to missed, increment counter
-		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+
+	// the missed blocks of a validator are not counted during the grace window
+	// following its unjail
+	if missed && height < signInfo.UnjailGraceEndHeight {
+		missed = false
+	}
+
+	if missed {
 		signInfo.MissedBlocksCounter++
-	case previous && !missed:
-		// Array value has changed from missed to not missed, decrement counter
-		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, false)
-		signInfo.MissedBlocksCounter--
-	default:
-		// Array value at this index has not changed, no need to update counter
+	}
+	if missed != previous {
+		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, missed)
 	}
 
-	minSignedPerWindow := k.MinSignedPerWindow(ctx)
+	minSignedPerWindow := k.minSignedPerWindow(ctx, signingWindow)
 
 	if missed {
 		ctx.EventManager().EmitEvent(
@@ -72,8 +84,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		)
 	}
 
-	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
-	maxMissed := k.SignedBlocksWindow(ctx) - minSignedPerWindow
+	minHeight := signInfo.StartHeight + signingWindow
+	maxMissed := signingWindow - minSignedPerWindow
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
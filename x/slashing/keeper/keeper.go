@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -111,6 +113,22 @@ func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	)
 }
 
+// ValidateEvidenceAge returns ErrExpiredEvidence if the evidence of an
+// infraction committed at the given time is older than the MaxEvidenceAge
+// param.
+func (k Keeper) ValidateEvidenceAge(ctx sdk.Context, infractionTime time.Time) error {
+	maxAge := k.MaxEvidenceAge(ctx)
+	if maxAge == 0 {
+		return nil
+	}
+
+	if age := ctx.BlockHeader().Time.Sub(infractionTime); age > maxAge {
+		return sdkerrors.Wrapf(types.ErrExpiredEvidence, "evidence age %s exceeds %s", age, maxAge)
+	}
+
+	return nil
+}
+
 func (k Keeper) deleteAddrPubkeyRelation(ctx sdk.Context, addr cryptotypes.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.AddrPubkeyRelationKey(addr))
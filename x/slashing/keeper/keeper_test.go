@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -118,6 +119,21 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	s.slashingKeeper.Jail(s.ctx, consAddr)
 }
 
+func (s *KeeperTestSuite) TestValidateEvidenceAge() {
+	require := s.Require()
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// no limit by default
+	require.NoError(s.slashingKeeper.ValidateEvidenceAge(ctx, time.Unix(0, 0)))
+
+	params := s.slashingKeeper.GetParams(ctx)
+	params.MaxEvidenceAge = 100 * time.Second
+	require.NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	require.NoError(s.slashingKeeper.ValidateEvidenceAge(ctx, time.Unix(900, 0)))
+	require.ErrorIs(s.slashingKeeper.ValidateEvidenceAge(ctx, time.Unix(899, 0)), slashingtypes.ErrExpiredEvidence)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -28,6 +28,12 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if cp := ctx.ConsensusParams(); cp != nil {
+		if err := req.Params.ValidateMaxEvidenceAge(cp.Evidence); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -52,3 +58,20 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// SetSigningWindow implements MsgServer.SetSigningWindow method.
+// Validators may set their own signing window, shorter than the signed
+// blocks window param.
+func (k msgServer) SetSigningWindow(goCtx context.Context, msg *types.MsgSetSigningWindow) (*types.MsgSetSigningWindowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetSigningWindow(ctx, valAddr, msg.SigningWindow); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSigningWindowResponse{}, nil
+}
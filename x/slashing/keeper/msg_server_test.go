@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtestutil "github.com/cosmos/cosmos-sdk/x/slashing/testutil"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -143,6 +148,26 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUpdateParamsMaxEvidenceAge() {
+	require := s.Require()
+
+	params := slashingtestutil.TestParams()
+	params.MaxEvidenceAge = 48 * time.Hour
+	req := &slashingtypes.MsgUpdateParams{Authority: s.slashingKeeper.GetAuthority(), Params: params}
+
+	ctx := s.ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Evidence: &tmproto.EvidenceParams{MaxAgeNumBlocks: 100000, MaxAgeDuration: 24 * time.Hour},
+	})
+
+	_, err := s.msgServer.UpdateParams(ctx, req)
+	require.EqualError(err, "max evidence age 48h0m0s exceeds the consensus evidence max age duration 24h0m0s (max age num blocks 100000)")
+
+	req.Params.MaxEvidenceAge = 24 * time.Hour
+	_, err = s.msgServer.UpdateParams(ctx, req)
+	require.NoError(err)
+	require.Equal(24*time.Hour, s.slashingKeeper.MaxEvidenceAge(ctx))
+}
+
 func (s *KeeperTestSuite) TestUnjail() {
 	testCases := []struct {
 		name      string
@@ -320,3 +345,190 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUnjailGraceWindow() {
+	require := s.Require()
+
+	params := s.slashingKeeper.GetParams(s.ctx)
+	params.UnjailGraceWindow = 10
+	require.NoError(s.slashingKeeper.SetParams(s.ctx, params))
+
+	ctx := s.ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr, consAddr := sdk.ValAddress(addr), sdk.ConsAddress(addr)
+
+	val, err := types.NewValidator(valAddr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Tokens = sdk.NewInt(1000)
+	val.DelegatorShares = sdk.NewDec(1)
+	val.Jailed = true
+
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(5),
+	))
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val)
+	s.stakingKeeper.EXPECT().Delegation(ctx, addr, valAddr).Return(types.NewDelegation(addr, valAddr, sdk.NewDec(100)))
+	s.stakingKeeper.EXPECT().Unjail(ctx, consAddr).Return()
+
+	_, err = s.msgServer.Unjail(ctx, &slashingtypes.MsgUnjail{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+
+	// the missed blocks are reset and the grace window started
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(found)
+	require.Equal(int64(0), info.MissedBlocksCounter)
+	require.Equal(int64(0), info.IndexOffset)
+	require.Equal(int64(30), info.UnjailGraceEndHeight)
+	require.False(s.slashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+
+	var graceEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == slashingtypes.EventTypeUnjailGraceStart {
+			graceEvent = true
+		}
+	}
+	require.True(graceEvent)
+
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false).AnyTimes()
+
+	// missed blocks are not counted during the grace window
+	s.slashingKeeper.HandleValidatorSignature(ctx.WithBlockHeight(29), pubKey.Address(), 100, false)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(0), info.MissedBlocksCounter)
+
+	// but are once it ended
+	s.slashingKeeper.HandleValidatorSignature(ctx.WithBlockHeight(30), pubKey.Address(), 100, false)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(1), info.MissedBlocksCounter)
+}
+
+func (s *KeeperTestSuite) TestSetSigningWindow() {
+	require := s.Require()
+
+	ctx := s.ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr, consAddr := sdk.ValAddress(addr), sdk.ConsAddress(pubKey.Address())
+
+	val, err := types.NewValidator(valAddr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+
+	// the validator missed the 2nd, 11th and 12th of the 12 blocks it signed
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, int64(0), int64(12), time.Unix(0, 0), false, int64(3),
+	))
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 10, true)
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 11, true)
+
+	// windows longer than the signed blocks window are rejected
+	_, err = s.msgServer.SetSigningWindow(ctx, slashingtypes.NewMsgSetSigningWindow(valAddr, 1001))
+	require.ErrorIs(err, slashingtypes.ErrSigningWindowTooLong)
+
+	_, err = s.msgServer.SetSigningWindow(ctx, slashingtypes.NewMsgSetSigningWindow(valAddr, 5))
+	require.NoError(err)
+
+	// only the missed blocks of the last 5 signed blocks are counted, all are kept
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(found)
+	require.Equal(uint64(5), info.ValidatorSigningWindow)
+	require.Equal(int64(2), info.MissedBlocksCounter)
+	require.Equal(
+		[]slashingtypes.MissedBlock{
+			slashingtypes.NewMissedBlock(1, true), slashingtypes.NewMissedBlock(10, true), slashingtypes.NewMissedBlock(11, true),
+		},
+		s.slashingKeeper.GetValidatorMissedBlocks(ctx, consAddr),
+	)
+
+	// the validator is jailed once it missed more than half of its 5 blocks window
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val).AnyTimes()
+
+	s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 100, false)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(3), info.MissedBlocksCounter)
+
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), int64(100), gomock.Any(), types.Infraction_INFRACTION_DOWNTIME).Return(sdk.ZeroInt())
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr)
+	s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 100, false)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(0), info.MissedBlocksCounter)
+	require.Equal(uint64(5), info.ValidatorSigningWindow)
+}
+
+func (s *KeeperTestSuite) TestSetSigningWindowShrinkRestore() {
+	require := s.Require()
+
+	ctx := s.ctx.WithBlockHeight(100)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr, consAddr := sdk.ValAddress(addr), sdk.ConsAddress(pubKey.Address())
+
+	val, err := types.NewValidator(valAddr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+
+	// the validator missed the 2nd, 3rd and 4th of the 12 blocks it signed
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, int64(0), int64(12), time.Unix(0, 0), false, int64(3),
+	))
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, true)
+	s.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 3, true)
+
+	// none of the missed blocks is in a 5 blocks window
+	_, err = s.msgServer.SetSigningWindow(ctx, slashingtypes.NewMsgSetSigningWindow(valAddr, 5))
+	require.NoError(err)
+	info, _ := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(0), info.MissedBlocksCounter)
+
+	// restoring the signed blocks window, in the same block, counts them again
+	_, err = s.msgServer.SetSigningWindow(ctx, slashingtypes.NewMsgSetSigningWindow(valAddr, 0))
+	require.NoError(err)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(3), info.MissedBlocksCounter)
+	require.Len(s.slashingKeeper.GetValidatorMissedBlocks(ctx, consAddr), 3)
+}
+
+func (s *KeeperTestSuite) TestSetSigningWindowShort() {
+	require := s.Require()
+
+	ctx := s.ctx.WithBlockHeight(100)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr, consAddr := sdk.ValAddress(addr), sdk.ConsAddress(pubKey.Address())
+
+	val, err := types.NewValidator(valAddr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, int64(0), int64(0), time.Unix(0, 0), false, int64(0),
+	))
+
+	// half of a 1 block window rounds to no block to sign, the validator could
+	// never be jailed
+	_, err = s.msgServer.SetSigningWindow(ctx, slashingtypes.NewMsgSetSigningWindow(valAddr, 1))
+	require.ErrorIs(err, slashingtypes.ErrSigningWindowTooShort)
+
+	_, err = s.msgServer.SetSigningWindow(ctx, slashingtypes.NewMsgSetSigningWindow(valAddr, 2))
+	require.NoError(err)
+
+	// the validator is jailed once it missed both blocks of its window
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val).AnyTimes()
+
+	s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 100, false)
+	info, _ := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(1), info.MissedBlocksCounter)
+
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), int64(100), gomock.Any(), types.Infraction_INFRACTION_DOWNTIME).Return(sdk.ZeroInt())
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr)
+	s.slashingKeeper.HandleValidatorSignature(ctx.WithBlockHeight(101), pubKey.Address(), 100, false)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(0), info.MissedBlocksCounter)
+}
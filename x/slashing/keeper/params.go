@@ -14,12 +14,16 @@ func (k Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
 
 // MinSignedPerWindow - minimum blocks signed per window
 func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
+	return k.minSignedPerWindow(ctx, k.SignedBlocksWindow(ctx))
+}
+
+// minSignedPerWindow - minimum blocks signed per given signing window
+func (k Keeper) minSignedPerWindow(ctx sdk.Context, signingWindow int64) int64 {
 	params := k.GetParams(ctx)
-	signedBlocksWindow := k.SignedBlocksWindow(ctx)
 
 	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
 	//       less than 1.
-	return params.MinSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
+	return params.MinSignedPerWindow.MulInt64(signingWindow).RoundInt64()
 }
 
 // DowntimeJailDuration - Downtime unbond duration
@@ -37,6 +41,16 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).SlashFractionDowntime
 }
 
+// UnjailGraceWindow - number of blocks after an unjail during which missed blocks are not counted
+func (k Keeper) UnjailGraceWindow(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).UnjailGraceWindow
+}
+
+// MaxEvidenceAge - maximum age of the double-sign evidence handled, no limit if zero
+func (k Keeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
+	return k.GetParams(ctx).MaxEvidenceAge
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -89,6 +90,45 @@ func (s *KeeperTestSuite) TestParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid max evidence age",
+			input: types.Params{
+				SignedBlocksWindow:      int64(750),
+				MinSignedPerWindow:      minSignedPerWindow,
+				DowntimeJailDuration:    time.Duration(10),
+				SlashFractionDoubleSign: slashFractionDoubleSign,
+				SlashFractionDowntime:   slashFractionDowntime,
+				MaxEvidenceAge:          -time.Hour,
+			},
+			expectErr: true,
+			expErrMsg: "max evidence age cannot be negative",
+		},
+		{
+			name: "set invalid unjail grace window",
+			input: types.Params{
+				SignedBlocksWindow:      int64(750),
+				MinSignedPerWindow:      minSignedPerWindow,
+				DowntimeJailDuration:    time.Duration(10),
+				SlashFractionDoubleSign: slashFractionDoubleSign,
+				SlashFractionDowntime:   slashFractionDowntime,
+				UnjailGraceWindow:       751,
+			},
+			expectErr: true,
+			expErrMsg: "unjail grace window 751 exceeds the signed blocks window 750",
+		},
+		{
+			name: "set unjail grace window overflowing the block height",
+			input: types.Params{
+				SignedBlocksWindow:      int64(750),
+				MinSignedPerWindow:      minSignedPerWindow,
+				DowntimeJailDuration:    time.Duration(10),
+				SlashFractionDoubleSign: slashFractionDoubleSign,
+				SlashFractionDowntime:   slashFractionDowntime,
+				UnjailGraceWindow:       math.MaxUint64,
+			},
+			expectErr: true,
+			expErrMsg: "exceeds the signed blocks window",
+		},
 		{
 			name: "set all valid params",
 			input: types.Params{
@@ -97,6 +137,8 @@ func (s *KeeperTestSuite) TestParams() {
 				DowntimeJailDuration:    time.Duration(34800000000000),
 				SlashFractionDoubleSign: slashFractionDoubleSign,
 				SlashFractionDowntime:   slashFractionDowntime,
+				UnjailGraceWindow:       750,
+				MaxEvidenceAge:          time.Hour,
 			},
 			expectErr: false,
 		},
@@ -122,6 +164,7 @@ func (s *KeeperTestSuite) TestParams() {
 			require.Equal(keeper.DowntimeJailDuration(ctx), expected.DowntimeJailDuration)
 			require.Equal(keeper.SlashFractionDoubleSign(ctx), expected.SlashFractionDoubleSign)
 			require.Equal(keeper.SlashFractionDowntime(ctx), expected.SlashFractionDowntime)
+			require.Equal(keeper.MaxEvidenceAge(ctx), expected.MaxEvidenceAge)
 		})
 	}
 }
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -129,6 +131,76 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// SetSigningWindow sets the signing window of a validator, which must not exceed
+// the signed blocks window param, zero reverting to it. The window must be long
+// enough for the minimum number of blocks to sign in it to be at least one, or
+// the validator could never be jailed for downtime. The missed blocks of the
+// validator are recorded over the signed blocks window, so its missed blocks
+// counter is recomputed over the last blocks of its new window.
+func (k Keeper) SetSigningWindow(ctx sdk.Context, validatorAddr sdk.ValAddress, signingWindow uint64) error {
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	if signingWindow > uint64(signedBlocksWindow) {
+		return sdkerrors.Wrapf(types.ErrSigningWindowTooLong, "%d > %d", signingWindow, signedBlocksWindow)
+	}
+	if signingWindow != 0 && k.minSignedPerWindow(ctx, int64(signingWindow)) < 1 {
+		return sdkerrors.Wrapf(types.ErrSigningWindowTooShort, "no block must be signed in a window of %d blocks", signingWindow)
+	}
+
+	validator := k.sk.Validator(ctx, validatorAddr)
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return types.ErrNoSigningInfoFound
+	}
+
+	oldWindow := signInfo.SigningWindow(signedBlocksWindow)
+	signInfo.ValidatorSigningWindow = signingWindow
+	newWindow := signInfo.SigningWindow(signedBlocksWindow)
+
+	if newWindow != oldWindow {
+		signInfo.MissedBlocksCounter = k.countValidatorMissedBlocks(ctx, consAddr, signInfo.IndexOffset, signedBlocksWindow, newWindow)
+	}
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSigningWindow,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySigningWindow, fmt.Sprintf("%d", newWindow)),
+		),
+	)
+
+	return nil
+}
+
+// countValidatorMissedBlocks returns the number of blocks missed by a validator
+// among its last signed blocks in the given window, the missed block bit array
+// being indexed over the signed blocks window.
+func (k Keeper) countValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress, indexOffset, signedBlocksWindow, window int64) int64 {
+	last := indexOffset
+	if last > window {
+		last = window
+	}
+
+	// the i-th last signed block is at index (indexOffset-1-i) % signedBlocksWindow
+	var counter int64
+	for i := int64(0); i < last; i++ {
+		if k.GetValidatorMissedBlockBitArray(ctx, address, (indexOffset-1-i)%signedBlocksWindow) {
+			counter++
+		}
+	}
+
+	return counter
+}
+
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
 func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -56,6 +58,26 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		if ctx.BlockHeader().Time.Before(info.JailedUntil) {
 			return types.ErrValidatorJailed
 		}
+
+		// reset the missed blocks of the validator and, if enabled, start the
+		// grace window during which its missed blocks are not counted
+		info.MissedBlocksCounter = 0
+		info.IndexOffset = 0
+		k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+
+		if window := k.UnjailGraceWindow(ctx); window > 0 {
+			info.UnjailGraceEndHeight = ctx.BlockHeight() + int64(window)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeUnjailGraceStart,
+					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyGraceEnd, fmt.Sprintf("%d", info.UnjailGraceEndHeight)),
+				),
+			)
+		}
+
+		k.SetValidatorSigningInfo(ctx, consAddr, info)
 	}
 
 	k.sk.Unjail(ctx, consAddr)
@@ -22,6 +22,7 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	UnjailGraceWindow       = "unjail_grace_window"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +50,11 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenUnjailGraceWindow randomized UnjailGraceWindow
+func GenUnjailGraceWindow(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +87,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var unjailGraceWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UnjailGraceWindow, &unjailGraceWindow, simState.Rand,
+		func(r *rand.Rand) { unjailGraceWindow = GenUnjailGraceWindow(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, unjailGraceWindow,
+		types.DefaultMaxEvidenceAge,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
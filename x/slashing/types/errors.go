@@ -13,4 +13,7 @@ var (
 	ErrMissingSelfDelegation        = sdkerrors.Register(ModuleName, 6, "validator has no self-delegation; cannot be unjailed")
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
+	ErrExpiredEvidence              = sdkerrors.Register(ModuleName, 9, "evidence is older than the max evidence age")
+	ErrSigningWindowTooLong         = sdkerrors.Register(ModuleName, 10, "signing window exceeds the signed blocks window")
+	ErrSigningWindowTooShort        = sdkerrors.Register(ModuleName, 11, "signing window too short for the validator to be jailed for downtime")
 )
@@ -5,13 +5,18 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
-	AttributeKeyAddress      = "address"
-	AttributeKeyHeight       = "height"
-	AttributeKeyPower        = "power"
-	AttributeKeyReason       = "reason"
-	AttributeKeyJailed       = "jailed"
-	AttributeKeyMissedBlocks = "missed_blocks"
-	AttributeKeyBurnedCoins  = "burned_coins"
+	EventTypeUnjailGraceStart = "unjail_grace_start"
+	EventTypeSetSigningWindow = "set_signing_window"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyHeight        = "height"
+	AttributeKeyPower         = "power"
+	AttributeKeyReason        = "reason"
+	AttributeKeyJailed        = "jailed"
+	AttributeKeyMissedBlocks  = "missed_blocks"
+	AttributeKeyBurnedCoins   = "burned_coins"
+	AttributeKeyGraceEnd      = "grace_end_height"
+	AttributeKeySigningWindow = "signing_window"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
@@ -7,13 +7,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail           = "unjail"
+	TypeMsgSetSigningWindow = "set_signing_window"
 )
 
 // verify interface at compile time
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetSigningWindow{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -51,6 +53,42 @@ func (msg MsgUnjail) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetSigningWindow creates a new MsgSetSigningWindow instance
+//
+//nolint:interfacer
+func NewMsgSetSigningWindow(validatorAddr sdk.ValAddress, signingWindow uint64) *MsgSetSigningWindow {
+	return &MsgSetSigningWindow{
+		ValidatorAddr: validatorAddr.String(),
+		SigningWindow: signingWindow,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetSigningWindow) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetSigningWindow) Type() string { return TypeMsgSetSigningWindow }
+
+// GetSigners returns the expected signers for MsgSetSigningWindow.
+func (msg MsgSetSigningWindow) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetSigningWindow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does a sanity check on the provided message.
+func (msg MsgSetSigningWindow) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+	return nil
+}
+
 // GetSignBytes implements the LegacyMsg interface.
 func (msg MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
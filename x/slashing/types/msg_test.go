@@ -18,3 +18,14 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgSetSigningWindowGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("abcd")
+	msg := NewMsgSetSigningWindow(sdk.ValAddress(addr), 50)
+	bytes := msg.GetSignBytes()
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/MsgSetSigningWindow","value":{"signing_window":"50","validator_addr":"cosmosvaloper1v93xxeqhg9nn6"}}`,
+		string(bytes),
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,6 +14,8 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultUnjailGraceWindow    = uint64(0)
+	DefaultMaxEvidenceAge       = time.Duration(0)
 )
 
 var (
@@ -24,7 +27,8 @@ var (
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, unjailGraceWindow uint64,
+	maxEvidenceAge time.Duration,
 ) Params {
 	return Params{
 		SignedBlocksWindow:      signedBlocksWindow,
@@ -32,6 +36,8 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		UnjailGraceWindow:       unjailGraceWindow,
+		MaxEvidenceAge:          maxEvidenceAge,
 	}
 }
 
@@ -43,6 +49,8 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultUnjailGraceWindow,
+		DefaultMaxEvidenceAge,
 	)
 }
 
@@ -63,6 +71,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateUnjailGraceWindow(p.UnjailGraceWindow, p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMaxEvidenceAge(p.MaxEvidenceAge); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -148,3 +162,46 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+// validateUnjailGraceWindow validates the unjail grace window against the
+// signed blocks window: a longer grace window would let a validator miss every
+// block of a full signed blocks window without being jailed. This also keeps
+// the grace window from overflowing when added to the block height.
+func validateUnjailGraceWindow(window uint64, signedBlocksWindow int64) error {
+	if window > uint64(signedBlocksWindow) {
+		return fmt.Errorf("unjail grace window %d exceeds the signed blocks window %d", window, signedBlocksWindow)
+	}
+
+	return nil
+}
+
+func validateMaxEvidenceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max evidence age cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// ValidateMaxEvidenceAge validates the max evidence age against the evidence
+// consensus params: evidence older than their max age duration is dropped by
+// CometBFT, so a longer max evidence age would have no effect.
+func (p Params) ValidateMaxEvidenceAge(evidenceParams *tmproto.EvidenceParams) error {
+	if evidenceParams == nil || p.MaxEvidenceAge == 0 {
+		return nil
+	}
+
+	if p.MaxEvidenceAge > evidenceParams.MaxAgeDuration {
+		return fmt.Errorf(
+			"max evidence age %s exceeds the consensus evidence max age duration %s (max age num blocks %d)",
+			p.MaxEvidenceAge, evidenceParams.MaxAgeDuration, evidenceParams.MaxAgeNumBlocks,
+		)
+	}
+
+	return nil
+}
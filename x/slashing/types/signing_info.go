@@ -38,6 +38,15 @@ func (i ValidatorSigningInfo) String() string {
 		i.Tombstoned, i.MissedBlocksCounter)
 }
 
+// SigningWindow returns the signing window of the validator, its own signing
+// window if set and shorter than the given signed blocks window param.
+func (i ValidatorSigningInfo) SigningWindow(signedBlocksWindow int64) int64 {
+	if i.ValidatorSigningWindow > 0 && i.ValidatorSigningWindow < uint64(signedBlocksWindow) {
+		return int64(i.ValidatorSigningWindow)
+	}
+	return signedBlocksWindow
+}
+
 // unmarshal a validator signing info from a store value
 // UnmarshalValSigningInfo unmarshals a validator signing info from a store value
 func UnmarshalValSigningInfo(cdc codec.Codec, value []byte) (signingInfo ValidatorSigningInfo, err error) {
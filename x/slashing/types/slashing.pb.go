@@ -49,6 +49,12 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Height until which the missed blocks of the validator are not counted, as
+	// it was unjailed less than `UnjailGraceWindow` blocks ago.
+	UnjailGraceEndHeight int64 `protobuf:"varint,7,opt,name=unjail_grace_end_height,json=unjailGraceEndHeight,proto3" json:"unjail_grace_end_height,omitempty"`
+	// Signing window of the validator overriding the `SignedBlocksWindow` param
+	// if non-zero. It can only be shorter than the `SignedBlocksWindow`.
+	ValidatorSigningWindow uint64 `protobuf:"varint,8,opt,name=validator_signing_window,json=validatorSigningWindow,proto3" json:"validator_signing_window,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -125,6 +131,20 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetUnjailGraceEndHeight() int64 {
+	if m != nil {
+		return m.UnjailGraceEndHeight
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetValidatorSigningWindow() uint64 {
+	if m != nil {
+		return m.ValidatorSigningWindow
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -132,6 +152,12 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// unjail_grace_window is the number of blocks after an unjail during which
+	// the missed blocks of the validator are not counted.
+	UnjailGraceWindow uint64 `protobuf:"varint,6,opt,name=unjail_grace_window,json=unjailGraceWindow,proto3" json:"unjail_grace_window,omitempty"`
+	// max_evidence_age is the maximum age of the double-sign evidence handled,
+	// older evidence being rejected. Zero means no limit.
+	MaxEvidenceAge time.Duration `protobuf:"bytes,7,opt,name=max_evidence_age,json=maxEvidenceAge,proto3,stdduration" json:"max_evidence_age"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,6 +207,20 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetUnjailGraceWindow() uint64 {
+	if m != nil {
+		return m.UnjailGraceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEvidenceAge() time.Duration {
+	if m != nil {
+		return m.MaxEvidenceAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -191,46 +231,52 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x18, 0xce, 0x01, 0x3f, 0x7e, 0xf4, 0x42, 0x87, 0x5e, 0x43, 0x31, 0x51, 0x65, 0x07, 0x06, 0x14,
-	0x21, 0x61, 0x97, 0xb0, 0xb1, 0x35, 0x45, 0x55, 0xff, 0x49, 0x45, 0xa1, 0x7f, 0xa4, 0x0e, 0xb5,
-	0xce, 0xbe, 0xb3, 0x73, 0xc5, 0xbe, 0x8b, 0x7c, 0xe7, 0x42, 0xf7, 0x4e, 0x9d, 0x18, 0x19, 0x19,
-	0x19, 0x19, 0x3a, 0xf6, 0x03, 0x30, 0xa2, 0x4e, 0x55, 0x07, 0x5a, 0x85, 0x81, 0x7e, 0x8c, 0xca,
-	0x77, 0x76, 0x88, 0x40, 0xea, 0xc0, 0x92, 0xd8, 0xcf, 0xf3, 0xbc, 0xcf, 0x7b, 0xcf, 0xfb, 0x9e,
-	0xe1, 0x72, 0x28, 0x64, 0x2a, 0xa4, 0x27, 0x13, 0x2c, 0xfb, 0x8c, 0xc7, 0xde, 0xc7, 0xb5, 0x80,
-	0x2a, 0xbc, 0x36, 0x02, 0xdc, 0x41, 0x26, 0x94, 0x40, 0xf3, 0x46, 0xe7, 0x8e, 0xe0, 0x52, 0xd7,
-	0x6c, 0xc4, 0x22, 0x16, 0x5a, 0xe3, 0x15, 0x4f, 0x46, 0xde, 0xb4, 0x63, 0x21, 0xe2, 0x84, 0x7a,
-	0xfa, 0x2d, 0xc8, 0x23, 0x8f, 0xe4, 0x19, 0x56, 0x4c, 0xf0, 0x92, 0x77, 0xae, 0xf2, 0x8a, 0xa5,
-	0x54, 0x2a, 0x9c, 0x0e, 0x4a, 0xc1, 0x82, 0xe9, 0xe7, 0x1b, 0xe7, 0xb2, 0xb9, 0xa1, 0xee, 0xe0,
-	0x94, 0x71, 0xe1, 0xe9, 0x5f, 0x03, 0x2d, 0x7d, 0x9b, 0x80, 0x8d, 0x37, 0x38, 0x61, 0x04, 0x2b,
-	0x91, 0x6d, 0xb3, 0x98, 0x33, 0x1e, 0x3f, 0xe5, 0x91, 0x40, 0x1d, 0xf8, 0x3f, 0x26, 0x24, 0xa3,
-	0x52, 0x5a, 0xa0, 0x05, 0xda, 0xb7, 0xba, 0xd6, 0xf7, 0xaf, 0xab, 0x8d, 0xd2, 0xee, 0xa1, 0x61,
-	0xb6, 0x55, 0xc6, 0x78, 0xdc, 0xab, 0x84, 0x68, 0x11, 0xce, 0x4a, 0x85, 0x33, 0xe5, 0xf7, 0x29,
-	0x8b, 0xfb, 0xca, 0x9a, 0x68, 0x81, 0xf6, 0x64, 0xaf, 0xae, 0xb1, 0x27, 0x1a, 0x2a, 0x24, 0x8c,
-	0x13, 0xba, 0xe7, 0x8b, 0x28, 0x92, 0x54, 0x59, 0x93, 0x46, 0xa2, 0xb1, 0x97, 0x1a, 0x42, 0x2f,
-	0xe0, 0xec, 0x07, 0xcc, 0x12, 0x4a, 0xfc, 0x9c, 0x2b, 0x96, 0x58, 0x53, 0x2d, 0xd0, 0xae, 0x77,
-	0x9a, 0xae, 0x09, 0xee, 0x56, 0xc1, 0xdd, 0x57, 0x55, 0xf0, 0xee, 0xed, 0x93, 0x33, 0xa7, 0xb6,
-	0xff, 0xcb, 0x01, 0x47, 0x17, 0xc7, 0x2b, 0xa0, 0x57, 0x37, 0xe5, 0xaf, 0x8b, 0x6a, 0x64, 0x43,
-	0xa8, 0x44, 0x1a, 0x48, 0x25, 0x38, 0x25, 0xd6, 0x7f, 0x2d, 0xd0, 0x9e, 0xe9, 0x8d, 0x21, 0xa8,
-	0x03, 0xe7, 0x52, 0x26, 0x25, 0x25, 0x7e, 0x90, 0x88, 0x70, 0x47, 0xfa, 0xa1, 0xc8, 0xb9, 0xa2,
-	0x99, 0x35, 0xad, 0x4f, 0x76, 0xd7, 0x90, 0x5d, 0xcd, 0x3d, 0x32, 0xd4, 0xc6, 0xcc, 0xc1, 0xa1,
-	0x53, 0xfb, 0x73, 0xe8, 0x80, 0xa5, 0xcf, 0x53, 0x70, 0x7a, 0x0b, 0x67, 0x38, 0x95, 0xe8, 0x01,
-	0x6c, 0x48, 0x16, 0xf3, 0x4b, 0xa3, 0x5d, 0xc6, 0x89, 0xd8, 0xd5, 0xd3, 0x9b, 0xec, 0x21, 0xc3,
-	0x19, 0x9f, 0xb7, 0x9a, 0x41, 0x51, 0xd1, 0x9a, 0xfb, 0x65, 0xd5, 0x80, 0x66, 0x55, 0x49, 0x31,
-	0xb7, 0xd9, 0xee, 0x7a, 0x91, 0xea, 0xe7, 0x99, 0xb3, 0x1c, 0x33, 0xd5, 0xcf, 0x03, 0x37, 0x14,
-	0x69, 0xb9, 0xce, 0xf2, 0x6f, 0x55, 0x92, 0x1d, 0x4f, 0x7d, 0x1a, 0x50, 0xe9, 0x6e, 0xd2, 0xd0,
-	0x64, 0x47, 0x29, 0xe3, 0xdb, 0xda, 0x70, 0x8b, 0x66, 0x65, 0x9f, 0xf7, 0xf0, 0x1e, 0x11, 0xbb,
-	0xbc, 0xb8, 0x28, 0x7e, 0x31, 0x1a, 0xbf, 0xba, 0x52, 0x7a, 0xfa, 0xf5, 0xce, 0xc2, 0xb5, 0xd1,
-	0x6e, 0x96, 0x02, 0x33, 0xd9, 0x83, 0xd1, 0x64, 0x1b, 0x95, 0xcf, 0x33, 0xcc, 0x92, 0x4a, 0x84,
-	0x06, 0xb0, 0xa9, 0x2f, 0xb7, 0x1f, 0x65, 0x38, 0x2c, 0x10, 0x9f, 0x88, 0x3c, 0x48, 0xa8, 0x4e,
-	0xa6, 0xd7, 0x77, 0xc3, 0x30, 0xf3, 0xda, 0xf6, 0x71, 0xe9, 0xba, 0xa9, 0x4d, 0x8b, 0x70, 0x68,
-	0x07, 0xce, 0x5f, 0xeb, 0x68, 0x0e, 0xa6, 0x37, 0x7c, 0xc3, 0x76, 0x73, 0x57, 0xda, 0x19, 0xc7,
-	0x8d, 0xc5, 0x2f, 0x17, 0xc7, 0x2b, 0xf7, 0xc7, 0xca, 0xf6, 0x2e, 0xbf, 0x79, 0xb3, 0xfb, 0xee,
-	0xf3, 0xa3, 0xa1, 0x0d, 0x4e, 0x86, 0x36, 0x38, 0x1d, 0xda, 0xe0, 0xf7, 0xd0, 0x06, 0xfb, 0xe7,
-	0x76, 0xed, 0xf4, 0xdc, 0xae, 0xfd, 0x38, 0xb7, 0x6b, 0xef, 0x56, 0xff, 0x79, 0x88, 0x31, 0x37,
-	0x7d, 0x9e, 0x60, 0x5a, 0xaf, 0x61, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x46, 0x11,
-	0xae, 0x61, 0x04, 0x00, 0x00,
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf5, 0x3e, 0xc0, 0xf0, 0xc6, 0xbc, 0xa7, 0xb0, 0x18, 0xbc, 0x58, 0xd1, 0xda, 0x50, 0x20,
+	0x0b, 0x89, 0x75, 0x30, 0x8a, 0x14, 0xd1, 0xe1, 0x40, 0xbe, 0xa5, 0x20, 0x93, 0x0f, 0x29, 0x45,
+	0x46, 0xe3, 0x9d, 0xf1, 0x7a, 0xc2, 0xee, 0x8c, 0xb5, 0x33, 0x36, 0xce, 0x3f, 0x88, 0x52, 0x51,
+	0x52, 0x52, 0xd2, 0x44, 0xa2, 0xc8, 0x8f, 0xa0, 0x44, 0xa9, 0xa2, 0x14, 0x24, 0x32, 0x05, 0xf9,
+	0x19, 0xd1, 0xce, 0xcc, 0x82, 0x03, 0x52, 0xa4, 0xd0, 0xf8, 0xe3, 0x9e, 0x73, 0xcf, 0xbd, 0xf7,
+	0xdc, 0xbb, 0x0b, 0x16, 0x7d, 0x2e, 0x22, 0x2e, 0xaa, 0x22, 0x44, 0xa2, 0x4d, 0x59, 0x50, 0xed,
+	0xad, 0x34, 0x89, 0x44, 0x2b, 0x17, 0x01, 0xaf, 0x13, 0x73, 0xc9, 0xed, 0x82, 0xe6, 0x79, 0x17,
+	0x61, 0xc3, 0x2b, 0xe6, 0x03, 0x1e, 0x70, 0xc5, 0xa9, 0x26, 0xbf, 0x34, 0xbd, 0xe8, 0x06, 0x9c,
+	0x07, 0x21, 0xa9, 0xaa, 0x7f, 0xcd, 0x6e, 0xab, 0x8a, 0xbb, 0x31, 0x92, 0x94, 0x33, 0x83, 0x97,
+	0xae, 0xe2, 0x92, 0x46, 0x44, 0x48, 0x14, 0x75, 0x0c, 0x61, 0x4e, 0xd7, 0x83, 0x5a, 0xd9, 0x14,
+	0xd7, 0xd0, 0x14, 0x8a, 0x28, 0xe3, 0x55, 0xf5, 0xa9, 0x43, 0x0b, 0x9f, 0x46, 0x40, 0xfe, 0x15,
+	0x0a, 0x29, 0x46, 0x92, 0xc7, 0xdb, 0x34, 0x60, 0x94, 0x05, 0x8f, 0x59, 0x8b, 0xdb, 0x35, 0x30,
+	0x8e, 0x30, 0x8e, 0x89, 0x10, 0x8e, 0x55, 0xb6, 0x2a, 0xff, 0xd6, 0x9d, 0x2f, 0x9f, 0x97, 0xf3,
+	0x46, 0x6e, 0x5d, 0x23, 0xdb, 0x32, 0xa6, 0x2c, 0x68, 0xa4, 0x44, 0x7b, 0x1e, 0x4c, 0x0a, 0x89,
+	0x62, 0x09, 0xdb, 0x84, 0x06, 0x6d, 0xe9, 0xfc, 0x53, 0xb6, 0x2a, 0x23, 0x8d, 0x9c, 0x8a, 0x3d,
+	0x52, 0xa1, 0x84, 0x42, 0x19, 0x26, 0x7d, 0xc8, 0x5b, 0x2d, 0x41, 0xa4, 0x33, 0xa2, 0x29, 0x2a,
+	0xf6, 0x5c, 0x85, 0xec, 0x67, 0x60, 0xf2, 0x1d, 0xa2, 0x21, 0xc1, 0xb0, 0xcb, 0x24, 0x0d, 0x9d,
+	0xd1, 0xb2, 0x55, 0xc9, 0xd5, 0x8a, 0x9e, 0x1e, 0xdc, 0x4b, 0x07, 0xf7, 0x5e, 0xa4, 0x83, 0xd7,
+	0xff, 0x3b, 0x3e, 0x2d, 0x65, 0xf6, 0xbe, 0x97, 0xac, 0xc3, 0xf3, 0xa3, 0x25, 0xab, 0x91, 0xd3,
+	0xe9, 0x2f, 0x93, 0x6c, 0xdb, 0x05, 0x40, 0xf2, 0xa8, 0x29, 0x24, 0x67, 0x04, 0x3b, 0x63, 0x65,
+	0xab, 0x32, 0xd1, 0x18, 0x8a, 0xd8, 0x35, 0x30, 0x13, 0x51, 0x21, 0x08, 0x86, 0xcd, 0x90, 0xfb,
+	0x3b, 0x02, 0xfa, 0xbc, 0xcb, 0x24, 0x89, 0x9d, 0xac, 0xea, 0x6c, 0x5a, 0x83, 0x75, 0x85, 0xdd,
+	0xd7, 0x90, 0x7d, 0x17, 0x14, 0xba, 0x2c, 0x29, 0x02, 0x83, 0x18, 0xf9, 0x04, 0x12, 0x86, 0xd3,
+	0x91, 0xc7, 0x55, 0x56, 0x5e, 0xc3, 0x0f, 0x13, 0x74, 0x93, 0x61, 0x33, 0xfb, 0x3d, 0xe0, 0xf4,
+	0x52, 0xab, 0xa1, 0xd0, 0x5e, 0xc3, 0x5d, 0xca, 0x30, 0xdf, 0x75, 0x26, 0xca, 0x56, 0x65, 0xb4,
+	0x31, 0xdb, 0xbb, 0xb2, 0x8a, 0xd7, 0x0a, 0x5d, 0x9b, 0xd8, 0x3f, 0x28, 0x65, 0x7e, 0x1e, 0x94,
+	0xac, 0x85, 0x0f, 0x63, 0x20, 0xbb, 0x85, 0x62, 0x14, 0x09, 0xfb, 0x0e, 0xc8, 0x27, 0x22, 0x97,
+	0x9d, 0x1b, 0x29, 0x4b, 0xb5, 0x60, 0x6b, 0x4c, 0x37, 0xae, 0x65, 0xec, 0x56, 0x32, 0x2b, 0x83,
+	0x26, 0xab, 0x43, 0xe2, 0x34, 0x25, 0x59, 0xd4, 0x64, 0x7d, 0x35, 0xb1, 0xf1, 0xdb, 0x69, 0x69,
+	0x31, 0xa0, 0xb2, 0xdd, 0x6d, 0x7a, 0x3e, 0x8f, 0xcc, 0xfd, 0x98, 0xaf, 0x65, 0x81, 0x77, 0xaa,
+	0xf2, 0x7d, 0x87, 0x08, 0x6f, 0x83, 0xf8, 0xda, 0x6c, 0x3b, 0xa2, 0x6c, 0x5b, 0x09, 0x6e, 0x91,
+	0xd8, 0xd4, 0x79, 0x0b, 0x66, 0x31, 0xdf, 0x65, 0xc9, 0x65, 0x42, 0x65, 0x53, 0x7a, 0xc3, 0x6a,
+	0xdd, 0xb9, 0xda, 0xdc, 0xb5, 0x5d, 0x6e, 0x18, 0x82, 0x5e, 0xe5, 0xfe, 0xc5, 0x2a, 0xf3, 0xa9,
+	0xce, 0x13, 0x44, 0xc3, 0x94, 0x64, 0x77, 0x40, 0x51, 0x3d, 0x4d, 0xb0, 0x15, 0x23, 0x3f, 0x89,
+	0x40, 0xcc, 0xbb, 0xcd, 0x90, 0xa8, 0xc9, 0x9c, 0xd1, 0x9b, 0x0f, 0x53, 0x50, 0xb2, 0x0f, 0x8c,
+	0xea, 0x86, 0x12, 0x4d, 0x86, 0xb3, 0x77, 0x40, 0xe1, 0x5a, 0x45, 0xdd, 0x98, 0x33, 0x76, 0xf3,
+	0x72, 0x33, 0x57, 0xca, 0x69, 0x45, 0xdb, 0x03, 0xd3, 0xbf, 0x9d, 0x97, 0x59, 0x52, 0x56, 0x9d,
+	0xc8, 0xd4, 0xd0, 0x69, 0x19, 0xbb, 0x1b, 0xe0, 0x56, 0x84, 0xfa, 0x90, 0xf4, 0x28, 0x26, 0xcc,
+	0x27, 0x10, 0x05, 0xc4, 0x19, 0xff, 0x4b, 0xa3, 0xff, 0x8f, 0x50, 0x7f, 0xd3, 0x08, 0xac, 0x07,
+	0x64, 0x6d, 0xfe, 0xe3, 0xf9, 0xd1, 0xd2, 0xed, 0xa1, 0xd6, 0xfb, 0x97, 0x2f, 0x3a, 0x7d, 0x7f,
+	0xf5, 0xa7, 0x87, 0x03, 0xd7, 0x3a, 0x1e, 0xb8, 0xd6, 0xc9, 0xc0, 0xb5, 0x7e, 0x0c, 0x5c, 0x6b,
+	0xef, 0xcc, 0xcd, 0x9c, 0x9c, 0xb9, 0x99, 0xaf, 0x67, 0x6e, 0xe6, 0xcd, 0xf2, 0x1f, 0x8d, 0x18,
+	0x52, 0x53, 0x9e, 0x34, 0xb3, 0xaa, 0xc3, 0xd5, 0x5f, 0x03, 0x00, 0xe7, 0xfe, 0xb4, 0xbe, 0x56,
+	0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -270,6 +316,12 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.UnjailGraceEndHeight != that1.UnjailGraceEndHeight {
+		return false
+	}
+	if this.ValidatorSigningWindow != that1.ValidatorSigningWindow {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -306,6 +358,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.UnjailGraceWindow != that1.UnjailGraceWindow {
+		return false
+	}
+	if this.MaxEvidenceAge != that1.MaxEvidenceAge {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -328,6 +386,16 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorSigningWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorSigningWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UnjailGraceEndHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UnjailGraceEndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -391,6 +459,19 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxEvidenceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxEvidenceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.UnjailGraceWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UnjailGraceWindow))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -411,12 +492,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -472,6 +553,12 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.UnjailGraceEndHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.UnjailGraceEndHeight))
+	}
+	if m.ValidatorSigningWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorSigningWindow))
+	}
 	return n
 }
 
@@ -492,6 +579,11 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.UnjailGraceWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.UnjailGraceWindow))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxEvidenceAge)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -672,6 +764,44 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailGraceEndHeight", wireType)
+			}
+			m.UnjailGraceEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailGraceEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSigningWindow", wireType)
+			}
+			m.ValidatorSigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -873,6 +1003,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailGraceWindow", wireType)
+			}
+			m.UnjailGraceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailGraceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvidenceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxEvidenceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
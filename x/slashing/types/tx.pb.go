@@ -205,46 +205,145 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetSigningWindow defines the Msg/SetSigningWindow request type
+type MsgSetSigningWindow struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// signing_window is the signing window of the validator. It must not exceed
+	// the `SignedBlocksWindow` param, zero reverting to it.
+	SigningWindow uint64 `protobuf:"varint,2,opt,name=signing_window,json=signingWindow,proto3" json:"signing_window,omitempty"`
+}
+
+func (m *MsgSetSigningWindow) Reset()         { *m = MsgSetSigningWindow{} }
+func (m *MsgSetSigningWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSigningWindow) ProtoMessage()    {}
+func (*MsgSetSigningWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgSetSigningWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSigningWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSigningWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSigningWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSigningWindow.Merge(m, src)
+}
+func (m *MsgSetSigningWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSigningWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSigningWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSigningWindow proto.InternalMessageInfo
+
+func (m *MsgSetSigningWindow) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *MsgSetSigningWindow) GetSigningWindow() uint64 {
+	if m != nil {
+		return m.SigningWindow
+	}
+	return 0
+}
+
+// MsgSetSigningWindowResponse defines the Msg/SetSigningWindow response type
+type MsgSetSigningWindowResponse struct {
+}
+
+func (m *MsgSetSigningWindowResponse) Reset()         { *m = MsgSetSigningWindowResponse{} }
+func (m *MsgSetSigningWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSigningWindowResponse) ProtoMessage()    {}
+func (*MsgSetSigningWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgSetSigningWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSigningWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSigningWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSigningWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSigningWindowResponse.Merge(m, src)
+}
+func (m *MsgSetSigningWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSigningWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSigningWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSigningWindowResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetSigningWindow)(nil), "cosmos.slashing.v1beta1.MsgSetSigningWindow")
+	proto.RegisterType((*MsgSetSigningWindowResponse)(nil), "cosmos.slashing.v1beta1.MsgSetSigningWindowResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x3c, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x68, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0x93, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x45,
-	0xa0, 0xd6, 0x39, 0x42, 0x44, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d, 0xdf, 0xa0, 0x05,
-	0x53, 0xb3, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0x5e, 0xb8, 0x59, 0x20, 0x85, 0x56, 0x26, 0x1d,
-	0x0b, 0xe4, 0x19, 0x66, 0x2c, 0x90, 0x67, 0x6c, 0x7a, 0xbe, 0x41, 0x0b, 0xcd, 0x9e, 0xae, 0xe7,
-	0x1b, 0xb4, 0xa0, 0xa6, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0xc3, 0x9d, 0xa4, 0x24, 0xcc, 0x25, 0x08,
-	0xe7, 0x04, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x2a, 0x1d, 0x67, 0xe4, 0xe2, 0x07, 0x89,
-	0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x99, 0x71, 0x71, 0x26,
-	0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x54, 0x42, 0x9d, 0x2d, 0x81, 0xcb, 0xad, 0x41, 0x08,
-	0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0xe4,
-	0xf5, 0x70, 0x44, 0x98, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x20, 0x3e, 0x84,
-	0xea, 0xb4, 0xb2, 0x00, 0x79, 0x09, 0x61, 0x26, 0xc8, 0x37, 0xaa, 0x48, 0xbe, 0xa9, 0x40, 0xc4,
-	0x26, 0x9a, 0xab, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x60, 0x9e, 0x34, 0xba, 0xc4, 0xc8, 0xc5,
-	0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc1, 0xc5, 0x06, 0x8d, 0x1e, 0x25, 0x9c, 0x4e, 0x83, 0x07, 0x91,
-	0x94, 0x16, 0x61, 0x35, 0x30, 0x1b, 0x84, 0xb2, 0xb8, 0x78, 0x50, 0x82, 0x50, 0x03, 0xaf, 0x5e,
-	0x24, 0x95, 0x52, 0x06, 0xc4, 0xaa, 0x84, 0xd9, 0x25, 0xc5, 0xda, 0x00, 0x0a, 0x31, 0x27, 0xef,
-	0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x9a, 0xa4, 0xf5, 0xb1, 0x07, 0x63,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x0d, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x5d, 0xa0, 0x38, 0x85, 0x03, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0x54, 0x8d, 0x64, 0xb4, 0xd5, 0x6e, 0x0b, 0x4d, 0x57, 0xdc, 0x84, 0x85, 0x4a, 0x58,
+	0xcc, 0xae, 0xad, 0xa1, 0x48, 0x2e, 0x62, 0xae, 0x12, 0x90, 0x04, 0x51, 0xf4, 0x10, 0x26, 0xdd,
+	0x65, 0x32, 0x35, 0xbb, 0xb3, 0xec, 0x4c, 0xd3, 0xf6, 0x26, 0x9e, 0xc4, 0x93, 0x47, 0x4f, 0x52,
+	0x3c, 0xf5, 0x98, 0x83, 0xfe, 0x06, 0x8b, 0xa7, 0xe2, 0xc9, 0x53, 0x91, 0xe4, 0x10, 0xf0, 0x57,
+	0xc8, 0xee, 0xcc, 0x6e, 0x92, 0xb5, 0x5b, 0xf5, 0x92, 0x64, 0xbe, 0x79, 0xdf, 0x7b, 0xdf, 0xcb,
+	0xfb, 0x18, 0x58, 0xde, 0xa1, 0xcc, 0xa5, 0xcc, 0x62, 0x7d, 0xc4, 0x7a, 0xc4, 0xc3, 0xd6, 0x60,
+	0xb3, 0xeb, 0x70, 0xb4, 0x69, 0xf1, 0x03, 0xd3, 0x0f, 0x28, 0xa7, 0xca, 0x9a, 0x40, 0x98, 0x31,
+	0xc2, 0x94, 0x08, 0x75, 0x15, 0x53, 0x4c, 0x23, 0x8c, 0x15, 0xfe, 0x12, 0x70, 0xf5, 0x4e, 0x16,
+	0x61, 0xd2, 0x2f, 0x70, 0xeb, 0x02, 0xd7, 0x11, 0x04, 0x52, 0x43, 0x5c, 0x49, 0x45, 0xcb, 0x65,
+	0x61, 0x77, 0xf8, 0x25, 0x2f, 0x96, 0x91, 0x4b, 0x3c, 0x6a, 0x45, 0x9f, 0xa2, 0xa4, 0x7f, 0x04,
+	0xb0, 0xd0, 0x64, 0xf8, 0xa9, 0xb7, 0x8b, 0x48, 0x5f, 0x79, 0x09, 0x97, 0x06, 0xa8, 0x4f, 0x6c,
+	0xc4, 0x69, 0xd0, 0x41, 0xb6, 0x1d, 0x14, 0x41, 0x19, 0x54, 0x0a, 0x8d, 0xda, 0xaf, 0xb3, 0xd2,
+	0xd5, 0xf0, 0xec, 0x30, 0xf6, 0xfd, 0x73, 0x75, 0x55, 0xca, 0x3d, 0x12, 0x95, 0x36, 0x0f, 0x88,
+	0x87, 0x3f, 0x4d, 0x86, 0x46, 0x8c, 0x39, 0x9e, 0x0c, 0x0d, 0xd0, 0x5a, 0x4c, 0xb8, 0x42, 0x60,
+	0xbd, 0xf6, 0xf6, 0xa8, 0x94, 0xfb, 0x70, 0x54, 0x02, 0x6f, 0x26, 0x43, 0x23, 0xa5, 0xf3, 0x6e,
+	0x32, 0x34, 0x24, 0x6b, 0x95, 0xd9, 0xaf, 0xac, 0x64, 0x24, 0x7d, 0x05, 0x2e, 0x27, 0x87, 0x96,
+	0xc3, 0x7c, 0xea, 0x31, 0x47, 0xff, 0x0a, 0xe0, 0x8d, 0xb0, 0xea, 0xdb, 0x88, 0x3b, 0x4f, 0x50,
+	0x80, 0x5c, 0xa6, 0x6c, 0xc3, 0x02, 0xda, 0xe3, 0x3d, 0x1a, 0x10, 0x7e, 0x28, 0xc7, 0x2e, 0x66,
+	0xcd, 0xda, 0x9a, 0x42, 0x95, 0x06, 0xcc, 0xfb, 0x11, 0x43, 0x71, 0xa1, 0x0c, 0x2a, 0xd7, 0xb6,
+	0x4a, 0x66, 0x46, 0x60, 0xa6, 0x10, 0x6a, 0x14, 0x4e, 0xce, 0x4a, 0x39, 0xe1, 0x50, 0x76, 0xd6,
+	0x1f, 0x84, 0x96, 0xa6, 0x9c, 0xa1, 0x9b, 0x8d, 0x19, 0x37, 0x07, 0xd3, 0x34, 0x53, 0x53, 0xeb,
+	0xeb, 0x70, 0x2d, 0x55, 0x4a, 0x4c, 0x7e, 0x01, 0x70, 0xa5, 0xc9, 0x70, 0xdb, 0xe1, 0x6d, 0x82,
+	0x3d, 0xe2, 0xe1, 0x67, 0xc4, 0xb3, 0xe9, 0xbe, 0xf2, 0x30, 0x23, 0xa4, 0x6c, 0xb7, 0xf3, 0x41,
+	0x28, 0x1b, 0x70, 0x89, 0x09, 0xc6, 0xce, 0x7e, 0x44, 0x19, 0x39, 0xbf, 0xdc, 0x5a, 0x64, 0xb3,
+	0x3a, 0xf5, 0xed, 0x8c, 0x9c, 0xb4, 0xf9, 0x9c, 0xd2, 0xf3, 0xe9, 0xb7, 0xe1, 0xad, 0x73, 0xca,
+	0xb1, 0xad, 0xad, 0x6f, 0x0b, 0xf0, 0x52, 0x93, 0x61, 0xe5, 0x39, 0xcc, 0xcb, 0xad, 0xd3, 0x33,
+	0xff, 0xf1, 0x24, 0x79, 0xd5, 0xf8, 0x3b, 0x26, 0x56, 0x50, 0x76, 0xe1, 0xf5, 0xb9, 0xcd, 0xa8,
+	0x5c, 0xd8, 0x3b, 0x83, 0x54, 0xef, 0xfd, 0x2b, 0x32, 0xd1, 0x1a, 0xc0, 0x9b, 0x7f, 0x04, 0x74,
+	0xf7, 0x22, 0x96, 0x34, 0x5a, 0xad, 0xfd, 0x0f, 0x3a, 0xd6, 0x55, 0xaf, 0xbc, 0x0e, 0x17, 0xb0,
+	0xf1, 0xf8, 0x78, 0xa4, 0x81, 0x93, 0x91, 0x06, 0x4e, 0x47, 0x1a, 0xf8, 0x39, 0xd2, 0xc0, 0xfb,
+	0xb1, 0x96, 0x3b, 0x1d, 0x6b, 0xb9, 0x1f, 0x63, 0x2d, 0xf7, 0xa2, 0x8a, 0x09, 0xef, 0xed, 0x75,
+	0xcd, 0x1d, 0xea, 0xca, 0x17, 0xc2, 0x3a, 0x7f, 0x2b, 0xf9, 0xa1, 0xef, 0xb0, 0x6e, 0x3e, 0x7a,
+	0x12, 0xee, 0xff, 0x1e, 0x00, 0xdc, 0xcf, 0x33, 0x7c, 0xd4, 0x04, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -340,6 +439,54 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetSigningWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetSigningWindow)
+	if !ok {
+		that2, ok := that.(MsgSetSigningWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if this.SigningWindow != that1.SigningWindow {
+		return false
+	}
+	return true
+}
+func (this *MsgSetSigningWindowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetSigningWindowResponse)
+	if !ok {
+		that2, ok := that.(MsgSetSigningWindowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -362,6 +509,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetSigningWindow defines a method for a validator to set its own signing
+	// window, shorter than the `SignedBlocksWindow` param.
+	SetSigningWindow(ctx context.Context, in *MsgSetSigningWindow, opts ...grpc.CallOption) (*MsgSetSigningWindowResponse, error)
 }
 
 type msgClient struct {
@@ -390,6 +540,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetSigningWindow(ctx context.Context, in *MsgSetSigningWindow, opts ...grpc.CallOption) (*MsgSetSigningWindowResponse, error) {
+	out := new(MsgSetSigningWindowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/SetSigningWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -401,6 +560,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetSigningWindow defines a method for a validator to set its own signing
+	// window, shorter than the `SignedBlocksWindow` param.
+	SetSigningWindow(context.Context, *MsgSetSigningWindow) (*MsgSetSigningWindowResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -413,6 +575,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetSigningWindow(ctx context.Context, req *MsgSetSigningWindow) (*MsgSetSigningWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSigningWindow not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -454,6 +619,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSigningWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSigningWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSigningWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/SetSigningWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSigningWindow(ctx, req.(*MsgSetSigningWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -466,6 +649,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetSigningWindow",
+			Handler:    _Msg_SetSigningWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -587,6 +774,64 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSigningWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSigningWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSigningWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigningWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SigningWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSigningWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSigningWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSigningWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -644,6 +889,31 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSigningWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SigningWindow != 0 {
+		n += 1 + sovTx(uint64(m.SigningWindow))
+	}
+	return n
+}
+
+func (m *MsgSetSigningWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -947,6 +1217,157 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSigningWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSigningWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSigningWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningWindow", wireType)
+			}
+			m.SigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSigningWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSigningWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSigningWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
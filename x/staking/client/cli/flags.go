@@ -27,6 +27,7 @@ const (
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
 	FlagMinSelfDelegation = "min-self-delegation"
+	FlagMaxDelegations    = "max-delegations"
 
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
@@ -67,6 +68,13 @@ func FlagSetMinSelfDelegation() *flag.FlagSet {
 	return fs
 }
 
+// FlagSetMaxDelegations Returns the FlagSet used for maximum delegations.
+func FlagSetMaxDelegations() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMaxDelegations, "", "The maximum number of delegations accepted by the validator, 0 for the absolute max delegations param")
+	return fs
+}
+
 // FlagSetAmount Returns the FlagSet for amount related operations.
 func FlagSetAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
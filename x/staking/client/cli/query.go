@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryValidatorCredential(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
@@ -88,6 +89,51 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorCredential implements the validator credential query
+// command.
+func GetCmdQueryValidatorCredential() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-credential [validator-addr]",
+		Short: "Query the attested identity credential of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the attested identity credential of an individual validator.
+
+Example:
+$ %s query staking validator-credential %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorCredentialRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorCredential(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Credential)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators() *cobra.Command {
 	cmd := &cobra.Command{
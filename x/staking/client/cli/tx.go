@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strconv"
@@ -48,6 +49,7 @@ func NewTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingDelegation(),
+		NewAttestValidatorIdentityCmd(),
 	)
 
 	return stakingTxCmd
@@ -83,6 +85,7 @@ func NewCreateValidatorCmd() *cobra.Command {
 	cmd.Flags().AddFlagSet(flagSetDescriptionCreate())
 	cmd.Flags().AddFlagSet(FlagSetCommissionCreate())
 	cmd.Flags().AddFlagSet(FlagSetMinSelfDelegation())
+	cmd.Flags().AddFlagSet(FlagSetMaxDelegations())
 
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("The node's public IP. It takes effect only when used in combination with --%s", flags.FlagGenerateOnly))
 	cmd.Flags().String(FlagNodeID, "", "The node's ID")
@@ -138,7 +141,19 @@ func NewEditValidatorCmd() *cobra.Command {
 				newMinSelfDelegation = &msb
 			}
 
-			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate, newMinSelfDelegation)
+			var newMaxDelegations *math.Int
+
+			maxDelegationsString, _ := cmd.Flags().GetString(FlagMaxDelegations)
+			if maxDelegationsString != "" {
+				md, ok := sdk.NewIntFromString(maxDelegationsString)
+				if !ok {
+					return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max delegations must be a non-negative integer")
+				}
+
+				newMaxDelegations = &md
+			}
+
+			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate, newMinSelfDelegation, newMaxDelegations)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -147,6 +162,7 @@ func NewEditValidatorCmd() *cobra.Command {
 	cmd.Flags().AddFlagSet(flagSetDescriptionEdit())
 	cmd.Flags().AddFlagSet(flagSetCommissionUpdate())
 	cmd.Flags().AddFlagSet(FlagSetMinSelfDelegation())
+	cmd.Flags().AddFlagSet(FlagSetMaxDelegations())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -340,6 +356,46 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 	return cmd
 }
 
+// NewAttestValidatorIdentityCmd returns a CLI command handler for creating a
+// MsgAttestValidatorIdentity transaction.
+func NewAttestValidatorIdentityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-identity [credential-hash]",
+		Short: "Attest the verifiable credential of the identity of your validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attest the verifiable credential of the identity of your validator, given the
+hex-encoded SHA-256 digest of its JSON-LD document.
+
+Example:
+$ %s tx staking attest-identity $(sha256sum credential.jsonld | cut -d' ' -f1) --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+
+			credentialHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid credential hash: %w", err)
+			}
+
+			msg := types.NewMsgAttestValidatorIdentity(sdk.ValAddress(valAddr), credentialHash)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, *types.MsgCreateValidator, error) {
 	fAmount, _ := fs.GetString(FlagAmount)
 	amount, err := sdk.ParseCoinNormalized(fAmount)
@@ -395,6 +451,15 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	if err != nil {
 		return txf, nil, err
 	}
+
+	// get the maximum number of delegations accepted by the validator
+	if mdStr, _ := fs.GetString(FlagMaxDelegations); mdStr != "" {
+		msg.MaxDelegations, err = strconv.ParseUint(mdStr, 10, 64)
+		if err != nil {
+			return txf, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max delegations must be a non-negative integer")
+		}
+	}
+
 	if err := msg.ValidateBasic(); err != nil {
 		return txf, nil, err
 	}
@@ -45,9 +45,38 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateValidatorCredentials(data.ValidatorCredentials, data.Validators); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateValidatorCredentials(credentials []types.ValidatorCredential, validators []types.Validator) error {
+	valAddrs := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		valAddrs[val.OperatorAddress] = true
+	}
+
+	attested := make(map[string]bool, len(credentials))
+	for _, credential := range credentials {
+		if err := credential.Validate(); err != nil {
+			return err
+		}
+
+		if !valAddrs[credential.ValidatorAddress] {
+			return fmt.Errorf("credential of unknown validator in genesis state: %s", credential.ValidatorAddress)
+		}
+
+		if attested[credential.ValidatorAddress] {
+			return fmt.Errorf("duplicate credential in genesis state: validator %s", credential.ValidatorAddress)
+		}
+		attested[credential.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -19,6 +19,8 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0] = testutil.NewValidator(t, sdk.ValAddress(pk.Address()), pk)
 	genValidators1[0].Tokens = math.OneInt()
 	genValidators1[0].DelegatorShares = math.LegacyOneDec()
+	genValidator := genValidators1[0]
+	credential := types.NewValidatorCredential(genValidator.GetOperator(), make([]byte, 32), make([]byte, 32))
 
 	tests := []struct {
 		name    string
@@ -26,6 +28,24 @@ func TestValidateGenesis(t *testing.T) {
 		wantErr bool
 	}{
 		{"default", func(*types.GenesisState) {}, false},
+		// validate genesis validator credentials
+		{"validator credential", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.ValidatorCredentials = []types.ValidatorCredential{credential}
+		}, false},
+		{"credential of unknown validator", func(data *types.GenesisState) {
+			data.ValidatorCredentials = []types.ValidatorCredential{credential}
+		}, true},
+		{"duplicate validator credential", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.ValidatorCredentials = []types.ValidatorCredential{credential, credential}
+		}, true},
+		{"invalid validator credential hash", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.ValidatorCredentials = []types.ValidatorCredential{
+				types.NewValidatorCredential(genValidator.GetOperator(), make([]byte, 20), make([]byte, 32)),
+			}
+		}, true},
 		// validate genesis validators
 		{"duplicate validator", func(data *types.GenesisState) {
 			data.Validators = genValidators1
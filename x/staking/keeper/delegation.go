@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -92,10 +93,16 @@ func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddres
 // SetDelegation sets a delegation.
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+	key := types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr())
 
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		valAddr := delegation.GetValidatorAddr()
+		k.setValidatorDelegationCount(ctx, valAddr, k.GetValidatorDelegationCount(ctx, valAddr)+1)
+	}
+
 	b := types.MustMarshalDelegation(k.cdc, delegation)
-	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+	store.Set(key, b)
 }
 
 // RemoveDelegation removes a delegation
@@ -107,11 +114,55 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 		return err
 	}
 
+	key := types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr())
+
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	if store.Has(key) {
+		valAddr := delegation.GetValidatorAddr()
+		k.setValidatorDelegationCount(ctx, valAddr, k.GetValidatorDelegationCount(ctx, valAddr)-1)
+	}
+
+	store.Delete(key)
 	return nil
 }
 
+// GetValidatorDelegationCount returns the number of delegations of a validator.
+func (k Keeper) GetValidatorDelegationCount(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorDelegationCountKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setValidatorDelegationCount sets the number of delegations of a validator,
+// deleting it when there are none left.
+func (k Keeper) setValidatorDelegationCount(ctx sdk.Context, valAddr sdk.ValAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetValidatorDelegationCountKey(valAddr))
+		return
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.GetValidatorDelegationCountKey(valAddr), bz)
+}
+
+// ValidatorMaxDelegations returns the maximum number of delegations accepted by
+// a validator, bounded by the absolute max delegations param, 0 meaning
+// unlimited.
+func (k Keeper) ValidatorMaxDelegations(ctx sdk.Context, validator types.Validator) uint64 {
+	absoluteMax := k.AbsoluteMaxDelegations(ctx)
+	if absoluteMax != 0 && (validator.MaxDelegations == 0 || validator.MaxDelegations > absoluteMax) {
+		return absoluteMax
+	}
+
+	return validator.MaxDelegations
+}
+
 // GetUnbondingDelegations returns a given amount of all the delegator unbonding-delegations.
 func (k Keeper) GetUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (unbondingDelegations []types.UnbondingDelegation) {
 	unbondingDelegations = make([]types.UnbondingDelegation, maxRetrieve)
@@ -641,6 +692,12 @@ func (k Keeper) Delegate(
 	// Get or create the delegation object
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
 	if !found {
+		// existing delegations are unaffected by the capacity of the validator
+		maxDelegations := k.ValidatorMaxDelegations(ctx, validator)
+		if maxDelegations != 0 && k.GetValidatorDelegationCount(ctx, validator.GetOperator()) >= maxDelegations {
+			return math.LegacyZeroDec(), types.ErrValidatorAtCapacity
+		}
+
 		delegation = types.NewDelegation(delAddr, validator.GetOperator(), math.LegacyZeroDec())
 	}
 
@@ -895,6 +952,53 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 // record.
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (completionTime time.Time, err error) {
+	return k.beginRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, sharesAmount, false)
+}
+
+// PriorityRedelegate begins a redelegation away from a jailed or tombstoned
+// validator which is not held back by the earlier redelegations of the
+// delegator: it is not subject to the MaxEntries limit. It completes after the
+// unbonding period like any redelegation, so that the redelegated tokens can
+// still be slashed for the infractions committed before. The given reason must
+// match the state of the source validator so that the priority cannot be
+// claimed for redelegations away from validators in good standing.
+func (k Keeper) PriorityRedelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
+	reason types.PriorityReason,
+) (completionTime time.Time, err error) {
+	srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return time.Time{}, types.ErrBadRedelegationDst
+	}
+
+	switch reason {
+	case types.PriorityReasonJailed:
+		if !srcValidator.IsJailed() {
+			return time.Time{}, types.ErrPriorityReasonMismatch.Wrapf("validator %s is not jailed", valSrcAddr)
+		}
+
+	case types.PriorityReasonTombstoned:
+		consAddr, err := srcValidator.GetConsAddr()
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if k.slashingKeeper == nil || !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+			return time.Time{}, types.ErrPriorityReasonMismatch.Wrapf("validator %s is not tombstoned", valSrcAddr)
+		}
+
+	default:
+		return time.Time{}, types.ErrInvalidPriorityReason.Wrapf("%s", reason)
+	}
+
+	return k.beginRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, sharesAmount, true)
+}
+
+// beginRedelegation begins a redelegation, bypassing the MaxEntries limit if
+// priority is set.
+func (k Keeper) beginRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec, priority bool,
 ) (completionTime time.Time, err error) {
 	if bytes.Equal(valSrcAddr, valDstAddr) {
 		return time.Time{}, types.ErrSelfRedelegation
@@ -915,7 +1019,7 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrTransitiveRedelegation
 	}
 
-	if k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
+	if !priority && k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
@@ -139,6 +139,80 @@ func (s *KeeperTestSuite) TestDelegation() {
 	require.Equal(0, len(resBonds))
 }
 
+func (s *KeeperTestSuite) TestValidatorDelegationCount() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, valAddrs := createValAddrs(2)
+
+	bond1to1 := stakingtypes.NewDelegation(addrDels[0], valAddrs[0], math.LegacyNewDec(9))
+	bond2to1 := stakingtypes.NewDelegation(addrDels[1], valAddrs[0], math.LegacyNewDec(9))
+	bond1to2 := stakingtypes.NewDelegation(addrDels[0], valAddrs[1], math.LegacyNewDec(9))
+	keeper.SetDelegation(ctx, bond1to1)
+	keeper.SetDelegation(ctx, bond2to1)
+	keeper.SetDelegation(ctx, bond1to2)
+	require.Equal(uint64(2), keeper.GetValidatorDelegationCount(ctx, valAddrs[0]))
+	require.Equal(uint64(1), keeper.GetValidatorDelegationCount(ctx, valAddrs[1]))
+
+	// updating a delegation does not change the count
+	bond1to1.Shares = math.LegacyNewDec(99)
+	keeper.SetDelegation(ctx, bond1to1)
+	require.Equal(uint64(2), keeper.GetValidatorDelegationCount(ctx, valAddrs[0]))
+
+	require.NoError(keeper.RemoveDelegation(ctx, bond1to1))
+	require.NoError(keeper.RemoveDelegation(ctx, bond1to2))
+	require.Equal(uint64(1), keeper.GetValidatorDelegationCount(ctx, valAddrs[0]))
+	require.Equal(uint64(0), keeper.GetValidatorDelegationCount(ctx, valAddrs[1]))
+
+	// removing a missing delegation does not change the count
+	require.NoError(keeper.RemoveDelegation(ctx, bond1to1))
+	require.Equal(uint64(1), keeper.GetValidatorDelegationCount(ctx, valAddrs[0]))
+}
+
+func (s *KeeperTestSuite) TestDelegateValidatorAtCapacity() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, valAddrs := createValAddrs(3)
+	amt := keeper.TokensFromConsensusPower(ctx, 1)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator.MaxDelegations = 2
+	keeper.SetValidator(ctx, validator)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	for _, addr := range addrDels[:2] {
+		_, err := keeper.Delegate(ctx, addr, amt, stakingtypes.Unbonded, validator, true)
+		require.NoError(err)
+		validator, _ = keeper.GetValidator(ctx, valAddrs[0])
+	}
+
+	_, err := keeper.Delegate(ctx, addrDels[2], amt, stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrValidatorAtCapacity)
+
+	// existing delegations are unaffected
+	_, err = keeper.Delegate(ctx, addrDels[0], amt, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	validator, _ = keeper.GetValidator(ctx, valAddrs[0])
+
+	// the absolute max delegations param caps the validator max delegations
+	params := keeper.GetParams(ctx)
+	params.AbsoluteMaxDelegations = 1
+	require.NoError(keeper.SetParams(ctx, params))
+	require.Equal(uint64(1), keeper.ValidatorMaxDelegations(ctx, validator))
+
+	validator.MaxDelegations = 0
+	require.Equal(uint64(1), keeper.ValidatorMaxDelegations(ctx, validator))
+
+	params.AbsoluteMaxDelegations = 0
+	require.NoError(keeper.SetParams(ctx, params))
+	require.Equal(uint64(0), keeper.ValidatorMaxDelegations(ctx, validator))
+
+	_, err = keeper.Delegate(ctx, addrDels[2], amt, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+}
+
 // tests Get/Set/Remove UnbondingDelegation
 func (s *KeeperTestSuite) TestUnbondingDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
@@ -649,6 +723,83 @@ func (s *KeeperTestSuite) TestRedelegationMaxEntries() {
 	require.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestPriorityRedelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(2)
+
+	// create a validator with a self-delegation
+	validator := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
+	require.Equal(valTokens, issuedShares.RoundInt())
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	selfDelegation := stakingtypes.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, selfDelegation)
+
+	// create a second validator
+	validator2 := testutil.NewValidator(s.T(), addrVals[1], PKs[1])
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
+	require.Equal(valTokens, issuedShares.RoundInt())
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator2, true)
+
+	// the source validator is neither jailed nor tombstoned
+	_, err := keeper.PriorityRedelegate(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5), stakingtypes.PriorityReasonJailed)
+	require.ErrorIs(err, stakingtypes.ErrPriorityReasonMismatch)
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+	_, err = keeper.PriorityRedelegate(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5), stakingtypes.PriorityReasonTombstoned)
+	require.ErrorIs(err, stakingtypes.ErrPriorityReasonMismatch)
+
+	_, err = keeper.PriorityRedelegate(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5), stakingtypes.PriorityReasonUnspecified)
+	require.ErrorIs(err, stakingtypes.ErrInvalidPriorityReason)
+
+	// a regular redelegation completes after the unbonding period
+	completionTime, err := keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5))
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)), completionTime)
+
+	// fill the redelegation entries of the delegator
+	for i := uint32(1); i < keeper.MaxEntries(ctx); i++ {
+		_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5))
+		require.NoError(err)
+	}
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5))
+	require.ErrorIs(err, stakingtypes.ErrMaxRedelegationEntries)
+
+	// a priority redelegation away from the jailed validator is not subject to
+	// the max entries, but still completes after the unbonding period so that
+	// the redelegated tokens can be slashed
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(found)
+	validator.Jailed = true
+	keeper.SetValidator(ctx, validator)
+
+	completionTime, err = keeper.PriorityRedelegate(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5), stakingtypes.PriorityReasonJailed)
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)), completionTime)
+
+	require.Empty(keeper.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockTime()))
+
+	red, found := keeper.GetRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1])
+	require.True(found)
+	require.Len(red.Entries, int(keeper.MaxEntries(ctx))+1)
+
+	// the tombstoned reason is checked against the slashing keeper
+	s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+	completionTime, err = keeper.PriorityRedelegate(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(5), stakingtypes.PriorityReasonTombstoned)
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)), completionTime)
+}
+
 func (s *KeeperTestSuite) TestRedelegateSelfDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -63,6 +63,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, credential := range data.ValidatorCredentials {
+		k.SetValidatorCredential(ctx, credential)
+	}
+
 	for _, delegation := range data.Delegations {
 		delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
 
@@ -202,5 +206,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		ValidatorCredentials: k.GetAllValidatorCredentials(ctx),
 	}
 }
@@ -81,6 +81,30 @@ func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest)
 	return &types.QueryValidatorResponse{Validator: validator}, nil
 }
 
+// ValidatorCredential queries the attested identity credential of a validator
+func (k Querier) ValidatorCredential(c context.Context, req *types.QueryValidatorCredentialRequest) (*types.QueryValidatorCredentialResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	credential, found := k.GetValidatorCredential(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no credential attested for validator %s", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorCredentialResponse{Credential: credential}, nil
+}
+
 // ValidatorDelegations queries delegate info for given validator
 func (k Querier) ValidatorDelegations(c context.Context, req *types.QueryValidatorDelegationsRequest) (*types.QueryValidatorDelegationsResponse, error) {
 	if req == nil {
@@ -28,6 +28,14 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	authority  string
+
+	// slashingKeeper is optional, it is only used to check whether the source
+	// validator of a priority redelegation is tombstoned.
+	slashingKeeper types.SlashingKeeper
+
+	// distributionKeeper is optional, it is only used to redistribute the
+	// slashed tokens. Without it, all slashed tokens are burnt.
+	distributionKeeper types.DistributionKeeper
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -87,6 +95,27 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetSlashingKeeper sets the slashing keeper. Like SetHooks, this method must
+// take a pointer as the slashing keeper is built after the staking keeper.
+func (k *Keeper) SetSlashingKeeper(sk types.SlashingKeeper) {
+	if k.slashingKeeper != nil {
+		panic("cannot set slashing keeper twice")
+	}
+
+	k.slashingKeeper = sk
+}
+
+// SetDistributionKeeper sets the distribution keeper. Like SetHooks, this
+// method must take a pointer as the distribution keeper is built after the
+// staking keeper.
+func (k *Keeper) SetDistributionKeeper(dk types.DistributionKeeper) {
+	if k.distributionKeeper != nil {
+		panic("cannot set distribution keeper twice")
+	}
+
+	k.distributionKeeper = dk
+}
+
 // GetLastTotalPower Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
@@ -17,6 +17,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -31,12 +32,13 @@ var (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx           sdk.Context
-	stakingKeeper *stakingkeeper.Keeper
-	bankKeeper    *stakingtestutil.MockBankKeeper
-	accountKeeper *stakingtestutil.MockAccountKeeper
-	queryClient   stakingtypes.QueryClient
-	msgServer     stakingtypes.MsgServer
+	ctx            sdk.Context
+	stakingKeeper  *stakingkeeper.Keeper
+	bankKeeper     *stakingtestutil.MockBankKeeper
+	accountKeeper  *stakingtestutil.MockAccountKeeper
+	slashingKeeper *stakingtestutil.MockSlashingKeeper
+	queryClient    stakingtypes.QueryClient
+	msgServer      stakingtypes.MsgServer
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -50,6 +52,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress())
 	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress())
 	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
+	slashingKeeper := stakingtestutil.NewMockSlashingKeeper(ctrl)
 
 	keeper := stakingkeeper.NewKeeper(
 		encCfg.Codec,
@@ -59,11 +62,13 @@ func (s *KeeperTestSuite) SetupTest() {
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper.SetParams(ctx, stakingtypes.DefaultParams())
+	keeper.SetSlashingKeeper(slashingKeeper)
 
 	s.ctx = ctx
 	s.stakingKeeper = keeper
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
+	s.slashingKeeper = slashingKeeper
 
 	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
@@ -94,6 +99,31 @@ func (s *KeeperTestSuite) TestLastTotalPower() {
 	require.True(expTotalPower.Equal(resTotalPower))
 }
 
+type mockSubspace struct {
+	ps stakingtypes.Params
+}
+
+func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	*ps.(*stakingtypes.Params) = ms.ps
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// the legacy subspace does not hold the params added afterwards
+	legacyParams := stakingtypes.DefaultParams()
+	legacyParams.MaxValidators = 555
+	legacyParams.BurnFraction = sdk.Dec{}
+
+	m := stakingkeeper.NewMigrator(keeper, mockSubspace{ps: legacyParams})
+	require.NoError(m.Migrate3to4(ctx))
+
+	expParams := legacyParams
+	expParams.BurnFraction = stakingtypes.DefaultBurnFraction
+	require.True(expParams.Equal(keeper.GetParams(ctx)))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
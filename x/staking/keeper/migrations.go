@@ -2,10 +2,15 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 	v2 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -34,5 +39,36 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 // Migrate3to4 migrates x/staking state from consensus version 3 to 4.
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
-	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace)
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, legacySubspaceWithDefaults{m.legacySubspace})
+}
+
+// legacySubspaceWithDefaults wraps the legacy x/params subspace so that the
+// params added after the migration to the x/staking store, which the legacy
+// subspace does not hold, are set to their default values.
+type legacySubspaceWithDefaults struct {
+	exported.Subspace
+}
+
+// GetParamSet implements exported.Subspace.
+func (s legacySubspaceWithDefaults) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	s.Subspace.GetParamSet(ctx, ps)
+
+	if params, ok := ps.(*types.Params); ok && params.BurnFraction.IsNil() {
+		params.BurnFraction = types.DefaultBurnFraction
+	}
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"strconv"
 	"time"
 
@@ -107,6 +109,12 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	validator.MinSelfDelegation = msg.MinSelfDelegation
 
+	if absoluteMax := k.AbsoluteMaxDelegations(ctx); absoluteMax != 0 && msg.MaxDelegations > absoluteMax {
+		return nil, sdkerrors.Wrapf(types.ErrMaxDelegationsTooLarge, "%d > %d", msg.MaxDelegations, absoluteMax)
+	}
+
+	validator.MaxDelegations = msg.MaxDelegations
+
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetNewValidatorByPowerIndex(ctx, validator)
@@ -182,6 +190,15 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.MinSelfDelegation = *msg.MinSelfDelegation
 	}
 
+	if msg.MaxDelegations != nil {
+		maxDelegations := msg.MaxDelegations.Uint64()
+		if absoluteMax := k.AbsoluteMaxDelegations(ctx); absoluteMax != 0 && maxDelegations > absoluteMax {
+			return nil, sdkerrors.Wrapf(types.ErrMaxDelegationsTooLarge, "%d > %d", maxDelegations, absoluteMax)
+		}
+
+		validator.MaxDelegations = maxDelegations
+	}
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -313,6 +330,75 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 	}, nil
 }
 
+// PriorityRedelegate defines a method for performing a redelegation away from a
+// jailed or tombstoned validator without the MaxEntries limit
+func (k msgServer) PriorityRedelegate(goCtx context.Context, msg *types.MsgPriorityRedelegate) (*types.MsgPriorityRedelegateResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := k.ValidateUnbondAmount(
+		ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.Keeper.PriorityRedelegate(
+		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares, msg.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "priority_redelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePriorityRedelegate,
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPriorityReason, msg.Reason.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgPriorityRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
 // Undelegate defines a method for performing an undelegation from a delegate and a validator
 func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -491,3 +577,41 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// AttestValidatorIdentity defines a method for a validator operator to attest
+// the verifiable credential of its identity. The credential is recorded with the
+// fingerprint of the operator public key which signed the attestation.
+func (k msgServer) AttestValidatorIdentity(goCtx context.Context, msg *types.MsgAttestValidatorIdentity) (*types.MsgAttestValidatorIdentityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateCredentialHash(msg.CredentialHash); err != nil {
+		return nil, err
+	}
+
+	// validator must already be registered
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	operator := k.authKeeper.GetAccount(ctx, sdk.AccAddress(valAddr))
+	if operator == nil || operator.GetPubKey() == nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("no public key for validator operator %s", sdk.AccAddress(valAddr))
+	}
+	fingerprint := sha256.Sum256(operator.GetPubKey().Bytes())
+
+	k.SetValidatorCredential(ctx, types.NewValidatorCredential(valAddr, msg.CredentialHash, fingerprint[:]))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestValidatorIdentity,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCredentialHash, hex.EncodeToString(msg.CredentialHash)),
+		),
+	)
+
+	return &types.MsgAttestValidatorIdentityResponse{}, nil
+}
@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -129,3 +136,65 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgAttestValidatorIdentity() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(2)
+	keeper.SetValidator(ctx, testutil.NewValidator(s.T(), valAddrs[0], PKs[0]))
+
+	operatorPubKey := PKs[1]
+	operator := authtypes.NewBaseAccount(sdk.AccAddress(valAddrs[0]), operatorPubKey, 0, 0)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), sdk.AccAddress(valAddrs[0])).Return(operator).AnyTimes()
+
+	credentialHash := sha256.Sum256([]byte("credential"))
+
+	// unknown validator
+	_, err := msgServer.AttestValidatorIdentity(ctx, stakingtypes.NewMsgAttestValidatorIdentity(valAddrs[1], credentialHash[:]))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	// invalid credential hash
+	_, err = msgServer.AttestValidatorIdentity(ctx, stakingtypes.NewMsgAttestValidatorIdentity(valAddrs[0], credentialHash[:20]))
+	require.ErrorIs(err, stakingtypes.ErrInvalidCredentialHash)
+
+	_, err = msgServer.AttestValidatorIdentity(ctx, stakingtypes.NewMsgAttestValidatorIdentity(valAddrs[0], credentialHash[:]))
+	require.NoError(err)
+
+	fingerprint := sha256.Sum256(operatorPubKey.Bytes())
+	res, err := s.queryClient.ValidatorCredential(ctx, &stakingtypes.QueryValidatorCredentialRequest{ValidatorAddr: valAddrs[0].String()})
+	require.NoError(err)
+	require.Equal(stakingtypes.NewValidatorCredential(valAddrs[0], credentialHash[:], fingerprint[:]), res.Credential)
+
+	_, err = s.queryClient.ValidatorCredential(ctx, &stakingtypes.QueryValidatorCredentialRequest{ValidatorAddr: valAddrs[1].String()})
+	require.Error(err)
+
+	// a new attestation replaces the previous credential
+	newCredentialHash := sha256.Sum256([]byte("new credential"))
+	_, err = msgServer.AttestValidatorIdentity(ctx, stakingtypes.NewMsgAttestValidatorIdentity(valAddrs[0], newCredentialHash[:]))
+	require.NoError(err)
+
+	credential, found := keeper.GetValidatorCredential(ctx, valAddrs[0])
+	require.True(found)
+	require.Equal(newCredentialHash[:], credential.CredentialHash)
+	require.Len(keeper.GetAllValidatorCredentials(ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestMsgPriorityRedelegate() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 1))
+
+	// only the authority can prioritize a redelegation
+	_, err := msgServer.PriorityRedelegate(ctx, stakingtypes.NewMsgPriorityRedelegate(
+		delAddrs[0].String(), delAddrs[0], valAddrs[0], valAddrs[1], amount, stakingtypes.PriorityReasonJailed,
+	))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.PriorityRedelegate(ctx, stakingtypes.NewMsgPriorityRedelegate(
+		keeper.GetAuthority(), delAddrs[0], valAddrs[0], valAddrs[1], amount, stakingtypes.PriorityReasonJailed,
+	))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+}
@@ -49,6 +49,16 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// AbsoluteMaxDelegations - maximum number of delegations a validator can accept
+func (k Keeper) AbsoluteMaxDelegations(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).AbsoluteMaxDelegations
+}
+
+// CommissionChangeCooldown - minimum duration between two commission rate changes
+func (k Keeper) CommissionChangeCooldown(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CommissionChangeCooldown
+}
+
 // SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
@@ -33,28 +33,47 @@ func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens math.Int) {
 	}
 }
 
-// burnBondedTokens removes coins from the bonded pool module account
-func (k Keeper) burnBondedTokens(ctx sdk.Context, amt math.Int) error {
+// burnBondedTokens removes the coins slashed from the given validator from the
+// bonded pool module account, see removeSlashedTokens.
+func (k Keeper) burnBondedTokens(ctx sdk.Context, slashedVal sdk.ValAddress, amt math.Int) error {
+	return k.removeSlashedTokens(ctx, slashedVal, types.BondedPoolName, amt)
+}
+
+// burnNotBondedTokens removes the coins slashed from the given validator from
+// the not bonded pool module account, see removeSlashedTokens.
+func (k Keeper) burnNotBondedTokens(ctx sdk.Context, slashedVal sdk.ValAddress, amt math.Int) error {
+	return k.removeSlashedTokens(ctx, slashedVal, types.NotBondedPoolName, amt)
+}
+
+// removeSlashedTokens burns the coins slashed from the given validator from the
+// given pool module account. If the slashed tokens redistribution is enabled
+// and a distribution keeper is set, only BurnFraction of them is burnt, the
+// rest being redistributed by x/distribution to the delegators of the other
+// validators.
+func (k Keeper) removeSlashedTokens(ctx sdk.Context, slashedVal sdk.ValAddress, poolName string, amt math.Int) error {
 	if !amt.IsPositive() {
 		// skip as no coins need to be burned
 		return nil
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
+	params := k.GetParams(ctx)
+	burnAmt := amt
+	if params.SlashRedistribution && k.distributionKeeper != nil {
+		burnAmt = params.BurnFraction.MulInt(amt).TruncateInt()
+	}
 
-	return k.bankKeeper.BurnCoins(ctx, types.BondedPoolName, coins)
-}
+	if redistributeAmt := amt.Sub(burnAmt); redistributeAmt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.BondDenom, redistributeAmt))
+		if err := k.distributionKeeper.AllocateSlashedTokens(ctx, slashedVal, poolName, coins); err != nil {
+			return err
+		}
+	}
 
-// burnNotBondedTokens removes coins from the not bonded pool module account
-func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt math.Int) error {
-	if !amt.IsPositive() {
-		// skip as no coins need to be burned
+	if !burnAmt.IsPositive() {
 		return nil
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
-
-	return k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
+	return k.bankKeeper.BurnCoins(ctx, poolName, sdk.NewCoins(sdk.NewCoin(params.BondDenom, burnAmt)))
 }
 
 // TotalBondedTokens total staking tokens supply which is bonded
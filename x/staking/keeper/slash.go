@@ -136,11 +136,11 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	switch validator.GetStatus() {
 	case types.Bonded:
-		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.burnBondedTokens(ctx, operatorAddress, tokensToBurn); err != nil {
 			panic(err)
 		}
 	case types.Unbonding, types.Unbonded:
-		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.burnNotBondedTokens(ctx, operatorAddress, tokensToBurn); err != nil {
 			panic(err)
 		}
 	default:
@@ -188,6 +188,7 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = math.ZeroInt()
 	burnedAmount := math.ZeroInt()
+	slashedStake := math.ZeroInt()
 
 	// perform slashing on all entries within the unbonding delegation
 	for i, entry := range unbondingDelegation.Entries {
@@ -201,6 +202,8 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 			continue
 		}
 
+		slashedStake = slashedStake.Add(entry.InitialBalance)
+
 		// Calculate slash amount proportional to stake contributing to infraction
 		slashAmountDec := slashFactor.MulInt(entry.InitialBalance)
 		slashAmount := slashAmountDec.TruncateInt()
@@ -223,10 +226,27 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
 	}
 
-	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
+	valAddr, err := sdk.ValAddressFromBech32(unbondingDelegation.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := k.burnNotBondedTokens(ctx, valAddr, burnedAmount); err != nil {
 		panic(err)
 	}
 
+	if slashedStake.IsPositive() {
+		delAddr := sdk.MustAccAddressFromBech32(unbondingDelegation.DelegatorAddress)
+
+		// call the after-unbonding-slashed hook, discarding its writes if it fails
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.Hooks().AfterUnbondingSlashed(cacheCtx, delAddr, valAddr, slashedStake); err != nil {
+			k.Logger(ctx).Error("failed to call after unbonding slashed hook", "error", err)
+		} else {
+			write()
+		}
+	}
+
 	return totalSlashAmount
 }
 
@@ -242,6 +262,7 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = math.ZeroInt()
 	bondedBurnedAmount, notBondedBurnedAmount := math.ZeroInt(), math.ZeroInt()
+	slashedStake := math.ZeroInt()
 
 	// perform slashing on all entries within the redelegation
 	for _, entry := range redelegation.Entries {
@@ -255,6 +276,8 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			continue
 		}
 
+		slashedStake = slashedStake.Add(entry.InitialBalance)
+
 		// Calculate slash amount proportional to stake contributing to infraction
 		slashAmountDec := slashFactor.MulInt(entry.InitialBalance)
 		slashAmount := slashAmountDec.TruncateInt()
@@ -342,13 +365,25 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		}
 	}
 
-	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
+	if err := k.burnBondedTokens(ctx, srcValidator.GetOperator(), bondedBurnedAmount); err != nil {
 		panic(err)
 	}
 
-	if err := k.burnNotBondedTokens(ctx, notBondedBurnedAmount); err != nil {
+	if err := k.burnNotBondedTokens(ctx, srcValidator.GetOperator(), notBondedBurnedAmount); err != nil {
 		panic(err)
 	}
 
+	if slashedStake.IsPositive() {
+		delAddr := sdk.MustAccAddressFromBech32(redelegation.DelegatorAddress)
+
+		// call the after-unbonding-slashed hook, discarding its writes if it fails
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.Hooks().AfterUnbondingSlashed(cacheCtx, delAddr, srcValidator.GetOperator(), slashedStake); err != nil {
+			k.Logger(ctx).Error("failed to call after unbonding slashed hook", "error", err)
+		} else {
+			write()
+		}
+	}
+
 	return totalSlashAmount
 }
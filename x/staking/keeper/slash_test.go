@@ -1,8 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // tests Jail, Unjail
@@ -48,3 +56,39 @@ func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	fraction := sdk.NewDecWithPrec(5, 1)
 	require.Panics(func() { keeper.Slash(ctx, consAddr, 1, 10, fraction) })
 }
+
+// tests that the writes of a failing AfterUnbondingSlashed hook are discarded
+func (s *KeeperTestSuite) TestSlashUnbondingDelegationHookFailure() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr := sdk.AccAddress(PKs[0].Address())
+	valAddr := sdk.ValAddress(PKs[1].Address())
+	hookValAddrs := []sdk.ValAddress{sdk.ValAddress(PKs[2].Address()), sdk.ValAddress(PKs[3].Address())}
+
+	// the hook stores a validator, then fails the first time only
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	for i, hookErr := range []error{errors.New("hook failed"), nil} {
+		hookValAddr, hookErr := hookValAddrs[i], hookErr
+		hooks.EXPECT().AfterUnbondingSlashed(gomock.Any(), delAddr, valAddr, math.NewInt(10)).DoAndReturn(
+			func(ctx sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ math.Int) error {
+				keeper.SetValidator(ctx, testutil.NewValidator(s.T(), hookValAddr, PKs[2]))
+				return hookErr
+			},
+		)
+	}
+	keeper.SetHooks(hooks)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).Times(2)
+
+	ubd := stakingtypes.NewUnbondingDelegation(delAddr, valAddr, 10, ctx.BlockTime().Add(time.Hour), math.NewInt(10), 1)
+	ctx = ctx.WithBlockHeight(12)
+
+	keeper.SlashUnbondingDelegation(ctx, ubd, 10, sdk.NewDecWithPrec(5, 1))
+	_, found := keeper.GetValidator(ctx, hookValAddrs[0])
+	require.False(found)
+
+	keeper.SlashUnbondingDelegation(ctx, ubd, 10, sdk.NewDecWithPrec(5, 1))
+	_, found = keeper.GetValidator(ctx, hookValAddrs[1])
+	require.True(found)
+}
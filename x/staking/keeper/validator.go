@@ -139,7 +139,7 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	commission := validator.Commission
 	blockTime := ctx.BlockHeader().Time
 
-	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+	if err := commission.ValidateNewRate(newRate, blockTime, k.CommissionChangeCooldown(ctx)); err != nil {
 		return commission, err
 	}
 
@@ -181,12 +181,55 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorCredentialKey(address))
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
 	}
 }
 
+// GetValidatorCredential gets the attested identity credential of a validator.
+func (k Keeper) GetValidatorCredential(ctx sdk.Context, addr sdk.ValAddress) (credential types.ValidatorCredential, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorCredentialKey(addr))
+	if value == nil {
+		return credential, false
+	}
+
+	k.cdc.MustUnmarshal(value, &credential)
+	return credential, true
+}
+
+// SetValidatorCredential sets the attested identity credential of a validator,
+// replacing the previous one.
+func (k Keeper) SetValidatorCredential(ctx sdk.Context, credential types.ValidatorCredential) {
+	valAddr, err := sdk.ValAddressFromBech32(credential.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorCredentialKey(valAddr), k.cdc.MustMarshal(&credential))
+}
+
+// GetAllValidatorCredentials returns the attested identity credentials of all
+// the validators, used during genesis dump.
+func (k Keeper) GetAllValidatorCredentials(ctx sdk.Context) (credentials []types.ValidatorCredential) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorCredentialKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var credential types.ValidatorCredential
+		k.cdc.MustUnmarshal(iterator.Value(), &credential)
+		credentials = append(credentials, credential)
+	}
+
+	return credentials
+}
+
 // get groups of validators
 
 // get the set of all validators with no limits, used during genesis dump
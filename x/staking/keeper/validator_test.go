@@ -330,6 +330,34 @@ func (s *KeeperTestSuite) TestUpdateValidatorCommission() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUpdateValidatorCommissionCooldown() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	commission := stakingtypes.NewCommissionWithTime(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(1, 1), now.Add(-48*time.Hour),
+	)
+	val := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	val, _ = val.SetInitialCommission(commission)
+
+	// the default cooldown of a week is not elapsed
+	_, err := keeper.UpdateValidatorCommission(ctx, val, sdk.NewDecWithPrec(2, 1))
+	require.ErrorIs(err, stakingtypes.ErrCommissionUpdateTime)
+
+	params := keeper.GetParams(ctx)
+	params.CommissionChangeCooldown = 48 * time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	commission, err = keeper.UpdateValidatorCommission(ctx, val, sdk.NewDecWithPrec(2, 1))
+	require.NoError(err)
+	require.Equal(sdk.NewDecWithPrec(2, 1), commission.Rate)
+	require.Equal(now, commission.UpdateTime)
+}
+
 func (s *KeeperTestSuite) TestValidatorToken() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
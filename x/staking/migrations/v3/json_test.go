@@ -41,15 +41,20 @@ func TestMigrateJSON(t *testing.T) {
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
+		"absolute_max_delegations": "0",
 		"bond_denom": "stake",
+		"burn_fraction": "0.000000000000000000",
+		"commission_change_cooldown": "604800s",
 		"historical_entries": 10000,
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
+		"slash_redistribution": false,
 		"unbonding_time": "1814400s"
 	},
 	"redelegations": [],
 	"unbonding_delegations": [],
+	"validator_credentials": [],
 	"validators": []
 }`
 
@@ -0,0 +1,32 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The migration
+// includes:
+// - Set the default BurnFraction param, slashed tokens redistribution being
+// disabled
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	if params.BurnFraction.IsNil() {
+		params.BurnFraction = types.DefaultBurnFraction
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,45 @@
+package v6
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration
+// includes:
+// - Index the number of delegations of each validator, used to enforce the
+// maximum number of delegations accepted by the validators
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	counts := make(map[string]uint64)
+	var valAddrs []sdk.ValAddress
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	for ; iterator.Valid(); iterator.Next() {
+		delegation, err := types.UnmarshalDelegation(cdc, iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		if _, ok := counts[valAddr.String()]; !ok {
+			valAddrs = append(valAddrs, valAddr)
+		}
+		counts[valAddr.String()]++
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, counts[valAddr.String()])
+		store.Set(types.GetValidatorDelegationCountKey(valAddr), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v6_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	_, _, del1 := testdata.KeyTestPubAddr()
+	_, _, del2 := testdata.KeyTestPubAddr()
+	_, _, val1 := testdata.KeyTestPubAddr()
+	_, _, val2 := testdata.KeyTestPubAddr()
+
+	for _, delegation := range []types.Delegation{
+		types.NewDelegation(del1, sdk.ValAddress(val1), sdk.OneDec()),
+		types.NewDelegation(del2, sdk.ValAddress(val1), sdk.OneDec()),
+		types.NewDelegation(del1, sdk.ValAddress(val2), sdk.OneDec()),
+	} {
+		store.Set(types.GetDelegationKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()), types.MustMarshalDelegation(cdc, delegation))
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	require.Equal(t, uint64(2), binary.BigEndian.Uint64(store.Get(types.GetValidatorDelegationCountKey(sdk.ValAddress(val1)))))
+	require.Equal(t, uint64(1), binary.BigEndian.Uint64(store.Get(types.GetValidatorDelegationCountKey(sdk.ValAddress(val2)))))
+}
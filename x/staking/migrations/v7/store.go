@@ -0,0 +1,34 @@
+package v7
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The migration
+// includes:
+// - Set the default CommissionChangeCooldown param
+//
+// The commission update times of the validators are kept as is, the cooldown
+// applying from the last commission change made before the migration.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	if params.CommissionChangeCooldown == 0 {
+		params.CommissionChangeCooldown = types.DefaultCommissionChangeCooldown
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
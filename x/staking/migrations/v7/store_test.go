@@ -0,0 +1,41 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// store params without the commission change cooldown
+	params := types.DefaultParams()
+	params.CommissionChangeCooldown = 0
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, types.DefaultCommissionChangeCooldown, res.CommissionChangeCooldown)
+
+	// a cooldown set after the params migration is kept
+	res.CommissionChangeCooldown = time.Hour
+	store.Set(types.ParamsKey, cdc.MustMarshal(&res))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, time.Hour, res.CommissionChangeCooldown)
+}
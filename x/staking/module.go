@@ -33,7 +33,7 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 4
+	consensusVersion uint64 = 7
 )
 
 var (
@@ -161,6 +161,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the staking module.
@@ -197,6 +206,8 @@ func init() {
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
 		appmodule.Invoke(InvokeSetStakingHooks),
+		appmodule.Invoke(InvokeSetSlashingKeeper),
+		appmodule.Invoke(InvokeSetDistributionKeeper),
 	)
 }
 
@@ -278,6 +289,29 @@ func InvokeSetStakingHooks(
 	return nil
 }
 
+// InvokeSetSlashingKeeper sets the x/slashing keeper used by priority
+// redelegations on the staking keeper, if x/slashing is part of the app.
+func InvokeSetSlashingKeeper(keeper *keeper.Keeper, slashingKeeper types.SlashingKeeper) {
+	// all arguments to invokers are optional
+	if keeper == nil || slashingKeeper == nil {
+		return
+	}
+
+	keeper.SetSlashingKeeper(slashingKeeper)
+}
+
+// InvokeSetDistributionKeeper sets the x/distribution keeper used to
+// redistribute the slashed tokens on the staking keeper, if x/distribution is
+// part of the app.
+func InvokeSetDistributionKeeper(keeper *keeper.Keeper, distributionKeeper types.DistributionKeeper) {
+	// all arguments to invokers are optional
+	if keeper == nil || distributionKeeper == nil {
+		return
+	}
+
+	keeper.SetDistributionKeeper(distributionKeeper)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the staking module.
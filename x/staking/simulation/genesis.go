@@ -16,9 +16,11 @@ import (
 
 // Simulation parameter constants
 const (
-	unbondingTime     = "unbonding_time"
-	maxValidators     = "max_validators"
-	historicalEntries = "historical_entries"
+	unbondingTime       = "unbonding_time"
+	maxValidators       = "max_validators"
+	historicalEntries   = "historical_entries"
+	slashRedistribution = "slash_redistribution"
+	burnFraction        = "burn_fraction"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -36,6 +38,16 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genSlashRedistribution returns randomized SlashRedistribution
+func genSlashRedistribution(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// genBurnFraction returns randomized BurnFraction
+func genBurnFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +56,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxVals           uint32
 		histEntries       uint32
 		minCommissionRate sdk.Dec
+		slashRedist       bool
+		burnFrac          sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +75,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = getHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashRedistribution, &slashRedist, simState.Rand,
+		func(r *rand.Rand) { slashRedist = genSlashRedistribution(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, burnFraction, &burnFrac, simState.Rand,
+		func(r *rand.Rand) { burnFrac = genBurnFraction(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate,
+		slashRedist, burnFrac, types.DefaultAbsoluteMaxDelegations,
+		types.DefaultCommissionChangeCooldown,
+	)
 
 	// validators & delegations
 	var (
@@ -37,6 +37,20 @@ func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
 	return m.recorder
 }
 
+// AllocateSlashedTokens mocks base method.
+func (m *MockDistributionKeeper) AllocateSlashedTokens(ctx types.Context, slashedVal types.ValAddress, poolName string, tokens types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateSlashedTokens", ctx, slashedVal, poolName, tokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllocateSlashedTokens indicates an expected call of AllocateSlashedTokens.
+func (mr *MockDistributionKeeperMockRecorder) AllocateSlashedTokens(ctx, slashedVal, poolName, tokens interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateSlashedTokens", reflect.TypeOf((*MockDistributionKeeper)(nil).AllocateSlashedTokens), ctx, slashedVal, poolName, tokens)
+}
+
 // GetFeePoolCommunityCoins mocks base method.
 func (m *MockDistributionKeeper) GetFeePoolCommunityCoins(ctx types.Context) types.DecCoins {
 	m.ctrl.T.Helper()
@@ -303,6 +317,43 @@ func (mr *MockBankKeeperMockRecorder) UndelegateCoinsFromModuleToAccount(ctx, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).UndelegateCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// IsTombstoned mocks base method.
+func (m *MockSlashingKeeper) IsTombstoned(ctx types.Context, consAddr types.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTombstoned", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTombstoned indicates an expected call of IsTombstoned.
+func (mr *MockSlashingKeeperMockRecorder) IsTombstoned(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTombstoned", reflect.TypeOf((*MockSlashingKeeper)(nil).IsTombstoned), ctx, consAddr)
+}
+
 // MockValidatorSet is a mock of ValidatorSet interface.
 type MockValidatorSet struct {
 	ctrl     *gomock.Controller
@@ -598,6 +649,20 @@ func (mr *MockStakingHooksMockRecorder) AfterUnbondingInitiated(ctx, id interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterUnbondingInitiated", reflect.TypeOf((*MockStakingHooks)(nil).AfterUnbondingInitiated), ctx, id)
 }
 
+// AfterUnbondingSlashed mocks base method.
+func (m *MockStakingHooks) AfterUnbondingSlashed(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress, stake math.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterUnbondingSlashed", ctx, delAddr, valAddr, stake)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterUnbondingSlashed indicates an expected call of AfterUnbondingSlashed.
+func (mr *MockStakingHooksMockRecorder) AfterUnbondingSlashed(ctx, delAddr, valAddr, stake interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterUnbondingSlashed", reflect.TypeOf((*MockStakingHooks)(nil).AfterUnbondingSlashed), ctx, delAddr, valAddr, stake)
+}
+
 // AfterValidatorBeginUnbonding mocks base method.
 func (m *MockStakingHooks) AfterValidatorBeginUnbonding(ctx types.Context, consAddr types.ConsAddress, valAddr types.ValAddress) error {
 	m.ctrl.T.Helper()
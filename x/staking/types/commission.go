@@ -80,11 +80,12 @@ func (cr CommissionRates) Validate() error {
 }
 
 // ValidateNewRate performs basic sanity validation checks of a new commission
-// rate. If validation fails, an SDK error is returned.
-func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error {
+// rate, the commission rate being changed at most once per cooldown duration.
+// If validation fails, an SDK error is returned.
+func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time, cooldown time.Duration) error {
 	switch {
-	case blockTime.Sub(c.UpdateTime).Hours() < 24:
-		// new rate cannot be changed more than once within 24 hours
+	case blockTime.Sub(c.UpdateTime) < cooldown:
+		// new rate cannot be changed more than once within the cooldown duration
 		return ErrCommissionUpdateTime
 
 	case newRate.IsNegative():
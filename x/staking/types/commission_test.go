@@ -61,10 +61,12 @@ func TestCommissionValidateNewRate(t *testing.T) {
 		{c1, sdk.MustNewDecFromStr("0.50"), now.Add(48 * time.Hour), false},
 		// valid commission
 		{c1, sdk.MustNewDecFromStr("0.10"), now.Add(48 * time.Hour), false},
+		// valid commission; last update exactly one cooldown ago
+		{c1, sdk.MustNewDecFromStr("0.50"), now.Add(24 * time.Hour), false},
 	}
 
 	for i, tc := range testCases {
-		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime)
+		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime, 24*time.Hour)
 		require.Equal(
 			t, tc.expectErr, err != nil,
 			"unexpected result; tc #%d, input: %v, newRate: %s, blockTime: %s",
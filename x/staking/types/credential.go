@@ -0,0 +1,46 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewValidatorCredential creates a new ValidatorCredential instance.
+//
+//nolint:interfacer
+func NewValidatorCredential(valAddr sdk.ValAddress, credentialHash, signingKeyFingerprint []byte) ValidatorCredential {
+	return ValidatorCredential{
+		ValidatorAddress:      valAddr.String(),
+		CredentialHash:        credentialHash,
+		SigningKeyFingerprint: signingKeyFingerprint,
+	}
+}
+
+// ValidateCredentialHash checks that the given credential hash is a SHA-256
+// digest.
+func ValidateCredentialHash(credentialHash []byte) error {
+	if len(credentialHash) != sha256.Size {
+		return ErrInvalidCredentialHash.Wrapf("expected %d bytes, got %d", sha256.Size, len(credentialHash))
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the credential.
+func (c ValidatorCredential) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(c.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := ValidateCredentialHash(c.CredentialHash); err != nil {
+		return err
+	}
+
+	if len(c.SigningKeyFingerprint) != sha256.Size {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid signing key fingerprint, expected %d bytes, got %d", sha256.Size, len(c.SigningKeyFingerprint))
+	}
+
+	return nil
+}
@@ -21,7 +21,7 @@ var (
 	ErrCommissionNegative              = sdkerrors.Register(ModuleName, 9, "commission must be positive")
 	ErrCommissionHuge                  = sdkerrors.Register(ModuleName, 10, "commission cannot be more than 100%")
 	ErrCommissionGTMaxRate             = sdkerrors.Register(ModuleName, 11, "commission cannot be more than the max rate")
-	ErrCommissionUpdateTime            = sdkerrors.Register(ModuleName, 12, "commission cannot be changed more than once in 24h")
+	ErrCommissionUpdateTime            = sdkerrors.Register(ModuleName, 12, "commission cannot be changed more than once within the commission change cooldown")
 	ErrCommissionChangeRateNegative    = sdkerrors.Register(ModuleName, 13, "commission change rate must be positive")
 	ErrCommissionChangeRateGTMaxRate   = sdkerrors.Register(ModuleName, 14, "commission change rate cannot be more than the max rate")
 	ErrCommissionGTMaxChangeRate       = sdkerrors.Register(ModuleName, 15, "commission cannot be changed more than max change rate")
@@ -52,4 +52,9 @@ var (
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 41, "unbonding operation not found")
 	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 42, "cannot un-hold unbonding operation that is not on hold")
+	ErrValidatorAtCapacity             = sdkerrors.Register(ModuleName, 43, "validator does not accept new delegations, maximum number of delegations reached")
+	ErrMaxDelegationsTooLarge          = sdkerrors.Register(ModuleName, 44, "validator max delegations cannot exceed the absolute max delegations")
+	ErrInvalidPriorityReason           = sdkerrors.Register(ModuleName, 45, "invalid priority redelegation reason")
+	ErrPriorityReasonMismatch          = sdkerrors.Register(ModuleName, 46, "source validator state does not match the priority redelegation reason")
+	ErrInvalidCredentialHash           = sdkerrors.Register(ModuleName, 47, "invalid credential hash, expected a SHA-256 digest")
 )
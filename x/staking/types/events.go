@@ -10,6 +10,8 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypePriorityRedelegate        = "priority_redelegate"
+	EventTypeAttestValidatorIdentity   = "attest_validator_identity"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +22,6 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyPriorityReason    = "priority_reason"
+	AttributeKeyCredentialHash    = "credential_hash"
 )
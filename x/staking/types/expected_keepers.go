@@ -9,6 +9,9 @@ import (
 
 // DistributionKeeper expected distribution keeper (noalias)
 type DistributionKeeper interface {
+	// AllocateSlashedTokens redistributes the tokens slashed from the given
+	// validator, held by the given pool, to the delegators of the other validators.
+	AllocateSlashedTokens(ctx sdk.Context, slashedVal sdk.ValAddress, poolName string, tokens sdk.Coins) error
 	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
 	GetValidatorOutstandingRewardsCoins(ctx sdk.Context, val sdk.ValAddress) sdk.DecCoins
 }
@@ -16,7 +19,7 @@ type DistributionKeeper interface {
 // AccountKeeper defines the expected account keeper (noalias)
 type AccountKeeper interface {
 	IterateAccounts(ctx sdk.Context, process func(authtypes.AccountI) (stop bool))
-	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI // only used for simulation
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
@@ -41,6 +44,12 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// SlashingKeeper defines the expected slashing keeper, used to check whether a
+// validator is tombstoned (noalias)
+type SlashingKeeper interface {
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+}
+
 // ValidatorSet expected properties for the set of all validators (noalias)
 type ValidatorSet interface {
 	// iterate through validators by operator address, execute func for each validator
@@ -105,6 +114,7 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error
 	AfterUnbondingInitiated(ctx sdk.Context, id uint64) error
+	AfterUnbondingSlashed(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, stake math.Int) error // Must be called when the unbonding delegations or redelegations of a delegator from a validator are slashed
 }
 
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
@@ -45,6 +45,9 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// validator_credentials defines the attested identity credentials of the
+	// validators.
+	ValidatorCredentials []ValidatorCredential `protobuf:"bytes,9,rep,name=validator_credentials,json=validatorCredentials,proto3" json:"validator_credentials"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +132,13 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetValidatorCredentials() []ValidatorCredential {
+	if m != nil {
+		return m.ValidatorCredentials
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +190,40 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x93, 0x7f, 0xff, 0xeb, 0x5a, 0x77, 0x20, 0x30, 0x1d, 0x0a, 0x3d, 0xa4, 0xa5, 0x9a,
+	0x50, 0x35, 0x58, 0xa2, 0x75, 0x37, 0x6e, 0x2b, 0x08, 0x84, 0x34, 0x89, 0x29, 0x63, 0x1c, 0x90,
+	0x50, 0xe5, 0x36, 0x56, 0x66, 0x35, 0xb5, 0xa3, 0xbc, 0x6e, 0x19, 0x9f, 0x00, 0x8e, 0x7c, 0x84,
+	0x1d, 0x39, 0x72, 0xe0, 0x43, 0xec, 0x38, 0x71, 0x42, 0x1c, 0x26, 0xd4, 0x1e, 0xe0, 0x63, 0xa0,
+	0xd8, 0x69, 0x96, 0xa9, 0xcd, 0x2e, 0x49, 0x9c, 0xf7, 0x79, 0x7e, 0xcf, 0x6b, 0xd9, 0x2f, 0xda,
+	0x1a, 0x0a, 0x18, 0x0b, 0x70, 0x41, 0x92, 0x11, 0xe3, 0x81, 0x3b, 0xdd, 0x1d, 0x50, 0x49, 0x76,
+	0xdd, 0x80, 0x72, 0x0a, 0x0c, 0x9c, 0x28, 0x16, 0x52, 0xe0, 0xfb, 0x5a, 0xe5, 0xa4, 0x2a, 0x27,
+	0x55, 0x35, 0xea, 0x81, 0x08, 0x84, 0x92, 0xb8, 0xc9, 0x97, 0x56, 0x37, 0x8a, 0x98, 0x0b, 0xb7,
+	0x56, 0x3d, 0xd0, 0xaa, 0xbe, 0xb6, 0xa7, 0x01, 0xba, 0x74, 0x97, 0x8c, 0x19, 0x17, 0xae, 0x7a,
+	0xea, 0x5f, 0xed, 0x4f, 0x65, 0xb4, 0xf1, 0x52, 0xf7, 0x74, 0x24, 0x89, 0xa4, 0x78, 0x1f, 0x95,
+	0x23, 0x12, 0x93, 0x31, 0x58, 0x66, 0xcb, 0xec, 0xd4, 0xba, 0xb6, 0xb3, 0xba, 0x47, 0xe7, 0x50,
+	0xa9, 0x7a, 0xd5, 0xf3, 0xcb, 0xa6, 0xf1, 0xf5, 0xcf, 0xb7, 0x6d, 0xd3, 0x4b, 0x8d, 0xf8, 0x3d,
+	0xba, 0x13, 0x12, 0x90, 0x7d, 0x29, 0x24, 0x09, 0xfb, 0x91, 0xf8, 0x40, 0x63, 0xeb, 0xbf, 0x96,
+	0xd9, 0xd9, 0xe8, 0xed, 0x25, 0xe2, 0x5f, 0x97, 0xcd, 0x47, 0x01, 0x93, 0x27, 0x93, 0x81, 0x33,
+	0x14, 0xe3, 0xb4, 0xc3, 0xf4, 0xb5, 0x03, 0xfe, 0xc8, 0x95, 0x1f, 0x23, 0x0a, 0xce, 0x2b, 0x2e,
+	0x35, 0xf6, 0x76, 0x02, 0x7b, 0x93, 0xb0, 0x0e, 0x13, 0x14, 0x66, 0x68, 0x53, 0xe1, 0xa7, 0x24,
+	0x64, 0x3e, 0x91, 0x22, 0xd6, 0x11, 0x60, 0x95, 0x5a, 0xa5, 0x4e, 0xad, 0xbb, 0x5d, 0xd4, 0xf0,
+	0x01, 0x01, 0xf9, 0x76, 0xe1, 0x51, 0xa8, 0x7c, 0xf3, 0xf7, 0xc2, 0xa5, 0x32, 0xe0, 0x03, 0x84,
+	0xb2, 0x14, 0xb0, 0xfe, 0x57, 0xfc, 0x87, 0x45, 0xfc, 0xcc, 0x9c, 0xc7, 0xe6, 0xfc, 0xf8, 0x35,
+	0xaa, 0xf9, 0x34, 0xa4, 0x01, 0x91, 0x4c, 0x70, 0xb0, 0xd6, 0x14, 0xae, 0x5d, 0x84, 0x7b, 0x9e,
+	0x49, 0xf3, 0xbc, 0x3c, 0x01, 0x8f, 0xd0, 0xe6, 0x84, 0x0f, 0x04, 0xf7, 0x19, 0x0f, 0xfa, 0x79,
+	0x74, 0x59, 0xa1, 0x1f, 0x17, 0xa1, 0x8f, 0x17, 0xa6, 0xd5, 0x19, 0xf5, 0xc9, 0x72, 0x1d, 0xf0,
+	0x31, 0xba, 0x15, 0xd3, 0x7c, 0xc8, 0xba, 0x0a, 0xd9, 0x2a, 0x0a, 0xf1, 0xa8, 0xbf, 0x92, 0x7e,
+	0x9d, 0x82, 0x1b, 0xa8, 0x42, 0x4f, 0x23, 0x11, 0x4b, 0xea, 0x5b, 0x95, 0x96, 0xd9, 0xa9, 0x78,
+	0xd9, 0x3a, 0xd9, 0xdf, 0xd5, 0x21, 0x0f, 0x13, 0x1f, 0x97, 0x8c, 0x84, 0x60, 0x55, 0x6f, 0xde,
+	0x5f, 0x76, 0x12, 0xcf, 0x32, 0xcf, 0xb5, 0xfd, 0x4d, 0x97, 0xeb, 0xd0, 0x3e, 0x41, 0x78, 0xf9,
+	0x86, 0xe0, 0x2e, 0x5a, 0x27, 0xbe, 0x1f, 0x53, 0xd0, 0xf3, 0x50, 0xed, 0x59, 0x3f, 0xbe, 0xef,
+	0xd4, 0xd3, 0xdc, 0x7d, 0x5d, 0x39, 0x92, 0x31, 0xe3, 0x81, 0xb7, 0x10, 0xe2, 0x3a, 0x5a, 0xbb,
+	0xba, 0xf4, 0x25, 0x4f, 0x2f, 0x9e, 0x56, 0x3e, 0x9f, 0x35, 0x8d, 0xbf, 0x67, 0x4d, 0xa3, 0xf7,
+	0xe2, 0x7c, 0x66, 0x9b, 0x17, 0x33, 0xdb, 0xfc, 0x3d, 0xb3, 0xcd, 0x2f, 0x73, 0xdb, 0xb8, 0x98,
+	0xdb, 0xc6, 0xcf, 0xb9, 0x6d, 0xbc, 0x7b, 0x72, 0xe3, 0x5c, 0x9c, 0x66, 0x93, 0xaf, 0x26, 0x64,
+	0x50, 0x56, 0x23, 0xbc, 0xf7, 0x6f, 0x00, 0xbf, 0x7f, 0xe6, 0x15, 0x6c, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +246,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorCredentials) > 0 {
+		for iNdEx := len(m.ValidatorCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCredentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +452,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.ValidatorCredentials) > 0 {
+		for _, e := range m.ValidatorCredentials {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +768,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCredentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCredentials = append(m.ValidatorCredentials, ValidatorCredential{})
+			if err := m.ValidatorCredentials[len(m.ValidatorCredentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -112,3 +114,12 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) e
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterUnbondingSlashed(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, stake math.Int) error {
+	for i := range h {
+		if err := h[i].AfterUnbondingSlashed(ctx, delAddr, valAddr, stake); err != nil {
+			return err
+		}
+	}
+	return nil
+}
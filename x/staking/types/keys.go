@@ -34,6 +34,7 @@ var (
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorCredentialKey    = []byte{0x24} // prefix for each key to a validator identity credential
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -46,6 +47,8 @@ var (
 	UnbondingIndexKey = []byte{0x38} // prefix for an index for looking up unbonding operations by their IDs
 	UnbondingTypeKey  = []byte{0x39} // prefix for an index containing the type of unbonding operations
 
+	ValidatorDelegationCountKey = []byte{0x3A} // prefix for the number of delegations of each validator
+
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
@@ -86,6 +89,19 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
 
+// GetValidatorCredentialKey creates the key for the identity credential of the
+// validator with the given operator address.
+func GetValidatorCredentialKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorCredentialKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorDelegationCountKey creates the key for the number of delegations
+// of a validator
+// VALUE: number of delegations (uint64 big endian)
+func GetValidatorDelegationCountKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorDelegationCountKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
 // GetValidatorByConsAddrKey creates the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -16,7 +16,9 @@ const (
 	TypeMsgCreateValidator           = "create_validator"
 	TypeMsgDelegate                  = "delegate"
 	TypeMsgBeginRedelegate           = "begin_redelegate"
+	TypeMsgPriorityRedelegate        = "priority_redelegate"
 	TypeMsgUpdateParams              = "update_params"
+	TypeMsgAttestValidatorIdentity   = "attest_validator_identity"
 )
 
 var (
@@ -27,8 +29,10 @@ var (
 	_ sdk.Msg                            = &MsgDelegate{}
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
+	_ sdk.Msg                            = &MsgPriorityRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgAttestValidatorIdentity{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -143,12 +147,13 @@ func (msg MsgCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 // NewMsgEditValidator creates a new MsgEditValidator instance
 //
 //nolint:interfacer
-func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec, newMinSelfDelegation *math.Int) *MsgEditValidator {
+func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec, newMinSelfDelegation, newMaxDelegations *math.Int) *MsgEditValidator {
 	return &MsgEditValidator{
 		Description:       description,
 		CommissionRate:    newRate,
 		ValidatorAddress:  valAddr.String(),
 		MinSelfDelegation: newMinSelfDelegation,
+		MaxDelegations:    newMaxDelegations,
 	}
 }
 
@@ -187,6 +192,10 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		)
 	}
 
+	if msg.MaxDelegations != nil && (msg.MaxDelegations.IsNegative() || !msg.MaxDelegations.IsUint64()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max delegations must be a non-negative 64 bits integer")
+	}
+
 	if msg.CommissionRate != nil {
 		if msg.CommissionRate.GT(math.LegacyOneDec()) || msg.CommissionRate.IsNegative() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
@@ -298,6 +307,69 @@ func (msg MsgBeginRedelegate) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgPriorityRedelegate creates a new MsgPriorityRedelegate instance.
+//
+//nolint:interfacer
+func NewMsgPriorityRedelegate(
+	authority string, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Coin, reason PriorityReason,
+) *MsgPriorityRedelegate {
+	return &MsgPriorityRedelegate{
+		Authority:           authority,
+		DelegatorAddress:    delAddr.String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		ValidatorDstAddress: valDstAddr.String(),
+		Amount:              amount,
+		Reason:              reason,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgPriorityRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgPriorityRedelegate) Type() string { return TypeMsgPriorityRedelegate }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgPriorityRedelegate) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgPriorityRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPriorityRedelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination validator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	if msg.Reason != PriorityReasonJailed && msg.Reason != PriorityReasonTombstoned {
+		return ErrInvalidPriorityReason.Wrapf("%s", msg.Reason)
+	}
+
+	return nil
+}
+
 // NewMsgUndelegate creates a new MsgUndelegate instance.
 //
 //nolint:interfacer
@@ -401,6 +473,44 @@ func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgAttestValidatorIdentity creates a new MsgAttestValidatorIdentity
+// instance.
+//
+//nolint:interfacer
+func NewMsgAttestValidatorIdentity(valAddr sdk.ValAddress, credentialHash []byte) *MsgAttestValidatorIdentity {
+	return &MsgAttestValidatorIdentity{
+		ValidatorAddress: valAddr.String(),
+		CredentialHash:   credentialHash,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAttestValidatorIdentity) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAttestValidatorIdentity) Type() string { return TypeMsgAttestValidatorIdentity }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAttestValidatorIdentity) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAttestValidatorIdentity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAttestValidatorIdentity) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return ValidateCredentialHash(msg.CredentialHash)
+}
+
 // GetSignBytes returns the raw bytes for a MsgUpdateParams message that
 // the expected signer needs to sign.
 func (m *MsgUpdateParams) GetSignBytes() []byte {
@@ -96,24 +96,29 @@ func TestMsgCreateValidator(t *testing.T) {
 
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
+	maxDelegations, negativeMaxDelegations := math.NewInt(10), math.NewInt(-1)
+
 	tests := []struct {
 		name, moniker, identity, website, securityContact, details string
 		validatorAddr                                              sdk.ValAddress
 		expectPass                                                 bool
 		minSelfDelegation                                          math.Int
+		maxDelegations                                             *math.Int
 	}{
-		{"basic good", "a", "b", "c", "d", "e", valAddr1, true, math.OneInt()},
-		{"partial description", "", "", "c", "", "", valAddr1, true, math.OneInt()},
-		{"empty description", "", "", "", "", "", valAddr1, false, math.OneInt()},
-		{"empty address", "a", "b", "c", "d", "e", emptyAddr, false, math.OneInt()},
-		{"nil int", "a", "b", "c", "d", "e", emptyAddr, false, math.Int{}},
+		{"basic good", "a", "b", "c", "d", "e", valAddr1, true, math.OneInt(), nil},
+		{"partial description", "", "", "c", "", "", valAddr1, true, math.OneInt(), nil},
+		{"empty description", "", "", "", "", "", valAddr1, false, math.OneInt(), nil},
+		{"empty address", "a", "b", "c", "d", "e", emptyAddr, false, math.OneInt(), nil},
+		{"nil int", "a", "b", "c", "d", "e", emptyAddr, false, math.Int{}, nil},
+		{"max delegations", "a", "b", "c", "d", "e", valAddr1, true, math.OneInt(), &maxDelegations},
+		{"negative max delegations", "a", "b", "c", "d", "e", valAddr1, false, math.OneInt(), &negativeMaxDelegations},
 	}
 
 	for _, tc := range tests {
 		description := types.NewDescription(tc.moniker, tc.identity, tc.website, tc.securityContact, tc.details)
 		newRate := math.LegacyZeroDec()
 
-		msg := types.NewMsgEditValidator(tc.validatorAddr, description, &newRate, &tc.minSelfDelegation)
+		msg := types.NewMsgEditValidator(tc.validatorAddr, description, &newRate, &tc.minSelfDelegation, tc.maxDelegations)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -177,6 +182,64 @@ func TestMsgBeginRedelegate(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgPriorityRedelegate
+func TestMsgPriorityRedelegate(t *testing.T) {
+	authority := sdk.AccAddress(valAddr3).String()
+	tests := []struct {
+		name             string
+		authority        string
+		delegatorAddr    sdk.AccAddress
+		validatorSrcAddr sdk.ValAddress
+		validatorDstAddr sdk.ValAddress
+		amount           sdk.Coin
+		reason           types.PriorityReason
+		expectPass       bool
+	}{
+		{"jailed", authority, sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonJailed, true},
+		{"tombstoned", authority, sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonTombstoned, true},
+		{"unspecified reason", authority, sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonUnspecified, false},
+		{"unknown reason", authority, sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReason(3), false},
+		{"zero amount", authority, sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), types.PriorityReasonJailed, false},
+		{"empty authority", "", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonJailed, false},
+		{"empty delegator", authority, sdk.AccAddress(emptyAddr), valAddr1, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonJailed, false},
+		{"empty source validator", authority, sdk.AccAddress(valAddr1), emptyAddr, valAddr3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonJailed, false},
+		{"empty destination validator", authority, sdk.AccAddress(valAddr1), valAddr2, emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), types.PriorityReasonJailed, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgPriorityRedelegate(tc.authority, tc.delegatorAddr, tc.validatorSrcAddr, tc.validatorDstAddr, tc.amount, tc.reason)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgAttestValidatorIdentity(t *testing.T) {
+	tests := []struct {
+		name           string
+		validatorAddr  sdk.ValAddress
+		credentialHash []byte
+		expectPass     bool
+	}{
+		{"basic good", valAddr1, make([]byte, 32), true},
+		{"empty validator", emptyAddr, make([]byte, 32), false},
+		{"empty credential hash", valAddr1, nil, false},
+		{"short credential hash", valAddr1, make([]byte, 20), false},
+		{"long credential hash", valAddr1, make([]byte, 64), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgAttestValidatorIdentity(tc.validatorAddr, tc.credentialHash)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgUnbond
 func TestMsgUndelegate(t *testing.T) {
 	tests := []struct {
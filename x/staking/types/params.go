@@ -30,20 +30,43 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeCooldown is one week, the minimum duration between
+	// two changes of the commission rate of a validator.
+	DefaultCommissionChangeCooldown time.Duration = time.Hour * 24 * 7
 )
 
 // DefaultMinCommissionRate is set to 0%
 var DefaultMinCommissionRate = math.LegacyZeroDec()
 
+// DefaultSlashRedistribution disables the redistribution of the slashed tokens.
+var DefaultSlashRedistribution = false
+
+// DefaultAbsoluteMaxDelegations does not limit the number of delegations a
+// validator can accept.
+var DefaultAbsoluteMaxDelegations uint64
+
+// DefaultBurnFraction is set to 0%, all the slashed tokens being redistributed
+// when the redistribution is enabled.
+var DefaultBurnFraction = math.LegacyZeroDec()
+
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate sdk.Dec, slashRedistribution bool, burnFraction sdk.Dec, absoluteMaxDelegations uint64,
+	commissionChangeCooldown time.Duration,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:            unbondingTime,
+		MaxValidators:            maxValidators,
+		MaxEntries:               maxEntries,
+		HistoricalEntries:        historicalEntries,
+		BondDenom:                bondDenom,
+		MinCommissionRate:        minCommissionRate,
+		SlashRedistribution:      slashRedistribution,
+		BurnFraction:             burnFraction,
+		AbsoluteMaxDelegations:   absoluteMaxDelegations,
+		CommissionChangeCooldown: commissionChangeCooldown,
 	}
 }
 
@@ -56,6 +79,10 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultSlashRedistribution,
+		DefaultBurnFraction,
+		DefaultAbsoluteMaxDelegations,
+		DefaultCommissionChangeCooldown,
 	)
 }
 
@@ -111,6 +138,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBurnFraction(p.BurnFraction); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeCooldown(p.CommissionChangeCooldown); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -127,6 +162,19 @@ func validateUnbondingTime(i interface{}) error {
 	return nil
 }
 
+func validateCommissionChangeCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change cooldown cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
 func validateMaxValidators(i interface{}) error {
 	v, ok := i.(uint32)
 	if !ok {
@@ -210,3 +258,22 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateBurnFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("burn fraction cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("burn fraction cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("burn fraction cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
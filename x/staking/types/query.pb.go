@@ -1391,6 +1391,100 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryValidatorCredentialRequest is request type for the
+// Query/ValidatorCredential RPC method.
+type QueryValidatorCredentialRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorCredentialRequest) Reset()         { *m = QueryValidatorCredentialRequest{} }
+func (m *QueryValidatorCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCredentialRequest) ProtoMessage()    {}
+func (*QueryValidatorCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryValidatorCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCredentialRequest.Merge(m, src)
+}
+func (m *QueryValidatorCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorCredentialRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorCredentialRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorCredentialResponse is response type for the
+// Query/ValidatorCredential RPC method.
+type QueryValidatorCredentialResponse struct {
+	// credential defines the attested identity credential of the validator.
+	Credential ValidatorCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential"`
+}
+
+func (m *QueryValidatorCredentialResponse) Reset()         { *m = QueryValidatorCredentialResponse{} }
+func (m *QueryValidatorCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCredentialResponse) ProtoMessage()    {}
+func (*QueryValidatorCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryValidatorCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCredentialResponse.Merge(m, src)
+}
+func (m *QueryValidatorCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorCredentialResponse) GetCredential() ValidatorCredential {
+	if m != nil {
+		return m.Credential
+	}
+	return ValidatorCredential{}
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1420,6 +1514,8 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorCredentialRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorCredentialRequest")
+	proto.RegisterType((*QueryValidatorCredentialResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorCredentialResponse")
 }
 
 func init() {
@@ -1427,93 +1523,97 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6f, 0x14, 0x65,
-	0x1b, 0xdf, 0xb7, 0xf4, 0x6b, 0xbe, 0x3e, 0x04, 0x02, 0xef, 0x2e, 0xb5, 0x0c, 0xb8, 0xbb, 0x4c,
-	0x08, 0xf6, 0x40, 0x77, 0x6c, 0x8b, 0x50, 0x31, 0x0a, 0x5b, 0x09, 0x8a, 0x10, 0x2c, 0x6b, 0x6c,
-	0xf0, 0x94, 0x66, 0xda, 0x19, 0x66, 0x27, 0x6c, 0x67, 0x96, 0x79, 0xa7, 0x0d, 0xa4, 0x69, 0x4c,
-	0xbc, 0x30, 0x5c, 0x19, 0x13, 0xef, 0x0d, 0x17, 0x5e, 0x18, 0xc5, 0xa4, 0x17, 0x98, 0xe8, 0x0d,
-	0x97, 0x86, 0x0b, 0x63, 0x88, 0x06, 0xa3, 0x37, 0x68, 0x5a, 0x13, 0xbd, 0xf1, 0x3f, 0x30, 0xc6,
-	0xec, 0xcc, 0x33, 0xa7, 0xce, 0x71, 0xb7, 0xdb, 0xa4, 0xdc, 0xc0, 0xee, 0x3b, 0xcf, 0xe1, 0xf7,
-	0x7b, 0x0e, 0xef, 0x3c, 0xcf, 0x16, 0xf8, 0x05, 0x9d, 0x2d, 0xea, 0x4c, 0x60, 0xa6, 0x78, 0x5d,
-	0xd5, 0x14, 0x61, 0x79, 0x7c, 0x5e, 0x36, 0xc5, 0x71, 0xe1, 0xc6, 0x92, 0x6c, 0xdc, 0xaa, 0x34,
-	0x0d, 0xdd, 0xd4, 0xe9, 0x80, 0x2d, 0x53, 0x41, 0x99, 0x0a, 0xca, 0x70, 0x23, 0xa8, 0x3b, 0x2f,
-	0x32, 0xd9, 0x56, 0x70, 0xd5, 0x9b, 0xa2, 0xa2, 0x6a, 0xa2, 0xa9, 0xea, 0x9a, 0x6d, 0x83, 0x2b,
-	0x28, 0xba, 0xa2, 0x5b, 0x1f, 0x85, 0xd6, 0x27, 0x3c, 0x3d, 0xac, 0xe8, 0xba, 0xd2, 0x90, 0x05,
-	0xb1, 0xa9, 0x0a, 0xa2, 0xa6, 0xe9, 0xa6, 0xa5, 0xc2, 0xf0, 0xe9, 0xd1, 0x18, 0x6c, 0x0e, 0x0e,
-	0x5b, 0xea, 0xa0, 0x2d, 0x35, 0x67, 0x1b, 0x47, 0xa8, 0xf6, 0xa3, 0x43, 0x68, 0xc0, 0xc1, 0xe6,
-	0x67, 0xc5, 0xed, 0x17, 0x17, 0x55, 0x4d, 0x17, 0xac, 0x7f, 0xed, 0x23, 0xfe, 0x26, 0x0c, 0x5c,
-	0x69, 0x49, 0xcc, 0x8a, 0x0d, 0x55, 0x12, 0x4d, 0xdd, 0x60, 0x35, 0xf9, 0xc6, 0x92, 0xcc, 0x4c,
-	0x3a, 0x00, 0x7d, 0xcc, 0x14, 0xcd, 0x25, 0x36, 0x48, 0xca, 0x64, 0xa8, 0xbf, 0x86, 0xdf, 0xe8,
-	0x79, 0x00, 0x8f, 0xea, 0x60, 0x4f, 0x99, 0x0c, 0xed, 0x9e, 0x38, 0x56, 0x41, 0x10, 0xad, 0xb8,
-	0x54, 0x6c, 0x97, 0x08, 0xbd, 0x32, 0x23, 0x2a, 0x32, 0xda, 0xac, 0xf9, 0x34, 0xf9, 0x35, 0x02,
-	0x4f, 0x85, 0x5c, 0xb3, 0xa6, 0xae, 0x31, 0x99, 0x5e, 0x02, 0x58, 0x76, 0x4f, 0x07, 0x49, 0x79,
-	0xd7, 0xd0, 0xee, 0x89, 0x23, 0x95, 0xe8, 0x9c, 0x54, 0x5c, 0xfd, 0xe9, 0xfe, 0x07, 0x8f, 0x4b,
-	0xb9, 0xcf, 0xff, 0x5c, 0x1b, 0x21, 0x35, 0x9f, 0x3e, 0x7d, 0x25, 0x02, 0xf1, 0x33, 0xa9, 0x88,
-	0x6d, 0x28, 0x01, 0xc8, 0x57, 0xe1, 0x40, 0x10, 0xb1, 0x13, 0xab, 0x33, 0xb0, 0xd7, 0xf5, 0x37,
-	0x27, 0x4a, 0x92, 0x61, 0xc7, 0x6c, 0x7a, 0xf0, 0xc7, 0x7b, 0x63, 0x05, 0x74, 0x54, 0x95, 0x24,
-	0x43, 0x66, 0xec, 0x0d, 0xd3, 0x50, 0x35, 0xa5, 0xb6, 0xc7, 0x95, 0x6f, 0x9d, 0xf3, 0xd2, 0xe6,
-	0x34, 0xb8, 0xa1, 0x78, 0x0d, 0xfa, 0x5d, 0x51, 0xcb, 0x6a, 0xbb, 0x91, 0xf0, 0xd4, 0xf9, 0x2f,
-	0x09, 0x94, 0x83, 0x6e, 0xce, 0xc9, 0x0d, 0x59, 0xb1, 0x2b, 0xb0, 0x5b, 0x5c, 0xba, 0x56, 0x20,
-	0x7f, 0x13, 0x38, 0x92, 0x80, 0x16, 0xe3, 0xf3, 0x3e, 0x14, 0x24, 0xf7, 0x78, 0xce, 0xc0, 0x63,
-	0xa7, 0x68, 0x46, 0xe2, 0x42, 0xe5, 0x99, 0x72, 0x2c, 0x4d, 0x97, 0x5b, 0x31, 0xfb, 0xe2, 0xb7,
-	0x52, 0x3e, 0xfc, 0x8c, 0xd9, 0xa1, 0xcc, 0x4b, 0xe1, 0x27, 0xdd, 0xab, 0xae, 0x7b, 0x04, 0x86,
-	0x83, 0x7c, 0xdf, 0xd4, 0xe6, 0x75, 0x4d, 0x52, 0x35, 0x65, 0x27, 0xa7, 0xe9, 0x31, 0x81, 0x91,
-	0x2c, 0xb0, 0x31, 0x5f, 0x0a, 0xe4, 0x97, 0x9c, 0xe7, 0xa1, 0x74, 0x8d, 0xc6, 0xa5, 0x2b, 0xc2,
-	0xa4, 0xbf, 0xc6, 0xa9, 0x6b, 0x72, 0x1b, 0xf2, 0xf2, 0x19, 0xc1, 0xe6, 0xf4, 0xd7, 0x85, 0x9b,
-	0x04, 0x2c, 0x89, 0xcc, 0x49, 0x70, 0xe5, 0xad, 0x24, 0x84, 0xb3, 0xd8, 0xd3, 0x56, 0x16, 0x4f,
-	0xff, 0xff, 0xf6, 0x9d, 0x52, 0xee, 0xaf, 0x3b, 0xa5, 0x1c, 0xbf, 0x8c, 0xd7, 0x69, 0xb8, 0x7a,
-	0xe9, 0x3b, 0x90, 0x8f, 0xe8, 0x11, 0xbc, 0x4d, 0xda, 0x68, 0x91, 0x1a, 0x0d, 0x37, 0x00, 0xff,
-	0x15, 0x81, 0x92, 0xe5, 0x38, 0x22, 0x47, 0x3b, 0x31, 0x4e, 0x06, 0xde, 0x81, 0x91, 0x70, 0x31,
-	0x60, 0x97, 0xa1, 0xcf, 0xae, 0x28, 0x8c, 0x51, 0xa7, 0x75, 0x89, 0x56, 0xf8, 0xaf, 0x9d, 0x8b,
-	0xf7, 0x9c, 0xc3, 0x2a, 0xba, 0xa3, 0xb7, 0x16, 0xa4, 0x2e, 0x75, 0xb4, 0x2f, 0x56, 0x3f, 0x3b,
-	0x57, 0x70, 0x34, 0x6e, 0x8c, 0x56, 0xbd, 0x6b, 0x57, 0xb0, 0x2f, 0x74, 0xdb, 0x7b, 0xd7, 0xde,
-	0x77, 0xee, 0x5a, 0x97, 0x58, 0xca, 0x5d, 0xbb, 0xd3, 0x32, 0xe3, 0xde, 0xba, 0x29, 0x04, 0x9e,
-	0xd8, 0x5b, 0xf7, 0x7e, 0x0f, 0x1c, 0xb4, 0x08, 0xd6, 0x64, 0x69, 0x5b, 0x32, 0x42, 0x99, 0xb1,
-	0x30, 0xd7, 0xe6, 0xa5, 0xb2, 0x8f, 0x19, 0x0b, 0xb3, 0x9b, 0xde, 0xa2, 0x54, 0x62, 0xe6, 0x66,
-	0x3b, 0xbb, 0xd2, 0xec, 0x48, 0xcc, 0x9c, 0x4d, 0x78, 0x1b, 0xf7, 0x76, 0xa1, 0x42, 0x1e, 0x11,
-	0xe0, 0xa2, 0x02, 0x88, 0x15, 0xa1, 0xc1, 0x80, 0x21, 0x27, 0xb4, 0xed, 0xf1, 0xb8, 0xa2, 0xf0,
-	0x9b, 0x8b, 0x6a, 0xdc, 0x03, 0x86, 0xbc, 0xdd, 0x63, 0x52, 0x29, 0x58, 0xf9, 0xe1, 0xdd, 0x65,
-	0x07, 0x36, 0xec, 0xb7, 0xa1, 0x57, 0xc0, 0x93, 0xb3, 0xf7, 0xdc, 0x25, 0x50, 0x8c, 0xc1, 0xbe,
-	0x13, 0xdf, 0xf0, 0x8b, 0xb1, 0x05, 0xb2, 0x2d, 0x5b, 0xd5, 0x09, 0xec, 0xb3, 0x57, 0x55, 0x66,
-	0xea, 0x86, 0xba, 0x20, 0x36, 0x2e, 0x68, 0xd7, 0x74, 0xdf, 0x1a, 0x5d, 0x97, 0x55, 0xa5, 0x6e,
-	0x5a, 0x6e, 0x76, 0xd5, 0xf0, 0x1b, 0xff, 0x16, 0x1c, 0x8a, 0xd4, 0x42, 0x80, 0xa7, 0xa1, 0xb7,
-	0xae, 0x32, 0x13, 0xb1, 0x1d, 0x8b, 0xc3, 0xb6, 0x49, 0xdb, 0xd2, 0xe1, 0x29, 0xec, 0xb3, 0x4c,
-	0xcf, 0xe8, 0x7a, 0x03, 0x61, 0xf0, 0x33, 0xb0, 0xdf, 0x77, 0x86, 0x4e, 0x5e, 0x80, 0xde, 0xa6,
-	0xae, 0x37, 0xd0, 0xc9, 0xe1, 0x38, 0x27, 0x2d, 0x1d, 0x3f, 0x77, 0x4b, 0x89, 0x2f, 0x00, 0xb5,
-	0x2d, 0x8a, 0x86, 0xb8, 0xe8, 0x74, 0x1e, 0x7f, 0x15, 0xf2, 0x81, 0x53, 0xf4, 0x54, 0x85, 0xbe,
-	0xa6, 0x75, 0x82, 0xbe, 0x8a, 0xb1, 0xbe, 0x2c, 0xa9, 0xc0, 0x0c, 0x65, 0x2b, 0x4e, 0xac, 0x0d,
-	0xc0, 0xff, 0x2c, 0xd3, 0xf4, 0x53, 0x02, 0xe0, 0x35, 0x0f, 0xad, 0xc4, 0xd9, 0x8a, 0xfe, 0x61,
-	0x83, 0x13, 0x32, 0xcb, 0xe3, 0x84, 0x2b, 0xdc, 0x6e, 0x01, 0xf9, 0xe0, 0xa7, 0x3f, 0x3e, 0xe9,
-	0x39, 0x4a, 0x79, 0x21, 0xe6, 0x27, 0x1a, 0x5f, 0xe3, 0xdd, 0x25, 0xd0, 0xef, 0xda, 0xa1, 0x63,
-	0xd9, 0xfc, 0x39, 0xf0, 0x2a, 0x59, 0xc5, 0x11, 0xdd, 0x59, 0x0f, 0xdd, 0x73, 0x74, 0x32, 0x1d,
-	0x9d, 0xb0, 0x12, 0xec, 0xb3, 0x55, 0xfa, 0x2b, 0x81, 0x42, 0xd4, 0x8e, 0x4d, 0xa7, 0xb2, 0x41,
-	0x09, 0x4f, 0x4c, 0xdc, 0xf3, 0x1d, 0x68, 0x22, 0x9f, 0x4b, 0x1e, 0x9f, 0x2a, 0x3d, 0xd3, 0x01,
-	0x1f, 0xc1, 0xf7, 0xba, 0xa3, 0xff, 0x12, 0x78, 0x3a, 0x71, 0x31, 0xa5, 0xd5, 0x6c, 0x50, 0x13,
-	0xe6, 0x43, 0x6e, 0x7a, 0x2b, 0x26, 0x90, 0xf6, 0xac, 0x47, 0xfb, 0x22, 0xbd, 0xd0, 0x09, 0x6d,
-	0x6f, 0xc0, 0xf3, 0x07, 0xe0, 0x7b, 0x02, 0xe0, 0xf9, 0x4b, 0x69, 0x96, 0xd0, 0xe6, 0x96, 0xd2,
-	0x2c, 0xe1, 0x11, 0x9e, 0x7f, 0xcf, 0xe3, 0x51, 0xa3, 0x33, 0x5b, 0x4c, 0x9f, 0xb0, 0x12, 0x7c,
-	0xa9, 0xac, 0xd2, 0x7f, 0x08, 0xe4, 0x23, 0xe2, 0x48, 0x4f, 0x25, 0xe2, 0x8c, 0x5f, 0x4d, 0xb9,
-	0xa9, 0xf6, 0x15, 0x91, 0xa9, 0xe1, 0x31, 0x55, 0xa8, 0xdc, 0x6d, 0xa6, 0x91, 0xe9, 0xa4, 0x3f,
-	0x10, 0x28, 0x44, 0xed, 0x62, 0x29, 0xad, 0x9a, 0xb0, 0x76, 0xa6, 0xb4, 0x6a, 0xd2, 0xe2, 0xc7,
-	0x57, 0xbd, 0x08, 0x9c, 0xa4, 0x27, 0xe2, 0x22, 0x90, 0x98, 0xcf, 0x56, 0x7f, 0x26, 0xae, 0x30,
-	0x29, 0xfd, 0x99, 0x65, 0x7f, 0x4b, 0xe9, 0xcf, 0x4c, 0x1b, 0x54, 0xc6, 0xfe, 0x74, 0xe9, 0x65,
-	0x4c, 0x28, 0xa3, 0xdf, 0x11, 0xd8, 0x13, 0x98, 0xd0, 0xe9, 0x78, 0x22, 0xda, 0xa8, 0x75, 0x88,
-	0x9b, 0x68, 0x47, 0x05, 0x09, 0x5d, 0xf6, 0x08, 0xbd, 0x4c, 0xab, 0x9d, 0x10, 0x32, 0x02, 0xb0,
-	0x1f, 0x11, 0xc8, 0x47, 0xcc, 0xb6, 0x29, 0x9d, 0x19, 0x3f, 0xc4, 0x73, 0x53, 0xed, 0x2b, 0x22,
-	0xb5, 0x8b, 0x1e, 0xb5, 0xb3, 0xf4, 0xa5, 0x4e, 0xa8, 0xf9, 0x5e, 0xe6, 0x1b, 0x04, 0x68, 0xd8,
-	0x19, 0x3d, 0xd9, 0x26, 0x3a, 0x87, 0xd5, 0xa9, 0xb6, 0xf5, 0x90, 0xd4, 0xbb, 0x1e, 0xa9, 0x2b,
-	0xf4, 0xf5, 0xad, 0x91, 0x0a, 0xcf, 0x00, 0xdf, 0x10, 0xd8, 0x1b, 0x1c, 0x26, 0x69, 0x72, 0x51,
-	0x45, 0x4e, 0xbb, 0xdc, 0x64, 0x5b, 0x3a, 0xc8, 0xec, 0x45, 0x8f, 0xd9, 0x04, 0x7d, 0x36, 0x8e,
-	0x59, 0xdd, 0x55, 0x9e, 0x53, 0xb5, 0x6b, 0xba, 0xb0, 0x62, 0x0f, 0xd2, 0xab, 0xf4, 0x43, 0x02,
-	0xbd, 0xad, 0x11, 0x95, 0x0e, 0x25, 0x3a, 0xf7, 0x4d, 0xc3, 0xdc, 0x70, 0x06, 0x49, 0x04, 0x37,
-	0xec, 0x81, 0x2b, 0xd2, 0xc3, 0x71, 0xe0, 0x5a, 0x13, 0x31, 0xfd, 0x88, 0x40, 0x9f, 0x3d, 0xbf,
-	0xd2, 0x91, 0x64, 0x07, 0xfe, 0x91, 0x99, 0x1b, 0xcd, 0x24, 0x8b, 0x70, 0x46, 0x3d, 0x38, 0x65,
-	0x5a, 0x8c, 0x85, 0x63, 0x4f, 0xd1, 0xe7, 0x1f, 0xac, 0x17, 0xc9, 0xc3, 0xf5, 0x22, 0xf9, 0x7d,
-	0xbd, 0x48, 0x3e, 0xde, 0x28, 0xe6, 0x1e, 0x6e, 0x14, 0x73, 0xbf, 0x6c, 0x14, 0x73, 0x6f, 0x1f,
-	0x57, 0x54, 0xb3, 0xbe, 0x34, 0x5f, 0x59, 0xd0, 0x17, 0x1d, 0x1b, 0xf6, 0x7f, 0x63, 0x4c, 0xba,
-	0x2e, 0xdc, 0x74, 0x0d, 0x9a, 0xb7, 0x9a, 0x32, 0x9b, 0xef, 0xb3, 0xfe, 0x56, 0x38, 0xf9, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xb1, 0x80, 0x02, 0x3a, 0x1d, 0x00, 0x00,
+	// 1430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6f, 0x14, 0x55,
+	0x1b, 0xdf, 0x53, 0xfa, 0x36, 0x6f, 0x1f, 0x02, 0x81, 0xb3, 0x4b, 0x59, 0x06, 0xdc, 0x5d, 0x26,
+	0x44, 0x4b, 0x4b, 0x77, 0x6c, 0x8b, 0x50, 0x31, 0x0a, 0x5b, 0x08, 0x8a, 0x10, 0x2c, 0x6b, 0xdc,
+	0xe0, 0x57, 0x9a, 0xd9, 0x9d, 0x61, 0x76, 0xc2, 0x76, 0x66, 0x99, 0x33, 0x6d, 0xc0, 0xa6, 0x31,
+	0xf1, 0xc2, 0xf4, 0xca, 0x98, 0x78, 0x6f, 0xb8, 0xf0, 0xc2, 0x28, 0x26, 0x5c, 0x60, 0xa2, 0x37,
+	0x5c, 0x1a, 0x2e, 0x8c, 0x21, 0x1a, 0x8c, 0xde, 0xa0, 0x69, 0x4d, 0xf4, 0xc6, 0xff, 0xc0, 0x18,
+	0x33, 0x33, 0x67, 0xbe, 0x3a, 0xdf, 0xdb, 0x6d, 0x52, 0x6e, 0x60, 0xf7, 0xcc, 0xf3, 0xf1, 0xfb,
+	0x3d, 0x1f, 0x67, 0x9e, 0x67, 0x0b, 0x6c, 0x4b, 0x25, 0x0b, 0x2a, 0xe1, 0x88, 0xce, 0x5f, 0x97,
+	0x15, 0x89, 0x5b, 0x9a, 0x6c, 0x8a, 0x3a, 0x3f, 0xc9, 0xdd, 0x58, 0x14, 0xb5, 0x5b, 0xd5, 0xae,
+	0xa6, 0xea, 0x2a, 0x1e, 0xb1, 0x64, 0xaa, 0x54, 0xa6, 0x4a, 0x65, 0x98, 0x31, 0xaa, 0xdb, 0xe4,
+	0x89, 0x68, 0x29, 0x38, 0xea, 0x5d, 0x5e, 0x92, 0x15, 0x5e, 0x97, 0x55, 0xc5, 0xb2, 0xc1, 0x14,
+	0x24, 0x55, 0x52, 0xcd, 0x8f, 0x9c, 0xf1, 0x89, 0x9e, 0x1e, 0x92, 0x54, 0x55, 0xea, 0x88, 0x1c,
+	0xdf, 0x95, 0x39, 0x5e, 0x51, 0x54, 0xdd, 0x54, 0x21, 0xf4, 0xe9, 0x91, 0x08, 0x6c, 0x36, 0x0e,
+	0x4b, 0xea, 0x80, 0x25, 0x35, 0x6f, 0x19, 0xa7, 0x50, 0xad, 0x47, 0x07, 0xa9, 0x01, 0x1b, 0x9b,
+	0x97, 0x15, 0xb3, 0x97, 0x5f, 0x90, 0x15, 0x95, 0x33, 0xff, 0xb5, 0x8e, 0xd8, 0x9b, 0x30, 0x72,
+	0xc5, 0x90, 0x68, 0xf0, 0x1d, 0x59, 0xe0, 0x75, 0x55, 0x23, 0x75, 0xf1, 0xc6, 0xa2, 0x48, 0x74,
+	0x3c, 0x02, 0x43, 0x44, 0xe7, 0xf5, 0x45, 0x52, 0x44, 0x15, 0x34, 0x3a, 0x5c, 0xa7, 0xdf, 0xf0,
+	0x79, 0x00, 0x97, 0x6a, 0x71, 0xa0, 0x82, 0x46, 0x77, 0x4e, 0x3d, 0x5d, 0xa5, 0x20, 0x8c, 0xb8,
+	0x54, 0x2d, 0x97, 0x14, 0x7a, 0x75, 0x8e, 0x97, 0x44, 0x6a, 0xb3, 0xee, 0xd1, 0x64, 0xef, 0x22,
+	0xd8, 0x1f, 0x70, 0x4d, 0xba, 0xaa, 0x42, 0x44, 0x7c, 0x09, 0x60, 0xc9, 0x39, 0x2d, 0xa2, 0xca,
+	0x8e, 0xd1, 0x9d, 0x53, 0x87, 0xab, 0xe1, 0x39, 0xa9, 0x3a, 0xfa, 0xb3, 0xc3, 0x0f, 0x1e, 0x97,
+	0x73, 0x9f, 0xff, 0x79, 0x77, 0x0c, 0xd5, 0x3d, 0xfa, 0xf8, 0xe5, 0x10, 0xc4, 0xcf, 0x24, 0x22,
+	0xb6, 0xa0, 0xf8, 0x20, 0x5f, 0x85, 0x7d, 0x7e, 0xc4, 0x76, 0xac, 0x4e, 0xc3, 0x6e, 0xc7, 0xdf,
+	0x3c, 0x2f, 0x08, 0x9a, 0x15, 0xb3, 0xd9, 0xe2, 0x8f, 0xf7, 0x26, 0x0a, 0xd4, 0x51, 0x4d, 0x10,
+	0x34, 0x91, 0x90, 0xd7, 0x75, 0x4d, 0x56, 0xa4, 0xfa, 0x2e, 0x47, 0xde, 0x38, 0x67, 0x85, 0x8d,
+	0x69, 0x70, 0x42, 0xf1, 0x2a, 0x0c, 0x3b, 0xa2, 0xa6, 0xd5, 0xac, 0x91, 0x70, 0xd5, 0xd9, 0x2f,
+	0x11, 0x54, 0xfc, 0x6e, 0xce, 0x89, 0x1d, 0x51, 0xb2, 0x2a, 0xb0, 0x5f, 0x5c, 0xfa, 0x56, 0x20,
+	0x7f, 0x23, 0x38, 0x1c, 0x83, 0x96, 0xc6, 0xe7, 0x7d, 0x28, 0x08, 0xce, 0xf1, 0xbc, 0x46, 0x8f,
+	0xed, 0xa2, 0x19, 0x8b, 0x0a, 0x95, 0x6b, 0xca, 0xb6, 0x34, 0x5b, 0x31, 0x62, 0xf6, 0xc5, 0x6f,
+	0xe5, 0x7c, 0xf0, 0x19, 0xb1, 0x42, 0x99, 0x17, 0x82, 0x4f, 0xfa, 0x57, 0x5d, 0xf7, 0x10, 0x1c,
+	0xf5, 0xf3, 0x7d, 0x43, 0x69, 0xaa, 0x8a, 0x20, 0x2b, 0xd2, 0x76, 0x4e, 0xd3, 0x63, 0x04, 0x63,
+	0x69, 0x60, 0xd3, 0x7c, 0x49, 0x90, 0x5f, 0xb4, 0x9f, 0x07, 0xd2, 0x35, 0x1e, 0x95, 0xae, 0x10,
+	0x93, 0xde, 0x1a, 0xc7, 0x8e, 0xc9, 0x2d, 0xc8, 0xcb, 0x67, 0x88, 0x36, 0xa7, 0xb7, 0x2e, 0x9c,
+	0x24, 0xd0, 0x92, 0x48, 0x9d, 0x04, 0x47, 0xde, 0x4c, 0x42, 0x30, 0x8b, 0x03, 0x99, 0xb2, 0x78,
+	0xea, 0xff, 0xab, 0xb7, 0xcb, 0xb9, 0xbf, 0x6e, 0x97, 0x73, 0xec, 0x12, 0xec, 0x0f, 0xa0, 0xa4,
+	0x31, 0x7f, 0x1b, 0xf2, 0x21, 0x3d, 0x42, 0x6f, 0x93, 0x0c, 0x2d, 0x52, 0xc7, 0xc1, 0x06, 0x60,
+	0xbf, 0x42, 0x50, 0x36, 0x1d, 0x87, 0xe4, 0x68, 0x3b, 0xc6, 0x49, 0x83, 0x4a, 0x34, 0x5c, 0x1a,
+	0xb0, 0xcb, 0x30, 0x64, 0x55, 0x14, 0x8d, 0x51, 0xaf, 0x75, 0x49, 0xad, 0xb0, 0x5f, 0xdb, 0x17,
+	0xef, 0x39, 0x9b, 0x55, 0x78, 0x47, 0x6f, 0x2e, 0x48, 0x7d, 0xea, 0x68, 0x4f, 0xac, 0x7e, 0xb6,
+	0xaf, 0xe0, 0x70, 0xdc, 0x34, 0x5a, 0xed, 0xbe, 0x5d, 0xc1, 0x9e, 0xd0, 0x6d, 0xed, 0x5d, 0x7b,
+	0xdf, 0xbe, 0x6b, 0x1d, 0x62, 0x09, 0x77, 0xed, 0x76, 0xcb, 0x8c, 0x73, 0xeb, 0x26, 0x10, 0x78,
+	0x62, 0x6f, 0xdd, 0xfb, 0x03, 0x70, 0xc0, 0x24, 0x58, 0x17, 0x85, 0x2d, 0xc9, 0x08, 0x26, 0x5a,
+	0x6b, 0x3e, 0xe3, 0xa5, 0xb2, 0x87, 0x68, 0xad, 0xc6, 0x86, 0xb7, 0x28, 0x16, 0x88, 0xbe, 0xd1,
+	0xce, 0x8e, 0x24, 0x3b, 0x02, 0xd1, 0x1b, 0x31, 0x6f, 0xe3, 0xc1, 0x3e, 0x54, 0xc8, 0x23, 0x04,
+	0x4c, 0x58, 0x00, 0x69, 0x45, 0x28, 0x30, 0xa2, 0x89, 0x31, 0x6d, 0x7b, 0x2c, 0xaa, 0x28, 0xbc,
+	0xe6, 0xc2, 0x1a, 0x77, 0x9f, 0x26, 0x6e, 0xf5, 0x98, 0x54, 0xf6, 0x57, 0x7e, 0x70, 0x77, 0xd9,
+	0x86, 0x0d, 0xfb, 0x6d, 0xe0, 0x15, 0xf0, 0xe4, 0xec, 0x3d, 0x77, 0x10, 0x94, 0x22, 0xb0, 0x6f,
+	0xc7, 0x37, 0xfc, 0x42, 0x64, 0x81, 0x6c, 0xc9, 0x56, 0x75, 0x9c, 0xf6, 0xd9, 0x2b, 0x32, 0xd1,
+	0x55, 0x4d, 0x6e, 0xf1, 0x9d, 0x0b, 0xca, 0x35, 0xd5, 0xb3, 0x46, 0xb7, 0x45, 0x59, 0x6a, 0xeb,
+	0xa6, 0x9b, 0x1d, 0x75, 0xfa, 0x8d, 0x7d, 0x13, 0x0e, 0x86, 0x6a, 0x51, 0x80, 0xa7, 0x60, 0xb0,
+	0x2d, 0x13, 0xbd, 0x88, 0xfc, 0xa5, 0xb7, 0x11, 0xdb, 0x06, 0x6d, 0x53, 0x87, 0xc5, 0xb0, 0xc7,
+	0x34, 0x3d, 0xa7, 0xaa, 0x1d, 0x0a, 0x83, 0x9d, 0x83, 0xbd, 0x9e, 0x33, 0xea, 0xe4, 0x05, 0x18,
+	0xec, 0xaa, 0x6a, 0x87, 0x3a, 0x39, 0x14, 0xe5, 0xc4, 0xd0, 0xf1, 0x72, 0x37, 0x95, 0xd8, 0x02,
+	0x60, 0xcb, 0x22, 0xaf, 0xf1, 0x0b, 0x76, 0xe7, 0xb1, 0x57, 0x21, 0xef, 0x3b, 0xa5, 0x9e, 0x6a,
+	0x30, 0xd4, 0x35, 0x4f, 0xa8, 0xaf, 0x52, 0xa4, 0x2f, 0x53, 0xca, 0x37, 0x43, 0x59, 0x8a, 0x6c,
+	0x93, 0x66, 0xd5, 0x49, 0xc7, 0x59, 0xe3, 0xa2, 0x51, 0x74, 0x99, 0xef, 0xf4, 0x6d, 0x0d, 0x7f,
+	0x0f, 0x2a, 0xd1, 0x3e, 0x28, 0x95, 0x06, 0x40, 0xcb, 0x39, 0x4d, 0x9a, 0x0f, 0x43, 0x0c, 0xf9,
+	0xba, 0xd5, 0xb5, 0x34, 0xb5, 0x5a, 0x84, 0xff, 0x99, 0xce, 0xf1, 0xa7, 0x08, 0xa0, 0xe1, 0xb6,
+	0x71, 0x35, 0xca, 0x78, 0xf8, 0x0f, 0x37, 0x0c, 0x97, 0x5a, 0x9e, 0x4e, 0xf0, 0xdc, 0xaa, 0x01,
+	0xe6, 0x83, 0x9f, 0xfe, 0xf8, 0x64, 0xe0, 0x08, 0x66, 0xb9, 0x88, 0x9f, 0xa0, 0x3c, 0x17, 0xcb,
+	0x1d, 0x04, 0xc3, 0x8e, 0x1d, 0x3c, 0x91, 0xce, 0x9f, 0x0d, 0xaf, 0x9a, 0x56, 0x9c, 0xa2, 0x3b,
+	0xe3, 0xa2, 0x7b, 0x0e, 0x4f, 0x27, 0xa3, 0xe3, 0x96, 0xfd, 0x35, 0xb0, 0x82, 0x7f, 0x45, 0x50,
+	0x08, 0xfb, 0x0d, 0x01, 0xcf, 0xa4, 0x83, 0x12, 0x9c, 0x08, 0x99, 0xe7, 0x7b, 0xd0, 0xa4, 0x7c,
+	0x2e, 0xb9, 0x7c, 0x6a, 0xf8, 0x74, 0x0f, 0x7c, 0x38, 0xcf, 0xeb, 0x1c, 0xff, 0x8b, 0xe0, 0xa9,
+	0xd8, 0xc5, 0x1b, 0xd7, 0xd2, 0x41, 0x8d, 0x99, 0x7f, 0x99, 0xd9, 0xcd, 0x98, 0xa0, 0xb4, 0x1b,
+	0x2e, 0xed, 0x8b, 0xf8, 0x42, 0x2f, 0xb4, 0xdd, 0x01, 0xd6, 0x1b, 0x80, 0xef, 0x11, 0x80, 0xeb,
+	0x2f, 0xa1, 0x59, 0x02, 0x9b, 0x29, 0xc3, 0xa5, 0x96, 0xa7, 0x3c, 0xde, 0x75, 0x79, 0xd4, 0xf1,
+	0xdc, 0x26, 0xd3, 0xc7, 0x2d, 0xfb, 0x5f, 0x9a, 0x2b, 0xf8, 0x1f, 0x04, 0xf9, 0x90, 0x38, 0xe2,
+	0x93, 0xb1, 0x38, 0xa3, 0x57, 0x6f, 0x66, 0x26, 0xbb, 0x22, 0x65, 0xaa, 0xb9, 0x4c, 0x25, 0x2c,
+	0xf6, 0x9b, 0x69, 0x68, 0x3a, 0xf1, 0x0f, 0x08, 0x0a, 0x61, 0xbb, 0x66, 0x42, 0xab, 0xc6, 0xac,
+	0xd5, 0x09, 0xad, 0x1a, 0xb7, 0xd8, 0xb2, 0x35, 0x37, 0x02, 0x27, 0xf0, 0xf1, 0xa8, 0x08, 0xc4,
+	0xe6, 0xd3, 0xe8, 0xcf, 0xd8, 0x15, 0x2d, 0xa1, 0x3f, 0xd3, 0xec, 0xa7, 0x09, 0xfd, 0x99, 0x6a,
+	0x43, 0x4c, 0xd9, 0x9f, 0x0e, 0xbd, 0x94, 0x09, 0x25, 0xf8, 0x3b, 0x04, 0xbb, 0x7c, 0x1b, 0x08,
+	0x9e, 0x8c, 0x45, 0x1b, 0xb6, 0xee, 0x31, 0x53, 0x59, 0x54, 0x28, 0xa1, 0xcb, 0x2e, 0xa1, 0xb3,
+	0xb8, 0xd6, 0x0b, 0x21, 0xcd, 0x07, 0xfb, 0x11, 0x82, 0x7c, 0xc8, 0xec, 0x9e, 0xd0, 0x99, 0xd1,
+	0x4b, 0x0a, 0x33, 0x93, 0x5d, 0x91, 0x52, 0xbb, 0xe8, 0x52, 0x3b, 0x83, 0x5f, 0xea, 0x85, 0x9a,
+	0xe7, 0x65, 0xbe, 0x8e, 0x00, 0x07, 0x9d, 0xe1, 0x13, 0x19, 0xd1, 0xd9, 0xac, 0x4e, 0x66, 0xd6,
+	0xa3, 0xa4, 0xde, 0x71, 0x49, 0x5d, 0xc1, 0xaf, 0x6d, 0x8e, 0x54, 0x70, 0x06, 0xf8, 0x06, 0xc1,
+	0x6e, 0xff, 0xb0, 0x8c, 0xe3, 0x8b, 0x2a, 0x74, 0x9a, 0x67, 0xa6, 0x33, 0xe9, 0x50, 0x66, 0x2f,
+	0xba, 0xcc, 0xa6, 0xf0, 0xb3, 0x51, 0xcc, 0xda, 0x8e, 0xf2, 0xbc, 0xac, 0x5c, 0x53, 0xb9, 0x65,
+	0x6b, 0x51, 0x58, 0xc1, 0x1f, 0x22, 0x18, 0x34, 0x46, 0x70, 0x3c, 0x1a, 0xeb, 0xdc, 0x33, 0xed,
+	0x33, 0x47, 0x53, 0x48, 0x52, 0x70, 0x47, 0x5d, 0x70, 0x25, 0x7c, 0x28, 0x0a, 0x9c, 0x31, 0xf1,
+	0xe3, 0x8f, 0x10, 0x0c, 0x59, 0xf3, 0x39, 0x1e, 0x8b, 0x77, 0xe0, 0x5d, 0x09, 0x98, 0xf1, 0x54,
+	0xb2, 0x14, 0xce, 0xb8, 0x0b, 0xa7, 0x82, 0x4b, 0x91, 0x70, 0x2c, 0x14, 0x46, 0x4b, 0x86, 0x4c,
+	0xd8, 0x09, 0x2d, 0x19, 0xbd, 0x40, 0x30, 0x33, 0xd9, 0x15, 0x33, 0xb5, 0x64, 0xdc, 0xcb, 0xd2,
+	0x5d, 0x05, 0x66, 0xcf, 0x3f, 0x58, 0x2b, 0xa1, 0x87, 0x6b, 0x25, 0xf4, 0xfb, 0x5a, 0x09, 0x7d,
+	0xbc, 0x5e, 0xca, 0x3d, 0x5c, 0x2f, 0xe5, 0x7e, 0x59, 0x2f, 0xe5, 0xde, 0x3a, 0x26, 0xc9, 0x7a,
+	0x7b, 0xb1, 0x59, 0x6d, 0xa9, 0x0b, 0xb6, 0x0f, 0xeb, 0xbf, 0x09, 0x22, 0x5c, 0xe7, 0x6e, 0x3a,
+	0x0e, 0xf5, 0x5b, 0x5d, 0x91, 0x34, 0x87, 0xcc, 0xbf, 0xf1, 0x4e, 0xff, 0x37, 0x00, 0x5d, 0xc2,
+	0x41, 0x86, 0xf2, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1581,6 +1681,9 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorCredential queries the attested identity credential of a
+	// validator.
+	ValidatorCredential(ctx context.Context, in *QueryValidatorCredentialRequest, opts ...grpc.CallOption) (*QueryValidatorCredentialResponse, error)
 }
 
 type queryClient struct {
@@ -1717,6 +1820,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorCredential(ctx context.Context, in *QueryValidatorCredentialRequest, opts ...grpc.CallOption) (*QueryValidatorCredentialResponse, error) {
+	out := new(QueryValidatorCredentialResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1772,6 +1884,9 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorCredential queries the attested identity credential of a
+	// validator.
+	ValidatorCredential(context.Context, *QueryValidatorCredentialRequest) (*QueryValidatorCredentialResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1820,6 +1935,9 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorCredential(ctx context.Context, req *QueryValidatorCredentialRequest) (*QueryValidatorCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCredential not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2077,6 +2195,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCredential(ctx, req.(*QueryValidatorCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2137,6 +2273,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorCredential",
+			Handler:    _Query_ValidatorCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3243,6 +3383,69 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3689,6 +3892,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credential.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6605,6 +6832,171 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -78,6 +78,39 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{0}
 }
 
+// PriorityReason enumerates the reasons for which a redelegation can skip the
+// redelegation queue.
+type PriorityReason int32
+
+const (
+	// UNSPECIFIED defines an invalid priority reason.
+	PriorityReasonUnspecified PriorityReason = 0
+	// JAILED defines a redelegation away from a jailed validator.
+	PriorityReasonJailed PriorityReason = 1
+	// TOMBSTONED defines a redelegation away from a tombstoned validator.
+	PriorityReasonTombstoned PriorityReason = 2
+)
+
+var PriorityReason_name = map[int32]string{
+	0: "PRIORITY_REASON_UNSPECIFIED",
+	1: "PRIORITY_REASON_JAILED",
+	2: "PRIORITY_REASON_TOMBSTONED",
+}
+
+var PriorityReason_value = map[string]int32{
+	"PRIORITY_REASON_UNSPECIFIED": 0,
+	"PRIORITY_REASON_JAILED":      1,
+	"PRIORITY_REASON_TOMBSTONED":  2,
+}
+
+func (x PriorityReason) String() string {
+	return proto.EnumName(PriorityReason_name, int32(x))
+}
+
+func (PriorityReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+
 // Infraction indicates the infraction a validator commited.
 type Infraction int32
 
@@ -107,7 +140,7 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -374,6 +407,9 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifing an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// max_delegations is the maximum number of delegations accepted by the
+	// validator, 0 meaning up to the absolute_max_delegations param.
+	MaxDelegations uint64 `protobuf:"varint,14,opt,name=max_delegations,json=maxDelegations,proto3" json:"max_delegations,omitempty"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -922,6 +958,18 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// slash_redistribution defines whether the slashed tokens which are not
+	// burnt are redistributed to the delegators of the other validators.
+	SlashRedistribution bool `protobuf:"varint,7,opt,name=slash_redistribution,json=slashRedistribution,proto3" json:"slash_redistribution,omitempty"`
+	// burn_fraction is the fraction of the slashed tokens which is burnt when
+	// slash_redistribution is enabled.
+	BurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=burn_fraction,json=burnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_fraction"`
+	// absolute_max_delegations is the maximum number of delegations a validator
+	// can accept, 0 meaning unlimited.
+	AbsoluteMaxDelegations uint64 `protobuf:"varint,9,opt,name=absolute_max_delegations,json=absoluteMaxDelegations,proto3" json:"absolute_max_delegations,omitempty"`
+	// commission_change_cooldown is the minimum duration between two changes of
+	// the commission rate of a validator.
+	CommissionChangeCooldown time.Duration `protobuf:"bytes,10,opt,name=commission_change_cooldown,json=commissionChangeCooldown,proto3,stdduration" json:"commission_change_cooldown"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -991,6 +1039,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetSlashRedistribution() bool {
+	if m != nil {
+		return m.SlashRedistribution
+	}
+	return false
+}
+
+func (m *Params) GetAbsoluteMaxDelegations() uint64 {
+	if m != nil {
+		return m.AbsoluteMaxDelegations
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeCooldown() time.Duration {
+	if m != nil {
+		return m.CommissionChangeCooldown
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1187,6 +1256,73 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ValidatorCredential links a validator to a W3C Verifiable Credential about
+// its operator, kept off-chain, so that identity registries can verify the
+// validator metadata.
+type ValidatorCredential struct {
+	// validator_address is the address of the attested validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// credential_hash is the SHA-256 digest of the JSON-LD credential document.
+	CredentialHash []byte `protobuf:"bytes,2,opt,name=credential_hash,json=credentialHash,proto3" json:"credential_hash,omitempty"`
+	// signing_key_fingerprint is the SHA-256 digest of the public key which
+	// signed the attestation.
+	SigningKeyFingerprint []byte `protobuf:"bytes,3,opt,name=signing_key_fingerprint,json=signingKeyFingerprint,proto3" json:"signing_key_fingerprint,omitempty"`
+}
+
+func (m *ValidatorCredential) Reset()         { *m = ValidatorCredential{} }
+func (m *ValidatorCredential) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCredential) ProtoMessage()    {}
+func (*ValidatorCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *ValidatorCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCredential.Merge(m, src)
+}
+func (m *ValidatorCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCredential proto.InternalMessageInfo
+
+func (m *ValidatorCredential) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorCredential) GetCredentialHash() []byte {
+	if m != nil {
+		return m.CredentialHash
+	}
+	return nil
+}
+
+func (m *ValidatorCredential) GetSigningKeyFingerprint() []byte {
+	if m != nil {
+		return m.SigningKeyFingerprint
+	}
+	return nil
+}
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
 type ValidatorUpdates struct {
@@ -1197,7 +1333,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1235,6 +1371,7 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.PriorityReason", PriorityReason_name, PriorityReason_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
@@ -1256,6 +1393,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ValidatorCredential)(nil), "cosmos.staking.v1beta1.ValidatorCredential")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 }
 
@@ -1264,125 +1402,142 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1890 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xf7, 0xb3, 0x5d, 0x27, 0xf9, 0x9c, 0xc4, 0xce, 0xec, 0x76, 0xd7, 0xeb, 0x85, 0xd8, 0x75,
-	0x4b, 0x9b, 0xae, 0xba, 0x0e, 0x1b, 0x24, 0x0e, 0xa1, 0x02, 0xad, 0xe3, 0x6c, 0xd7, 0x65, 0x9b,
-	0x58, 0xcf, 0x49, 0x4a, 0x41, 0xe8, 0x69, 0xfc, 0xde, 0xc4, 0x19, 0x62, 0xcf, 0xb3, 0xde, 0x8c,
-	0xb7, 0xb1, 0xc4, 0x01, 0x71, 0x5a, 0xe5, 0x80, 0x2a, 0x71, 0xa0, 0x97, 0x95, 0x56, 0x82, 0x03,
-	0x87, 0x22, 0xf5, 0x50, 0x71, 0xe1, 0x80, 0x38, 0x20, 0x15, 0x2e, 0xac, 0x7a, 0x42, 0x08, 0x05,
-	0xb4, 0x7b, 0x28, 0xe2, 0x84, 0xb8, 0x83, 0xd0, 0xcc, 0x9b, 0xf7, 0xc7, 0x76, 0xb2, 0x9b, 0xac,
-	0x42, 0x55, 0xa9, 0x97, 0xc4, 0x33, 0xf3, 0x7d, 0xbf, 0x37, 0xdf, 0xef, 0xfb, 0x33, 0xf3, 0x0d,
-	0xbc, 0x64, 0xbb, 0xbc, 0xe7, 0xf2, 0x65, 0x2e, 0xf0, 0x3e, 0x65, 0x9d, 0xe5, 0xbb, 0x37, 0xda,
-	0x44, 0xe0, 0x1b, 0xc1, 0xb8, 0xda, 0xf7, 0x5c, 0xe1, 0xa2, 0x4b, 0xbe, 0x54, 0x35, 0x98, 0xd5,
-	0x52, 0xc5, 0x8b, 0x1d, 0xb7, 0xe3, 0x2a, 0x91, 0x65, 0xf9, 0xcb, 0x97, 0x2e, 0x5e, 0xe9, 0xb8,
-	0x6e, 0xa7, 0x4b, 0x96, 0xd5, 0xa8, 0x3d, 0xd8, 0x5d, 0xc6, 0x6c, 0xa8, 0x97, 0x16, 0xc7, 0x97,
-	0x9c, 0x81, 0x87, 0x05, 0x75, 0x99, 0x5e, 0x2f, 0x8d, 0xaf, 0x0b, 0xda, 0x23, 0x5c, 0xe0, 0x5e,
-	0x3f, 0xc0, 0xf6, 0x77, 0x62, 0xf9, 0x1f, 0xd5, 0xdb, 0xd2, 0xd8, 0xda, 0x94, 0x36, 0xe6, 0x24,
-	0xb4, 0xc3, 0x76, 0x69, 0x80, 0xbd, 0x80, 0x7b, 0x94, 0xb9, 0xcb, 0xea, 0xaf, 0x9e, 0xfa, 0x92,
-	0x20, 0xcc, 0x21, 0x5e, 0x8f, 0x32, 0xb1, 0x2c, 0x86, 0x7d, 0xc2, 0xfd, 0xbf, 0x7a, 0xf5, 0x6a,
-	0x6c, 0x15, 0xb7, 0x6d, 0x1a, 0x5f, 0xac, 0xfc, 0xd4, 0x80, 0xf9, 0xdb, 0x94, 0x0b, 0xd7, 0xa3,
-	0x36, 0xee, 0x36, 0xd8, 0xae, 0x8b, 0xbe, 0x01, 0x99, 0x3d, 0x82, 0x1d, 0xe2, 0x15, 0x8c, 0xb2,
-	0xb1, 0x94, 0x5d, 0x29, 0x54, 0x23, 0x80, 0xaa, 0xaf, 0x7b, 0x5b, 0xad, 0xd7, 0x66, 0x3e, 0x3e,
-	0x2a, 0x25, 0x7e, 0xf9, 0xe9, 0x87, 0xd7, 0x0c, 0x53, 0xab, 0xa0, 0x3a, 0x64, 0xee, 0xe2, 0x2e,
-	0x27, 0xa2, 0x90, 0x2c, 0xa7, 0x96, 0xb2, 0x2b, 0x2f, 0x54, 0x8f, 0xe7, 0xbc, 0xba, 0x83, 0xbb,
-	0xd4, 0xc1, 0xc2, 0x1d, 0x45, 0xf1, 0x75, 0x2b, 0x1f, 0x24, 0x21, 0xb7, 0xe6, 0xf6, 0x7a, 0x94,
-	0x73, 0xea, 0x32, 0x13, 0x0b, 0xc2, 0x51, 0x13, 0xd2, 0x1e, 0x16, 0x44, 0x6d, 0x6a, 0xa6, 0xf6,
-	0xba, 0x54, 0xfa, 0xcb, 0x51, 0xe9, 0xe5, 0x0e, 0x15, 0x7b, 0x83, 0x76, 0xd5, 0x76, 0x7b, 0x9a,
-	0x46, 0xfd, 0xef, 0x3a, 0x77, 0xf6, 0xb5, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xd1, 0x75, 0xd0, 0x1b,
-	0xa9, 0x13, 0xdb, 0x54, 0x48, 0xe8, 0x6d, 0x98, 0xee, 0xe1, 0x03, 0x4b, 0xa1, 0x26, 0xcf, 0x01,
-	0x75, 0xaa, 0x87, 0x0f, 0xe4, 0x5e, 0x91, 0x03, 0x39, 0x09, 0x6c, 0xef, 0x61, 0xd6, 0x21, 0x3e,
-	0x7e, 0xea, 0x1c, 0xf0, 0xe7, 0x7a, 0xf8, 0x60, 0x4d, 0x61, 0xca, 0xaf, 0xac, 0x4e, 0xbf, 0xff,
-	0xa0, 0x94, 0xf8, 0xc7, 0x83, 0x92, 0x51, 0xf9, 0xbd, 0x01, 0x10, 0xd1, 0x85, 0x30, 0xe4, 0xed,
-	0x70, 0xa4, 0x3e, 0xcf, 0xb5, 0x2b, 0x5f, 0x39, 0xc9, 0x1b, 0x63, 0x64, 0xd7, 0xe6, 0xe4, 0x46,
-	0x1f, 0x1e, 0x95, 0x0c, 0xdf, 0x2f, 0x39, 0x7b, 0xcc, 0x19, 0x6f, 0x42, 0x76, 0xd0, 0x77, 0xb0,
-	0x20, 0x96, 0x8c, 0x6c, 0xc5, 0x5e, 0x76, 0xa5, 0x58, 0xf5, 0xc3, 0xbe, 0x1a, 0x84, 0x7d, 0x75,
-	0x2b, 0x08, 0x7b, 0x1f, 0xf0, 0xbd, 0xbf, 0x05, 0x80, 0xe0, 0x6b, 0xcb, 0xf5, 0x98, 0x1d, 0x1f,
-	0x18, 0x90, 0xad, 0x13, 0x6e, 0x7b, 0xb4, 0x2f, 0x93, 0x09, 0x15, 0x60, 0xaa, 0xe7, 0x32, 0xba,
-	0xaf, 0x43, 0x71, 0xc6, 0x0c, 0x86, 0xa8, 0x08, 0xd3, 0xd4, 0x21, 0x4c, 0x50, 0x31, 0xf4, 0x5d,
-	0x67, 0x86, 0x63, 0xa9, 0xf5, 0x2e, 0x69, 0x73, 0x1a, 0xb0, 0x6e, 0x06, 0x43, 0xf4, 0x2a, 0xe4,
-	0x39, 0xb1, 0x07, 0x1e, 0x15, 0x43, 0xcb, 0x76, 0x99, 0xc0, 0xb6, 0x28, 0xa4, 0x95, 0x48, 0x2e,
-	0x98, 0x5f, 0xf3, 0xa7, 0x25, 0x88, 0x43, 0x04, 0xa6, 0x5d, 0x5e, 0x78, 0xce, 0x07, 0xd1, 0xc3,
-	0xd8, 0x76, 0x7f, 0x33, 0x05, 0x33, 0x61, 0x18, 0xa3, 0x35, 0xc8, 0xbb, 0x7d, 0xe2, 0xc9, 0xdf,
-	0x16, 0x76, 0x1c, 0x8f, 0x70, 0xae, 0x63, 0xb5, 0xf0, 0xc9, 0x47, 0xd7, 0x2f, 0x6a, 0xe2, 0x6f,
-	0xfa, 0x2b, 0x2d, 0xe1, 0x51, 0xd6, 0x31, 0x73, 0x81, 0x86, 0x9e, 0x46, 0xef, 0x48, 0xd7, 0x31,
-	0x4e, 0x18, 0x1f, 0x70, 0xab, 0x3f, 0x68, 0xef, 0x93, 0xa1, 0x26, 0xf7, 0xe2, 0x04, 0xb9, 0x37,
-	0xd9, 0xb0, 0x56, 0xf8, 0x63, 0x04, 0x6d, 0x7b, 0xc3, 0xbe, 0x70, 0xab, 0xcd, 0x41, 0xfb, 0xdb,
-	0x64, 0x28, 0x5d, 0xa6, 0x71, 0x9a, 0x0a, 0x06, 0x5d, 0x82, 0xcc, 0x0f, 0x30, 0xed, 0x12, 0x47,
-	0xb1, 0x32, 0x6d, 0xea, 0x11, 0x5a, 0x85, 0x0c, 0x17, 0x58, 0x0c, 0xb8, 0xa2, 0x62, 0x7e, 0xa5,
-	0x72, 0x52, 0x8c, 0xd4, 0x5c, 0xe6, 0xb4, 0x94, 0xa4, 0xa9, 0x35, 0xd0, 0x16, 0x64, 0x84, 0xbb,
-	0x4f, 0x98, 0x26, 0xe9, 0x4c, 0xf1, 0xdd, 0x60, 0x22, 0x16, 0xdf, 0x0d, 0x26, 0x4c, 0x8d, 0x85,
-	0x3a, 0x90, 0x77, 0x48, 0x97, 0x74, 0x14, 0x95, 0x7c, 0x0f, 0x7b, 0x84, 0x17, 0x32, 0xe7, 0x90,
-	0x3f, 0xb9, 0x10, 0xb5, 0xa5, 0x40, 0x51, 0x13, 0xb2, 0x4e, 0x14, 0x6e, 0x85, 0x29, 0x45, 0xf4,
-	0x8b, 0x27, 0xd9, 0x1f, 0x8b, 0xcc, 0x78, 0xcd, 0x8a, 0x43, 0xc8, 0x08, 0x1b, 0xb0, 0xb6, 0xcb,
-	0x1c, 0xca, 0x3a, 0xd6, 0x1e, 0xa1, 0x9d, 0x3d, 0x51, 0x98, 0x2e, 0x1b, 0x4b, 0x29, 0x33, 0x17,
-	0xce, 0xdf, 0x56, 0xd3, 0xa8, 0x09, 0xf3, 0x91, 0xa8, 0xca, 0xa2, 0x99, 0xb3, 0x66, 0xd1, 0x5c,
-	0x08, 0x20, 0x45, 0xd0, 0x5b, 0x00, 0x51, 0x9e, 0x16, 0x40, 0xa1, 0x55, 0x9e, 0x9e, 0xf1, 0x71,
-	0x63, 0x62, 0x00, 0xa8, 0x0b, 0x17, 0x7a, 0x94, 0x59, 0x9c, 0x74, 0x77, 0x2d, 0xcd, 0x9c, 0xc4,
-	0xcd, 0x9e, 0x83, 0xa7, 0x17, 0x7a, 0x94, 0xb5, 0x48, 0x77, 0xb7, 0x1e, 0xc2, 0xa2, 0xd7, 0xe1,
-	0x6a, 0x44, 0x87, 0xcb, 0xac, 0x3d, 0xb7, 0xeb, 0x58, 0x1e, 0xd9, 0xb5, 0x6c, 0x77, 0xc0, 0x44,
-	0x61, 0x56, 0x91, 0x78, 0x39, 0x14, 0xd9, 0x64, 0xb7, 0xdd, 0xae, 0x63, 0x92, 0xdd, 0x35, 0xb9,
-	0x8c, 0x5e, 0x84, 0x88, 0x0b, 0x8b, 0x3a, 0xbc, 0x30, 0x57, 0x4e, 0x2d, 0xa5, 0xcd, 0xd9, 0x70,
-	0xb2, 0xe1, 0xf0, 0xd5, 0xd9, 0x7b, 0x0f, 0x4a, 0x09, 0x9d, 0xbd, 0x89, 0x4a, 0x13, 0x66, 0x77,
-	0x70, 0x57, 0x27, 0x1e, 0xe1, 0xe8, 0xeb, 0x30, 0x83, 0x83, 0x41, 0xc1, 0x28, 0xa7, 0x9e, 0x98,
-	0xb8, 0x91, 0xa8, 0x5f, 0x0f, 0x7e, 0xf4, 0xd7, 0xb2, 0x51, 0xf9, 0x85, 0x01, 0x99, 0xfa, 0x4e,
-	0x13, 0x53, 0x0f, 0xad, 0xc3, 0x42, 0x14, 0xc2, 0xa7, 0xad, 0x06, 0x51, 0xd4, 0x07, 0xe5, 0x60,
-	0x1d, 0x16, 0xee, 0x06, 0x05, 0x26, 0x84, 0x49, 0x3e, 0x0d, 0x26, 0x54, 0xd1, 0xf3, 0x63, 0x86,
-	0xbf, 0x09, 0x53, 0xfe, 0x2e, 0x39, 0xfa, 0x16, 0x3c, 0xd7, 0x97, 0x3f, 0x94, 0xbd, 0xd9, 0x95,
-	0xc5, 0x13, 0x43, 0x5f, 0xc9, 0xc7, 0x03, 0xc5, 0xd7, 0xab, 0xfc, 0xc7, 0x00, 0xa8, 0xef, 0xec,
-	0x6c, 0x79, 0xb4, 0xdf, 0x25, 0xe2, 0xbc, 0xcc, 0xbe, 0x03, 0xcf, 0x47, 0x66, 0x73, 0xcf, 0x3e,
-	0xb5, 0xe9, 0x17, 0x42, 0xb5, 0x96, 0x67, 0x1f, 0x8b, 0xe6, 0x70, 0x11, 0xa2, 0xa5, 0x4e, 0x8d,
-	0x56, 0xe7, 0xe2, 0x78, 0x2e, 0xbf, 0x03, 0xd9, 0xc8, 0x7c, 0x8e, 0x1a, 0x30, 0x2d, 0xf4, 0x6f,
-	0x4d, 0x69, 0xe5, 0x64, 0x4a, 0x03, 0xb5, 0x38, 0xad, 0xa1, 0x7a, 0xe5, 0xbf, 0x92, 0xd9, 0x28,
-	0x3d, 0x3e, 0x57, 0x01, 0x25, 0xeb, 0xbe, 0xae, 0xcb, 0xe7, 0x71, 0xaf, 0xd1, 0x58, 0x63, 0xd4,
-	0xde, 0x4b, 0xc2, 0x85, 0xed, 0x20, 0x7d, 0x3f, 0xb7, 0x4c, 0x6c, 0xc3, 0x14, 0x61, 0xc2, 0xa3,
-	0x8a, 0x0a, 0xe9, 0xf0, 0xaf, 0x9e, 0xe4, 0xf0, 0x63, 0x6c, 0x59, 0x67, 0xc2, 0x1b, 0xc6, 0xdd,
-	0x1f, 0x60, 0x8d, 0x51, 0xf1, 0xbb, 0x14, 0x14, 0x4e, 0x52, 0x47, 0xaf, 0x40, 0xce, 0xf6, 0x88,
-	0x9a, 0x08, 0x4e, 0x1c, 0x43, 0x15, 0xcb, 0xf9, 0x60, 0x5a, 0x1f, 0x38, 0x26, 0xc8, 0x6b, 0x9c,
-	0x8c, 0x2e, 0x29, 0xfa, 0x6c, 0xf7, 0xb6, 0xf9, 0x08, 0x41, 0x1d, 0x39, 0x04, 0x72, 0x94, 0x51,
-	0x41, 0x71, 0xd7, 0x6a, 0xe3, 0x2e, 0x66, 0xf6, 0xb3, 0xdc, 0x74, 0x27, 0xcf, 0x87, 0x79, 0x0d,
-	0x5a, 0xf3, 0x31, 0xd1, 0x0e, 0x4c, 0x05, 0xf0, 0xe9, 0x73, 0x80, 0x0f, 0xc0, 0xd0, 0x0b, 0x30,
-	0x1b, 0x3f, 0x36, 0xd4, 0x2d, 0x26, 0x6d, 0x66, 0x63, 0xa7, 0xc6, 0xd3, 0xce, 0xa5, 0xcc, 0x13,
-	0xcf, 0xa5, 0xd8, 0x65, 0xf1, 0xb7, 0x29, 0x58, 0x30, 0x89, 0xf3, 0x05, 0x74, 0xde, 0xf7, 0x00,
-	0xfc, 0x04, 0x97, 0xc5, 0xf7, 0x19, 0xfc, 0x37, 0x59, 0x30, 0x66, 0x7c, 0xbc, 0x3a, 0x17, 0x9f,
-	0xa5, 0x07, 0xff, 0x94, 0x84, 0xd9, 0xb8, 0x07, 0xbf, 0x00, 0xa7, 0x1d, 0xda, 0x88, 0xca, 0x5b,
-	0x5a, 0x95, 0xb7, 0x57, 0x4f, 0x2a, 0x6f, 0x13, 0xb1, 0x7d, 0x8a, 0xba, 0xf6, 0xb3, 0x14, 0x64,
-	0x9a, 0xd8, 0xc3, 0x3d, 0x8e, 0x36, 0x27, 0x6e, 0xc3, 0x7e, 0xc7, 0x7a, 0x65, 0x22, 0xbc, 0xeb,
-	0xfa, 0xa9, 0xc5, 0x8f, 0xee, 0xf7, 0x4f, 0xba, 0x0c, 0x7f, 0x05, 0xe6, 0x65, 0x0f, 0x1e, 0x1a,
-	0xe5, 0xd3, 0x39, 0xa7, 0x9a, 0xe8, 0xb0, 0x69, 0xe3, 0xa8, 0x04, 0x59, 0x29, 0x16, 0xd5, 0x70,
-	0x29, 0x03, 0x3d, 0x7c, 0xb0, 0xee, 0xcf, 0xa0, 0xeb, 0x80, 0xf6, 0xc2, 0xf7, 0x11, 0x2b, 0x22,
-	0x43, 0xca, 0x2d, 0x44, 0x2b, 0x81, 0xf8, 0x97, 0x01, 0xe4, 0x2e, 0x2c, 0x87, 0x30, 0xb7, 0xa7,
-	0x5b, 0xc7, 0x19, 0x39, 0x53, 0x97, 0x13, 0xe8, 0x87, 0xfe, 0x9d, 0x7a, 0xac, 0x3d, 0xd7, 0xdd,
-	0xcd, 0x9d, 0xb3, 0x25, 0xc5, 0xbf, 0x8f, 0x4a, 0xc5, 0x21, 0xee, 0x75, 0x57, 0x2b, 0xc7, 0x40,
-	0x56, 0xd4, 0x1d, 0x7b, 0xb4, 0xad, 0x5f, 0x5d, 0x0a, 0x62, 0xf9, 0xf0, 0xd3, 0x0f, 0xaf, 0x5d,
-	0x8d, 0x61, 0x1e, 0x84, 0x0f, 0x67, 0xbe, 0x3b, 0x2a, 0xbf, 0x32, 0x00, 0x45, 0x07, 0x8d, 0x49,
-	0x78, 0x5f, 0x76, 0x93, 0xb2, 0xc3, 0x88, 0x75, 0x02, 0xc6, 0x93, 0x3b, 0x8c, 0x48, 0x7f, 0xa4,
-	0xc3, 0x88, 0x25, 0xd0, 0x37, 0xa3, 0xb2, 0x9e, 0xd4, 0xde, 0xd6, 0x58, 0x6d, 0xcc, 0x49, 0xac,
-	0x55, 0xa1, 0x23, 0x10, 0x81, 0x52, 0x98, 0x9b, 0x89, 0xca, 0x91, 0x01, 0x57, 0x26, 0x22, 0x30,
-	0xdc, 0xb6, 0x0d, 0xc8, 0x8b, 0x2d, 0x2a, 0x2f, 0x0e, 0xf5, 0xf6, 0x9f, 0x2d, 0xa0, 0x17, 0xbc,
-	0x89, 0x52, 0xfe, 0x7f, 0x3a, 0xa3, 0x56, 0xd3, 0xaa, 0xf8, 0xfc, 0xc1, 0x80, 0x8b, 0xf1, 0x1d,
-	0x85, 0xb6, 0xb5, 0x60, 0x36, 0xbe, 0x17, 0x6d, 0xd5, 0x4b, 0xa7, 0xb1, 0x2a, 0x6e, 0xd0, 0x08,
-	0x88, 0xb4, 0x25, 0x88, 0x74, 0xff, 0x19, 0xef, 0xc6, 0xa9, 0x59, 0x0a, 0x36, 0x76, 0x6c, 0xfa,
-	0xa7, 0x95, 0xb3, 0x7e, 0x92, 0x84, 0x74, 0xd3, 0x75, 0xbb, 0xe8, 0xc7, 0x06, 0x2c, 0x30, 0x57,
-	0x58, 0x32, 0x3f, 0x88, 0x63, 0xe9, 0xa7, 0x04, 0xbf, 0x82, 0xee, 0x9c, 0x8d, 0xbd, 0x7f, 0x1e,
-	0x95, 0x26, 0xa1, 0x46, 0x29, 0xd5, 0x4f, 0x59, 0xcc, 0x15, 0x35, 0x25, 0xb4, 0xe5, 0xbf, 0x36,
-	0xbc, 0x0b, 0x73, 0xa3, 0xdf, 0xf7, 0xcb, 0xae, 0x79, 0xe6, 0xef, 0xcf, 0x3d, 0xf5, 0xdb, 0xb3,
-	0xed, 0xd8, 0x87, 0x57, 0xa7, 0xa5, 0x63, 0xff, 0x25, 0x9d, 0xfb, 0x0e, 0xe4, 0xc3, 0x92, 0xb4,
-	0xad, 0x1e, 0xc6, 0xe4, 0xfd, 0x74, 0xca, 0x7f, 0x23, 0x0b, 0x3a, 0x89, 0x72, 0xfc, 0x19, 0x16,
-	0xb7, 0x6d, 0x5a, 0x1d, 0xd3, 0x19, 0x61, 0x5c, 0xeb, 0x5e, 0xfb, 0xb5, 0x01, 0x10, 0x3d, 0xdc,
-	0xa0, 0xd7, 0xe0, 0x72, 0x6d, 0x73, 0xa3, 0x6e, 0xb5, 0xb6, 0x6e, 0x6e, 0x6d, 0xb7, 0xac, 0xed,
-	0x8d, 0x56, 0x73, 0x7d, 0xad, 0x71, 0xab, 0xb1, 0x5e, 0xcf, 0x27, 0x8a, 0xb9, 0xc3, 0xfb, 0xe5,
-	0xec, 0x36, 0xe3, 0x7d, 0x62, 0xd3, 0x5d, 0x4a, 0x1c, 0xf4, 0x32, 0x5c, 0x1c, 0x95, 0x96, 0xa3,
-	0xf5, 0x7a, 0xde, 0x28, 0xce, 0x1e, 0xde, 0x2f, 0x4f, 0xfb, 0x57, 0x52, 0xe2, 0xa0, 0x25, 0x78,
-	0x7e, 0x52, 0xae, 0xb1, 0xf1, 0x46, 0x3e, 0x59, 0x9c, 0x3b, 0xbc, 0x5f, 0x9e, 0x09, 0xef, 0xae,
-	0xa8, 0x02, 0x28, 0x2e, 0xa9, 0xf1, 0x52, 0x45, 0x38, 0xbc, 0x5f, 0xce, 0xf8, 0x6e, 0x29, 0xa6,
-	0xef, 0xfd, 0x7c, 0x31, 0x71, 0xed, 0xfb, 0x00, 0x0d, 0xb6, 0xeb, 0x61, 0x5b, 0x05, 0x64, 0x11,
-	0x2e, 0x35, 0x36, 0x6e, 0x99, 0x37, 0xd7, 0xb6, 0x1a, 0x9b, 0x1b, 0xa3, 0xdb, 0x1e, 0x5b, 0xab,
-	0x6f, 0x6e, 0xd7, 0xee, 0xac, 0x5b, 0xad, 0xc6, 0x1b, 0x1b, 0x79, 0x03, 0x5d, 0x86, 0x0b, 0x23,
-	0x6b, 0x6f, 0x6f, 0x6c, 0x35, 0xde, 0x5a, 0xcf, 0x27, 0x6b, 0xb7, 0x3e, 0x7e, 0xb4, 0x68, 0x3c,
-	0x7c, 0xb4, 0x68, 0xfc, 0xfd, 0xd1, 0xa2, 0xf1, 0xde, 0xe3, 0xc5, 0xc4, 0xc3, 0xc7, 0x8b, 0x89,
-	0x3f, 0x3f, 0x5e, 0x4c, 0x7c, 0xf7, 0xb5, 0x27, 0x3a, 0x3c, 0xaa, 0x94, 0xca, 0xf5, 0xed, 0x8c,
-	0x3a, 0x97, 0xbe, 0xf6, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x15, 0x6b, 0xa2, 0x81, 0x18,
+	// 2162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x5b, 0x49,
+	0x19, 0xcf, 0x4b, 0xbc, 0x4e, 0xf2, 0xd9, 0x49, 0x9c, 0x49, 0xda, 0xbe, 0xba, 0xbb, 0x89, 0xd7,
+	0xbb, 0xec, 0x66, 0xab, 0xad, 0x43, 0x0b, 0x5a, 0xa1, 0x50, 0x81, 0xe2, 0x38, 0xdd, 0xba, 0xdb,
+	0x26, 0xd6, 0xb3, 0x93, 0xa5, 0x20, 0xf4, 0x34, 0x7e, 0x6f, 0x6c, 0x3f, 0x62, 0xcf, 0xb3, 0xde,
+	0x8c, 0xdb, 0x58, 0xe2, 0x80, 0x38, 0x55, 0x39, 0xa0, 0x95, 0xb8, 0xec, 0x25, 0x52, 0x25, 0x38,
+	0x70, 0x58, 0xa4, 0x3d, 0xac, 0xb8, 0x22, 0x84, 0x90, 0x96, 0xbd, 0x50, 0xad, 0x38, 0x20, 0x84,
+	0x02, 0x6a, 0x91, 0x16, 0x71, 0x42, 0xdc, 0x41, 0x68, 0xe6, 0xcd, 0xfb, 0x63, 0x27, 0x69, 0x93,
+	0x12, 0xd0, 0x4a, 0x7b, 0x49, 0x3c, 0xf3, 0x7d, 0xdf, 0xef, 0xcd, 0xf7, 0x7f, 0xbe, 0x81, 0x57,
+	0x2d, 0x97, 0x75, 0x5c, 0xb6, 0xcc, 0x38, 0xde, 0x71, 0x68, 0x73, 0xf9, 0xde, 0xd5, 0x3a, 0xe1,
+	0xf8, 0x6a, 0xb0, 0x2e, 0x74, 0x3d, 0x97, 0xbb, 0xe8, 0xbc, 0xcf, 0x55, 0x08, 0x76, 0x15, 0x57,
+	0x76, 0xbe, 0xe9, 0x36, 0x5d, 0xc9, 0xb2, 0x2c, 0x7e, 0xf9, 0xdc, 0xd9, 0x8b, 0x4d, 0xd7, 0x6d,
+	0xb6, 0xc9, 0xb2, 0x5c, 0xd5, 0x7b, 0x8d, 0x65, 0x4c, 0xfb, 0x8a, 0xb4, 0x30, 0x4c, 0xb2, 0x7b,
+	0x1e, 0xe6, 0x8e, 0x4b, 0x15, 0x7d, 0x71, 0x98, 0xce, 0x9d, 0x0e, 0x61, 0x1c, 0x77, 0xba, 0x01,
+	0xb6, 0x7f, 0x12, 0xd3, 0xff, 0xa8, 0x3a, 0x96, 0xc2, 0x56, 0xaa, 0xd4, 0x31, 0x23, 0xa1, 0x1e,
+	0x96, 0xeb, 0x04, 0xd8, 0xb3, 0xb8, 0xe3, 0x50, 0x77, 0x59, 0xfe, 0x55, 0x5b, 0x2f, 0x72, 0x42,
+	0x6d, 0xe2, 0x75, 0x1c, 0xca, 0x97, 0x79, 0xbf, 0x4b, 0x98, 0xff, 0x57, 0x51, 0x2f, 0xc5, 0xa8,
+	0xb8, 0x6e, 0x39, 0x71, 0x62, 0xfe, 0xc7, 0x1a, 0x4c, 0xdf, 0x74, 0x18, 0x77, 0x3d, 0xc7, 0xc2,
+	0xed, 0x32, 0x6d, 0xb8, 0xe8, 0xeb, 0x90, 0x6c, 0x11, 0x6c, 0x13, 0x4f, 0xd7, 0x72, 0xda, 0x52,
+	0xea, 0x9a, 0x5e, 0x88, 0x00, 0x0a, 0xbe, 0xec, 0x4d, 0x49, 0x2f, 0x4e, 0x7e, 0x7c, 0xb0, 0x38,
+	0xf2, 0xb3, 0xcf, 0x3e, 0xbc, 0xac, 0x19, 0x4a, 0x04, 0x95, 0x20, 0x79, 0x0f, 0xb7, 0x19, 0xe1,
+	0xfa, 0x68, 0x6e, 0x6c, 0x29, 0x75, 0xed, 0xe5, 0xc2, 0xd1, 0x36, 0x2f, 0x6c, 0xe3, 0xb6, 0x63,
+	0x63, 0xee, 0x0e, 0xa2, 0xf8, 0xb2, 0xf9, 0x0f, 0x46, 0x61, 0x66, 0xcd, 0xed, 0x74, 0x1c, 0xc6,
+	0x1c, 0x97, 0x1a, 0x98, 0x13, 0x86, 0x2a, 0x90, 0xf0, 0x30, 0x27, 0xf2, 0x50, 0x93, 0xc5, 0xeb,
+	0x42, 0xe8, 0x8f, 0x07, 0x8b, 0xaf, 0x35, 0x1d, 0xde, 0xea, 0xd5, 0x0b, 0x96, 0xdb, 0x51, 0x66,
+	0x54, 0xff, 0xae, 0x30, 0x7b, 0x47, 0x69, 0x5a, 0x22, 0xd6, 0xa7, 0x1f, 0x5d, 0x01, 0x75, 0x90,
+	0x12, 0xb1, 0x0c, 0x89, 0x84, 0xde, 0x85, 0x89, 0x0e, 0xde, 0x35, 0x25, 0xea, 0xe8, 0x19, 0xa0,
+	0x8e, 0x77, 0xf0, 0xae, 0x38, 0x2b, 0xb2, 0x61, 0x46, 0x00, 0x5b, 0x2d, 0x4c, 0x9b, 0xc4, 0xc7,
+	0x1f, 0x3b, 0x03, 0xfc, 0xa9, 0x0e, 0xde, 0x5d, 0x93, 0x98, 0xe2, 0x2b, 0x2b, 0x13, 0xef, 0x3f,
+	0x5c, 0x1c, 0xf9, 0xdb, 0xc3, 0x45, 0x2d, 0xff, 0x1b, 0x0d, 0x20, 0x32, 0x17, 0xc2, 0x90, 0xb1,
+	0xc2, 0x95, 0xfc, 0x3c, 0x53, 0xae, 0x7c, 0xfd, 0x38, 0x6f, 0x0c, 0x19, 0xbb, 0x38, 0x25, 0x0e,
+	0xfa, 0xe8, 0x60, 0x51, 0xf3, 0xfd, 0x32, 0x63, 0x0d, 0x39, 0xe3, 0x16, 0xa4, 0x7a, 0x5d, 0x1b,
+	0x73, 0x62, 0x8a, 0xc8, 0x96, 0xd6, 0x4b, 0x5d, 0xcb, 0x16, 0xfc, 0xb0, 0x2f, 0x04, 0x61, 0x5f,
+	0xa8, 0x05, 0x61, 0xef, 0x03, 0xbe, 0xf7, 0xe7, 0x00, 0x10, 0x7c, 0x69, 0x41, 0x8f, 0xe9, 0xf1,
+	0x81, 0x06, 0xa9, 0x12, 0x61, 0x96, 0xe7, 0x74, 0x45, 0x32, 0x21, 0x1d, 0xc6, 0x3b, 0x2e, 0x75,
+	0x76, 0x54, 0x28, 0x4e, 0x1a, 0xc1, 0x12, 0x65, 0x61, 0xc2, 0xb1, 0x09, 0xe5, 0x0e, 0xef, 0xfb,
+	0xae, 0x33, 0xc2, 0xb5, 0x90, 0xba, 0x4f, 0xea, 0xcc, 0x09, 0xac, 0x6e, 0x04, 0x4b, 0xf4, 0x06,
+	0x64, 0x18, 0xb1, 0x7a, 0x9e, 0xc3, 0xfb, 0xa6, 0xe5, 0x52, 0x8e, 0x2d, 0xae, 0x27, 0x24, 0xcb,
+	0x4c, 0xb0, 0xbf, 0xe6, 0x6f, 0x0b, 0x10, 0x9b, 0x70, 0xec, 0xb4, 0x99, 0xfe, 0x82, 0x0f, 0xa2,
+	0x96, 0xb1, 0xe3, 0xfe, 0x75, 0x1c, 0x26, 0xc3, 0x30, 0x46, 0x6b, 0x90, 0x71, 0xbb, 0xc4, 0x13,
+	0xbf, 0x4d, 0x6c, 0xdb, 0x1e, 0x61, 0x4c, 0xc5, 0xaa, 0xfe, 0xe9, 0x47, 0x57, 0xe6, 0x95, 0xe1,
+	0x57, 0x7d, 0x4a, 0x95, 0x7b, 0x0e, 0x6d, 0x1a, 0x33, 0x81, 0x84, 0xda, 0x46, 0x77, 0x85, 0xeb,
+	0x28, 0x23, 0x94, 0xf5, 0x98, 0xd9, 0xed, 0xd5, 0x77, 0x48, 0x5f, 0x19, 0x77, 0xfe, 0x90, 0x71,
+	0x57, 0x69, 0xbf, 0xa8, 0x7f, 0x12, 0x41, 0x5b, 0x5e, 0xbf, 0xcb, 0xdd, 0x42, 0xa5, 0x57, 0x7f,
+	0x87, 0xf4, 0x8d, 0x99, 0x10, 0xa7, 0x22, 0x61, 0xd0, 0x79, 0x48, 0x7e, 0x0f, 0x3b, 0x6d, 0x62,
+	0x4b, 0xab, 0x4c, 0x18, 0x6a, 0x85, 0x56, 0x20, 0xc9, 0x38, 0xe6, 0x3d, 0x26, 0x4d, 0x31, 0x7d,
+	0x2d, 0x7f, 0x5c, 0x8c, 0x14, 0x5d, 0x6a, 0x57, 0x25, 0xa7, 0xa1, 0x24, 0x50, 0x0d, 0x92, 0xdc,
+	0xdd, 0x21, 0x54, 0x19, 0xe9, 0x54, 0xf1, 0x5d, 0xa6, 0x3c, 0x16, 0xdf, 0x65, 0xca, 0x0d, 0x85,
+	0x85, 0x9a, 0x90, 0xb1, 0x49, 0x9b, 0x34, 0xa5, 0x29, 0x59, 0x0b, 0x7b, 0x84, 0xe9, 0xc9, 0x33,
+	0xc8, 0x9f, 0x99, 0x10, 0xb5, 0x2a, 0x41, 0x51, 0x05, 0x52, 0x76, 0x14, 0x6e, 0xfa, 0xb8, 0x34,
+	0xf4, 0x2b, 0xc7, 0xe9, 0x1f, 0x8b, 0xcc, 0x78, 0xcd, 0x8a, 0x43, 0x88, 0x08, 0xeb, 0xd1, 0xba,
+	0x4b, 0x6d, 0x87, 0x36, 0xcd, 0x16, 0x71, 0x9a, 0x2d, 0xae, 0x4f, 0xe4, 0xb4, 0xa5, 0x31, 0x63,
+	0x26, 0xdc, 0xbf, 0x29, 0xb7, 0x51, 0x05, 0xa6, 0x23, 0x56, 0x99, 0x45, 0x93, 0xa7, 0xcd, 0xa2,
+	0xa9, 0x10, 0x40, 0xb0, 0xa0, 0x3b, 0x00, 0x51, 0x9e, 0xea, 0x20, 0xd1, 0xf2, 0xcf, 0xce, 0xf8,
+	0xb8, 0x32, 0x31, 0x00, 0xd4, 0x86, 0xb9, 0x8e, 0x43, 0x4d, 0x46, 0xda, 0x0d, 0x53, 0x59, 0x4e,
+	0xe0, 0xa6, 0xce, 0xc0, 0xd3, 0xb3, 0x1d, 0x87, 0x56, 0x49, 0xbb, 0x51, 0x0a, 0x61, 0xd1, 0x75,
+	0xb8, 0x14, 0x99, 0xc3, 0xa5, 0x66, 0xcb, 0x6d, 0xdb, 0xa6, 0x47, 0x1a, 0xa6, 0xe5, 0xf6, 0x28,
+	0xd7, 0xd3, 0xd2, 0x88, 0x17, 0x42, 0x96, 0x4d, 0x7a, 0xd3, 0x6d, 0xdb, 0x06, 0x69, 0xac, 0x09,
+	0x32, 0x7a, 0x05, 0x22, 0x5b, 0x98, 0x8e, 0xcd, 0xf4, 0xa9, 0xdc, 0xd8, 0x52, 0xc2, 0x48, 0x87,
+	0x9b, 0x65, 0x9b, 0xa1, 0xd7, 0xfd, 0xb2, 0x1c, 0xe9, 0xc2, 0xf4, 0xe9, 0x9c, 0xb6, 0x94, 0x30,
+	0xa6, 0x3b, 0x78, 0x37, 0x3a, 0x0a, 0x5b, 0x49, 0x3f, 0x78, 0xb8, 0x38, 0xa2, 0xd2, 0x7c, 0x24,
+	0x5f, 0x81, 0xf4, 0x36, 0x6e, 0xab, 0x0c, 0x25, 0x0c, 0xbd, 0x05, 0x93, 0x38, 0x58, 0xe8, 0x5a,
+	0x6e, 0xec, 0xa9, 0x19, 0x1e, 0xb1, 0xfa, 0x85, 0xe3, 0x07, 0x7f, 0xca, 0x69, 0xf9, 0x9f, 0x6a,
+	0x90, 0x2c, 0x6d, 0x57, 0xb0, 0xe3, 0xa1, 0x75, 0x98, 0x8d, 0x62, 0xfd, 0xa4, 0x65, 0x23, 0x4a,
+	0x0f, 0xb5, 0x2f, 0x60, 0xee, 0x05, 0x95, 0x28, 0x84, 0x19, 0x7d, 0x16, 0x4c, 0x28, 0xa2, 0xf6,
+	0x87, 0x14, 0xbf, 0x05, 0xe3, 0xfe, 0x29, 0x19, 0xfa, 0x26, 0xbc, 0xd0, 0x15, 0x3f, 0xa4, 0xbe,
+	0xa9, 0x6b, 0x0b, 0xc7, 0xe6, 0x88, 0xe4, 0x8f, 0x47, 0x94, 0x2f, 0x97, 0xff, 0x97, 0x06, 0x50,
+	0xda, 0xde, 0xae, 0x79, 0x4e, 0xb7, 0x4d, 0xf8, 0x59, 0xa9, 0x7d, 0x1b, 0xce, 0x45, 0x6a, 0x33,
+	0xcf, 0x3a, 0xb1, 0xea, 0x73, 0xa1, 0x58, 0xd5, 0xb3, 0x8e, 0x44, 0xb3, 0x19, 0x0f, 0xd1, 0xc6,
+	0x4e, 0x8c, 0x56, 0x62, 0xfc, 0x68, 0x5b, 0x7e, 0x0b, 0x52, 0x91, 0xfa, 0x0c, 0x95, 0x61, 0x82,
+	0xab, 0xdf, 0xca, 0xa4, 0xf9, 0xe3, 0x4d, 0x1a, 0x88, 0xc5, 0xcd, 0x1a, 0x8a, 0xe7, 0xff, 0x2d,
+	0x2c, 0x1b, 0xe5, 0xd1, 0xe7, 0x2a, 0xa0, 0x44, 0x83, 0x50, 0x05, 0xfc, 0x2c, 0x2e, 0x40, 0x0a,
+	0x6b, 0xc8, 0xb4, 0x0f, 0x46, 0x61, 0x6e, 0x2b, 0xc8, 0xf3, 0xcf, 0xad, 0x25, 0xb6, 0x60, 0x9c,
+	0x50, 0xee, 0x39, 0xd2, 0x14, 0xc2, 0xe1, 0x5f, 0x3e, 0xce, 0xe1, 0x47, 0xe8, 0xb2, 0x4e, 0xb9,
+	0xd7, 0x8f, 0xbb, 0x3f, 0xc0, 0x1a, 0x32, 0xc5, 0xaf, 0xc6, 0x40, 0x3f, 0x4e, 0x5c, 0x94, 0x3f,
+	0xcb, 0x23, 0x72, 0x23, 0x68, 0x4d, 0x9a, 0xac, 0xaa, 0xd3, 0xc1, 0xb6, 0xea, 0x4c, 0x06, 0x88,
+	0xfb, 0x9e, 0x88, 0x2e, 0xc1, 0xfa, 0x7c, 0x17, 0xbc, 0xe9, 0x08, 0x41, 0xf6, 0x26, 0x02, 0x33,
+	0x0e, 0x75, 0xb8, 0x83, 0xdb, 0x66, 0x1d, 0xb7, 0x31, 0xb5, 0x9e, 0xe7, 0x4a, 0x7c, 0xb8, 0x91,
+	0x4c, 0x2b, 0xd0, 0xa2, 0x8f, 0x89, 0xb6, 0x61, 0x3c, 0x80, 0x4f, 0x9c, 0x01, 0x7c, 0x00, 0x86,
+	0x5e, 0x86, 0x74, 0xbc, 0xbf, 0xc8, 0xeb, 0x4e, 0xc2, 0x48, 0xc5, 0xda, 0xcb, 0xb3, 0x1a, 0x58,
+	0xf2, 0xa9, 0x0d, 0x2c, 0x76, 0xab, 0xfc, 0xe5, 0x18, 0xcc, 0x1a, 0xc4, 0xfe, 0x02, 0x3a, 0xef,
+	0x3b, 0x00, 0x7e, 0x82, 0x8b, 0xe2, 0xab, 0x27, 0xce, 0xa0, 0x60, 0x4c, 0xfa, 0x78, 0x25, 0xc6,
+	0xff, 0x9f, 0x1e, 0xfc, 0xdd, 0x28, 0xa4, 0xe3, 0x1e, 0xfc, 0x02, 0x74, 0x3b, 0xb4, 0x11, 0x95,
+	0xb7, 0x84, 0x2c, 0x6f, 0x6f, 0x1c, 0x57, 0xde, 0x0e, 0xc5, 0xf6, 0x09, 0xea, 0xda, 0xef, 0x5f,
+	0x80, 0x64, 0x05, 0x7b, 0xb8, 0xc3, 0xd0, 0xe6, 0xa1, 0x6b, 0xb3, 0x3f, 0xda, 0x5e, 0x3c, 0x14,
+	0xde, 0x25, 0xf5, 0x26, 0xe3, 0x47, 0xf7, 0xfb, 0xc7, 0xdd, 0x9a, 0xbf, 0x04, 0xe2, 0xfa, 0x67,
+	0x86, 0x4a, 0xf9, 0xe6, 0x9c, 0x92, 0xd3, 0x76, 0x38, 0xdd, 0x31, 0xb4, 0x08, 0x29, 0xc1, 0x16,
+	0xd5, 0x70, 0xc1, 0x03, 0x1d, 0xbc, 0xbb, 0xee, 0xef, 0xa0, 0x2b, 0x80, 0x5a, 0xe1, 0x43, 0x8a,
+	0x19, 0x19, 0x43, 0xf0, 0xcd, 0x46, 0x94, 0x80, 0xfd, 0x25, 0x00, 0x71, 0x0a, 0xd3, 0x26, 0xd4,
+	0xed, 0xa8, 0x19, 0x73, 0x52, 0xec, 0x94, 0xc4, 0x06, 0xfa, 0xbe, 0x7f, 0xf9, 0x1e, 0x9a, 0xe3,
+	0xd5, 0x18, 0x74, 0xfb, 0x74, 0x49, 0xf1, 0xcf, 0x83, 0xc5, 0x6c, 0x1f, 0x77, 0xda, 0x2b, 0xf9,
+	0x23, 0x20, 0xf3, 0xf2, 0x32, 0x3e, 0x38, 0xff, 0xa3, 0xab, 0x30, 0xcf, 0xda, 0x98, 0xb5, 0x4c,
+	0x8f, 0xd8, 0x0e, 0xe3, 0x9e, 0x53, 0xef, 0x85, 0x13, 0xd2, 0x84, 0x31, 0x27, 0x69, 0xc6, 0x00,
+	0x09, 0x35, 0x60, 0xaa, 0xde, 0xf3, 0xa8, 0xd9, 0xf0, 0xb0, 0x25, 0x79, 0x27, 0xe4, 0x51, 0x57,
+	0xff, 0x9b, 0xfc, 0xf5, 0xfd, 0x95, 0x16, 0xb8, 0x37, 0x14, 0x2c, 0xfa, 0x1a, 0xe8, 0xb8, 0xce,
+	0xdc, 0x76, 0x8f, 0x13, 0x73, 0xf8, 0x36, 0x3f, 0x29, 0x73, 0xfa, 0x7c, 0x40, 0xbf, 0x33, 0x70,
+	0xab, 0x47, 0x0d, 0xc8, 0xc6, 0x74, 0x57, 0x8f, 0x33, 0x96, 0xeb, 0xb6, 0x6d, 0xf7, 0x7e, 0x30,
+	0x2e, 0x9d, 0x3c, 0x8a, 0xf4, 0x08, 0xcb, 0x7f, 0x93, 0x59, 0x53, 0x48, 0x2b, 0x4b, 0x41, 0x21,
+	0xd8, 0xfb, 0xec, 0xc3, 0xcb, 0x97, 0x62, 0x5a, 0xee, 0x86, 0xcf, 0x93, 0x7e, 0x2c, 0xe7, 0x7f,
+	0xae, 0x01, 0x8a, 0x4e, 0x68, 0x10, 0xd6, 0x75, 0x29, 0x93, 0x73, 0x5c, 0x6c, 0xde, 0xd2, 0x9e,
+	0x3e, 0xc7, 0x45, 0xf2, 0x03, 0x73, 0x5c, 0xac, 0xfa, 0x7c, 0x23, 0xea, 0x89, 0xa3, 0x4a, 0x49,
+	0x85, 0x25, 0x9e, 0x18, 0x63, 0x03, 0xa1, 0x33, 0x00, 0x11, 0x08, 0x85, 0x85, 0x6d, 0x24, 0x7f,
+	0xa0, 0xc1, 0xc5, 0x43, 0xe9, 0x1b, 0x1e, 0xdb, 0x02, 0xe4, 0xc5, 0x88, 0x32, 0x05, 0xfa, 0xea,
+	0xf8, 0xcf, 0x57, 0x0d, 0x66, 0xbd, 0x61, 0xea, 0xff, 0xaa, 0xc1, 0xaf, 0x24, 0x64, 0xe5, 0xfe,
+	0xad, 0x06, 0xf3, 0xf1, 0x13, 0x85, 0xba, 0x55, 0x21, 0x1d, 0x3f, 0x8b, 0xd2, 0xea, 0xd5, 0x93,
+	0x68, 0x15, 0x57, 0x68, 0x00, 0x44, 0xe8, 0x12, 0x94, 0x09, 0xff, 0xb1, 0xf4, 0xea, 0x89, 0xad,
+	0x14, 0x1c, 0xec, 0xc8, 0xda, 0x99, 0x90, 0xce, 0xfa, 0xd1, 0x28, 0x24, 0x2a, 0xae, 0xdb, 0x46,
+	0x3f, 0xd4, 0x60, 0x96, 0xba, 0xdc, 0x14, 0xc5, 0x85, 0xd8, 0xa6, 0x7a, 0xb0, 0xf1, 0xdb, 0xcf,
+	0xf6, 0xe9, 0xac, 0xf7, 0xf7, 0x83, 0xc5, 0xc3, 0x50, 0x83, 0x26, 0x55, 0x0f, 0x86, 0xd4, 0xe5,
+	0x45, 0xc9, 0x54, 0x93, 0x3c, 0xe8, 0x3e, 0x4c, 0x0d, 0x7e, 0xdf, 0xef, 0x59, 0xc6, 0xa9, 0xbf,
+	0x3f, 0xf5, 0xcc, 0x6f, 0xa7, 0xeb, 0xb1, 0x0f, 0xaf, 0x4c, 0x08, 0xc7, 0xfe, 0x43, 0x38, 0xf7,
+	0xd7, 0x1a, 0xcc, 0x85, 0x05, 0x7d, 0x4d, 0x78, 0x82, 0x8a, 0xdb, 0xc7, 0xd1, 0x17, 0x7c, 0xed,
+	0xd4, 0x17, 0x7c, 0xff, 0x86, 0xa6, 0x40, 0xcd, 0x16, 0x66, 0x2d, 0xa9, 0x63, 0xda, 0x98, 0x8e,
+	0xb6, 0x6f, 0x62, 0xd6, 0x42, 0x6f, 0xc1, 0x05, 0xe6, 0x34, 0xa9, 0xe8, 0x5f, 0x3b, 0xa4, 0x6f,
+	0x36, 0x1c, 0xda, 0x24, 0x5e, 0xd7, 0x73, 0x28, 0x97, 0x5d, 0x25, 0x6d, 0x9c, 0x53, 0xe4, 0x77,
+	0x48, 0xff, 0x46, 0x44, 0x54, 0x21, 0x7a, 0x17, 0x32, 0xa1, 0x12, 0x5b, 0xf2, 0x11, 0x55, 0x8c,
+	0x28, 0xe3, 0xfe, 0x7b, 0x6a, 0x30, 0x4c, 0xe6, 0xe2, 0x4f, 0xf6, 0xe2, 0xcd, 0xbf, 0x30, 0x24,
+	0x33, 0x10, 0x37, 0x4a, 0xf6, 0xf2, 0x2f, 0x34, 0x80, 0xe8, 0x91, 0x0f, 0xbd, 0x09, 0x17, 0x8a,
+	0x9b, 0x1b, 0x25, 0xb3, 0x5a, 0x5b, 0xad, 0x6d, 0x55, 0xcd, 0xad, 0x8d, 0x6a, 0x65, 0x7d, 0xad,
+	0x7c, 0xa3, 0xbc, 0x5e, 0xca, 0x8c, 0x64, 0x67, 0xf6, 0xf6, 0x73, 0xa9, 0x2d, 0xca, 0xba, 0xc4,
+	0x72, 0x1a, 0x0e, 0xb1, 0xd1, 0x6b, 0x30, 0x3f, 0xc8, 0x2d, 0x56, 0xeb, 0xa5, 0x8c, 0x96, 0x4d,
+	0xef, 0xed, 0xe7, 0x26, 0xfc, 0xa9, 0x84, 0xd8, 0x68, 0x09, 0xce, 0x1d, 0xe6, 0x2b, 0x6f, 0xbc,
+	0x9d, 0x19, 0xcd, 0x4e, 0xed, 0xed, 0xe7, 0x26, 0xc3, 0xf1, 0x05, 0xe5, 0x01, 0xc5, 0x39, 0x15,
+	0xde, 0x58, 0x16, 0xf6, 0xf6, 0x73, 0x49, 0x3f, 0xb8, 0xb2, 0x89, 0x07, 0x3f, 0x59, 0x18, 0xb9,
+	0xfc, 0x89, 0x06, 0xd3, 0x15, 0xcf, 0x71, 0xc5, 0x03, 0xae, 0x41, 0x30, 0x93, 0x45, 0xef, 0x52,
+	0xc5, 0x28, 0x6f, 0x1a, 0xe5, 0xda, 0x5d, 0xd3, 0x58, 0x5f, 0xad, 0x6e, 0x6e, 0x0c, 0x29, 0xf0,
+	0xd2, 0xde, 0x7e, 0xee, 0xe2, 0xa0, 0x50, 0x5c, 0x9d, 0xaf, 0xc2, 0xf9, 0x61, 0xf9, 0x5b, 0xab,
+	0xe5, 0xdb, 0x52, 0x21, 0x7d, 0x6f, 0x3f, 0x37, 0x3f, 0x28, 0x7a, 0xcb, 0x7f, 0x4b, 0xbd, 0x0e,
+	0xd9, 0x61, 0xa9, 0xda, 0xe6, 0x9d, 0x62, 0xb5, 0xb6, 0xb9, 0xb1, 0x5e, 0xca, 0x8c, 0x66, 0x5f,
+	0xdc, 0xdb, 0xcf, 0xe9, 0x83, 0x92, 0x35, 0xb7, 0x53, 0x67, 0xdc, 0xa5, 0xa1, 0x32, 0xdf, 0x05,
+	0x28, 0xd3, 0xa0, 0x8b, 0xa2, 0x2c, 0x9c, 0x2f, 0x6f, 0xdc, 0x30, 0x56, 0xd7, 0x6a, 0xe5, 0x61,
+	0x15, 0x86, 0x68, 0xa5, 0xcd, 0xad, 0xe2, 0xed, 0x75, 0xb3, 0x5a, 0x7e, 0x7b, 0x23, 0xa3, 0xa1,
+	0x0b, 0x30, 0x37, 0x40, 0x7b, 0x77, 0xa3, 0x56, 0xbe, 0xb3, 0x9e, 0x19, 0x2d, 0xde, 0xf8, 0xf8,
+	0xf1, 0x82, 0xf6, 0xe8, 0xf1, 0x82, 0xf6, 0x97, 0xc7, 0x0b, 0xda, 0x7b, 0x4f, 0x16, 0x46, 0x1e,
+	0x3d, 0x59, 0x18, 0xf9, 0xc3, 0x93, 0x85, 0x91, 0x6f, 0xbf, 0xf9, 0xd4, 0x1c, 0x8c, 0x9a, 0x97,
+	0xcc, 0xc6, 0x7a, 0x52, 0x76, 0xc8, 0xaf, 0xfc, 0x67, 0x00, 0xac, 0xe5, 0x3d, 0xbf, 0x7a, 0x1b,
 	0x00, 0x00,
 }
 
@@ -1392,690 +1547,762 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10916 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x90, 0x1c, 0xc7,
-		0x79, 0xdf, 0xed, 0xe3, 0xf6, 0xf1, 0xed, 0x6b, 0xae, 0xef, 0x00, 0x1c, 0x16, 0xe4, 0xdd, 0x71,
-		0x28, 0x12, 0x0f, 0x92, 0x07, 0x12, 0x24, 0x40, 0xf2, 0x20, 0x8a, 0xde, 0xdd, 0x5b, 0x1c, 0x16,
-		0xb8, 0x97, 0x66, 0xf7, 0xc0, 0x87, 0x63, 0x8d, 0xe7, 0x66, 0xfb, 0xee, 0x86, 0xd8, 0x9d, 0x59,
-		0xcd, 0xcc, 0x02, 0x77, 0x2c, 0x57, 0x8a, 0xb6, 0x62, 0x47, 0x46, 0x1c, 0x47, 0x8e, 0x53, 0x36,
-		0xfd, 0x80, 0x23, 0xf9, 0x29, 0x3b, 0x76, 0xe2, 0x87, 0x22, 0xcb, 0x51, 0xc5, 0xb1, 0x53, 0x71,
-		0x22, 0xbb, 0x52, 0x89, 0xec, 0x4a, 0x25, 0x2e, 0x97, 0xc3, 0xd8, 0x94, 0x2b, 0x52, 0x24, 0x39,
-		0xb6, 0x15, 0xba, 0x92, 0x94, 0xca, 0x4e, 0xaa, 0x5f, 0xf3, 0xd8, 0xc7, 0xed, 0x1e, 0x44, 0x32,
-		0x4a, 0xe9, 0x1f, 0x60, 0xa7, 0xfb, 0xfb, 0x7e, 0xd3, 0xfd, 0xf5, 0xd7, 0x5f, 0x7f, 0xdf, 0xd7,
-		0x3d, 0x7d, 0xf0, 0xc7, 0x65, 0x58, 0xd8, 0xb5, 0xac, 0xdd, 0x16, 0x3e, 0xdf, 0xb1, 0x2d, 0xd7,
-		0xda, 0xee, 0xee, 0x9c, 0x6f, 0x62, 0x47, 0xb7, 0x8d, 0x8e, 0x6b, 0xd9, 0x8b, 0xb4, 0x0c, 0x15,
-		0x18, 0xc5, 0xa2, 0xa0, 0x90, 0xd7, 0x60, 0xea, 0x8a, 0xd1, 0xc2, 0xcb, 0x1e, 0x61, 0x1d, 0xbb,
-		0xe8, 0x19, 0x88, 0xef, 0x18, 0x2d, 0x3c, 0x1b, 0x59, 0x88, 0x9d, 0xc9, 0x5c, 0x78, 0xcf, 0x62,
-		0x0f, 0xd3, 0x62, 0x98, 0x63, 0x93, 0x14, 0x2b, 0x94, 0x43, 0xfe, 0xeb, 0x38, 0x4c, 0x0f, 0xa8,
-		0x45, 0x08, 0xe2, 0xa6, 0xd6, 0x26, 0x88, 0x91, 0x33, 0x69, 0x85, 0xfe, 0x46, 0xb3, 0x90, 0xec,
-		0x68, 0xfa, 0x4d, 0x6d, 0x17, 0xcf, 0x46, 0x69, 0xb1, 0x78, 0x44, 0x73, 0x00, 0x4d, 0xdc, 0xc1,
-		0x66, 0x13, 0x9b, 0xfa, 0xc1, 0x6c, 0x6c, 0x21, 0x76, 0x26, 0xad, 0x04, 0x4a, 0xd0, 0x23, 0x30,
-		0xd5, 0xe9, 0x6e, 0xb7, 0x0c, 0x5d, 0x0d, 0x90, 0xc1, 0x42, 0xec, 0xcc, 0xa4, 0x22, 0xb1, 0x8a,
-		0x65, 0x9f, 0xf8, 0x34, 0x14, 0x6e, 0x63, 0xed, 0x66, 0x90, 0x34, 0x43, 0x49, 0xf3, 0xa4, 0x38,
-		0x40, 0x58, 0x81, 0x6c, 0x1b, 0x3b, 0x8e, 0xb6, 0x8b, 0x55, 0xf7, 0xa0, 0x83, 0x67, 0xe3, 0xb4,
-		0xf7, 0x0b, 0x7d, 0xbd, 0xef, 0xed, 0x79, 0x86, 0x73, 0x35, 0x0e, 0x3a, 0x18, 0x95, 0x20, 0x8d,
-		0xcd, 0x6e, 0x9b, 0x21, 0x4c, 0x0e, 0x91, 0x5f, 0xd5, 0xec, 0xb6, 0x7b, 0x51, 0x52, 0x84, 0x8d,
-		0x43, 0x24, 0x1d, 0x6c, 0xdf, 0x32, 0x74, 0x3c, 0x9b, 0xa0, 0x00, 0xa7, 0xfb, 0x00, 0xea, 0xac,
-		0xbe, 0x17, 0x43, 0xf0, 0xa1, 0x0a, 0xa4, 0xf1, 0xbe, 0x8b, 0x4d, 0xc7, 0xb0, 0xcc, 0xd9, 0x24,
-		0x05, 0x79, 0x68, 0xc0, 0x28, 0xe2, 0x56, 0xb3, 0x17, 0xc2, 0xe7, 0x43, 0x97, 0x20, 0x69, 0x75,
-		0x5c, 0xc3, 0x32, 0x9d, 0xd9, 0xd4, 0x42, 0xe4, 0x4c, 0xe6, 0xc2, 0x7d, 0x03, 0x15, 0x61, 0x83,
-		0xd1, 0x28, 0x82, 0x18, 0xd5, 0x40, 0x72, 0xac, 0xae, 0xad, 0x63, 0x55, 0xb7, 0x9a, 0x58, 0x35,
-		0xcc, 0x1d, 0x6b, 0x36, 0x4d, 0x01, 0xe6, 0xfb, 0x3b, 0x42, 0x09, 0x2b, 0x56, 0x13, 0xd7, 0xcc,
-		0x1d, 0x4b, 0xc9, 0x3b, 0xa1, 0x67, 0x74, 0x1c, 0x12, 0xce, 0x81, 0xe9, 0x6a, 0xfb, 0xb3, 0x59,
-		0xaa, 0x21, 0xfc, 0x89, 0xa8, 0x0e, 0x6e, 0x1a, 0xe4, 0x75, 0xb3, 0x39, 0xa6, 0x3a, 0xfc, 0x51,
-		0xfe, 0xb5, 0x04, 0x14, 0xc6, 0x51, 0xbe, 0xcb, 0x30, 0xb9, 0x43, 0xfa, 0x3f, 0x1b, 0x3d, 0x8a,
-		0x74, 0x18, 0x4f, 0x58, 0xbc, 0x89, 0x7b, 0x14, 0x6f, 0x09, 0x32, 0x26, 0x76, 0x5c, 0xdc, 0x64,
-		0xba, 0x12, 0x1b, 0x53, 0xdb, 0x80, 0x31, 0xf5, 0x2b, 0x5b, 0xfc, 0x9e, 0x94, 0xed, 0x45, 0x28,
-		0x78, 0x4d, 0x52, 0x6d, 0xcd, 0xdc, 0x15, 0x5a, 0x7b, 0x7e, 0x54, 0x4b, 0x16, 0xab, 0x82, 0x4f,
-		0x21, 0x6c, 0x4a, 0x1e, 0x87, 0x9e, 0xd1, 0x32, 0x80, 0x65, 0x62, 0x6b, 0x47, 0x6d, 0x62, 0xbd,
-		0x35, 0x9b, 0x1a, 0x22, 0xa5, 0x0d, 0x42, 0xd2, 0x27, 0x25, 0x8b, 0x95, 0xea, 0x2d, 0xf4, 0xac,
-		0xaf, 0x84, 0xc9, 0x21, 0x3a, 0xb4, 0xc6, 0xa6, 0x5f, 0x9f, 0x1e, 0x6e, 0x41, 0xde, 0xc6, 0x64,
-		0x46, 0xe0, 0x26, 0xef, 0x59, 0x9a, 0x36, 0x62, 0x71, 0x64, 0xcf, 0x14, 0xce, 0xc6, 0x3a, 0x96,
-		0xb3, 0x83, 0x8f, 0xe8, 0x41, 0xf0, 0x0a, 0x54, 0xaa, 0x56, 0x40, 0xed, 0x53, 0x56, 0x14, 0xae,
-		0x6b, 0x6d, 0x5c, 0x7c, 0x15, 0xf2, 0x61, 0xf1, 0xa0, 0x19, 0x98, 0x74, 0x5c, 0xcd, 0x76, 0xa9,
-		0x16, 0x4e, 0x2a, 0xec, 0x01, 0x49, 0x10, 0xc3, 0x66, 0x93, 0xda, 0xbf, 0x49, 0x85, 0xfc, 0x44,
-		0xdf, 0xe4, 0x77, 0x38, 0x46, 0x3b, 0xfc, 0x70, 0xff, 0x88, 0x86, 0x90, 0x7b, 0xfb, 0x5d, 0x7c,
-		0x1a, 0x72, 0xa1, 0x0e, 0x8c, 0xfb, 0x6a, 0xf9, 0xdb, 0xe0, 0xd8, 0x40, 0x68, 0xf4, 0x22, 0xcc,
-		0x74, 0x4d, 0xc3, 0x74, 0xb1, 0xdd, 0xb1, 0x31, 0xd1, 0x58, 0xf6, 0xaa, 0xd9, 0xcf, 0x27, 0x87,
-		0xe8, 0xdc, 0x56, 0x90, 0x9a, 0xa1, 0x28, 0xd3, 0xdd, 0xfe, 0xc2, 0x73, 0xe9, 0xd4, 0x17, 0x92,
-		0xd2, 0x6b, 0xaf, 0xbd, 0xf6, 0x5a, 0x54, 0xfe, 0xcd, 0x04, 0xcc, 0x0c, 0x9a, 0x33, 0x03, 0xa7,
-		0xef, 0x71, 0x48, 0x98, 0xdd, 0xf6, 0x36, 0xb6, 0xa9, 0x90, 0x26, 0x15, 0xfe, 0x84, 0x4a, 0x30,
-		0xd9, 0xd2, 0xb6, 0x71, 0x6b, 0x36, 0xbe, 0x10, 0x39, 0x93, 0xbf, 0xf0, 0xc8, 0x58, 0xb3, 0x72,
-		0x71, 0x95, 0xb0, 0x28, 0x8c, 0x13, 0xbd, 0x0f, 0xe2, 0xdc, 0x78, 0x13, 0x84, 0x73, 0xe3, 0x21,
-		0x90, 0xb9, 0xa4, 0x50, 0x3e, 0x74, 0x0a, 0xd2, 0xe4, 0x7f, 0xa6, 0x1b, 0x09, 0xda, 0xe6, 0x14,
-		0x29, 0x20, 0x7a, 0x81, 0x8a, 0x90, 0xa2, 0xd3, 0xa4, 0x89, 0xc5, 0xa2, 0xe7, 0x3d, 0x13, 0xc5,
-		0x6a, 0xe2, 0x1d, 0xad, 0xdb, 0x72, 0xd5, 0x5b, 0x5a, 0xab, 0x8b, 0xa9, 0xc2, 0xa7, 0x95, 0x2c,
-		0x2f, 0xbc, 0x41, 0xca, 0xd0, 0x3c, 0x64, 0xd8, 0xac, 0x32, 0xcc, 0x26, 0xde, 0xa7, 0x76, 0x75,
-		0x52, 0x61, 0x13, 0xad, 0x46, 0x4a, 0xc8, 0xeb, 0x5f, 0x71, 0x2c, 0x53, 0xa8, 0x26, 0x7d, 0x05,
-		0x29, 0xa0, 0xaf, 0x7f, 0xba, 0xd7, 0xa4, 0xdf, 0x3f, 0xb8, 0x7b, 0x7d, 0x73, 0xe9, 0x34, 0x14,
-		0x28, 0xc5, 0x93, 0x7c, 0xe8, 0xb5, 0xd6, 0xec, 0xd4, 0x42, 0xe4, 0x4c, 0x4a, 0xc9, 0xb3, 0xe2,
-		0x0d, 0x5e, 0x2a, 0x7f, 0x32, 0x0a, 0x71, 0x6a, 0x58, 0x0a, 0x90, 0x69, 0xbc, 0xb4, 0x59, 0x55,
-		0x97, 0x37, 0xb6, 0xca, 0xab, 0x55, 0x29, 0x82, 0xf2, 0x00, 0xb4, 0xe0, 0xca, 0xea, 0x46, 0xa9,
-		0x21, 0x45, 0xbd, 0xe7, 0xda, 0x7a, 0xe3, 0xd2, 0x53, 0x52, 0xcc, 0x63, 0xd8, 0x62, 0x05, 0xf1,
-		0x20, 0xc1, 0x93, 0x17, 0xa4, 0x49, 0x24, 0x41, 0x96, 0x01, 0xd4, 0x5e, 0xac, 0x2e, 0x5f, 0x7a,
-		0x4a, 0x4a, 0x84, 0x4b, 0x9e, 0xbc, 0x20, 0x25, 0x51, 0x0e, 0xd2, 0xb4, 0xa4, 0xbc, 0xb1, 0xb1,
-		0x2a, 0xa5, 0x3c, 0xcc, 0x7a, 0x43, 0xa9, 0xad, 0xaf, 0x48, 0x69, 0x0f, 0x73, 0x45, 0xd9, 0xd8,
-		0xda, 0x94, 0xc0, 0x43, 0x58, 0xab, 0xd6, 0xeb, 0xa5, 0x95, 0xaa, 0x94, 0xf1, 0x28, 0xca, 0x2f,
-		0x35, 0xaa, 0x75, 0x29, 0x1b, 0x6a, 0xd6, 0x93, 0x17, 0xa4, 0x9c, 0xf7, 0x8a, 0xea, 0xfa, 0xd6,
-		0x9a, 0x94, 0x47, 0x53, 0x90, 0x63, 0xaf, 0x10, 0x8d, 0x28, 0xf4, 0x14, 0x5d, 0x7a, 0x4a, 0x92,
-		0xfc, 0x86, 0x30, 0x94, 0xa9, 0x50, 0xc1, 0xa5, 0xa7, 0x24, 0x24, 0x57, 0x60, 0x92, 0xaa, 0x21,
-		0x42, 0x90, 0x5f, 0x2d, 0x95, 0xab, 0xab, 0xea, 0xc6, 0x66, 0xa3, 0xb6, 0xb1, 0x5e, 0x5a, 0x95,
-		0x22, 0x7e, 0x99, 0x52, 0x7d, 0xff, 0x56, 0x4d, 0xa9, 0x2e, 0x4b, 0xd1, 0x60, 0xd9, 0x66, 0xb5,
-		0xd4, 0xa8, 0x2e, 0x4b, 0x31, 0x59, 0x87, 0x99, 0x41, 0x06, 0x75, 0xe0, 0x14, 0x0a, 0xe8, 0x42,
-		0x74, 0x88, 0x2e, 0x50, 0xac, 0x5e, 0x5d, 0x90, 0x3f, 0x17, 0x85, 0xe9, 0x01, 0x8b, 0xca, 0xc0,
-		0x97, 0x3c, 0x0f, 0x93, 0x4c, 0x97, 0xd9, 0x32, 0x7b, 0x76, 0xe0, 0xea, 0x44, 0x35, 0xbb, 0x6f,
-		0xa9, 0xa5, 0x7c, 0x41, 0x27, 0x24, 0x36, 0xc4, 0x09, 0x21, 0x10, 0x7d, 0x0a, 0xfb, 0x2d, 0x7d,
-		0xc6, 0x9f, 0xad, 0x8f, 0x97, 0xc6, 0x59, 0x1f, 0x69, 0xd9, 0xd1, 0x16, 0x81, 0xc9, 0x01, 0x8b,
-		0xc0, 0x65, 0x98, 0xea, 0x03, 0x1a, 0xdb, 0x18, 0x7f, 0x28, 0x02, 0xb3, 0xc3, 0x84, 0x33, 0xc2,
-		0x24, 0x46, 0x43, 0x26, 0xf1, 0x72, 0xaf, 0x04, 0x1f, 0x18, 0x3e, 0x08, 0x7d, 0x63, 0xfd, 0x33,
-		0x11, 0x38, 0x3e, 0xd8, 0xd9, 0x1c, 0xd8, 0x86, 0xf7, 0x41, 0xa2, 0x8d, 0xdd, 0x3d, 0x4b, 0xb8,
-		0x55, 0x0f, 0x0f, 0x58, 0xac, 0x49, 0x75, 0xef, 0x60, 0x73, 0xae, 0xe0, 0x6a, 0x1f, 0x1b, 0xe6,
-		0x31, 0xb2, 0xd6, 0xf4, 0xb5, 0xf4, 0xbb, 0xa3, 0x70, 0x6c, 0x20, 0xf8, 0xc0, 0x86, 0xde, 0x0f,
-		0x60, 0x98, 0x9d, 0xae, 0xcb, 0x5c, 0x27, 0x66, 0x89, 0xd3, 0xb4, 0x84, 0x1a, 0x2f, 0x62, 0x65,
-		0xbb, 0xae, 0x57, 0x1f, 0xa3, 0xf5, 0xc0, 0x8a, 0x28, 0xc1, 0x33, 0x7e, 0x43, 0xe3, 0xb4, 0xa1,
-		0x73, 0x43, 0x7a, 0xda, 0xa7, 0x98, 0x8f, 0x83, 0xa4, 0xb7, 0x0c, 0x6c, 0xba, 0xaa, 0xe3, 0xda,
-		0x58, 0x6b, 0x1b, 0xe6, 0x2e, 0x5d, 0x6a, 0x52, 0x4b, 0x93, 0x3b, 0x5a, 0xcb, 0xc1, 0x4a, 0x81,
-		0x55, 0xd7, 0x45, 0x2d, 0xe1, 0xa0, 0x0a, 0x64, 0x07, 0x38, 0x12, 0x21, 0x0e, 0x56, 0xed, 0x71,
-		0xc8, 0xdf, 0x97, 0x86, 0x4c, 0xc0, 0x35, 0x47, 0x0f, 0x40, 0xf6, 0x15, 0xed, 0x96, 0xa6, 0x8a,
-		0x70, 0x8b, 0x49, 0x22, 0x43, 0xca, 0x36, 0x79, 0xc8, 0xf5, 0x38, 0xcc, 0x50, 0x12, 0xab, 0xeb,
-		0x62, 0x5b, 0xd5, 0x5b, 0x9a, 0xe3, 0x50, 0xa1, 0xa5, 0x28, 0x29, 0x22, 0x75, 0x1b, 0xa4, 0xaa,
-		0x22, 0x6a, 0xd0, 0x45, 0x98, 0xa6, 0x1c, 0xed, 0x6e, 0xcb, 0x35, 0x3a, 0x2d, 0xac, 0x92, 0x00,
-		0xd0, 0xa1, 0x4b, 0x8e, 0xd7, 0xb2, 0x29, 0x42, 0xb1, 0xc6, 0x09, 0x48, 0x8b, 0x1c, 0xb4, 0x0c,
-		0xf7, 0x53, 0xb6, 0x5d, 0x6c, 0x62, 0x5b, 0x73, 0xb1, 0x8a, 0x3f, 0xd8, 0xd5, 0x5a, 0x8e, 0xaa,
-		0x99, 0x4d, 0x75, 0x4f, 0x73, 0xf6, 0x66, 0x67, 0x08, 0x40, 0x39, 0x3a, 0x1b, 0x51, 0x4e, 0x12,
-		0xc2, 0x15, 0x4e, 0x57, 0xa5, 0x64, 0x25, 0xb3, 0x79, 0x55, 0x73, 0xf6, 0xd0, 0x12, 0x1c, 0xa7,
-		0x28, 0x8e, 0x6b, 0x1b, 0xe6, 0xae, 0xaa, 0xef, 0x61, 0xfd, 0xa6, 0xda, 0x75, 0x77, 0x9e, 0x99,
-		0x3d, 0x15, 0x7c, 0x3f, 0x6d, 0x61, 0x9d, 0xd2, 0x54, 0x08, 0xc9, 0x96, 0xbb, 0xf3, 0x0c, 0xaa,
-		0x43, 0x96, 0x0c, 0x46, 0xdb, 0x78, 0x15, 0xab, 0x3b, 0x96, 0x4d, 0xd7, 0xd0, 0xfc, 0x00, 0xd3,
-		0x14, 0x90, 0xe0, 0xe2, 0x06, 0x67, 0x58, 0xb3, 0x9a, 0x78, 0x69, 0xb2, 0xbe, 0x59, 0xad, 0x2e,
-		0x2b, 0x19, 0x81, 0x72, 0xc5, 0xb2, 0x89, 0x42, 0xed, 0x5a, 0x9e, 0x80, 0x33, 0x4c, 0xa1, 0x76,
-		0x2d, 0x21, 0xde, 0x8b, 0x30, 0xad, 0xeb, 0xac, 0xcf, 0x86, 0xae, 0xf2, 0x30, 0xcd, 0x99, 0x95,
-		0x42, 0xc2, 0xd2, 0xf5, 0x15, 0x46, 0xc0, 0x75, 0xdc, 0x41, 0xcf, 0xc2, 0x31, 0x5f, 0x58, 0x41,
-		0xc6, 0xa9, 0xbe, 0x5e, 0xf6, 0xb2, 0x5e, 0x84, 0xe9, 0xce, 0x41, 0x3f, 0x23, 0x0a, 0xbd, 0xb1,
-		0x73, 0xd0, 0xcb, 0xf6, 0x34, 0xcc, 0x74, 0xf6, 0x3a, 0xfd, 0x7c, 0xe7, 0x82, 0x7c, 0xa8, 0xb3,
-		0xd7, 0xe9, 0x65, 0x7c, 0x88, 0xc6, 0xec, 0x36, 0xd6, 0x35, 0x17, 0x37, 0x67, 0x4f, 0x04, 0xc9,
-		0x03, 0x15, 0x68, 0x11, 0x24, 0x5d, 0x57, 0xb1, 0xa9, 0x6d, 0xb7, 0xb0, 0xaa, 0xd9, 0xd8, 0xd4,
-		0x9c, 0xd9, 0x79, 0x4a, 0x1c, 0x77, 0xed, 0x2e, 0x56, 0xf2, 0xba, 0x5e, 0xa5, 0x95, 0x25, 0x5a,
-		0x87, 0xce, 0xc1, 0x94, 0xb5, 0xfd, 0x8a, 0xce, 0x34, 0x52, 0xed, 0xd8, 0x78, 0xc7, 0xd8, 0x9f,
-		0x7d, 0x0f, 0x15, 0x6f, 0x81, 0x54, 0x50, 0x7d, 0xdc, 0xa4, 0xc5, 0xe8, 0x2c, 0x48, 0xba, 0xb3,
-		0xa7, 0xd9, 0x1d, 0x6a, 0x92, 0x9d, 0x8e, 0xa6, 0xe3, 0xd9, 0x87, 0x18, 0x29, 0x2b, 0x5f, 0x17,
-		0xc5, 0x64, 0x46, 0x38, 0xb7, 0x8d, 0x1d, 0x57, 0x20, 0x9e, 0x66, 0x33, 0x82, 0x96, 0x71, 0xb4,
-		0x33, 0x20, 0x11, 0x49, 0x84, 0x5e, 0x7c, 0x86, 0x92, 0xe5, 0x3b, 0x7b, 0x9d, 0xe0, 0x7b, 0x1f,
-		0x84, 0x1c, 0xa1, 0xf4, 0x5f, 0x7a, 0x96, 0x39, 0x6e, 0x9d, 0xbd, 0xc0, 0x1b, 0x9f, 0x82, 0xe3,
-		0x84, 0xa8, 0x8d, 0x5d, 0xad, 0xa9, 0xb9, 0x5a, 0x80, 0xfa, 0x51, 0x4a, 0x4d, 0xc4, 0xbe, 0xc6,
-		0x2b, 0x43, 0xed, 0xb4, 0xbb, 0xdb, 0x07, 0x9e, 0x62, 0x3d, 0xc6, 0xda, 0x49, 0xca, 0x84, 0x6a,
-		0xbd, 0x63, 0xce, 0xb9, 0xbc, 0x04, 0xd9, 0xa0, 0xde, 0xa3, 0x34, 0x30, 0xcd, 0x97, 0x22, 0xc4,
-		0x09, 0xaa, 0x6c, 0x2c, 0x13, 0xf7, 0xe5, 0xe5, 0xaa, 0x14, 0x25, 0x6e, 0xd4, 0x6a, 0xad, 0x51,
-		0x55, 0x95, 0xad, 0xf5, 0x46, 0x6d, 0xad, 0x2a, 0xc5, 0x02, 0x8e, 0xfd, 0xb5, 0x78, 0xea, 0x61,
-		0xe9, 0xb4, 0xfc, 0xe9, 0x18, 0xe4, 0xc3, 0x91, 0x1a, 0x7a, 0x2f, 0x9c, 0x10, 0x09, 0x17, 0x07,
-		0xbb, 0xea, 0x6d, 0xc3, 0xa6, 0x13, 0xb2, 0xad, 0xb1, 0xc5, 0xd1, 0xd3, 0x9f, 0x19, 0x4e, 0x55,
-		0xc7, 0xee, 0x0b, 0x86, 0x4d, 0xa6, 0x5b, 0x5b, 0x73, 0xd1, 0x2a, 0xcc, 0x9b, 0x96, 0xea, 0xb8,
-		0x9a, 0xd9, 0xd4, 0xec, 0xa6, 0xea, 0xa7, 0xba, 0x54, 0x4d, 0xd7, 0xb1, 0xe3, 0x58, 0x6c, 0x21,
-		0xf4, 0x50, 0xee, 0x33, 0xad, 0x3a, 0x27, 0xf6, 0x57, 0x88, 0x12, 0x27, 0xed, 0x51, 0xdf, 0xd8,
-		0x30, 0xf5, 0x3d, 0x05, 0xe9, 0xb6, 0xd6, 0x51, 0xb1, 0xe9, 0xda, 0x07, 0xd4, 0x3f, 0x4f, 0x29,
-		0xa9, 0xb6, 0xd6, 0xa9, 0x92, 0x67, 0x74, 0x03, 0x1e, 0xf6, 0x49, 0xd5, 0x16, 0xde, 0xd5, 0xf4,
-		0x03, 0x95, 0x3a, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xd3, 0x32, 0x74, 0xd7, 0xa1, 0xf6,
-		0x81, 0xd9, 0x38, 0xd9, 0xe7, 0x58, 0xa5, 0x0c, 0xd7, 0x1c, 0xcb, 0xa4, 0x3e, 0x78, 0x45, 0x50,
-		0xbf, 0x2b, 0xe1, 0xd7, 0xb5, 0x78, 0x2a, 0x2e, 0x4d, 0x5e, 0x8b, 0xa7, 0x26, 0xa5, 0xc4, 0xb5,
-		0x78, 0x2a, 0x21, 0x25, 0xaf, 0xc5, 0x53, 0x29, 0x29, 0x7d, 0x2d, 0x9e, 0x4a, 0x4b, 0x20, 0xff,
-		0x6a, 0x0a, 0xb2, 0xc1, 0xc8, 0x80, 0x04, 0x5a, 0x3a, 0x5d, 0x1b, 0x23, 0xd4, 0x7a, 0x3e, 0x78,
-		0x68, 0x1c, 0xb1, 0x58, 0x21, 0x8b, 0xe6, 0x52, 0x82, 0xb9, 0xe1, 0x0a, 0xe3, 0x24, 0x0e, 0x0b,
-		0x51, 0x6b, 0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x9f, 0xd0, 0x0a, 0x24, 0x5e, 0x71, 0x28, 0x76, 0x82,
-		0x62, 0xbf, 0xe7, 0x70, 0xec, 0x6b, 0x75, 0x0a, 0x9e, 0xbe, 0x56, 0x57, 0xd7, 0x37, 0x94, 0xb5,
-		0xd2, 0xaa, 0xc2, 0xd9, 0xd1, 0x49, 0x88, 0xb7, 0xb4, 0x57, 0x0f, 0xc2, 0xcb, 0x2b, 0x2d, 0x42,
-		0x8b, 0x50, 0xe8, 0x9a, 0xb7, 0xb0, 0x6d, 0xec, 0x18, 0x64, 0xa8, 0x08, 0x55, 0x21, 0x48, 0x95,
-		0xf7, 0x6b, 0x57, 0x09, 0xfd, 0x98, 0xea, 0x71, 0x12, 0xe2, 0xb7, 0xb1, 0x76, 0x33, 0xbc, 0x08,
-		0xd2, 0x22, 0x74, 0x06, 0xb2, 0x4d, 0xbc, 0xdd, 0xdd, 0x55, 0x6d, 0xdc, 0xd4, 0x74, 0x37, 0x6c,
-		0xfa, 0x33, 0xb4, 0x4a, 0xa1, 0x35, 0xe8, 0x3a, 0xa4, 0xc9, 0x18, 0x99, 0x74, 0x8c, 0xa7, 0xa8,
-		0x08, 0x1e, 0x3b, 0x5c, 0x04, 0x7c, 0x88, 0x05, 0x93, 0xe2, 0xf3, 0xa3, 0x2b, 0x90, 0x70, 0x35,
-		0x7b, 0x17, 0xbb, 0xd4, 0xf2, 0xe7, 0x07, 0x24, 0x3f, 0x06, 0x20, 0x35, 0x28, 0x07, 0x8d, 0x69,
-		0x39, 0xf7, 0x3b, 0x68, 0x65, 0xce, 0xc3, 0x24, 0x55, 0x0f, 0x04, 0xc0, 0x15, 0x44, 0x9a, 0x40,
-		0x29, 0x88, 0x57, 0x36, 0x14, 0x62, 0x69, 0x24, 0xc8, 0xb2, 0x52, 0x75, 0xb3, 0x56, 0xad, 0x54,
-		0xa5, 0xa8, 0x7c, 0x11, 0x12, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x46, 0x5d, 0x9a, 0xe0, 0x8f, 0x1c,
-		0x23, 0x22, 0x6a, 0xb7, 0xd6, 0xca, 0x55, 0x45, 0x8a, 0xca, 0x5b, 0x50, 0xe8, 0x91, 0x13, 0x3a,
-		0x06, 0x53, 0x4a, 0xb5, 0x51, 0x5d, 0x27, 0x71, 0x96, 0xba, 0xb5, 0x7e, 0x7d, 0x7d, 0xe3, 0x85,
-		0x75, 0x69, 0x22, 0x5c, 0x2c, 0x4c, 0x5a, 0x04, 0xcd, 0x80, 0xe4, 0x17, 0xd7, 0x37, 0xb6, 0x14,
-		0xda, 0x9a, 0xef, 0x89, 0x82, 0xd4, 0x2b, 0x35, 0x74, 0x02, 0xa6, 0x1b, 0x25, 0x65, 0xa5, 0xda,
-		0x50, 0x59, 0xec, 0xe8, 0x41, 0xcf, 0x80, 0x14, 0xac, 0xb8, 0x52, 0xa3, 0xa1, 0xf1, 0x3c, 0x9c,
-		0x0a, 0x96, 0x56, 0x5f, 0x6c, 0x54, 0xd7, 0xeb, 0xf4, 0xe5, 0xa5, 0xf5, 0x15, 0x62, 0x5f, 0x7b,
-		0xf0, 0x44, 0xb4, 0x1a, 0x23, 0x4d, 0x0d, 0xe3, 0x55, 0x57, 0x97, 0xa5, 0x78, 0x6f, 0xf1, 0xc6,
-		0x7a, 0x75, 0xe3, 0x8a, 0x34, 0xd9, 0xfb, 0x76, 0x1a, 0xc1, 0x26, 0x50, 0x11, 0x8e, 0xf7, 0x96,
-		0xaa, 0xd5, 0xf5, 0x86, 0xf2, 0x92, 0x94, 0xec, 0x7d, 0x71, 0xbd, 0xaa, 0xdc, 0xa8, 0x55, 0xaa,
-		0x52, 0x0a, 0x1d, 0x07, 0x14, 0x6e, 0x51, 0xe3, 0xea, 0xc6, 0xb2, 0x94, 0xee, 0xb3, 0x28, 0xb2,
-		0x03, 0xd9, 0x60, 0x18, 0xf9, 0xee, 0xe4, 0x92, 0x5e, 0x8f, 0x42, 0x26, 0x10, 0x16, 0x12, 0x7f,
-		0x5e, 0x6b, 0xb5, 0xac, 0xdb, 0xaa, 0xd6, 0x32, 0x34, 0x87, 0xdb, 0x1b, 0xa0, 0x45, 0x25, 0x52,
-		0x32, 0xee, 0xfc, 0x1e, 0xdf, 0xc2, 0x27, 0xbe, 0x1e, 0x2d, 0xfc, 0xa4, 0x94, 0x90, 0x7f, 0x2c,
-		0x02, 0x52, 0x6f, 0xbc, 0xd7, 0xd3, 0xfd, 0xc8, 0xb0, 0xee, 0xbf, 0x2b, 0x63, 0xf7, 0xa3, 0x11,
-		0xc8, 0x87, 0x83, 0xbc, 0x9e, 0xe6, 0x3d, 0xf0, 0xff, 0xb4, 0x79, 0x7f, 0x14, 0x85, 0x5c, 0x28,
-		0xb4, 0x1b, 0xb7, 0x75, 0x1f, 0x84, 0x29, 0xa3, 0x89, 0xdb, 0x1d, 0xcb, 0xc5, 0xa6, 0x7e, 0xa0,
-		0xb6, 0xf0, 0x2d, 0xdc, 0x9a, 0x95, 0xa9, 0x51, 0x3e, 0x7f, 0x78, 0xf0, 0xb8, 0x58, 0xf3, 0xf9,
-		0x56, 0x09, 0xdb, 0xd2, 0x74, 0x6d, 0xb9, 0xba, 0xb6, 0xb9, 0xd1, 0xa8, 0xae, 0x57, 0x5e, 0x12,
-		0xd6, 0x45, 0x91, 0x8c, 0x1e, 0xb2, 0x77, 0xd0, 0x68, 0x6f, 0x82, 0xd4, 0xdb, 0x28, 0x62, 0x2b,
-		0x06, 0x34, 0x4b, 0x9a, 0x40, 0xd3, 0x50, 0x58, 0xdf, 0x50, 0xeb, 0xb5, 0xe5, 0xaa, 0x5a, 0xbd,
-		0x72, 0xa5, 0x5a, 0x69, 0xd4, 0x59, 0x3a, 0xd0, 0xa3, 0x6e, 0x48, 0xd1, 0xa0, 0x88, 0x7f, 0x38,
-		0x06, 0xd3, 0x03, 0x5a, 0x82, 0x4a, 0x3c, 0x90, 0x67, 0xb9, 0x85, 0xc7, 0xc6, 0x69, 0xfd, 0x22,
-		0x71, 0xa5, 0x37, 0x35, 0xdb, 0xe5, 0x71, 0xff, 0x59, 0x20, 0x52, 0x32, 0x5d, 0xb2, 0xb2, 0xdb,
-		0x3c, 0xcd, 0xca, 0xa2, 0xfb, 0x82, 0x5f, 0xce, 0x32, 0xad, 0x8f, 0x02, 0xea, 0x58, 0x8e, 0xe1,
-		0x1a, 0xb7, 0xb0, 0x6a, 0x98, 0x22, 0x27, 0x4b, 0xa2, 0xfd, 0xb8, 0x22, 0x89, 0x9a, 0x9a, 0xe9,
-		0x7a, 0xd4, 0x26, 0xde, 0xd5, 0x7a, 0xa8, 0x89, 0xe7, 0x11, 0x53, 0x24, 0x51, 0xe3, 0x51, 0x3f,
-		0x00, 0xd9, 0xa6, 0xd5, 0x25, 0x21, 0x10, 0xa3, 0x23, 0xd6, 0x22, 0xa2, 0x64, 0x58, 0x99, 0x47,
-		0xc2, 0x83, 0x5b, 0x3f, 0x19, 0x9c, 0x55, 0x32, 0xac, 0x8c, 0x91, 0x9c, 0x86, 0x82, 0xb6, 0xbb,
-		0x6b, 0x13, 0x70, 0x01, 0xc4, 0xc2, 0xf5, 0xbc, 0x57, 0x4c, 0x09, 0x8b, 0xd7, 0x20, 0x25, 0xe4,
-		0x40, 0x3c, 0x58, 0x22, 0x09, 0xb5, 0xc3, 0x72, 0x50, 0xd1, 0x33, 0x69, 0x25, 0x65, 0x8a, 0xca,
-		0x07, 0x20, 0x6b, 0x38, 0xaa, 0xbf, 0xb7, 0x15, 0x5d, 0x88, 0x9e, 0x49, 0x29, 0x19, 0xc3, 0xf1,
-		0xf6, 0x05, 0xe4, 0x9f, 0x89, 0x42, 0x3e, 0xbc, 0x6b, 0x87, 0x96, 0x21, 0xd5, 0xb2, 0x74, 0x8d,
-		0xaa, 0x16, 0xdb, 0x32, 0x3e, 0x33, 0x62, 0xa3, 0x6f, 0x71, 0x95, 0xd3, 0x2b, 0x1e, 0x67, 0xf1,
-		0xdf, 0x45, 0x20, 0x25, 0x8a, 0xd1, 0x71, 0x88, 0x77, 0x34, 0x77, 0x8f, 0xc2, 0x4d, 0x96, 0xa3,
-		0x52, 0x44, 0xa1, 0xcf, 0xa4, 0xdc, 0xe9, 0x68, 0x26, 0x55, 0x01, 0x5e, 0x4e, 0x9e, 0xc9, 0xb8,
-		0xb6, 0xb0, 0xd6, 0xa4, 0xb9, 0x00, 0xab, 0xdd, 0xc6, 0xa6, 0xeb, 0x88, 0x71, 0xe5, 0xe5, 0x15,
-		0x5e, 0x8c, 0x1e, 0x81, 0x29, 0xd7, 0xd6, 0x8c, 0x56, 0x88, 0x36, 0x4e, 0x69, 0x25, 0x51, 0xe1,
-		0x11, 0x2f, 0xc1, 0x49, 0x81, 0xdb, 0xc4, 0xae, 0xa6, 0xef, 0xe1, 0xa6, 0xcf, 0x94, 0xa0, 0x39,
-		0xbf, 0x13, 0x9c, 0x60, 0x99, 0xd7, 0x0b, 0x5e, 0xf9, 0xb3, 0x51, 0x98, 0x12, 0xd9, 0x8b, 0xa6,
-		0x27, 0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0x37, 0x28, 0xae, 0x7e, 0x55, 0xee, 0xe3, 0x5b, 0x2c,
-		0x79, 0x4c, 0x4a, 0x00, 0xa0, 0xf8, 0xa5, 0x08, 0x80, 0x5f, 0x35, 0x54, 0x6e, 0xf3, 0x90, 0xe1,
-		0x7b, 0xb2, 0x74, 0x63, 0x9f, 0x25, 0xbc, 0x80, 0x15, 0x5d, 0x31, 0x5a, 0x34, 0x2d, 0xb9, 0x8d,
-		0x77, 0x0d, 0x93, 0xef, 0xa7, 0xb0, 0x07, 0x91, 0x96, 0x8c, 0xfb, 0xdb, 0x53, 0x0a, 0xa4, 0x1c,
-		0xdc, 0xd6, 0x4c, 0xd7, 0xd0, 0xf9, 0x0e, 0xc9, 0xa5, 0x23, 0x35, 0x7e, 0xb1, 0xce, 0xb9, 0x15,
-		0x0f, 0x47, 0x3e, 0x03, 0x29, 0x51, 0x4a, 0x1c, 0xbf, 0xf5, 0x8d, 0xf5, 0xaa, 0x34, 0x81, 0x92,
-		0x10, 0xab, 0x57, 0x1b, 0x52, 0x84, 0x84, 0x9d, 0xa5, 0xd5, 0x5a, 0xa9, 0x2e, 0x45, 0xcb, 0x7f,
-		0x13, 0xa6, 0x75, 0xab, 0xdd, 0xfb, 0xc2, 0xb2, 0xd4, 0x93, 0xf2, 0x73, 0xae, 0x46, 0x5e, 0x7e,
-		0x8c, 0x13, 0xed, 0x5a, 0x2d, 0xcd, 0xdc, 0x5d, 0xb4, 0xec, 0x5d, 0xff, 0x58, 0x04, 0x89, 0x0e,
-		0x9c, 0xc0, 0xe1, 0x88, 0xce, 0xf6, 0xff, 0x8a, 0x44, 0x7e, 0x3c, 0x1a, 0x5b, 0xd9, 0x2c, 0xff,
-		0x5c, 0xb4, 0xb8, 0xc2, 0x18, 0x37, 0x45, 0x77, 0x14, 0xbc, 0xd3, 0xc2, 0x3a, 0x69, 0x3c, 0xfc,
-		0xe9, 0x23, 0x30, 0xb3, 0x6b, 0xed, 0x5a, 0x14, 0xe9, 0x3c, 0xf9, 0xc5, 0xcf, 0x55, 0xa4, 0xbd,
-		0xd2, 0xe2, 0xc8, 0x43, 0x18, 0x4b, 0xeb, 0x30, 0xcd, 0x89, 0x55, 0xba, 0x7d, 0xcb, 0x92, 0x0b,
-		0xe8, 0xd0, 0xcc, 0xf6, 0xec, 0x2f, 0xfd, 0x09, 0xf5, 0x4a, 0x94, 0x29, 0xce, 0x4a, 0xea, 0x58,
-		0xfe, 0x61, 0x49, 0x81, 0x63, 0x21, 0x3c, 0x66, 0x23, 0xb0, 0x3d, 0x02, 0xf1, 0xb7, 0x38, 0xe2,
-		0x74, 0x00, 0xb1, 0xce, 0x59, 0x97, 0x2a, 0x90, 0x3b, 0x0a, 0xd6, 0xbf, 0xe6, 0x58, 0x59, 0x1c,
-		0x04, 0x59, 0x81, 0x02, 0x05, 0xd1, 0xbb, 0x8e, 0x6b, 0xb5, 0xa9, 0x01, 0x3e, 0x1c, 0xe6, 0xdf,
-		0xfc, 0x09, 0x9b, 0xb4, 0x79, 0xc2, 0x56, 0xf1, 0xb8, 0x96, 0x96, 0x80, 0xee, 0x58, 0x37, 0xb1,
-		0xde, 0x1a, 0x81, 0xf0, 0x19, 0xde, 0x10, 0x8f, 0x7e, 0xe9, 0x06, 0xcc, 0x90, 0xdf, 0xd4, 0x3e,
-		0x06, 0x5b, 0x32, 0x3a, 0x0d, 0x3e, 0xfb, 0xbb, 0x1f, 0x62, 0x76, 0x61, 0xda, 0x03, 0x08, 0xb4,
-		0x29, 0x30, 0x8a, 0xbb, 0xd8, 0x75, 0xb1, 0xed, 0xa8, 0x5a, 0x6b, 0x50, 0xf3, 0x02, 0x79, 0xc4,
-		0xd9, 0x1f, 0xfa, 0x72, 0x78, 0x14, 0x57, 0x18, 0x67, 0xa9, 0xd5, 0x5a, 0xda, 0x82, 0x13, 0x03,
-		0xb4, 0x62, 0x0c, 0xcc, 0x1f, 0xe6, 0x98, 0x33, 0x7d, 0x9a, 0x41, 0x60, 0x37, 0x41, 0x94, 0x7b,
-		0x63, 0x39, 0x06, 0xe6, 0x8f, 0x70, 0x4c, 0xc4, 0x79, 0xc5, 0x90, 0x12, 0xc4, 0x6b, 0x30, 0x75,
-		0x0b, 0xdb, 0xdb, 0x96, 0xc3, 0x73, 0xb7, 0x63, 0xc0, 0xfd, 0x28, 0x87, 0x2b, 0x70, 0x46, 0x9a,
-		0xcc, 0x25, 0x58, 0xcf, 0x42, 0x6a, 0x47, 0xd3, 0xf1, 0x18, 0x10, 0x77, 0x39, 0x44, 0x92, 0xd0,
-		0x13, 0xd6, 0x12, 0x64, 0x77, 0x2d, 0xbe, 0x44, 0x8e, 0x66, 0xff, 0x31, 0xce, 0x9e, 0x11, 0x3c,
-		0x1c, 0xa2, 0x63, 0x75, 0xba, 0x2d, 0xb2, 0x7e, 0x8e, 0x86, 0xf8, 0x87, 0x02, 0x42, 0xf0, 0x70,
-		0x88, 0x23, 0x88, 0xf5, 0xa3, 0x02, 0xc2, 0x09, 0xc8, 0xf3, 0x79, 0xc8, 0x58, 0x66, 0xeb, 0xc0,
-		0x32, 0xc7, 0x69, 0xc4, 0xc7, 0x38, 0x02, 0x70, 0x16, 0x02, 0x70, 0x19, 0xd2, 0xe3, 0x0e, 0xc4,
-		0x4f, 0x7d, 0x59, 0x4c, 0x0f, 0x31, 0x02, 0x2b, 0x50, 0x10, 0x06, 0xca, 0xb0, 0xcc, 0x31, 0x20,
-		0x7e, 0x9a, 0x43, 0xe4, 0x03, 0x6c, 0xbc, 0x1b, 0x2e, 0x76, 0xdc, 0x5d, 0x3c, 0x0e, 0xc8, 0xcf,
-		0x88, 0x6e, 0x70, 0x16, 0x2e, 0xca, 0x6d, 0x6c, 0xea, 0x7b, 0xe3, 0x21, 0x7c, 0x5c, 0x88, 0x52,
-		0xf0, 0x10, 0x88, 0x0a, 0xe4, 0xda, 0x9a, 0xed, 0xec, 0x69, 0xad, 0xb1, 0x86, 0xe3, 0x67, 0x39,
-		0x46, 0xd6, 0x63, 0xe2, 0x12, 0xe9, 0x9a, 0x47, 0x81, 0xf9, 0x39, 0x21, 0x91, 0x00, 0x1b, 0x9f,
-		0x7a, 0x8e, 0x4b, 0x13, 0xdd, 0x47, 0x41, 0xfb, 0x47, 0x62, 0xea, 0x31, 0xde, 0xb5, 0x20, 0xe2,
-		0x65, 0x48, 0x3b, 0xc6, 0xab, 0x63, 0xc1, 0xfc, 0xbc, 0x18, 0x69, 0xca, 0x40, 0x98, 0x5f, 0x82,
-		0x93, 0x03, 0x97, 0x89, 0x31, 0xc0, 0x7e, 0x81, 0x83, 0x1d, 0x1f, 0xb0, 0x54, 0x70, 0x93, 0x70,
-		0x54, 0xc8, 0x7f, 0x2c, 0x4c, 0x02, 0xee, 0xc1, 0xda, 0x24, 0x41, 0x8b, 0xa3, 0xed, 0x1c, 0x4d,
-		0x6a, 0xff, 0x44, 0x48, 0x8d, 0xf1, 0x86, 0xa4, 0xd6, 0x80, 0xe3, 0x1c, 0xf1, 0x68, 0xe3, 0xfa,
-		0x8b, 0xc2, 0xb0, 0x32, 0xee, 0xad, 0xf0, 0xe8, 0x7e, 0x33, 0x14, 0x3d, 0x71, 0x0a, 0xef, 0xd8,
-		0x51, 0xdb, 0x5a, 0x67, 0x0c, 0xe4, 0x5f, 0xe2, 0xc8, 0xc2, 0xe2, 0x7b, 0xee, 0xb5, 0xb3, 0xa6,
-		0x75, 0x08, 0xf8, 0x8b, 0x30, 0x2b, 0xc0, 0xbb, 0xa6, 0x8d, 0x75, 0x6b, 0xd7, 0x34, 0x5e, 0xc5,
-		0xcd, 0x31, 0xa0, 0x7f, 0xb9, 0x67, 0xa8, 0xb6, 0x02, 0xec, 0x04, 0xb9, 0x06, 0x92, 0xe7, 0xab,
-		0xa8, 0x46, 0xbb, 0x63, 0xd9, 0xee, 0x08, 0xc4, 0x5f, 0x11, 0x23, 0xe5, 0xf1, 0xd5, 0x28, 0xdb,
-		0x52, 0x15, 0xd8, 0xe9, 0x8f, 0x71, 0x55, 0xf2, 0x13, 0x1c, 0x28, 0xe7, 0x73, 0x71, 0xc3, 0xa1,
-		0x5b, 0xed, 0x8e, 0x66, 0x8f, 0x63, 0xff, 0xfe, 0xa9, 0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2,
-		0xd1, 0x91, 0xd5, 0x7e, 0x0c, 0x84, 0x4f, 0x0a, 0xc3, 0x21, 0x78, 0x38, 0x84, 0x70, 0x18, 0xc6,
-		0x80, 0xf8, 0x55, 0x01, 0x21, 0x78, 0x08, 0xc4, 0xfb, 0xfd, 0x85, 0xd6, 0xc6, 0xbb, 0x86, 0xe3,
-		0xda, 0xcc, 0x25, 0x3f, 0x1c, 0xea, 0x53, 0x5f, 0x0e, 0x3b, 0x61, 0x4a, 0x80, 0x95, 0x58, 0x22,
-		0xbe, 0xf5, 0x41, 0x43, 0xb6, 0xd1, 0x0d, 0xfb, 0x35, 0x61, 0x89, 0x02, 0x6c, 0xa4, 0x6d, 0x01,
-		0x0f, 0x91, 0x88, 0x5d, 0x27, 0x81, 0xca, 0x18, 0x70, 0xff, 0xac, 0xa7, 0x71, 0x75, 0xc1, 0x4b,
-		0x30, 0x03, 0xfe, 0x4f, 0xd7, 0xbc, 0x89, 0x0f, 0xc6, 0xd2, 0xce, 0x4f, 0xf7, 0xf8, 0x3f, 0x5b,
-		0x8c, 0x93, 0xd9, 0x90, 0x42, 0x8f, 0x3f, 0x85, 0x46, 0x9d, 0xf5, 0x9b, 0xfd, 0xf6, 0xb7, 0x78,
-		0x7f, 0xc3, 0xee, 0xd4, 0xd2, 0x2a, 0x51, 0xf2, 0xb0, 0xd3, 0x33, 0x1a, 0xec, 0x43, 0x6f, 0x79,
-		0x7a, 0x1e, 0xf2, 0x79, 0x96, 0xae, 0x40, 0x2e, 0xe4, 0xf0, 0x8c, 0x86, 0xfa, 0x5b, 0x1c, 0x2a,
-		0x1b, 0xf4, 0x77, 0x96, 0x2e, 0x42, 0x9c, 0x38, 0x2f, 0xa3, 0xd9, 0xbf, 0x93, 0xb3, 0x53, 0xf2,
-		0xa5, 0xe7, 0x20, 0x25, 0x9c, 0x96, 0xd1, 0xac, 0xdf, 0xc5, 0x59, 0x3d, 0x16, 0xc2, 0x2e, 0x1c,
-		0x96, 0xd1, 0xec, 0x7f, 0x5b, 0xb0, 0x0b, 0x16, 0xc2, 0x3e, 0xbe, 0x08, 0x7f, 0xe3, 0xef, 0xc4,
-		0xf9, 0xa2, 0x23, 0x64, 0x77, 0x19, 0x92, 0xdc, 0x53, 0x19, 0xcd, 0xfd, 0xdd, 0xfc, 0xe5, 0x82,
-		0x63, 0xe9, 0x69, 0x98, 0x1c, 0x53, 0xe0, 0x7f, 0x97, 0xb3, 0x32, 0xfa, 0xa5, 0x0a, 0x64, 0x02,
-		0xde, 0xc9, 0x68, 0xf6, 0xef, 0xe5, 0xec, 0x41, 0x2e, 0xd2, 0x74, 0xee, 0x9d, 0x8c, 0x06, 0xf8,
-		0x7b, 0xa2, 0xe9, 0x9c, 0x83, 0x88, 0x4d, 0x38, 0x26, 0xa3, 0xb9, 0x3f, 0x22, 0xa4, 0x2e, 0x58,
-		0x96, 0x9e, 0x87, 0xb4, 0xb7, 0xd8, 0x8c, 0xe6, 0xff, 0x3e, 0xce, 0xef, 0xf3, 0x10, 0x09, 0x04,
-		0x16, 0xbb, 0xd1, 0x10, 0x7f, 0x5f, 0x48, 0x20, 0xc0, 0x45, 0xa6, 0x51, 0xaf, 0x03, 0x33, 0x1a,
-		0xe9, 0xfb, 0xc5, 0x34, 0xea, 0xf1, 0x5f, 0xc8, 0x68, 0x52, 0x9b, 0x3f, 0x1a, 0xe2, 0x1f, 0x88,
-		0xd1, 0xa4, 0xf4, 0xa4, 0x19, 0xbd, 0x1e, 0xc1, 0x68, 0x8c, 0x1f, 0x14, 0xcd, 0xe8, 0x71, 0x08,
-		0x96, 0x36, 0x01, 0xf5, 0x7b, 0x03, 0xa3, 0xf1, 0x5e, 0xe7, 0x78, 0x53, 0x7d, 0xce, 0xc0, 0xd2,
-		0x0b, 0x70, 0x7c, 0xb0, 0x27, 0x30, 0x1a, 0xf5, 0x87, 0xde, 0xea, 0x89, 0xdd, 0x82, 0x8e, 0xc0,
-		0x52, 0xc3, 0x5f, 0x52, 0x82, 0x5e, 0xc0, 0x68, 0xd8, 0x1f, 0x7e, 0x2b, 0x6c, 0xb8, 0x83, 0x4e,
-		0xc0, 0x52, 0x09, 0xc0, 0x5f, 0x80, 0x47, 0x63, 0xfd, 0x28, 0xc7, 0x0a, 0x30, 0x91, 0xa9, 0xc1,
-		0xd7, 0xdf, 0xd1, 0xfc, 0x77, 0xc5, 0xd4, 0xe0, 0x1c, 0x64, 0x6a, 0x88, 0xa5, 0x77, 0x34, 0xf7,
-		0x8f, 0x89, 0xa9, 0x21, 0x58, 0x88, 0x66, 0x07, 0x56, 0xb7, 0xd1, 0x08, 0x1f, 0x13, 0x9a, 0x1d,
-		0xe0, 0x5a, 0x5a, 0x87, 0xa9, 0xbe, 0x05, 0x71, 0x34, 0xd4, 0x8f, 0x73, 0x28, 0xa9, 0x77, 0x3d,
-		0x0c, 0x2e, 0x5e, 0x7c, 0x31, 0x1c, 0x8d, 0xf6, 0x13, 0x3d, 0x8b, 0x17, 0x5f, 0x0b, 0x97, 0x2e,
-		0x43, 0xca, 0xec, 0xb6, 0x5a, 0x64, 0xf2, 0xa0, 0xc3, 0xcf, 0xe7, 0xce, 0xfe, 0xb7, 0xaf, 0x72,
-		0xe9, 0x08, 0x86, 0xa5, 0x8b, 0x30, 0x89, 0xdb, 0xdb, 0xb8, 0x39, 0x8a, 0xf3, 0x8b, 0x5f, 0x15,
-		0x06, 0x93, 0x50, 0x2f, 0x3d, 0x0f, 0xc0, 0x52, 0x23, 0x74, 0xe3, 0x7c, 0x04, 0xef, 0x97, 0xbe,
-		0xca, 0x0f, 0xc4, 0xf9, 0x2c, 0x3e, 0x00, 0x3b, 0x5e, 0x77, 0x38, 0xc0, 0x97, 0xc3, 0x00, 0x74,
-		0x44, 0x9e, 0x85, 0xe4, 0x2b, 0x8e, 0x65, 0xba, 0xda, 0xee, 0x28, 0xee, 0x3f, 0xe5, 0xdc, 0x82,
-		0x9e, 0x08, 0xac, 0x6d, 0xd9, 0xd8, 0xd5, 0x76, 0x9d, 0x51, 0xbc, 0xff, 0x9d, 0xf3, 0x7a, 0x0c,
-		0x84, 0x59, 0xd7, 0x1c, 0x77, 0x9c, 0x7e, 0xff, 0x99, 0x60, 0x16, 0x0c, 0xa4, 0xd1, 0xe4, 0xf7,
-		0x4d, 0x7c, 0x30, 0x8a, 0xf7, 0xcf, 0x45, 0xa3, 0x39, 0xfd, 0xd2, 0x73, 0x90, 0x26, 0x3f, 0xd9,
-		0x29, 0xd7, 0x11, 0xcc, 0x7f, 0xc1, 0x99, 0x7d, 0x0e, 0xf2, 0x66, 0xc7, 0x6d, 0xba, 0xc6, 0x68,
-		0x61, 0x7f, 0x85, 0x8f, 0xb4, 0xa0, 0x5f, 0x2a, 0x41, 0xc6, 0x71, 0x9b, 0xcd, 0x2e, 0xf7, 0x4f,
-		0x47, 0xb0, 0xff, 0x8f, 0xaf, 0x7a, 0x29, 0x0b, 0x8f, 0x87, 0x8c, 0xf6, 0xed, 0x9b, 0x6e, 0xc7,
-		0xa2, 0xfb, 0x2d, 0xa3, 0x10, 0xde, 0xe2, 0x08, 0x01, 0x96, 0xa5, 0x0a, 0x64, 0x49, 0x5f, 0x6c,
-		0xdc, 0xc1, 0x74, 0x73, 0x6c, 0x04, 0xc4, 0x5f, 0x72, 0x01, 0x84, 0x98, 0xca, 0xdf, 0xfa, 0x99,
-		0x37, 0xe7, 0x22, 0x9f, 0x7d, 0x73, 0x2e, 0xf2, 0x47, 0x6f, 0xce, 0x45, 0x3e, 0xf2, 0xb9, 0xb9,
-		0x89, 0xcf, 0x7e, 0x6e, 0x6e, 0xe2, 0xf7, 0x3f, 0x37, 0x37, 0x31, 0x38, 0x4b, 0x0c, 0x2b, 0xd6,
-		0x8a, 0xc5, 0xf2, 0xc3, 0x2f, 0x3f, 0xb4, 0x6b, 0xb8, 0x7b, 0xdd, 0xed, 0x45, 0xdd, 0x6a, 0x9f,
-		0xd7, 0x2d, 0xa7, 0x6d, 0x39, 0xe7, 0xc3, 0x79, 0x5d, 0xfa, 0x0b, 0xfe, 0x2a, 0x42, 0x62, 0xe6,
-		0x70, 0x3a, 0x57, 0x33, 0x0f, 0x86, 0x7d, 0x4c, 0x77, 0x09, 0x62, 0x25, 0xf3, 0x00, 0x9d, 0x64,
-		0x06, 0x4e, 0xed, 0xda, 0x2d, 0x7e, 0xd4, 0x32, 0x49, 0x9e, 0xb7, 0xec, 0x16, 0x9a, 0xf1, 0xcf,
-		0x43, 0x47, 0xce, 0x64, 0xf9, 0x21, 0xe7, 0xf2, 0xf7, 0x46, 0x8e, 0xd6, 0x93, 0x54, 0xc9, 0x3c,
-		0xa0, 0x1d, 0xd9, 0x8c, 0xbc, 0xfc, 0xe8, 0xc8, 0x3c, 0xf7, 0x4d, 0xd3, 0xba, 0x6d, 0x92, 0x66,
-		0x77, 0xb6, 0x45, 0x8e, 0x7b, 0xae, 0x37, 0xc7, 0xfd, 0x02, 0x6e, 0xb5, 0xae, 0x13, 0xba, 0x06,
-		0x61, 0xd9, 0x4e, 0xb0, 0x53, 0xfd, 0xf0, 0xfd, 0x51, 0x98, 0xeb, 0x4b, 0x67, 0x73, 0x25, 0x18,
-		0x26, 0x84, 0x25, 0x48, 0x2d, 0x0b, 0xdd, 0x9a, 0x85, 0xa4, 0x83, 0x75, 0xcb, 0x6c, 0x3a, 0x54,
-		0x10, 0x31, 0x45, 0x3c, 0x12, 0x41, 0x98, 0x9a, 0x69, 0x39, 0xfc, 0xb0, 0x32, 0x7b, 0x28, 0xff,
-		0xc8, 0x11, 0x05, 0x91, 0x13, 0x6f, 0x12, 0xd2, 0x78, 0x62, 0x4c, 0x69, 0x88, 0x4e, 0x84, 0x32,
-		0xff, 0xe3, 0x4a, 0xe5, 0x07, 0xa3, 0x30, 0xdf, 0x2b, 0x15, 0x32, 0xb3, 0x1c, 0x57, 0x6b, 0x77,
-		0x86, 0x89, 0xe5, 0x32, 0xa4, 0x1b, 0x82, 0xe6, 0xc8, 0x72, 0xb9, 0x7b, 0x44, 0xb9, 0xe4, 0xbd,
-		0x57, 0x09, 0xc1, 0x5c, 0x18, 0x53, 0x30, 0x5e, 0x3f, 0xee, 0x49, 0x32, 0xff, 0x3b, 0x01, 0x27,
-		0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x0f, 0x5c, 0x26, 0xd9, 0x60, 0xd5, 0xe8, 0x7d, 0x12, 0xf9,
-		0x3a, 0x4c, 0xd7, 0x88, 0xb5, 0x20, 0x51, 0x90, 0xbf, 0xc3, 0x33, 0xf0, 0x3c, 0xf7, 0x42, 0xc8,
-		0xe1, 0xe7, 0xfb, 0x5b, 0xc1, 0x22, 0xf9, 0xdb, 0x23, 0x20, 0xd5, 0x75, 0xad, 0xa5, 0xd9, 0x5f,
-		0x2b, 0x14, 0x7a, 0x1a, 0x80, 0x1d, 0xf7, 0xf0, 0x3e, 0xdc, 0xcb, 0x5f, 0x98, 0x5d, 0x0c, 0x76,
-		0x6e, 0x91, 0xbd, 0x89, 0x9e, 0xa0, 0x4a, 0x53, 0x5a, 0xf2, 0xf3, 0xdc, 0x8b, 0x00, 0x7e, 0x05,
-		0x3a, 0x05, 0x27, 0xea, 0x95, 0xd2, 0x6a, 0x49, 0x11, 0x87, 0x84, 0xea, 0x9b, 0xd5, 0x4a, 0xed,
-		0x4a, 0xad, 0xba, 0x2c, 0x4d, 0xa0, 0xe3, 0x80, 0x82, 0x95, 0xde, 0xa1, 0xa6, 0x63, 0x30, 0x15,
-		0x2c, 0x67, 0x5f, 0xa9, 0x44, 0x89, 0xa7, 0x68, 0xb4, 0x3b, 0x2d, 0x4c, 0x77, 0x1e, 0x55, 0x43,
-		0x48, 0x6d, 0xb4, 0x13, 0xf2, 0xdb, 0xff, 0x91, 0x7d, 0xb9, 0x30, 0xed, 0xb3, 0x7b, 0x32, 0x5f,
-		0x5a, 0x85, 0x29, 0x4d, 0xd7, 0x71, 0x27, 0x04, 0x39, 0xc2, 0x54, 0x13, 0x40, 0xba, 0x97, 0xca,
-		0x39, 0x7d, 0xb4, 0xa7, 0x21, 0xe1, 0xd0, 0xde, 0x8f, 0x82, 0xf8, 0x1d, 0x0e, 0xc1, 0xc9, 0x97,
-		0x4c, 0x98, 0x22, 0x9e, 0x9f, 0x66, 0xe3, 0x40, 0x33, 0x0e, 0xcf, 0x33, 0xfc, 0xf3, 0x5f, 0x79,
-		0x9c, 0xee, 0xac, 0x3e, 0x10, 0x1e, 0x96, 0x01, 0xea, 0xa4, 0x48, 0x1c, 0xdb, 0x6f, 0x28, 0x86,
-		0xbc, 0x78, 0x1f, 0x6f, 0xf0, 0xe1, 0x2f, 0xfb, 0x75, 0xfe, 0xb2, 0xb9, 0x41, 0x3a, 0x10, 0x78,
-		0x53, 0x8e, 0xa3, 0xb2, 0x8a, 0x72, 0x75, 0xd8, 0x9c, 0x7e, 0xf9, 0x91, 0xfe, 0xd5, 0x89, 0xfd,
-		0xf7, 0x18, 0x45, 0xbe, 0x1c, 0x7c, 0x8d, 0x37, 0xf7, 0xfe, 0x20, 0x06, 0x53, 0x5a, 0xdb, 0x30,
-		0xad, 0xf3, 0xf4, 0x5f, 0x3e, 0xe7, 0x26, 0xe9, 0xc3, 0x18, 0x9b, 0x92, 0x97, 0xd8, 0x54, 0x18,
-		0xad, 0x31, 0x7f, 0xf1, 0x3d, 0x3f, 0x3d, 0xe9, 0x4f, 0x97, 0xa5, 0x35, 0x90, 0xc4, 0x21, 0x5e,
-		0x6c, 0xea, 0x56, 0x73, 0xac, 0x2c, 0xc5, 0x57, 0x04, 0x86, 0xc8, 0x6f, 0x55, 0x39, 0xeb, 0xd2,
-		0x7b, 0x21, 0xe5, 0xc1, 0x8c, 0xf2, 0x4c, 0x04, 0x88, 0xc7, 0x41, 0xfc, 0x12, 0x36, 0x33, 0xc7,
-		0xf1, 0x42, 0xdf, 0x12, 0xfc, 0x6c, 0x86, 0xae, 0x93, 0xde, 0xac, 0x40, 0xbe, 0x69, 0x99, 0xae,
-		0x6a, 0xb5, 0x0d, 0x17, 0xb7, 0x3b, 0xee, 0x48, 0xbf, 0xee, 0x2f, 0x19, 0x48, 0x4a, 0xc9, 0x11,
-		0xbe, 0x0d, 0xc1, 0x76, 0x4f, 0x83, 0xeb, 0x34, 0x6f, 0x72, 0x5b, 0xec, 0xee, 0xb3, 0x41, 0xf4,
-		0x06, 0xf7, 0xcf, 0x62, 0x30, 0xc7, 0x89, 0xb7, 0x35, 0x07, 0x9f, 0xbf, 0xf5, 0xc4, 0x36, 0x76,
-		0xb5, 0x27, 0xce, 0xeb, 0x96, 0x21, 0x16, 0xe2, 0x69, 0x6e, 0x6b, 0x49, 0xfd, 0x22, 0xaf, 0x2f,
-		0x0e, 0xdc, 0xad, 0x2e, 0x0e, 0xb7, 0xd1, 0xc5, 0x7e, 0x15, 0x92, 0x5f, 0x86, 0x78, 0xc5, 0x32,
-		0x4c, 0xb2, 0x34, 0x35, 0xb1, 0x69, 0xb5, 0xb9, 0xb5, 0x64, 0x0f, 0xe8, 0x12, 0x24, 0xb4, 0xb6,
-		0xd5, 0x35, 0x5d, 0x66, 0x29, 0xcb, 0x73, 0x9f, 0x79, 0x63, 0x7e, 0xe2, 0x0f, 0xde, 0x98, 0x8f,
-		0xd5, 0x4c, 0xf7, 0xf7, 0x3e, 0xf1, 0x18, 0x70, 0xf4, 0x9a, 0xe9, 0x7e, 0xfc, 0xf3, 0xbf, 0x78,
-		0x2e, 0xa2, 0x70, 0xea, 0xa5, 0xf8, 0x17, 0x3e, 0x3a, 0x1f, 0x91, 0x5f, 0x84, 0xe4, 0x32, 0xd6,
-		0x0f, 0x81, 0x7f, 0xa2, 0x07, 0xfe, 0xa4, 0x80, 0x5f, 0xc6, 0x7a, 0x00, 0x7e, 0x19, 0xeb, 0x3d,
-		0xc8, 0x4f, 0x43, 0xaa, 0x66, 0xba, 0xec, 0x0b, 0xa0, 0x47, 0x20, 0x66, 0x98, 0xec, 0x50, 0x79,
-		0x00, 0xa1, 0xaf, 0x81, 0x0a, 0xa1, 0x22, 0x8c, 0xcb, 0x58, 0xf7, 0x18, 0x9b, 0x58, 0xef, 0x65,
-		0xec, 0x7f, 0x35, 0xa1, 0x2a, 0x2f, 0xff, 0xfe, 0x1f, 0xcf, 0x4d, 0xbc, 0xf6, 0xe6, 0xdc, 0xc4,
-		0xd0, 0xa1, 0x97, 0x47, 0x0f, 0xbd, 0x37, 0xe2, 0x3f, 0x1d, 0x87, 0xfb, 0xe9, 0x87, 0xa1, 0x76,
-		0xdb, 0x30, 0xdd, 0xf3, 0xba, 0x7d, 0xd0, 0x71, 0x2d, 0x32, 0x7f, 0xad, 0x1d, 0x3e, 0xe0, 0x53,
-		0x7e, 0xf5, 0x22, 0xab, 0x1e, 0x3c, 0xdc, 0xf2, 0x0e, 0x4c, 0x6e, 0x12, 0x3e, 0x22, 0x62, 0xd7,
-		0x72, 0xb5, 0x16, 0x77, 0x3a, 0xd8, 0x03, 0x29, 0x65, 0x1f, 0x93, 0x46, 0x59, 0xa9, 0x21, 0xbe,
-		0x23, 0x6d, 0x61, 0x6d, 0x87, 0x7d, 0x93, 0x13, 0xa3, 0xde, 0x6a, 0x8a, 0x14, 0xd0, 0xcf, 0x6f,
-		0x66, 0x60, 0x52, 0xeb, 0xb2, 0x73, 0x33, 0x31, 0xe2, 0xc6, 0xd2, 0x07, 0xf9, 0x3a, 0x24, 0xf9,
-		0xf6, 0x39, 0x92, 0x20, 0x76, 0x13, 0x1f, 0xd0, 0xf7, 0x64, 0x15, 0xf2, 0x13, 0x2d, 0xc2, 0x24,
-		0x6d, 0x3c, 0xff, 0xd8, 0x70, 0x76, 0xb1, 0xaf, 0xf5, 0x8b, 0xb4, 0x91, 0x0a, 0x23, 0x93, 0xaf,
-		0x41, 0x6a, 0xd9, 0x22, 0x5a, 0x18, 0x46, 0x4b, 0x33, 0x34, 0xda, 0xe6, 0x4e, 0x97, 0x6b, 0x85,
-		0xc2, 0x1e, 0xd0, 0x71, 0x48, 0xb0, 0x6f, 0xb4, 0xf8, 0xd9, 0x1f, 0xfe, 0x24, 0x57, 0x20, 0x49,
-		0xb1, 0x37, 0x3a, 0x64, 0xc5, 0xf7, 0x8e, 0xad, 0xa7, 0xf9, 0x17, 0xbb, 0x1c, 0x3e, 0xea, 0x37,
-		0x16, 0x41, 0xbc, 0xa9, 0xb9, 0x1a, 0xef, 0x37, 0xfd, 0x2d, 0xbf, 0x0f, 0x52, 0x1c, 0xc4, 0x41,
-		0x17, 0x20, 0x66, 0x75, 0x1c, 0x7e, 0x7a, 0xa7, 0x38, 0xac, 0x2b, 0x1b, 0x9d, 0x72, 0x9c, 0xe8,
-		0x8c, 0x42, 0x88, 0xcb, 0xeb, 0x43, 0xd5, 0xe2, 0xa9, 0x90, 0x5a, 0xb4, 0xb1, 0xbb, 0xbd, 0xe3,
-		0xfa, 0x3f, 0xd8, 0x70, 0xf6, 0xa9, 0x82, 0xa7, 0x28, 0x77, 0xa3, 0x30, 0x17, 0xa8, 0xbd, 0x85,
-		0x6d, 0xc7, 0xb0, 0x4c, 0xa6, 0x4d, 0x5c, 0x53, 0x50, 0xa0, 0x81, 0xbc, 0x7e, 0x88, 0xaa, 0x3c,
-		0x07, 0xb1, 0x52, 0xa7, 0x83, 0x8a, 0x90, 0xa2, 0xcf, 0xba, 0xc5, 0x74, 0x25, 0xae, 0x78, 0xcf,
-		0xa4, 0xce, 0xb1, 0x76, 0xdc, 0xdb, 0x9a, 0xed, 0x7d, 0xc2, 0x2c, 0x9e, 0xe5, 0x67, 0x21, 0x5d,
-		0xb1, 0x4c, 0x07, 0x9b, 0x4e, 0x97, 0xba, 0xb2, 0xdb, 0x2d, 0x4b, 0xbf, 0xc9, 0x11, 0xd8, 0x03,
-		0x11, 0xb6, 0xd6, 0xe9, 0x50, 0xce, 0xb8, 0x42, 0x7e, 0xb2, 0xf9, 0x5a, 0xde, 0x18, 0x2a, 0x9e,
-		0x8b, 0x47, 0x13, 0x0f, 0xef, 0xa0, 0xef, 0x93, 0x46, 0xe0, 0xbe, 0xfe, 0x89, 0x74, 0x13, 0x1f,
-		0x38, 0x47, 0x9d, 0x47, 0x2f, 0x42, 0x7a, 0x93, 0xde, 0x2e, 0x72, 0x1d, 0x1f, 0xa0, 0x22, 0x24,
-		0x71, 0xf3, 0xc2, 0xc5, 0x8b, 0x4f, 0x3c, 0xcb, 0xb4, 0xfc, 0xea, 0x84, 0x22, 0x0a, 0xd0, 0x1c,
-		0xa4, 0x1d, 0xac, 0x77, 0x2e, 0x5c, 0xbc, 0x74, 0xf3, 0x09, 0xa6, 0x56, 0x57, 0x27, 0x14, 0xbf,
-		0x68, 0x29, 0x45, 0x7a, 0xfc, 0x85, 0x8f, 0xcd, 0x47, 0xca, 0x93, 0x10, 0x73, 0xba, 0xed, 0x77,
-		0x4c, 0x37, 0x7e, 0x60, 0x12, 0x16, 0x02, 0xb5, 0x6c, 0x71, 0xb9, 0xa5, 0xb5, 0x8c, 0xa6, 0xe6,
-		0xdf, 0x09, 0x23, 0x05, 0xfa, 0x4f, 0x29, 0x86, 0xac, 0x1a, 0x87, 0x4a, 0x51, 0xfe, 0xe5, 0x08,
-		0x64, 0x6f, 0x08, 0xe4, 0x3a, 0x76, 0xd1, 0x65, 0x00, 0xef, 0x4d, 0x62, 0xaa, 0x9c, 0x5a, 0xec,
-		0x7d, 0xd7, 0xa2, 0xc7, 0xa3, 0x04, 0xc8, 0xd1, 0xd3, 0x54, 0x01, 0x3b, 0x96, 0xc3, 0x3f, 0x67,
-		0x1d, 0xc1, 0xea, 0x11, 0xa3, 0x47, 0x01, 0x51, 0xab, 0xa6, 0xde, 0xb2, 0x5c, 0xc3, 0xdc, 0x55,
-		0x3b, 0xd6, 0x6d, 0x7e, 0x49, 0x40, 0x4c, 0x91, 0x68, 0xcd, 0x0d, 0x5a, 0xb1, 0x49, 0xca, 0x49,
-		0xa3, 0xd3, 0x1e, 0x0a, 0x89, 0xca, 0xb4, 0x66, 0xd3, 0xc6, 0x8e, 0xc3, 0x0d, 0x97, 0x78, 0x44,
-		0x97, 0x21, 0xd9, 0xe9, 0x6e, 0xab, 0xc2, 0x4a, 0x64, 0x2e, 0xdc, 0x37, 0x68, 0xce, 0x0b, 0xdd,
-		0xe0, 0xb3, 0x3e, 0xd1, 0xe9, 0x6e, 0x13, 0x4d, 0x79, 0x00, 0xb2, 0x03, 0x1a, 0x93, 0xb9, 0xe5,
-		0xb7, 0x83, 0x5e, 0x68, 0xc3, 0x7b, 0xa0, 0x76, 0x6c, 0xc3, 0xb2, 0x0d, 0xf7, 0x80, 0x9e, 0xba,
-		0x8b, 0x29, 0x92, 0xa8, 0xd8, 0xe4, 0xe5, 0xf2, 0x4d, 0x28, 0xd4, 0xa9, 0xb7, 0xee, 0xb7, 0xfc,
-		0xa2, 0xdf, 0xbe, 0xc8, 0xe8, 0xf6, 0x0d, 0x6d, 0x59, 0xb4, 0xaf, 0x65, 0xe5, 0xb5, 0xa1, 0x9a,
-		0xf9, 0xe4, 0xd1, 0x34, 0x33, 0xbc, 0xba, 0x7d, 0xe9, 0x64, 0x68, 0x52, 0x72, 0xaf, 0x27, 0x60,
-		0xb2, 0xc6, 0x55, 0xca, 0x51, 0x81, 0x78, 0xf1, 0xf0, 0x45, 0xb4, 0x38, 0xc2, 0x74, 0x16, 0x47,
-		0x4e, 0x1f, 0xf9, 0x59, 0xc8, 0x6d, 0x6a, 0xb6, 0x5b, 0xc7, 0xee, 0x55, 0xac, 0x35, 0xb1, 0x1d,
-		0x5e, 0x65, 0x73, 0x62, 0x95, 0x45, 0x10, 0xa7, 0x4b, 0x29, 0x5b, 0x65, 0xe8, 0x6f, 0x79, 0x0f,
-		0xe2, 0xf4, 0xd8, 0xad, 0xb7, 0x02, 0x73, 0x0e, 0xb6, 0x02, 0x13, 0xfb, 0x79, 0xe0, 0x62, 0x47,
-		0xe4, 0x8a, 0xe8, 0x03, 0x7a, 0x4a, 0xac, 0xa3, 0xb1, 0xc3, 0xd7, 0x51, 0xae, 0x84, 0x7c, 0x35,
-		0x6d, 0x41, 0xb2, 0x4c, 0xcc, 0x6f, 0x6d, 0xd9, 0x6b, 0x48, 0xc4, 0x6f, 0x08, 0x5a, 0x83, 0x42,
-		0x47, 0xb3, 0x5d, 0xfa, 0x19, 0xde, 0x1e, 0xed, 0x05, 0xd7, 0xf3, 0xf9, 0xfe, 0x59, 0x17, 0xea,
-		0x2c, 0x7f, 0x4b, 0xae, 0x13, 0x2c, 0x94, 0xff, 0x6b, 0x1c, 0x12, 0x5c, 0x18, 0xcf, 0x41, 0x92,
-		0x8b, 0x95, 0x6b, 0xe6, 0xfd, 0x8b, 0xfd, 0x8b, 0xd1, 0xa2, 0xb7, 0x68, 0x70, 0x3c, 0xc1, 0x83,
-		0x1e, 0x86, 0x94, 0xbe, 0xa7, 0x19, 0xa6, 0x6a, 0x34, 0xb9, 0x03, 0x98, 0x79, 0xf3, 0x8d, 0xf9,
-		0x64, 0x85, 0x94, 0xd5, 0x96, 0x95, 0x24, 0xad, 0xac, 0x35, 0xc9, 0xca, 0xbf, 0x87, 0x8d, 0xdd,
-		0x3d, 0x97, 0xcf, 0x2e, 0xfe, 0x84, 0x9e, 0x81, 0x38, 0x51, 0x08, 0xfe, 0x91, 0x76, 0xb1, 0xcf,
-		0x97, 0xf7, 0xf2, 0x24, 0xe5, 0x14, 0x79, 0xf1, 0x47, 0xfe, 0xcb, 0x7c, 0x44, 0xa1, 0x1c, 0xa8,
-		0x02, 0xb9, 0x96, 0xe6, 0xb8, 0x2a, 0x5d, 0xb5, 0xc8, 0xeb, 0x27, 0x29, 0xc4, 0xc9, 0x7e, 0x81,
-		0x70, 0xc1, 0xf2, 0xa6, 0x67, 0x08, 0x17, 0x2b, 0x6a, 0xa2, 0x33, 0x20, 0x51, 0x10, 0xdd, 0x6a,
-		0xb7, 0x0d, 0x97, 0xf9, 0x52, 0x09, 0x2a, 0xf7, 0x3c, 0x29, 0xaf, 0xd0, 0x62, 0xea, 0x51, 0x9d,
-		0x82, 0x34, 0xfd, 0x2c, 0x94, 0x92, 0xb0, 0xb3, 0xde, 0x29, 0x52, 0x40, 0x2b, 0x4f, 0x43, 0xc1,
-		0xb7, 0x8d, 0x8c, 0x24, 0xc5, 0x50, 0xfc, 0x62, 0x4a, 0xf8, 0x38, 0xcc, 0x98, 0x78, 0x9f, 0x9e,
-		0x3e, 0x0f, 0x51, 0xa7, 0x29, 0x35, 0x22, 0x75, 0x37, 0xc2, 0x1c, 0x0f, 0x41, 0x5e, 0x17, 0xc2,
-		0x67, 0xb4, 0x40, 0x69, 0x73, 0x5e, 0x29, 0x25, 0x3b, 0x09, 0x29, 0xad, 0xd3, 0x61, 0x04, 0x19,
-		0x6e, 0x1b, 0x3b, 0x1d, 0x5a, 0x75, 0x0e, 0xa6, 0x68, 0x1f, 0x6d, 0xec, 0x74, 0x5b, 0x2e, 0x07,
-		0xc9, 0x52, 0x9a, 0x02, 0xa9, 0x50, 0x58, 0x39, 0xa5, 0x7d, 0x10, 0x72, 0xf8, 0x96, 0xd1, 0xc4,
-		0xa6, 0x8e, 0x19, 0x5d, 0x8e, 0xd2, 0x65, 0x45, 0x21, 0x25, 0x3a, 0x0b, 0x9e, 0xcd, 0x53, 0x85,
-		0x3d, 0xce, 0x33, 0x3c, 0x51, 0x5e, 0x62, 0xc5, 0xf2, 0x2c, 0xc4, 0x97, 0x35, 0x57, 0x23, 0x4e,
-		0x85, 0xbb, 0xcf, 0x16, 0x99, 0xac, 0x42, 0x7e, 0xca, 0x5f, 0x88, 0x42, 0xfc, 0x86, 0xe5, 0x62,
-		0xf4, 0x64, 0xc0, 0xe1, 0xcb, 0x0f, 0xd2, 0xe7, 0xba, 0xb1, 0x6b, 0xe2, 0xe6, 0x9a, 0xb3, 0x1b,
-		0xb8, 0xc3, 0xc5, 0x57, 0xa7, 0x68, 0x48, 0x9d, 0x66, 0x60, 0xd2, 0xb6, 0xba, 0x66, 0x53, 0x9c,
-		0x92, 0xa6, 0x0f, 0xa8, 0x0a, 0x29, 0x4f, 0x4b, 0xe2, 0xa3, 0xb4, 0xa4, 0x40, 0xb4, 0x84, 0xe8,
-		0x30, 0x2f, 0x50, 0x92, 0xdb, 0x5c, 0x59, 0xca, 0x90, 0xf6, 0x8c, 0x17, 0xd7, 0xb6, 0xf1, 0x14,
-		0xd6, 0x67, 0x23, 0x0b, 0x89, 0x37, 0xf6, 0x9e, 0xf0, 0x98, 0xc6, 0x49, 0x5e, 0x05, 0x97, 0x5e,
-		0x48, 0xad, 0xf8, 0x7d, 0x32, 0x49, 0xda, 0x2f, 0x5f, 0xad, 0xd8, 0x9d, 0x32, 0xf7, 0x41, 0xda,
-		0x31, 0x76, 0x4d, 0xcd, 0xed, 0xda, 0x98, 0x6b, 0x9e, 0x5f, 0x20, 0xff, 0x46, 0x04, 0x12, 0x4c,
-		0x93, 0x03, 0x72, 0x8b, 0x0c, 0x96, 0x5b, 0x74, 0x98, 0xdc, 0x62, 0xf7, 0x2e, 0xb7, 0x12, 0x80,
-		0xd7, 0x18, 0x87, 0x5f, 0xf3, 0x31, 0xc0, 0x5b, 0x60, 0x4d, 0xac, 0x1b, 0xbb, 0x7c, 0xa2, 0x06,
-		0x98, 0xe4, 0xff, 0x1c, 0x21, 0x8e, 0x2b, 0xaf, 0x47, 0x25, 0xc8, 0x89, 0x76, 0xa9, 0x3b, 0x2d,
-		0x6d, 0x97, 0xeb, 0xce, 0xfd, 0x43, 0x1b, 0x77, 0xa5, 0xa5, 0xed, 0x2a, 0x19, 0xde, 0x1e, 0xf2,
-		0x30, 0x78, 0x1c, 0xa2, 0x43, 0xc6, 0x21, 0x34, 0xf0, 0xb1, 0x7b, 0x1b, 0xf8, 0xd0, 0x10, 0xc5,
-		0x7b, 0x87, 0xe8, 0x57, 0xa2, 0x34, 0x78, 0xe9, 0x58, 0x8e, 0xd6, 0x7a, 0x37, 0x66, 0xc4, 0x29,
-		0x48, 0x77, 0xac, 0x96, 0xca, 0x6a, 0xd8, 0xd7, 0x03, 0xa9, 0x8e, 0xd5, 0x52, 0xfa, 0x86, 0x7d,
-		0xf2, 0x6d, 0x9a, 0x2e, 0x89, 0xb7, 0x41, 0x6a, 0xc9, 0x5e, 0xa9, 0xd9, 0x90, 0x65, 0xa2, 0xe0,
-		0x6b, 0xd9, 0xe3, 0x44, 0x06, 0x74, 0x71, 0x8c, 0xf4, 0xaf, 0xbd, 0xac, 0xd9, 0x8c, 0x52, 0xe1,
-		0x74, 0x84, 0x83, 0x99, 0xfe, 0x41, 0x51, 0x6f, 0x50, 0x2d, 0x15, 0x4e, 0x27, 0xff, 0x40, 0x04,
-		0x60, 0x95, 0x48, 0x96, 0xf6, 0x97, 0xac, 0x42, 0x0e, 0x6d, 0x82, 0x1a, 0x7a, 0xf3, 0xdc, 0xb0,
-		0x41, 0xe3, 0xef, 0xcf, 0x3a, 0xc1, 0x76, 0x57, 0x20, 0xe7, 0x2b, 0xa3, 0x83, 0x45, 0x63, 0xe6,
-		0x0e, 0xf1, 0xa8, 0xeb, 0xd8, 0x55, 0xb2, 0xb7, 0x02, 0x4f, 0xf2, 0xbf, 0x8c, 0x40, 0x9a, 0xb6,
-		0x69, 0x0d, 0xbb, 0x5a, 0x68, 0x0c, 0x23, 0xf7, 0x3e, 0x86, 0xf7, 0x03, 0x30, 0x18, 0xc7, 0x78,
-		0x15, 0x73, 0xcd, 0x4a, 0xd3, 0x92, 0xba, 0xf1, 0x2a, 0x46, 0x97, 0x3c, 0x81, 0xc7, 0x0e, 0x17,
-		0xb8, 0xf0, 0xb8, 0xb9, 0xd8, 0x4f, 0x40, 0x92, 0x5e, 0x8b, 0xb7, 0xef, 0x70, 0x27, 0x3a, 0x61,
-		0x76, 0xdb, 0x8d, 0x7d, 0x47, 0x7e, 0x05, 0x92, 0x8d, 0x7d, 0x96, 0x0b, 0x39, 0x05, 0x69, 0xdb,
-		0xb2, 0xf8, 0x9a, 0xcc, 0x7c, 0xa1, 0x14, 0x29, 0xa0, 0x4b, 0x90, 0x88, 0xff, 0xa3, 0x7e, 0xfc,
-		0xef, 0x27, 0x30, 0x62, 0x63, 0x25, 0x30, 0xce, 0xfd, 0xa7, 0x08, 0x64, 0x02, 0xf6, 0x01, 0x3d,
-		0x01, 0xc7, 0xca, 0xab, 0x1b, 0x95, 0xeb, 0x6a, 0x6d, 0x59, 0xbd, 0xb2, 0x5a, 0x5a, 0xf1, 0x3f,
-		0x90, 0x2b, 0x1e, 0xbf, 0x73, 0x77, 0x01, 0x05, 0x68, 0xb7, 0x4c, 0xba, 0x19, 0x83, 0xce, 0xc3,
-		0x4c, 0x98, 0xa5, 0x54, 0xae, 0x57, 0xd7, 0x1b, 0x52, 0xa4, 0x78, 0xec, 0xce, 0xdd, 0x85, 0xa9,
-		0x00, 0x47, 0x69, 0xdb, 0xc1, 0xa6, 0xdb, 0xcf, 0x50, 0xd9, 0x58, 0x5b, 0xab, 0x35, 0xa4, 0x68,
-		0x1f, 0x03, 0x37, 0xd8, 0x67, 0x61, 0x2a, 0xcc, 0xb0, 0x5e, 0x5b, 0x95, 0x62, 0x45, 0x74, 0xe7,
-		0xee, 0x42, 0x3e, 0x40, 0xbd, 0x6e, 0xb4, 0x8a, 0xa9, 0x0f, 0xff, 0xc4, 0xdc, 0xc4, 0xc7, 0x7f,
-		0x72, 0x2e, 0x42, 0x7a, 0x96, 0x0b, 0xd9, 0x08, 0xf4, 0x28, 0x9c, 0xa8, 0xd7, 0x56, 0xd6, 0xab,
-		0xcb, 0xea, 0x5a, 0x7d, 0xa5, 0xe7, 0x9b, 0xe7, 0x62, 0xe1, 0xce, 0xdd, 0x85, 0x0c, 0xef, 0xd2,
-		0x30, 0xea, 0x4d, 0xa5, 0x7a, 0x63, 0xa3, 0x51, 0x95, 0x22, 0x8c, 0x7a, 0xd3, 0xc6, 0xb7, 0x2c,
-		0x97, 0xdd, 0xa8, 0xf9, 0x38, 0x9c, 0x1c, 0x40, 0xed, 0x75, 0x6c, 0xea, 0xce, 0xdd, 0x85, 0xdc,
-		0xa6, 0x8d, 0xd9, 0xfc, 0xa1, 0x1c, 0x8b, 0x30, 0xdb, 0xcf, 0xb1, 0xb1, 0xb9, 0x51, 0x2f, 0xad,
-		0x4a, 0x0b, 0x45, 0xe9, 0xce, 0xdd, 0x85, 0xac, 0x30, 0x86, 0x84, 0xde, 0xef, 0xd9, 0x3b, 0x15,
-		0xed, 0x7c, 0x57, 0x32, 0x94, 0xcb, 0x63, 0x71, 0x44, 0x47, 0xb3, 0xb5, 0xf6, 0x51, 0xc3, 0x9d,
-		0x11, 0xbb, 0xb1, 0xf2, 0x6b, 0x51, 0x28, 0x78, 0xce, 0xf4, 0x26, 0x7d, 0x03, 0x7a, 0x32, 0x98,
-		0x87, 0xc9, 0x0c, 0x5d, 0xc6, 0x18, 0xb5, 0x48, 0xd3, 0xbc, 0x17, 0x52, 0xc2, 0x29, 0xe3, 0xe6,
-		0x62, 0xa1, 0x9f, 0xaf, 0xca, 0x29, 0x38, 0xab, 0xc7, 0x81, 0x9e, 0x87, 0xb4, 0x67, 0x3c, 0xbc,
-		0x5b, 0xa7, 0x86, 0x5b, 0x1b, 0xce, 0xef, 0xf3, 0xa0, 0x67, 0xfd, 0xb0, 0x21, 0x3e, 0x2c, 0x10,
-		0xb9, 0xc1, 0x08, 0x38, 0xb3, 0xa0, 0x97, 0x6b, 0x7c, 0xda, 0xf1, 0xde, 0xd3, 0x1b, 0x3b, 0xf6,
-		0x55, 0x16, 0x49, 0x31, 0xaf, 0x24, 0xd5, 0xd6, 0xf6, 0xcb, 0x34, 0x98, 0x3a, 0x01, 0x49, 0x52,
-		0xb9, 0xcb, 0xbf, 0x09, 0x8f, 0x29, 0x89, 0xb6, 0xb6, 0xbf, 0xa2, 0x39, 0xd7, 0xe2, 0xa9, 0x98,
-		0x14, 0x97, 0x7f, 0x36, 0x02, 0xf9, 0x70, 0x1f, 0xd1, 0x23, 0x80, 0x08, 0x87, 0xb6, 0x8b, 0x55,
-		0x62, 0x62, 0xa8, 0xb0, 0x04, 0x6e, 0xa1, 0xad, 0xed, 0x97, 0x76, 0xf1, 0x7a, 0xb7, 0x4d, 0x1b,
-		0xe0, 0xa0, 0x35, 0x90, 0x04, 0xb1, 0x18, 0x27, 0x2e, 0xcc, 0x93, 0xfd, 0x77, 0x50, 0x72, 0x02,
-		0xb6, 0x50, 0xbd, 0x4e, 0x16, 0xaa, 0x3c, 0xc3, 0xf3, 0x76, 0xd3, 0x43, 0x5d, 0x89, 0x85, 0xbb,
-		0x22, 0x3f, 0x0f, 0x85, 0x1e, 0x79, 0x22, 0x19, 0x72, 0x3c, 0x2b, 0x40, 0x77, 0x2a, 0x99, 0x7f,
-		0x9c, 0x56, 0x32, 0x2c, 0xfa, 0xa7, 0x3b, 0xb7, 0x4b, 0xa9, 0x4f, 0x7d, 0x74, 0x3e, 0x42, 0x13,
-		0xe6, 0x8f, 0x40, 0x2e, 0x24, 0x51, 0x91, 0xa9, 0x8b, 0xf8, 0x99, 0x3a, 0x9f, 0xf8, 0x65, 0xc8,
-		0x12, 0x43, 0x89, 0x9b, 0x9c, 0xf6, 0x61, 0x28, 0x30, 0x43, 0xde, 0x2b, 0x6b, 0xe6, 0x49, 0xad,
-		0x09, 0x81, 0xcb, 0xc2, 0xb5, 0x0a, 0x8b, 0x3d, 0x23, 0xa8, 0x56, 0x34, 0xa7, 0xfc, 0xfe, 0x8f,
-		0xbf, 0x39, 0x17, 0x79, 0x67, 0x26, 0xe2, 0xef, 0xbe, 0x1f, 0x4e, 0x05, 0x2a, 0xb5, 0x6d, 0xdd,
-		0x08, 0x65, 0x1d, 0x0a, 0x01, 0x25, 0x23, 0x95, 0xa3, 0xb2, 0x07, 0x87, 0xe6, 0x30, 0x0e, 0x4f,
-		0x98, 0x15, 0x0f, 0xb7, 0x08, 0xa3, 0x13, 0x1b, 0x83, 0x73, 0x94, 0xff, 0x27, 0x05, 0x49, 0x05,
-		0x7f, 0xb0, 0x8b, 0x1d, 0x17, 0x5d, 0x80, 0x38, 0xd6, 0xf7, 0xac, 0x41, 0x29, 0x21, 0xd2, 0xb9,
-		0x45, 0x4e, 0x57, 0xd5, 0xf7, 0xac, 0xab, 0x13, 0x0a, 0xa5, 0x45, 0x17, 0x61, 0x72, 0xa7, 0xd5,
-		0xe5, 0x79, 0x8a, 0x1e, 0x63, 0x11, 0x64, 0xba, 0x42, 0x88, 0xae, 0x4e, 0x28, 0x8c, 0x9a, 0xbc,
-		0x8a, 0xde, 0xf3, 0x1b, 0x3b, 0xfc, 0x55, 0x35, 0x73, 0x87, 0xbe, 0x8a, 0xd0, 0xa2, 0x32, 0x80,
-		0x61, 0x1a, 0xae, 0x4a, 0x63, 0x78, 0xee, 0x09, 0x3e, 0x30, 0x9c, 0xd3, 0x70, 0x69, 0xd4, 0x7f,
-		0x75, 0x42, 0x49, 0x1b, 0xe2, 0x81, 0x34, 0xf7, 0x83, 0x5d, 0x6c, 0x1f, 0x70, 0x07, 0x70, 0x68,
-		0x73, 0xdf, 0x4f, 0x88, 0x48, 0x73, 0x29, 0x35, 0xaa, 0x42, 0x86, 0x7e, 0xe0, 0xca, 0xe6, 0x2f,
-		0xbf, 0x59, 0x56, 0x1e, 0xc6, 0x5c, 0x26, 0xa4, 0x74, 0x4a, 0x5f, 0x9d, 0x50, 0x60, 0xdb, 0x7b,
-		0x22, 0x46, 0x92, 0xdd, 0x3c, 0xe6, 0xee, 0xf3, 0xfb, 0x34, 0xe7, 0x87, 0x61, 0xd0, 0xeb, 0xc7,
-		0x1a, 0xfb, 0x57, 0x27, 0x94, 0xa4, 0xce, 0x7e, 0x92, 0xfe, 0x37, 0x71, 0xcb, 0xb8, 0x85, 0x6d,
-		0xc2, 0x9f, 0x3e, 0xbc, 0xff, 0xcb, 0x8c, 0x92, 0x22, 0xa4, 0x9b, 0xe2, 0x81, 0x18, 0x5a, 0x6c,
-		0x36, 0x79, 0x37, 0xa0, 0xdf, 0x4e, 0x87, 0xc6, 0xd9, 0x6c, 0x8a, 0x4e, 0xa4, 0x30, 0xff, 0x8d,
-		0x9e, 0xf1, 0x3c, 0xd4, 0x4c, 0xbf, 0x53, 0x18, 0xea, 0x00, 0xcb, 0x55, 0x4c, 0x08, 0x4f, 0x15,
-		0xad, 0x43, 0xbe, 0x65, 0x38, 0xae, 0xea, 0x98, 0x5a, 0xc7, 0xd9, 0xb3, 0x5c, 0x87, 0x06, 0xfd,
-		0x99, 0x0b, 0x0f, 0x0d, 0x43, 0x58, 0x35, 0x1c, 0xb7, 0x2e, 0x88, 0xaf, 0x4e, 0x28, 0xb9, 0x56,
-		0xb0, 0x80, 0xe0, 0x59, 0x3b, 0x3b, 0xd8, 0xf6, 0x00, 0x69, 0x72, 0xe0, 0x10, 0xbc, 0x0d, 0x42,
-		0x2d, 0xf8, 0x09, 0x9e, 0x15, 0x2c, 0x40, 0xdf, 0x0c, 0xd3, 0x2d, 0x4b, 0x6b, 0x7a, 0x70, 0xaa,
-		0xbe, 0xd7, 0x35, 0x6f, 0xd2, 0x4c, 0x42, 0xe6, 0xc2, 0xd9, 0xa1, 0x8d, 0xb4, 0xb4, 0xa6, 0x80,
-		0xa8, 0x10, 0x86, 0xab, 0x13, 0xca, 0x54, 0xab, 0xb7, 0x10, 0x7d, 0x00, 0x66, 0xb4, 0x4e, 0xa7,
-		0x75, 0xd0, 0x8b, 0x5e, 0xa0, 0xe8, 0xe7, 0x86, 0xa1, 0x97, 0x08, 0x4f, 0x2f, 0x3c, 0xd2, 0xfa,
-		0x4a, 0x51, 0x03, 0xa4, 0x8e, 0x8d, 0xe9, 0x37, 0x31, 0x1d, 0xee, 0xaa, 0xd0, 0x1b, 0x83, 0x32,
-		0x17, 0x4e, 0x0f, 0xc3, 0xde, 0x64, 0xf4, 0xc2, 0xb3, 0xb9, 0x3a, 0xa1, 0x14, 0x3a, 0xe1, 0x22,
-		0x86, 0x6a, 0xe9, 0x98, 0x5e, 0x68, 0xc6, 0x51, 0xa7, 0x46, 0xa1, 0x52, 0xfa, 0x30, 0x6a, 0xa8,
-		0xa8, 0x9c, 0xe4, 0x67, 0xda, 0xf8, 0x85, 0x2f, 0xa7, 0x21, 0x13, 0x30, 0x2c, 0x68, 0x16, 0x92,
-		0x7c, 0x8f, 0x5f, 0x9c, 0x85, 0xe3, 0x8f, 0x72, 0x1e, 0xb2, 0x41, 0x63, 0x22, 0x7f, 0x24, 0xe2,
-		0x71, 0xd2, 0x2f, 0xe5, 0x67, 0xc3, 0xa9, 0xc3, 0xb4, 0x9f, 0x15, 0x7c, 0x50, 0xac, 0x22, 0xa2,
-		0x9e, 0xed, 0x26, 0x65, 0x69, 0x21, 0x5f, 0xc4, 0xd0, 0x3c, 0x64, 0x3a, 0x17, 0x3a, 0x1e, 0x49,
-		0x8c, 0x92, 0x40, 0xe7, 0x42, 0x47, 0x10, 0x3c, 0x00, 0x59, 0xd2, 0x53, 0x35, 0xe8, 0x68, 0xa4,
-		0x95, 0x0c, 0x29, 0xe3, 0x24, 0xf2, 0xbf, 0x8d, 0x82, 0xd4, 0x6b, 0x80, 0xbc, 0x9c, 0x62, 0xe4,
-		0xc8, 0x39, 0xc5, 0x93, 0xbd, 0xd9, 0x4c, 0x3f, 0x81, 0xb9, 0x0a, 0x92, 0x9f, 0x87, 0x63, 0x0b,
-		0xc1, 0x70, 0xc7, 0xa9, 0xc7, 0xc3, 0x53, 0x0a, 0x7a, 0x8f, 0xcb, 0x77, 0x25, 0xb4, 0xf7, 0x22,
-		0x2e, 0xb2, 0xef, 0x1d, 0x62, 0xcf, 0x5f, 0xd8, 0xea, 0x34, 0x35, 0x17, 0x8b, 0xbc, 0x48, 0x60,
-		0x1b, 0xe6, 0x61, 0x28, 0x68, 0x9d, 0x8e, 0xea, 0xb8, 0x9a, 0x8b, 0xf9, 0xb2, 0x3e, 0xc9, 0xd2,
-		0x83, 0x5a, 0xa7, 0x53, 0x27, 0xa5, 0x6c, 0x59, 0x7f, 0x08, 0xf2, 0xc4, 0x26, 0x1b, 0x5a, 0x4b,
-		0xe5, 0x59, 0x82, 0x04, 0x5b, 0xfd, 0x79, 0xe9, 0x55, 0x5a, 0x28, 0x37, 0xbd, 0x11, 0xa7, 0xf6,
-		0xd8, 0x0b, 0xb3, 0x22, 0x81, 0x30, 0x0b, 0xf1, 0x1b, 0x0c, 0x98, 0x7c, 0xc4, 0xad, 0x0f, 0x83,
-		0xb3, 0xbb, 0x33, 0x34, 0x24, 0xbb, 0xc5, 0x12, 0x1e, 0x29, 0x85, 0x3d, 0xc8, 0x1f, 0x8a, 0xc2,
-		0x54, 0x9f, 0xe5, 0x1e, 0x98, 0xf6, 0xf6, 0xe3, 0xcb, 0xe8, 0x91, 0xe2, 0xcb, 0xeb, 0xe1, 0xb4,
-		0x6e, 0x60, 0xe5, 0x3b, 0xd5, 0x27, 0x64, 0x66, 0x37, 0x89, 0x42, 0x73, 0x90, 0x40, 0xe6, 0x97,
-		0xaa, 0xf9, 0x16, 0xcc, 0x6c, 0x1f, 0xbc, 0xaa, 0x99, 0xae, 0x61, 0x62, 0xb5, 0x6f, 0xd4, 0xfa,
-		0x97, 0xd2, 0x35, 0xc3, 0xd9, 0xc6, 0x7b, 0xda, 0x2d, 0xc3, 0x12, 0xcd, 0x9a, 0xf6, 0xf8, 0xfd,
-		0xe4, 0xae, 0xac, 0x40, 0x3e, 0xbc, 0xf4, 0xa0, 0x3c, 0x44, 0xdd, 0x7d, 0xde, 0xff, 0xa8, 0xbb,
-		0x8f, 0x1e, 0xe7, 0x79, 0xa0, 0x28, 0xcd, 0x03, 0xf5, 0xbf, 0x88, 0xf3, 0xf9, 0x49, 0x20, 0x59,
-		0xf6, 0x66, 0x83, 0xb7, 0x1c, 0xf5, 0xa2, 0xca, 0x67, 0xa1, 0xd0, 0xb3, 0xde, 0x0c, 0xcb, 0x0a,
-		0xca, 0x05, 0xc8, 0x85, 0x16, 0x17, 0xf9, 0x38, 0xcc, 0x0c, 0x5a, 0x2b, 0xe4, 0x3d, 0xaf, 0x3c,
-		0x64, 0xf3, 0xd1, 0x45, 0x48, 0x79, 0x8b, 0xc5, 0x80, 0x2c, 0x04, 0xed, 0x85, 0x20, 0x56, 0x3c,
-		0xd2, 0x50, 0x32, 0x3b, 0x1a, 0x4a, 0x66, 0xcb, 0xdf, 0x0a, 0xb3, 0xc3, 0x16, 0x82, 0x9e, 0x6e,
-		0xc4, 0x3d, 0x2d, 0x3c, 0x0e, 0x09, 0x7e, 0x6b, 0x61, 0x94, 0x6e, 0xdf, 0xf0, 0x27, 0xa2, 0x9d,
-		0x6c, 0x51, 0x88, 0xb1, 0x5d, 0x1d, 0xfa, 0x20, 0xab, 0x70, 0x72, 0xe8, 0x62, 0x30, 0x7c, 0x23,
-		0x88, 0x01, 0xf1, 0x8d, 0x20, 0x5d, 0x34, 0xc7, 0xa1, 0x7d, 0x15, 0x87, 0x1d, 0xd8, 0x93, 0xfc,
-		0x7a, 0x0c, 0x8e, 0x0f, 0x5e, 0x12, 0xd0, 0x02, 0x64, 0x89, 0xdf, 0xed, 0x86, 0x5d, 0x74, 0x68,
-		0x6b, 0xfb, 0x0d, 0xee, 0x9f, 0xf3, 0x44, 0x7a, 0xd4, 0x4b, 0xa4, 0xa3, 0x2d, 0x98, 0x6a, 0x59,
-		0xba, 0xd6, 0x52, 0x03, 0x1a, 0xcf, 0x95, 0xfd, 0xc1, 0x3e, 0x61, 0x57, 0xd9, 0x5d, 0xe6, 0xcd,
-		0x3e, 0xa5, 0x2f, 0x50, 0x8c, 0x55, 0x4f, 0xf3, 0xd1, 0x32, 0x64, 0xda, 0xbe, 0x22, 0x1f, 0x41,
-		0xd9, 0x83, 0x6c, 0x81, 0x21, 0x99, 0x1c, 0xb8, 0xed, 0x93, 0x38, 0xb2, 0x89, 0x1e, 0xb6, 0x83,
-		0x92, 0x1c, 0xba, 0x83, 0x32, 0x68, 0xbb, 0x22, 0x35, 0x78, 0xbb, 0xe2, 0xc3, 0xc1, 0xa1, 0x09,
-		0x2d, 0xa2, 0xfd, 0x3b, 0x18, 0xa8, 0x0e, 0x33, 0x9c, 0xbf, 0x19, 0x92, 0x7d, 0x74, 0x5c, 0x43,
-		0x83, 0x04, 0xfb, 0x70, 0xb1, 0xc7, 0xee, 0x4d, 0xec, 0xc2, 0x96, 0xc6, 0x03, 0xb6, 0xf4, 0xff,
-		0xb3, 0xa1, 0xf8, 0x0f, 0x69, 0x48, 0x29, 0xd8, 0xe9, 0x90, 0x85, 0x13, 0x95, 0x21, 0x8d, 0xf7,
-		0x75, 0xdc, 0x71, 0xfd, 0x6d, 0xca, 0x41, 0xc1, 0x00, 0xa3, 0xae, 0x0a, 0x4a, 0xe2, 0x89, 0x7b,
-		0x6c, 0xe8, 0x49, 0x1e, 0x6c, 0x0d, 0x8f, 0x9b, 0x38, 0x7b, 0x30, 0xda, 0xba, 0x24, 0xa2, 0xad,
-		0xd8, 0x50, 0xe7, 0x9b, 0x71, 0xf5, 0x84, 0x5b, 0x4f, 0xf2, 0x70, 0x2b, 0x3e, 0xe2, 0x65, 0xa1,
-		0x78, 0xab, 0x12, 0x8a, 0xb7, 0x12, 0x23, 0xba, 0x39, 0x24, 0xe0, 0xba, 0x24, 0x02, 0xae, 0xe4,
-		0x88, 0x16, 0xf7, 0x44, 0x5c, 0x57, 0xc2, 0x11, 0x57, 0x6a, 0x88, 0x01, 0x11, 0xdc, 0x43, 0x43,
-		0xae, 0xe7, 0x02, 0x21, 0x57, 0x7a, 0x68, 0xbc, 0xc3, 0x40, 0x06, 0xc4, 0x5c, 0x95, 0x50, 0xcc,
-		0x05, 0x23, 0x64, 0x30, 0x24, 0xe8, 0xfa, 0xa6, 0x60, 0xd0, 0x95, 0x19, 0x1a, 0xb7, 0xf1, 0xf1,
-		0x1e, 0x14, 0x75, 0x3d, 0xeb, 0x45, 0x5d, 0xd9, 0xa1, 0x61, 0x23, 0xef, 0x43, 0x6f, 0xd8, 0xb5,
-		0xd1, 0x17, 0x76, 0xe5, 0xf8, 0x9f, 0x09, 0x19, 0x06, 0x31, 0x22, 0xee, 0xda, 0xe8, 0x8b, 0xbb,
-		0xf2, 0x23, 0x00, 0x47, 0x04, 0x5e, 0x7f, 0x63, 0x70, 0xe0, 0x35, 0x3c, 0x34, 0xe2, 0xcd, 0x1c,
-		0x2f, 0xf2, 0x52, 0x87, 0x44, 0x5e, 0x2c, 0x3a, 0x7a, 0x64, 0x28, 0xfc, 0xd8, 0xa1, 0xd7, 0xd6,
-		0x80, 0xd0, 0x8b, 0x05, 0x49, 0x67, 0x86, 0x82, 0x8f, 0x11, 0x7b, 0x6d, 0x0d, 0x88, 0xbd, 0xd0,
-		0x48, 0xd8, 0xa3, 0x04, 0x5f, 0x93, 0x52, 0x42, 0x3e, 0x4b, 0x5c, 0xdf, 0x1e, 0x3b, 0x45, 0xfc,
-		0x07, 0x6c, 0xdb, 0x96, 0x2d, 0x4e, 0xd6, 0xd2, 0x07, 0xf9, 0x0c, 0x71, 0xc6, 0x7d, 0x9b, 0x74,
-		0x48, 0xa0, 0x46, 0xfd, 0xb4, 0x80, 0x1d, 0x92, 0x3f, 0x15, 0xf1, 0x79, 0xa9, 0x0f, 0x1b, 0x74,
-		0xe4, 0xd3, 0xdc, 0x91, 0x0f, 0x84, 0x6f, 0xd1, 0x70, 0xf8, 0x36, 0x0f, 0x19, 0xe2, 0x7f, 0xf5,
-		0x44, 0x66, 0x5a, 0xc7, 0x8b, 0xcc, 0xc4, 0x91, 0x02, 0x16, 0xe4, 0xf1, 0x65, 0x85, 0xed, 0xe4,
-		0x14, 0xbc, 0xe3, 0x15, 0x2c, 0xa6, 0x40, 0x8f, 0xc1, 0x74, 0x80, 0xd6, 0xf3, 0xeb, 0x58, 0x98,
-		0x22, 0x79, 0xd4, 0x25, 0xee, 0xe0, 0xfd, 0xab, 0x88, 0x2f, 0x21, 0x3f, 0xa4, 0x1b, 0x14, 0x7d,
-		0x45, 0xde, 0xa6, 0xe8, 0x2b, 0x7a, 0xcf, 0xd1, 0x57, 0xd0, 0x4f, 0x8d, 0x85, 0xfd, 0xd4, 0xff,
-		0x19, 0xf1, 0xc7, 0xc4, 0x8b, 0xa5, 0x74, 0xab, 0x89, 0xb9, 0xe7, 0x48, 0x7f, 0x13, 0xa7, 0xa2,
-		0x65, 0xed, 0x72, 0xff, 0x90, 0xfc, 0x24, 0x54, 0xde, 0xc2, 0x91, 0xe6, 0xeb, 0x82, 0xe7, 0x74,
-		0x4e, 0x06, 0xcf, 0xff, 0xf2, 0x43, 0xb1, 0x09, 0xff, 0x50, 0xac, 0xf7, 0xed, 0x5a, 0x32, 0xf0,
-		0xed, 0x1a, 0x7a, 0x06, 0xd2, 0x34, 0x23, 0xaa, 0x5a, 0x1d, 0xf1, 0x47, 0x65, 0x4e, 0x0d, 0x3f,
-		0x10, 0xeb, 0xd0, 0xa3, 0x7a, 0xec, 0x10, 0xad, 0xef, 0x31, 0xa4, 0x43, 0x1e, 0xc3, 0x7d, 0x90,
-		0x26, 0xad, 0x67, 0x97, 0xa3, 0x03, 0xff, 0xf0, 0x51, 0x14, 0xc8, 0x1f, 0x00, 0xd4, 0xbf, 0x48,
-		0xa0, 0xab, 0x90, 0xc0, 0xb7, 0xe8, 0xa5, 0x7c, 0xec, 0xa0, 0xe1, 0xf1, 0x7e, 0xd7, 0x94, 0x54,
-		0x97, 0x67, 0x89, 0x90, 0xbf, 0xf8, 0xc6, 0xbc, 0xc4, 0xa8, 0x1f, 0xf5, 0xce, 0xf9, 0x2b, 0x9c,
-		0x5f, 0xfe, 0xc3, 0x28, 0x09, 0x60, 0x42, 0x0b, 0xc8, 0x40, 0xd9, 0x0e, 0xda, 0x22, 0x1c, 0x4f,
-		0xde, 0x73, 0x00, 0xbb, 0x9a, 0xa3, 0xde, 0xd6, 0x4c, 0x17, 0x37, 0xb9, 0xd0, 0x03, 0x25, 0xa8,
-		0x08, 0x29, 0xf2, 0xd4, 0x75, 0x70, 0x93, 0x87, 0xd1, 0xde, 0x73, 0xa0, 0x9f, 0xc9, 0xaf, 0xad,
-		0x9f, 0x61, 0x29, 0xa7, 0x7a, 0xa4, 0x1c, 0x08, 0x2e, 0xd2, 0xc1, 0xe0, 0x82, 0x1d, 0x0c, 0xe6,
-		0xe7, 0x13, 0x81, 0xb5, 0x4d, 0x3c, 0xa3, 0x07, 0x21, 0xd7, 0xc6, 0xed, 0x8e, 0x65, 0xb5, 0x54,
-		0x66, 0x6e, 0xd8, 0x5f, 0x41, 0xc8, 0xf2, 0xc2, 0x2a, 0xb5, 0x3a, 0xdf, 0x19, 0xf5, 0xe7, 0x9f,
-		0x1f, 0x44, 0x7e, 0xc3, 0x09, 0x98, 0x5e, 0x1b, 0xdd, 0xeb, 0x22, 0xa0, 0x7a, 0xf0, 0xd4, 0x48,
-		0x97, 0x9a, 0x05, 0xa1, 0xd0, 0xe3, 0xda, 0x0f, 0xff, 0x74, 0x09, 0x2b, 0x76, 0xd0, 0x4b, 0x70,
-		0xa2, 0xc7, 0xb6, 0x79, 0xd0, 0xd1, 0x71, 0x4d, 0xdc, 0xb1, 0xb0, 0x89, 0x13, 0xd0, 0xbe, 0xb0,
-		0x62, 0x5f, 0xe3, 0xac, 0xab, 0x41, 0x3e, 0xec, 0xf1, 0x0c, 0x1c, 0x7e, 0xfa, 0x57, 0x77, 0x5c,
-		0xcd, 0x30, 0xd5, 0x50, 0x3a, 0x28, 0xcb, 0x0a, 0x79, 0x92, 0x69, 0x13, 0x8e, 0x0d, 0xf4, 0x7c,
-		0xd0, 0xd3, 0x90, 0xf6, 0x9d, 0x26, 0x26, 0xd5, 0x43, 0xd2, 0x05, 0x3e, 0xad, 0xfc, 0xeb, 0x11,
-		0x1f, 0x32, 0x9c, 0x80, 0xa8, 0x42, 0x82, 0x1d, 0x7b, 0xe3, 0x87, 0x69, 0x1e, 0x1b, 0xcf, 0x67,
-		0x5a, 0x64, 0x67, 0xe2, 0x14, 0xce, 0x2c, 0x7f, 0x00, 0x12, 0xac, 0x04, 0x65, 0x20, 0xe9, 0xdf,
-		0x9b, 0x0b, 0x90, 0x28, 0x55, 0x2a, 0xd5, 0x4d, 0x71, 0x09, 0x66, 0x79, 0x43, 0x69, 0x48, 0x51,
-		0x52, 0xac, 0x54, 0xaf, 0x55, 0x2b, 0x0d, 0x29, 0x86, 0xa6, 0x20, 0xc7, 0x7e, 0xab, 0x57, 0x36,
-		0x94, 0xb5, 0x52, 0x43, 0x8a, 0x07, 0x8a, 0xea, 0xd5, 0xf5, 0xe5, 0xaa, 0x22, 0x4d, 0xca, 0x4f,
-		0xc0, 0xc9, 0xa1, 0x5e, 0x96, 0x9f, 0x5d, 0x88, 0x04, 0xb2, 0x0b, 0xf2, 0xeb, 0x51, 0x28, 0x0e,
-		0x77, 0x9d, 0xd0, 0xb5, 0x9e, 0x8e, 0x5f, 0x38, 0x82, 0xdf, 0xd5, 0xd3, 0x7b, 0xf4, 0x10, 0xe4,
-		0x6d, 0xbc, 0x83, 0x5d, 0x7d, 0x8f, 0xb9, 0x72, 0x6c, 0xc9, 0xcc, 0x29, 0x39, 0x5e, 0x4a, 0x99,
-		0x1c, 0x46, 0xf6, 0x0a, 0xd6, 0x5d, 0x95, 0xd9, 0x22, 0x87, 0xff, 0x61, 0xd0, 0x1c, 0x2b, 0xad,
-		0xb3, 0x42, 0xf9, 0x5b, 0x8f, 0x24, 0xcb, 0x34, 0x4c, 0x2a, 0xd5, 0x86, 0xf2, 0x92, 0x14, 0x43,
-		0x08, 0xf2, 0xf4, 0xa7, 0x5a, 0x5f, 0x2f, 0x6d, 0xd6, 0xaf, 0x6e, 0x10, 0x59, 0x4e, 0x43, 0x41,
-		0xc8, 0x52, 0x14, 0x4e, 0xca, 0x8f, 0xc0, 0x89, 0x21, 0x7e, 0xdf, 0x80, 0x73, 0x88, 0x1f, 0x8b,
-		0x04, 0xa9, 0xc3, 0x31, 0xff, 0x06, 0x24, 0x1c, 0x57, 0x73, 0xbb, 0x0e, 0x17, 0xe2, 0xd3, 0xe3,
-		0x3a, 0x82, 0x8b, 0xe2, 0x47, 0x9d, 0xb2, 0x2b, 0x1c, 0x46, 0xbe, 0x08, 0xf9, 0x70, 0xcd, 0x70,
-		0x19, 0xf8, 0x4a, 0x14, 0x95, 0x5f, 0x02, 0x08, 0xe4, 0x23, 0xbd, 0x13, 0x5d, 0x91, 0xe0, 0x89,
-		0xae, 0x8b, 0x30, 0x79, 0xcb, 0x62, 0x36, 0x63, 0xf0, 0xc4, 0xb9, 0x61, 0xb9, 0x38, 0x90, 0x7c,
-		0x60, 0xd4, 0xb2, 0x01, 0xa8, 0x3f, 0x27, 0x34, 0xe4, 0x15, 0xcf, 0x85, 0x5f, 0xf1, 0xc0, 0xd0,
-		0xec, 0xd2, 0xe0, 0x57, 0xbd, 0x0a, 0x93, 0xd4, 0xda, 0x0c, 0xfc, 0xc4, 0xe7, 0x5b, 0x00, 0x34,
-		0xd7, 0xb5, 0x8d, 0xed, 0xae, 0xff, 0x82, 0xf9, 0xc1, 0xd6, 0xaa, 0x24, 0xe8, 0xca, 0xf7, 0x71,
-		0xb3, 0x35, 0xe3, 0xb3, 0x06, 0x4c, 0x57, 0x00, 0x50, 0x5e, 0x87, 0x7c, 0x98, 0x77, 0xf0, 0x27,
-		0x4b, 0xfe, 0xa7, 0xff, 0x69, 0xe1, 0x3e, 0x79, 0xce, 0x17, 0xbf, 0x8e, 0x83, 0x3e, 0xc8, 0x77,
-		0x22, 0x90, 0x6a, 0xec, 0x73, 0x3d, 0x3e, 0xe4, 0x50, 0xa5, 0xff, 0xdd, 0x96, 0x97, 0x2c, 0x64,
-		0xf9, 0xd8, 0x98, 0x97, 0xe5, 0xfd, 0x26, 0x6f, 0xa6, 0xc6, 0xc7, 0x8d, 0x76, 0x45, 0xb6, 0x9b,
-		0x5b, 0xa7, 0xcb, 0xe3, 0x7d, 0x23, 0x31, 0x03, 0x93, 0xc1, 0xef, 0x1b, 0xd8, 0x83, 0xdc, 0x0c,
-		0x1c, 0x4b, 0x60, 0xcb, 0x46, 0xf0, 0x63, 0x8a, 0xc8, 0x91, 0x3f, 0xa6, 0xf0, 0xde, 0x12, 0x0d,
-		0xbe, 0xe5, 0x16, 0xa4, 0x84, 0x52, 0xa0, 0xf7, 0x05, 0xcf, 0x9e, 0x88, 0x3d, 0x9a, 0xa1, 0x8b,
-		0x27, 0x87, 0x0f, 0x1c, 0x3d, 0x39, 0x07, 0x53, 0xfc, 0xc8, 0x9d, 0x1f, 0x57, 0xf0, 0xbf, 0x18,
-		0x50, 0x60, 0x15, 0xab, 0x22, 0xa8, 0x90, 0x7f, 0x2a, 0x02, 0x52, 0xaf, 0x56, 0xbe, 0x9b, 0x0d,
-		0x20, 0x46, 0x91, 0x68, 0x7f, 0xe0, 0xca, 0x6e, 0x36, 0xf2, 0x39, 0x52, 0xea, 0x5f, 0xda, 0xfd,
-		0xa1, 0x28, 0x64, 0x02, 0x39, 0x3d, 0xf4, 0x54, 0xe8, 0x08, 0xe8, 0xc2, 0x61, 0xf9, 0xbf, 0xc0,
-		0x19, 0xd0, 0x50, 0xc7, 0xa2, 0x47, 0xef, 0xd8, 0xdb, 0x7f, 0x48, 0x7f, 0xf0, 0xd7, 0x3e, 0x93,
-		0x43, 0xbe, 0xf6, 0xf9, 0x8e, 0x08, 0xa4, 0xbc, 0xa5, 0xfb, 0xa8, 0xd9, 0xfc, 0xe3, 0x90, 0xe0,
-		0xab, 0x13, 0x4b, 0xe7, 0xf3, 0xa7, 0x81, 0xb9, 0xd0, 0x22, 0xa4, 0xc4, 0xdf, 0x79, 0xe2, 0x81,
-		0xa8, 0xf7, 0x7c, 0xee, 0x59, 0xc8, 0x04, 0x36, 0x56, 0x88, 0x9d, 0x58, 0xaf, 0xbe, 0x20, 0x4d,
-		0x14, 0x93, 0x77, 0xee, 0x2e, 0xc4, 0xd6, 0xf1, 0x6d, 0x32, 0xc3, 0x94, 0x6a, 0xe5, 0x6a, 0xb5,
-		0x72, 0x5d, 0x8a, 0x14, 0x33, 0x77, 0xee, 0x2e, 0x24, 0x15, 0x4c, 0xd3, 0x57, 0xe7, 0xae, 0x43,
-		0xa1, 0x67, 0x60, 0xc2, 0xf6, 0x1d, 0x41, 0x7e, 0x79, 0x6b, 0x73, 0xb5, 0x56, 0x29, 0x35, 0xaa,
-		0x2a, 0x3b, 0x4e, 0x87, 0x4e, 0xc0, 0xf4, 0x6a, 0x6d, 0xe5, 0x6a, 0x43, 0xad, 0xac, 0xd6, 0xaa,
-		0xeb, 0x0d, 0xb5, 0xd4, 0x68, 0x94, 0x2a, 0xd7, 0xa5, 0xe8, 0x85, 0xaf, 0x02, 0x14, 0x4a, 0xe5,
-		0x4a, 0x8d, 0xac, 0xcf, 0x06, 0xbf, 0x75, 0xbd, 0x02, 0x71, 0x9a, 0x0a, 0x38, 0xf4, 0xa8, 0x48,
-		0xf1, 0xf0, 0xdc, 0x26, 0xba, 0x02, 0x93, 0x34, 0x4b, 0x80, 0x0e, 0x3f, 0x3b, 0x52, 0x1c, 0x91,
-		0xec, 0x24, 0x8d, 0xa1, 0xd3, 0xe9, 0xd0, 0xc3, 0x24, 0xc5, 0xc3, 0x73, 0x9f, 0x48, 0x81, 0xb4,
-		0x1f, 0x65, 0x8c, 0x3e, 0x5c, 0x51, 0x1c, 0xc3, 0x3a, 0xa2, 0x55, 0x48, 0x8a, 0xc0, 0x70, 0xd4,
-		0x71, 0x8f, 0xe2, 0xc8, 0xe4, 0x24, 0x11, 0x17, 0x0b, 0xe0, 0x0f, 0x3f, 0xbb, 0x52, 0x1c, 0x91,
-		0x69, 0x45, 0x35, 0xef, 0x10, 0xfe, 0x88, 0x23, 0x1c, 0xc5, 0x51, 0xc9, 0x46, 0x22, 0x34, 0x3f,
-		0x35, 0x32, 0xfa, 0x44, 0x4e, 0x71, 0x8c, 0x24, 0x32, 0xda, 0x02, 0x08, 0x84, 0xeb, 0x63, 0x1c,
-		0xb5, 0x29, 0x8e, 0x93, 0x1c, 0x46, 0x1b, 0x90, 0xf2, 0xa2, 0xa7, 0x91, 0x07, 0x5f, 0x8a, 0xa3,
-		0xb3, 0xb4, 0xe8, 0x03, 0x90, 0x0b, 0x47, 0x0d, 0xe3, 0x1d, 0x67, 0x29, 0x8e, 0x99, 0x7e, 0x25,
-		0xf8, 0xe1, 0x10, 0x62, 0xbc, 0xe3, 0x2d, 0xc5, 0x31, 0xb3, 0xb1, 0xe8, 0x15, 0x98, 0xea, 0x77,
-		0xf1, 0xc7, 0x3f, 0xed, 0x52, 0x3c, 0x42, 0x7e, 0x16, 0xb5, 0x01, 0x0d, 0x08, 0x0d, 0x8e, 0x70,
-		0xf8, 0xa5, 0x78, 0x94, 0x74, 0x2d, 0x6a, 0x42, 0xa1, 0xd7, 0xdf, 0x1e, 0xf7, 0x30, 0x4c, 0x71,
-		0xec, 0xd4, 0x2d, 0x7b, 0x4b, 0xd8, 0x4f, 0x1f, 0xf7, 0x70, 0x4c, 0x71, 0xec, 0x4c, 0x6e, 0xb9,
-		0x34, 0xf4, 0x7c, 0xe3, 0xe9, 0x43, 0xcf, 0x37, 0xfa, 0x27, 0x16, 0xbd, 0x33, 0x8d, 0xdf, 0xfe,
-		0x04, 0xbc, 0x87, 0x5f, 0x26, 0xe0, 0xb8, 0xda, 0x4d, 0xc3, 0xdc, 0xf5, 0x6e, 0x87, 0xe0, 0xcf,
-		0xfc, 0x70, 0xe3, 0x71, 0x7e, 0x61, 0x81, 0x28, 0x1d, 0x71, 0x47, 0xc4, 0xd0, 0x7b, 0xaf, 0x46,
-		0x1d, 0x42, 0x1e, 0x7d, 0x74, 0xf1, 0x90, 0xfb, 0x27, 0x46, 0xdc, 0x72, 0x31, 0xe0, 0x7e, 0x8a,
-		0x11, 0x87, 0x30, 0x0f, 0x3b, 0xef, 0x29, 0x7f, 0x7f, 0x04, 0xf2, 0x57, 0x0d, 0xc7, 0xb5, 0x6c,
-		0x43, 0xd7, 0x5a, 0x74, 0xc5, 0xb8, 0x3c, 0xee, 0x47, 0x1e, 0xe5, 0x34, 0x71, 0x46, 0xf8, 0xa5,
-		0x16, 0xfc, 0x60, 0xc8, 0x32, 0x24, 0x6e, 0x69, 0x2d, 0xf6, 0x89, 0x45, 0xf0, 0xfa, 0x99, 0x5e,
-		0x99, 0x07, 0xbc, 0xa4, 0x20, 0x0a, 0xe3, 0x95, 0x7f, 0x9e, 0x1e, 0xe2, 0x6e, 0xb7, 0x0d, 0x87,
-		0xfd, 0x25, 0x76, 0x17, 0x3b, 0x68, 0x13, 0xe2, 0xb6, 0xe6, 0xf2, 0xa0, 0xa6, 0xfc, 0x5e, 0x7e,
-		0x15, 0xc5, 0xc3, 0xa3, 0x2f, 0x94, 0x58, 0xec, 0xbf, 0xad, 0x82, 0x22, 0xa1, 0x17, 0x20, 0xd5,
-		0xd6, 0xf6, 0x55, 0x8a, 0x1a, 0x7d, 0x1b, 0x50, 0x93, 0x6d, 0x6d, 0x9f, 0xb4, 0x95, 0xcc, 0x20,
-		0x02, 0xac, 0xef, 0x69, 0xe6, 0x2e, 0x66, 0xf8, 0xb1, 0xb7, 0x01, 0x3f, 0xd7, 0xd6, 0xf6, 0x2b,
-		0x14, 0x93, 0xbc, 0x65, 0x29, 0xf5, 0xfa, 0x47, 0xe7, 0x27, 0xe8, 0x59, 0xe4, 0xdf, 0x8a, 0xf0,
-		0xf8, 0x95, 0x8a, 0x0b, 0x69, 0x20, 0xe9, 0xde, 0x13, 0x7d, 0xbd, 0xc8, 0xe5, 0x9f, 0x1e, 0x36,
-		0x1a, 0x3d, 0xc2, 0x2e, 0xe7, 0x48, 0x43, 0x3f, 0xfb, 0xc6, 0x7c, 0x84, 0x8d, 0x4b, 0x41, 0xef,
-		0x19, 0x8c, 0x6b, 0x90, 0x61, 0x29, 0x34, 0x95, 0xfa, 0xad, 0xd1, 0x91, 0x7e, 0x6b, 0x4e, 0xf8,
-		0xad, 0x0c, 0x10, 0x18, 0x37, 0xa9, 0x0f, 0xf4, 0xe3, 0xe7, 0x23, 0x90, 0x59, 0x0e, 0x5c, 0x33,
-		0x35, 0x0b, 0xc9, 0xb6, 0x65, 0x1a, 0x37, 0xb1, 0xed, 0x6d, 0xc8, 0xb0, 0x47, 0xe2, 0x5f, 0xb2,
-		0xbf, 0x56, 0xe4, 0x1e, 0x88, 0x2b, 0x18, 0xc4, 0x33, 0xe1, 0xba, 0x8d, 0xb7, 0x1d, 0x43, 0x48,
-		0x5d, 0x11, 0x8f, 0xe8, 0x2c, 0x48, 0x0e, 0xd6, 0xbb, 0xb6, 0xe1, 0x1e, 0xa8, 0xba, 0x65, 0xba,
-		0x9a, 0xee, 0xf2, 0xb4, 0x69, 0x41, 0x94, 0x57, 0x58, 0x31, 0x01, 0x69, 0x62, 0x57, 0x33, 0x5a,
-		0xec, 0xbc, 0x57, 0x5a, 0x11, 0x8f, 0x81, 0xe6, 0x7e, 0x3a, 0x19, 0x8c, 0x0b, 0x2b, 0x20, 0x59,
-		0x1d, 0x6c, 0x87, 0xbe, 0x77, 0x63, 0xba, 0x3a, 0xfb, 0x7b, 0x9f, 0x78, 0x6c, 0x86, 0x0b, 0x9e,
-		0xef, 0xbe, 0xb3, 0x9b, 0x95, 0x95, 0x82, 0xe0, 0x10, 0x1f, 0xc2, 0xbd, 0x14, 0xda, 0x86, 0xe9,
-		0x6e, 0xfb, 0xdf, 0xdb, 0xcf, 0xf4, 0x09, 0xb7, 0x64, 0x1e, 0x94, 0x67, 0x7f, 0xc7, 0x87, 0xf6,
-		0xe3, 0xc6, 0xeb, 0xf8, 0x20, 0xb8, 0x27, 0x43, 0x61, 0x88, 0xfb, 0xfe, 0x8a, 0x66, 0xb4, 0xc4,
-		0x1f, 0x77, 0x53, 0xf8, 0x13, 0x5a, 0xf2, 0x72, 0x30, 0x71, 0x1a, 0x0b, 0xc9, 0xc3, 0x74, 0xa4,
-		0x6c, 0x99, 0xcd, 0x70, 0xba, 0x05, 0x35, 0x20, 0xe1, 0x5a, 0x37, 0xb1, 0xc9, 0x85, 0x74, 0x24,
-		0xfd, 0xee, 0xbf, 0x7c, 0x86, 0x63, 0xa1, 0x5d, 0x90, 0x9a, 0xb8, 0x85, 0x77, 0xd9, 0xd7, 0x5a,
-		0x7b, 0x9a, 0x8d, 0xd9, 0x17, 0x9c, 0x5f, 0xeb, 0xfc, 0x29, 0x78, 0xa8, 0x75, 0x0a, 0x8a, 0x36,
-		0xc3, 0x17, 0x9d, 0x25, 0xf9, 0x96, 0xfc, 0x90, 0xfe, 0x07, 0x34, 0x33, 0x68, 0xb3, 0x42, 0x17,
-		0xa3, 0x9d, 0x05, 0xa9, 0x6b, 0x6e, 0x5b, 0x26, 0xfd, 0x7b, 0x49, 0x3c, 0xba, 0x4a, 0xb1, 0x6d,
-		0x3b, 0xaf, 0x9c, 0x6f, 0xdb, 0x6d, 0x42, 0xde, 0x27, 0xa5, 0xb3, 0x28, 0x7d, 0xd4, 0x59, 0x94,
-		0xf3, 0x00, 0x08, 0x09, 0x5a, 0x03, 0xf0, 0xe7, 0xa9, 0xb7, 0xb5, 0x3f, 0x72, 0xc6, 0x07, 0x3b,
-		0x13, 0x00, 0x40, 0x2d, 0x98, 0x6e, 0x1b, 0xa6, 0xea, 0xe0, 0xd6, 0x8e, 0xca, 0x25, 0x47, 0x70,
-		0x33, 0x6f, 0xc3, 0x48, 0x4f, 0xb5, 0x0d, 0xb3, 0x8e, 0x5b, 0x3b, 0xcb, 0x1e, 0x2c, 0x7a, 0x2f,
-		0x9c, 0xf2, 0xc5, 0x61, 0x99, 0xea, 0x9e, 0xd5, 0x6a, 0xaa, 0x36, 0xde, 0x51, 0x75, 0x7a, 0xf7,
-		0x51, 0x96, 0x0a, 0xf1, 0x84, 0x47, 0xb2, 0x61, 0x5e, 0xb5, 0x5a, 0x4d, 0x05, 0xef, 0x54, 0x48,
-		0x35, 0x7a, 0x10, 0x7c, 0x59, 0xa8, 0x46, 0xd3, 0x99, 0xcd, 0x2d, 0xc4, 0xce, 0xc4, 0x95, 0xac,
-		0x57, 0x58, 0x6b, 0x3a, 0x4b, 0xd9, 0x0f, 0x7f, 0x74, 0x7e, 0x82, 0xcf, 0xde, 0x09, 0x79, 0x93,
-		0xde, 0xd6, 0xc1, 0x27, 0x1e, 0x76, 0xd0, 0x25, 0x48, 0x6b, 0xe2, 0x81, 0x7d, 0x27, 0x72, 0xc8,
-		0xc4, 0xf5, 0x49, 0x99, 0x3d, 0x78, 0xed, 0x0f, 0x17, 0x22, 0xf2, 0x4f, 0x46, 0x20, 0xb1, 0x7c,
-		0x63, 0x53, 0x33, 0x6c, 0x54, 0x85, 0x29, 0x5f, 0x85, 0xc7, 0xb5, 0x06, 0xbe, 0xd6, 0x0b, 0x73,
-		0x50, 0x1d, 0xf6, 0x11, 0xed, 0xa1, 0x30, 0xbd, 0x9f, 0xd7, 0xf6, 0x74, 0xfc, 0x1a, 0x24, 0x59,
-		0x2b, 0x1d, 0xf4, 0x3c, 0x4c, 0x76, 0xc8, 0x0f, 0xbe, 0x19, 0x30, 0x37, 0x54, 0xf5, 0x29, 0x7d,
-		0x50, 0x51, 0x18, 0x9f, 0xfc, 0x57, 0x11, 0x80, 0xe5, 0x1b, 0x37, 0x1a, 0xb6, 0xd1, 0x69, 0x61,
-		0xf7, 0xed, 0xea, 0xf6, 0x2a, 0x1c, 0x0b, 0x7c, 0xae, 0x69, 0xeb, 0x63, 0x77, 0x7d, 0xda, 0xff,
-		0x60, 0xd3, 0xd6, 0x07, 0xa2, 0x35, 0x1d, 0xd7, 0x43, 0x8b, 0x8d, 0x8d, 0xb6, 0xec, 0xb8, 0x83,
-		0x65, 0xf9, 0x22, 0x64, 0xfc, 0xee, 0x3b, 0xa8, 0x06, 0x29, 0x97, 0xff, 0xe6, 0x22, 0x95, 0x87,
-		0x8b, 0x54, 0xb0, 0x05, 0xc5, 0xea, 0xb1, 0xcb, 0x7f, 0x4d, 0x24, 0xeb, 0x4f, 0x8f, 0xaf, 0x2b,
-		0x85, 0x22, 0x76, 0x9f, 0xdb, 0xe5, 0xb7, 0xc3, 0xaf, 0xe1, 0x58, 0x3d, 0xa2, 0xfd, 0x70, 0x14,
-		0xa6, 0xb7, 0xc4, 0xf4, 0xfd, 0xba, 0x95, 0xc4, 0x16, 0x24, 0xb1, 0xe9, 0xda, 0x06, 0x16, 0x3b,
-		0x80, 0x8f, 0x0f, 0x1b, 0xf0, 0x01, 0x7d, 0xa1, 0x7f, 0x34, 0x3b, 0x38, 0xfc, 0x02, 0xab, 0x47,
-		0x14, 0xbf, 0x19, 0x83, 0xd9, 0x61, 0xec, 0xe8, 0x34, 0x14, 0x74, 0x1b, 0xd3, 0x02, 0x35, 0x94,
-		0x25, 0xcf, 0x8b, 0x62, 0xbe, 0xe0, 0x28, 0x40, 0xdc, 0x38, 0xa2, 0x5d, 0x84, 0xf4, 0xde, 0xfc,
-		0xb6, 0xbc, 0x8f, 0x40, 0x97, 0x1c, 0x0c, 0x05, 0x71, 0xec, 0x7d, 0x5b, 0x6b, 0x69, 0xa6, 0x7e,
-		0x2f, 0x9e, 0x6e, 0xff, 0xfa, 0x20, 0xce, 0xd2, 0x97, 0x19, 0x26, 0xba, 0x01, 0x49, 0x01, 0x1f,
-		0x7f, 0x1b, 0xe0, 0x05, 0x18, 0x7a, 0x00, 0xb2, 0xc1, 0x65, 0x83, 0x7a, 0x31, 0x71, 0x25, 0x13,
-		0x58, 0x35, 0x46, 0xad, 0x4b, 0x89, 0x43, 0xd7, 0xa5, 0x80, 0xb3, 0xf8, 0x2f, 0x62, 0x30, 0xa5,
-		0xe0, 0xe6, 0x37, 0xe0, 0xe0, 0x7d, 0x33, 0x00, 0x9b, 0xe0, 0xc4, 0xf8, 0xde, 0xc3, 0xf8, 0xf5,
-		0x1b, 0x8c, 0x34, 0xc3, 0x5b, 0x76, 0xdc, 0x77, 0x73, 0x04, 0xff, 0x7d, 0x14, 0xb2, 0xc1, 0x11,
-		0xfc, 0x06, 0x58, 0xed, 0xd0, 0xba, 0x6f, 0xde, 0xd8, 0x01, 0xf5, 0xb3, 0xc3, 0xcc, 0x5b, 0x9f,
-		0x6e, 0x8f, 0x61, 0xd7, 0x7e, 0x30, 0x06, 0x09, 0x7e, 0x62, 0x6c, 0xa3, 0xcf, 0x1b, 0x8e, 0x8c,
-		0xfa, 0x4c, 0x38, 0x27, 0x3e, 0x13, 0x1e, 0xe8, 0x0c, 0x3f, 0x04, 0x79, 0x12, 0x83, 0x87, 0x8e,
-		0xa1, 0x45, 0xce, 0xe4, 0x68, 0x10, 0xed, 0x1f, 0x9a, 0x46, 0xf3, 0x90, 0x21, 0x64, 0xbe, 0x0d,
-		0x27, 0x34, 0xd0, 0xd6, 0xf6, 0xab, 0xac, 0x04, 0x3d, 0x06, 0x68, 0xcf, 0xcb, 0x8f, 0xa8, 0xbe,
-		0x30, 0x08, 0xdd, 0x94, 0x5f, 0x23, 0xc8, 0xef, 0x07, 0x20, 0xad, 0x50, 0xd9, 0x45, 0x9e, 0xfc,
-		0x76, 0x55, 0x52, 0xb2, 0x4c, 0x2f, 0xf3, 0xfc, 0x36, 0xe6, 0x53, 0xf7, 0x84, 0xe7, 0x3c, 0xba,
-		0x59, 0x3d, 0xda, 0xa4, 0xf8, 0xca, 0x1b, 0xf3, 0xc5, 0x03, 0xad, 0xdd, 0x5a, 0x92, 0x07, 0x40,
-		0xca, 0xd4, 0xc7, 0x0e, 0x87, 0xf5, 0x4b, 0x67, 0x84, 0x2e, 0xdf, 0xf9, 0xfc, 0x2f, 0x9e, 0x3b,
-		0x15, 0xc0, 0xdc, 0xf7, 0x12, 0x67, 0x6c, 0x38, 0xe4, 0x5f, 0x88, 0x00, 0xf2, 0x17, 0x1a, 0xef,
-		0x98, 0xf8, 0x1a, 0x3d, 0x3c, 0x2c, 0x22, 0x81, 0xc8, 0xe1, 0x11, 0x86, 0xcf, 0x1f, 0x8a, 0x30,
-		0x02, 0x13, 0xe8, 0x7d, 0xbe, 0x59, 0x17, 0x1f, 0x85, 0x0f, 0xb8, 0xc2, 0x75, 0xb1, 0x62, 0x19,
-		0x21, 0x08, 0xc1, 0xe4, 0xcd, 0xcd, 0x09, 0xf9, 0x8d, 0x08, 0x9c, 0xec, 0xd3, 0x40, 0xaf, 0xd9,
-		0x3a, 0x20, 0x3b, 0x50, 0x49, 0x47, 0x51, 0x6c, 0xbd, 0xde, 0x9b, 0x42, 0x4f, 0xd9, 0x7d, 0xa6,
-		0xfc, 0x1d, 0x5a, 0xa3, 0xf8, 0x65, 0xae, 0xbf, 0x1d, 0x81, 0x99, 0x60, 0x8b, 0xbc, 0xbe, 0xd5,
-		0x21, 0x1b, 0x6c, 0x0b, 0xef, 0xd5, 0x7b, 0xc6, 0xe9, 0x55, 0xb0, 0x43, 0x21, 0x10, 0xd2, 0x17,
-		0xa1, 0xe9, 0x2c, 0x8d, 0xf7, 0xc4, 0xd8, 0x52, 0xf2, 0xb6, 0x28, 0x06, 0x4d, 0xff, 0x38, 0x1d,
-		0xac, 0xef, 0x8d, 0x42, 0x7c, 0xd3, 0xb2, 0x5a, 0xe8, 0x3b, 0x22, 0x30, 0x65, 0x5a, 0xae, 0x4a,
-		0xe6, 0x07, 0x6e, 0xaa, 0x3c, 0x95, 0xc0, 0x2c, 0xe8, 0x8d, 0xa3, 0x49, 0xef, 0x8b, 0x6f, 0xcc,
-		0xf7, 0x43, 0x0d, 0xba, 0x7d, 0xb7, 0x60, 0x5a, 0x6e, 0x99, 0x12, 0x35, 0x58, 0xb6, 0xe1, 0x36,
-		0xe4, 0xc2, 0xef, 0x67, 0x66, 0x57, 0x39, 0xf2, 0xfb, 0x73, 0x23, 0xdf, 0x9d, 0xdd, 0x0e, 0xbc,
-		0x98, 0xdd, 0x81, 0xf9, 0xe7, 0x64, 0x70, 0x5f, 0x02, 0xe9, 0x46, 0xef, 0xa1, 0xb5, 0x2a, 0x24,
-		0x8f, 0x7a, 0xfe, 0x2d, 0x28, 0x71, 0xce, 0x7b, 0xee, 0x93, 0x11, 0x00, 0x3f, 0x71, 0x83, 0x1e,
-		0x85, 0x13, 0xe5, 0x8d, 0xf5, 0x65, 0xb5, 0xde, 0x28, 0x35, 0xb6, 0xea, 0xe1, 0x1b, 0xd7, 0xc5,
-		0x35, 0x25, 0x4e, 0x07, 0xeb, 0xc6, 0x8e, 0x81, 0x9b, 0xe8, 0x61, 0x98, 0x09, 0x53, 0x93, 0xa7,
-		0xea, 0xb2, 0x14, 0x29, 0x66, 0xef, 0xdc, 0x5d, 0x48, 0x31, 0x97, 0x14, 0x37, 0xd1, 0x19, 0x38,
-		0xd6, 0x4f, 0x57, 0x5b, 0x5f, 0x91, 0xa2, 0xc5, 0xdc, 0x9d, 0xbb, 0x0b, 0x69, 0xcf, 0x77, 0x45,
-		0x32, 0xa0, 0x20, 0x25, 0xc7, 0x8b, 0x15, 0xe1, 0xce, 0xdd, 0x85, 0x04, 0x1b, 0x96, 0x62, 0xfc,
-		0xc3, 0x3f, 0x31, 0x37, 0x71, 0xee, 0x5b, 0x00, 0x6a, 0xe6, 0x8e, 0xad, 0xd1, 0x3f, 0x3b, 0x8c,
-		0x8a, 0x70, 0xbc, 0xb6, 0x7e, 0x45, 0x29, 0x55, 0x1a, 0xb5, 0x8d, 0xf5, 0x9e, 0x8b, 0xe2, 0xc3,
-		0x75, 0xcb, 0x1b, 0x5b, 0xe5, 0xd5, 0xaa, 0x5a, 0xaf, 0xad, 0xac, 0xb3, 0xcd, 0xdf, 0x50, 0xdd,
-		0x0b, 0xeb, 0x8d, 0xda, 0x5a, 0x55, 0x8a, 0x96, 0xaf, 0x0c, 0xdd, 0x7e, 0x78, 0xf4, 0xd0, 0x01,
-		0xf7, 0x2d, 0x65, 0x68, 0x0f, 0xe2, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x67, 0x40, 0xb3,
-		0x7c, 0x9a, 0x00, 0x00,
+		// 12079 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x90, 0x1c, 0xd7,
+		0x75, 0x18, 0xbc, 0x3d, 0xef, 0x39, 0x33, 0x3b, 0xd3, 0x7b, 0x77, 0x01, 0x0c, 0x06, 0x24, 0xb0,
+		0x6c, 0x8a, 0x24, 0x08, 0x8a, 0x4b, 0x12, 0x24, 0x40, 0x62, 0x29, 0x89, 0x9e, 0x17, 0x80, 0x01,
+		0x77, 0x77, 0x56, 0x3d, 0xb3, 0x20, 0x41, 0xdb, 0x6a, 0xf7, 0xce, 0xf4, 0xee, 0x0e, 0x31, 0xd3,
+		0x3d, 0x9a, 0xee, 0x01, 0xb1, 0x2c, 0xd7, 0x57, 0xb4, 0xf5, 0xd9, 0x96, 0xe1, 0x47, 0x14, 0xdb,
+		0x65, 0xd3, 0x0f, 0xd8, 0x92, 0x9f, 0xb2, 0x23, 0x27, 0x7e, 0xc5, 0xb1, 0x93, 0x8a, 0x63, 0x27,
+		0x71, 0x6c, 0xab, 0x52, 0x89, 0xec, 0x72, 0x12, 0x97, 0xcb, 0x61, 0x12, 0x49, 0x25, 0xd2, 0xb2,
+		0x1c, 0x3f, 0x42, 0x57, 0x1e, 0x8a, 0x9d, 0xd4, 0xb9, 0x8f, 0x7e, 0xcc, 0x63, 0x67, 0x16, 0x82,
+		0x54, 0x4c, 0xf9, 0x0f, 0xb0, 0xf7, 0xde, 0x73, 0x4e, 0xdf, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x73,
+		0xee, 0xb9, 0x77, 0xe0, 0xb3, 0x2f, 0xc1, 0xf2, 0xae, 0x65, 0xed, 0x76, 0x8c, 0xc7, 0x7a, 0x7d,
+		0xcb, 0xb1, 0xb6, 0x07, 0x3b, 0x8f, 0xb5, 0x0c, 0xbb, 0xd9, 0x6f, 0xf7, 0x1c, 0xab, 0xbf, 0x42,
+		0xeb, 0x48, 0x96, 0x41, 0xac, 0x08, 0x08, 0x65, 0x1d, 0x16, 0x2e, 0xb6, 0x3b, 0x46, 0xd9, 0x05,
+		0xac, 0x1b, 0x0e, 0x79, 0x06, 0x22, 0x3b, 0xed, 0x8e, 0x91, 0x93, 0x96, 0xc3, 0xa7, 0x53, 0x67,
+		0xdf, 0xb5, 0x32, 0x84, 0xb4, 0x12, 0xc4, 0xd8, 0xc4, 0x6a, 0x95, 0x62, 0x28, 0xaf, 0x47, 0x61,
+		0x71, 0x4c, 0x2b, 0x21, 0x10, 0x31, 0xf5, 0x2e, 0x52, 0x94, 0x4e, 0x27, 0x55, 0xfa, 0x37, 0xc9,
+		0x41, 0xbc, 0xa7, 0x37, 0xaf, 0xeb, 0xbb, 0x46, 0x2e, 0x44, 0xab, 0x45, 0x91, 0x9c, 0x04, 0x68,
+		0x19, 0x3d, 0xc3, 0x6c, 0x19, 0x66, 0x73, 0x3f, 0x17, 0x5e, 0x0e, 0x9f, 0x4e, 0xaa, 0xbe, 0x1a,
+		0xf2, 0x08, 0x2c, 0xf4, 0x06, 0xdb, 0x9d, 0x76, 0x53, 0xf3, 0x81, 0xc1, 0x72, 0xf8, 0x74, 0x54,
+		0x95, 0x59, 0x43, 0xd9, 0x03, 0x7e, 0x08, 0xb2, 0xaf, 0x18, 0xfa, 0x75, 0x3f, 0x68, 0x8a, 0x82,
+		0x66, 0xb0, 0xda, 0x07, 0x58, 0x82, 0x74, 0xd7, 0xb0, 0x6d, 0x7d, 0xd7, 0xd0, 0x9c, 0xfd, 0x9e,
+		0x91, 0x8b, 0xd0, 0xd1, 0x2f, 0x8f, 0x8c, 0x7e, 0x78, 0xe4, 0x29, 0x8e, 0xd5, 0xd8, 0xef, 0x19,
+		0xa4, 0x00, 0x49, 0xc3, 0x1c, 0x74, 0x19, 0x85, 0xe8, 0x04, 0xfe, 0x55, 0xcc, 0x41, 0x77, 0x98,
+		0x4a, 0x02, 0xd1, 0x38, 0x89, 0xb8, 0x6d, 0xf4, 0x6f, 0xb4, 0x9b, 0x46, 0x2e, 0x46, 0x09, 0x3c,
+		0x34, 0x42, 0xa0, 0xce, 0xda, 0x87, 0x69, 0x08, 0x3c, 0x52, 0x82, 0xa4, 0x71, 0xd3, 0x31, 0x4c,
+		0xbb, 0x6d, 0x99, 0xb9, 0x38, 0x25, 0xf2, 0xc0, 0x98, 0x59, 0x34, 0x3a, 0xad, 0x61, 0x12, 0x1e,
+		0x1e, 0x39, 0x0f, 0x71, 0xab, 0xe7, 0xb4, 0x2d, 0xd3, 0xce, 0x25, 0x96, 0xa5, 0xd3, 0xa9, 0xb3,
+		0xf7, 0x8c, 0x15, 0x84, 0x1a, 0x83, 0x51, 0x05, 0x30, 0xa9, 0x82, 0x6c, 0x5b, 0x83, 0x7e, 0xd3,
+		0xd0, 0x9a, 0x56, 0xcb, 0xd0, 0xda, 0xe6, 0x8e, 0x95, 0x4b, 0x52, 0x02, 0xa7, 0x46, 0x07, 0x42,
+		0x01, 0x4b, 0x56, 0xcb, 0xa8, 0x9a, 0x3b, 0x96, 0x9a, 0xb1, 0x03, 0x65, 0x72, 0x14, 0x62, 0xf6,
+		0xbe, 0xe9, 0xe8, 0x37, 0x73, 0x69, 0x2a, 0x21, 0xbc, 0x44, 0xce, 0x42, 0xdc, 0x68, 0xb5, 0xf1,
+		0x73, 0xb9, 0xcc, 0xb2, 0x74, 0x3a, 0x73, 0x36, 0x37, 0xca, 0x63, 0xd6, 0xae, 0x0a, 0x40, 0xe5,
+		0x57, 0x63, 0x90, 0x9d, 0x45, 0x2c, 0x9f, 0x85, 0xe8, 0x0e, 0x72, 0x26, 0x17, 0x3a, 0x0c, 0xdf,
+		0x18, 0x4e, 0x90, 0xf1, 0xb1, 0x3b, 0x64, 0x7c, 0x01, 0x52, 0xa6, 0x61, 0x3b, 0x46, 0x8b, 0x49,
+		0x51, 0x78, 0x46, 0x39, 0x04, 0x86, 0x34, 0x2a, 0x86, 0x91, 0x3b, 0x12, 0xc3, 0x17, 0x21, 0xeb,
+		0x76, 0x49, 0xeb, 0xeb, 0xe6, 0xae, 0x90, 0xe7, 0xc7, 0xa6, 0xf5, 0x64, 0xa5, 0x22, 0xf0, 0x54,
+		0x44, 0x53, 0x33, 0x46, 0xa0, 0x4c, 0xca, 0x00, 0x96, 0x69, 0x58, 0x3b, 0x5a, 0xcb, 0x68, 0x76,
+		0x72, 0x89, 0x09, 0x5c, 0xaa, 0x21, 0xc8, 0x08, 0x97, 0x2c, 0x56, 0xdb, 0xec, 0x90, 0x0b, 0x9e,
+		0x78, 0xc6, 0x27, 0x48, 0xd7, 0x3a, 0x5b, 0x98, 0x23, 0x12, 0xba, 0x05, 0x99, 0xbe, 0x81, 0x6b,
+		0xc5, 0x68, 0xf1, 0x91, 0x25, 0x69, 0x27, 0x56, 0xa6, 0x8e, 0x4c, 0xe5, 0x68, 0x6c, 0x60, 0xf3,
+		0x7d, 0x7f, 0x91, 0xdc, 0x0f, 0x6e, 0x85, 0x46, 0xc5, 0x0a, 0xa8, 0xe6, 0x4a, 0x8b, 0xca, 0x0d,
+		0xbd, 0x6b, 0xe4, 0x5f, 0x85, 0x4c, 0x90, 0x3d, 0x64, 0x09, 0xa2, 0xb6, 0xa3, 0xf7, 0x1d, 0x2a,
+		0x85, 0x51, 0x95, 0x15, 0x88, 0x0c, 0x61, 0xc3, 0x6c, 0x51, 0xcd, 0x18, 0x55, 0xf1, 0x4f, 0xf2,
+		0x55, 0xde, 0x80, 0xc3, 0x74, 0xc0, 0x0f, 0x8e, 0xce, 0x68, 0x80, 0xf2, 0xf0, 0xb8, 0xf3, 0x4f,
+		0xc3, 0x7c, 0x60, 0x00, 0xb3, 0x7e, 0x5a, 0xf9, 0xad, 0x08, 0x1c, 0x19, 0x4b, 0x9b, 0xbc, 0x08,
+		0x4b, 0x03, 0xb3, 0x6d, 0x3a, 0x46, 0xbf, 0xd7, 0x37, 0x50, 0x64, 0xd9, 0xb7, 0x72, 0x6f, 0xc6,
+		0x27, 0x08, 0xdd, 0x96, 0x1f, 0x9a, 0x51, 0x51, 0x17, 0x07, 0xa3, 0x95, 0xe4, 0x1a, 0xa4, 0x50,
+		0x3e, 0xf4, 0xbe, 0x4e, 0x09, 0xb2, 0xd5, 0x78, 0x76, 0xb6, 0x21, 0xaf, 0x94, 0x3d, 0xcc, 0x62,
+		0xf8, 0xc3, 0x52, 0x48, 0xf5, 0xd3, 0x22, 0x4f, 0x43, 0x62, 0xc7, 0xd0, 0x9d, 0x41, 0xdf, 0xb0,
+		0x73, 0x67, 0x29, 0x2b, 0x4f, 0x8c, 0x2e, 0x52, 0x06, 0x50, 0x37, 0x1c, 0xd5, 0x05, 0x26, 0x7b,
+		0x90, 0xbe, 0x61, 0xf4, 0xdb, 0x3b, 0xed, 0x26, 0xeb, 0x54, 0x98, 0x2a, 0x9f, 0x67, 0x66, 0xec,
+		0xd4, 0x55, 0x1f, 0x6a, 0xdd, 0xd1, 0x1d, 0x63, 0x15, 0xb6, 0x36, 0xae, 0x56, 0xd4, 0xea, 0xc5,
+		0x6a, 0xa5, 0xac, 0x06, 0x28, 0xe7, 0xbf, 0x47, 0x82, 0x94, 0x6f, 0x10, 0xa8, 0x09, 0xcd, 0x41,
+		0x77, 0xdb, 0xe8, 0xf3, 0xa9, 0xe2, 0x25, 0x72, 0x02, 0x92, 0x3b, 0x83, 0x4e, 0x87, 0xc9, 0x1b,
+		0xdb, 0x46, 0x13, 0x58, 0x81, 0xb2, 0x86, 0xea, 0x8d, 0x6b, 0x10, 0xaa, 0xde, 0xf0, 0x6f, 0x92,
+		0x87, 0x84, 0x90, 0xc7, 0x5c, 0x74, 0x59, 0x3a, 0x9d, 0x50, 0xdd, 0x32, 0x6b, 0xeb, 0x19, 0xba,
+		0x63, 0xb4, 0x72, 0x31, 0xd1, 0xc6, 0xca, 0x57, 0x22, 0x89, 0x88, 0x1c, 0x55, 0x9e, 0x82, 0x85,
+		0x91, 0x51, 0x90, 0x2c, 0xa4, 0xca, 0x95, 0xd2, 0x5a, 0x41, 0x2d, 0x34, 0xaa, 0xb5, 0x0d, 0x79,
+		0x8e, 0x64, 0xc0, 0x37, 0x30, 0x59, 0x3a, 0x93, 0x4c, 0xbc, 0x15, 0x97, 0x5f, 0x7b, 0xed, 0xb5,
+		0xd7, 0x42, 0xca, 0x6f, 0xc4, 0x60, 0x69, 0x9c, 0xfe, 0x1b, 0xab, 0x8a, 0xbd, 0x41, 0x87, 0x03,
+		0x83, 0x2e, 0x40, 0xb4, 0xa3, 0x6f, 0x1b, 0x9d, 0x5c, 0x84, 0xf2, 0xff, 0x91, 0x99, 0x34, 0xec,
+		0xca, 0x1a, 0xa2, 0xa8, 0x0c, 0x93, 0xbc, 0x8f, 0xb3, 0x26, 0x4a, 0x29, 0x9c, 0x99, 0x8d, 0x02,
+		0xea, 0x45, 0xce, 0xc6, 0x13, 0x90, 0xc4, 0xff, 0x19, 0xdf, 0x63, 0x8c, 0xef, 0x58, 0x41, 0xf9,
+		0x9e, 0x87, 0x04, 0x55, 0x79, 0x2d, 0xc3, 0x9d, 0x13, 0x51, 0x46, 0x25, 0xd1, 0x32, 0x76, 0xf4,
+		0x41, 0xc7, 0xd1, 0x6e, 0xe8, 0x9d, 0x81, 0x41, 0x95, 0x57, 0x52, 0x4d, 0xf3, 0xca, 0xab, 0x58,
+		0x47, 0x4e, 0x41, 0x8a, 0x69, 0xc8, 0xb6, 0xd9, 0x32, 0x6e, 0xd2, 0xdd, 0x33, 0xaa, 0x32, 0xa5,
+		0x59, 0xc5, 0x1a, 0xfc, 0xfc, 0xcb, 0xb6, 0x65, 0x0a, 0x35, 0x43, 0x3f, 0x81, 0x15, 0xf4, 0xf3,
+		0x4f, 0x0f, 0x6f, 0xdc, 0xf7, 0x8e, 0x1f, 0xde, 0x88, 0x5e, 0x7c, 0x08, 0xb2, 0x14, 0xe2, 0x49,
+		0xbe, 0x8a, 0xf5, 0x4e, 0x6e, 0x81, 0x8a, 0x41, 0x86, 0x55, 0xd7, 0x78, 0xad, 0xf2, 0xcb, 0x21,
+		0x88, 0xd0, 0x4d, 0x22, 0x0b, 0xa9, 0xc6, 0xb5, 0xcd, 0x8a, 0x56, 0xae, 0x6d, 0x15, 0xd7, 0x2a,
+		0xb2, 0x84, 0x53, 0x4f, 0x2b, 0x2e, 0xae, 0xd5, 0x0a, 0x0d, 0x39, 0xe4, 0x96, 0xab, 0x1b, 0x8d,
+		0xf3, 0x4f, 0xc9, 0x61, 0x17, 0x61, 0x8b, 0x55, 0x44, 0xfc, 0x00, 0x4f, 0x9e, 0x95, 0xa3, 0x44,
+		0x86, 0x34, 0x23, 0x50, 0x7d, 0xb1, 0x52, 0x3e, 0xff, 0x94, 0x1c, 0x0b, 0xd6, 0x3c, 0x79, 0x56,
+		0x8e, 0x93, 0x79, 0x48, 0xd2, 0x9a, 0x62, 0xad, 0xb6, 0x26, 0x27, 0x5c, 0x9a, 0xf5, 0x86, 0x5a,
+		0xdd, 0xb8, 0x24, 0x27, 0x5d, 0x9a, 0x97, 0xd4, 0xda, 0xd6, 0xa6, 0x0c, 0x2e, 0x85, 0xf5, 0x4a,
+		0xbd, 0x5e, 0xb8, 0x54, 0x91, 0x53, 0x2e, 0x44, 0xf1, 0x5a, 0xa3, 0x52, 0x97, 0xd3, 0x81, 0x6e,
+		0x3d, 0x79, 0x56, 0x9e, 0x77, 0x3f, 0x51, 0xd9, 0xd8, 0x5a, 0x97, 0x33, 0x64, 0x01, 0xe6, 0xd9,
+		0x27, 0x44, 0x27, 0xb2, 0x43, 0x55, 0xe7, 0x9f, 0x92, 0x65, 0xaf, 0x23, 0x8c, 0xca, 0x42, 0xa0,
+		0xe2, 0xfc, 0x53, 0x32, 0x51, 0x4a, 0x10, 0xa5, 0x62, 0x48, 0x08, 0x64, 0xd6, 0x0a, 0xc5, 0xca,
+		0x9a, 0x56, 0xdb, 0xc4, 0x45, 0x53, 0x58, 0x93, 0x25, 0xaf, 0x4e, 0xad, 0x6c, 0x56, 0x0a, 0x8d,
+		0x4a, 0x59, 0x0e, 0xfb, 0xeb, 0xde, 0xbf, 0x55, 0x55, 0x2b, 0x65, 0x39, 0xa4, 0x34, 0x61, 0x69,
+		0xdc, 0xe6, 0x38, 0x76, 0x09, 0xf9, 0x64, 0x21, 0x34, 0x41, 0x16, 0x28, 0xad, 0x61, 0x59, 0x50,
+		0x3e, 0x13, 0x82, 0xc5, 0x31, 0x06, 0xc2, 0xd8, 0x8f, 0x3c, 0x07, 0x51, 0x26, 0xcb, 0x4c, 0x49,
+		0x3f, 0x3c, 0xd6, 0xd2, 0xa0, 0x92, 0x3d, 0x62, 0x36, 0x51, 0x3c, 0xbf, 0xa9, 0x19, 0x9e, 0x60,
+		0x6a, 0x22, 0x89, 0x11, 0x81, 0xfd, 0xda, 0x91, 0x8d, 0x9c, 0xd9, 0x3a, 0xe7, 0x67, 0xb1, 0x75,
+		0x68, 0xdd, 0xe1, 0x36, 0xf4, 0xe8, 0x98, 0x0d, 0xfd, 0x59, 0x58, 0x18, 0x21, 0x34, 0xf3, 0xc6,
+		0xfa, 0x21, 0x09, 0x72, 0x93, 0x98, 0x33, 0x45, 0x25, 0x86, 0x02, 0x2a, 0xf1, 0xd9, 0x61, 0x0e,
+		0xde, 0x37, 0x79, 0x12, 0x46, 0xe6, 0xfa, 0xa7, 0x24, 0x38, 0x3a, 0xde, 0xa5, 0x18, 0xdb, 0x87,
+		0xf7, 0x41, 0xac, 0x6b, 0x38, 0x7b, 0x96, 0x30, 0x91, 0x1f, 0x1c, 0x63, 0x78, 0x61, 0xf3, 0xf0,
+		0x64, 0x73, 0x2c, 0x72, 0x61, 0xb8, 0xaf, 0xa7, 0x26, 0x39, 0x38, 0x23, 0x3d, 0xfd, 0xd6, 0x10,
+		0x1c, 0x19, 0x4b, 0x7c, 0x6c, 0x47, 0xef, 0x05, 0x68, 0x9b, 0xbd, 0x81, 0xc3, 0xcc, 0x60, 0xa6,
+		0x89, 0x93, 0xb4, 0x86, 0x2a, 0x2f, 0xd4, 0xb2, 0x03, 0xc7, 0x6d, 0x67, 0xbb, 0x24, 0xb0, 0x2a,
+		0x0a, 0xf0, 0x8c, 0xd7, 0xd1, 0x08, 0xed, 0xe8, 0xc9, 0x09, 0x23, 0x1d, 0x11, 0xcc, 0xc7, 0x41,
+		0x6e, 0x76, 0xda, 0x86, 0xe9, 0x68, 0xb6, 0xd3, 0x37, 0xf4, 0x6e, 0xdb, 0xdc, 0x65, 0xbb, 0xed,
+		0x6a, 0x74, 0x47, 0xef, 0xd8, 0x86, 0x9a, 0x65, 0xcd, 0x75, 0xd1, 0x8a, 0x18, 0x54, 0x80, 0xfa,
+		0x3e, 0x8c, 0x58, 0x00, 0x83, 0x35, 0xbb, 0x18, 0xca, 0x27, 0x93, 0x90, 0xf2, 0x39, 0x60, 0xe4,
+		0x3e, 0x48, 0xbf, 0xac, 0xdf, 0xd0, 0x35, 0xe1, 0x54, 0x33, 0x4e, 0xa4, 0xb0, 0x6e, 0x93, 0x55,
+		0x91, 0xc7, 0x61, 0x89, 0x82, 0x58, 0x03, 0xc7, 0xe8, 0x6b, 0xcd, 0x8e, 0x6e, 0xdb, 0x94, 0x69,
+		0x09, 0x0a, 0x4a, 0xb0, 0xad, 0x86, 0x4d, 0x25, 0xd1, 0x42, 0xce, 0xc1, 0x22, 0xc5, 0xe8, 0x0e,
+		0x3a, 0x4e, 0xbb, 0xd7, 0x31, 0x34, 0x74, 0xf3, 0xed, 0x1c, 0xf8, 0x7b, 0xb6, 0x80, 0x10, 0xeb,
+		0x1c, 0x00, 0x7b, 0x64, 0x93, 0x32, 0xdc, 0x4b, 0xd1, 0x76, 0x0d, 0xd3, 0xe8, 0xeb, 0x8e, 0xa1,
+		0x19, 0x1f, 0x1c, 0xe8, 0x1d, 0x5b, 0xd3, 0xcd, 0x96, 0xb6, 0xa7, 0xdb, 0x7b, 0xb9, 0x25, 0x24,
+		0x50, 0x0c, 0xe5, 0x24, 0xf5, 0x38, 0x02, 0x5e, 0xe2, 0x70, 0x15, 0x0a, 0x56, 0x30, 0x5b, 0x97,
+		0x75, 0x7b, 0x8f, 0xac, 0xc2, 0x51, 0x4a, 0xc5, 0x76, 0xfa, 0x6d, 0x73, 0x57, 0x6b, 0xee, 0x19,
+		0xcd, 0xeb, 0xda, 0xc0, 0xd9, 0x79, 0x26, 0x77, 0xc2, 0xff, 0x7d, 0xda, 0xc3, 0x3a, 0x85, 0x29,
+		0x21, 0xc8, 0x96, 0xb3, 0xf3, 0x0c, 0xa9, 0x43, 0x1a, 0x27, 0xa3, 0xdb, 0x7e, 0xd5, 0xd0, 0x76,
+		0xac, 0x3e, 0xdd, 0x43, 0x33, 0x63, 0x54, 0x93, 0x8f, 0x83, 0x2b, 0x35, 0x8e, 0xb0, 0x6e, 0xb5,
+		0x8c, 0xd5, 0x68, 0x7d, 0xb3, 0x52, 0x29, 0xab, 0x29, 0x41, 0xe5, 0xa2, 0xd5, 0x47, 0x81, 0xda,
+		0xb5, 0x5c, 0x06, 0xa7, 0x98, 0x40, 0xed, 0x5a, 0x82, 0xbd, 0xe7, 0x60, 0xb1, 0xd9, 0x64, 0x63,
+		0x6e, 0x37, 0x35, 0xee, 0x8c, 0xdb, 0x39, 0x39, 0xc0, 0xac, 0x66, 0xf3, 0x12, 0x03, 0xe0, 0x32,
+		0x6e, 0x93, 0x0b, 0x70, 0xc4, 0x63, 0x96, 0x1f, 0x71, 0x61, 0x64, 0x94, 0xc3, 0xa8, 0xe7, 0x60,
+		0xb1, 0xb7, 0x3f, 0x8a, 0x48, 0x02, 0x5f, 0xec, 0xed, 0x0f, 0xa3, 0x3d, 0x0d, 0x4b, 0xbd, 0xbd,
+		0xde, 0x28, 0xde, 0x19, 0x3f, 0x1e, 0xe9, 0xed, 0xf5, 0x86, 0x11, 0x1f, 0xa0, 0x91, 0x99, 0xbe,
+		0xd1, 0xa4, 0x36, 0xe2, 0x31, 0x3f, 0xb8, 0xaf, 0x81, 0xac, 0x80, 0xdc, 0x6c, 0x6a, 0x86, 0xa9,
+		0x6f, 0x77, 0x0c, 0x4d, 0xef, 0x1b, 0xa6, 0x6e, 0xe7, 0x4e, 0x51, 0xe0, 0x88, 0xd3, 0x1f, 0x18,
+		0x6a, 0xa6, 0xd9, 0xac, 0xd0, 0xc6, 0x02, 0x6d, 0x23, 0x67, 0x60, 0xc1, 0xda, 0x7e, 0xb9, 0xc9,
+		0x24, 0x52, 0xeb, 0xf5, 0x8d, 0x9d, 0xf6, 0xcd, 0xdc, 0xbb, 0x28, 0x7b, 0xb3, 0xd8, 0x40, 0xe5,
+		0x71, 0x93, 0x56, 0x93, 0x87, 0x41, 0x6e, 0xda, 0x7b, 0x7a, 0xbf, 0x47, 0x55, 0xb2, 0xdd, 0xd3,
+		0x9b, 0x46, 0xee, 0x01, 0x06, 0xca, 0xea, 0x37, 0x44, 0x35, 0xae, 0x08, 0xfb, 0x95, 0xf6, 0x8e,
+		0x23, 0x28, 0x3e, 0xc4, 0x56, 0x04, 0xad, 0xe3, 0xd4, 0x4e, 0x83, 0x8c, 0x9c, 0x08, 0x7c, 0xf8,
+		0x34, 0x05, 0xcb, 0xf4, 0xf6, 0x7a, 0xfe, 0xef, 0xde, 0x0f, 0xf3, 0xbd, 0x3d, 0xff, 0x47, 0x1f,
+		0x66, 0x86, 0x5b, 0x6f, 0xcf, 0xf7, 0xc5, 0xa7, 0xe0, 0x28, 0x02, 0x75, 0x0d, 0x47, 0x6f, 0xe9,
+		0x8e, 0xee, 0x83, 0x7e, 0x37, 0x85, 0x46, 0xb6, 0xaf, 0xf3, 0xc6, 0x40, 0x3f, 0xfb, 0x83, 0xed,
+		0x7d, 0x57, 0xb0, 0x1e, 0x65, 0xfd, 0xc4, 0x3a, 0x21, 0x5a, 0x77, 0xec, 0xb2, 0x7c, 0xd9, 0x1c,
+		0x34, 0x65, 0x15, 0xd2, 0xfe, 0x05, 0x43, 0x92, 0xc0, 0x96, 0x8c, 0x2c, 0xa1, 0xf5, 0x54, 0xaa,
+		0x95, 0xd1, 0xee, 0x79, 0xa9, 0x22, 0x87, 0xd0, 0xfe, 0x5a, 0xab, 0x36, 0x2a, 0x9a, 0xba, 0xb5,
+		0xd1, 0xa8, 0xae, 0x57, 0xe4, 0xb0, 0xcf, 0x23, 0xb8, 0x12, 0x49, 0x3c, 0x28, 0x3f, 0xa4, 0xbc,
+		0x1d, 0x86, 0x4c, 0xd0, 0x5d, 0x27, 0xef, 0x81, 0x63, 0x22, 0x1e, 0x67, 0x1b, 0x8e, 0xf6, 0x4a,
+		0xbb, 0x4f, 0x57, 0x72, 0x57, 0x67, 0xbb, 0xaa, 0x2b, 0x78, 0x4b, 0x1c, 0xaa, 0x6e, 0x38, 0x2f,
+		0xb4, 0xfb, 0xb8, 0x4e, 0xbb, 0xba, 0x43, 0xd6, 0xe0, 0x94, 0x69, 0x69, 0xb6, 0xa3, 0x9b, 0x2d,
+		0xbd, 0xdf, 0xd2, 0xbc, 0x48, 0xa8, 0xa6, 0x37, 0x9b, 0x86, 0x6d, 0x5b, 0x6c, 0x07, 0x75, 0xa9,
+		0xdc, 0x63, 0x5a, 0x75, 0x0e, 0xec, 0x6d, 0x2d, 0x05, 0x0e, 0x3a, 0x24, 0xf7, 0xe1, 0x49, 0x72,
+		0x7f, 0x02, 0x92, 0x5d, 0xbd, 0xa7, 0x19, 0xa6, 0xd3, 0xdf, 0xa7, 0x86, 0x7d, 0x42, 0x4d, 0x74,
+		0xf5, 0x5e, 0x05, 0xcb, 0xe4, 0x2a, 0x3c, 0xe8, 0x81, 0x6a, 0x1d, 0x63, 0x57, 0x6f, 0xee, 0x6b,
+		0xd4, 0x8a, 0xa7, 0xb1, 0x23, 0xad, 0x69, 0x99, 0x3b, 0x9d, 0x76, 0xd3, 0xb1, 0x73, 0x29, 0x57,
+		0x39, 0x2a, 0x1e, 0xc6, 0x1a, 0x45, 0xb8, 0x62, 0x5b, 0x26, 0x35, 0xde, 0x4b, 0x02, 0x3a, 0x20,
+		0x1a, 0xe9, 0x77, 0x84, 0x68, 0x04, 0xa7, 0x37, 0x22, 0x47, 0xaf, 0x44, 0x12, 0x51, 0x39, 0x76,
+		0x25, 0x92, 0x88, 0xc9, 0xf1, 0x2b, 0x91, 0x44, 0x42, 0x4e, 0x5e, 0x89, 0x24, 0x92, 0x32, 0x28,
+		0x3f, 0x0b, 0x90, 0xf6, 0xfb, 0x22, 0xe8, 0xda, 0x35, 0xe9, 0x6e, 0x2c, 0x51, 0x7d, 0x7d, 0xff,
+		0x81, 0x9e, 0xcb, 0x4a, 0x09, 0xb7, 0xe9, 0xd5, 0x18, 0x33, 0xfc, 0x55, 0x86, 0x89, 0x26, 0x12,
+		0x2e, 0x24, 0x83, 0x19, 0x5a, 0x09, 0x95, 0x97, 0xc8, 0x25, 0x88, 0xbd, 0x6c, 0x53, 0xda, 0x31,
+		0x4a, 0xfb, 0x5d, 0x07, 0xd3, 0xbe, 0x52, 0xa7, 0xc4, 0x93, 0x57, 0xea, 0xda, 0x46, 0x4d, 0x5d,
+		0x2f, 0xac, 0xa9, 0x1c, 0x9d, 0x1c, 0x87, 0x48, 0x47, 0x7f, 0x75, 0x3f, 0xb8, 0xa1, 0xd3, 0x2a,
+		0xb2, 0x02, 0xd9, 0x81, 0xc9, 0x1c, 0x79, 0x9c, 0x63, 0x84, 0xca, 0xfa, 0xa1, 0x32, 0x5e, 0xeb,
+		0x1a, 0xc2, 0xcf, 0x28, 0x57, 0xc7, 0x21, 0x82, 0xc1, 0xea, 0xe0, 0xb6, 0x4b, 0xab, 0xc8, 0x69,
+		0x48, 0xb7, 0x8c, 0xed, 0xc1, 0xae, 0xd6, 0x37, 0x5a, 0x7a, 0xd3, 0x09, 0x6e, 0x36, 0x29, 0xda,
+		0xa4, 0xd2, 0x16, 0xf2, 0x3c, 0x24, 0x71, 0x8e, 0x4c, 0x3a, 0xc7, 0x0b, 0x94, 0x05, 0x8f, 0x1e,
+		0xcc, 0x02, 0x3e, 0xc5, 0x02, 0x49, 0xf5, 0xf0, 0xc9, 0x65, 0x88, 0x3b, 0x7a, 0x7f, 0xd7, 0x70,
+		0xec, 0xdc, 0xe2, 0x72, 0xf8, 0x74, 0xe6, 0xec, 0xca, 0x2c, 0xa4, 0x1a, 0x14, 0x85, 0xba, 0xd1,
+		0x02, 0x9d, 0xbc, 0x00, 0x32, 0x0f, 0xd1, 0x6a, 0xdc, 0x07, 0xb6, 0x73, 0x4b, 0x54, 0x00, 0xdf,
+		0x7d, 0x30, 0x49, 0x1e, 0xe1, 0x2d, 0x33, 0x24, 0x35, 0x6b, 0x04, 0xca, 0xc1, 0x75, 0x71, 0xe4,
+		0x1d, 0xb1, 0x2e, 0xf2, 0x2f, 0x41, 0x26, 0xd8, 0x6b, 0x7f, 0x24, 0x3b, 0x3c, 0x63, 0x24, 0x1b,
+		0x9d, 0x0b, 0xe1, 0x6e, 0xe1, 0x3e, 0xc1, 0x0a, 0xca, 0x63, 0x10, 0xa5, 0xcb, 0x81, 0x00, 0xf0,
+		0x05, 0x21, 0xcf, 0x91, 0x04, 0x44, 0x4a, 0x35, 0x15, 0x55, 0xb2, 0x0c, 0x69, 0x56, 0xab, 0x6d,
+		0x56, 0x2b, 0xa5, 0x8a, 0x1c, 0x52, 0xce, 0x41, 0x8c, 0xc9, 0x38, 0xaa, 0x6b, 0x57, 0xca, 0xe5,
+		0x39, 0x5e, 0xe4, 0x34, 0x24, 0xd1, 0xba, 0xb5, 0x5e, 0xac, 0xa8, 0x72, 0x48, 0xd9, 0x82, 0xec,
+		0x90, 0x5c, 0x90, 0x23, 0xb0, 0xa0, 0x56, 0x1a, 0x95, 0x0d, 0xf4, 0x64, 0xb5, 0xad, 0x8d, 0xe7,
+		0x37, 0x6a, 0x2f, 0x60, 0x18, 0x28, 0x50, 0x2d, 0x74, 0xbf, 0x44, 0x96, 0x40, 0xf6, 0xaa, 0xeb,
+		0xb5, 0x2d, 0x95, 0xf6, 0xe6, 0xdb, 0x43, 0x20, 0x0f, 0x0b, 0x09, 0x39, 0x06, 0x8b, 0x8d, 0x82,
+		0x7a, 0xa9, 0xd2, 0xd0, 0x98, 0x77, 0xee, 0x92, 0x5e, 0x02, 0xd9, 0xdf, 0x70, 0xb1, 0x4a, 0x83,
+		0x0f, 0xa7, 0xe0, 0x84, 0xbf, 0xb6, 0xf2, 0x62, 0xa3, 0xb2, 0x51, 0xa7, 0x1f, 0x2f, 0x6c, 0x5c,
+		0xc2, 0x8d, 0x68, 0x88, 0x9e, 0x88, 0x07, 0x84, 0xb1, 0xab, 0x41, 0x7a, 0x95, 0xb5, 0xb2, 0x1c,
+		0x19, 0xae, 0xae, 0x6d, 0x54, 0x6a, 0x17, 0xe5, 0xe8, 0xf0, 0xd7, 0x69, 0x8c, 0x20, 0x46, 0xf2,
+		0x70, 0x74, 0xb8, 0x56, 0xab, 0x6c, 0x34, 0xd4, 0x6b, 0x72, 0x7c, 0xf8, 0xc3, 0xf5, 0x8a, 0x7a,
+		0xb5, 0x5a, 0xaa, 0xc8, 0x09, 0x72, 0x14, 0x48, 0xb0, 0x47, 0x8d, 0xcb, 0xb5, 0xb2, 0x9c, 0x1c,
+		0xa7, 0x41, 0x89, 0xbc, 0xa8, 0x7c, 0x42, 0x82, 0xb4, 0xdf, 0x5f, 0x0f, 0x08, 0xb9, 0xf4, 0x4e,
+		0x53, 0xfe, 0xca, 0xef, 0x86, 0x20, 0xe5, 0x73, 0xdc, 0xd1, 0xe3, 0xd2, 0x3b, 0x1d, 0xeb, 0x15,
+		0x4d, 0xef, 0xb4, 0x75, 0x9b, 0xeb, 0x67, 0xa0, 0x55, 0x05, 0xac, 0x99, 0x55, 0x1f, 0xce, 0xbe,
+		0x95, 0xc6, 0xee, 0x78, 0x2b, 0x8d, 0xbf, 0x03, 0xb7, 0xd2, 0xa8, 0x1c, 0x53, 0xbe, 0x21, 0x04,
+		0xf2, 0xb0, 0x2b, 0x3f, 0xc4, 0x37, 0x69, 0x12, 0xdf, 0xfc, 0xe3, 0x0b, 0x1d, 0x66, 0x7c, 0xc3,
+		0xbb, 0x4c, 0x78, 0xe2, 0x2e, 0xf3, 0x15, 0x91, 0xab, 0x7f, 0x27, 0x41, 0x26, 0x18, 0x22, 0x08,
+		0x0c, 0x4d, 0x39, 0xcc, 0xd0, 0x82, 0xac, 0xbb, 0x6f, 0x12, 0xeb, 0xbe, 0x22, 0xe3, 0xfa, 0xfe,
+		0x30, 0xcc, 0x07, 0x22, 0x0a, 0xb3, 0xf6, 0xee, 0x83, 0xb0, 0xd0, 0x6e, 0x19, 0xdd, 0x9e, 0xe5,
+		0xe0, 0x51, 0xb6, 0xd6, 0x31, 0x6e, 0x18, 0x1d, 0xca, 0x86, 0xcc, 0x98, 0xe3, 0xba, 0xc0, 0x17,
+		0x56, 0xaa, 0x1e, 0xde, 0x1a, 0xa2, 0xad, 0x2e, 0x56, 0xcb, 0x95, 0xf5, 0xcd, 0x5a, 0xa3, 0xb2,
+		0x51, 0xba, 0x26, 0x54, 0xae, 0x2a, 0xb7, 0x87, 0xc0, 0x02, 0x0c, 0xbf, 0xff, 0x9d, 0xe1, 0x91,
+		0x6c, 0x82, 0x3c, 0x3c, 0x1a, 0xd4, 0xbc, 0x63, 0xc6, 0x23, 0xcf, 0x91, 0x45, 0xc8, 0x6e, 0xd4,
+		0xb4, 0x7a, 0xb5, 0x5c, 0xd1, 0x2a, 0x17, 0x2f, 0x56, 0x4a, 0x8d, 0x3a, 0x0b, 0x5f, 0xbb, 0xd0,
+		0x0d, 0x39, 0xe4, 0x9f, 0x9b, 0x1f, 0x08, 0xc3, 0xe2, 0x98, 0x9e, 0x90, 0x02, 0x0f, 0x3c, 0xb1,
+		0x58, 0xd8, 0xa3, 0xb3, 0xf4, 0x7e, 0x05, 0x5d, 0xbf, 0x4d, 0xbd, 0xef, 0xf0, 0x38, 0xd5, 0xc3,
+		0x80, 0xec, 0x35, 0x1d, 0xb4, 0x0b, 0xfb, 0xfc, 0x58, 0x80, 0x45, 0xa3, 0xb2, 0x5e, 0x3d, 0x3b,
+		0x19, 0x78, 0x37, 0x90, 0x9e, 0x65, 0xb7, 0x9d, 0xf6, 0x0d, 0x3c, 0x59, 0x17, 0x67, 0x08, 0x18,
+		0x9d, 0x8a, 0xa8, 0xb2, 0x68, 0xa9, 0x9a, 0x8e, 0x0b, 0x6d, 0x1a, 0xbb, 0xfa, 0x10, 0x34, 0xda,
+		0xad, 0x61, 0x55, 0x16, 0x2d, 0x2e, 0xf4, 0x7d, 0x90, 0x6e, 0x59, 0x03, 0x74, 0xd9, 0x19, 0x1c,
+		0xea, 0x4e, 0x49, 0x4d, 0xb1, 0x3a, 0x17, 0x84, 0x07, 0x63, 0xbc, 0xc3, 0x8b, 0xb4, 0x9a, 0x62,
+		0x75, 0x0c, 0xe4, 0x21, 0xc8, 0xea, 0xbb, 0xbb, 0x7d, 0x24, 0x2e, 0x08, 0xb1, 0xf0, 0x52, 0xc6,
+		0xad, 0xa6, 0x80, 0xf9, 0x2b, 0x90, 0x10, 0x7c, 0x40, 0xc7, 0x09, 0x39, 0xa1, 0xf5, 0x58, 0xcc,
+		0x34, 0x84, 0xe7, 0x19, 0xa6, 0x68, 0xbc, 0x0f, 0xd2, 0x6d, 0x5b, 0xf3, 0xce, 0xd5, 0x43, 0xcb,
+		0xa1, 0xd3, 0x09, 0x35, 0xd5, 0xb6, 0xdd, 0x63, 0x36, 0xe5, 0x7f, 0x27, 0x01, 0x3c, 0x61, 0x23,
+		0xdf, 0x26, 0x41, 0x86, 0xed, 0x04, 0xbd, 0xbe, 0x61, 0x1b, 0x66, 0x53, 0xf8, 0x13, 0x0f, 0x1f,
+		0x20, 0xa2, 0xcc, 0xba, 0xdc, 0xe4, 0x08, 0xc5, 0x0b, 0x1f, 0x96, 0xa4, 0xd7, 0xa5, 0xc8, 0xeb,
+		0x92, 0xf4, 0xa3, 0xd2, 0x3c, 0x49, 0x54, 0x5e, 0xdc, 0x5c, 0xab, 0x96, 0xaa, 0x8d, 0xdc, 0xe7,
+		0xe2, 0xb4, 0x5c, 0x5d, 0xe7, 0xe5, 0x37, 0xe3, 0xc1, 0xf6, 0xb7, 0xe2, 0xea, 0xfc, 0x8e, 0x9f,
+		0x12, 0xd9, 0xf1, 0x1f, 0xc6, 0x87, 0x26, 0xf9, 0x1e, 0x5e, 0x3f, 0x2a, 0xfc, 0x08, 0xbe, 0x78,
+		0x3f, 0xed, 0x42, 0x8c, 0x76, 0x21, 0x45, 0x62, 0xa5, 0xb5, 0x5a, 0xbd, 0x52, 0xa6, 0x1d, 0x48,
+		0x92, 0x48, 0x6d, 0xb3, 0xb2, 0x91, 0x7b, 0x33, 0xee, 0x3b, 0xb1, 0xff, 0x2e, 0x09, 0x8e, 0x89,
+		0xf3, 0x3a, 0xbe, 0x11, 0x1a, 0x66, 0xd3, 0x6a, 0x61, 0x28, 0x91, 0x19, 0x97, 0x4f, 0x1c, 0xf4,
+		0x59, 0x95, 0xa3, 0x52, 0x36, 0x54, 0x38, 0x62, 0xf1, 0xa1, 0x11, 0x36, 0x14, 0x36, 0xca, 0xbc,
+		0x17, 0x29, 0x12, 0xdb, 0x2c, 0x94, 0x9e, 0xaf, 0x94, 0xb1, 0x1f, 0x47, 0xfa, 0xe3, 0xf0, 0xc9,
+		0x4d, 0xc8, 0x62, 0xc4, 0x0e, 0x25, 0xa1, 0xdd, 0x62, 0xa7, 0xa6, 0x91, 0x49, 0x67, 0x6e, 0x5e,
+		0x5f, 0x30, 0x84, 0x77, 0xd5, 0xc5, 0x28, 0xde, 0xef, 0xeb, 0x44, 0x92, 0x44, 0x36, 0x6a, 0x1b,
+		0x15, 0xd1, 0x01, 0x7a, 0xcc, 0x78, 0x0d, 0x3b, 0x90, 0x19, 0x04, 0x90, 0xc8, 0x4d, 0x90, 0x45,
+		0xfc, 0xc0, 0x65, 0x43, 0x74, 0xd2, 0x81, 0xa1, 0xf7, 0x69, 0x1e, 0x85, 0x70, 0x19, 0xb0, 0xec,
+		0xfb, 0xf6, 0x12, 0xc9, 0xae, 0x55, 0x36, 0x2e, 0x35, 0x2e, 0x6b, 0x9b, 0x6a, 0x85, 0x9e, 0xfb,
+		0xe4, 0x3e, 0x17, 0x57, 0xb3, 0xdd, 0x20, 0x0a, 0xf9, 0x7a, 0x48, 0x31, 0x63, 0x84, 0x45, 0x2b,
+		0x98, 0xbb, 0xf9, 0xe0, 0x41, 0x1f, 0xa5, 0xb6, 0x08, 0x85, 0x2e, 0x3e, 0x49, 0xbf, 0x17, 0x16,
+		0xf3, 0x7e, 0x8c, 0x90, 0xb5, 0xca, 0xa5, 0x42, 0xe9, 0x9a, 0x56, 0xac, 0xd4, 0x1b, 0xa8, 0xaa,
+		0x6a, 0x2a, 0x13, 0x42, 0x20, 0xd1, 0xc2, 0xda, 0x5a, 0xed, 0x05, 0x1c, 0x3b, 0xbc, 0xec, 0x12,
+		0x50, 0xbe, 0x06, 0xe6, 0x03, 0x92, 0x8c, 0x86, 0x29, 0x35, 0x68, 0xb1, 0xd3, 0xf5, 0xca, 0x46,
+		0xc9, 0x6f, 0x48, 0xa7, 0xc1, 0x95, 0x5c, 0x59, 0xc2, 0x92, 0x90, 0x6b, 0x39, 0x84, 0x1a, 0x92,
+		0x7f, 0xda, 0x3d, 0x7c, 0x0a, 0x2b, 0x4f, 0x43, 0x42, 0xc8, 0x27, 0x9a, 0xc7, 0xd4, 0xca, 0x1d,
+		0x32, 0xce, 0x13, 0x40, 0x85, 0x53, 0x96, 0xd0, 0x15, 0x61, 0x42, 0x2b, 0x87, 0x94, 0xab, 0x70,
+		0x64, 0xac, 0x84, 0x91, 0xfb, 0xe1, 0x94, 0x38, 0xf0, 0x62, 0x86, 0xb7, 0x56, 0xd9, 0x28, 0xd5,
+		0xca, 0xe8, 0xaa, 0x78, 0x34, 0x01, 0xb8, 0xa8, 0xb1, 0x5e, 0x0a, 0x31, 0x94, 0x43, 0x4a, 0x09,
+		0x32, 0x41, 0x69, 0x21, 0x27, 0xe0, 0xd8, 0x56, 0xe3, 0xe2, 0x33, 0xda, 0xd5, 0xc2, 0x5a, 0xb5,
+		0x5c, 0x18, 0x72, 0x4a, 0x12, 0x40, 0xc5, 0x87, 0x75, 0x8e, 0x09, 0x8f, 0x1c, 0x52, 0xea, 0x90,
+		0x1d, 0x9a, 0x77, 0x72, 0x0f, 0xe4, 0xb8, 0x7f, 0x30, 0xae, 0x3f, 0x8b, 0x30, 0x2c, 0x09, 0xcc,
+		0x53, 0x2a, 0x57, 0xd6, 0xaa, 0xeb, 0xd5, 0x06, 0xed, 0xd9, 0x65, 0x00, 0x6f, 0x5e, 0x71, 0x23,
+		0xba, 0x52, 0xaf, 0x6d, 0x68, 0x17, 0xd1, 0xcd, 0x6a, 0xf8, 0x48, 0x25, 0x81, 0xcd, 0xa3, 0x2c,
+		0xa1, 0x37, 0x30, 0x3a, 0xd9, 0x72, 0xe8, 0x4c, 0x0c, 0xb7, 0xa1, 0x3f, 0x8e, 0x9f, 0x89, 0x25,
+		0xfe, 0x38, 0x2e, 0x7f, 0x1e, 0xff, 0xff, 0xb6, 0x0d, 0xf9, 0x23, 0x1b, 0x57, 0x62, 0x89, 0x37,
+		0xe3, 0xf2, 0x5b, 0x71, 0xe5, 0x6f, 0x42, 0x40, 0x3c, 0x69, 0x72, 0x3d, 0xe0, 0x17, 0x21, 0xe1,
+		0xba, 0xd4, 0x2c, 0x97, 0xef, 0x3d, 0x07, 0x08, 0xa1, 0x40, 0xf3, 0x55, 0x0d, 0xb9, 0xd8, 0x2e,
+		0x35, 0x52, 0x80, 0x6c, 0xb7, 0x6d, 0xb6, 0xbb, 0x83, 0xae, 0x26, 0xdc, 0xd7, 0xc8, 0x14, 0xf7,
+		0x35, 0xc3, 0x11, 0x78, 0x99, 0x92, 0xd0, 0x6f, 0x06, 0x48, 0x44, 0xa7, 0x92, 0x60, 0x08, 0xbc,
+		0x9c, 0xff, 0x16, 0x09, 0x72, 0x93, 0x3a, 0x7b, 0x47, 0x9e, 0xf5, 0x9d, 0xda, 0xc7, 0xca, 0x4f,
+		0x85, 0x20, 0x13, 0xcc, 0x65, 0x23, 0x65, 0x48, 0x74, 0x2c, 0x9e, 0x27, 0xc2, 0x98, 0x7f, 0x7a,
+		0x4a, 0xfa, 0xdb, 0xca, 0x1a, 0x87, 0x57, 0x5d, 0xcc, 0xfc, 0xbf, 0x91, 0x20, 0x21, 0xaa, 0xc9,
+		0x51, 0x88, 0xf4, 0x74, 0x67, 0x8f, 0x92, 0x8b, 0x16, 0x43, 0xb2, 0xa4, 0xd2, 0x32, 0xd6, 0xdb,
+		0x3d, 0x9d, 0xe5, 0xc8, 0xf0, 0x7a, 0x2c, 0xa3, 0x5d, 0xd1, 0x31, 0xf4, 0x16, 0x3d, 0x3b, 0xb1,
+		0xba, 0x5d, 0xc3, 0x74, 0x6c, 0x61, 0x57, 0xf0, 0xfa, 0x12, 0xaf, 0xc6, 0x94, 0x4a, 0xa7, 0xaf,
+		0xb7, 0x3b, 0x01, 0xd8, 0x08, 0x85, 0x95, 0x45, 0x83, 0x0b, 0xbc, 0x0a, 0xc7, 0x05, 0xdd, 0x96,
+		0xe1, 0xe8, 0xcd, 0x3d, 0xa3, 0xe5, 0x21, 0xc5, 0xe8, 0x19, 0xe9, 0x31, 0x0e, 0x50, 0xe6, 0xed,
+		0x02, 0x57, 0xf9, 0x54, 0x08, 0x16, 0xc4, 0x69, 0x4f, 0xcb, 0x65, 0xd6, 0x3a, 0x80, 0x6e, 0x9a,
+		0x96, 0xe3, 0x67, 0xd7, 0xa8, 0x29, 0x35, 0x82, 0xb7, 0x52, 0x70, 0x91, 0x54, 0x1f, 0x81, 0xfc,
+		0x9f, 0x48, 0x00, 0x5e, 0xd3, 0x44, 0xbe, 0x9d, 0x82, 0x14, 0xcf, 0x54, 0xa4, 0xe9, 0xae, 0x2c,
+		0x9c, 0x02, 0xac, 0x0a, 0xcf, 0x85, 0x30, 0xd2, 0xb2, 0x6d, 0xec, 0xb6, 0x4d, 0x9e, 0x7f, 0xc2,
+		0x0a, 0xe2, 0x18, 0x37, 0xe2, 0xa5, 0x66, 0xa9, 0x90, 0xb0, 0x8d, 0xae, 0x6e, 0x3a, 0xed, 0x26,
+		0x17, 0xe2, 0xf3, 0x87, 0xea, 0xfc, 0x4a, 0x9d, 0x63, 0xab, 0x2e, 0x1d, 0xe5, 0x34, 0x24, 0x44,
+		0xad, 0xab, 0xb4, 0xe6, 0x48, 0x1c, 0xc2, 0xf5, 0x0a, 0xaa, 0x6a, 0xaa, 0x35, 0xaa, 0x85, 0xba,
+		0x1c, 0x3a, 0xf3, 0x79, 0x09, 0xe2, 0x62, 0x55, 0x2d, 0x42, 0xb6, 0x52, 0xae, 0x0e, 0xe9, 0xbc,
+		0x45, 0xc8, 0x88, 0xca, 0x4d, 0xb5, 0xd6, 0xa8, 0x9d, 0x95, 0x3f, 0x17, 0x1f, 0xa9, 0x7c, 0x52,
+		0x7e, 0x33, 0x4e, 0x16, 0x20, 0x2d, 0x2a, 0xcf, 0x3e, 0x7e, 0xf6, 0x49, 0xf9, 0x2d, 0x1a, 0xba,
+		0x10, 0x55, 0x4f, 0x68, 0x0d, 0x54, 0x4b, 0xb5, 0x8d, 0xb5, 0x6b, 0xb2, 0xe4, 0x6f, 0x38, 0xeb,
+		0x6b, 0x08, 0x91, 0x7b, 0xe1, 0x98, 0x68, 0xb8, 0x70, 0xe1, 0xc2, 0x85, 0xa7, 0x7d, 0x8d, 0xb7,
+		0xbf, 0x23, 0x36, 0xdc, 0xfc, 0x8c, 0xaf, 0xf9, 0x87, 0x47, 0x9b, 0x2f, 0xf8, 0x9a, 0x7f, 0xe4,
+		0x3b, 0x62, 0xc5, 0xff, 0x0f, 0x16, 0x9b, 0x56, 0x77, 0x98, 0xbb, 0x45, 0x79, 0xe8, 0x3c, 0xd8,
+		0xbe, 0x2c, 0xbd, 0xf4, 0x28, 0x07, 0xda, 0xb5, 0x3a, 0xba, 0xb9, 0xbb, 0x62, 0xf5, 0x77, 0xbd,
+		0xcc, 0x68, 0xb4, 0xc5, 0x6c, 0x5f, 0x7e, 0x74, 0x6f, 0xfb, 0x7f, 0x48, 0xd2, 0x8f, 0x86, 0xc2,
+		0x97, 0x36, 0x8b, 0x3f, 0x13, 0xca, 0x5f, 0x62, 0x88, 0x9b, 0x62, 0xee, 0x54, 0x63, 0xa7, 0x63,
+		0x34, 0x91, 0xc1, 0xf0, 0xa7, 0x8f, 0xc0, 0xd2, 0xae, 0xb5, 0x6b, 0x51, 0x4a, 0x8f, 0xe1, 0x5f,
+		0xac, 0x13, 0x24, 0xe9, 0xd6, 0xe6, 0xa7, 0xe6, 0x61, 0xaf, 0x6e, 0xc0, 0x22, 0x07, 0xd6, 0xa8,
+		0x69, 0xc8, 0x4e, 0x9e, 0xc8, 0x81, 0x69, 0x0f, 0xb9, 0x9f, 0xff, 0x2c, 0x75, 0xbb, 0xd5, 0x05,
+		0x8e, 0x8a, 0x6d, 0xec, 0x70, 0x6a, 0x55, 0x85, 0x23, 0x01, 0x7a, 0xcc, 0x20, 0x37, 0xfa, 0x53,
+		0x28, 0xfe, 0x26, 0xa7, 0xb8, 0xe8, 0xa3, 0x58, 0xe7, 0xa8, 0xab, 0x25, 0x98, 0x3f, 0x0c, 0xad,
+		0x7f, 0xc5, 0x69, 0xa5, 0x0d, 0x3f, 0x91, 0x4b, 0x90, 0xa5, 0x44, 0x9a, 0x03, 0xdb, 0xb1, 0xba,
+		0xd4, 0xdb, 0x39, 0x98, 0xcc, 0x6f, 0x7d, 0x96, 0x69, 0xa8, 0x0c, 0xa2, 0x95, 0x5c, 0xac, 0xd5,
+		0x55, 0xa0, 0x86, 0x2e, 0xa6, 0xf1, 0x4d, 0xa1, 0xf0, 0xdb, 0xbc, 0x23, 0x2e, 0xfc, 0xea, 0x55,
+		0x58, 0xc2, 0xbf, 0xa9, 0x33, 0xe2, 0xef, 0xc9, 0xf4, 0x1c, 0x89, 0xdc, 0xef, 0x7e, 0x88, 0x29,
+		0xc1, 0x45, 0x97, 0x80, 0xaf, 0x4f, 0xbe, 0x59, 0xdc, 0x35, 0x1c, 0xc7, 0xe8, 0xdb, 0x9a, 0xde,
+		0x19, 0xd7, 0x3d, 0xdf, 0x21, 0x73, 0xee, 0xfb, 0xbf, 0x10, 0x9c, 0xc5, 0x4b, 0x0c, 0xb3, 0xd0,
+		0xe9, 0xac, 0x6e, 0xc1, 0xb1, 0x31, 0x52, 0x31, 0x03, 0xcd, 0x1f, 0xe0, 0x34, 0x97, 0x46, 0x24,
+		0x03, 0xc9, 0x6e, 0x82, 0xa8, 0x77, 0xe7, 0x72, 0x06, 0x9a, 0x3f, 0xc8, 0x69, 0x12, 0x8e, 0x2b,
+		0xa6, 0x14, 0x29, 0x5e, 0x81, 0x85, 0x1b, 0x46, 0x7f, 0xdb, 0xb2, 0xf9, 0xc1, 0xfe, 0x0c, 0xe4,
+		0x7e, 0x88, 0x93, 0xcb, 0x72, 0x44, 0x7a, 0xd2, 0x8f, 0xb4, 0x2e, 0x40, 0x62, 0x47, 0x6f, 0x1a,
+		0x33, 0x90, 0xb8, 0xcd, 0x49, 0xc4, 0x11, 0x1e, 0x51, 0x0b, 0x90, 0xde, 0xb5, 0xb8, 0x3f, 0x3a,
+		0x1d, 0xfd, 0x87, 0x39, 0x7a, 0x4a, 0xe0, 0x70, 0x12, 0x3d, 0xab, 0x37, 0xe8, 0xa0, 0xb3, 0x3a,
+		0x9d, 0xc4, 0x8f, 0x08, 0x12, 0x02, 0x87, 0x93, 0x38, 0x04, 0x5b, 0x3f, 0x2a, 0x48, 0xd8, 0x3e,
+		0x7e, 0x3e, 0x87, 0xf9, 0x7e, 0x9d, 0x7d, 0xcb, 0x9c, 0xa5, 0x13, 0x1f, 0xe3, 0x14, 0x80, 0xa3,
+		0x20, 0x81, 0x67, 0x21, 0x39, 0xeb, 0x44, 0xfc, 0xc4, 0x17, 0xc4, 0xf2, 0x10, 0x33, 0x70, 0x09,
+		0xb2, 0x42, 0x41, 0xe1, 0x29, 0xcc, 0x74, 0x12, 0x3f, 0xc9, 0x49, 0x64, 0x7c, 0x68, 0x7c, 0x18,
+		0x8e, 0x61, 0x3b, 0xbb, 0xc6, 0x2c, 0x44, 0x7e, 0x4a, 0x0c, 0x83, 0xa3, 0x70, 0x56, 0x6e, 0x1b,
+		0x66, 0x73, 0x6f, 0x36, 0x0a, 0x1f, 0x17, 0xac, 0x14, 0x38, 0x48, 0xa2, 0x04, 0xf3, 0x5d, 0xbd,
+		0x6f, 0xef, 0xe9, 0x9d, 0x99, 0xa6, 0xe3, 0xa7, 0x39, 0x8d, 0xb4, 0x8b, 0xc4, 0x39, 0x32, 0x30,
+		0x0f, 0x43, 0xe6, 0x67, 0x04, 0x47, 0x06, 0x66, 0x80, 0xd0, 0x26, 0x2c, 0xd9, 0x0e, 0xcd, 0x82,
+		0x38, 0x0c, 0xb5, 0xbf, 0x27, 0x96, 0x1e, 0xc3, 0x5d, 0xf7, 0x53, 0x7c, 0x16, 0x92, 0x76, 0xfb,
+		0xd5, 0x99, 0xc8, 0x7c, 0x42, 0xcc, 0x34, 0x45, 0x40, 0xe4, 0x6b, 0x70, 0x7c, 0xec, 0x36, 0x31,
+		0x03, 0xb1, 0x9f, 0xe5, 0xc4, 0x8e, 0x8e, 0xd9, 0x2a, 0xb8, 0x4a, 0x38, 0x2c, 0xc9, 0xbf, 0x2f,
+		0x54, 0x82, 0x31, 0x44, 0x6b, 0x13, 0x23, 0x84, 0xb6, 0xbe, 0x73, 0x38, 0xae, 0xfd, 0x03, 0xc1,
+		0x35, 0x86, 0x1b, 0xe0, 0x5a, 0x03, 0x8e, 0x72, 0x8a, 0x87, 0x9b, 0xd7, 0x9f, 0x13, 0x8a, 0x95,
+		0x61, 0x6f, 0x05, 0x67, 0xf7, 0xab, 0x21, 0xef, 0xb2, 0x53, 0x84, 0xa2, 0x6c, 0x0d, 0x33, 0x00,
+		0xa6, 0x53, 0xfe, 0x79, 0x4e, 0x59, 0x68, 0x7c, 0x37, 0x96, 0x65, 0xaf, 0xeb, 0x3d, 0x24, 0xfe,
+		0x22, 0xe4, 0x04, 0xf1, 0x81, 0xd9, 0x37, 0x9a, 0xd6, 0xae, 0xd9, 0x7e, 0xd5, 0x68, 0xcd, 0x40,
+		0xfa, 0x17, 0x86, 0xa6, 0x6a, 0xcb, 0x87, 0x8e, 0x94, 0xab, 0x20, 0xbb, 0xb6, 0x8a, 0xd6, 0xee,
+		0xf6, 0xac, 0xbe, 0x33, 0x85, 0xe2, 0x2f, 0x8a, 0x99, 0x72, 0xf1, 0xaa, 0x14, 0x6d, 0xb5, 0x02,
+		0x2c, 0x35, 0x78, 0x56, 0x91, 0xfc, 0x25, 0x4e, 0x68, 0xde, 0xc3, 0xe2, 0x8a, 0xa3, 0x69, 0x75,
+		0x7b, 0x7a, 0x7f, 0x16, 0xfd, 0xf7, 0x0f, 0x85, 0xe2, 0xe0, 0x28, 0x5c, 0x71, 0xa0, 0x45, 0x87,
+		0xbb, 0xfd, 0x0c, 0x14, 0x7e, 0x59, 0x28, 0x0e, 0x81, 0xc3, 0x49, 0x08, 0x83, 0x61, 0x06, 0x12,
+		0xff, 0x48, 0x90, 0x10, 0x38, 0x48, 0xe2, 0xfd, 0xde, 0x46, 0xdb, 0x37, 0x76, 0xdb, 0xb6, 0xc3,
+		0x93, 0xf7, 0x0f, 0x26, 0xf5, 0x2b, 0x5f, 0x08, 0x1a, 0x61, 0xaa, 0x0f, 0x15, 0x35, 0x11, 0x8f,
+		0x2e, 0xd1, 0xf8, 0xe8, 0xf4, 0x8e, 0xfd, 0xaa, 0xd0, 0x44, 0x3e, 0x34, 0xec, 0x9b, 0xcf, 0x42,
+		0x44, 0xb6, 0x37, 0xd1, 0x2b, 0x9b, 0x81, 0xdc, 0x3f, 0x1e, 0xea, 0x5c, 0x5d, 0xe0, 0x22, 0x4d,
+		0x9f, 0xfd, 0x33, 0x30, 0xaf, 0x1b, 0xfb, 0x33, 0x49, 0xe7, 0x3f, 0x19, 0xb2, 0x7f, 0xb6, 0x18,
+		0x26, 0xd3, 0x21, 0xd9, 0x21, 0x7b, 0x8a, 0x4c, 0xbb, 0xd4, 0x93, 0xfb, 0x86, 0xb7, 0xf9, 0x78,
+		0x83, 0xe6, 0xd4, 0xea, 0x1a, 0xc8, 0xbc, 0xc6, 0x33, 0x60, 0xa7, 0x12, 0xfb, 0xd0, 0xdb, 0xae,
+		0x9c, 0x07, 0x6c, 0x9e, 0xd5, 0x8b, 0x30, 0x1f, 0x30, 0x78, 0xa6, 0x93, 0xfa, 0xff, 0x39, 0xa9,
+		0xb4, 0xdf, 0xde, 0x59, 0x3d, 0x07, 0x11, 0x34, 0x5e, 0xa6, 0xa3, 0x7f, 0x13, 0x47, 0xa7, 0xe0,
+		0xab, 0xef, 0x85, 0x84, 0x30, 0x5a, 0xa6, 0xa3, 0x7e, 0x33, 0x47, 0x75, 0x51, 0x10, 0x5d, 0x18,
+		0x2c, 0xd3, 0xd1, 0xbf, 0x45, 0xa0, 0x0b, 0x14, 0x44, 0x9f, 0x9d, 0x85, 0xbf, 0xfe, 0x6d, 0x11,
+		0x86, 0x2e, 0x50, 0x56, 0x31, 0x35, 0x99, 0x59, 0x2a, 0xd3, 0xb1, 0xbf, 0x95, 0x7f, 0x5c, 0x60,
+		0xac, 0x3e, 0x0d, 0xd1, 0x19, 0x19, 0xfe, 0x1d, 0x1c, 0x95, 0xc1, 0xaf, 0x96, 0x20, 0xe5, 0xb3,
+		0x4e, 0xa6, 0xa3, 0x7f, 0x27, 0x47, 0xf7, 0x63, 0x61, 0xd7, 0xb9, 0x75, 0x32, 0x9d, 0xc0, 0xdf,
+		0x11, 0x5d, 0xe7, 0x18, 0xc8, 0x36, 0x61, 0x98, 0x4c, 0xc7, 0xfe, 0x88, 0xe0, 0xba, 0x40, 0x59,
+		0x7d, 0x0e, 0x92, 0xee, 0x66, 0x33, 0x1d, 0xff, 0xef, 0x72, 0x7c, 0x0f, 0x07, 0x39, 0x30, 0x30,
+		0x0f, 0x41, 0xe2, 0xbb, 0x04, 0x07, 0x7c, 0x58, 0xb8, 0x8c, 0x86, 0x0d, 0x98, 0xe9, 0x94, 0xbe,
+		0x5b, 0x2c, 0xa3, 0x21, 0xfb, 0x05, 0x67, 0x93, 0xea, 0xfc, 0xe9, 0x24, 0xbe, 0x47, 0xcc, 0x26,
+		0x85, 0xc7, 0x6e, 0x0c, 0x5b, 0x04, 0xd3, 0x69, 0x7c, 0x9f, 0xe8, 0xc6, 0x90, 0x41, 0xb0, 0xba,
+		0x09, 0x64, 0xd4, 0x1a, 0x98, 0x4e, 0xef, 0x75, 0x4e, 0x6f, 0x61, 0xc4, 0x18, 0x58, 0x7d, 0x01,
+		0x8e, 0x8e, 0xb7, 0x04, 0xa6, 0x53, 0xfd, 0xfe, 0xb7, 0x87, 0x7c, 0x37, 0xbf, 0x21, 0xb0, 0xda,
+		0x80, 0xa5, 0x71, 0x56, 0xc0, 0x74, 0xb2, 0x3f, 0xf0, 0x76, 0x50, 0x71, 0xfb, 0x8d, 0x80, 0xd5,
+		0x02, 0x80, 0xb7, 0x01, 0x4f, 0xa7, 0xf5, 0x43, 0x9c, 0x96, 0x0f, 0x09, 0x97, 0x06, 0xdf, 0x7f,
+		0xa7, 0xe3, 0xdf, 0x16, 0x4b, 0x83, 0x63, 0xe0, 0xd2, 0x10, 0x5b, 0xef, 0x74, 0xec, 0x1f, 0x16,
+		0x4b, 0x43, 0xa0, 0xa0, 0x64, 0xfb, 0x76, 0xb7, 0xe9, 0x14, 0x3e, 0x26, 0x24, 0xdb, 0x87, 0xb5,
+		0xba, 0x01, 0x0b, 0x23, 0x1b, 0xe2, 0x74, 0x52, 0x3f, 0xca, 0x49, 0xc9, 0xc3, 0xfb, 0xa1, 0x7f,
+		0xf3, 0xe2, 0x9b, 0xe1, 0x74, 0x6a, 0x3f, 0x36, 0xb4, 0x79, 0xf1, 0xbd, 0x70, 0xf5, 0x59, 0x48,
+		0x98, 0x83, 0x4e, 0x07, 0x17, 0x0f, 0x39, 0xf8, 0xf2, 0x56, 0xee, 0x8f, 0xbf, 0xc8, 0xb9, 0x23,
+		0x10, 0x56, 0xcf, 0x41, 0xd4, 0xe8, 0x6e, 0x1b, 0xad, 0x69, 0x98, 0x9f, 0xff, 0xa2, 0x50, 0x98,
+		0x08, 0xbd, 0xfa, 0x1c, 0x00, 0x0b, 0x8d, 0xd0, 0x1c, 0xc7, 0x29, 0xb8, 0x7f, 0xf2, 0x45, 0x7e,
+		0x5b, 0xc2, 0x43, 0xf1, 0x08, 0xb0, 0xbb, 0x17, 0x07, 0x13, 0xf8, 0x42, 0x90, 0x00, 0x9d, 0x91,
+		0x0b, 0x10, 0xc7, 0x63, 0x2c, 0x47, 0xdf, 0x9d, 0x86, 0xfd, 0xa7, 0x1c, 0x5b, 0xc0, 0x23, 0xc3,
+		0xba, 0x56, 0xdf, 0x70, 0xf4, 0x5d, 0x7b, 0x1a, 0xee, 0x7f, 0xe5, 0xb8, 0x2e, 0x02, 0x22, 0x37,
+		0x75, 0xdb, 0x99, 0x65, 0xdc, 0x7f, 0x26, 0x90, 0x05, 0x02, 0x76, 0x1a, 0xff, 0xbe, 0x6e, 0xec,
+		0x4f, 0xc3, 0xfd, 0x73, 0xd1, 0x69, 0x0e, 0xbf, 0xfa, 0x5e, 0x48, 0xe2, 0x9f, 0xec, 0x0a, 0xd4,
+		0x14, 0xe4, 0xbf, 0xe0, 0xc8, 0x1e, 0x06, 0x7e, 0xd9, 0x76, 0x5a, 0x4e, 0x7b, 0x3a, 0xb3, 0xff,
+		0x92, 0xcf, 0xb4, 0x80, 0x5f, 0x2d, 0x40, 0xca, 0x76, 0x5a, 0xad, 0x01, 0xb7, 0x4f, 0xa7, 0xa0,
+		0xff, 0xb7, 0x2f, 0xba, 0x21, 0x0b, 0x17, 0x07, 0x67, 0xfb, 0x95, 0xeb, 0x4e, 0xcf, 0xa2, 0xc9,
+		0x0d, 0xd3, 0x28, 0xbc, 0xcd, 0x29, 0xf8, 0x50, 0x56, 0x4b, 0x90, 0xc6, 0xb1, 0x88, 0x53, 0xe3,
+		0x69, 0x24, 0xfe, 0x8a, 0x33, 0x20, 0x80, 0x54, 0xfc, 0xba, 0xdf, 0xfe, 0xf4, 0x49, 0xe9, 0x53,
+		0x9f, 0x3e, 0x29, 0xfd, 0xe7, 0x4f, 0x9f, 0x94, 0x3e, 0xf2, 0x99, 0x93, 0x73, 0x9f, 0xfa, 0xcc,
+		0xc9, 0xb9, 0x3f, 0xf8, 0xcc, 0xc9, 0xb9, 0xf1, 0x51, 0x62, 0xb8, 0x64, 0x5d, 0xb2, 0x58, 0x7c,
+		0xf8, 0xa5, 0x07, 0x76, 0xdb, 0xce, 0xde, 0x60, 0x7b, 0xa5, 0x69, 0x75, 0x1f, 0x6b, 0x5a, 0x76,
+		0xd7, 0xb2, 0x1f, 0x0b, 0xc6, 0x75, 0xe9, 0x5f, 0xf0, 0xd7, 0x12, 0x1c, 0x67, 0x64, 0xbc, 0x70,
+		0xae, 0x6e, 0xee, 0x4f, 0x7a, 0x4f, 0xe3, 0x3c, 0x84, 0x0b, 0xe6, 0x3e, 0x39, 0xce, 0x14, 0x9c,
+		0x36, 0xe8, 0x77, 0xf8, 0x3d, 0x9c, 0x38, 0x96, 0xb7, 0xfa, 0x9d, 0x60, 0xf6, 0x66, 0x9a, 0x67,
+		0x6f, 0x16, 0xbf, 0x53, 0x3a, 0xdc, 0x48, 0x12, 0x05, 0x73, 0x9f, 0x0e, 0x64, 0x53, 0x7a, 0xe9,
+		0xdd, 0x53, 0xe3, 0xdc, 0xd7, 0x4d, 0xeb, 0x15, 0x13, 0xbb, 0xdd, 0xdb, 0x16, 0x31, 0xee, 0x93,
+		0xc3, 0x31, 0xee, 0x17, 0x8c, 0x4e, 0xe7, 0x79, 0x84, 0xc3, 0xa3, 0x5d, 0x7b, 0x3b, 0xc6, 0xae,
+		0x7c, 0xc2, 0x77, 0x87, 0xe0, 0xe4, 0x48, 0x38, 0x9b, 0x0b, 0xc1, 0x24, 0x26, 0xac, 0x42, 0xa2,
+		0x2c, 0x64, 0x2b, 0x87, 0xaf, 0x59, 0x34, 0x2d, 0xb3, 0xc5, 0xf2, 0x13, 0xc3, 0xaa, 0x28, 0x22,
+		0x23, 0x4c, 0xdd, 0xb4, 0x6c, 0x7e, 0x93, 0x8d, 0x15, 0x8a, 0x3f, 0x78, 0x48, 0x46, 0xcc, 0x8b,
+		0x2f, 0x09, 0x6e, 0x3c, 0x31, 0x23, 0x37, 0xc4, 0x20, 0x02, 0x91, 0xff, 0x59, 0xb9, 0xf2, 0x7d,
+		0x21, 0x38, 0x35, 0xcc, 0x15, 0x5c, 0x59, 0xb6, 0xa3, 0x77, 0x7b, 0x93, 0xd8, 0xf2, 0x2c, 0x24,
+		0x1b, 0x02, 0xe6, 0xd0, 0x7c, 0xb9, 0x7d, 0x48, 0xbe, 0x64, 0xdc, 0x4f, 0x09, 0xc6, 0x9c, 0x9d,
+		0x91, 0x31, 0xee, 0x38, 0xee, 0x88, 0x33, 0xff, 0x33, 0x06, 0xc7, 0xd9, 0x72, 0xd2, 0xd8, 0x52,
+		0x62, 0x05, 0xce, 0x93, 0xb4, 0xbf, 0x69, 0xfa, 0x39, 0x89, 0xf2, 0x3c, 0x2c, 0x56, 0x51, 0x5b,
+		0xa0, 0x17, 0xe4, 0x9d, 0xf0, 0x8c, 0xbd, 0xec, 0xb7, 0x1c, 0x30, 0xf8, 0xf9, 0x61, 0x9e, 0xbf,
+		0x4a, 0xf9, 0x06, 0x09, 0xe4, 0x7a, 0x53, 0xef, 0xe8, 0xfd, 0x2f, 0x95, 0x14, 0x79, 0x1a, 0x80,
+		0x25, 0xd8, 0xb8, 0x2f, 0x74, 0xe0, 0xf9, 0xb2, 0x7f, 0x70, 0x2b, 0xec, 0x4b, 0x34, 0xd7, 0x3d,
+		0x49, 0x61, 0xf1, 0xcf, 0x33, 0x2f, 0x02, 0x78, 0x0d, 0x98, 0xa9, 0x50, 0x2f, 0x15, 0xd6, 0x0a,
+		0xaa, 0x48, 0xa1, 0xa8, 0x6f, 0x56, 0x4a, 0xec, 0xd6, 0xfc, 0x1c, 0x26, 0x03, 0xf8, 0x1b, 0xdd,
+		0x7c, 0xec, 0x23, 0xb0, 0xe0, 0xaf, 0x67, 0x57, 0x98, 0x43, 0x68, 0x29, 0xb6, 0xbb, 0xbd, 0x8e,
+		0x41, 0x8f, 0x59, 0xb5, 0xb6, 0xe0, 0xda, 0x74, 0x23, 0xe4, 0x77, 0xfe, 0x3d, 0xbb, 0xd6, 0xba,
+		0xe8, 0xa1, 0xbb, 0x3c, 0x5f, 0x5d, 0x83, 0x05, 0xbc, 0x2f, 0xd3, 0x0b, 0x90, 0x9c, 0xa2, 0xaa,
+		0x91, 0x20, 0x3d, 0x38, 0xe6, 0x98, 0x1e, 0xb5, 0xa7, 0x21, 0x66, 0xd3, 0xd1, 0x4f, 0x23, 0xf1,
+		0x49, 0x4e, 0x82, 0x83, 0xaf, 0x9a, 0xb0, 0xc0, 0x1e, 0x70, 0x30, 0x7c, 0xdd, 0x38, 0x38, 0xce,
+		0xf0, 0x4f, 0x7f, 0xf1, 0x71, 0x7a, 0x8c, 0x7c, 0x5f, 0x70, 0x5a, 0xc6, 0x88, 0x93, 0x2a, 0x73,
+		0xda, 0x5e, 0x47, 0x0d, 0xc8, 0x88, 0xef, 0xf1, 0x0e, 0x1f, 0xfc, 0xb1, 0x5f, 0xe3, 0x1f, 0x3b,
+		0x39, 0x4e, 0x06, 0x7c, 0x5f, 0x9a, 0xe7, 0x54, 0x59, 0x43, 0xb1, 0x32, 0x69, 0x4d, 0xbf, 0xf4,
+		0xc8, 0xe8, 0xee, 0xc4, 0xfe, 0x7b, 0x94, 0x52, 0x7e, 0xd6, 0xff, 0x19, 0x77, 0xed, 0xfd, 0x61,
+		0x18, 0x16, 0xf0, 0x6e, 0xa8, 0xf5, 0x18, 0xfd, 0x97, 0xaf, 0xb9, 0x28, 0x2d, 0xcc, 0x70, 0x28,
+		0x79, 0x9e, 0x2d, 0x85, 0xe9, 0x12, 0xf3, 0x17, 0xdf, 0xfe, 0x93, 0x51, 0x6f, 0xb9, 0xac, 0xae,
+		0x8f, 0x26, 0x5a, 0x4d, 0xa7, 0xf1, 0x97, 0x82, 0xc6, 0x70, 0xf6, 0xd4, 0xea, 0x7b, 0xf0, 0xa4,
+		0x8f, 0x93, 0x99, 0x66, 0x99, 0x08, 0x22, 0x2e, 0x06, 0xda, 0x25, 0x6c, 0x65, 0xce, 0x62, 0x85,
+		0xbe, 0x2d, 0xf0, 0xd9, 0x0a, 0xc5, 0x74, 0xc4, 0xd5, 0x4b, 0x90, 0x69, 0x59, 0xa6, 0xa3, 0x59,
+		0xdd, 0xb6, 0x63, 0x74, 0x7b, 0xce, 0x54, 0xbb, 0xee, 0xaf, 0x18, 0x91, 0x84, 0x3a, 0x8f, 0x78,
+		0x35, 0x81, 0x76, 0x47, 0x93, 0x6b, 0xb7, 0xae, 0x73, 0x5d, 0xec, 0xdc, 0x64, 0x93, 0xe8, 0x4e,
+		0xee, 0x9f, 0x85, 0xe1, 0x24, 0x07, 0xde, 0xd6, 0x6d, 0xe3, 0xb1, 0x1b, 0x4f, 0x6c, 0x1b, 0x8e,
+		0xfe, 0xc4, 0x63, 0x4d, 0xab, 0x2d, 0x36, 0xe2, 0x45, 0xd6, 0xbe, 0x82, 0xed, 0x2b, 0xbc, 0x3d,
+		0x3f, 0xf6, 0xb4, 0x3a, 0x3f, 0x59, 0x47, 0xe7, 0x47, 0x45, 0x48, 0x79, 0x09, 0x22, 0x25, 0xab,
+		0x4d, 0x6f, 0x9e, 0xb4, 0x0c, 0xd3, 0xea, 0x72, 0x6d, 0xc9, 0x0a, 0xe4, 0x3c, 0xc4, 0xf4, 0xae,
+		0x35, 0x30, 0x1d, 0xa6, 0x29, 0x8b, 0x27, 0x7f, 0xfb, 0x8d, 0x53, 0x73, 0x7f, 0xf8, 0xc6, 0xa9,
+		0x70, 0xd5, 0x74, 0x7e, 0xef, 0x97, 0x1e, 0x05, 0x4e, 0xbd, 0x6a, 0x3a, 0x1f, 0x7f, 0xf3, 0xe7,
+		0xce, 0x48, 0x2a, 0x87, 0x5e, 0x8d, 0xbc, 0xf5, 0xd1, 0x53, 0x92, 0xf2, 0x22, 0xc4, 0xcb, 0x46,
+		0xf3, 0x00, 0xf2, 0x4f, 0x0c, 0x91, 0x3f, 0x2e, 0xc8, 0x97, 0x8d, 0xa6, 0x8f, 0x7c, 0xd9, 0x68,
+		0x0e, 0x51, 0x7e, 0x1a, 0x12, 0x55, 0xd3, 0x61, 0xd7, 0xc3, 0x1f, 0x81, 0x70, 0xdb, 0x64, 0x17,
+		0x07, 0x7d, 0x14, 0x46, 0x3a, 0xa8, 0x22, 0x14, 0x22, 0x96, 0x8d, 0xa6, 0x8b, 0xd8, 0x32, 0x9a,
+		0x39, 0x69, 0xda, 0xa7, 0x11, 0xaa, 0x58, 0xfe, 0x83, 0xff, 0x72, 0x72, 0xee, 0xb5, 0x4f, 0x9f,
+		0x9c, 0x9b, 0x38, 0xf5, 0xca, 0xf4, 0xa9, 0x77, 0x67, 0xfc, 0x27, 0x23, 0x70, 0x2f, 0x7d, 0x35,
+		0xa4, 0xdf, 0x6d, 0x9b, 0xce, 0x63, 0xcd, 0xfe, 0x7e, 0xcf, 0xb1, 0x70, 0xfd, 0x5a, 0x3b, 0x7c,
+		0xc2, 0x17, 0xbc, 0xe6, 0x15, 0xd6, 0x3c, 0x7e, 0xba, 0x95, 0x1d, 0x88, 0x6e, 0x22, 0x1e, 0xb2,
+		0xd8, 0xb1, 0x1c, 0xbd, 0xc3, 0x8d, 0x0e, 0x56, 0xc0, 0x5a, 0xf6, 0xd2, 0x48, 0x88, 0xd5, 0xb6,
+		0xc5, 0x23, 0x23, 0x1d, 0x43, 0xdf, 0x61, 0x17, 0xb6, 0xc3, 0xd4, 0x5a, 0x4d, 0x60, 0x05, 0xbd,
+		0x9b, 0xbd, 0x04, 0x51, 0x7d, 0xc0, 0x92, 0x84, 0xc2, 0x68, 0xc6, 0xd2, 0x82, 0xf2, 0x3c, 0xc4,
+		0xf9, 0xf1, 0x39, 0x66, 0xc9, 0x5c, 0x37, 0xf6, 0xe9, 0x77, 0xd2, 0x2a, 0xfe, 0x49, 0x56, 0x20,
+		0x4a, 0x3b, 0xcf, 0x53, 0xab, 0x72, 0x2b, 0x23, 0xbd, 0x5f, 0xa1, 0x9d, 0x54, 0x19, 0x98, 0x72,
+		0x05, 0x12, 0x65, 0x0b, 0xa5, 0x30, 0x48, 0x2d, 0xc9, 0xa8, 0xd1, 0x3e, 0xf7, 0x06, 0x8e, 0xb8,
+		0x05, 0x45, 0x0b, 0x78, 0xf9, 0x8f, 0x5d, 0xe0, 0xe7, 0x89, 0x4e, 0xbc, 0xa4, 0x94, 0x20, 0x4e,
+		0x69, 0xd7, 0x7a, 0xee, 0xab, 0x38, 0x92, 0xef, 0x55, 0x1c, 0x4e, 0x3e, 0xe4, 0x75, 0x96, 0x40,
+		0x04, 0x2f, 0xe9, 0xf2, 0x71, 0xd3, 0xbf, 0x95, 0xf7, 0x41, 0x82, 0x13, 0xb1, 0xc9, 0x59, 0x08,
+		0x5b, 0x3d, 0x91, 0x56, 0x97, 0x9f, 0x34, 0x94, 0x5a, 0xaf, 0x18, 0x41, 0x99, 0x51, 0x11, 0xb8,
+		0xb8, 0x31, 0x51, 0x2c, 0x9e, 0x0a, 0x88, 0x45, 0xd7, 0x70, 0xb6, 0x77, 0x1c, 0xef, 0x0f, 0x36,
+		0x9d, 0x23, 0xa2, 0xe0, 0x0a, 0xca, 0xed, 0x10, 0x9c, 0xf4, 0xb5, 0xde, 0x30, 0xfa, 0x18, 0x3f,
+		0x62, 0xd2, 0xc4, 0x25, 0x85, 0xf8, 0x3a, 0xc8, 0xdb, 0x27, 0x88, 0xca, 0x7b, 0x21, 0x5c, 0xe8,
+		0xf5, 0xf0, 0x0d, 0x1b, 0x5a, 0x6e, 0x5a, 0x4c, 0x56, 0x22, 0xaa, 0x5b, 0xc6, 0x36, 0xdb, 0xda,
+		0x71, 0x5e, 0xd1, 0xfb, 0xee, 0xfb, 0x36, 0xa2, 0xac, 0x5c, 0x80, 0x64, 0xc9, 0x32, 0x6d, 0xc3,
+		0xb4, 0x07, 0xd4, 0x94, 0xdd, 0xee, 0x58, 0xcd, 0xeb, 0x9c, 0x02, 0x2b, 0x20, 0xb3, 0xf5, 0x5e,
+		0x8f, 0x62, 0x46, 0x54, 0xfc, 0x93, 0xad, 0xd7, 0x62, 0x6d, 0x22, 0x7b, 0xce, 0x1d, 0x8e, 0x3d,
+		0x7c, 0x80, 0x9e, 0x4d, 0x2a, 0xc1, 0x3d, 0xa3, 0x0b, 0xe9, 0xba, 0xb1, 0x6f, 0x1f, 0x76, 0x1d,
+		0xbd, 0x08, 0xc9, 0x4d, 0xfa, 0xc0, 0xe0, 0xf3, 0xc6, 0x3e, 0xc9, 0x63, 0x86, 0xe1, 0xd9, 0x73,
+		0xe7, 0x9e, 0xb8, 0xc0, 0xa4, 0xfc, 0xf2, 0x9c, 0x2a, 0x2a, 0xc8, 0x49, 0x48, 0xda, 0x46, 0xb3,
+		0x77, 0xf6, 0xdc, 0xf9, 0xeb, 0x4f, 0x30, 0xb1, 0xba, 0x3c, 0xa7, 0x7a, 0x55, 0xab, 0x09, 0x1c,
+		0xf1, 0x5b, 0x1f, 0x3b, 0x25, 0x15, 0xa3, 0x10, 0xb6, 0x07, 0xdd, 0x2f, 0x9b, 0x6c, 0x7c, 0x6f,
+		0x14, 0x96, 0x7d, 0xad, 0x6c, 0x73, 0xe1, 0xc9, 0xd8, 0xee, 0xb3, 0x90, 0xb2, 0x6f, 0xfc, 0x14,
+		0x62, 0xc2, 0xae, 0x71, 0x20, 0x17, 0x95, 0x5f, 0x90, 0x20, 0x7d, 0x55, 0x50, 0xc6, 0x34, 0xfc,
+		0x67, 0x01, 0xdc, 0x2f, 0x89, 0xa5, 0x72, 0x62, 0x65, 0xf8, 0x5b, 0x2b, 0x2e, 0x8e, 0xea, 0x03,
+		0xc7, 0x5c, 0xcc, 0x5e, 0xdf, 0xea, 0x59, 0x36, 0x7f, 0xeb, 0x64, 0x0a, 0xaa, 0x0b, 0x8c, 0x97,
+		0x1e, 0xa8, 0x56, 0xd3, 0x6e, 0x58, 0x0e, 0x66, 0x86, 0xf4, 0xac, 0x57, 0xf8, 0x0b, 0x52, 0x61,
+		0x55, 0xa6, 0x2d, 0x57, 0x69, 0xc3, 0x26, 0xd6, 0x63, 0xa7, 0x93, 0x2e, 0x15, 0xf4, 0xca, 0xf4,
+		0x56, 0xab, 0x6f, 0xd8, 0x36, 0x57, 0x5c, 0xa2, 0x88, 0x0f, 0xac, 0xf4, 0x06, 0xdb, 0x9a, 0xd0,
+		0x12, 0xf8, 0x44, 0xcd, 0x98, 0x35, 0x2f, 0x64, 0x83, 0xaf, 0xfa, 0x58, 0x6f, 0xb0, 0x8d, 0x92,
+		0x72, 0x1f, 0xa4, 0xc7, 0x74, 0x26, 0x75, 0xc3, 0xeb, 0x07, 0x7d, 0xd3, 0x92, 0x8f, 0x40, 0xeb,
+		0xf5, 0xdb, 0x56, 0xbf, 0xed, 0xec, 0xd3, 0x14, 0xc3, 0xb0, 0x2a, 0x8b, 0x86, 0x4d, 0x5e, 0xaf,
+		0x5c, 0x87, 0x6c, 0x9d, 0x5a, 0xeb, 0x5e, 0xcf, 0xcf, 0x79, 0xfd, 0x93, 0xa6, 0xf7, 0x6f, 0x62,
+		0xcf, 0x42, 0x23, 0x3d, 0x2b, 0xae, 0x4f, 0x94, 0xcc, 0x27, 0x0f, 0x27, 0x99, 0xc1, 0xdd, 0xed,
+		0x4f, 0x8e, 0xc3, 0x3d, 0xc3, 0x8d, 0x01, 0x95, 0x35, 0xab, 0x50, 0x4e, 0x73, 0xc4, 0xf3, 0x07,
+		0x6f, 0xa2, 0xf9, 0x29, 0xaa, 0x33, 0x3f, 0x75, 0xf9, 0x28, 0x17, 0x60, 0x1e, 0x2f, 0xaa, 0xd4,
+		0x0d, 0xe7, 0xb2, 0xa1, 0xb7, 0x8c, 0x7e, 0x70, 0x97, 0x9d, 0x17, 0xbb, 0x2c, 0x81, 0x08, 0xdd,
+		0x4a, 0xd9, 0x2e, 0x43, 0xff, 0x56, 0xf6, 0x20, 0x82, 0xa8, 0xde, 0x0e, 0xcc, 0x31, 0x68, 0x01,
+		0x6b, 0xb7, 0xf7, 0x1d, 0x9e, 0x8c, 0x9c, 0x56, 0x59, 0x81, 0x3c, 0x25, 0xf6, 0xd1, 0xf0, 0xc1,
+		0xfb, 0x28, 0x17, 0x42, 0xbe, 0x9b, 0x76, 0x20, 0x5e, 0x44, 0xf5, 0x5b, 0x2d, 0xbb, 0x1d, 0x91,
+		0xbc, 0x8e, 0x90, 0x75, 0xc8, 0xf6, 0xf4, 0xbe, 0x43, 0x9f, 0x5a, 0xd8, 0xa3, 0xa3, 0xe0, 0x72,
+		0x7e, 0x6a, 0x74, 0xd5, 0x05, 0x06, 0xcb, 0xbf, 0x32, 0xdf, 0xf3, 0x57, 0x2a, 0x9f, 0x8b, 0x40,
+		0x8c, 0x33, 0xe3, 0xbd, 0x10, 0xe7, 0x6c, 0xe5, 0x92, 0x79, 0xef, 0xca, 0xe8, 0x66, 0xb4, 0xe2,
+		0x6e, 0x1a, 0x9c, 0x9e, 0xc0, 0x21, 0x0f, 0x42, 0xa2, 0xb9, 0xa7, 0xb7, 0x4d, 0xad, 0xdd, 0xe2,
+		0x06, 0x60, 0xea, 0xd3, 0x6f, 0x9c, 0x8a, 0x97, 0xb0, 0xae, 0x5a, 0x56, 0xe3, 0xb4, 0xb1, 0xda,
+		0xc2, 0x9d, 0x7f, 0xcf, 0x68, 0xef, 0xee, 0x39, 0x7c, 0x75, 0xf1, 0x12, 0x3e, 0x66, 0x8b, 0x02,
+		0xc1, 0x5f, 0xf0, 0xc9, 0x8f, 0xd8, 0xf2, 0x6e, 0x9c, 0xa4, 0x98, 0xc0, 0x0f, 0x7f, 0xe4, 0x3f,
+		0x9d, 0x92, 0x54, 0x8a, 0x41, 0x4a, 0x30, 0xdf, 0xd1, 0x6d, 0x47, 0xa3, 0xbb, 0x16, 0x7e, 0x3e,
+		0x4a, 0x49, 0x1c, 0x1f, 0x65, 0x08, 0x67, 0x2c, 0xef, 0x7a, 0x0a, 0xb1, 0x58, 0x55, 0x0b, 0x1f,
+		0x18, 0xa1, 0x44, 0x30, 0x3f, 0xba, 0xed, 0x30, 0x5b, 0x2a, 0x46, 0xf9, 0x9e, 0xc1, 0xfa, 0x12,
+		0xad, 0xa6, 0x16, 0xd5, 0x09, 0x48, 0xd2, 0x37, 0x43, 0x28, 0x08, 0xbb, 0x58, 0x95, 0xc0, 0x0a,
+		0xda, 0xf8, 0x10, 0x64, 0x3d, 0xdd, 0xc8, 0x40, 0x12, 0x8c, 0x8a, 0x57, 0x4d, 0x01, 0x1f, 0x87,
+		0x25, 0xd3, 0xb8, 0xe9, 0x68, 0xc3, 0xd0, 0x49, 0x0a, 0x4d, 0xb0, 0xed, 0x6a, 0x10, 0xe3, 0x01,
+		0xc8, 0x34, 0x05, 0xf3, 0x19, 0x2c, 0x50, 0xd8, 0x79, 0xb7, 0x96, 0x82, 0x1d, 0x87, 0x84, 0xde,
+		0xeb, 0x31, 0x80, 0x14, 0xd7, 0x8d, 0xbd, 0x1e, 0x6d, 0x3a, 0x03, 0x0b, 0x74, 0x8c, 0x7d, 0xc3,
+		0xc6, 0xdb, 0x01, 0x0c, 0x26, 0x4d, 0x61, 0xb2, 0xd8, 0xa0, 0xb2, 0x7a, 0x0a, 0x7b, 0x3f, 0xcc,
+		0x1b, 0x37, 0xf0, 0x56, 0x5b, 0xd3, 0x60, 0x70, 0xf3, 0x14, 0x2e, 0x2d, 0x2a, 0x29, 0xd0, 0xc3,
+		0xe0, 0xea, 0x3c, 0x4d, 0xe8, 0xe3, 0x0c, 0xa3, 0x27, 0xea, 0x0b, 0xac, 0x5a, 0xc9, 0x41, 0xa4,
+		0xac, 0x3b, 0x3a, 0x1a, 0x15, 0xce, 0x4d, 0xb6, 0xc9, 0xa4, 0x55, 0xfc, 0x53, 0x79, 0x2b, 0x04,
+		0x91, 0xab, 0x96, 0x63, 0x90, 0x27, 0x7d, 0x06, 0x5f, 0x66, 0x9c, 0x3c, 0xd7, 0xdb, 0xbb, 0xa6,
+		0xd1, 0x5a, 0xb7, 0x77, 0x7d, 0x0f, 0xfc, 0x79, 0xe2, 0x14, 0x0a, 0x88, 0xd3, 0x12, 0x44, 0xfb,
+		0xd6, 0xc0, 0x6c, 0x89, 0x94, 0x70, 0x5a, 0x20, 0x15, 0x48, 0xb8, 0x52, 0x12, 0x99, 0x26, 0x25,
+		0x59, 0x94, 0x12, 0x94, 0x61, 0x5e, 0xa1, 0xc6, 0xb7, 0xb9, 0xb0, 0x14, 0x21, 0xe9, 0x2a, 0xaf,
+		0x5c, 0xf4, 0x10, 0x02, 0xeb, 0xa1, 0xe1, 0x46, 0xe2, 0xce, 0xbd, 0xcb, 0x3c, 0x26, 0x71, 0xb2,
+		0xdb, 0xc0, 0xb9, 0x17, 0x10, 0x2b, 0xfe, 0xd8, 0x60, 0x9c, 0x8e, 0xcb, 0x13, 0x2b, 0xf6, 0xe0,
+		0xe0, 0x3d, 0x98, 0x76, 0xb6, 0x6b, 0xd2, 0xeb, 0x0e, 0x5c, 0xf2, 0xbc, 0x0a, 0xe5, 0xd7, 0x25,
+		0x88, 0x31, 0x49, 0xf6, 0xf1, 0x4d, 0x1a, 0xcf, 0xb7, 0xd0, 0x24, 0xbe, 0x85, 0xef, 0x9c, 0x6f,
+		0x05, 0x00, 0xb7, 0x33, 0x36, 0x7f, 0x03, 0x6e, 0x8c, 0xb5, 0xc0, 0xba, 0x58, 0x6f, 0xef, 0xf2,
+		0x85, 0xea, 0x43, 0x52, 0xfe, 0xa3, 0x04, 0x49, 0xb7, 0x9d, 0x14, 0x60, 0x5e, 0xf4, 0x4b, 0xdb,
+		0xe9, 0xe8, 0xbb, 0x5c, 0x76, 0xee, 0x9d, 0xd8, 0xb9, 0x8b, 0x1d, 0x7d, 0x57, 0x4d, 0xf1, 0xfe,
+		0x60, 0x61, 0xfc, 0x3c, 0x84, 0x26, 0xcc, 0x43, 0x60, 0xe2, 0xc3, 0x77, 0x36, 0xf1, 0x81, 0x29,
+		0x8a, 0x0c, 0x4f, 0xd1, 0x2f, 0x86, 0xa8, 0xf3, 0xd2, 0xb3, 0x6c, 0xbd, 0xf3, 0x95, 0x58, 0x11,
+		0x27, 0x20, 0xd9, 0xb3, 0x3a, 0x1a, 0x6b, 0x61, 0x57, 0x25, 0x12, 0x3d, 0xab, 0xa3, 0x8e, 0x4c,
+		0x7b, 0xf4, 0x2e, 0x2d, 0x97, 0xd8, 0x5d, 0xe0, 0x5a, 0x7c, 0x98, 0x6b, 0x7d, 0x48, 0x33, 0x56,
+		0xf0, 0xbd, 0xec, 0x71, 0xe4, 0x01, 0xfe, 0x95, 0x93, 0x46, 0xf7, 0x5e, 0xd6, 0x6d, 0x06, 0xa9,
+		0xc6, 0xf6, 0x5c, 0x0c, 0xa6, 0xfa, 0x73, 0xa1, 0x49, 0x18, 0x4c, 0xec, 0x54, 0x0e, 0xa7, 0x7c,
+		0xaf, 0x04, 0xb0, 0x86, 0x9c, 0xa5, 0xe3, 0xc5, 0x5d, 0xc8, 0xa6, 0x5d, 0xd0, 0x02, 0x5f, 0x3e,
+		0x39, 0x69, 0xd2, 0xf8, 0xf7, 0xd3, 0xb6, 0xbf, 0xdf, 0x25, 0x98, 0xf7, 0x84, 0xd1, 0x36, 0x44,
+		0x67, 0x4e, 0x1e, 0x60, 0x51, 0xe3, 0x05, 0xa7, 0xf4, 0x0d, 0x5f, 0x49, 0xf9, 0xe7, 0x12, 0x24,
+		0x69, 0x9f, 0xf0, 0x05, 0xab, 0xc0, 0x1c, 0x4a, 0x77, 0x3e, 0x87, 0xf7, 0x02, 0x30, 0x32, 0x78,
+		0x08, 0xcf, 0x25, 0x2b, 0x49, 0x6b, 0xf0, 0x68, 0x1d, 0x63, 0x4b, 0x7c, 0xd8, 0xe1, 0x83, 0x19,
+		0x2e, 0x2c, 0x6e, 0xce, 0xf6, 0x63, 0x10, 0xa7, 0x57, 0x6e, 0x6f, 0xda, 0xdc, 0x88, 0xc6, 0x87,
+		0x12, 0x1b, 0x37, 0x6d, 0xe5, 0x65, 0x88, 0x37, 0x6e, 0xb2, 0x58, 0xc8, 0x09, 0x48, 0xf6, 0x2d,
+		0x8b, 0xef, 0xc9, 0xcc, 0x16, 0x4a, 0x60, 0x05, 0xdd, 0x82, 0x84, 0xff, 0x1f, 0xf2, 0xfc, 0x7f,
+		0x2f, 0x80, 0x11, 0x9e, 0x29, 0x80, 0x71, 0xe6, 0x3f, 0x48, 0x90, 0xf2, 0xe9, 0x07, 0xf2, 0x04,
+		0x1c, 0x29, 0xae, 0xd5, 0x4a, 0xcf, 0x6b, 0xd5, 0xb2, 0x76, 0x71, 0xad, 0xe0, 0xbb, 0x4f, 0x98,
+		0x3f, 0x7a, 0xeb, 0xf6, 0x32, 0xf1, 0xc1, 0x6e, 0x99, 0xf4, 0x30, 0x86, 0x3c, 0x06, 0x4b, 0x41,
+		0x94, 0x42, 0xb1, 0x8e, 0x57, 0xd3, 0xa5, 0xfc, 0x91, 0x5b, 0xb7, 0x97, 0x17, 0x7c, 0x18, 0x85,
+		0x6d, 0xdb, 0x30, 0x9d, 0x51, 0x84, 0x52, 0x6d, 0x7d, 0x1d, 0xaf, 0x73, 0x8e, 0x20, 0x70, 0x85,
+		0xfd, 0x30, 0x2c, 0x04, 0x11, 0x36, 0xaa, 0x6b, 0x72, 0x38, 0x4f, 0x6e, 0xdd, 0x5e, 0xce, 0xf8,
+		0xa0, 0x37, 0xda, 0x9d, 0x7c, 0xe2, 0xc3, 0x3f, 0x76, 0x72, 0xee, 0xe3, 0x3f, 0x7e, 0x52, 0xc2,
+		0x91, 0xcd, 0x07, 0x74, 0x04, 0x79, 0x37, 0x1c, 0xab, 0x57, 0x2f, 0x6d, 0x54, 0xca, 0xda, 0x7a,
+		0xfd, 0xd2, 0xd0, 0x8d, 0xd0, 0x7c, 0xf6, 0xd6, 0xed, 0xe5, 0x14, 0x1f, 0xd2, 0x24, 0xe8, 0x4d,
+		0xb5, 0x72, 0xb5, 0xd6, 0xa8, 0xc8, 0x12, 0x83, 0xde, 0xec, 0x1b, 0x37, 0x2c, 0x87, 0x3d, 0xaa,
+		0xff, 0x38, 0x1c, 0x1f, 0x03, 0xed, 0x0e, 0x6c, 0xe1, 0xd6, 0xed, 0xe5, 0xf9, 0xcd, 0xbe, 0xc1,
+		0xd6, 0x0f, 0xc5, 0x58, 0x81, 0xdc, 0x28, 0x46, 0x6d, 0xb3, 0x56, 0x2f, 0xac, 0xc9, 0xcb, 0x79,
+		0xf9, 0xd6, 0xed, 0xe5, 0xb4, 0x50, 0x86, 0x08, 0xef, 0x8d, 0xec, 0xcb, 0xe5, 0xed, 0x7c, 0x73,
+		0x1c, 0xee, 0x1d, 0x6e, 0x7c, 0xac, 0xa7, 0xf7, 0xf5, 0xee, 0x61, 0xdd, 0x9d, 0x29, 0xa7, 0xb1,
+		0xca, 0x6b, 0x21, 0xc8, 0xba, 0xc6, 0xf4, 0x26, 0xfd, 0x02, 0x79, 0xd2, 0x1f, 0x87, 0x49, 0x4d,
+		0xdc, 0xc6, 0x18, 0xb4, 0x08, 0xd3, 0x60, 0x1c, 0x9e, 0x1b, 0x65, 0x5c, 0x5d, 0x2c, 0x8f, 0xe2,
+		0x55, 0x38, 0x04, 0x47, 0x75, 0x31, 0xc8, 0x73, 0x90, 0x74, 0x95, 0x87, 0xfb, 0x24, 0xe9, 0x64,
+		0x6d, 0xc3, 0xf1, 0x3d, 0x1c, 0x7c, 0x25, 0x54, 0xb8, 0x0d, 0x91, 0x49, 0x8e, 0xc8, 0x55, 0x06,
+		0xc0, 0x91, 0x05, 0xbc, 0x52, 0xe5, 0xcb, 0x8e, 0x8f, 0x9e, 0xbe, 0xca, 0x76, 0x53, 0x63, 0x9e,
+		0x14, 0xb3, 0x4a, 0x12, 0x5d, 0xfd, 0x66, 0x11, 0xcb, 0xa8, 0x28, 0xb0, 0x71, 0x97, 0x3f, 0x47,
+		0x13, 0x56, 0x63, 0x5d, 0xfd, 0xe6, 0x25, 0xdd, 0xbe, 0x12, 0x49, 0x84, 0xe5, 0x88, 0xf2, 0xd3,
+		0x12, 0x64, 0x82, 0x63, 0x24, 0x8f, 0x00, 0x41, 0x0c, 0x3c, 0xee, 0x40, 0x15, 0x43, 0x99, 0x25,
+		0xe8, 0xe2, 0x95, 0xd6, 0xc2, 0xae, 0xb1, 0x31, 0xe8, 0xd2, 0x0e, 0xd8, 0x04, 0xcf, 0x46, 0x38,
+		0xb0, 0x98, 0x27, 0xce, 0xcc, 0xe3, 0xa3, 0x8f, 0xcd, 0x73, 0x00, 0xb6, 0x51, 0xbd, 0x8e, 0x1b,
+		0x55, 0x86, 0xd1, 0x13, 0x2d, 0xc1, 0xa1, 0x84, 0x83, 0x43, 0x51, 0x9e, 0x83, 0xec, 0x10, 0x3f,
+		0x89, 0x02, 0xf3, 0x3c, 0x2a, 0x40, 0x4f, 0x2a, 0x99, 0x7d, 0x9c, 0x54, 0x53, 0xcc, 0xfb, 0xa7,
+		0x27, 0xb7, 0xab, 0x89, 0x5f, 0xc1, 0x50, 0x14, 0x06, 0xcc, 0x1f, 0x81, 0xf9, 0x00, 0x47, 0x45,
+		0xa4, 0x4e, 0xf2, 0x22, 0x75, 0x1e, 0xf0, 0x4b, 0x90, 0x46, 0x45, 0x69, 0xb4, 0x38, 0xec, 0x83,
+		0x90, 0x65, 0x8a, 0x7c, 0x98, 0xd7, 0xcc, 0x92, 0x5a, 0x17, 0x0c, 0x57, 0x60, 0xde, 0x83, 0xf3,
+		0xd8, 0x9e, 0x12, 0x50, 0x97, 0x74, 0xbb, 0xf8, 0xfe, 0x8f, 0x7f, 0xfa, 0xa4, 0xf4, 0xe5, 0x59,
+		0x88, 0xbf, 0xfb, 0x7e, 0x38, 0xe1, 0x6b, 0xd4, 0xb7, 0x9b, 0xed, 0x40, 0xd4, 0x21, 0xeb, 0x35,
+		0xae, 0x60, 0xe3, 0xb4, 0xe8, 0xc1, 0x81, 0x31, 0x8c, 0x83, 0x03, 0x66, 0xf9, 0x83, 0x35, 0xc2,
+		0xf4, 0xc0, 0xc6, 0xf8, 0x18, 0xe5, 0xff, 0x49, 0x40, 0x5c, 0x35, 0x3e, 0x38, 0x30, 0x6c, 0xbc,
+		0x04, 0x1d, 0x31, 0x9a, 0x7b, 0xd6, 0xb8, 0x90, 0x10, 0x0e, 0x6e, 0x85, 0xc3, 0x55, 0x9a, 0x7b,
+		0xd6, 0xe5, 0x39, 0x95, 0xc2, 0x92, 0x73, 0x10, 0xdd, 0xe9, 0x0c, 0x78, 0x9c, 0x62, 0x48, 0x59,
+		0xf8, 0x91, 0x2e, 0x22, 0xd0, 0xe5, 0x39, 0x95, 0x41, 0xe3, 0xa7, 0xe8, 0x4f, 0x7d, 0x84, 0x0f,
+		0xfe, 0x14, 0xde, 0x7c, 0xc5, 0x4f, 0x21, 0x2c, 0x29, 0x02, 0xb4, 0xcd, 0xb6, 0xa3, 0x51, 0x1f,
+		0x3e, 0x17, 0x1d, 0xd5, 0x12, 0x41, 0xcc, 0xb6, 0x43, 0xbd, 0x7e, 0x8c, 0xa4, 0xb6, 0x45, 0x01,
+		0xbb, 0xfb, 0xc1, 0x81, 0xd1, 0xdf, 0xcf, 0xc5, 0x0e, 0xee, 0xee, 0xfb, 0x11, 0x08, 0xbb, 0x4b,
+		0xa1, 0x49, 0x05, 0x52, 0xf4, 0x36, 0x2f, 0x5b, 0xbf, 0xfc, 0xb5, 0x27, 0x65, 0x12, 0x72, 0x11,
+		0x41, 0xe9, 0x92, 0xbe, 0x3c, 0xa7, 0xc2, 0xb6, 0x5b, 0x42, 0x25, 0xc9, 0x9e, 0xa5, 0x75, 0x6e,
+		0xe6, 0x12, 0xa3, 0x6a, 0xca, 0x4f, 0x83, 0xbe, 0x4d, 0xdb, 0xb8, 0x89, 0x51, 0xe2, 0x26, 0xfb,
+		0x13, 0xc7, 0xdf, 0x32, 0x3a, 0x6d, 0x7c, 0xf5, 0xd7, 0xb9, 0x99, 0x4b, 0x1e, 0x3c, 0xfe, 0x32,
+		0x83, 0xa4, 0x14, 0x92, 0x2d, 0x51, 0x40, 0x45, 0x6b, 0x98, 0x2d, 0x3e, 0x0c, 0x18, 0xd5, 0xd3,
+		0x81, 0x79, 0x36, 0x5b, 0x62, 0x10, 0x09, 0x83, 0xff, 0x4d, 0x9e, 0x71, 0x2d, 0xd4, 0xd4, 0xa8,
+		0x51, 0x18, 0x18, 0x00, 0x8b, 0x55, 0xcc, 0x09, 0x4b, 0x95, 0x6c, 0x40, 0xa6, 0xd3, 0xb6, 0x1d,
+		0xcd, 0x36, 0xf5, 0x9e, 0xbd, 0x67, 0x39, 0xe2, 0xfd, 0xc9, 0x07, 0x26, 0x51, 0x58, 0x6b, 0xdb,
+		0x4e, 0x5d, 0x00, 0x5f, 0x9e, 0x53, 0xe7, 0x3b, 0xfe, 0x0a, 0xa4, 0x67, 0xed, 0xec, 0x18, 0x7d,
+		0x97, 0x60, 0x6e, 0xfe, 0x60, 0x7a, 0x35, 0x84, 0x16, 0xf8, 0x48, 0xcf, 0xf2, 0x57, 0x90, 0xaf,
+		0x86, 0xc5, 0x8e, 0xa5, 0xb7, 0x5c, 0x72, 0x5a, 0x73, 0x6f, 0x60, 0x5e, 0xa7, 0x91, 0x04, 0x7c,
+		0xa5, 0x7c, 0x52, 0x27, 0x2d, 0xbd, 0x25, 0x48, 0x94, 0x10, 0xe1, 0xf2, 0x9c, 0xba, 0xd0, 0x19,
+		0xae, 0x24, 0x1f, 0x80, 0x25, 0xbd, 0xd7, 0xeb, 0xec, 0x0f, 0x53, 0xcf, 0x52, 0xea, 0x67, 0x26,
+		0x51, 0x2f, 0x20, 0xce, 0x30, 0x79, 0xa2, 0x8f, 0xd4, 0x92, 0x06, 0xc6, 0x40, 0x0c, 0x7a, 0x27,
+		0xa6, 0xc7, 0x4d, 0x15, 0xfa, 0xb8, 0x23, 0xfe, 0x1e, 0xd0, 0x04, 0xda, 0x9b, 0x0c, 0x5e, 0x58,
+		0x36, 0x97, 0xe7, 0x30, 0x5c, 0x12, 0xa8, 0x62, 0x54, 0xad, 0xa6, 0x41, 0x5f, 0xbb, 0xe5, 0x54,
+		0x17, 0xa6, 0x51, 0xa5, 0xf0, 0x41, 0xaa, 0x81, 0xaa, 0x62, 0x9c, 0xe7, 0xb4, 0xf1, 0x5f, 0x89,
+		0x78, 0x08, 0x52, 0x3e, 0xc5, 0x82, 0x41, 0x75, 0x7e, 0xc6, 0x2f, 0x72, 0xe1, 0x78, 0x51, 0xc9,
+		0x40, 0xda, 0xaf, 0x4c, 0x94, 0x8f, 0x48, 0x90, 0xf2, 0xe9, 0x09, 0xc4, 0xf4, 0x87, 0x0e, 0x93,
+		0x5e, 0x54, 0xf0, 0x7e, 0xb1, 0x8b, 0x88, 0x76, 0x76, 0x9a, 0x94, 0xa6, 0x95, 0x7c, 0x13, 0xc3,
+		0xfb, 0xfd, 0xbd, 0xb3, 0x3d, 0x17, 0x24, 0x4c, 0x41, 0xa0, 0x77, 0xb6, 0x27, 0x00, 0xee, 0x83,
+		0x34, 0x8e, 0x54, 0xf3, 0x1b, 0x1a, 0x49, 0x35, 0x85, 0x75, 0x1c, 0x44, 0xf9, 0xd7, 0x21, 0x90,
+		0x87, 0x15, 0x90, 0x1b, 0x53, 0x94, 0x0e, 0x1d, 0x53, 0x3c, 0x3e, 0x1c, 0xcd, 0xf4, 0x02, 0x98,
+		0x6b, 0x20, 0x7b, 0x71, 0x38, 0xb6, 0x11, 0x4c, 0x36, 0x9c, 0x86, 0x2c, 0x3c, 0x35, 0xdb, 0x0c,
+		0x56, 0x90, 0x8b, 0x81, 0xb3, 0x17, 0xf1, 0x5b, 0x56, 0xc3, 0x53, 0xec, 0xda, 0x0b, 0x5b, 0xbd,
+		0x96, 0xee, 0x18, 0x22, 0x2e, 0xe2, 0x61, 0xe2, 0xb6, 0x8e, 0x61, 0x3f, 0xdb, 0xd1, 0x1d, 0x83,
+		0x6f, 0xeb, 0x51, 0x16, 0x1e, 0xd4, 0x7b, 0x3d, 0xfa, 0xfb, 0x1f, 0x6c, 0x5b, 0x7f, 0x00, 0x32,
+		0xa8, 0x93, 0xdb, 0x7a, 0x47, 0xe3, 0x51, 0x82, 0x18, 0xdb, 0xfd, 0x79, 0xed, 0x65, 0x5a, 0xa9,
+		0xb4, 0x20, 0xed, 0xd7, 0xc7, 0xae, 0x9b, 0x25, 0xf9, 0xdc, 0x2c, 0xc2, 0x9f, 0x6b, 0x60, 0xfc,
+		0x11, 0x4f, 0x5c, 0x8c, 0x8f, 0xee, 0x2e, 0x51, 0x97, 0xec, 0x06, 0x0b, 0x78, 0x24, 0x54, 0x56,
+		0x50, 0x3e, 0x14, 0x82, 0x85, 0x11, 0xcd, 0x3d, 0x36, 0xec, 0xed, 0xf9, 0x97, 0xa1, 0x43, 0xf9,
+		0x97, 0xcf, 0x07, 0xc3, 0xba, 0xbe, 0x9d, 0xef, 0xc4, 0x08, 0x93, 0x99, 0xde, 0x44, 0x81, 0xe6,
+		0x44, 0x7c, 0x91, 0x5f, 0x2a, 0xe6, 0x5b, 0xb0, 0xb4, 0xbd, 0xff, 0xaa, 0x6e, 0x3a, 0x6d, 0xd3,
+		0xd0, 0x46, 0x66, 0x6d, 0x74, 0x2b, 0x5d, 0x6f, 0xdb, 0xdb, 0xc6, 0x9e, 0x7e, 0xa3, 0x6d, 0x89,
+		0x6e, 0x2d, 0xba, 0xf8, 0x5e, 0x70, 0x57, 0x51, 0x21, 0x13, 0xdc, 0x7a, 0x48, 0x06, 0x42, 0xce,
+		0x4d, 0x3e, 0xfe, 0x90, 0x73, 0x93, 0x3c, 0xce, 0xe3, 0x40, 0xec, 0x51, 0xaa, 0xd1, 0x0f, 0x71,
+		0x3c, 0x2f, 0x08, 0xa4, 0x28, 0x20, 0x0f, 0x6f, 0x47, 0xc3, 0x54, 0x95, 0x87, 0x21, 0x3b, 0xb4,
+		0xdf, 0x4c, 0x8a, 0x0a, 0x2a, 0x59, 0x98, 0xe7, 0xa0, 0x8c, 0x1d, 0xca, 0x51, 0x58, 0x1a, 0xb7,
+		0x57, 0x28, 0x7b, 0xb0, 0x34, 0x4e, 0xe7, 0x93, 0x73, 0x90, 0x70, 0x37, 0x8b, 0x31, 0x51, 0x08,
+		0x3a, 0x0a, 0x01, 0xac, 0xba, 0xa0, 0x81, 0x60, 0x76, 0x28, 0x10, 0xcc, 0x56, 0xbe, 0x0e, 0x72,
+		0x93, 0x36, 0x82, 0xa1, 0x61, 0x44, 0x5c, 0x29, 0x3c, 0x0a, 0x31, 0xfe, 0xd6, 0x53, 0x88, 0x1e,
+		0xdf, 0xf0, 0x12, 0x4a, 0x27, 0xdb, 0x14, 0xc2, 0xb4, 0x9a, 0x15, 0x14, 0x0d, 0x8e, 0x4f, 0xdc,
+		0x0c, 0x26, 0x1f, 0x04, 0x31, 0x42, 0xfc, 0x20, 0xa8, 0x29, 0xba, 0x63, 0xd3, 0xb1, 0x8a, 0x64,
+		0x07, 0x56, 0x52, 0x5e, 0x0f, 0xc3, 0xd1, 0xf1, 0x5b, 0x02, 0x59, 0x86, 0x34, 0xda, 0xdd, 0x4e,
+		0xd0, 0x44, 0x87, 0xae, 0x7e, 0xb3, 0xc1, 0xed, 0x73, 0x1e, 0x48, 0x0f, 0xb9, 0x81, 0x74, 0xb2,
+		0x05, 0x0b, 0x1d, 0xab, 0xa9, 0x77, 0x34, 0x9f, 0xc4, 0x73, 0x61, 0xbf, 0x7f, 0x84, 0xd9, 0x15,
+		0xf6, 0x43, 0x37, 0xad, 0x11, 0xa1, 0xcf, 0x52, 0x1a, 0x6b, 0xae, 0xe4, 0x93, 0x32, 0xa4, 0xba,
+		0x9e, 0x20, 0x1f, 0x42, 0xd8, 0xfd, 0x68, 0xbe, 0x29, 0x89, 0x8e, 0x3d, 0xf6, 0x89, 0x1d, 0x5a,
+		0x45, 0x4f, 0x3a, 0x41, 0x89, 0x4f, 0x3c, 0x41, 0x19, 0x77, 0x5c, 0x91, 0x18, 0x7f, 0x5c, 0xf1,
+		0x61, 0xff, 0xd4, 0x04, 0x36, 0xd1, 0xd1, 0x13, 0x0c, 0x52, 0x87, 0x25, 0x8e, 0xdf, 0x0a, 0xf0,
+		0x3e, 0x34, 0xab, 0xa2, 0x21, 0x02, 0x7d, 0x32, 0xdb, 0xc3, 0x77, 0xc6, 0x76, 0xa1, 0x4b, 0x23,
+		0x3e, 0x5d, 0xfa, 0xff, 0xd8, 0x54, 0xfc, 0x7e, 0x12, 0x12, 0xaa, 0x61, 0xf7, 0x70, 0xe3, 0xc4,
+		0x50, 0xb2, 0x71, 0xb3, 0x69, 0xf4, 0x1c, 0xef, 0x98, 0x72, 0x9c, 0x33, 0xc0, 0xa0, 0x2b, 0x02,
+		0x12, 0x2d, 0x71, 0x17, 0x0d, 0xa3, 0xea, 0xd4, 0xd9, 0x9a, 0xec, 0x37, 0x71, 0x74, 0xbf, 0xb7,
+		0x75, 0x5e, 0x78, 0x5b, 0xe1, 0x89, 0xc6, 0x37, 0xc3, 0x1a, 0x72, 0xb7, 0x9e, 0xe4, 0xee, 0x56,
+		0x64, 0xca, 0xc7, 0x02, 0xfe, 0x56, 0x29, 0xe0, 0x6f, 0xc5, 0xa6, 0x0c, 0x73, 0x82, 0xc3, 0x75,
+		0x5e, 0x38, 0x5c, 0xf1, 0x29, 0x3d, 0x1e, 0xf2, 0xb8, 0x2e, 0x06, 0x3d, 0xae, 0xc4, 0x04, 0x05,
+		0x22, 0xb0, 0x27, 0xba, 0x5c, 0xef, 0xf5, 0xb9, 0x5c, 0xc9, 0x89, 0xfe, 0x0e, 0x23, 0x32, 0xc6,
+		0xe7, 0x2a, 0x05, 0x7c, 0x2e, 0x98, 0xc2, 0x83, 0x09, 0x4e, 0xd7, 0x57, 0xf9, 0x9d, 0xae, 0xd4,
+		0x44, 0xbf, 0x8d, 0xcf, 0xf7, 0x38, 0xaf, 0xeb, 0x82, 0xeb, 0x75, 0xa5, 0x27, 0xba, 0x8d, 0x7c,
+		0x0c, 0xc3, 0x6e, 0x57, 0x6d, 0xc4, 0xed, 0x9a, 0xe7, 0xbf, 0x07, 0x38, 0x89, 0xc4, 0x14, 0xbf,
+		0xab, 0x36, 0xe2, 0x77, 0x65, 0xa6, 0x10, 0x9c, 0xe2, 0x78, 0x7d, 0xcd, 0x78, 0xc7, 0x6b, 0xb2,
+		0x6b, 0xc4, 0xbb, 0x39, 0x9b, 0xe7, 0xa5, 0x4d, 0xf0, 0xbc, 0x98, 0x77, 0xf4, 0xc8, 0x44, 0xf2,
+		0x33, 0xbb, 0x5e, 0x5b, 0x63, 0x5c, 0x2f, 0xe6, 0x24, 0x9d, 0x9e, 0x48, 0x7c, 0x06, 0xdf, 0x6b,
+		0x6b, 0x8c, 0xef, 0x45, 0xa6, 0x92, 0x3d, 0x8c, 0xf3, 0x85, 0xef, 0x43, 0x3f, 0x0c, 0x0b, 0x02,
+		0xd9, 0xd5, 0x53, 0x68, 0x3f, 0x18, 0xfd, 0xbe, 0xd5, 0x17, 0x99, 0xb5, 0xb4, 0xa0, 0x9c, 0x86,
+		0xb4, 0x0b, 0x7a, 0xb0, 0xa3, 0x46, 0xed, 0x34, 0x9f, 0x1e, 0x52, 0x7e, 0x45, 0x82, 0xb4, 0x5f,
+		0xc5, 0x04, 0x0c, 0xf9, 0x24, 0x37, 0xe4, 0x7d, 0xee, 0x5b, 0x28, 0xe8, 0xbe, 0xe1, 0x43, 0xe0,
+		0xbd, 0x11, 0xcf, 0x4c, 0xef, 0xb9, 0x9e, 0x99, 0x48, 0x29, 0x60, 0x4e, 0x1e, 0xdf, 0x56, 0xd8,
+		0x49, 0x4e, 0xd6, 0x4d, 0xaf, 0x60, 0x3e, 0x05, 0x79, 0x14, 0x16, 0x7d, 0xb0, 0xae, 0x5d, 0xc7,
+		0xdc, 0x14, 0xd9, 0x85, 0x2e, 0x70, 0x03, 0xef, 0x5f, 0x4a, 0xb0, 0x30, 0xa2, 0xe2, 0xc6, 0x7a,
+		0x5f, 0xd2, 0x5d, 0xf2, 0xbe, 0x42, 0x77, 0xec, 0x7d, 0xf9, 0xed, 0xd4, 0x70, 0xd0, 0x4e, 0xfd,
+		0xef, 0x12, 0xcc, 0x07, 0x34, 0x2d, 0x4e, 0x41, 0xd3, 0x6a, 0x19, 0xdc, 0x72, 0xa4, 0x7f, 0xa3,
+		0x51, 0xd1, 0xb1, 0x76, 0xb9, 0x7d, 0x88, 0x7f, 0x22, 0x94, 0xbb, 0x71, 0x24, 0xf9, 0xbe, 0xe0,
+		0x1a, 0x9d, 0x51, 0x7f, 0xfe, 0x2f, 0x4f, 0x8a, 0x8d, 0x79, 0x49, 0xb1, 0xee, 0xdd, 0xb5, 0xb8,
+		0xef, 0xee, 0x1a, 0x79, 0x06, 0x92, 0x34, 0x22, 0xaa, 0x61, 0x42, 0x6c, 0x62, 0xd4, 0x36, 0x09,
+		0x26, 0xc4, 0xda, 0x34, 0x55, 0x8f, 0xfe, 0xe5, 0xb3, 0x18, 0x92, 0x01, 0x8b, 0xe1, 0x1e, 0x48,
+		0x62, 0xef, 0xd9, 0x2f, 0xe7, 0x00, 0xbf, 0xf8, 0x28, 0x2a, 0x94, 0x0f, 0x00, 0x19, 0xdd, 0x24,
+		0xc8, 0x65, 0x88, 0x19, 0x37, 0xe8, 0x0b, 0x84, 0x2c, 0xd1, 0xf0, 0xe8, 0xa8, 0x69, 0x8a, 0xcd,
+		0xc5, 0x1c, 0x32, 0xf9, 0xf3, 0x6f, 0x9c, 0x92, 0x19, 0xf4, 0xbb, 0xdd, 0x3c, 0x7f, 0x95, 0xe3,
+		0x2b, 0x7f, 0x14, 0x82, 0xac, 0xf8, 0x80, 0xf0, 0x9c, 0xc6, 0xf1, 0x76, 0xdc, 0x11, 0xe1, 0x6c,
+		0xfc, 0x3e, 0x09, 0xb0, 0xab, 0xdb, 0xda, 0x2b, 0xba, 0xe9, 0xf0, 0x9f, 0xe1, 0x0c, 0xab, 0xbe,
+		0x1a, 0x4c, 0xb0, 0xc5, 0xd2, 0xc0, 0xe6, 0x3f, 0xc4, 0x19, 0x56, 0xdd, 0xb2, 0x6f, 0x9c, 0xf1,
+		0x2f, 0x6d, 0x9c, 0x41, 0x2e, 0x27, 0x86, 0xb8, 0xec, 0x73, 0x2e, 0x92, 0x7e, 0xe7, 0x82, 0x25,
+		0x06, 0xf3, 0xfc, 0x44, 0x60, 0x7d, 0x13, 0x65, 0x8c, 0xca, 0x74, 0x8d, 0x6e, 0xcf, 0xb2, 0x3a,
+		0x1a, 0x53, 0x37, 0xec, 0x27, 0xb2, 0xd2, 0xbc, 0xb2, 0x42, 0xb5, 0xce, 0x37, 0x85, 0x60, 0x61,
+		0x64, 0x7b, 0xfd, 0xdb, 0xc7, 0x60, 0xfa, 0x8b, 0x17, 0xc3, 0x26, 0x02, 0xa9, 0xfb, 0xb3, 0x46,
+		0x06, 0x54, 0x2d, 0x08, 0x81, 0x9e, 0x55, 0x7f, 0xc8, 0x37, 0x82, 0xd5, 0x36, 0xb9, 0x06, 0xc7,
+		0x86, 0x74, 0x9b, 0x4b, 0x3a, 0x34, 0xab, 0x8a, 0x3b, 0x12, 0x54, 0x71, 0x82, 0xb4, 0xc7, 0xac,
+		0xf0, 0x97, 0xb8, 0xea, 0xaa, 0x90, 0x11, 0xdc, 0xe0, 0x9e, 0xca, 0xb8, 0xe9, 0xa7, 0x3f, 0xc9,
+		0xe8, 0x60, 0x00, 0x2d, 0x10, 0x0e, 0x4a, 0xb3, 0x4a, 0x1e, 0x64, 0xda, 0x84, 0x23, 0x82, 0x54,
+		0xc0, 0xf2, 0x21, 0x4f, 0x43, 0xd2, 0x33, 0x9a, 0x18, 0x57, 0x0f, 0x08, 0x17, 0x78, 0xb0, 0xca,
+		0xaf, 0x49, 0x70, 0x64, 0xac, 0xed, 0x43, 0x2a, 0x10, 0x63, 0x69, 0x6f, 0x3c, 0x99, 0xe6, 0xd1,
+		0xd9, 0x6c, 0xa6, 0x15, 0x96, 0x13, 0xa7, 0x72, 0x64, 0xe5, 0x03, 0x10, 0x63, 0x35, 0x24, 0x05,
+		0xf1, 0xc0, 0xb3, 0xc7, 0x85, 0x52, 0xa9, 0xb2, 0x29, 0x5e, 0xfc, 0x2c, 0xd2, 0xf7, 0x80, 0xb1,
+		0x5a, 0xad, 0x5c, 0xa9, 0x94, 0x1a, 0x72, 0x18, 0x7f, 0x87, 0x94, 0xfd, 0xcd, 0x5f, 0x16, 0x96,
+		0x23, 0xbe, 0xaa, 0x7a, 0x65, 0xa3, 0x5c, 0x51, 0xe5, 0xa8, 0xf2, 0x04, 0x1c, 0x17, 0xfd, 0x18,
+		0x0d, 0x6b, 0xb8, 0xd1, 0x05, 0xc9, 0x17, 0x5d, 0x50, 0x5e, 0x0f, 0x41, 0x7e, 0xb2, 0xe9, 0x44,
+		0xae, 0x0c, 0x0d, 0xfc, 0xec, 0x21, 0xec, 0xae, 0xa1, 0xd1, 0x63, 0xf0, 0xb0, 0x6f, 0xec, 0x18,
+		0x4e, 0x73, 0x8f, 0x99, 0x72, 0x6c, 0xcb, 0x9c, 0x57, 0xe7, 0x79, 0x2d, 0x45, 0xb2, 0x19, 0xd8,
+		0xcb, 0x46, 0xd3, 0xd1, 0x98, 0x2e, 0x62, 0x42, 0x97, 0x54, 0xe7, 0x59, 0x6d, 0x9d, 0x55, 0x2a,
+		0x5f, 0x77, 0x28, 0x5e, 0x26, 0x21, 0xaa, 0x56, 0xf0, 0x57, 0x5a, 0xe8, 0x8f, 0xad, 0xd2, 0x3f,
+		0xb5, 0xfa, 0x46, 0x61, 0xb3, 0x7e, 0xb9, 0x86, 0xbc, 0x5c, 0x84, 0xac, 0xe0, 0xa5, 0xa8, 0x8c,
+		0x2a, 0x8f, 0xc0, 0xb1, 0x09, 0x76, 0xdf, 0x98, 0x3c, 0xc4, 0x8f, 0x49, 0x7e, 0xe8, 0xa0, 0xcf,
+		0x5f, 0x83, 0x98, 0xed, 0xe8, 0xce, 0xc0, 0xe6, 0x4c, 0x7c, 0x7a, 0x56, 0x43, 0x70, 0x45, 0xfc,
+		0x51, 0xa7, 0xe8, 0x2a, 0x27, 0xa3, 0x9c, 0x83, 0x4c, 0xb0, 0x65, 0x32, 0x0f, 0x3c, 0x21, 0x0a,
+		0x29, 0xd7, 0x00, 0x7c, 0xf1, 0x48, 0x37, 0xa3, 0x4b, 0xf2, 0x67, 0x74, 0x9d, 0x83, 0xe8, 0x0d,
+		0x8b, 0xe9, 0x8c, 0xf1, 0x0b, 0x07, 0x93, 0x2d, 0x7d, 0xc1, 0x07, 0x06, 0xad, 0xb4, 0x81, 0x8c,
+		0xc6, 0x84, 0x26, 0x7c, 0xe2, 0xbd, 0xc1, 0x4f, 0xdc, 0x37, 0x31, 0xba, 0x34, 0xfe, 0x53, 0xaf,
+		0x42, 0x94, 0x6a, 0x9b, 0xb1, 0x57, 0x7c, 0xbe, 0x16, 0x40, 0x77, 0x9c, 0x7e, 0x7b, 0x7b, 0xe0,
+		0x7d, 0xe0, 0xd4, 0x78, 0x6d, 0x55, 0x10, 0x70, 0xc5, 0x7b, 0xb8, 0xda, 0x5a, 0xf2, 0x50, 0x7d,
+		0xaa, 0xcb, 0x47, 0x50, 0xd9, 0x80, 0x4c, 0x10, 0x77, 0xfc, 0x95, 0xa5, 0xd1, 0x1f, 0x6e, 0xf2,
+		0x8c, 0x2f, 0xfe, 0x1c, 0x07, 0x2d, 0x28, 0xb7, 0x24, 0x48, 0x34, 0x6e, 0x72, 0x39, 0x3e, 0x20,
+		0xa9, 0xd2, 0xbb, 0xb7, 0xe5, 0x06, 0x0b, 0x59, 0x3c, 0x36, 0xec, 0x46, 0x79, 0xbf, 0xca, 0x5d,
+		0xa9, 0x91, 0x59, 0xbd, 0x5d, 0x11, 0xed, 0xe6, 0xda, 0xe9, 0xd9, 0xd9, 0xee, 0x48, 0x60, 0x30,
+		0xde, 0x77, 0xbf, 0x81, 0x15, 0x94, 0x96, 0x2f, 0x2d, 0x81, 0x6d, 0x1b, 0xfe, 0xcb, 0x14, 0xd2,
+		0xa1, 0x2f, 0x53, 0xb8, 0x5f, 0x09, 0xf9, 0xbf, 0x72, 0x03, 0x12, 0x42, 0x28, 0xc8, 0xfb, 0xfc,
+		0xb9, 0x27, 0xe2, 0x8c, 0x66, 0xe2, 0xe6, 0xc9, 0xc9, 0x7b, 0x28, 0xe8, 0x7c, 0xf0, 0x94, 0x3b,
+		0xcf, 0xaf, 0xe0, 0x3f, 0x56, 0x94, 0x65, 0x0d, 0x6b, 0xc2, 0xa9, 0x50, 0x7e, 0x42, 0x02, 0x79,
+		0x58, 0x2a, 0xbf, 0x92, 0x1d, 0x40, 0xa5, 0x88, 0xd2, 0xef, 0xfb, 0x7d, 0x0c, 0x36, 0xf3, 0xf3,
+		0x58, 0xeb, 0xfd, 0x42, 0xc6, 0x87, 0x42, 0x90, 0xf2, 0xc5, 0xf4, 0xc8, 0x53, 0x81, 0x14, 0xd0,
+		0xe5, 0x83, 0xe2, 0x7f, 0xbe, 0x1c, 0xd0, 0xc0, 0xc0, 0x42, 0x87, 0x1f, 0xd8, 0xdd, 0x4f, 0xd2,
+		0x1f, 0x7f, 0xdb, 0x27, 0x3a, 0xe1, 0xb6, 0xcf, 0x37, 0x4a, 0x90, 0x70, 0xb7, 0xee, 0xc3, 0x46,
+		0xf3, 0x8f, 0x42, 0x8c, 0xef, 0x4e, 0x2c, 0x9c, 0xcf, 0x4b, 0x63, 0x63, 0xa1, 0x79, 0x48, 0x88,
+		0x1f, 0x01, 0xe5, 0x8e, 0xa8, 0x5b, 0x3e, 0x73, 0x01, 0x52, 0xbe, 0x83, 0x15, 0xd4, 0x13, 0x1b,
+		0x95, 0x17, 0xe4, 0xb9, 0x7c, 0xfc, 0xd6, 0xed, 0xe5, 0xf0, 0x86, 0xf1, 0x0a, 0xae, 0x30, 0xb5,
+		0x52, 0xba, 0x5c, 0x29, 0x3d, 0x2f, 0x4b, 0xf9, 0xd4, 0xad, 0xdb, 0xcb, 0x71, 0xd5, 0xa0, 0xe1,
+		0xab, 0x33, 0xcf, 0x43, 0x76, 0x68, 0x62, 0x82, 0xfa, 0x9d, 0x40, 0xa6, 0xbc, 0x85, 0x3f, 0xe0,
+		0x50, 0x68, 0x54, 0x34, 0x96, 0x4e, 0x87, 0xef, 0x74, 0xaf, 0x55, 0x2f, 0x5d, 0x6e, 0x68, 0xa5,
+		0xb5, 0x6a, 0x65, 0xa3, 0xa1, 0x15, 0x1a, 0x8d, 0x42, 0xe9, 0x79, 0x39, 0x74, 0xf6, 0x8b, 0x00,
+		0xd9, 0x42, 0xb1, 0x54, 0xc5, 0xfd, 0x99, 0xff, 0xa0, 0x3f, 0x29, 0x41, 0x84, 0x86, 0x02, 0x0e,
+		0x4c, 0x15, 0xc9, 0x1f, 0x1c, 0xdb, 0x24, 0x17, 0x21, 0x4a, 0xa3, 0x04, 0xe4, 0xe0, 0xdc, 0x91,
+		0xfc, 0x94, 0x60, 0x27, 0x76, 0x86, 0x2e, 0xa7, 0x03, 0x93, 0x49, 0xf2, 0x07, 0xc7, 0x3e, 0x89,
+		0x0a, 0x49, 0xcf, 0xcb, 0x98, 0x9e, 0x5c, 0x91, 0x9f, 0x41, 0x3b, 0x92, 0x35, 0x88, 0x0b, 0xc7,
+		0x70, 0x5a, 0xba, 0x47, 0x7e, 0x6a, 0x70, 0x12, 0xd9, 0xc5, 0x1c, 0xf8, 0x83, 0x73, 0x57, 0xf2,
+		0x53, 0x22, 0xad, 0xa4, 0xea, 0x26, 0xe1, 0x4f, 0x49, 0xe1, 0xc8, 0x4f, 0x0b, 0x36, 0x22, 0xd3,
+		0xbc, 0xd0, 0xc8, 0xf4, 0x8c, 0x9c, 0xfc, 0x0c, 0x41, 0x64, 0xb2, 0x05, 0xe0, 0x73, 0xd7, 0x67,
+		0x48, 0xb5, 0xc9, 0xcf, 0x12, 0x1c, 0x26, 0x35, 0x48, 0xb8, 0xde, 0xd3, 0xd4, 0xc4, 0x97, 0xfc,
+		0xf4, 0x28, 0x2d, 0xf9, 0x00, 0xcc, 0x07, 0xbd, 0x86, 0xd9, 0xd2, 0x59, 0xf2, 0x33, 0x86, 0x5f,
+		0x91, 0x7e, 0xd0, 0x85, 0x98, 0x2d, 0xbd, 0x25, 0x3f, 0x63, 0x34, 0x96, 0xbc, 0x0c, 0x0b, 0xa3,
+		0x26, 0xfe, 0xec, 0xd9, 0x2e, 0xf9, 0x43, 0xc4, 0x67, 0x49, 0x17, 0xc8, 0x18, 0xd7, 0xe0, 0x10,
+		0xc9, 0x2f, 0xf9, 0xc3, 0x84, 0x6b, 0x49, 0x0b, 0xb2, 0xc3, 0xf6, 0xf6, 0xac, 0xc9, 0x30, 0xf9,
+		0x99, 0x43, 0xb7, 0xec, 0x2b, 0x41, 0x3b, 0x7d, 0xd6, 0xe4, 0x98, 0xfc, 0xcc, 0x91, 0xdc, 0x62,
+		0x61, 0x62, 0x7e, 0xe3, 0x43, 0x07, 0xe6, 0x37, 0x7a, 0x19, 0x8b, 0x6e, 0x4e, 0xe3, 0xff, 0x3a,
+		0x0f, 0xef, 0xe2, 0x8f, 0x09, 0xd8, 0x8e, 0x7e, 0xbd, 0x6d, 0xee, 0xba, 0xaf, 0x43, 0xf0, 0x32,
+		0x4f, 0x6e, 0x3c, 0xca, 0xa0, 0x56, 0x44, 0xed, 0x94, 0x37, 0x22, 0x26, 0xbe, 0x7b, 0x35, 0x2d,
+		0x09, 0x79, 0x7a, 0xea, 0xe2, 0x01, 0xef, 0x4f, 0x4c, 0x79, 0xe5, 0x62, 0xcc, 0xfb, 0x14, 0x53,
+		0x92, 0x30, 0x0f, 0xca, 0xf7, 0x54, 0xbe, 0x5b, 0x82, 0xcc, 0xe5, 0xb6, 0xed, 0x58, 0xfd, 0x76,
+		0x53, 0xef, 0xd0, 0x1d, 0xe3, 0xd9, 0x59, 0x2f, 0x79, 0x14, 0x93, 0x68, 0x8c, 0xf0, 0x47, 0x2d,
+		0x18, 0x0a, 0x29, 0x43, 0xec, 0x86, 0xde, 0x61, 0x57, 0x2c, 0xfc, 0xcf, 0xcf, 0x0c, 0xf3, 0xdc,
+		0x67, 0x25, 0xf9, 0xa9, 0x30, 0x5c, 0xe5, 0x13, 0x34, 0x89, 0xbb, 0xdb, 0x6d, 0xdb, 0x68, 0xbc,
+		0xa9, 0x34, 0xd4, 0xb2, 0x09, 0x91, 0xbe, 0xee, 0x70, 0xa7, 0xa6, 0xf8, 0x1e, 0xfe, 0x14, 0xc5,
+		0x83, 0xd3, 0x1f, 0x94, 0x58, 0x19, 0x7d, 0xad, 0x82, 0x52, 0x22, 0x2f, 0x00, 0x26, 0x0f, 0x6b,
+		0x94, 0x6a, 0xe8, 0x2e, 0x50, 0xc5, 0x4c, 0x6a, 0xec, 0x2b, 0xae, 0x20, 0x24, 0xdc, 0xdc, 0xd3,
+		0xcd, 0x5d, 0x83, 0xd1, 0x0f, 0xdf, 0x05, 0xfa, 0xf3, 0x5d, 0xfd, 0x66, 0x89, 0xd2, 0xc4, 0xaf,
+		0xac, 0x26, 0x5e, 0xff, 0xe8, 0xa9, 0x39, 0x9a, 0x8b, 0xfc, 0x9b, 0x12, 0x80, 0xc7, 0x2e, 0xa2,
+		0x63, 0x2c, 0x5f, 0x94, 0xe8, 0xe7, 0x45, 0x2c, 0xff, 0xa1, 0x49, 0xb3, 0x31, 0xc4, 0xec, 0xe2,
+		0x3c, 0x76, 0xf4, 0x53, 0x6f, 0x9c, 0x92, 0xd8, 0xbc, 0x64, 0x9b, 0x43, 0x93, 0x71, 0x05, 0x52,
+		0x2c, 0x84, 0xa6, 0x51, 0xbb, 0x35, 0x34, 0xd5, 0x6e, 0x9d, 0x17, 0x76, 0x2b, 0x23, 0x08, 0x0c,
+		0x1b, 0xdb, 0x7d, 0xe3, 0xf8, 0x84, 0x04, 0xa9, 0xb2, 0xef, 0x99, 0x29, 0x3c, 0x90, 0xb1, 0xcc,
+		0xf6, 0x75, 0xa3, 0xef, 0x1e, 0xc8, 0xb0, 0x22, 0xda, 0x97, 0xec, 0xa7, 0x01, 0x9d, 0x7d, 0xf1,
+		0x04, 0x83, 0x28, 0x23, 0xd6, 0x2b, 0xc6, 0xb6, 0xdd, 0x16, 0x5c, 0x57, 0x45, 0x11, 0x4f, 0xc7,
+		0x6d, 0xa3, 0x39, 0xc0, 0x58, 0x2d, 0xfe, 0x46, 0xaa, 0x83, 0x3f, 0xe5, 0xc9, 0xc2, 0xa6, 0x59,
+		0x51, 0x5f, 0x62, 0xd5, 0x48, 0xa4, 0x65, 0x38, 0x7a, 0xbb, 0xc3, 0xf2, 0xbd, 0x92, 0xaa, 0x28,
+		0xfa, 0xba, 0xfb, 0xd9, 0xb8, 0xdf, 0x2f, 0x2c, 0x81, 0x6c, 0xf5, 0x8c, 0x7e, 0xe0, 0xbe, 0x1b,
+		0x93, 0xd5, 0xdc, 0xef, 0xfd, 0xd2, 0xa3, 0x4b, 0x9c, 0xf1, 0xfc, 0xf4, 0x9d, 0xbd, 0xac, 0xac,
+		0x66, 0x05, 0x06, 0xaf, 0x26, 0xd7, 0x02, 0xc7, 0x30, 0x83, 0x6d, 0xef, 0xbe, 0xfd, 0xd2, 0x08,
+		0x73, 0x0b, 0xe6, 0x7e, 0x31, 0xf7, 0x49, 0x8f, 0xb4, 0xe7, 0x37, 0xe2, 0x0d, 0x77, 0xdf, 0x99,
+		0x0c, 0x25, 0x83, 0xe6, 0xfb, 0xcb, 0x7a, 0xbb, 0x23, 0x7e, 0x57, 0x56, 0xe5, 0x25, 0xb2, 0xea,
+		0xc6, 0x60, 0xd8, 0x4f, 0x58, 0x29, 0x93, 0x64, 0xa4, 0x68, 0x99, 0xad, 0x60, 0xb8, 0x85, 0x34,
+		0x20, 0xe6, 0x58, 0xd7, 0x0d, 0x93, 0x33, 0xe9, 0x50, 0xf2, 0x3d, 0xfa, 0xf8, 0x0c, 0xa7, 0x45,
+		0x76, 0x41, 0x6e, 0x19, 0x1d, 0x63, 0x97, 0xb2, 0xd2, 0xde, 0xd3, 0xf1, 0x52, 0x63, 0xec, 0x2e,
+		0xac, 0x9f, 0xac, 0x4b, 0xb5, 0x4e, 0x89, 0x92, 0xcd, 0xe0, 0x43, 0x67, 0x71, 0x7e, 0x24, 0x3f,
+		0x61, 0xfc, 0x3e, 0xc9, 0xf4, 0xeb, 0x2c, 0x3f, 0x09, 0x94, 0xb0, 0x81, 0xb9, 0x6d, 0x99, 0xf4,
+		0xc7, 0xa1, 0xb8, 0x77, 0x95, 0x60, 0xc7, 0x76, 0x6e, 0x3d, 0x3f, 0xb6, 0xdb, 0x84, 0x8c, 0x07,
+		0x4a, 0x57, 0x51, 0xf2, 0xb0, 0xab, 0x68, 0xde, 0x25, 0x80, 0x20, 0xf8, 0x2b, 0x52, 0xde, 0x3a,
+		0x75, 0x8f, 0xf6, 0xa7, 0xae, 0x78, 0xff, 0x60, 0x7c, 0x04, 0x48, 0x07, 0x16, 0xbb, 0x6d, 0x53,
+		0xb3, 0x8d, 0xce, 0x8e, 0xc6, 0x39, 0x87, 0x74, 0x53, 0x77, 0x61, 0xa6, 0x17, 0xba, 0x6d, 0xb3,
+		0x6e, 0x74, 0x76, 0xca, 0x2e, 0x59, 0xf2, 0x1e, 0x38, 0xe1, 0xb1, 0xc3, 0x32, 0xb5, 0x3d, 0xab,
+		0xd3, 0xd2, 0xfa, 0xc6, 0x8e, 0xd6, 0xa4, 0x6f, 0x1f, 0xa5, 0x29, 0x13, 0x8f, 0xb9, 0x20, 0x35,
+		0xf3, 0xb2, 0xd5, 0x69, 0xa9, 0xc6, 0x4e, 0x09, 0x9b, 0x31, 0x2e, 0xee, 0x61, 0xb7, 0x5b, 0x98,
+		0x12, 0x10, 0xc6, 0x7c, 0x58, 0xb7, 0xb2, 0xda, 0xa2, 0xb7, 0x7d, 0x51, 0x2d, 0x7b, 0x63, 0x61,
+		0xb7, 0xaa, 0x23, 0xf4, 0x9a, 0x89, 0xd7, 0x15, 0x7b, 0x35, 0xfd, 0xe1, 0x8f, 0x9e, 0x9a, 0xe3,
+		0xcb, 0x7c, 0x4e, 0xd9, 0xa4, 0xcf, 0x7a, 0xf0, 0x15, 0x6a, 0xd8, 0xe4, 0x3c, 0x24, 0x75, 0x51,
+		0x60, 0x17, 0x4a, 0x0e, 0x58, 0xe1, 0x1e, 0x28, 0x53, 0x1c, 0xaf, 0xfd, 0xd1, 0xb2, 0xa4, 0xfc,
+		0xb8, 0x04, 0xb1, 0xf2, 0xd5, 0x4d, 0xbd, 0xdd, 0x27, 0x15, 0x7c, 0xd9, 0x4d, 0xc8, 0xfa, 0xac,
+		0x6a, 0xc3, 0x5b, 0x1e, 0xbc, 0x1e, 0xc9, 0x8c, 0xbf, 0x6d, 0x7b, 0x20, 0x99, 0xe1, 0x7b, 0xb8,
+		0x43, 0x03, 0xbf, 0x02, 0x71, 0xd6, 0x4b, 0x9b, 0x3c, 0x07, 0xd1, 0x1e, 0xfe, 0x91, 0x93, 0x02,
+		0xef, 0xbc, 0x8d, 0xae, 0x11, 0x0a, 0xef, 0x97, 0x28, 0x86, 0xa7, 0xfc, 0xb5, 0x04, 0x50, 0xbe,
+		0x7a, 0xb5, 0xd1, 0x6f, 0xf7, 0x3a, 0x86, 0x73, 0xb7, 0x86, 0xbd, 0x06, 0x47, 0xbc, 0x61, 0xdb,
+		0xfd, 0xe6, 0xcc, 0x43, 0x5f, 0x74, 0xd1, 0xea, 0xfd, 0xe6, 0x58, 0x6a, 0x2d, 0xdb, 0x71, 0xa9,
+		0x85, 0x67, 0xa6, 0x56, 0xb6, 0x9d, 0xf1, 0xbc, 0x7c, 0x11, 0x52, 0xde, 0xf0, 0x6d, 0x52, 0x85,
+		0x84, 0xc3, 0xff, 0xe6, 0x2c, 0x55, 0x26, 0xb3, 0x54, 0xa0, 0xf9, 0xd9, 0xea, 0xa2, 0x2b, 0x7f,
+		0x83, 0x9c, 0xf5, 0xd6, 0xd1, 0x3b, 0x4a, 0xa0, 0x70, 0x83, 0xe0, 0x0a, 0xfc, 0x6e, 0x18, 0x40,
+		0x9c, 0xd6, 0x10, 0x6b, 0x3f, 0x1c, 0xc2, 0x5f, 0x26, 0xe6, 0xeb, 0xfc, 0x1d, 0xcb, 0x89, 0x2d,
+		0x88, 0x1b, 0xa6, 0xd3, 0x6f, 0x1b, 0xe2, 0xa8, 0xf0, 0xf1, 0x49, 0x13, 0x3e, 0x66, 0x2c, 0x15,
+		0xd3, 0xe9, 0xef, 0xfb, 0xa7, 0x5f, 0xd0, 0x1a, 0x62, 0xc5, 0x6f, 0x84, 0x21, 0x37, 0x09, 0x1d,
+		0xd5, 0x5f, 0xb3, 0x6f, 0xd0, 0x0a, 0x2d, 0x10, 0x4e, 0xcf, 0x88, 0x6a, 0xbe, 0x33, 0xa9, 0x80,
+		0xf6, 0x1e, 0x4a, 0x17, 0x82, 0xde, 0x99, 0x81, 0x97, 0xf1, 0x28, 0xd0, 0xbd, 0xc9, 0x80, 0xac,
+		0xc8, 0x8f, 0xdf, 0xd6, 0x3b, 0x3a, 0x5e, 0xaa, 0x0c, 0xdf, 0x85, 0x8d, 0x44, 0x24, 0xdd, 0x17,
+		0x19, 0x4d, 0x72, 0x15, 0xe2, 0x82, 0x7c, 0xe4, 0x2e, 0x90, 0x17, 0xc4, 0xf0, 0xa6, 0x84, 0x7f,
+		0x7f, 0xa1, 0xe6, 0x4e, 0x44, 0x4d, 0xb9, 0x75, 0xd5, 0xd6, 0xb4, 0x0d, 0x2c, 0x76, 0xe0, 0x06,
+		0xe6, 0xb3, 0x2a, 0xff, 0x59, 0x18, 0xf3, 0x03, 0x5a, 0x7f, 0x0b, 0x27, 0xef, 0xab, 0x01, 0xd8,
+		0x02, 0x47, 0xe5, 0x9b, 0x8b, 0xdc, 0x05, 0x85, 0x91, 0x64, 0xf4, 0xca, 0xb6, 0xf3, 0x95, 0x9c,
+		0xc1, 0x7f, 0x1b, 0x82, 0xb4, 0x7f, 0x06, 0xff, 0x16, 0xec, 0x76, 0x64, 0xc3, 0x53, 0x6f, 0x2c,
+		0x93, 0xfd, 0xe1, 0x49, 0xea, 0x6d, 0x44, 0xb6, 0x67, 0xd0, 0x6b, 0xbf, 0x1f, 0x85, 0x18, 0x4f,
+		0x2d, 0xab, 0x8d, 0x98, 0xcd, 0xd2, 0xb4, 0xfb, 0xc4, 0xf3, 0xe2, 0x3e, 0xf1, 0x58, 0xab, 0xf9,
+		0x01, 0x40, 0xf3, 0x4f, 0x0b, 0xe4, 0xab, 0xe1, 0xf1, 0x06, 0x7a, 0xdb, 0x5e, 0x76, 0x35, 0xa6,
+		0xec, 0x21, 0x98, 0xa7, 0xc3, 0x11, 0x06, 0x2f, 0x0e, 0x54, 0x58, 0x0d, 0x79, 0x14, 0xc8, 0x9e,
+		0x1b, 0x48, 0xd1, 0x3c, 0x66, 0x20, 0xdc, 0x82, 0xd7, 0x22, 0xc0, 0xf1, 0xe1, 0x07, 0xcb, 0x6c,
+		0x69, 0xec, 0xc5, 0x4f, 0xfe, 0x0c, 0x2b, 0xd6, 0x94, 0xb1, 0x82, 0x7c, 0x3d, 0x33, 0xbe, 0x87,
+		0xfc, 0x78, 0xee, 0x06, 0xad, 0x1d, 0x6e, 0x51, 0xfc, 0xe5, 0x1b, 0xa7, 0xf2, 0xfb, 0x7a, 0xb7,
+		0xb3, 0xaa, 0x8c, 0x21, 0xa9, 0x50, 0x63, 0x3c, 0xe8, 0xff, 0x93, 0x27, 0x60, 0xc9, 0xee, 0xe8,
+		0xf6, 0x9e, 0xd6, 0x37, 0x5a, 0x6d, 0x9b, 0x9d, 0xe8, 0x0a, 0x0f, 0x29, 0xa1, 0x2e, 0xd2, 0x36,
+		0x35, 0xd0, 0x44, 0x76, 0x60, 0x7e, 0x7b, 0xd0, 0x37, 0xb5, 0x9d, 0xbe, 0x4e, 0x7f, 0x29, 0x94,
+		0x25, 0xfc, 0x14, 0x0b, 0x5f, 0xca, 0xfa, 0x65, 0xf3, 0x95, 0x46, 0xba, 0x17, 0x39, 0x59, 0xf2,
+		0x0c, 0xe4, 0xf4, 0x6d, 0xdb, 0xea, 0x0c, 0x1c, 0x43, 0x1b, 0xb6, 0xe6, 0x93, 0x74, 0x4d, 0x1f,
+		0x15, 0xed, 0xeb, 0x01, 0xab, 0x9e, 0xec, 0x40, 0xde, 0x37, 0x76, 0x1e, 0x9c, 0x69, 0x5a, 0x56,
+		0xa7, 0x65, 0xbd, 0x22, 0xdc, 0xa5, 0xd9, 0xa5, 0x28, 0xe7, 0xd1, 0x62, 0x31, 0x99, 0x12, 0xa7,
+		0xb4, 0x7a, 0x5a, 0x28, 0x82, 0x5b, 0x6f, 0xfe, 0xdc, 0x99, 0x13, 0xbe, 0x51, 0xde, 0x74, 0xc3,
+		0x93, 0x4c, 0x96, 0x95, 0x9f, 0x95, 0x80, 0x78, 0x3d, 0x74, 0x93, 0xf1, 0xd7, 0x69, 0x8a, 0x36,
+		0xaf, 0x75, 0xb3, 0xf1, 0x27, 0x7a, 0xa5, 0x02, 0x32, 0xe0, 0xc7, 0xf9, 0xb4, 0xcf, 0xfb, 0xbc,
+		0x3d, 0x51, 0x5c, 0xbd, 0x1f, 0xf3, 0x50, 0xee, 0x0a, 0x3e, 0x41, 0x1b, 0x58, 0x8a, 0x1c, 0xc9,
+		0x55, 0x6c, 0x73, 0xca, 0x1b, 0x12, 0xa6, 0xd1, 0x0c, 0x2d, 0x5f, 0xb7, 0xdb, 0x4d, 0x20, 0x7d,
+		0x5f, 0x23, 0x5d, 0x02, 0xe2, 0x80, 0xfb, 0xce, 0xb4, 0xc1, 0x42, 0x7f, 0xb8, 0xf5, 0xcb, 0xb5,
+		0xc1, 0xf3, 0x27, 0x73, 0x7f, 0x47, 0xc2, 0x7b, 0x56, 0xad, 0xd1, 0x29, 0xa9, 0x43, 0xda, 0xdf,
+		0x17, 0x3e, 0xaa, 0x77, 0xcd, 0x32, 0x2a, 0xff, 0x80, 0x02, 0x44, 0x70, 0x2c, 0x42, 0x4d, 0xb0,
+		0x60, 0xe9, 0x13, 0x33, 0x73, 0xc9, 0x3d, 0x08, 0x1a, 0xa7, 0x3b, 0x23, 0x74, 0xb2, 0xbe, 0x33,
+		0x04, 0x91, 0x4d, 0xcb, 0xea, 0x90, 0x6f, 0x94, 0x60, 0xc1, 0xb4, 0x1c, 0x0d, 0x95, 0x8b, 0xd1,
+		0xd2, 0x78, 0xc0, 0x86, 0x6d, 0x3f, 0x57, 0x0f, 0xc7, 0xbd, 0xcf, 0xbf, 0x71, 0x6a, 0x94, 0xd4,
+		0xb8, 0x37, 0x8e, 0xb3, 0xa6, 0xe5, 0x14, 0x29, 0x50, 0x83, 0xc2, 0x90, 0x57, 0x60, 0x3e, 0xf8,
+		0x7d, 0xb6, 0x67, 0xa9, 0x87, 0xfe, 0xfe, 0xfc, 0xd4, 0x6f, 0xa7, 0xb7, 0x7d, 0x1f, 0x66, 0x2f,
+		0x8d, 0xfe, 0x39, 0x4e, 0xee, 0xbf, 0x90, 0x60, 0xd1, 0x55, 0xe8, 0x25, 0x9c, 0x09, 0x13, 0xad,
+		0x8f, 0xf1, 0x06, 0xbe, 0x74, 0x68, 0x03, 0x9f, 0x59, 0x68, 0x9c, 0xa8, 0xff, 0x6a, 0x5d, 0xc6,
+		0xab, 0xbe, 0xcc, 0xee, 0x5c, 0x1e, 0xc3, 0x24, 0x06, 0xdc, 0xbf, 0xf0, 0x61, 0x8a, 0x1d, 0xfa,
+		0x33, 0x61, 0xbd, 0x7e, 0xdb, 0x74, 0x78, 0xea, 0xc2, 0x11, 0xde, 0xfc, 0xbc, 0xb1, 0x7f, 0xd1,
+		0x6b, 0xe4, 0x22, 0x7a, 0x0d, 0xe4, 0xab, 0xc3, 0x09, 0x8e, 0x15, 0x88, 0x1f, 0x36, 0x57, 0xd2,
+		0x2f, 0x37, 0x1c, 0xf7, 0xcc, 0x2f, 0x4b, 0x00, 0x5e, 0x90, 0x0f, 0x1f, 0xa9, 0x29, 0xd6, 0x36,
+		0xca, 0x5a, 0xbd, 0x51, 0x68, 0x6c, 0xd5, 0x83, 0xaf, 0xf3, 0x8b, 0x27, 0x6d, 0xec, 0x9e, 0xd1,
+		0x6c, 0xef, 0xb4, 0x8d, 0x16, 0x79, 0x10, 0x96, 0x82, 0xd0, 0x58, 0xaa, 0x94, 0x65, 0x29, 0x9f,
+		0xbe, 0x75, 0x7b, 0x39, 0xc1, 0xbc, 0x12, 0x03, 0x1f, 0x04, 0x3c, 0x32, 0x0a, 0x87, 0x2f, 0xfb,
+		0x87, 0xf2, 0xf3, 0xb7, 0x6e, 0x2f, 0x27, 0x5d, 0xf7, 0x85, 0x28, 0x40, 0xfc, 0x90, 0x9c, 0x5e,
+		0x38, 0x0f, 0xb7, 0x6e, 0x2f, 0xc7, 0x98, 0x70, 0xe5, 0x23, 0xf8, 0x70, 0xcd, 0x99, 0x4f, 0x4a,
+		0x98, 0xf6, 0xc5, 0x92, 0x70, 0x55, 0x43, 0xb7, 0xa9, 0xd2, 0x3b, 0xb1, 0xa9, 0x56, 0x6b, 0x6a,
+		0xb5, 0x71, 0x4d, 0x53, 0x2b, 0x85, 0x7a, 0x6d, 0x63, 0x68, 0x00, 0xf7, 0xde, 0xba, 0xbd, 0x7c,
+		0x3c, 0x88, 0xe4, 0x1f, 0xce, 0x53, 0x70, 0x74, 0x18, 0xff, 0x4a, 0xa1, 0xba, 0x46, 0x07, 0x94,
+		0xbb, 0x75, 0x7b, 0x79, 0x29, 0x88, 0x7a, 0x85, 0xc5, 0x52, 0xdf, 0x03, 0xf9, 0x61, 0xac, 0x46,
+		0x6d, 0xbd, 0x58, 0x6f, 0xd4, 0x36, 0x2a, 0x65, 0x39, 0x94, 0xbf, 0xe7, 0xd6, 0xed, 0xe5, 0x5c,
+		0x10, 0xb3, 0x61, 0x75, 0xb7, 0x6d, 0xc7, 0x32, 0xdd, 0xc1, 0x7c, 0x2d, 0x40, 0xd5, 0x14, 0xbb,
+		0x28, 0xc9, 0xc3, 0xd1, 0xea, 0xc6, 0x45, 0xb5, 0x50, 0x6a, 0x54, 0x87, 0x87, 0x30, 0xd4, 0x56,
+		0xae, 0x6d, 0x15, 0xd7, 0x2a, 0x1a, 0xbe, 0xfb, 0xc3, 0xb2, 0x1e, 0x02, 0x6d, 0x2f, 0x6c, 0x34,
+		0xaa, 0xeb, 0x15, 0x39, 0x54, 0xbc, 0x38, 0xf1, 0xdc, 0xed, 0xdd, 0x07, 0xae, 0x41, 0x6f, 0xf3,
+		0x0a, 0x1c, 0xbe, 0xfd, 0xdf, 0x01, 0x00, 0x7b, 0xb2, 0x79, 0x36, 0x78, 0xa9, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2299,6 +2526,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if this.SlashRedistribution != that1.SlashRedistribution {
+		return false
+	}
+	if !this.BurnFraction.Equal(that1.BurnFraction) {
+		return false
+	}
+	if this.AbsoluteMaxDelegations != that1.AbsoluteMaxDelegations {
+		return false
+	}
+	if this.CommissionChangeCooldown != that1.CommissionChangeCooldown {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2355,6 +2594,36 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ValidatorCredential) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorCredential)
+	if !ok {
+		that2, ok := that.(ValidatorCredential)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !bytes.Equal(this.CredentialHash, that1.CredentialHash) {
+		return false
+	}
+	if !bytes.Equal(this.SigningKeyFingerprint, that1.SigningKeyFingerprint) {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2574,6 +2843,11 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxDelegations))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.UnbondingIds) > 0 {
 		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
 		var j4 int
@@ -3192,6 +3466,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeCooldown):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x52
+	if m.AbsoluteMaxDelegations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.AbsoluteMaxDelegations))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.BurnFraction.Size()
+		i -= size
+		if _, err := m.BurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.SlashRedistribution {
+		i--
+		if m.SlashRedistribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3224,12 +3531,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3411,6 +3718,50 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningKeyFingerprint) > 0 {
+		i -= len(m.SigningKeyFingerprint)
+		copy(dAtA[i:], m.SigningKeyFingerprint)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SigningKeyFingerprint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CredentialHash) > 0 {
+		i -= len(m.CredentialHash)
+		copy(dAtA[i:], m.CredentialHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.CredentialHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3578,6 +3929,9 @@ func (m *Validator) Size() (n int) {
 		}
 		n += 1 + sovStaking(uint64(l)) + l
 	}
+	if m.MaxDelegations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxDelegations))
+	}
 	return n
 }
 
@@ -3804,6 +4158,16 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.SlashRedistribution {
+		n += 2
+	}
+	l = m.BurnFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.AbsoluteMaxDelegations != 0 {
+		n += 1 + sovStaking(uint64(m.AbsoluteMaxDelegations))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeCooldown)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3863,6 +4227,27 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.CredentialHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SigningKeyFingerprint)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorUpdates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4948,6 +5333,25 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegations", wireType)
+			}
+			m.MaxDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6556,6 +6960,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRedistribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashRedistribution = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteMaxDelegations", wireType)
+			}
+			m.AbsoluteMaxDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbsoluteMaxDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7045,6 +7555,156 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialHash == nil {
+				m.CredentialHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeyFingerprint", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeyFingerprint = append(m.SigningKeyFingerprint[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningKeyFingerprint == nil {
+				m.SigningKeyFingerprint = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
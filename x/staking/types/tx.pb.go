@@ -47,6 +47,9 @@ type MsgCreateValidator struct {
 	ValidatorAddress  string                                 `protobuf:"bytes,5,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Pubkey            *types.Any                             `protobuf:"bytes,6,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	Value             types1.Coin                            `protobuf:"bytes,7,opt,name=value,proto3" json:"value"`
+	// max_delegations is the maximum number of delegations accepted by the
+	// validator, 0 meaning up to the absolute_max_delegations param.
+	MaxDelegations uint64 `protobuf:"varint,8,opt,name=max_delegations,json=maxDelegations,proto3" json:"max_delegations,omitempty"`
 }
 
 func (m *MsgCreateValidator) Reset()         { *m = MsgCreateValidator{} }
@@ -123,12 +126,13 @@ var xxx_messageInfo_MsgCreateValidatorResponse proto.InternalMessageInfo
 type MsgEditValidator struct {
 	Description      Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
 	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// We pass a reference to the new commission rate and min self delegation as
-	// it's not mandatory to update. If not updated, the deserialized rate will be
+	// We pass a reference to the new commission rate, min self delegation and
+	// max delegations as it's not mandatory to update. If not updated, the deserialized rate will be
 	// zero with no way to distinguish if an update was intended.
 	// REF: #2373
 	CommissionRate    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
 	MinSelfDelegation *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation,omitempty"`
+	MaxDelegations    *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_delegations,json=maxDelegations,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_delegations,omitempty"`
 }
 
 func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
@@ -366,6 +370,97 @@ func (m *MsgBeginRedelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgPriorityRedelegate defines a SDK message for performing a priority
+// redelegation of coins from a delegator and a jailed or tombstoned source
+// validator to a destination validator.
+type MsgPriorityRedelegate struct {
+	DelegatorAddress    string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorSrcAddress string      `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	ValidatorDstAddress string      `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	Amount              types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// reason is the state of the source validator justifying the priority.
+	Reason    PriorityReason `protobuf:"varint,5,opt,name=reason,proto3,enum=cosmos.staking.v1beta1.PriorityReason" json:"reason,omitempty"`
+	Authority string         `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgPriorityRedelegate) Reset()         { *m = MsgPriorityRedelegate{} }
+func (m *MsgPriorityRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgPriorityRedelegate) ProtoMessage()    {}
+func (*MsgPriorityRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{8}
+}
+func (m *MsgPriorityRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPriorityRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPriorityRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPriorityRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPriorityRedelegate.Merge(m, src)
+}
+func (m *MsgPriorityRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPriorityRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPriorityRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPriorityRedelegate proto.InternalMessageInfo
+
+// MsgPriorityRedelegateResponse defines the Msg/PriorityRedelegate response type.
+type MsgPriorityRedelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgPriorityRedelegateResponse) Reset()         { *m = MsgPriorityRedelegateResponse{} }
+func (m *MsgPriorityRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPriorityRedelegateResponse) ProtoMessage()    {}
+func (*MsgPriorityRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{9}
+}
+func (m *MsgPriorityRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPriorityRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPriorityRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPriorityRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPriorityRedelegateResponse.Merge(m, src)
+}
+func (m *MsgPriorityRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPriorityRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPriorityRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPriorityRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgPriorityRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 // MsgUndelegate defines a SDK message for performing an undelegation from a
 // delegate and a validator.
 type MsgUndelegate struct {
@@ -378,7 +473,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{8}
+	return fileDescriptor_0926ef28816b35ab, []int{10}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +511,7 @@ func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
 func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateResponse) ProtoMessage()    {}
 func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{9}
+	return fileDescriptor_0926ef28816b35ab, []int{11}
 }
 func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +563,7 @@ func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbonding
 func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{10}
+	return fileDescriptor_0926ef28816b35ab, []int{12}
 }
 func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +602,7 @@ func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelU
 func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{11}
+	return fileDescriptor_0926ef28816b35ab, []int{13}
 }
 func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,6 +631,85 @@ func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgAttestValidatorIdentity defines a SDK message for attesting the
+// verifiable credential of a validator identity.
+type MsgAttestValidatorIdentity struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// credential_hash is the SHA-256 digest of the JSON-LD credential document.
+	CredentialHash []byte `protobuf:"bytes,2,opt,name=credential_hash,json=credentialHash,proto3" json:"credential_hash,omitempty"`
+}
+
+func (m *MsgAttestValidatorIdentity) Reset()         { *m = MsgAttestValidatorIdentity{} }
+func (m *MsgAttestValidatorIdentity) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestValidatorIdentity) ProtoMessage()    {}
+func (*MsgAttestValidatorIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgAttestValidatorIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestValidatorIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestValidatorIdentity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestValidatorIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestValidatorIdentity.Merge(m, src)
+}
+func (m *MsgAttestValidatorIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestValidatorIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestValidatorIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestValidatorIdentity proto.InternalMessageInfo
+
+// MsgAttestValidatorIdentityResponse defines the Msg/AttestValidatorIdentity
+// response type.
+type MsgAttestValidatorIdentityResponse struct {
+}
+
+func (m *MsgAttestValidatorIdentityResponse) Reset()         { *m = MsgAttestValidatorIdentityResponse{} }
+func (m *MsgAttestValidatorIdentityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestValidatorIdentityResponse) ProtoMessage()    {}
+func (*MsgAttestValidatorIdentityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgAttestValidatorIdentityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestValidatorIdentityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestValidatorIdentityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestValidatorIdentityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestValidatorIdentityResponse.Merge(m, src)
+}
+func (m *MsgAttestValidatorIdentityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestValidatorIdentityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestValidatorIdentityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestValidatorIdentityResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: cosmos-sdk 0.47
@@ -552,7 +726,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{12}
+	return fileDescriptor_0926ef28816b35ab, []int{16}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +780,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{13}
+	return fileDescriptor_0926ef28816b35ab, []int{17}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,10 +818,14 @@ func init() {
 	proto.RegisterType((*MsgDelegateResponse)(nil), "cosmos.staking.v1beta1.MsgDelegateResponse")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegate")
 	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegateResponse")
+	proto.RegisterType((*MsgPriorityRedelegate)(nil), "cosmos.staking.v1beta1.MsgPriorityRedelegate")
+	proto.RegisterType((*MsgPriorityRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgPriorityRedelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
 	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegation")
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgAttestValidatorIdentity)(nil), "cosmos.staking.v1beta1.MsgAttestValidatorIdentity")
+	proto.RegisterType((*MsgAttestValidatorIdentityResponse)(nil), "cosmos.staking.v1beta1.MsgAttestValidatorIdentityResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 }
@@ -655,76 +833,88 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1100 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x89, 0x21, 0x13, 0x92, 0x34, 0x9b, 0xa4, 0xb5, 0x97, 0x62, 0x57, 0xdb, 0xd0,
-	0x44, 0x81, 0xac, 0x69, 0x40, 0xfc, 0x30, 0x11, 0x22, 0xae, 0x5b, 0x51, 0xc0, 0x52, 0xb4, 0xa1,
-	0x3d, 0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0xec, 0xdd, 0xd9, 0xee, 0x8c, 0xa3, 0xfa, 0x86,
-	0x38, 0x21, 0x2e, 0x54, 0x88, 0x03, 0x17, 0xa4, 0x1e, 0x39, 0xe6, 0xd0, 0x03, 0xff, 0x00, 0x52,
-	0xc5, 0xa9, 0xea, 0x09, 0x71, 0x68, 0x51, 0x72, 0x08, 0xff, 0x01, 0x57, 0xb4, 0xbb, 0xb3, 0x3f,
-	0x6d, 0xaf, 0x37, 0x55, 0x7b, 0x80, 0x4b, 0xbc, 0x79, 0xf3, 0xbd, 0x6f, 0x66, 0xbe, 0x6f, 0xde,
-	0xcc, 0x03, 0x15, 0x05, 0x13, 0x03, 0x93, 0x2a, 0xa1, 0xb0, 0xab, 0x9b, 0x5a, 0xf5, 0xf0, 0x6a,
-	0x1b, 0x51, 0x78, 0xb5, 0x4a, 0xef, 0x4a, 0x96, 0x8d, 0x29, 0xe6, 0xcf, 0x7b, 0x00, 0x89, 0x01,
-	0x24, 0x06, 0x10, 0x4a, 0x1a, 0xc6, 0x5a, 0x0f, 0x55, 0x5d, 0x54, 0xbb, 0x7f, 0x50, 0x85, 0xe6,
-	0xc0, 0x4b, 0x11, 0x2a, 0xc9, 0x21, 0xaa, 0x1b, 0x88, 0x50, 0x68, 0x58, 0x0c, 0xb0, 0xa2, 0x61,
-	0x0d, 0xbb, 0x9f, 0x55, 0xe7, 0x8b, 0x45, 0x4b, 0xde, 0x4c, 0x2d, 0x6f, 0x80, 0x4d, 0xeb, 0x0d,
-	0x95, 0xd9, 0x2a, 0xdb, 0x90, 0xa0, 0x60, 0x89, 0x0a, 0xd6, 0x4d, 0x36, 0xbe, 0x36, 0x66, 0x17,
-	0xfe, 0xa2, 0x3d, 0xd4, 0x05, 0x86, 0x32, 0x88, 0x83, 0x70, 0x7e, 0xd8, 0xc0, 0x12, 0x34, 0x74,
-	0x13, 0x57, 0xdd, 0xbf, 0x5e, 0x48, 0xfc, 0x61, 0x06, 0xf0, 0x4d, 0xa2, 0x5d, 0xb3, 0x11, 0xa4,
-	0xe8, 0x36, 0xec, 0xe9, 0x2a, 0xa4, 0xd8, 0xe6, 0xf7, 0xc0, 0x9c, 0x8a, 0x88, 0x62, 0xeb, 0x16,
-	0xd5, 0xb1, 0x59, 0xe4, 0x2e, 0x71, 0x1b, 0x73, 0xdb, 0x97, 0xa5, 0xd1, 0x1a, 0x49, 0x8d, 0x10,
-	0x5a, 0x9f, 0x7d, 0xf8, 0xa4, 0x92, 0xfb, 0xe5, 0xf4, 0x68, 0x93, 0x93, 0xa3, 0x14, 0xbc, 0x0c,
-	0x80, 0x82, 0x0d, 0x43, 0x27, 0xc4, 0x21, 0xcc, 0xbb, 0x84, 0xeb, 0xe3, 0x08, 0xaf, 0x05, 0x48,
-	0x19, 0x52, 0x44, 0xa2, 0xa4, 0x11, 0x16, 0xbe, 0x07, 0x96, 0x0d, 0xdd, 0x6c, 0x11, 0xd4, 0x3b,
-	0x68, 0xa9, 0xa8, 0x87, 0x34, 0xe8, 0xae, 0x76, 0xea, 0x12, 0xb7, 0x31, 0x5b, 0xdf, 0x71, 0x72,
-	0xfe, 0x7c, 0x52, 0xb9, 0xa2, 0xe9, 0xb4, 0xd3, 0x6f, 0x4b, 0x0a, 0x36, 0x98, 0xd8, 0xec, 0x67,
-	0x8b, 0xa8, 0xdd, 0x2a, 0x1d, 0x58, 0x88, 0x48, 0x37, 0x4d, 0xfa, 0xf8, 0xc1, 0x16, 0x60, 0xab,
-	0xb9, 0x69, 0x52, 0x79, 0xc9, 0xd0, 0xcd, 0x7d, 0xd4, 0x3b, 0x68, 0x04, 0xb4, 0xfc, 0x75, 0xb0,
-	0xc4, 0x26, 0xc1, 0x76, 0x0b, 0xaa, 0xaa, 0x8d, 0x08, 0x29, 0x4e, 0xbb, 0x73, 0x15, 0x1f, 0x3f,
-	0xd8, 0x5a, 0x61, 0xd9, 0xbb, 0xde, 0xc8, 0x3e, 0xb5, 0x75, 0x53, 0x93, 0xcf, 0x05, 0x29, 0x2c,
-	0xee, 0xd0, 0x1c, 0xfa, 0x3a, 0x07, 0x34, 0x33, 0x93, 0x68, 0x82, 0x14, 0x9f, 0xe6, 0x06, 0x28,
-	0x58, 0xfd, 0x76, 0x17, 0x0d, 0x8a, 0x05, 0x57, 0xcb, 0x15, 0xc9, 0x3b, 0x8d, 0x92, 0x7f, 0x1a,
-	0xa5, 0x5d, 0x73, 0x50, 0x2f, 0xfe, 0x1e, 0x32, 0x2a, 0xf6, 0xc0, 0xa2, 0x58, 0xda, 0xeb, 0xb7,
-	0x3f, 0x43, 0x03, 0x99, 0x65, 0xf3, 0x35, 0x30, 0x73, 0x08, 0x7b, 0x7d, 0x54, 0x7c, 0xc9, 0xa5,
-	0x29, 0xf9, 0x96, 0x38, 0x47, 0x30, 0xe2, 0x87, 0x1e, 0x73, 0xd6, 0x4b, 0xa9, 0xdd, 0xfe, 0xf6,
-	0x7e, 0x25, 0xf7, 0xf7, 0xfd, 0x4a, 0xee, 0x9b, 0xd3, 0xa3, 0xcd, 0x61, 0x71, 0xdc, 0xe8, 0xd0,
-	0x5e, 0xbf, 0x3b, 0x3d, 0xda, 0x7c, 0x2d, 0xe2, 0xc0, 0xf0, 0xe9, 0x13, 0x2f, 0x02, 0x61, 0x38,
-	0x2a, 0x23, 0x62, 0x61, 0x93, 0x20, 0xf1, 0xd7, 0x29, 0x70, 0xae, 0x49, 0xb4, 0xeb, 0xaa, 0x4e,
-	0x5f, 0xe4, 0x81, 0x1d, 0xe9, 0x53, 0xfe, 0xcc, 0x3e, 0x41, 0xb0, 0x18, 0x9e, 0xd8, 0x96, 0x0d,
-	0x29, 0x62, 0xe7, 0xf3, 0xfd, 0x8c, 0x67, 0xb3, 0x81, 0x94, 0xc8, 0xd9, 0x6c, 0x20, 0x45, 0x5e,
-	0x50, 0x62, 0xe5, 0xc1, 0x77, 0x46, 0x97, 0xc1, 0xf4, 0x99, 0xa6, 0xc9, 0x52, 0x02, 0xb5, 0x8f,
-	0x62, 0x86, 0x8f, 0xb4, 0xf6, 0xd5, 0xb8, 0xb5, 0x31, 0x97, 0x44, 0x01, 0x14, 0x93, 0xb1, 0xc0,
-	0xd6, 0x1f, 0xf3, 0x60, 0xae, 0x49, 0x34, 0x36, 0x1b, 0x1a, 0x5d, 0x6e, 0xdc, 0xf3, 0x29, 0xb7,
-	0xb3, 0xdb, 0xb8, 0x03, 0x0a, 0xd0, 0xc0, 0x7d, 0x93, 0xba, 0xee, 0x65, 0xad, 0x13, 0x96, 0x53,
-	0xfb, 0x20, 0xbd, 0x50, 0x1c, 0xdd, 0xce, 0xc7, 0x75, 0xf3, 0x65, 0x10, 0x57, 0xc1, 0x72, 0xe4,
-	0xdf, 0x40, 0xad, 0x7f, 0xf2, 0xee, 0xbd, 0x5d, 0x47, 0x9a, 0x6e, 0xca, 0x48, 0x7d, 0xce, 0xa2,
-	0x7d, 0x0e, 0x56, 0x43, 0xd1, 0x88, 0xad, 0x64, 0x16, 0x6e, 0x39, 0x48, 0xdb, 0xb7, 0x95, 0x91,
-	0x6c, 0x2a, 0xa1, 0x01, 0xdb, 0x54, 0x66, 0xb6, 0x06, 0xa1, 0xc3, 0x4e, 0x4c, 0x3f, 0x83, 0x13,
-	0x1f, 0x4f, 0x76, 0x22, 0x71, 0x39, 0x25, 0x24, 0x16, 0x2d, 0xf7, 0x72, 0x4a, 0x44, 0x7d, 0x5f,
-	0x78, 0xd9, 0x2d, 0x77, 0xab, 0x87, 0x9c, 0x7a, 0x69, 0x39, 0x0d, 0x01, 0xbb, 0x8b, 0x84, 0xa1,
-	0xfb, 0xf9, 0x0b, 0xbf, 0x5b, 0xa8, 0xcf, 0x3b, 0xeb, 0xbc, 0xf7, 0xb4, 0xc2, 0x79, 0x6b, 0x5d,
-	0x08, 0x19, 0x1c, 0x8c, 0xf8, 0x53, 0x1e, 0xcc, 0x37, 0x89, 0x76, 0xcb, 0x54, 0xff, 0x8f, 0xb5,
-	0xf1, 0xe1, 0x64, 0x47, 0x8a, 0x71, 0x47, 0x42, 0x21, 0xc4, 0x2e, 0x58, 0x8d, 0x05, 0x5e, 0xa8,
-	0x0f, 0x4f, 0xf3, 0xe0, 0xa2, 0xf3, 0x2e, 0x41, 0x53, 0x41, 0xbd, 0x5b, 0x66, 0x1b, 0x9b, 0xaa,
-	0x6e, 0x6a, 0x93, 0x3a, 0x84, 0xff, 0xa6, 0x2d, 0xfc, 0x3a, 0x58, 0x54, 0x9c, 0x07, 0xd8, 0x91,
-	0xaf, 0x83, 0x74, 0xad, 0xe3, 0xd5, 0xdb, 0x94, 0xbc, 0xe0, 0x87, 0x3f, 0x71, 0xa3, 0xb5, 0x4f,
-	0x27, 0xfb, 0xb7, 0x9e, 0x78, 0xee, 0xc7, 0x09, 0x28, 0x5e, 0x01, 0x6b, 0x69, 0xe3, 0xc1, 0xed,
-	0xf7, 0x1b, 0x07, 0x16, 0x1d, 0xdf, 0x2d, 0x15, 0x52, 0xb4, 0x07, 0x6d, 0x68, 0x10, 0xfe, 0x5d,
-	0x30, 0x0b, 0xfb, 0xb4, 0x83, 0x6d, 0x9d, 0x0e, 0x26, 0x8a, 0x1e, 0x42, 0xf9, 0x5d, 0x50, 0xb0,
-	0x5c, 0x06, 0xd6, 0x94, 0x96, 0xc7, 0x35, 0x0d, 0xde, 0x3c, 0x31, 0xad, 0xbc, 0xc4, 0xda, 0x7b,
-	0xce, 0xd6, 0x43, 0x4a, 0x67, 0xcb, 0x6b, 0x91, 0x2d, 0xdf, 0x0d, 0xfa, 0xf5, 0xc4, 0x9a, 0xc5,
-	0x12, 0xb8, 0x90, 0x08, 0xf9, 0x5b, 0xdc, 0xfe, 0xb9, 0x00, 0xa6, 0x9a, 0x44, 0xe3, 0xef, 0x80,
-	0xc5, 0x64, 0x73, 0xbe, 0x39, 0x6e, 0x85, 0xc3, 0x4d, 0x93, 0xb0, 0x9d, 0x1d, 0x1b, 0xd4, 0x4e,
-	0x17, 0xcc, 0xc7, 0x9b, 0xab, 0x8d, 0x14, 0x92, 0x18, 0x52, 0x78, 0x2b, 0x2b, 0x32, 0x98, 0xec,
-	0x2b, 0xf0, 0x72, 0xf0, 0xe4, 0x5f, 0x4e, 0xc9, 0xf6, 0x41, 0xc2, 0x1b, 0x19, 0x40, 0x01, 0xfb,
-	0x1d, 0xb0, 0x98, 0x7c, 0x22, 0xd3, 0xd4, 0x4b, 0x60, 0x53, 0xd5, 0x1b, 0xf7, 0x02, 0xb4, 0x01,
-	0x88, 0xdc, 0xd4, 0xaf, 0xa7, 0x30, 0x84, 0x30, 0x61, 0x2b, 0x13, 0x2c, 0x98, 0xe3, 0x7b, 0x0e,
-	0x94, 0xc6, 0x5f, 0x43, 0xef, 0xa4, 0x79, 0x3e, 0x2e, 0x4b, 0xd8, 0x79, 0x96, 0xac, 0x60, 0x45,
-	0x1d, 0xf0, 0x4a, 0xac, 0x1a, 0xd7, 0xd3, 0x36, 0x14, 0x01, 0x0a, 0xd5, 0x8c, 0x40, 0x7f, 0x26,
-	0x61, 0xe6, 0x6b, 0xa7, 0xf6, 0xea, 0x37, 0x1e, 0x1e, 0x97, 0xb9, 0x47, 0xc7, 0x65, 0xee, 0xaf,
-	0xe3, 0x32, 0x77, 0xef, 0xa4, 0x9c, 0x7b, 0x74, 0x52, 0xce, 0xfd, 0x71, 0x52, 0xce, 0x7d, 0xf9,
-	0x66, 0x6a, 0xb7, 0x1b, 0x16, 0xa3, 0xdb, 0xf7, 0xb6, 0x0b, 0xee, 0x3b, 0xf0, 0xf6, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa0, 0x87, 0x6e, 0xf3, 0x21, 0x10, 0x00, 0x00,
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x3d, 0x6c, 0xdb, 0x46,
+	0x14, 0x16, 0x25, 0x5b, 0x4d, 0x2e, 0xb1, 0x1c, 0xd3, 0x76, 0x2c, 0xb3, 0x89, 0x64, 0x30, 0x8e,
+	0x6d, 0xb8, 0xb5, 0xd4, 0xb8, 0xff, 0x8a, 0x11, 0xd4, 0x8a, 0x13, 0xd8, 0x6d, 0x05, 0x18, 0x74,
+	0x93, 0xa1, 0x28, 0x20, 0x9c, 0xc8, 0x33, 0x45, 0x58, 0x24, 0x15, 0xde, 0xc9, 0xb0, 0x3a, 0x15,
+	0x9d, 0xda, 0x2e, 0xcd, 0xd0, 0xa1, 0x40, 0x97, 0x2c, 0x05, 0x3a, 0x7a, 0xc8, 0xd6, 0xb9, 0x40,
+	0xd0, 0x29, 0xc8, 0x50, 0x14, 0x1d, 0x92, 0xc2, 0x1e, 0xdc, 0xa5, 0x73, 0xd7, 0x82, 0x7f, 0x47,
+	0x52, 0x14, 0x29, 0x2a, 0x8d, 0x87, 0x16, 0x5d, 0x62, 0xe5, 0xdd, 0xf7, 0xde, 0x3d, 0x7e, 0xef,
+	0xbb, 0x77, 0xef, 0x40, 0x51, 0xd4, 0xb1, 0xaa, 0xe3, 0x32, 0x26, 0x70, 0x4f, 0xd1, 0xe4, 0xf2,
+	0xfe, 0xb5, 0x06, 0x22, 0xf0, 0x5a, 0x99, 0x1c, 0x94, 0xda, 0x86, 0x4e, 0x74, 0xf6, 0xa2, 0x0d,
+	0x28, 0x39, 0x80, 0x92, 0x03, 0xe0, 0x66, 0x65, 0x5d, 0x97, 0x5b, 0xa8, 0x6c, 0xa1, 0x1a, 0x9d,
+	0xdd, 0x32, 0xd4, 0xba, 0xb6, 0x0b, 0x57, 0xec, 0x5d, 0x22, 0x8a, 0x8a, 0x30, 0x81, 0x6a, 0xdb,
+	0x01, 0x4c, 0xc9, 0xba, 0xac, 0x5b, 0x3f, 0xcb, 0xe6, 0x2f, 0xc7, 0x3a, 0x6b, 0xef, 0x54, 0xb7,
+	0x17, 0x9c, 0x6d, 0xed, 0xa5, 0x82, 0x93, 0x65, 0x03, 0x62, 0x44, 0x53, 0x14, 0x75, 0x45, 0x73,
+	0xd6, 0xe7, 0x23, 0xbe, 0xc2, 0x4d, 0xda, 0x46, 0xcd, 0x38, 0x28, 0x15, 0x9b, 0x08, 0xf3, 0x8f,
+	0xb3, 0x30, 0x01, 0x55, 0x45, 0xd3, 0xcb, 0xd6, 0xbf, 0xb6, 0x89, 0xff, 0x71, 0x14, 0xb0, 0x35,
+	0x2c, 0xdf, 0x34, 0x10, 0x24, 0xe8, 0x2e, 0x6c, 0x29, 0x12, 0x24, 0xba, 0xc1, 0x6e, 0x83, 0x73,
+	0x12, 0xc2, 0xa2, 0xa1, 0xb4, 0x89, 0xa2, 0x6b, 0x79, 0x66, 0x8e, 0x59, 0x3a, 0xb7, 0x7a, 0xa5,
+	0xd4, 0x9f, 0xa3, 0xd2, 0x86, 0x07, 0xad, 0x9e, 0x7d, 0xf4, 0xb4, 0x98, 0xfa, 0xe1, 0xe4, 0x70,
+	0x99, 0x11, 0xfc, 0x21, 0x58, 0x01, 0x00, 0x51, 0x57, 0x55, 0x05, 0x63, 0x33, 0x60, 0xda, 0x0a,
+	0xb8, 0x18, 0x15, 0xf0, 0x26, 0x45, 0x0a, 0x90, 0x20, 0xec, 0x0f, 0xea, 0x8b, 0xc2, 0xb6, 0xc0,
+	0xa4, 0xaa, 0x68, 0x75, 0x8c, 0x5a, 0xbb, 0x75, 0x09, 0xb5, 0x90, 0x0c, 0xad, 0x6c, 0x33, 0x73,
+	0xcc, 0xd2, 0xd9, 0xea, 0x9a, 0xe9, 0xf3, 0xdb, 0xd3, 0xe2, 0x82, 0xac, 0x90, 0x66, 0xa7, 0x51,
+	0x12, 0x75, 0xd5, 0x21, 0xdb, 0xf9, 0xb3, 0x82, 0xa5, 0xbd, 0x32, 0xe9, 0xb6, 0x11, 0x2e, 0x6d,
+	0x69, 0xe4, 0xc9, 0xc3, 0x15, 0xe0, 0x64, 0xb3, 0xa5, 0x11, 0x61, 0x42, 0x55, 0xb4, 0x1d, 0xd4,
+	0xda, 0xdd, 0xa0, 0x61, 0xd9, 0x5b, 0x60, 0xc2, 0xd9, 0x44, 0x37, 0xea, 0x50, 0x92, 0x0c, 0x84,
+	0x71, 0x7e, 0xc4, 0xda, 0x2b, 0xff, 0xe4, 0xe1, 0xca, 0x94, 0xe3, 0xbd, 0x6e, 0xaf, 0xec, 0x10,
+	0x43, 0xd1, 0x64, 0xe1, 0x02, 0x75, 0x71, 0xec, 0x66, 0x98, 0x7d, 0x97, 0x67, 0x1a, 0x66, 0x74,
+	0x50, 0x18, 0xea, 0xe2, 0x86, 0xb9, 0x0d, 0xb2, 0xed, 0x4e, 0x63, 0x0f, 0x75, 0xf3, 0x59, 0x8b,
+	0xcb, 0xa9, 0x92, 0xad, 0xc6, 0x92, 0xab, 0xc6, 0xd2, 0xba, 0xd6, 0xad, 0xe6, 0x7f, 0xf6, 0x22,
+	0x8a, 0x46, 0xb7, 0x4d, 0xf4, 0xd2, 0x76, 0xa7, 0xf1, 0x01, 0xea, 0x0a, 0x8e, 0x37, 0x5b, 0x01,
+	0xa3, 0xfb, 0xb0, 0xd5, 0x41, 0xf9, 0x97, 0xac, 0x30, 0xb3, 0x6e, 0x49, 0x4c, 0x09, 0xfa, 0xea,
+	0xa1, 0x04, 0x2a, 0x6b, 0xbb, 0xb0, 0x8b, 0x60, 0x5c, 0x85, 0x07, 0x3e, 0xea, 0x71, 0xfe, 0xcc,
+	0x1c, 0xb3, 0x34, 0x22, 0xe4, 0x54, 0x78, 0xe0, 0x31, 0x87, 0x2b, 0x77, 0xbf, 0x78, 0x50, 0x4c,
+	0xfd, 0xf1, 0xa0, 0x98, 0xfa, 0xfc, 0xe4, 0x70, 0x39, 0xcc, 0xa2, 0x65, 0x0d, 0x91, 0xf2, 0xd5,
+	0xc9, 0xe1, 0xf2, 0x65, 0x5f, 0xa9, 0xc2, 0x32, 0xe5, 0x2f, 0x01, 0x2e, 0x6c, 0x15, 0x10, 0x6e,
+	0xeb, 0x1a, 0x46, 0xfc, 0x77, 0x23, 0xe0, 0x42, 0x0d, 0xcb, 0xb7, 0x24, 0x85, 0x9c, 0xa6, 0xb2,
+	0xfb, 0x16, 0x34, 0x3d, 0x74, 0x41, 0x21, 0x18, 0xf7, 0xa4, 0x5d, 0x37, 0x20, 0x41, 0x8e, 0x90,
+	0xdf, 0x49, 0x28, 0xe2, 0x0d, 0x24, 0xfa, 0x44, 0xbc, 0x81, 0x44, 0x21, 0x27, 0x06, 0xce, 0x11,
+	0xdb, 0xec, 0x7f, 0x5e, 0x46, 0x86, 0xda, 0x26, 0xd1, 0x59, 0x81, 0x61, 0x65, 0x8c, 0xfe, 0xc3,
+	0x5d, 0x7a, 0x35, 0x75, 0x23, 0xa0, 0xa9, 0xbe, 0xea, 0x79, 0x39, 0xa8, 0x9e, 0x80, 0x10, 0x78,
+	0x0e, 0xe4, 0x7b, 0x6d, 0x54, 0x39, 0xdf, 0xa4, 0xc1, 0xb9, 0x1a, 0x96, 0x9d, 0xed, 0x50, 0xff,
+	0xa3, 0xcf, 0xbc, 0x98, 0xa3, 0x3f, 0xbc, 0x52, 0xd6, 0x40, 0x16, 0xaa, 0x7a, 0x47, 0x23, 0xf9,
+	0xcc, 0x10, 0x67, 0xd6, 0xf1, 0xa9, 0xbc, 0x1b, 0x7f, 0x16, 0x4d, 0xde, 0x2e, 0x06, 0x79, 0x73,
+	0x69, 0xe0, 0xa7, 0xc1, 0xa4, 0xef, 0xbf, 0x94, 0xad, 0xbf, 0xd2, 0xd6, 0x1d, 0x52, 0x45, 0xb2,
+	0xa2, 0x09, 0x48, 0x7a, 0xc1, 0xa4, 0x7d, 0x08, 0xa6, 0x3d, 0xd2, 0xb0, 0x21, 0x26, 0x26, 0x6e,
+	0x92, 0xba, 0xed, 0x18, 0x62, 0xdf, 0x68, 0x12, 0x26, 0x34, 0x5a, 0x26, 0x71, 0xb4, 0x0d, 0x4c,
+	0xc2, 0x95, 0x18, 0x79, 0x8e, 0x4a, 0xbc, 0x37, 0xb8, 0x12, 0x3d, 0xfd, 0xaf, 0x87, 0x62, 0xbe,
+	0x0d, 0xb8, 0xb0, 0xd5, 0xad, 0x0b, 0x2b, 0x58, 0x1d, 0xa5, 0xdd, 0x42, 0xe6, 0x81, 0xa9, 0x9b,
+	0xc3, 0x89, 0xd3, 0xee, 0xb8, 0xd0, 0x5d, 0xf1, 0x91, 0x3b, 0xb9, 0x54, 0xc7, 0xcc, 0x3c, 0xef,
+	0x3f, 0x2b, 0x32, 0x76, 0xae, 0x39, 0x2f, 0x82, 0x89, 0xe1, 0xff, 0xcc, 0x80, 0xe9, 0x1a, 0x96,
+	0xb7, 0x0d, 0x45, 0x37, 0x14, 0xd2, 0xfd, 0xbf, 0xdc, 0x89, 0xcb, 0xcd, 0xde, 0x00, 0x59, 0x03,
+	0x41, 0xac, 0x6b, 0x56, 0x2b, 0xcc, 0xad, 0x2e, 0x44, 0x5d, 0x3a, 0x1e, 0xb9, 0x26, 0x5a, 0x70,
+	0xbc, 0xd8, 0xb7, 0xc0, 0x59, 0xd8, 0x21, 0x4d, 0x6b, 0x29, 0x9f, 0x1d, 0x90, 0xbf, 0x07, 0xad,
+	0x5c, 0xf7, 0xcb, 0xcc, 0xb3, 0x9b, 0xf2, 0x9a, 0x0b, 0xca, 0x2b, 0x5c, 0x55, 0x1e, 0x83, 0xcb,
+	0x7d, 0x17, 0x4e, 0x55, 0x64, 0xdf, 0xa6, 0xc1, 0x58, 0x0d, 0xcb, 0x77, 0x34, 0xe9, 0xbf, 0xd8,
+	0x80, 0xaf, 0x0f, 0x3e, 0xf6, 0xf9, 0x60, 0x5d, 0x3c, 0x22, 0xf8, 0x3d, 0x30, 0x1d, 0x30, 0x9c,
+	0x6a, 0x1d, 0x9e, 0xa5, 0xc1, 0x25, 0x73, 0xbe, 0x82, 0x9a, 0x88, 0x5a, 0x77, 0xb4, 0x86, 0xae,
+	0x49, 0x8a, 0x26, 0x0f, 0x1a, 0x89, 0xff, 0x9d, 0x65, 0x31, 0x87, 0x59, 0xd1, 0x40, 0xd6, 0x77,
+	0xd5, 0x9b, 0x48, 0x91, 0x9b, 0xf6, 0x29, 0xcf, 0x08, 0x39, 0xd7, 0xbc, 0x69, 0x59, 0x2b, 0xef,
+	0x0f, 0xae, 0xdf, 0x62, 0xcf, 0xd8, 0x1a, 0x45, 0x20, 0xbf, 0x00, 0xe6, 0xe3, 0xd6, 0xe9, 0x15,
+	0xfb, 0x0b, 0x63, 0x75, 0xfa, 0x75, 0x42, 0x10, 0xf6, 0xe6, 0x95, 0x2d, 0x09, 0x69, 0x44, 0x21,
+	0xdd, 0xfe, 0x04, 0x32, 0x43, 0x13, 0x68, 0x53, 0x60, 0x05, 0x85, 0xad, 0x7a, 0x13, 0xe2, 0xa6,
+	0x55, 0x85, 0xf3, 0x42, 0xce, 0x33, 0x6f, 0x42, 0xdc, 0xac, 0x6c, 0x0e, 0x9e, 0xbd, 0xae, 0x06,
+	0x29, 0x88, 0xc8, 0x9c, 0x9f, 0x07, 0x7c, 0xf4, 0x2a, 0xfd, 0xfc, 0x9f, 0x18, 0x30, 0x6e, 0xca,
+	0xbe, 0x2d, 0x41, 0x82, 0xb6, 0xa1, 0x01, 0x55, 0x1c, 0x6c, 0x87, 0x4c, 0xe2, 0x76, 0xc8, 0xae,
+	0x83, 0x6c, 0xdb, 0x8a, 0xe0, 0x3c, 0x42, 0x0b, 0x91, 0x6d, 0xd8, 0x42, 0x05, 0xa4, 0x62, 0x3b,
+	0x56, 0xde, 0x0e, 0x77, 0xd2, 0x79, 0xdf, 0xe7, 0x1e, 0xd0, 0xf7, 0x79, 0x4f, 0xce, 0xfc, 0x2c,
+	0x98, 0xe9, 0x31, 0xb9, 0x9f, 0xb8, 0xfa, 0xfd, 0x19, 0x90, 0xa9, 0x61, 0x99, 0xbd, 0x07, 0xc6,
+	0x7b, 0x1f, 0xe3, 0xcb, 0x51, 0x19, 0x86, 0xdf, 0x3e, 0xdc, 0x6a, 0x72, 0x2c, 0x6d, 0x1d, 0x7b,
+	0x60, 0x2c, 0xf8, 0x46, 0x5a, 0x8a, 0x09, 0x12, 0x40, 0x72, 0xaf, 0x25, 0x45, 0xd2, 0xcd, 0x3e,
+	0x01, 0x67, 0xe8, 0x58, 0x7d, 0x25, 0xc6, 0xdb, 0x05, 0x71, 0xaf, 0x24, 0x00, 0xd1, 0xe8, 0xf7,
+	0xc0, 0x78, 0xef, 0x18, 0x1a, 0xc7, 0x5e, 0x0f, 0x96, 0x5b, 0x4d, 0x8e, 0xa5, 0x5b, 0x7e, 0x0a,
+	0xd8, 0x3e, 0xd3, 0xd0, 0x4a, 0x4c, 0xa4, 0x30, 0x9c, 0x7b, 0x73, 0x28, 0x38, 0xdd, 0xbb, 0x01,
+	0x80, 0xef, 0x92, 0xbc, 0x1a, 0x13, 0xc4, 0x83, 0x71, 0x2b, 0x89, 0x60, 0x74, 0x8f, 0xaf, 0x19,
+	0x30, 0x1b, 0x7d, 0x03, 0xbc, 0x11, 0xa7, 0xb7, 0x28, 0x2f, 0x6e, 0xed, 0x79, 0xbc, 0x68, 0x46,
+	0x4d, 0x70, 0x3e, 0xd0, 0x09, 0x16, 0xe3, 0x3e, 0xc8, 0x07, 0xe4, 0xca, 0x09, 0x81, 0x74, 0xa7,
+	0x2f, 0x19, 0x30, 0x13, 0xd5, 0x73, 0xe3, 0xb4, 0x12, 0xe1, 0xc3, 0x55, 0x86, 0xf7, 0x71, 0x73,
+	0xe1, 0x46, 0x3f, 0x33, 0x7b, 0x50, 0xf5, 0xf6, 0xa3, 0xa3, 0x02, 0xf3, 0xf8, 0xa8, 0xc0, 0xfc,
+	0x7e, 0x54, 0x60, 0xee, 0x1f, 0x17, 0x52, 0x8f, 0x8f, 0x0b, 0xa9, 0x5f, 0x8f, 0x0b, 0xa9, 0x8f,
+	0x5f, 0x8d, 0x7d, 0x56, 0x7b, 0x4d, 0xc9, 0x7a, 0x60, 0x37, 0xb2, 0xd6, 0x38, 0xf0, 0xfa, 0xdf,
+	0x03, 0x00, 0x9f, 0x3f, 0x6d, 0x60, 0x19, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -749,6 +939,10 @@ type MsgClient interface {
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error)
+	// PriorityRedelegate defines a method for performing a redelegation away
+	// from a jailed or tombstoned validator, executed by the authority, which is
+	// not subject to the MaxEntries limit.
+	PriorityRedelegate(ctx context.Context, in *MsgPriorityRedelegate, opts ...grpc.CallOption) (*MsgPriorityRedelegateResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
@@ -761,6 +955,9 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AttestValidatorIdentity defines a method for a validator operator to
+	// attest the verifiable credential of its identity.
+	AttestValidatorIdentity(ctx context.Context, in *MsgAttestValidatorIdentity, opts ...grpc.CallOption) (*MsgAttestValidatorIdentityResponse, error)
 }
 
 type msgClient struct {
@@ -807,6 +1004,15 @@ func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate,
 	return out, nil
 }
 
+func (c *msgClient) PriorityRedelegate(ctx context.Context, in *MsgPriorityRedelegate, opts ...grpc.CallOption) (*MsgPriorityRedelegateResponse, error) {
+	out := new(MsgPriorityRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/PriorityRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
 	out := new(MsgUndelegateResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/Undelegate", in, out, opts...)
@@ -834,6 +1040,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AttestValidatorIdentity(ctx context.Context, in *MsgAttestValidatorIdentity, opts ...grpc.CallOption) (*MsgAttestValidatorIdentityResponse, error) {
+	out := new(MsgAttestValidatorIdentityResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/AttestValidatorIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -846,6 +1061,10 @@ type MsgServer interface {
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error)
+	// PriorityRedelegate defines a method for performing a redelegation away
+	// from a jailed or tombstoned validator, executed by the authority, which is
+	// not subject to the MaxEntries limit.
+	PriorityRedelegate(context.Context, *MsgPriorityRedelegate) (*MsgPriorityRedelegateResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
@@ -858,6 +1077,9 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AttestValidatorIdentity defines a method for a validator operator to
+	// attest the verifiable credential of its identity.
+	AttestValidatorIdentity(context.Context, *MsgAttestValidatorIdentity) (*MsgAttestValidatorIdentityResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -876,6 +1098,9 @@ func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (
 func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
 }
+func (*UnimplementedMsgServer) PriorityRedelegate(ctx context.Context, req *MsgPriorityRedelegate) (*MsgPriorityRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriorityRedelegate not implemented")
+}
 func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
@@ -885,6 +1110,9 @@ func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) AttestValidatorIdentity(ctx context.Context, req *MsgAttestValidatorIdentity) (*MsgAttestValidatorIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestValidatorIdentity not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -962,6 +1190,24 @@ func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PriorityRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPriorityRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PriorityRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/PriorityRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PriorityRedelegate(ctx, req.(*MsgPriorityRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUndelegate)
 	if err := dec(in); err != nil {
@@ -1016,6 +1262,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AttestValidatorIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestValidatorIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestValidatorIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/AttestValidatorIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestValidatorIdentity(ctx, req.(*MsgAttestValidatorIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1036,6 +1300,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BeginRedelegate",
 			Handler:    _Msg_BeginRedelegate_Handler,
 		},
+		{
+			MethodName: "PriorityRedelegate",
+			Handler:    _Msg_PriorityRedelegate_Handler,
+		},
 		{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
@@ -1048,6 +1316,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "AttestValidatorIdentity",
+			Handler:    _Msg_AttestValidatorIdentity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1073,6 +1345,11 @@ func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxDelegations))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1185,6 +1462,18 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegations != nil {
+		{
+			size := m.MaxDelegations.Size()
+			i -= size
+			if _, err := m.MaxDelegations.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MinSelfDelegation != nil {
 		{
 			size := m.MinSelfDelegation.Size()
@@ -1407,7 +1696,7 @@ func (m *MsgBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgPriorityRedelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1417,16 +1706,28 @@ func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPriorityRedelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPriorityRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1436,11 +1737,18 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1454,7 +1762,7 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPriorityRedelegateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1464,12 +1772,12 @@ func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPriorityRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPriorityRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1485,7 +1793,7 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1495,21 +1803,16 @@ func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreationHeight))
-		i--
-		dAtA[i] = 0x20
-	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1537,7 +1840,7 @@ func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1547,20 +1850,28 @@ func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1570,18 +1881,23 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1589,18 +1905,25 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1610,12 +1933,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1623,12 +1946,135 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *MsgAttestValidatorIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestValidatorIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestValidatorIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialHash) > 0 {
+		i -= len(m.CredentialHash)
+		copy(dAtA[i:], m.CredentialHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestValidatorIdentityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestValidatorIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestValidatorIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -1660,6 +2106,9 @@ func (m *MsgCreateValidator) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.MaxDelegations != 0 {
+		n += 1 + sovTx(uint64(m.MaxDelegations))
+	}
 	return n
 }
 
@@ -1692,6 +2141,10 @@ func (m *MsgEditValidator) Size() (n int) {
 		l = m.MinSelfDelegation.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MaxDelegations != nil {
+		l = m.MaxDelegations.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1766,6 +2219,47 @@ func (m *MsgBeginRedelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPriorityRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPriorityRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgUndelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1827,6 +2321,32 @@ func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAttestValidatorIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CredentialHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestValidatorIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2119,6 +2639,25 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegations", wireType)
+			}
+			m.MaxDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2356,6 +2895,42 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxDelegations = &v
+			if err := m.MaxDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2886,7 +3461,7 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgPriorityRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2909,10 +3484,10 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPriorityRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPriorityRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2949,7 +3524,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2977,13 +3552,13 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2993,29 +3568,342 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= PriorityReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPriorityRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPriorityRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPriorityRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -3332,6 +4220,172 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAttestValidatorIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestValidatorIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestValidatorIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialHash == nil {
+				m.CredentialHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestValidatorIdentityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestValidatorIdentityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestValidatorIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 // BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
+// A ready plan is delayed until enough of the bonded voting power signaled its readiness.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
@@ -64,6 +65,18 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			return
 		}
 
+		// Delay the upgrade until enough of the bonded voting power signaled its
+		// readiness, so that the chain does not halt waiting for the validators
+		// to switch binaries
+		if !k.IsUpgradeReady(ctx) {
+			logger.Info(
+				fmt.Sprintf("UPGRADE \"%s\" DELAYED at %d: waiting for validators readiness", plan.Name, ctx.BlockHeight()),
+				"ready_fraction", k.GetUpgradeReadyFraction(ctx),
+				"threshold", k.GetParams(ctx).UpgradeReadinessThreshold,
+			)
+			return
+		}
+
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
@@ -78,6 +91,17 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			panic(upgradeMsg)
 		}
 
+		// Make sure the binary is also the version expected by the plan, if any,
+		// before applying the upgrade
+		versionChecker := k.GetVersionCompatibilityChecker()
+		if err := versionChecker.Check(plan); err != nil {
+			logger.Error(err.Error())
+			panic(err.Error())
+		}
+		if plan.ExpectedVersion() != "" && !versionChecker.IsVersionKnown() {
+			logger.Info(fmt.Sprintf("UPGRADE \"%s\" expects binary version %s but the binary version is unknown, skipping the version check", plan.Name, plan.ExpectedVersion()))
+		}
+
 		// We have an upgrade handler for this upgrade name, so apply the upgrade
 		ctx.Logger().Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
 		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
@@ -9,6 +9,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -22,8 +23,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetestutil "github.com/cosmos/cosmos-sdk/x/upgrade/testutil"
 
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -98,6 +101,65 @@ func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestUpgradeDelayedUntilReady(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+
+	valAddr := sdk.ValAddress("validator___________")
+	stakingKeeper := upgradetestutil.NewMockStakingKeeper(gomock.NewController(t))
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction),
+	}).AnyTimes()
+	stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(10)).AnyTimes()
+	stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	s.keeper.SetStakingKeeper(stakingKeeper)
+	require.NoError(t, s.keeper.SetParams(s.ctx, types.DefaultParams()))
+
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	t.Log("Verify that the upgrade is delayed while the validators are not ready")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+	VerifyNotDone(t, newCtx, "test")
+
+	require.NoError(t, s.keeper.SignalUpgradeReady(newCtx, valAddr, "test", "v2.0.0"))
+
+	t.Log("Verify that the upgrade is executed once the validators are ready")
+	newCtx = newCtx.WithBlockHeight(newCtx.BlockHeight() + 1)
+	VerifyDoUpgradeWithCtx(t, newCtx, "test")
+}
+
+func TestUpgradeVersionCompatibility(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	s.keeper.SetVersionCompatibilityChecker(types.VersionCompatibilityChecker{BinaryVersion: "v1.9.0"})
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1, Info: `{"version":"v2.0.0"}`}})
+	require.NoError(t, err)
+
+	t.Log("Verify that a panic happens at the upgrade height if the binary is not the expected version")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+	require.PanicsWithValue(t, "UPGRADE \"test\" at 11 expects binary version v2.0.0 but the running binary is version v1.9.0: switch to the v2.0.0 binary", func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+	VerifyNotDone(t, newCtx, "test")
+
+	t.Log("Verify that the upgrade is applied with the expected binary version")
+	s.keeper.SetVersionCompatibilityChecker(types.VersionCompatibilityChecker{BinaryVersion: "v2.0.0"})
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+	VerifyCleared(t, newCtx)
+}
+
 func VerifyDoUpgrade(t *testing.T) {
 	t.Log("Verify that a panic happens at the upgrade height")
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
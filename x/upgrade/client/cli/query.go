@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetParamsCmd(),
+		GetUpgradeReadinessCmd(),
 	)
 
 	return cmd
@@ -154,3 +156,60 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetParamsCmd returns the query upgrade params command.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "get the upgrade module parameters",
+		Long:  "Gets the current upgrade module parameters",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUpgradeReadinessCmd returns the query upgrade readiness command.
+func GetUpgradeReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness",
+		Short: "get the readiness signals for the scheduled upgrade plan",
+		Long: "Gets the validators that signaled readiness for the currently scheduled upgrade plan,\n" +
+			"along with the fraction of bonded voting power they represent.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UpgradeReadiness(cmd.Context(), &types.QueryUpgradeReadinessRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -31,6 +32,36 @@ func GetTxCmd() *cobra.Command {
 		Short: "Upgrade transaction subcommands",
 	}
 
+	cmd.AddCommand(
+		NewCmdSignalUpgradeReady(),
+	)
+
+	return cmd
+}
+
+// NewCmdSignalUpgradeReady implements a command handler for signaling that a
+// validator runs a binary ready for the currently scheduled upgrade plan.
+func NewCmdSignalUpgradeReady() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-upgrade-ready [plan-name] [software-version]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Signal readiness for the scheduled upgrade plan",
+		Long: "Signal that the validator operated by the --from key runs a binary ready for the scheduled upgrade plan.\n" +
+			"The upgrade only executes once validators holding more than the readiness threshold of voting power have signaled.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSignalUpgradeReady(sdk.ValAddress(clientCtx.GetFromAddress()), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -76,3 +76,25 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(c context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return &types.QueryUpgradeReadinessResponse{Readiness: []types.UpgradeReadiness{}, ReadyFraction: sdk.ZeroDec()}, nil
+	}
+
+	return &types.QueryUpgradeReadinessResponse{
+		PlanName:      plan.Name,
+		Readiness:     k.GetUpgradeReadiness(ctx),
+		ReadyFraction: k.GetUpgradeReadyFraction(ctx),
+	}, nil
+}
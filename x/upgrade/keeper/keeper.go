@@ -27,15 +27,17 @@ import (
 const UpgradeInfoFileName string = "upgrade-info.json"
 
 type Keeper struct {
-	homePath           string                          // root directory of app config
-	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
-	storeKey           storetypes.StoreKey             // key to access x/upgrade store
-	cdc                codec.BinaryCodec               // App-wide binary codec
-	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
-	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
-	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
-	authority          string                          // the address capable of executing and cancelling an upgrade. Usually the gov module account
-	initVersionMap     module.VersionMap               // the module version map at init genesis
+	homePath           string                            // root directory of app config
+	skipUpgradeHeights map[int64]bool                    // map of heights to skip for an upgrade
+	storeKey           storetypes.StoreKey               // key to access x/upgrade store
+	cdc                codec.BinaryCodec                 // App-wide binary codec
+	upgradeHandlers    map[string]types.UpgradeHandler   // map of plan name to upgrade handler
+	versionSetter      xp.ProtocolVersionSetter          // implements setting the protocol version field on BaseApp
+	downgradeVerified  bool                              // tells if we've already sanity checked that this binary version isn't being used against an old state.
+	authority          string                            // the address capable of executing and cancelling an upgrade. Usually the gov module account
+	initVersionMap     module.VersionMap                 // the module version map at init genesis
+	stakingKeeper      types.StakingKeeper               // optional, weighs the readiness signals of validators by their voting power
+	versionChecker     types.VersionCompatibilityChecker // checks the binary version is the one expected by the upgrade plans
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -53,9 +55,30 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
 		authority:          authority,
+		versionChecker:     types.NewVersionCompatibilityChecker(),
 	}
 }
 
+// SetStakingKeeper sets the staking keeper used to weigh the readiness signals
+// of validators. Without it, upgrades are executed regardless of the readiness
+// of validators.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
+// SetVersionCompatibilityChecker sets the checker verifying that the running
+// binary is the version expected by the upgrade plans. It defaults to a checker
+// reading the binary version from version.Version.
+func (k *Keeper) SetVersionCompatibilityChecker(c types.VersionCompatibilityChecker) {
+	k.versionChecker = c
+}
+
+// GetVersionCompatibilityChecker gets the checker verifying that the running
+// binary is the version expected by the upgrade plans.
+func (k *Keeper) GetVersionCompatibilityChecker() types.VersionCompatibilityChecker {
+	return k.versionChecker
+}
+
 // SetVersionSetter sets the interface implemented by baseapp which allows setting baseapp's protocol version field
 func (k *Keeper) SetVersionSetter(vs xp.ProtocolVersionSetter) {
 	k.versionSetter = vs
@@ -207,10 +230,11 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 
 	store := ctx.KVStore(k.storeKey)
 
-	// clear any old IBC state stored by previous plan
+	// clear any old IBC state and readiness signals stored by previous plan
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		k.clearUpgradeReadiness(ctx)
 	}
 
 	bz := k.cdc.MustMarshal(&plan)
@@ -307,13 +331,15 @@ func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears any schedule upgrade and associated IBC states and
+// readiness signals.
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
 	// clear IBC states everytime upgrade plan is removed
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
 	}
+	k.clearUpgradeReadiness(ctx)
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
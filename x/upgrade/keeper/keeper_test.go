@@ -7,6 +7,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -18,8 +19,10 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetestutil "github.com/cosmos/cosmos-sdk/x/upgrade/testutil"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -29,6 +32,7 @@ type KeeperTestSuite struct {
 	key           *storetypes.KVStoreKey
 	baseApp       *baseapp.BaseApp
 	upgradeKeeper *keeper.Keeper
+	stakingKeeper *upgradetestutil.MockStakingKeeper
 	homeDir       string
 	ctx           sdk.Context
 	msgSrvr       types.MsgServer
@@ -55,6 +59,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.upgradeKeeper = keeper.NewKeeper(skipUpgradeHeights, key, s.encCfg.Codec, homeDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	s.upgradeKeeper.SetVersionSetter(s.baseApp)
 
+	ctrl := gomock.NewController(s.T())
+	s.stakingKeeper = upgradetestutil.NewMockStakingKeeper(ctrl)
+	s.upgradeKeeper.SetStakingKeeper(s.stakingKeeper)
+
 	vs := s.upgradeKeeper.GetVersionSetter()
 	s.Require().Equal(vs, s.baseApp)
 
@@ -374,6 +382,48 @@ func (s *KeeperTestSuite) TestLastCompletedUpgradeOrdering() {
 	require.Equal(int64(15), height)
 }
 
+func (s *KeeperTestSuite) TestIsUpgradeReady() {
+	require := s.Require()
+
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(simtestutil.CreateIncrementalAccounts(3))
+	powers := []int64{60, 10, 30}
+	for i, valAddr := range valAddrs {
+		val := stakingtypes.Validator{
+			OperatorAddress: valAddr.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          sdk.TokensFromConsensusPower(powers[i], sdk.DefaultPowerReduction),
+		}
+		s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+	}
+	s.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100)).AnyTimes()
+	s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	require.NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 100}))
+
+	// upgrades are ready without params
+	require.True(s.upgradeKeeper.IsUpgradeReady(s.ctx))
+
+	require.NoError(s.upgradeKeeper.SetParams(s.ctx, types.DefaultParams()))
+	require.False(s.upgradeKeeper.IsUpgradeReady(s.ctx))
+
+	require.NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, valAddrs[0], "test", "v2.0.0"))
+	require.Equal(sdk.NewDecWithPrec(6, 1), s.upgradeKeeper.GetUpgradeReadyFraction(s.ctx))
+	require.False(s.upgradeKeeper.IsUpgradeReady(s.ctx))
+
+	require.NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, valAddrs[1], "test", "v2.0.0"))
+	require.Equal(sdk.NewDecWithPrec(7, 1), s.upgradeKeeper.GetUpgradeReadyFraction(s.ctx))
+	require.True(s.upgradeKeeper.IsUpgradeReady(s.ctx))
+
+	// signaling twice does not count twice
+	require.NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, valAddrs[1], "test", "v2.0.1"))
+	require.Equal(sdk.NewDecWithPrec(7, 1), s.upgradeKeeper.GetUpgradeReadyFraction(s.ctx))
+	require.Len(s.upgradeKeeper.GetUpgradeReadiness(s.ctx), 2)
+
+	// the signals are cleared with the plan
+	s.upgradeKeeper.ClearUpgradePlan(s.ctx)
+	require.Empty(s.upgradeKeeper.GetUpgradeReadiness(s.ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -24,6 +24,12 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return migrateDoneUpgradeKeys(ctx, m.keeper.storeKey)
 }
 
+// Migrate2to3 migrates from version 2 to 3. The default params are set, as the
+// x/upgrade module has no params before version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
 func migrateDoneUpgradeKeys(ctx sdk.Context, storeKey storetypes.StoreKey) error {
 	store := ctx.KVStore(storeKey)
 	oldDoneStore := prefix.NewStore(store, []byte{types.DoneByte})
@@ -49,3 +49,32 @@ func (k msgServer) CancelUpgrade(goCtx context.Context, req *types.MsgCancelUpgr
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SignalUpgradeReady implements the Msg/SignalUpgradeReady Msg service.
+func (k msgServer) SignalUpgradeReady(goCtx context.Context, req *types.MsgSignalUpgradeReady) (*types.MsgSignalUpgradeReadyResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SignalUpgradeReady(ctx, valAddr, req.PlanName, req.SoftwareVersion); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalUpgradeReadyResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
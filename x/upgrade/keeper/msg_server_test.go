@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -115,3 +119,117 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignalUpgradeReady() {
+	valAddr := sdk.ValAddress(s.addrs[0])
+	unknownAddr := sdk.ValAddress("unknown_validator___")
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingtypes.Validator{OperatorAddress: valAddr.String()}).AnyTimes()
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), unknownAddr).Return(nil).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		req       *types.MsgSignalUpgradeReady
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"no upgrade plan",
+			func() {},
+			types.NewMsgSignalUpgradeReady(valAddr, "test", "v2.0.0"),
+			true,
+			"no upgrade plan is scheduled",
+		},
+		{
+			"wrong upgrade plan",
+			func() {
+				s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 100}))
+			},
+			types.NewMsgSignalUpgradeReady(valAddr, "other", "v2.0.0"),
+			true,
+			"upgrade plan other is not the scheduled plan test",
+		},
+		{
+			"unknown validator",
+			func() {},
+			types.NewMsgSignalUpgradeReady(unknownAddr, "test", "v2.0.0"),
+			true,
+			"not found",
+		},
+		{
+			"readiness signaled successfully",
+			func() {},
+			types.NewMsgSignalUpgradeReady(valAddr, "test", "v2.0.0"),
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.malleate()
+			_, err := s.msgSrvr.SignalUpgradeReady(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(
+					[]types.UpgradeReadiness{{ValidatorAddress: valAddr.String(), SoftwareVersion: "v2.0.0"}},
+					s.upgradeKeeper.GetUpgradeReadiness(s.ctx),
+				)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govAccAddr := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgUpdateParams{
+				Authority: s.addrs[0].String(),
+				Params:    types.DefaultParams(),
+			},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"invalid readiness threshold",
+			&types.MsgUpdateParams{
+				Authority: govAccAddr,
+				Params:    types.NewParams(sdk.OneDec()),
+			},
+			true,
+			"upgrade readiness threshold must be less than one",
+		},
+		{
+			"params updated successfully",
+			&types.MsgUpdateParams{
+				Authority: govAccAddr,
+				Params:    types.NewParams(sdk.NewDecWithPrec(5, 1)),
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.UpdateParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.Params, s.upgradeKeeper.GetParams(s.ctx))
+			}
+		})
+	}
+}
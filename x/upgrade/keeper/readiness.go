@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SignalUpgradeReady records that the given validator is ready for the current
+// upgrade plan, which must be the plan of the given name.
+func (k Keeper) SignalUpgradeReady(ctx sdk.Context, valAddr sdk.ValAddress, planName, softwareVersion string) error {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no upgrade plan is scheduled")
+	}
+
+	if plan.Name != planName {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade plan %s is not the scheduled plan %s", planName, plan.Name)
+	}
+
+	if k.stakingKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade readiness signals are not supported without the staking keeper")
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s", valAddr)
+	}
+
+	readiness := types.UpgradeReadiness{ValidatorAddress: valAddr.String(), SoftwareVersion: softwareVersion}
+	ctx.KVStore(k.storeKey).Set(types.UpgradeReadinessKey(valAddr), k.cdc.MustMarshal(&readiness))
+
+	return nil
+}
+
+// GetUpgradeReadiness returns the readiness signals of the validators for the
+// current upgrade plan.
+func (k Keeper) GetUpgradeReadiness(ctx sdk.Context) []types.UpgradeReadiness {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.ReadinessByte})
+	defer iter.Close()
+
+	var signals []types.UpgradeReadiness
+	for ; iter.Valid(); iter.Next() {
+		var readiness types.UpgradeReadiness
+		k.cdc.MustUnmarshal(iter.Value(), &readiness)
+		signals = append(signals, readiness)
+	}
+
+	return signals
+}
+
+// clearUpgradeReadiness deletes the readiness signals of the validators.
+func (k Keeper) clearUpgradeReadiness(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.ReadinessByte})
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetUpgradeReadyFraction returns the fraction of the bonded voting power of
+// the validators which signaled their readiness for the current upgrade plan.
+func (k Keeper) GetUpgradeReadyFraction(ctx sdk.Context) sdk.Dec {
+	if k.stakingKeeper == nil {
+		return sdk.ZeroDec()
+	}
+
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	readyPower := sdk.ZeroInt()
+	for _, readiness := range k.GetUpgradeReadiness(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(readiness.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		validator := k.stakingKeeper.Validator(ctx, valAddr)
+		if validator == nil || !validator.IsBonded() {
+			continue
+		}
+
+		readyPower = readyPower.AddRaw(validator.GetConsensusPower(powerReduction))
+	}
+
+	return sdk.NewDecFromInt(readyPower).QuoInt(totalPower)
+}
+
+// IsUpgradeReady returns true if the fraction of the bonded voting power which
+// signaled its readiness for the current upgrade plan exceeds the
+// UpgradeReadinessThreshold param. Upgrades are always ready when the threshold
+// is zero or when the staking keeper is not set.
+func (k Keeper) IsUpgradeReady(ctx sdk.Context) bool {
+	threshold := k.GetParams(ctx).UpgradeReadinessThreshold
+	if k.stakingKeeper == nil || threshold.IsNil() || threshold.IsZero() {
+		return true
+	}
+
+	return k.GetUpgradeReadyFraction(ctx).GT(threshold)
+}
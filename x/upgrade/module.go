@@ -38,7 +38,7 @@ func init() {
 }
 
 const (
-	consensusVersion uint64 = 2
+	consensusVersion uint64 = 3
 )
 
 var (
@@ -116,10 +116,20 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
-// InitGenesis is ignored, no sense in serializing future upgrades
+// InitGenesis is ignored, no sense in serializing future upgrades. The default
+// params are set, they can then be updated through governance.
 func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	if err := am.keeper.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
 	// set version map automatically if available
 	if versionMap := am.keeper.GetInitVersionMap(); versionMap != nil {
 		// chains can still use a custom init chainer for setting the version map
@@ -169,6 +179,7 @@ func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
 		appmodule.Invoke(PopulateVersionMap),
+		appmodule.Invoke(InvokeSetStakingKeeper),
 	)
 }
 
@@ -229,3 +240,14 @@ func PopulateVersionMap(upgradeKeeper *keeper.Keeper, modules map[string]appmodu
 
 	upgradeKeeper.SetInitVersionMap(module.NewManagerFromMap(modules).GetVersionMap())
 }
+
+// InvokeSetStakingKeeper sets the x/staking keeper used to weigh the upgrade
+// readiness signals of validators, if x/staking is part of the app.
+func InvokeSetStakingKeeper(upgradeKeeper *keeper.Keeper, stakingKeeper types.StakingKeeper) {
+	// all arguments to invokers are optional
+	if upgradeKeeper == nil || stakingKeeper == nil {
+		return
+	}
+
+	upgradeKeeper.SetStakingKeeper(stakingKeeper)
+}
@@ -20,6 +20,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgSoftwareUpgrade{}, "cosmos-sdk/MsgSoftwareUpgrade")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUpgrade{}, "cosmos-sdk/MsgCancelUpgrade")
+	legacy.RegisterAminoMsg(cdc, &MsgSignalUpgradeReady{}, "cosmos-sdk/MsgSignalUpgradeReady")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/upgrade/MsgUpdateParams")
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/upgrade/Params", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -33,6 +36,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSoftwareUpgrade{},
 		&MsgCancelUpgrade{},
+		&MsgSignalUpgradeReady{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weigh the
+// readiness signals of validators by their voting power.
+type StakingKeeper interface {
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI
+	GetLastTotalPower(sdk.Context) math.Int
+	PowerReduction(sdk.Context) math.Int
+}
+
+// ParamsKeeper defines the expected x/params keeper used to look up the
+// subspaces targeted by parameter migrations.
+type ParamsKeeper interface {
+	GetSubspace(moduleName string) (paramstypes.Subspace, bool)
+}
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -26,6 +31,12 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// ParamsByte specifies the Byte under which the module params are stored in the store
+	ParamsByte = 0x4
+
+	// ReadinessByte is a prefix to look up the readiness signals of validators (key) for the current plan
+	ReadinessByte = 0x5
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +53,17 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ParamsKey is the key under which the module params are saved
+func ParamsKey() []byte {
+	return []byte{ParamsByte}
+}
+
+// UpgradeReadinessKey is the key under which the readiness signal of a
+// validator for the current plan is saved
+func UpgradeReadinessKey(valAddr sdk.ValAddress) []byte {
+	return append([]byte{ReadinessByte}, address.MustLengthPrefix(valAddr)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -7,8 +7,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
-	_, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+	_, _, _, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalUpgradeReady{}, &MsgUpdateParams{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalUpgradeReady{}, &MsgUpdateParams{}
 )
 
 // Route implements the LegacyMsg interface.
@@ -66,3 +66,72 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSignalUpgradeReady creates a new MsgSignalUpgradeReady instance.
+func NewMsgSignalUpgradeReady(valAddr sdk.ValAddress, planName, softwareVersion string) *MsgSignalUpgradeReady {
+	return &MsgSignalUpgradeReady{
+		ValidatorAddress: valAddr.String(),
+		PlanName:         planName,
+		SoftwareVersion:  softwareVersion,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSignalUpgradeReady) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "validator address")
+	}
+
+	if len(m.PlanName) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+
+	if len(m.SoftwareVersion) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "software version cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSignalUpgradeReady.
+func (m *MsgSignalUpgradeReady) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -107,3 +107,56 @@ func TestMsgCancelUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSignalUpgradeReady(t *testing.T) {
+	valAddr := sdk.ValAddress("validator")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSignalUpgradeReady
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: "validator",
+				PlanName:         "all-good",
+				SoftwareVersion:  "v1.0.0",
+			},
+			true,
+			"validator address: decoding bech32 failed",
+		},
+		{
+			"empty plan name",
+			types.NewMsgSignalUpgradeReady(valAddr, "", "v1.0.0"),
+			true,
+			"plan name cannot be empty",
+		},
+		{
+			"empty software version",
+			types.NewMsgSignalUpgradeReady(valAddr, "all-good", ""),
+			true,
+			"software version cannot be empty",
+		},
+		{
+			"all good",
+			types.NewMsgSignalUpgradeReady(valAddr, "all-good", "v1.0.0"),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr)}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
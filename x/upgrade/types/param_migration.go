@@ -0,0 +1,68 @@
+package types
+
+import (
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamMigration defines a single x/params value to set during an upgrade.
+type ParamMigration struct {
+	// ModuleName is the name of the subspace owning the parameter.
+	ModuleName string
+	// ParamKey is the key the parameter is registered under in the subspace.
+	ParamKey []byte
+	// NewValue is the value to set, it must match the registered parameter type.
+	NewValue interface{}
+}
+
+// ParamMigrator applies parameter migrations to x/params subspaces as part of
+// an upgrade handler.
+type ParamMigrator struct {
+	paramsKeeper ParamsKeeper
+}
+
+// NewParamMigrator returns a new ParamMigrator instance.
+func NewParamMigrator(paramsKeeper ParamsKeeper) ParamMigrator {
+	return ParamMigrator{paramsKeeper: paramsKeeper}
+}
+
+// Migrate applies the given migrations atomically: either every value is set,
+// or an error is returned and the state is left untouched.
+func (m ParamMigrator) Migrate(ctx sdk.Context, migrations []ParamMigration) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	for _, migration := range migrations {
+		subspace, ok := m.paramsKeeper.GetSubspace(migration.ModuleName)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "params subspace %s", migration.ModuleName)
+		}
+
+		if err := SafeSetParam(cacheCtx, subspace, migration.ParamKey, migration.NewValue); err != nil {
+			return sdkerrors.Wrapf(err, "failed to migrate param %s/%s", migration.ModuleName, migration.ParamKey)
+		}
+	}
+
+	write()
+	return nil
+}
+
+// SafeSetParam sets a parameter value in the given subspace. Contrary to
+// Subspace.Set, it returns an error instead of panicking if the value type does
+// not match the registered parameter type, and it runs the registered
+// validation function before storing the value.
+func SafeSetParam[T any](ctx sdk.Context, subspace paramstypes.Subspace, key []byte, value T) error {
+	if err := subspace.ValidateType(key, value); err != nil {
+		return err
+	}
+
+	// validation functions operate on dereferenced values
+	if err := subspace.Validate(ctx, key, reflect.Indirect(reflect.ValueOf(value)).Interface()); err != nil {
+		return err
+	}
+
+	subspace.Set(ctx, key, value)
+	return nil
+}
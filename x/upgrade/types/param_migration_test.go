@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetestutil "github.com/cosmos/cosmos-sdk/x/upgrade/testutil"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+var (
+	keyMaxEntries = []byte("MaxEntries")
+	keyDenom      = []byte("Denom")
+)
+
+func validateMaxEntries(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max entries must be positive")
+	}
+	return nil
+}
+
+func validateDenom(i interface{}) error {
+	if _, ok := i.(string); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func setupSubspace(t *testing.T) (sdk.Context, paramstypes.Subspace) {
+	t.Helper()
+
+	key := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContext(key, tkey)
+
+	var (
+		maxEntries uint32
+		denom      string
+	)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	subspace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), key, tkey, "testsubspace").
+		WithKeyTable(paramstypes.NewKeyTable(
+			paramstypes.NewParamSetPair(keyMaxEntries, &maxEntries, validateMaxEntries),
+			paramstypes.NewParamSetPair(keyDenom, &denom, validateDenom),
+		))
+
+	return ctx, subspace
+}
+
+func TestSafeSetParam(t *testing.T) {
+	ctx, subspace := setupSubspace(t)
+
+	require.ErrorContains(t, types.SafeSetParam(ctx, subspace, []byte("unknown"), uint32(1)), "not registered")
+	require.ErrorContains(t, types.SafeSetParam(ctx, subspace, keyMaxEntries, uint64(1)), "type mismatch")
+	require.ErrorContains(t, types.SafeSetParam(ctx, subspace, keyMaxEntries, uint32(0)), "max entries must be positive")
+	require.False(t, subspace.Has(ctx, keyMaxEntries))
+
+	require.NoError(t, types.SafeSetParam(ctx, subspace, keyMaxEntries, uint32(7)))
+	var maxEntries uint32
+	subspace.Get(ctx, keyMaxEntries, &maxEntries)
+	require.Equal(t, uint32(7), maxEntries)
+
+	denom := "stake"
+	require.NoError(t, types.SafeSetParam(ctx, subspace, keyDenom, &denom))
+	var got string
+	subspace.Get(ctx, keyDenom, &got)
+	require.Equal(t, denom, got)
+}
+
+func TestParamMigrator(t *testing.T) {
+	ctx, subspace := setupSubspace(t)
+
+	ctrl := gomock.NewController(t)
+	paramsKeeper := upgradetestutil.NewMockParamsKeeper(ctrl)
+	paramsKeeper.EXPECT().GetSubspace("testsubspace").Return(subspace, true).AnyTimes()
+	paramsKeeper.EXPECT().GetSubspace("unknown").Return(paramstypes.Subspace{}, false).AnyTimes()
+
+	migrator := types.NewParamMigrator(paramsKeeper)
+
+	// a failing migration leaves every param untouched
+	err := migrator.Migrate(ctx, []types.ParamMigration{
+		{ModuleName: "testsubspace", ParamKey: keyDenom, NewValue: "stake"},
+		{ModuleName: "testsubspace", ParamKey: keyMaxEntries, NewValue: 7},
+	})
+	require.ErrorContains(t, err, "failed to migrate param testsubspace/MaxEntries")
+	require.False(t, subspace.Has(ctx, keyDenom))
+
+	err = migrator.Migrate(ctx, []types.ParamMigration{
+		{ModuleName: "testsubspace", ParamKey: keyDenom, NewValue: "stake"},
+		{ModuleName: "unknown", ParamKey: keyMaxEntries, NewValue: uint32(7)},
+	})
+	require.ErrorContains(t, err, "params subspace unknown")
+	require.False(t, subspace.Has(ctx, keyDenom))
+
+	err = migrator.Migrate(ctx, []types.ParamMigration{
+		{ModuleName: "testsubspace", ParamKey: keyDenom, NewValue: "stake"},
+		{ModuleName: "testsubspace", ParamKey: keyMaxEntries, NewValue: uint32(7)},
+	})
+	require.NoError(t, err)
+
+	var (
+		denom      string
+		maxEntries uint32
+	)
+	subspace.Get(ctx, keyDenom, &denom)
+	subspace.Get(ctx, keyMaxEntries, &maxEntries)
+	require.Equal(t, "stake", denom)
+	require.Equal(t, uint32(7), maxEntries)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultUpgradeReadinessThreshold is the default fraction of the bonded voting
+// power which must signal its readiness before an upgrade is executed.
+var DefaultUpgradeReadinessThreshold = sdk.NewDec(2).QuoInt64(3)
+
+// NewParams creates a new Params object
+func NewParams(upgradeReadinessThreshold sdk.Dec) Params {
+	return Params{
+		UpgradeReadinessThreshold: upgradeReadinessThreshold,
+	}
+}
+
+// DefaultParams returns the default parameters of the upgrade module
+func DefaultParams() Params {
+	return NewParams(DefaultUpgradeReadinessThreshold)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	return validateUpgradeReadinessThreshold(p.UpgradeReadinessThreshold)
+}
+
+func validateUpgradeReadinessThreshold(v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("upgrade readiness threshold cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("upgrade readiness threshold cannot be negative: %s", v)
+	}
+	// the ready fraction must exceed the threshold, so a threshold of one could
+	// never be reached
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("upgrade readiness threshold must be less than one: %s", v)
+	}
+
+	return nil
+}
@@ -152,3 +152,35 @@ func TestShouldExecute(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanExpectedVersion(t *testing.T) {
+	cases := map[string]struct {
+		info     string
+		expected string
+	}{
+		"no info":         {info: "", expected: ""},
+		"text info":       {info: "upgrade to v2", expected: ""},
+		"url info":        {info: "https://example.com/info.json", expected: ""},
+		"json no version": {info: `{"binaries":{"any":"https://example.com"}}`, expected: ""},
+		"json version":    {info: ` {"version":"v2.0.0","binaries":{"any":"https://example.com"}} `, expected: "v2.0.0"},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.Plan{Name: "test", Height: 10, Info: tc.info}.ExpectedVersion())
+		})
+	}
+}
+
+func TestVersionCompatibilityCheckerCheck(t *testing.T) {
+	plan := types.Plan{Name: "test", Height: 10, Info: `{"version":"v2.0.0"}`}
+
+	require.NoError(t, types.VersionCompatibilityChecker{BinaryVersion: "v2.0.0"}.Check(plan))
+	require.NoError(t, types.VersionCompatibilityChecker{BinaryVersion: "2.0.0"}.Check(plan))
+	require.ErrorContains(t, types.VersionCompatibilityChecker{BinaryVersion: "v1.9.0"}.Check(plan), "switch to the v2.0.0 binary")
+
+	// binaries of unknown version and plans without expected version always pass
+	require.NoError(t, types.VersionCompatibilityChecker{}.Check(plan))
+	require.NoError(t, types.VersionCompatibilityChecker{BinaryVersion: "v1.9.0"}.Check(types.Plan{Name: "test", Height: 10, Info: "v2"}))
+}
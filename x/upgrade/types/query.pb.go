@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -492,6 +496,186 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryUpgradeReadinessRequest is the request type for the
+// Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessRequest struct {
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+// QueryUpgradeReadinessResponse is the response type for the
+// Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessResponse struct {
+	// plan_name is the name of the current upgrade plan.
+	PlanName string `protobuf:"bytes,1,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// readiness is the list of the readiness signals for the plan.
+	Readiness []UpgradeReadiness `protobuf:"bytes,2,rep,name=readiness,proto3" json:"readiness"`
+	// ready_fraction is the fraction of the bonded voting power which signaled
+	// its readiness for the plan.
+	ReadyFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ready_fraction,json=readyFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ready_fraction"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *QueryUpgradeReadinessResponse) GetReadiness() []UpgradeReadiness {
+	if m != nil {
+		return m.Readiness
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +687,10 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.upgrade.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.upgrade.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessResponse")
 }
 
 func init() {
@@ -510,48 +698,63 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0x66, 0x0a, 0x3f, 0x7e, 0xf2, 0x6a, 0xd0, 0x4c, 0x62, 0x59, 0x57, 0x52, 0x71, 0x40, 0x85,
-	0x48, 0x77, 0xa0, 0x5c, 0x0c, 0x46, 0xa3, 0x92, 0x18, 0x31, 0x4a, 0xb4, 0x46, 0x0f, 0x5e, 0x9a,
-	0xa1, 0x3b, 0x69, 0x37, 0xb6, 0x3b, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x2e, 0x9e, 0x3c, 0x9a, 0x18,
-	0xaf, 0xde, 0xbc, 0xf8, 0x97, 0x78, 0x24, 0xf1, 0xe2, 0xc1, 0x83, 0x01, 0xff, 0x04, 0xff, 0x00,
-	0xb3, 0xb3, 0xb3, 0xa4, 0xa5, 0xbb, 0x0b, 0x7a, 0x6a, 0x77, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0x3b,
-	0xdf, 0x6b, 0x81, 0xb4, 0x84, 0xec, 0x09, 0x49, 0xa3, 0xa0, 0x1d, 0x32, 0x97, 0xd3, 0xed, 0xe5,
-	0x4d, 0xae, 0xd8, 0x32, 0xdd, 0x8a, 0x78, 0xb8, 0xe3, 0x04, 0xa1, 0x50, 0x02, 0x57, 0x12, 0x8c,
-	0x63, 0x30, 0x8e, 0xc1, 0xd8, 0xd3, 0x6d, 0x21, 0xda, 0x5d, 0x4e, 0x59, 0xe0, 0x51, 0xe6, 0xfb,
-	0x42, 0x31, 0xe5, 0x09, 0x5f, 0x26, 0x2c, 0x7b, 0x2e, 0x47, 0x39, 0x55, 0xd1, 0x28, 0x72, 0x11,
-	0xa6, 0x9e, 0xc5, 0xad, 0xd6, 0xa2, 0x30, 0xe4, 0xbe, 0x7a, 0xda, 0x65, 0x7e, 0x83, 0x6f, 0x45,
-	0x5c, 0x2a, 0xf2, 0x18, 0xac, 0xe1, 0x92, 0x0c, 0x84, 0x2f, 0x39, 0x5e, 0x82, 0xb1, 0xa0, 0xcb,
-	0x7c, 0x0b, 0xcd, 0xa0, 0xf9, 0x72, 0x7d, 0xda, 0xc9, 0x76, 0xe8, 0x68, 0x8e, 0x46, 0x92, 0x9a,
-	0x69, 0x74, 0x2f, 0x08, 0xba, 0x1e, 0x77, 0xfb, 0x1a, 0x61, 0x0c, 0x63, 0x3e, 0xeb, 0x71, 0x2d,
-	0x36, 0xd1, 0xd0, 0xdf, 0x49, 0xdd, 0x34, 0x1f, 0x80, 0x9b, 0xe6, 0x15, 0x18, 0xef, 0x70, 0xaf,
-	0xdd, 0x51, 0x9a, 0x31, 0xda, 0x30, 0x4f, 0x64, 0x1d, 0x88, 0xe6, 0xbc, 0x48, 0x5c, 0xb8, 0x6b,
-	0x31, 0xda, 0x97, 0x91, 0x7c, 0xae, 0x98, 0xe2, 0x69, 0xb7, 0xcb, 0x50, 0xee, 0x32, 0xa9, 0x9a,
-	0x03, 0x12, 0x10, 0x1f, 0x3d, 0xd4, 0x27, 0xab, 0x25, 0x0b, 0x11, 0x0f, 0x66, 0x0b, 0xa5, 0x8c,
-	0x93, 0x9b, 0x60, 0x99, 0x91, 0xdd, 0x66, 0x2b, 0x85, 0x34, 0x65, 0x8c, 0xb1, 0x4a, 0x33, 0x68,
-	0xfe, 0x6c, 0xa3, 0x12, 0x65, 0x2a, 0xc4, 0x4d, 0x1e, 0x8d, 0x9d, 0x41, 0xe7, 0x4b, 0xe4, 0x36,
-	0xd8, 0xba, 0xd5, 0x13, 0xe1, 0x46, 0x5d, 0xfe, 0x92, 0x87, 0x32, 0xbe, 0xc4, 0x3e, 0xb7, 0x3d,
-	0x5d, 0x68, 0xf6, 0xbd, 0x22, 0x48, 0x8e, 0x36, 0xe2, 0x17, 0xd5, 0x83, 0x4b, 0x99, 0x74, 0xe3,
-	0x70, 0x03, 0xce, 0x19, 0xfe, 0xb6, 0x29, 0x59, 0x68, 0x66, 0x74, 0xbe, 0x5c, 0xbf, 0x9a, 0x77,
-	0x67, 0x03, 0x42, 0x8d, 0xc9, 0xde, 0x80, 0x2e, 0x99, 0x82, 0x0b, 0xc9, 0xbd, 0x44, 0xaa, 0x23,
-	0x42, 0x4f, 0xed, 0xa4, 0x69, 0xa9, 0x43, 0xe5, 0x78, 0xc1, 0x58, 0xb0, 0xe0, 0x7f, 0xe6, 0xba,
-	0x21, 0x97, 0xd2, 0xd8, 0x4f, 0x1f, 0xeb, 0xbf, 0xc7, 0xe1, 0x3f, 0x4d, 0xc2, 0x9f, 0x10, 0x94,
-	0xfb, 0x72, 0x86, 0x69, 0x9e, 0xbb, 0x9c, 0xb0, 0xda, 0x4b, 0xa7, 0x27, 0x24, 0xb6, 0xc8, 0xe2,
-	0xdb, 0x6f, 0xbf, 0x3e, 0x94, 0xae, 0xe1, 0x39, 0x9a, 0xb3, 0x28, 0xad, 0x84, 0xd4, 0x8c, 0xe3,
-	0x8b, 0x3f, 0x23, 0x28, 0xf7, 0x65, 0xf1, 0x04, 0x83, 0xc3, 0x21, 0x3f, 0xc1, 0x60, 0x46, 0xcc,
-	0xc9, 0x8a, 0x36, 0x58, 0xc3, 0x37, 0xf2, 0x0c, 0xb2, 0x84, 0xa4, 0x0d, 0xd2, 0xdd, 0x38, 0x1f,
-	0x7b, 0xf8, 0x07, 0x82, 0x4a, 0x76, 0x68, 0xf1, 0x6a, 0xa1, 0x83, 0xc2, 0xa5, 0xb1, 0x6f, 0xfd,
-	0x13, 0xd7, 0x0c, 0xb2, 0xae, 0x07, 0xb9, 0x8b, 0xef, 0xd0, 0xe2, 0x9f, 0xa4, 0xa1, 0x1d, 0xa2,
-	0xbb, 0x7d, 0x9b, 0xba, 0xf7, 0xae, 0x84, 0xf0, 0x17, 0x04, 0x93, 0x83, 0x49, 0xc7, 0xf5, 0x42,
-	0x6b, 0x99, 0x5b, 0x65, 0xaf, 0xfc, 0x15, 0xc7, 0x8c, 0x41, 0xf5, 0x18, 0x0b, 0xf8, 0x7a, 0xde,
-	0x18, 0xc7, 0x16, 0x0d, 0x7f, 0x44, 0x30, 0x71, 0xb4, 0x0e, 0xb8, 0x56, 0x1c, 0x80, 0x63, 0xfb,
-	0x64, 0x3b, 0xa7, 0x85, 0x1b, 0x77, 0x0b, 0xda, 0xdd, 0x2c, 0xbe, 0x92, 0x9b, 0x96, 0x94, 0x72,
-	0xff, 0xc1, 0xd7, 0x83, 0x2a, 0xda, 0x3f, 0xa8, 0xa2, 0x9f, 0x07, 0x55, 0xf4, 0xfe, 0xb0, 0x3a,
-	0xb2, 0x7f, 0x58, 0x1d, 0xf9, 0x7e, 0x58, 0x1d, 0x79, 0xb5, 0xd8, 0xf6, 0x54, 0x27, 0xda, 0x74,
-	0x5a, 0xa2, 0x97, 0xca, 0x24, 0x1f, 0x35, 0xe9, 0xbe, 0xa6, 0x6f, 0x8e, 0x34, 0xd5, 0x4e, 0xc0,
-	0xe5, 0xe6, 0xb8, 0xfe, 0x0b, 0x59, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x18, 0x7f, 0x99, 0xff,
-	0xc4, 0x06, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xce, 0x64, 0x97, 0xd0, 0xbc, 0xc0, 0x52, 0x86, 0x92, 0xba, 0xee, 0xe2, 0x0d, 0x6e, 0x59,
+	0xb2, 0xdd, 0xc6, 0xde, 0xcd, 0x82, 0x84, 0xca, 0x0f, 0xd1, 0x6d, 0x55, 0x51, 0x04, 0x55, 0x6b,
+	0x04, 0x42, 0x5c, 0xac, 0x59, 0x7b, 0x48, 0x2c, 0xe2, 0x1f, 0xf5, 0xd8, 0x15, 0x51, 0xd5, 0x0b,
+	0x27, 0xb8, 0x21, 0x21, 0xae, 0xdc, 0x90, 0x10, 0x27, 0x84, 0xe0, 0x7f, 0xd8, 0xe3, 0x0a, 0x2e,
+	0x08, 0xa1, 0x15, 0xda, 0x45, 0xe2, 0x1f, 0xe0, 0x0f, 0x40, 0x33, 0x9e, 0x04, 0xe7, 0x87, 0xbd,
+	0x59, 0x2e, 0x89, 0xe7, 0xcd, 0xf7, 0xbd, 0xf7, 0xbd, 0x99, 0xe7, 0x2f, 0x01, 0xdd, 0x09, 0x99,
+	0x1f, 0x32, 0x33, 0x8d, 0x7a, 0x31, 0x71, 0xa9, 0xf9, 0x60, 0x7b, 0x8f, 0x26, 0x64, 0xdb, 0xbc,
+	0x9f, 0xd2, 0x78, 0x68, 0x44, 0x71, 0x98, 0x84, 0xb8, 0x99, 0x61, 0x0c, 0x89, 0x31, 0x24, 0x46,
+	0x3d, 0xd7, 0x0b, 0x7b, 0xa1, 0x80, 0x98, 0xfc, 0x29, 0x43, 0xab, 0xab, 0xbd, 0x30, 0xec, 0x0d,
+	0xa8, 0x49, 0x22, 0xcf, 0x24, 0x41, 0x10, 0x26, 0x24, 0xf1, 0xc2, 0x80, 0xc9, 0xdd, 0xa7, 0x89,
+	0xef, 0x05, 0xa1, 0x29, 0x3e, 0x65, 0xe8, 0x72, 0x81, 0x84, 0x51, 0xb9, 0x0c, 0x75, 0x21, 0x43,
+	0xd9, 0x59, 0x3d, 0xa9, 0x48, 0x2c, 0xf4, 0x0b, 0x70, 0xfe, 0x1e, 0x97, 0x7b, 0x23, 0x8d, 0x63,
+	0x1a, 0x24, 0x77, 0x07, 0x24, 0xb0, 0xe8, 0xfd, 0x94, 0xb2, 0x44, 0x7f, 0x07, 0x94, 0xd9, 0x2d,
+	0x16, 0x85, 0x01, 0xa3, 0x78, 0x0b, 0x96, 0xa3, 0x01, 0x09, 0x14, 0xd4, 0x42, 0xed, 0x46, 0x77,
+	0xd5, 0x98, 0xdf, 0xa5, 0x21, 0x38, 0x02, 0xa9, 0x77, 0x64, 0xa1, 0xeb, 0x51, 0x34, 0xf0, 0xa8,
+	0x9b, 0x2b, 0x84, 0x31, 0x2c, 0x07, 0xc4, 0xa7, 0x22, 0x59, 0xdd, 0x12, 0xcf, 0x7a, 0x17, 0x94,
+	0x59, 0xb8, 0x2c, 0xde, 0x84, 0x5a, 0x9f, 0x7a, 0xbd, 0x7e, 0x22, 0x18, 0x4b, 0x96, 0x5c, 0xe9,
+	0xb7, 0x41, 0x17, 0x9c, 0xf7, 0x33, 0x15, 0xee, 0x0d, 0x8e, 0x0e, 0x58, 0xca, 0xde, 0x4b, 0x48,
+	0x42, 0x47, 0xd5, 0xd6, 0xa0, 0x31, 0x20, 0x2c, 0xb1, 0x27, 0x52, 0x00, 0x0f, 0xbd, 0x25, 0x22,
+	0xd7, 0xaa, 0x0a, 0xd2, 0x3d, 0xb8, 0x54, 0x9a, 0x4a, 0x2a, 0x79, 0x05, 0x14, 0xd9, 0xb2, 0x6b,
+	0x3b, 0x23, 0x88, 0xcd, 0x38, 0x46, 0xa9, 0xb6, 0x50, 0xfb, 0x09, 0xab, 0x99, 0xce, 0xcd, 0xc0,
+	0x8b, 0xbc, 0xbd, 0x7c, 0x06, 0x9d, 0xad, 0xea, 0xaf, 0x83, 0x2a, 0x4a, 0xbd, 0x1b, 0xba, 0xe9,
+	0x80, 0x7e, 0x40, 0x63, 0xc6, 0xaf, 0x3c, 0xa7, 0xd6, 0x17, 0x1b, 0x76, 0xee, 0x88, 0x20, 0x0b,
+	0xdd, 0xe1, 0x07, 0xe5, 0xc3, 0xc5, 0xb9, 0x74, 0xa9, 0xf0, 0x0e, 0x3c, 0x25, 0xf9, 0x0f, 0xe4,
+	0x96, 0x82, 0x5a, 0x4b, 0xed, 0x46, 0xf7, 0x85, 0xa2, 0x3b, 0x9b, 0x48, 0x64, 0xad, 0xf8, 0x13,
+	0x79, 0xf5, 0xf3, 0xf0, 0x6c, 0x76, 0x2f, 0x69, 0xd2, 0x0f, 0x63, 0x2f, 0x19, 0x8e, 0xa6, 0xa5,
+	0x0b, 0xcd, 0xe9, 0x0d, 0x29, 0x41, 0x81, 0xc7, 0x89, 0xeb, 0xc6, 0x94, 0x31, 0x29, 0x7f, 0xb4,
+	0xd4, 0xcf, 0x01, 0x16, 0x9c, 0xbb, 0x24, 0x26, 0xfe, 0xa8, 0x65, 0xfd, 0x43, 0x78, 0x66, 0x22,
+	0x2a, 0xd3, 0x5c, 0x87, 0x5a, 0x24, 0x22, 0x72, 0xe8, 0xb4, 0xc2, 0xa1, 0x13, 0xa8, 0xdd, 0xfa,
+	0xfe, 0xe1, 0x5a, 0xe5, 0xbb, 0xbf, 0x7f, 0xb8, 0x82, 0x2c, 0x49, 0xd4, 0x35, 0x58, 0xcd, 0xdf,
+	0xaa, 0x45, 0x89, 0xeb, 0x05, 0x94, 0x8d, 0x2b, 0xff, 0x83, 0xe0, 0xb9, 0x02, 0x80, 0x14, 0x71,
+	0x11, 0xea, 0x7c, 0x9a, 0xf3, 0x97, 0x71, 0x86, 0x07, 0xf8, 0x55, 0xe0, 0x7b, 0x50, 0x8f, 0x47,
+	0x0c, 0xa5, 0x2a, 0x4e, 0xb9, 0x5d, 0x24, 0x72, 0xba, 0x42, 0x5e, 0xee, 0x7f, 0x59, 0xb0, 0x03,
+	0x2b, 0x7c, 0x31, 0xb4, 0x3f, 0x8e, 0x89, 0xc3, 0xbd, 0x40, 0x59, 0xe2, 0x45, 0x77, 0x5f, 0xe3,
+	0xe8, 0xdf, 0x0f, 0xd7, 0xd6, 0x7b, 0x5e, 0xd2, 0x4f, 0xf7, 0x0c, 0x27, 0xf4, 0xe5, 0x7b, 0x2d,
+	0xbf, 0x3a, 0xcc, 0xfd, 0xc4, 0x4c, 0x86, 0x11, 0x65, 0xc6, 0x4d, 0xea, 0xfc, 0xf2, 0x53, 0x07,
+	0xa4, 0x90, 0x9b, 0xd4, 0xb1, 0x9e, 0x14, 0x39, 0x6f, 0xc9, 0x94, 0xdd, 0x9f, 0xeb, 0xf0, 0x98,
+	0x68, 0x1b, 0x7f, 0x83, 0xa0, 0x91, 0x7b, 0xdd, 0xb1, 0x59, 0x24, 0xbf, 0xc0, 0x33, 0xd4, 0xad,
+	0xc5, 0x09, 0xd9, 0x89, 0xea, 0x57, 0x3f, 0xfb, 0xf5, 0xaf, 0xaf, 0xaa, 0xeb, 0xf8, 0xb2, 0x59,
+	0x60, 0x65, 0x4e, 0x46, 0xb2, 0xf9, 0x31, 0xe3, 0x6f, 0x11, 0x34, 0x72, 0x96, 0x70, 0x82, 0xc0,
+	0x59, 0xaf, 0x51, 0xb7, 0x16, 0x27, 0x48, 0x81, 0x3b, 0x42, 0x60, 0x07, 0x6f, 0x16, 0x09, 0x24,
+	0x19, 0x49, 0x08, 0x34, 0x1f, 0xf2, 0xc9, 0x78, 0x84, 0xff, 0x40, 0xd0, 0x9c, 0xef, 0x1d, 0xf8,
+	0x5a, 0xa9, 0x82, 0x52, 0xef, 0x52, 0x5f, 0xfd, 0x5f, 0x5c, 0xd9, 0xc8, 0x6d, 0xd1, 0xc8, 0x9b,
+	0xf8, 0x0d, 0xb3, 0xfc, 0x47, 0x63, 0xc6, 0xca, 0xcc, 0x87, 0x39, 0xc3, 0x7c, 0xf4, 0x79, 0x15,
+	0xe1, 0xef, 0x11, 0xac, 0x4c, 0x1a, 0x0e, 0xee, 0x96, 0x4a, 0x9b, 0x6b, 0x6e, 0xea, 0xce, 0xa9,
+	0x38, 0xb2, 0x0d, 0x53, 0xb4, 0xb1, 0x81, 0x5f, 0x2c, 0x6a, 0x63, 0xca, 0xef, 0xf0, 0xd7, 0x08,
+	0xea, 0x63, 0x57, 0xc2, 0x9d, 0xf2, 0x01, 0x98, 0xb2, 0x35, 0xd5, 0x58, 0x14, 0x2e, 0xd5, 0x6d,
+	0x08, 0x75, 0x97, 0xf0, 0xf3, 0x85, 0xd3, 0x32, 0x56, 0xf2, 0x05, 0x82, 0x5a, 0xe6, 0x55, 0xf8,
+	0x4a, 0x69, 0x95, 0x09, 0x7b, 0x54, 0x37, 0x17, 0xc2, 0x4a, 0x39, 0xeb, 0x42, 0x4e, 0x0b, 0x6b,
+	0x45, 0x72, 0x32, 0x67, 0xc4, 0x3f, 0x22, 0x38, 0x3b, 0x6d, 0x49, 0xf8, 0xa5, 0x45, 0xa6, 0x6d,
+	0xda, 0x44, 0xd5, 0x97, 0x4f, 0xc9, 0x92, 0x4a, 0xb7, 0x85, 0xd2, 0x4d, 0xbc, 0x71, 0xc2, 0x74,
+	0xda, 0x63, 0x73, 0xdc, 0xbd, 0xb5, 0x7f, 0xa4, 0xa1, 0x83, 0x23, 0x0d, 0xfd, 0x79, 0xa4, 0xa1,
+	0x2f, 0x8f, 0xb5, 0xca, 0xc1, 0xb1, 0x56, 0xf9, 0xed, 0x58, 0xab, 0x7c, 0x74, 0xb5, 0xd4, 0x16,
+	0x3f, 0x1d, 0xe7, 0x16, 0x06, 0xb9, 0x57, 0x13, 0x7f, 0x85, 0x76, 0xfe, 0x1d, 0x00, 0x23, 0xc2,
+	0xc4, 0x84, 0xd0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -585,6 +788,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// Params queries the parameters of the upgrade module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// UpgradeReadiness queries the readiness signals of the validators for the
+	// current upgrade plan.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 }
 
 type queryClient struct {
@@ -641,6 +849,24 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -662,6 +888,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// Params queries the parameters of the upgrade module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// UpgradeReadiness queries the readiness signals of the validators for the
+	// current upgrade plan.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -683,6 +914,12 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -778,6 +1015,42 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -802,6 +1075,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1101,6 +1382,139 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReadyFraction.Size()
+		i -= size
+		if _, err := m.ReadyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Readiness) > 0 {
+		for iNdEx := len(m.Readiness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Readiness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1234,17 +1648,67 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Readiness) > 0 {
+		for _, e := range m.Readiness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ReadyFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1978,6 +2442,339 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Readiness = append(m.Readiness, UpgradeReadiness{})
+			if err := m.Readiness[len(m.Readiness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
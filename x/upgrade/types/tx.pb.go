@@ -214,43 +214,254 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalUpgradeReady is the Msg/SignalUpgradeReady request type.
+type MsgSignalUpgradeReady struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// plan_name is the name of the upgrade plan the validator is ready for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// software_version is the version of the software the validator runs.
+	SoftwareVersion string `protobuf:"bytes,3,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"`
+}
+
+func (m *MsgSignalUpgradeReady) Reset()         { *m = MsgSignalUpgradeReady{} }
+func (m *MsgSignalUpgradeReady) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReady) ProtoMessage()    {}
+func (*MsgSignalUpgradeReady) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalUpgradeReady) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReady) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReady.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReady) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReady.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReady) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReady) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReady.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReady proto.InternalMessageInfo
+
+func (m *MsgSignalUpgradeReady) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReady) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReady) GetSoftwareVersion() string {
+	if m != nil {
+		return m.SoftwareVersion
+	}
+	return ""
+}
+
+// MsgSignalUpgradeReadyResponse is the Msg/SignalUpgradeReady response type.
+type MsgSignalUpgradeReadyResponse struct {
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Reset()         { *m = MsgSignalUpgradeReadyResponse{} }
+func (m *MsgSignalUpgradeReadyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadyResponse) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadyResponse.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReadyResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/upgrade parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalUpgradeReady)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReady")
+	proto.RegisterType((*MsgSignalUpgradeReadyResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReadyResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.upgrade.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.upgrade.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xcd, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x19, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xac, 0xeb, 0xf9, 0x06, 0x2d, 0x59, 0x88,
-	0x01, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x98, 0xce, 0x54, 0x92, 0xe1, 0x92, 0xc2, 0x14, 0x0d, 0x4a,
-	0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0xaa, 0xe2, 0x12, 0xf0, 0x2d, 0x4e, 0x77, 0x4e, 0xcc,
-	0x4b, 0x4e, 0xcd, 0xa1, 0xd0, 0x63, 0x56, 0x7a, 0x98, 0x6e, 0x93, 0x46, 0x75, 0x1b, 0x8a, 0x3d,
-	0x4a, 0x52, 0x5c, 0x12, 0xe8, 0x62, 0x30, 0x77, 0x19, 0xbd, 0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e,
-	0x17, 0x2a, 0xe4, 0xe2, 0x47, 0x0f, 0x77, 0x2d, 0x5c, 0x21, 0x86, 0xe9, 0x4d, 0x29, 0x23, 0xe2,
-	0xd5, 0xc2, 0xac, 0x16, 0xca, 0xe6, 0xe2, 0x45, 0x0d, 0x0f, 0x0d, 0x3c, 0x86, 0xa0, 0xa8, 0x94,
-	0x32, 0x20, 0x56, 0x25, 0xcc, 0x32, 0x29, 0xd6, 0x06, 0x50, 0xe4, 0x3a, 0xb9, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0x2e, 0x34, 0x9d, 0xeb, 0x23, 0x85, 0x69, 0x05, 0x3c, 0x99, 0x97, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x53, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x07, 0x64, 0xf8,
-	0x6f, 0x03, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0x12, 0x4f,
+	0x18, 0xc7, 0xd9, 0x5f, 0x7f, 0x36, 0x32, 0x6a, 0xa0, 0x9b, 0x6a, 0xe9, 0xb6, 0x5d, 0xc8, 0xa6,
+	0x89, 0x48, 0x64, 0xb7, 0x60, 0xd4, 0x84, 0x9e, 0x8a, 0xd1, 0x1b, 0xa6, 0xa1, 0xa9, 0x07, 0x2f,
+	0x64, 0x60, 0xc7, 0x61, 0x53, 0x76, 0x67, 0xdd, 0x19, 0xb0, 0xf4, 0x64, 0x3c, 0x7a, 0xf2, 0x65,
+	0x78, 0xe4, 0xe0, 0xcd, 0xb3, 0x49, 0x8f, 0x4d, 0x4f, 0x9e, 0x8c, 0x81, 0x03, 0x6f, 0xc3, 0xec,
+	0xce, 0xb0, 0xfc, 0x59, 0x40, 0xd4, 0x0b, 0x6c, 0x9e, 0xe7, 0xf3, 0x3c, 0xcf, 0xf7, 0x99, 0xf9,
+	0xee, 0x82, 0x74, 0x83, 0x50, 0x9b, 0x50, 0xa3, 0xed, 0x62, 0x0f, 0x9a, 0xc8, 0xe8, 0x14, 0xea,
+	0x88, 0xc1, 0x82, 0xc1, 0xce, 0x75, 0xd7, 0x23, 0x8c, 0xc8, 0xf7, 0x38, 0xa0, 0x0b, 0x40, 0x17,
+	0x80, 0xb2, 0x89, 0x09, 0x26, 0x01, 0x62, 0xf8, 0x4f, 0x9c, 0x56, 0xb6, 0x39, 0x5d, 0xe3, 0x09,
+	0x51, 0xca, 0x53, 0xfb, 0x0b, 0x26, 0x8d, 0x1a, 0x73, 0x6a, 0x4b, 0x50, 0x36, 0xc5, 0x46, 0xa7,
+	0xe0, 0xff, 0x89, 0xc4, 0x06, 0xb4, 0x2d, 0x87, 0x18, 0xc1, 0x2f, 0x0f, 0x69, 0x5f, 0x25, 0x20,
+	0x57, 0x28, 0x3e, 0x21, 0x6f, 0xd8, 0x3b, 0xe8, 0xa1, 0x53, 0xde, 0x48, 0x7e, 0x02, 0xe2, 0xb0,
+	0xcd, 0x9a, 0xc4, 0xb3, 0x58, 0x37, 0x25, 0x65, 0xa4, 0x6c, 0xbc, 0x9c, 0xba, 0xfe, 0x92, 0xdf,
+	0x14, 0x6a, 0x8e, 0x4c, 0xd3, 0x43, 0x94, 0x9e, 0x30, 0xcf, 0x72, 0x70, 0x75, 0x8c, 0xca, 0x87,
+	0xe0, 0x7f, 0xb7, 0x05, 0x9d, 0xd4, 0x7f, 0x19, 0x29, 0x7b, 0xab, 0xb8, 0xab, 0xcf, 0x5f, 0x5c,
+	0x3f, 0x6e, 0x41, 0xa7, 0x1c, 0xbf, 0xfc, 0x91, 0x8e, 0x7d, 0x1e, 0xf6, 0x72, 0x52, 0x35, 0x28,
+	0x2a, 0x1d, 0x7c, 0x18, 0xf6, 0x72, 0xe3, 0x66, 0x1f, 0x87, 0xbd, 0xdc, 0x1e, 0x6f, 0x90, 0xa7,
+	0xe6, 0x99, 0x11, 0x95, 0xa9, 0xed, 0x02, 0x25, 0x1a, 0xad, 0x22, 0xea, 0x12, 0x87, 0x22, 0xed,
+	0x02, 0x24, 0x2b, 0x14, 0x3f, 0x83, 0x4e, 0x03, 0xb5, 0xfe, 0x71, 0xb1, 0x92, 0x1e, 0xd5, 0xb6,
+	0x33, 0xad, 0x6d, 0x6a, 0x8e, 0xa6, 0x80, 0xd4, 0x6c, 0x2c, 0xd4, 0x35, 0x90, 0xc0, 0x5d, 0x5f,
+	0xb6, 0x85, 0x1d, 0x38, 0x4e, 0x42, 0xb3, 0x2b, 0x3f, 0x07, 0x1b, 0x1d, 0xd8, 0xb2, 0x4c, 0xc8,
+	0x88, 0x57, 0x83, 0x5c, 0xcb, 0x6f, 0x55, 0x26, 0xc3, 0x12, 0x11, 0x97, 0x77, 0x40, 0xdc, 0x3f,
+	0xd0, 0x9a, 0x03, 0x6d, 0x14, 0x5c, 0x45, 0xbc, 0x7a, 0xd3, 0x0f, 0xbc, 0x84, 0x36, 0x92, 0x1f,
+	0x80, 0x24, 0x15, 0x07, 0x56, 0xeb, 0x20, 0x8f, 0x5a, 0xc4, 0x49, 0xad, 0x05, 0x4c, 0x62, 0x14,
+	0x7f, 0xc5, 0xc3, 0xa5, 0x43, 0x7f, 0xe9, 0xa8, 0x22, 0x7f, 0xf9, 0xcc, 0xcc, 0xc5, 0x44, 0x76,
+	0xd1, 0xd2, 0x60, 0x6f, 0x6e, 0x22, 0x3c, 0x86, 0x6f, 0x12, 0x48, 0x54, 0x28, 0x3e, 0x75, 0x4d,
+	0xc8, 0xd0, 0x31, 0xf4, 0xa0, 0x4d, 0xff, 0xda, 0x77, 0x47, 0x60, 0xdd, 0x0d, 0x3a, 0x08, 0xe7,
+	0xa9, 0x0b, 0x9d, 0x17, 0x50, 0x93, 0xde, 0x13, 0x85, 0xa5, 0xa7, 0xd1, 0x1b, 0xde, 0x9f, 0x58,
+	0xf2, 0x3c, 0x7c, 0xe9, 0x66, 0x34, 0x6b, 0xdb, 0x60, 0x6b, 0x26, 0x34, 0x5a, 0xb1, 0x78, 0xbd,
+	0x06, 0xd6, 0x2a, 0x14, 0xcb, 0x6f, 0x41, 0x62, 0xf6, 0x0d, 0xcb, 0x2d, 0x52, 0x18, 0x35, 0xb4,
+	0x52, 0x5c, 0x9d, 0x1d, 0x8d, 0x96, 0xcf, 0xc0, 0x9d, 0x69, 0xe7, 0x67, 0x97, 0x34, 0x99, 0x22,
+	0x95, 0x83, 0x55, 0xc9, 0x70, 0xd8, 0x05, 0x90, 0xe7, 0xb8, 0x39, 0xbf, 0x4c, 0x76, 0x04, 0x57,
+	0x1e, 0xff, 0x11, 0x1e, 0xce, 0x6e, 0x82, 0xdb, 0x53, 0x16, 0xba, 0xbf, 0xa4, 0xcd, 0x24, 0xa8,
+	0x18, 0x2b, 0x82, 0xa3, 0x49, 0xca, 0x8d, 0xf7, 0xbe, 0x61, 0xca, 0x2f, 0x2e, 0xfb, 0xaa, 0x74,
+	0xd5, 0x57, 0xa5, 0x9f, 0x7d, 0x55, 0xfa, 0x34, 0x50, 0x63, 0x57, 0x03, 0x35, 0xf6, 0x7d, 0xa0,
+	0xc6, 0x5e, 0x3f, 0xc4, 0x16, 0x6b, 0xb6, 0xeb, 0x7a, 0x83, 0xd8, 0xe2, 0xbb, 0x6d, 0xcc, 0x75,
+	0x10, 0xeb, 0xba, 0x88, 0xd6, 0xd7, 0x83, 0x2f, 0xf0, 0xa3, 0x5f, 0x03, 0x00, 0x58, 0xbb, 0x55,
+	0x29, 0x3f, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -274,6 +485,12 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReady defines a method for a validator to signal it is ready
+	// for the current upgrade plan.
+	SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams defines a governance operation for updating the x/upgrade
+	// module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -302,6 +519,24 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error) {
+	out := new(MsgSignalUpgradeReadyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -313,6 +548,12 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReady defines a method for a validator to signal it is ready
+	// for the current upgrade plan.
+	SignalUpgradeReady(context.Context, *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams defines a governance operation for updating the x/upgrade
+	// module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +566,12 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalUpgradeReady(ctx context.Context, req *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReady not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +613,42 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalUpgradeReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReady)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, req.(*MsgSignalUpgradeReady))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +661,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalUpgradeReady",
+			Handler:    _Msg_SignalUpgradeReady_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -499,83 +790,267 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSignalUpgradeReady) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSoftwareUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSignalUpgradeReady) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReady) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SoftwareVersion) > 0 {
+		i -= len(m.SoftwareVersion)
+		copy(dAtA[i:], m.SoftwareVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SoftwareVersion)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Plan.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSoftwareUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSignalUpgradeReadyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSignalUpgradeReadyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSignalUpgradeReadyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgSoftwareUpgrade) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSoftwareUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignalUpgradeReady) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SoftwareVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSoftwareUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -859,6 +1334,367 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalUpgradeReady) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReady: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReady: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftwareVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalUpgradeReadyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -222,11 +223,94 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// Params defines the parameters of the upgrade module.
+type Params struct {
+	// upgrade_readiness_threshold is the fraction of the bonded voting power
+	// which must have signaled its readiness before an upgrade is executed.
+	UpgradeReadinessThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=upgrade_readiness_threshold,json=upgradeReadinessThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upgrade_readiness_threshold"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// UpgradeReadiness is the readiness signal of a validator for an upgrade plan.
+type UpgradeReadiness struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// software_version is the version of the software the validator runs.
+	SoftwareVersion string `protobuf:"bytes,2,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"`
+}
+
+func (m *UpgradeReadiness) Reset()         { *m = UpgradeReadiness{} }
+func (m *UpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*UpgradeReadiness) ProtoMessage()    {}
+func (*UpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
+}
+func (m *UpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeReadiness.Merge(m, src)
+}
+func (m *UpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeReadiness proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*Params)(nil), "cosmos.upgrade.v1beta1.Params")
+	proto.RegisterType((*UpgradeReadiness)(nil), "cosmos.upgrade.v1beta1.UpgradeReadiness")
 }
 
 func init() {
@@ -234,41 +318,50 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0xb5, 0x6e, 0x21, 0x17, 0xa1, 0x0a, 0x13, 0xca, 0x35, 0x2a, 0x76, 0x64, 0x31, 0x44,
-	0x15, 0xb5, 0xd5, 0xb2, 0x85, 0x01, 0x91, 0x0c, 0x48, 0x08, 0x44, 0xe5, 0x52, 0x06, 0x96, 0xe8,
-	0x62, 0x5f, 0x1c, 0x0b, 0xfb, 0xce, 0xb2, 0x2f, 0x81, 0x7c, 0x05, 0xa6, 0x8e, 0x1d, 0x33, 0x32,
-	0xa1, 0x0e, 0x7c, 0x88, 0x88, 0xa9, 0x23, 0x13, 0x85, 0x64, 0x28, 0x3b, 0x5f, 0x00, 0xdd, 0x9d,
-	0x5d, 0x45, 0x10, 0x10, 0x43, 0x97, 0xe8, 0xbd, 0x77, 0xef, 0x97, 0xdf, 0x1f, 0xdf, 0xc1, 0x7b,
-	0x3e, 0xcb, 0x13, 0x96, 0xbb, 0xc3, 0x34, 0xcc, 0x70, 0x40, 0xdc, 0xd1, 0x5e, 0x8f, 0x70, 0xbc,
-	0x57, 0xf6, 0x4e, 0x9a, 0x31, 0xce, 0x8c, 0x4d, 0xb5, 0xe5, 0x94, 0xd3, 0x62, 0xab, 0xbe, 0x15,
-	0x32, 0x16, 0xc6, 0xc4, 0x95, 0x5b, 0xbd, 0x61, 0xdf, 0xc5, 0x74, 0xac, 0x20, 0xf5, 0x5a, 0xc8,
-	0x42, 0x26, 0x4b, 0x57, 0x54, 0xc5, 0xd4, 0xfa, 0x1d, 0xc0, 0xa3, 0x84, 0xe4, 0x1c, 0x27, 0x69,
-	0xb1, 0xb0, 0xa5, 0x98, 0xba, 0x0a, 0x59, 0xd0, 0xaa, 0xa3, 0x9b, 0x38, 0x89, 0x28, 0x73, 0xe5,
-	0xaf, 0x1a, 0xd9, 0x3f, 0x01, 0xd4, 0x0f, 0x62, 0x4c, 0x0d, 0x03, 0xea, 0x14, 0x27, 0x04, 0x81,
-	0x06, 0x68, 0x56, 0x3c, 0x59, 0x1b, 0x8f, 0xa0, 0x2e, 0xfe, 0x1d, 0xad, 0x34, 0x40, 0xb3, 0xba,
-	0x5f, 0x77, 0x14, 0xb5, 0x53, 0x52, 0x3b, 0x2f, 0x4b, 0xea, 0xf6, 0xc6, 0xf4, 0xab, 0xa5, 0x1d,
-	0x9f, 0x5b, 0xe0, 0xc3, 0xc5, 0xe9, 0x0e, 0x40, 0xc0, 0x93, 0x40, 0x63, 0x13, 0xae, 0x0f, 0x48,
-	0x14, 0x0e, 0x38, 0x5a, 0x6d, 0x80, 0xe6, 0xaa, 0x57, 0x74, 0x82, 0x2c, 0xa2, 0x7d, 0x86, 0x74,
-	0x45, 0x26, 0x6a, 0xe3, 0x19, 0xbc, 0x5d, 0x84, 0x13, 0x74, 0xfd, 0x38, 0x22, 0x94, 0x77, 0x73,
-	0x8e, 0x39, 0x41, 0x6b, 0x92, 0xbd, 0xf6, 0x07, 0xfb, 0x63, 0x3a, 0x6e, 0xaf, 0x20, 0xe0, 0xdd,
-	0x2a, 0x61, 0x1d, 0x89, 0x3a, 0x14, 0xa0, 0xd6, 0xf6, 0xc9, 0xc4, 0xd2, 0x7e, 0x4c, 0x2c, 0xf0,
-	0xfe, 0xe2, 0x74, 0x67, 0x43, 0xa5, 0xb0, 0x9b, 0x07, 0x6f, 0x5c, 0x61, 0xd6, 0x3e, 0x07, 0xf0,
-	0xce, 0x21, 0xeb, 0xf3, 0xb7, 0x38, 0x23, 0x47, 0x0a, 0x7d, 0x90, 0xb1, 0x94, 0xe5, 0x38, 0x36,
-	0x6a, 0x70, 0x8d, 0x47, 0x3c, 0x2e, 0x93, 0x50, 0x8d, 0xd1, 0x80, 0xd5, 0x80, 0xe4, 0x7e, 0x16,
-	0xa5, 0x3c, 0x62, 0x54, 0x26, 0x52, 0xf1, 0x16, 0x47, 0xc6, 0x43, 0xa8, 0xa7, 0x31, 0xa6, 0xd2,
-	0x69, 0x75, 0x7f, 0xdb, 0x59, 0xfe, 0xc1, 0x1d, 0xc1, 0xdf, 0xae, 0x88, 0xb8, 0x64, 0x54, 0x9e,
-	0x04, 0xb5, 0x5e, 0x94, 0x72, 0x3f, 0x7f, 0xda, 0xad, 0x17, 0xc8, 0x90, 0x8d, 0x2e, 0x51, 0x1d,
-	0x46, 0x39, 0xa1, 0x5c, 0x98, 0xb1, 0x17, 0xcc, 0xfc, 0xc5, 0x03, 0x02, 0xf6, 0x47, 0x00, 0xef,
-	0x76, 0x30, 0xf5, 0x49, 0x7c, 0xc5, 0x3e, 0x5b, 0x47, 0xff, 0x2f, 0xb5, 0xb9, 0x20, 0xf5, 0x9f,
-	0x62, 0x10, 0xb0, 0x9f, 0xc0, 0x1b, 0xcf, 0x59, 0x30, 0x8c, 0xc9, 0x2b, 0x92, 0xe5, 0x22, 0xcf,
-	0x65, 0x17, 0x12, 0xc1, 0x6b, 0x23, 0x75, 0x2c, 0x95, 0xe9, 0x5e, 0xd9, 0xb6, 0xae, 0x9f, 0x4c,
-	0x2c, 0x20, 0x54, 0xb5, 0x9f, 0x4e, 0xbf, 0x9b, 0xda, 0x74, 0x66, 0x82, 0xb3, 0x99, 0x09, 0xbe,
-	0xcd, 0x4c, 0x70, 0x3c, 0x37, 0xb5, 0xb3, 0xb9, 0xa9, 0x7d, 0x99, 0x9b, 0xda, 0xeb, 0xfb, 0x61,
-	0xc4, 0x07, 0xc3, 0x9e, 0xe3, 0xb3, 0xa4, 0x78, 0x1b, 0xee, 0x82, 0xc8, 0x77, 0x97, 0xaf, 0x98,
-	0x8f, 0x53, 0x92, 0xf7, 0xd6, 0xe5, 0x65, 0x7b, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x32, 0xc0,
-	0xef, 0xa8, 0xe4, 0x03, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x4f, 0x14, 0x4f,
+	0x18, 0xbf, 0x81, 0x83, 0xff, 0xff, 0x86, 0x18, 0x60, 0x3d, 0x71, 0x39, 0xf0, 0xf6, 0x72, 0x31,
+	0xe6, 0x24, 0xde, 0x6e, 0xc0, 0xee, 0x2c, 0x0c, 0x07, 0xc6, 0xc4, 0x68, 0x24, 0x0b, 0x58, 0xd8,
+	0x6c, 0xe6, 0x76, 0x87, 0xbd, 0x8d, 0xbb, 0x33, 0x9b, 0x9d, 0xb9, 0x53, 0x4a, 0x5b, 0x2a, 0x4a,
+	0x4a, 0x4a, 0x2b, 0x43, 0xc1, 0x87, 0x20, 0x56, 0x84, 0xca, 0x58, 0x80, 0x42, 0x81, 0xbd, 0x5f,
+	0xc0, 0xcc, 0xcb, 0xe2, 0x05, 0x91, 0x58, 0xd8, 0x5c, 0xe6, 0x79, 0xe6, 0xf9, 0xcd, 0xef, 0x65,
+	0x6e, 0x16, 0xde, 0xf5, 0x29, 0x4b, 0x28, 0x73, 0x7a, 0x69, 0x98, 0xa1, 0x00, 0x3b, 0xfd, 0xf9,
+	0x0e, 0xe6, 0x68, 0x3e, 0xaf, 0xed, 0x34, 0xa3, 0x9c, 0x1a, 0x53, 0x6a, 0xca, 0xce, 0xbb, 0x7a,
+	0xaa, 0x32, 0x1d, 0x52, 0x1a, 0xc6, 0xd8, 0x91, 0x53, 0x9d, 0xde, 0x86, 0x83, 0xc8, 0xa6, 0x82,
+	0x54, 0xca, 0x21, 0x0d, 0xa9, 0x5c, 0x3a, 0x62, 0xa5, 0xbb, 0xd6, 0x65, 0x00, 0x8f, 0x12, 0xcc,
+	0x38, 0x4a, 0x52, 0x3d, 0x30, 0xad, 0x98, 0x3c, 0x85, 0xd4, 0xb4, 0x6a, 0x6b, 0x12, 0x25, 0x11,
+	0xa1, 0x8e, 0xfc, 0x55, 0xad, 0xfa, 0x0f, 0x00, 0x8b, 0x2b, 0x31, 0x22, 0x86, 0x01, 0x8b, 0x04,
+	0x25, 0xd8, 0x04, 0x35, 0xd0, 0x28, 0xb9, 0x72, 0x6d, 0x3c, 0x86, 0x45, 0x71, 0xba, 0x39, 0x54,
+	0x03, 0x8d, 0xb1, 0x85, 0x8a, 0xad, 0xa8, 0xed, 0x9c, 0xda, 0x5e, 0xcb, 0xa9, 0xdb, 0xe3, 0x07,
+	0xc7, 0x56, 0x61, 0xfb, 0xc4, 0x02, 0x1f, 0xce, 0xf7, 0xe6, 0x80, 0x09, 0x5c, 0x09, 0x34, 0xa6,
+	0xe0, 0x68, 0x17, 0x47, 0x61, 0x97, 0x9b, 0xc3, 0x35, 0xd0, 0x18, 0x76, 0x75, 0x25, 0xc8, 0x22,
+	0xb2, 0x41, 0xcd, 0xa2, 0x22, 0x13, 0x6b, 0xe3, 0x39, 0xbc, 0xa5, 0xc3, 0x09, 0x3c, 0x3f, 0x8e,
+	0x30, 0xe1, 0x1e, 0xe3, 0x88, 0x63, 0x73, 0x44, 0xb2, 0x97, 0x7f, 0x63, 0x5f, 0x24, 0x9b, 0xed,
+	0x21, 0x13, 0xb8, 0x37, 0x73, 0xd8, 0x92, 0x44, 0xad, 0x0a, 0x50, 0x6b, 0x76, 0x67, 0xd7, 0x2a,
+	0x7c, 0xdf, 0xb5, 0xc0, 0xd6, 0xf9, 0xde, 0xdc, 0xb8, 0x4a, 0xa1, 0xc9, 0x82, 0x37, 0x8e, 0x30,
+	0x5b, 0x3f, 0x01, 0xf0, 0xf6, 0x2a, 0xdd, 0xe0, 0x6f, 0x51, 0x86, 0xd7, 0x15, 0x7a, 0x25, 0xa3,
+	0x29, 0x65, 0x28, 0x36, 0xca, 0x70, 0x84, 0x47, 0x3c, 0xce, 0x93, 0x50, 0x85, 0x51, 0x83, 0x63,
+	0x01, 0x66, 0x7e, 0x16, 0xa5, 0x3c, 0xa2, 0x44, 0x26, 0x52, 0x72, 0x07, 0x5b, 0xc6, 0x23, 0x58,
+	0x4c, 0x63, 0x44, 0xa4, 0xd3, 0xb1, 0x85, 0x59, 0xfb, 0xea, 0x0b, 0xb7, 0x05, 0x7f, 0xbb, 0x24,
+	0xe2, 0x92, 0x51, 0xb9, 0x12, 0xd4, 0x7a, 0x99, 0xcb, 0xfd, 0xb4, 0xdf, 0xac, 0x68, 0x64, 0x48,
+	0xfb, 0x17, 0xa8, 0x25, 0x4a, 0x38, 0x26, 0x5c, 0x98, 0xa9, 0x0f, 0x98, 0xf9, 0x83, 0x07, 0x13,
+	0xd4, 0x3f, 0x02, 0x78, 0x67, 0x09, 0x11, 0x1f, 0xc7, 0xff, 0xd8, 0x67, 0x6b, 0xfd, 0xef, 0xa5,
+	0x36, 0x06, 0xa4, 0x5e, 0x2b, 0xc6, 0x04, 0xf5, 0xa7, 0xf0, 0xc6, 0x0b, 0x1a, 0xf4, 0x62, 0xfc,
+	0x0a, 0x67, 0x2c, 0xa2, 0x57, 0xff, 0x21, 0x4d, 0xf8, 0x5f, 0x5f, 0x6d, 0x4b, 0x65, 0x45, 0x37,
+	0x2f, 0x5b, 0xff, 0xef, 0xec, 0x5a, 0x40, 0xa8, 0x12, 0xce, 0x47, 0x57, 0x50, 0x86, 0x12, 0x66,
+	0xbc, 0x07, 0x70, 0x46, 0xe7, 0xef, 0x65, 0x18, 0x05, 0x11, 0xc1, 0x8c, 0x79, 0xbc, 0x9b, 0x61,
+	0xd6, 0xa5, 0x71, 0xa0, 0x8e, 0x6e, 0x2f, 0x8a, 0xcb, 0xf8, 0x72, 0x6c, 0xdd, 0x0b, 0x23, 0xde,
+	0xed, 0x75, 0x6c, 0x9f, 0x26, 0xfa, 0xd9, 0x38, 0x03, 0xfa, 0xf9, 0x66, 0x8a, 0x99, 0xbd, 0x8c,
+	0xfd, 0xa3, 0xfd, 0x26, 0xd4, 0xb6, 0x97, 0xb1, 0xaf, 0x2e, 0x71, 0x5a, 0xb3, 0xb8, 0x39, 0xc9,
+	0x5a, 0xce, 0xd1, 0xaa, 0x89, 0x20, 0x66, 0x06, 0x0e, 0x7a, 0x77, 0xf1, 0xa5, 0x50, 0x2a, 0xeb,
+	0x5b, 0x00, 0x4e, 0xac, 0x5f, 0xc2, 0x1b, 0x4f, 0xe0, 0x64, 0x1f, 0xc5, 0x51, 0x80, 0x38, 0xcd,
+	0x3c, 0x14, 0x04, 0x19, 0x66, 0x4c, 0xeb, 0x35, 0x8f, 0xf6, 0x9b, 0x65, 0xad, 0x60, 0x51, 0xed,
+	0xac, 0xf2, 0x2c, 0x22, 0xa1, 0x3b, 0x71, 0x01, 0xd1, 0x7d, 0xe3, 0x3e, 0x9c, 0x60, 0x3a, 0x72,
+	0x6f, 0x30, 0xb9, 0x92, 0x3b, 0x9e, 0xf7, 0x75, 0xde, 0xad, 0xa2, 0x48, 0xaf, 0xfd, 0xec, 0xe0,
+	0x5b, 0xb5, 0x70, 0x70, 0x5a, 0x05, 0x87, 0xa7, 0x55, 0xf0, 0xf5, 0xb4, 0x0a, 0xb6, 0xcf, 0xaa,
+	0x85, 0xc3, 0xb3, 0x6a, 0xe1, 0xf3, 0x59, 0xb5, 0xf0, 0xfa, 0xc1, 0xb5, 0x11, 0xfd, 0x72, 0x26,
+	0xc3, 0xea, 0x8c, 0xca, 0xa7, 0xfa, 0xf0, 0xe7, 0x00, 0x91, 0x52, 0x20, 0x24, 0x22, 0x05, 0x00,
+	0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -391,6 +484,33 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpgradeReadiness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpgradeReadiness)
+	if !ok {
+		that2, ok := that.(UpgradeReadiness)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.SoftwareVersion != that1.SoftwareVersion {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -572,6 +692,76 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UpgradeReadinessThreshold.Size()
+		i -= size
+		if _, err := m.UpgradeReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SoftwareVersion) > 0 {
+		i -= len(m.SoftwareVersion)
+		copy(dAtA[i:], m.SoftwareVersion)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.SoftwareVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -661,6 +851,34 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UpgradeReadinessThreshold.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	return n
+}
+
+func (m *UpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.SoftwareVersion)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1231,6 +1449,204 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeReadinessThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradeReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftwareVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// planInfoVersion is the part of the Plan.Info, when given as json, which
+// specifies the binary version expected to run after the upgrade, e.g.
+// {"version":"v2.0.0","binaries":{...}}.
+type planInfoVersion struct {
+	Version string `json:"version"`
+}
+
+// ExpectedVersion returns the binary version expected to run after the upgrade,
+// read from the version field of the plan info. It returns an empty string if
+// the plan info is not json or does not specify a version.
+func (p Plan) ExpectedVersion() string {
+	var info planInfoVersion
+	if err := json.Unmarshal([]byte(strings.TrimSpace(p.Info)), &info); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(info.Version)
+}
+
+// VersionCompatibilityChecker checks that the running binary is the version
+// expected by an upgrade plan.
+type VersionCompatibilityChecker struct {
+	// BinaryVersion is the version of the running binary.
+	BinaryVersion string
+}
+
+// NewVersionCompatibilityChecker returns a VersionCompatibilityChecker for the
+// running binary, whose version is set at build time in version.Version.
+func NewVersionCompatibilityChecker() VersionCompatibilityChecker {
+	return VersionCompatibilityChecker{BinaryVersion: version.Version}
+}
+
+// IsVersionKnown returns whether the version of the running binary is known.
+func (c VersionCompatibilityChecker) IsVersionKnown() bool {
+	return c.BinaryVersion != ""
+}
+
+// Check returns an error if the plan specifies an expected version which the
+// running binary does not match. Versions are compared ignoring a "v" prefix.
+// Plans without expected version and binaries of unknown version always pass.
+func (c VersionCompatibilityChecker) Check(plan Plan) error {
+	expected := plan.ExpectedVersion()
+	if expected == "" || !c.IsVersionKnown() {
+		return nil
+	}
+
+	if strings.TrimPrefix(expected, "v") != strings.TrimPrefix(c.BinaryVersion, "v") {
+		return fmt.Errorf(
+			"UPGRADE \"%s\" at %d expects binary version %s but the running binary is version %s: switch to the %s binary",
+			plan.Name, plan.Height, expected, c.BinaryVersion, expected,
+		)
+	}
+
+	return nil
+}
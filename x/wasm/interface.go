@@ -0,0 +1,25 @@
+/*
+Package wasm defines the interface a CosmWasm integration exposes to other
+modules. It is not a CosmWasm implementation: modules depend on WasmKeeper to
+execute and query contracts, and the application provides the implementation
+(e.g. the wasmd keeper), so modules do not need to depend on the full wasmd
+repository.
+*/
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WasmKeeper defines the expected keeper used to execute and query CosmWasm
+// contracts.
+type WasmKeeper interface {
+	// Execute calls the given contract with a JSON encoded execute msg, sending
+	// coins from sender to the contract. It returns the events emitted by the
+	// contract and the data returned by each message it handled.
+	Execute(ctx sdk.Context, contract, sender sdk.AccAddress, msg []byte, coins sdk.Coins) ([]sdk.Event, [][]byte, error)
+
+	// Query performs a smart query against the given contract with a JSON
+	// encoded query msg and returns the JSON encoded response.
+	Query(ctx sdk.Context, contract sdk.AccAddress, msg []byte) ([]byte, error)
+}